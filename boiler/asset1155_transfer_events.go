@@ -0,0 +1,1953 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Asset1155TransferEvent is an object representing the database table.
+type Asset1155TransferEvent struct {
+	ID              int64       `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	CollectionID    string      `boiler:"collection_id" boil:"collection_id" json:"collection_id" toml:"collection_id" yaml:"collection_id"`
+	ExternalTokenID int         `boiler:"external_token_id" boil:"external_token_id" json:"external_token_id" toml:"external_token_id" yaml:"external_token_id"`
+	Amount          int         `boiler:"amount" boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	FromAssetID     string      `boiler:"from_asset_id" boil:"from_asset_id" json:"from_asset_id" toml:"from_asset_id" yaml:"from_asset_id"`
+	ToAssetID       string      `boiler:"to_asset_id" boil:"to_asset_id" json:"to_asset_id" toml:"to_asset_id" yaml:"to_asset_id"`
+	FromUserID      string      `boiler:"from_user_id" boil:"from_user_id" json:"from_user_id" toml:"from_user_id" yaml:"from_user_id"`
+	ToUserID        string      `boiler:"to_user_id" boil:"to_user_id" json:"to_user_id" toml:"to_user_id" yaml:"to_user_id"`
+	ServiceID       null.String `boiler:"service_id" boil:"service_id" json:"service_id,omitempty" toml:"service_id" yaml:"service_id,omitempty"`
+	InitiatedFrom   string      `boiler:"initiated_from" boil:"initiated_from" json:"initiated_from" toml:"initiated_from" yaml:"initiated_from"`
+	TransferredAt   time.Time   `boiler:"transferred_at" boil:"transferred_at" json:"transferred_at" toml:"transferred_at" yaml:"transferred_at"`
+
+	R *asset1155TransferEventR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L asset1155TransferEventL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var Asset1155TransferEventColumns = struct {
+	ID              string
+	CollectionID    string
+	ExternalTokenID string
+	Amount          string
+	FromAssetID     string
+	ToAssetID       string
+	FromUserID      string
+	ToUserID        string
+	ServiceID       string
+	InitiatedFrom   string
+	TransferredAt   string
+}{
+	ID:              "id",
+	CollectionID:    "collection_id",
+	ExternalTokenID: "external_token_id",
+	Amount:          "amount",
+	FromAssetID:     "from_asset_id",
+	ToAssetID:       "to_asset_id",
+	FromUserID:      "from_user_id",
+	ToUserID:        "to_user_id",
+	ServiceID:       "service_id",
+	InitiatedFrom:   "initiated_from",
+	TransferredAt:   "transferred_at",
+}
+
+var Asset1155TransferEventTableColumns = struct {
+	ID              string
+	CollectionID    string
+	ExternalTokenID string
+	Amount          string
+	FromAssetID     string
+	ToAssetID       string
+	FromUserID      string
+	ToUserID        string
+	ServiceID       string
+	InitiatedFrom   string
+	TransferredAt   string
+}{
+	ID:              "asset1155_transfer_events.id",
+	CollectionID:    "asset1155_transfer_events.collection_id",
+	ExternalTokenID: "asset1155_transfer_events.external_token_id",
+	Amount:          "asset1155_transfer_events.amount",
+	FromAssetID:     "asset1155_transfer_events.from_asset_id",
+	ToAssetID:       "asset1155_transfer_events.to_asset_id",
+	FromUserID:      "asset1155_transfer_events.from_user_id",
+	ToUserID:        "asset1155_transfer_events.to_user_id",
+	ServiceID:       "asset1155_transfer_events.service_id",
+	InitiatedFrom:   "asset1155_transfer_events.initiated_from",
+	TransferredAt:   "asset1155_transfer_events.transferred_at",
+}
+
+// Generated where
+
+var Asset1155TransferEventWhere = struct {
+	ID              whereHelperint64
+	CollectionID    whereHelperstring
+	ExternalTokenID whereHelperint
+	Amount          whereHelperint
+	FromAssetID     whereHelperstring
+	ToAssetID       whereHelperstring
+	FromUserID      whereHelperstring
+	ToUserID        whereHelperstring
+	ServiceID       whereHelpernull_String
+	InitiatedFrom   whereHelperstring
+	TransferredAt   whereHelpertime_Time
+}{
+	ID:              whereHelperint64{field: "\"asset1155_transfer_events\".\"id\""},
+	CollectionID:    whereHelperstring{field: "\"asset1155_transfer_events\".\"collection_id\""},
+	ExternalTokenID: whereHelperint{field: "\"asset1155_transfer_events\".\"external_token_id\""},
+	Amount:          whereHelperint{field: "\"asset1155_transfer_events\".\"amount\""},
+	FromAssetID:     whereHelperstring{field: "\"asset1155_transfer_events\".\"from_asset_id\""},
+	ToAssetID:       whereHelperstring{field: "\"asset1155_transfer_events\".\"to_asset_id\""},
+	FromUserID:      whereHelperstring{field: "\"asset1155_transfer_events\".\"from_user_id\""},
+	ToUserID:        whereHelperstring{field: "\"asset1155_transfer_events\".\"to_user_id\""},
+	ServiceID:       whereHelpernull_String{field: "\"asset1155_transfer_events\".\"service_id\""},
+	InitiatedFrom:   whereHelperstring{field: "\"asset1155_transfer_events\".\"initiated_from\""},
+	TransferredAt:   whereHelpertime_Time{field: "\"asset1155_transfer_events\".\"transferred_at\""},
+}
+
+// Asset1155TransferEventRels is where relationship names are stored.
+var Asset1155TransferEventRels = struct {
+	Collection string
+	FromAsset  string
+	FromUser   string
+	Service    string
+	ToAsset    string
+	ToUser     string
+}{
+	Collection: "Collection",
+	FromAsset:  "FromAsset",
+	FromUser:   "FromUser",
+	Service:    "Service",
+	ToAsset:    "ToAsset",
+	ToUser:     "ToUser",
+}
+
+// asset1155TransferEventR is where relationships are stored.
+type asset1155TransferEventR struct {
+	Collection *Collection     `boiler:"Collection" boil:"Collection" json:"Collection" toml:"Collection" yaml:"Collection"`
+	FromAsset  *UserAssets1155 `boiler:"FromAsset" boil:"FromAsset" json:"FromAsset" toml:"FromAsset" yaml:"FromAsset"`
+	FromUser   *User           `boiler:"FromUser" boil:"FromUser" json:"FromUser" toml:"FromUser" yaml:"FromUser"`
+	Service    *User           `boiler:"Service" boil:"Service" json:"Service" toml:"Service" yaml:"Service"`
+	ToAsset    *UserAssets1155 `boiler:"ToAsset" boil:"ToAsset" json:"ToAsset" toml:"ToAsset" yaml:"ToAsset"`
+	ToUser     *User           `boiler:"ToUser" boil:"ToUser" json:"ToUser" toml:"ToUser" yaml:"ToUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*asset1155TransferEventR) NewStruct() *asset1155TransferEventR {
+	return &asset1155TransferEventR{}
+}
+
+// asset1155TransferEventL is where Load methods for each relationship are stored.
+type asset1155TransferEventL struct{}
+
+var (
+	asset1155TransferEventAllColumns            = []string{"id", "collection_id", "external_token_id", "amount", "from_asset_id", "to_asset_id", "from_user_id", "to_user_id", "service_id", "initiated_from", "transferred_at"}
+	asset1155TransferEventColumnsWithoutDefault = []string{"collection_id", "external_token_id", "amount", "from_asset_id", "to_asset_id", "from_user_id", "to_user_id"}
+	asset1155TransferEventColumnsWithDefault    = []string{"id", "service_id", "initiated_from", "transferred_at"}
+	asset1155TransferEventPrimaryKeyColumns     = []string{"id"}
+	asset1155TransferEventGeneratedColumns      = []string{}
+)
+
+type (
+	// Asset1155TransferEventSlice is an alias for a slice of pointers to Asset1155TransferEvent.
+	// This should almost always be used instead of []Asset1155TransferEvent.
+	Asset1155TransferEventSlice []*Asset1155TransferEvent
+	// Asset1155TransferEventHook is the signature for custom Asset1155TransferEvent hook methods
+	Asset1155TransferEventHook func(boil.Executor, *Asset1155TransferEvent) error
+
+	asset1155TransferEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	asset1155TransferEventType                 = reflect.TypeOf(&Asset1155TransferEvent{})
+	asset1155TransferEventMapping              = queries.MakeStructMapping(asset1155TransferEventType)
+	asset1155TransferEventPrimaryKeyMapping, _ = queries.BindMapping(asset1155TransferEventType, asset1155TransferEventMapping, asset1155TransferEventPrimaryKeyColumns)
+	asset1155TransferEventInsertCacheMut       sync.RWMutex
+	asset1155TransferEventInsertCache          = make(map[string]insertCache)
+	asset1155TransferEventUpdateCacheMut       sync.RWMutex
+	asset1155TransferEventUpdateCache          = make(map[string]updateCache)
+	asset1155TransferEventUpsertCacheMut       sync.RWMutex
+	asset1155TransferEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var asset1155TransferEventAfterSelectHooks []Asset1155TransferEventHook
+
+var asset1155TransferEventBeforeInsertHooks []Asset1155TransferEventHook
+var asset1155TransferEventAfterInsertHooks []Asset1155TransferEventHook
+
+var asset1155TransferEventBeforeUpdateHooks []Asset1155TransferEventHook
+var asset1155TransferEventAfterUpdateHooks []Asset1155TransferEventHook
+
+var asset1155TransferEventBeforeDeleteHooks []Asset1155TransferEventHook
+var asset1155TransferEventAfterDeleteHooks []Asset1155TransferEventHook
+
+var asset1155TransferEventBeforeUpsertHooks []Asset1155TransferEventHook
+var asset1155TransferEventAfterUpsertHooks []Asset1155TransferEventHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Asset1155TransferEvent) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range asset1155TransferEventAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Asset1155TransferEvent) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range asset1155TransferEventBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Asset1155TransferEvent) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range asset1155TransferEventAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Asset1155TransferEvent) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range asset1155TransferEventBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Asset1155TransferEvent) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range asset1155TransferEventAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Asset1155TransferEvent) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range asset1155TransferEventBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Asset1155TransferEvent) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range asset1155TransferEventAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Asset1155TransferEvent) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range asset1155TransferEventBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Asset1155TransferEvent) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range asset1155TransferEventAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAsset1155TransferEventHook registers your hook function for all future operations.
+func AddAsset1155TransferEventHook(hookPoint boil.HookPoint, asset1155TransferEventHook Asset1155TransferEventHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		asset1155TransferEventAfterSelectHooks = append(asset1155TransferEventAfterSelectHooks, asset1155TransferEventHook)
+	case boil.BeforeInsertHook:
+		asset1155TransferEventBeforeInsertHooks = append(asset1155TransferEventBeforeInsertHooks, asset1155TransferEventHook)
+	case boil.AfterInsertHook:
+		asset1155TransferEventAfterInsertHooks = append(asset1155TransferEventAfterInsertHooks, asset1155TransferEventHook)
+	case boil.BeforeUpdateHook:
+		asset1155TransferEventBeforeUpdateHooks = append(asset1155TransferEventBeforeUpdateHooks, asset1155TransferEventHook)
+	case boil.AfterUpdateHook:
+		asset1155TransferEventAfterUpdateHooks = append(asset1155TransferEventAfterUpdateHooks, asset1155TransferEventHook)
+	case boil.BeforeDeleteHook:
+		asset1155TransferEventBeforeDeleteHooks = append(asset1155TransferEventBeforeDeleteHooks, asset1155TransferEventHook)
+	case boil.AfterDeleteHook:
+		asset1155TransferEventAfterDeleteHooks = append(asset1155TransferEventAfterDeleteHooks, asset1155TransferEventHook)
+	case boil.BeforeUpsertHook:
+		asset1155TransferEventBeforeUpsertHooks = append(asset1155TransferEventBeforeUpsertHooks, asset1155TransferEventHook)
+	case boil.AfterUpsertHook:
+		asset1155TransferEventAfterUpsertHooks = append(asset1155TransferEventAfterUpsertHooks, asset1155TransferEventHook)
+	}
+}
+
+// One returns a single asset1155TransferEvent record from the query.
+func (q asset1155TransferEventQuery) One(exec boil.Executor) (*Asset1155TransferEvent, error) {
+	o := &Asset1155TransferEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for asset1155_transfer_events")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Asset1155TransferEvent records from the query.
+func (q asset1155TransferEventQuery) All(exec boil.Executor) (Asset1155TransferEventSlice, error) {
+	var o []*Asset1155TransferEvent
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to Asset1155TransferEvent slice")
+	}
+
+	if len(asset1155TransferEventAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Asset1155TransferEvent records in the query.
+func (q asset1155TransferEventQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count asset1155_transfer_events rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q asset1155TransferEventQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if asset1155_transfer_events exists")
+	}
+
+	return count > 0, nil
+}
+
+// Collection pointed to by the foreign key.
+func (o *Asset1155TransferEvent) Collection(mods ...qm.QueryMod) collectionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CollectionID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Collections(queryMods...)
+	queries.SetFrom(query.Query, "\"collections\"")
+
+	return query
+}
+
+// FromAsset pointed to by the foreign key.
+func (o *Asset1155TransferEvent) FromAsset(mods ...qm.QueryMod) userAssets1155Query {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FromAssetID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := UserAssets1155S(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets_1155\"")
+
+	return query
+}
+
+// FromUser pointed to by the foreign key.
+func (o *Asset1155TransferEvent) FromUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FromUserID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// Service pointed to by the foreign key.
+func (o *Asset1155TransferEvent) Service(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ServiceID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// ToAsset pointed to by the foreign key.
+func (o *Asset1155TransferEvent) ToAsset(mods ...qm.QueryMod) userAssets1155Query {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ToAssetID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := UserAssets1155S(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets_1155\"")
+
+	return query
+}
+
+// ToUser pointed to by the foreign key.
+func (o *Asset1155TransferEvent) ToUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ToUserID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// LoadCollection allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (asset1155TransferEventL) LoadCollection(e boil.Executor, singular bool, maybeAsset1155TransferEvent interface{}, mods queries.Applicator) error {
+	var slice []*Asset1155TransferEvent
+	var object *Asset1155TransferEvent
+
+	if singular {
+		object = maybeAsset1155TransferEvent.(*Asset1155TransferEvent)
+	} else {
+		slice = *maybeAsset1155TransferEvent.(*[]*Asset1155TransferEvent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &asset1155TransferEventR{}
+		}
+		args = append(args, object.CollectionID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &asset1155TransferEventR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CollectionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CollectionID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`collections`),
+		qm.WhereIn(`collections.id in ?`, args...),
+		qmhelper.WhereIsNull(`collections.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Collection")
+	}
+
+	var resultSlice []*Collection
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Collection")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for collections")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collections")
+	}
+
+	if len(asset1155TransferEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Collection = foreign
+		if foreign.R == nil {
+			foreign.R = &collectionR{}
+		}
+		foreign.R.Asset1155TransferEvents = append(foreign.R.Asset1155TransferEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CollectionID == foreign.ID {
+				local.R.Collection = foreign
+				if foreign.R == nil {
+					foreign.R = &collectionR{}
+				}
+				foreign.R.Asset1155TransferEvents = append(foreign.R.Asset1155TransferEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFromAsset allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (asset1155TransferEventL) LoadFromAsset(e boil.Executor, singular bool, maybeAsset1155TransferEvent interface{}, mods queries.Applicator) error {
+	var slice []*Asset1155TransferEvent
+	var object *Asset1155TransferEvent
+
+	if singular {
+		object = maybeAsset1155TransferEvent.(*Asset1155TransferEvent)
+	} else {
+		slice = *maybeAsset1155TransferEvent.(*[]*Asset1155TransferEvent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &asset1155TransferEventR{}
+		}
+		args = append(args, object.FromAssetID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &asset1155TransferEventR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FromAssetID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FromAssetID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets_1155`),
+		qm.WhereIn(`user_assets_1155.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserAssets1155")
+	}
+
+	var resultSlice []*UserAssets1155
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserAssets1155")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_assets_1155")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets_1155")
+	}
+
+	if len(asset1155TransferEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.FromAsset = foreign
+		if foreign.R == nil {
+			foreign.R = &userAssets1155R{}
+		}
+		foreign.R.FromAssetAsset1155TransferEvents = append(foreign.R.FromAssetAsset1155TransferEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FromAssetID == foreign.ID {
+				local.R.FromAsset = foreign
+				if foreign.R == nil {
+					foreign.R = &userAssets1155R{}
+				}
+				foreign.R.FromAssetAsset1155TransferEvents = append(foreign.R.FromAssetAsset1155TransferEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFromUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (asset1155TransferEventL) LoadFromUser(e boil.Executor, singular bool, maybeAsset1155TransferEvent interface{}, mods queries.Applicator) error {
+	var slice []*Asset1155TransferEvent
+	var object *Asset1155TransferEvent
+
+	if singular {
+		object = maybeAsset1155TransferEvent.(*Asset1155TransferEvent)
+	} else {
+		slice = *maybeAsset1155TransferEvent.(*[]*Asset1155TransferEvent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &asset1155TransferEventR{}
+		}
+		args = append(args, object.FromUserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &asset1155TransferEventR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FromUserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FromUserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(asset1155TransferEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.FromUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.FromUserAsset1155TransferEvents = append(foreign.R.FromUserAsset1155TransferEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FromUserID == foreign.ID {
+				local.R.FromUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.FromUserAsset1155TransferEvents = append(foreign.R.FromUserAsset1155TransferEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadService allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (asset1155TransferEventL) LoadService(e boil.Executor, singular bool, maybeAsset1155TransferEvent interface{}, mods queries.Applicator) error {
+	var slice []*Asset1155TransferEvent
+	var object *Asset1155TransferEvent
+
+	if singular {
+		object = maybeAsset1155TransferEvent.(*Asset1155TransferEvent)
+	} else {
+		slice = *maybeAsset1155TransferEvent.(*[]*Asset1155TransferEvent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &asset1155TransferEventR{}
+		}
+		if !queries.IsNil(object.ServiceID) {
+			args = append(args, object.ServiceID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &asset1155TransferEventR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ServiceID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ServiceID) {
+				args = append(args, obj.ServiceID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(asset1155TransferEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Service = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ServiceAsset1155TransferEvents = append(foreign.R.ServiceAsset1155TransferEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ServiceID, foreign.ID) {
+				local.R.Service = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ServiceAsset1155TransferEvents = append(foreign.R.ServiceAsset1155TransferEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadToAsset allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (asset1155TransferEventL) LoadToAsset(e boil.Executor, singular bool, maybeAsset1155TransferEvent interface{}, mods queries.Applicator) error {
+	var slice []*Asset1155TransferEvent
+	var object *Asset1155TransferEvent
+
+	if singular {
+		object = maybeAsset1155TransferEvent.(*Asset1155TransferEvent)
+	} else {
+		slice = *maybeAsset1155TransferEvent.(*[]*Asset1155TransferEvent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &asset1155TransferEventR{}
+		}
+		args = append(args, object.ToAssetID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &asset1155TransferEventR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ToAssetID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ToAssetID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets_1155`),
+		qm.WhereIn(`user_assets_1155.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserAssets1155")
+	}
+
+	var resultSlice []*UserAssets1155
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserAssets1155")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_assets_1155")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets_1155")
+	}
+
+	if len(asset1155TransferEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ToAsset = foreign
+		if foreign.R == nil {
+			foreign.R = &userAssets1155R{}
+		}
+		foreign.R.ToAssetAsset1155TransferEvents = append(foreign.R.ToAssetAsset1155TransferEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ToAssetID == foreign.ID {
+				local.R.ToAsset = foreign
+				if foreign.R == nil {
+					foreign.R = &userAssets1155R{}
+				}
+				foreign.R.ToAssetAsset1155TransferEvents = append(foreign.R.ToAssetAsset1155TransferEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadToUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (asset1155TransferEventL) LoadToUser(e boil.Executor, singular bool, maybeAsset1155TransferEvent interface{}, mods queries.Applicator) error {
+	var slice []*Asset1155TransferEvent
+	var object *Asset1155TransferEvent
+
+	if singular {
+		object = maybeAsset1155TransferEvent.(*Asset1155TransferEvent)
+	} else {
+		slice = *maybeAsset1155TransferEvent.(*[]*Asset1155TransferEvent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &asset1155TransferEventR{}
+		}
+		args = append(args, object.ToUserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &asset1155TransferEventR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ToUserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ToUserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(asset1155TransferEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ToUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ToUserAsset1155TransferEvents = append(foreign.R.ToUserAsset1155TransferEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ToUserID == foreign.ID {
+				local.R.ToUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ToUserAsset1155TransferEvents = append(foreign.R.ToUserAsset1155TransferEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCollection of the asset1155TransferEvent to the related item.
+// Sets o.R.Collection to related.
+// Adds o to related.R.Asset1155TransferEvents.
+func (o *Asset1155TransferEvent) SetCollection(exec boil.Executor, insert bool, related *Collection) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset1155_transfer_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+		strmangle.WhereClause("\"", "\"", 2, asset1155TransferEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CollectionID = related.ID
+	if o.R == nil {
+		o.R = &asset1155TransferEventR{
+			Collection: related,
+		}
+	} else {
+		o.R.Collection = related
+	}
+
+	if related.R == nil {
+		related.R = &collectionR{
+			Asset1155TransferEvents: Asset1155TransferEventSlice{o},
+		}
+	} else {
+		related.R.Asset1155TransferEvents = append(related.R.Asset1155TransferEvents, o)
+	}
+
+	return nil
+}
+
+// SetFromAsset of the asset1155TransferEvent to the related item.
+// Sets o.R.FromAsset to related.
+// Adds o to related.R.FromAssetAsset1155TransferEvents.
+func (o *Asset1155TransferEvent) SetFromAsset(exec boil.Executor, insert bool, related *UserAssets1155) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset1155_transfer_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"from_asset_id"}),
+		strmangle.WhereClause("\"", "\"", 2, asset1155TransferEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FromAssetID = related.ID
+	if o.R == nil {
+		o.R = &asset1155TransferEventR{
+			FromAsset: related,
+		}
+	} else {
+		o.R.FromAsset = related
+	}
+
+	if related.R == nil {
+		related.R = &userAssets1155R{
+			FromAssetAsset1155TransferEvents: Asset1155TransferEventSlice{o},
+		}
+	} else {
+		related.R.FromAssetAsset1155TransferEvents = append(related.R.FromAssetAsset1155TransferEvents, o)
+	}
+
+	return nil
+}
+
+// SetFromUser of the asset1155TransferEvent to the related item.
+// Sets o.R.FromUser to related.
+// Adds o to related.R.FromUserAsset1155TransferEvents.
+func (o *Asset1155TransferEvent) SetFromUser(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset1155_transfer_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"from_user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, asset1155TransferEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FromUserID = related.ID
+	if o.R == nil {
+		o.R = &asset1155TransferEventR{
+			FromUser: related,
+		}
+	} else {
+		o.R.FromUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			FromUserAsset1155TransferEvents: Asset1155TransferEventSlice{o},
+		}
+	} else {
+		related.R.FromUserAsset1155TransferEvents = append(related.R.FromUserAsset1155TransferEvents, o)
+	}
+
+	return nil
+}
+
+// SetService of the asset1155TransferEvent to the related item.
+// Sets o.R.Service to related.
+// Adds o to related.R.ServiceAsset1155TransferEvents.
+func (o *Asset1155TransferEvent) SetService(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset1155_transfer_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"service_id"}),
+		strmangle.WhereClause("\"", "\"", 2, asset1155TransferEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ServiceID, related.ID)
+	if o.R == nil {
+		o.R = &asset1155TransferEventR{
+			Service: related,
+		}
+	} else {
+		o.R.Service = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ServiceAsset1155TransferEvents: Asset1155TransferEventSlice{o},
+		}
+	} else {
+		related.R.ServiceAsset1155TransferEvents = append(related.R.ServiceAsset1155TransferEvents, o)
+	}
+
+	return nil
+}
+
+// RemoveService relationship.
+// Sets o.R.Service to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Asset1155TransferEvent) RemoveService(exec boil.Executor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ServiceID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("service_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Service = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ServiceAsset1155TransferEvents {
+		if queries.Equal(o.ServiceID, ri.ServiceID) {
+			continue
+		}
+
+		ln := len(related.R.ServiceAsset1155TransferEvents)
+		if ln > 1 && i < ln-1 {
+			related.R.ServiceAsset1155TransferEvents[i] = related.R.ServiceAsset1155TransferEvents[ln-1]
+		}
+		related.R.ServiceAsset1155TransferEvents = related.R.ServiceAsset1155TransferEvents[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetToAsset of the asset1155TransferEvent to the related item.
+// Sets o.R.ToAsset to related.
+// Adds o to related.R.ToAssetAsset1155TransferEvents.
+func (o *Asset1155TransferEvent) SetToAsset(exec boil.Executor, insert bool, related *UserAssets1155) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset1155_transfer_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"to_asset_id"}),
+		strmangle.WhereClause("\"", "\"", 2, asset1155TransferEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ToAssetID = related.ID
+	if o.R == nil {
+		o.R = &asset1155TransferEventR{
+			ToAsset: related,
+		}
+	} else {
+		o.R.ToAsset = related
+	}
+
+	if related.R == nil {
+		related.R = &userAssets1155R{
+			ToAssetAsset1155TransferEvents: Asset1155TransferEventSlice{o},
+		}
+	} else {
+		related.R.ToAssetAsset1155TransferEvents = append(related.R.ToAssetAsset1155TransferEvents, o)
+	}
+
+	return nil
+}
+
+// SetToUser of the asset1155TransferEvent to the related item.
+// Sets o.R.ToUser to related.
+// Adds o to related.R.ToUserAsset1155TransferEvents.
+func (o *Asset1155TransferEvent) SetToUser(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset1155_transfer_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"to_user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, asset1155TransferEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ToUserID = related.ID
+	if o.R == nil {
+		o.R = &asset1155TransferEventR{
+			ToUser: related,
+		}
+	} else {
+		o.R.ToUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ToUserAsset1155TransferEvents: Asset1155TransferEventSlice{o},
+		}
+	} else {
+		related.R.ToUserAsset1155TransferEvents = append(related.R.ToUserAsset1155TransferEvents, o)
+	}
+
+	return nil
+}
+
+// Asset1155TransferEvents retrieves all the records using an executor.
+func Asset1155TransferEvents(mods ...qm.QueryMod) asset1155TransferEventQuery {
+	mods = append(mods, qm.From("\"asset1155_transfer_events\""))
+	return asset1155TransferEventQuery{NewQuery(mods...)}
+}
+
+// FindAsset1155TransferEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAsset1155TransferEvent(exec boil.Executor, iD int64, selectCols ...string) (*Asset1155TransferEvent, error) {
+	asset1155TransferEventObj := &Asset1155TransferEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"asset1155_transfer_events\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, asset1155TransferEventObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from asset1155_transfer_events")
+	}
+
+	if err = asset1155TransferEventObj.doAfterSelectHooks(exec); err != nil {
+		return asset1155TransferEventObj, err
+	}
+
+	return asset1155TransferEventObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Asset1155TransferEvent) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no asset1155_transfer_events provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(asset1155TransferEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	asset1155TransferEventInsertCacheMut.RLock()
+	cache, cached := asset1155TransferEventInsertCache[key]
+	asset1155TransferEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			asset1155TransferEventAllColumns,
+			asset1155TransferEventColumnsWithDefault,
+			asset1155TransferEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(asset1155TransferEventType, asset1155TransferEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(asset1155TransferEventType, asset1155TransferEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"asset1155_transfer_events\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"asset1155_transfer_events\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into asset1155_transfer_events")
+	}
+
+	if !cached {
+		asset1155TransferEventInsertCacheMut.Lock()
+		asset1155TransferEventInsertCache[key] = cache
+		asset1155TransferEventInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the Asset1155TransferEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Asset1155TransferEvent) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	asset1155TransferEventUpdateCacheMut.RLock()
+	cache, cached := asset1155TransferEventUpdateCache[key]
+	asset1155TransferEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			asset1155TransferEventAllColumns,
+			asset1155TransferEventPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update asset1155_transfer_events, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"asset1155_transfer_events\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, asset1155TransferEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(asset1155TransferEventType, asset1155TransferEventMapping, append(wl, asset1155TransferEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update asset1155_transfer_events row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for asset1155_transfer_events")
+	}
+
+	if !cached {
+		asset1155TransferEventUpdateCacheMut.Lock()
+		asset1155TransferEventUpdateCache[key] = cache
+		asset1155TransferEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q asset1155TransferEventQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for asset1155_transfer_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for asset1155_transfer_events")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o Asset1155TransferEventSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), asset1155TransferEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"asset1155_transfer_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, asset1155TransferEventPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in asset1155TransferEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all asset1155TransferEvent")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Asset1155TransferEvent) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no asset1155_transfer_events provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(asset1155TransferEventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	asset1155TransferEventUpsertCacheMut.RLock()
+	cache, cached := asset1155TransferEventUpsertCache[key]
+	asset1155TransferEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			asset1155TransferEventAllColumns,
+			asset1155TransferEventColumnsWithDefault,
+			asset1155TransferEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			asset1155TransferEventAllColumns,
+			asset1155TransferEventPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert asset1155_transfer_events, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(asset1155TransferEventPrimaryKeyColumns))
+			copy(conflict, asset1155TransferEventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"asset1155_transfer_events\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(asset1155TransferEventType, asset1155TransferEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(asset1155TransferEventType, asset1155TransferEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert asset1155_transfer_events")
+	}
+
+	if !cached {
+		asset1155TransferEventUpsertCacheMut.Lock()
+		asset1155TransferEventUpsertCache[key] = cache
+		asset1155TransferEventUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single Asset1155TransferEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Asset1155TransferEvent) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no Asset1155TransferEvent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), asset1155TransferEventPrimaryKeyMapping)
+	sql := "DELETE FROM \"asset1155_transfer_events\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from asset1155_transfer_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for asset1155_transfer_events")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q asset1155TransferEventQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no asset1155TransferEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from asset1155_transfer_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for asset1155_transfer_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o Asset1155TransferEventSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(asset1155TransferEventBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), asset1155TransferEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"asset1155_transfer_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, asset1155TransferEventPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from asset1155TransferEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for asset1155_transfer_events")
+	}
+
+	if len(asset1155TransferEventAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Asset1155TransferEvent) Reload(exec boil.Executor) error {
+	ret, err := FindAsset1155TransferEvent(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *Asset1155TransferEventSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := Asset1155TransferEventSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), asset1155TransferEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"asset1155_transfer_events\".* FROM \"asset1155_transfer_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, asset1155TransferEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in Asset1155TransferEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// Asset1155TransferEventExists checks if the Asset1155TransferEvent row exists.
+func Asset1155TransferEventExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"asset1155_transfer_events\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if asset1155_transfer_events exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1047 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AssetMetadataRefresh is an object representing the database table.
+type AssetMetadataRefresh struct {
+	UserAssetID   string      `boiler:"user_asset_id" boil:"user_asset_id" json:"user_asset_id" toml:"user_asset_id" yaml:"user_asset_id"`
+	Priority      int         `boiler:"priority" boil:"priority" json:"priority" toml:"priority" yaml:"priority"`
+	Attempts      int         `boiler:"attempts" boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	LastError     null.String `boiler:"last_error" boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	LastAttemptAt null.Time   `boiler:"last_attempt_at" boil:"last_attempt_at" json:"last_attempt_at,omitempty" toml:"last_attempt_at" yaml:"last_attempt_at,omitempty"`
+	NextAttemptAt time.Time   `boiler:"next_attempt_at" boil:"next_attempt_at" json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at"`
+	RequestedAt   time.Time   `boiler:"requested_at" boil:"requested_at" json:"requested_at" toml:"requested_at" yaml:"requested_at"`
+
+	R *assetMetadataRefreshR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L assetMetadataRefreshL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AssetMetadataRefreshColumns = struct {
+	UserAssetID   string
+	Priority      string
+	Attempts      string
+	LastError     string
+	LastAttemptAt string
+	NextAttemptAt string
+	RequestedAt   string
+}{
+	UserAssetID:   "user_asset_id",
+	Priority:      "priority",
+	Attempts:      "attempts",
+	LastError:     "last_error",
+	LastAttemptAt: "last_attempt_at",
+	NextAttemptAt: "next_attempt_at",
+	RequestedAt:   "requested_at",
+}
+
+var AssetMetadataRefreshTableColumns = struct {
+	UserAssetID   string
+	Priority      string
+	Attempts      string
+	LastError     string
+	LastAttemptAt string
+	NextAttemptAt string
+	RequestedAt   string
+}{
+	UserAssetID:   "asset_metadata_refreshes.user_asset_id",
+	Priority:      "asset_metadata_refreshes.priority",
+	Attempts:      "asset_metadata_refreshes.attempts",
+	LastError:     "asset_metadata_refreshes.last_error",
+	LastAttemptAt: "asset_metadata_refreshes.last_attempt_at",
+	NextAttemptAt: "asset_metadata_refreshes.next_attempt_at",
+	RequestedAt:   "asset_metadata_refreshes.requested_at",
+}
+
+// Generated where
+
+var AssetMetadataRefreshWhere = struct {
+	UserAssetID   whereHelperstring
+	Priority      whereHelperint
+	Attempts      whereHelperint
+	LastError     whereHelpernull_String
+	LastAttemptAt whereHelpernull_Time
+	NextAttemptAt whereHelpertime_Time
+	RequestedAt   whereHelpertime_Time
+}{
+	UserAssetID:   whereHelperstring{field: "\"asset_metadata_refreshes\".\"user_asset_id\""},
+	Priority:      whereHelperint{field: "\"asset_metadata_refreshes\".\"priority\""},
+	Attempts:      whereHelperint{field: "\"asset_metadata_refreshes\".\"attempts\""},
+	LastError:     whereHelpernull_String{field: "\"asset_metadata_refreshes\".\"last_error\""},
+	LastAttemptAt: whereHelpernull_Time{field: "\"asset_metadata_refreshes\".\"last_attempt_at\""},
+	NextAttemptAt: whereHelpertime_Time{field: "\"asset_metadata_refreshes\".\"next_attempt_at\""},
+	RequestedAt:   whereHelpertime_Time{field: "\"asset_metadata_refreshes\".\"requested_at\""},
+}
+
+// AssetMetadataRefreshRels is where relationship names are stored.
+var AssetMetadataRefreshRels = struct {
+	UserAsset string
+}{
+	UserAsset: "UserAsset",
+}
+
+// assetMetadataRefreshR is where relationships are stored.
+type assetMetadataRefreshR struct {
+	UserAsset *UserAsset `boiler:"UserAsset" boil:"UserAsset" json:"UserAsset" toml:"UserAsset" yaml:"UserAsset"`
+}
+
+// NewStruct creates a new relationship struct
+func (*assetMetadataRefreshR) NewStruct() *assetMetadataRefreshR {
+	return &assetMetadataRefreshR{}
+}
+
+// assetMetadataRefreshL is where Load methods for each relationship are stored.
+type assetMetadataRefreshL struct{}
+
+var (
+	assetMetadataRefreshAllColumns            = []string{"user_asset_id", "priority", "attempts", "last_error", "last_attempt_at", "next_attempt_at", "requested_at"}
+	assetMetadataRefreshColumnsWithoutDefault = []string{"user_asset_id"}
+	assetMetadataRefreshColumnsWithDefault    = []string{"priority", "attempts", "last_error", "last_attempt_at", "next_attempt_at", "requested_at"}
+	assetMetadataRefreshPrimaryKeyColumns     = []string{"user_asset_id"}
+	assetMetadataRefreshGeneratedColumns      = []string{}
+)
+
+type (
+	// AssetMetadataRefreshSlice is an alias for a slice of pointers to AssetMetadataRefresh.
+	// This should almost always be used instead of []AssetMetadataRefresh.
+	AssetMetadataRefreshSlice []*AssetMetadataRefresh
+	// AssetMetadataRefreshHook is the signature for custom AssetMetadataRefresh hook methods
+	AssetMetadataRefreshHook func(boil.Executor, *AssetMetadataRefresh) error
+
+	assetMetadataRefreshQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	assetMetadataRefreshType                 = reflect.TypeOf(&AssetMetadataRefresh{})
+	assetMetadataRefreshMapping              = queries.MakeStructMapping(assetMetadataRefreshType)
+	assetMetadataRefreshPrimaryKeyMapping, _ = queries.BindMapping(assetMetadataRefreshType, assetMetadataRefreshMapping, assetMetadataRefreshPrimaryKeyColumns)
+	assetMetadataRefreshInsertCacheMut       sync.RWMutex
+	assetMetadataRefreshInsertCache          = make(map[string]insertCache)
+	assetMetadataRefreshUpdateCacheMut       sync.RWMutex
+	assetMetadataRefreshUpdateCache          = make(map[string]updateCache)
+	assetMetadataRefreshUpsertCacheMut       sync.RWMutex
+	assetMetadataRefreshUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var assetMetadataRefreshAfterSelectHooks []AssetMetadataRefreshHook
+
+var assetMetadataRefreshBeforeInsertHooks []AssetMetadataRefreshHook
+var assetMetadataRefreshAfterInsertHooks []AssetMetadataRefreshHook
+
+var assetMetadataRefreshBeforeUpdateHooks []AssetMetadataRefreshHook
+var assetMetadataRefreshAfterUpdateHooks []AssetMetadataRefreshHook
+
+var assetMetadataRefreshBeforeDeleteHooks []AssetMetadataRefreshHook
+var assetMetadataRefreshAfterDeleteHooks []AssetMetadataRefreshHook
+
+var assetMetadataRefreshBeforeUpsertHooks []AssetMetadataRefreshHook
+var assetMetadataRefreshAfterUpsertHooks []AssetMetadataRefreshHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AssetMetadataRefresh) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetMetadataRefreshAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AssetMetadataRefresh) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetMetadataRefreshBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AssetMetadataRefresh) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetMetadataRefreshAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AssetMetadataRefresh) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetMetadataRefreshBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AssetMetadataRefresh) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetMetadataRefreshAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AssetMetadataRefresh) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetMetadataRefreshBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AssetMetadataRefresh) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetMetadataRefreshAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AssetMetadataRefresh) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetMetadataRefreshBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AssetMetadataRefresh) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetMetadataRefreshAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAssetMetadataRefreshHook registers your hook function for all future operations.
+func AddAssetMetadataRefreshHook(hookPoint boil.HookPoint, assetMetadataRefreshHook AssetMetadataRefreshHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		assetMetadataRefreshAfterSelectHooks = append(assetMetadataRefreshAfterSelectHooks, assetMetadataRefreshHook)
+	case boil.BeforeInsertHook:
+		assetMetadataRefreshBeforeInsertHooks = append(assetMetadataRefreshBeforeInsertHooks, assetMetadataRefreshHook)
+	case boil.AfterInsertHook:
+		assetMetadataRefreshAfterInsertHooks = append(assetMetadataRefreshAfterInsertHooks, assetMetadataRefreshHook)
+	case boil.BeforeUpdateHook:
+		assetMetadataRefreshBeforeUpdateHooks = append(assetMetadataRefreshBeforeUpdateHooks, assetMetadataRefreshHook)
+	case boil.AfterUpdateHook:
+		assetMetadataRefreshAfterUpdateHooks = append(assetMetadataRefreshAfterUpdateHooks, assetMetadataRefreshHook)
+	case boil.BeforeDeleteHook:
+		assetMetadataRefreshBeforeDeleteHooks = append(assetMetadataRefreshBeforeDeleteHooks, assetMetadataRefreshHook)
+	case boil.AfterDeleteHook:
+		assetMetadataRefreshAfterDeleteHooks = append(assetMetadataRefreshAfterDeleteHooks, assetMetadataRefreshHook)
+	case boil.BeforeUpsertHook:
+		assetMetadataRefreshBeforeUpsertHooks = append(assetMetadataRefreshBeforeUpsertHooks, assetMetadataRefreshHook)
+	case boil.AfterUpsertHook:
+		assetMetadataRefreshAfterUpsertHooks = append(assetMetadataRefreshAfterUpsertHooks, assetMetadataRefreshHook)
+	}
+}
+
+// One returns a single assetMetadataRefresh record from the query.
+func (q assetMetadataRefreshQuery) One(exec boil.Executor) (*AssetMetadataRefresh, error) {
+	o := &AssetMetadataRefresh{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for asset_metadata_refreshes")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AssetMetadataRefresh records from the query.
+func (q assetMetadataRefreshQuery) All(exec boil.Executor) (AssetMetadataRefreshSlice, error) {
+	var o []*AssetMetadataRefresh
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to AssetMetadataRefresh slice")
+	}
+
+	if len(assetMetadataRefreshAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AssetMetadataRefresh records in the query.
+func (q assetMetadataRefreshQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count asset_metadata_refreshes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q assetMetadataRefreshQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if asset_metadata_refreshes exists")
+	}
+
+	return count > 0, nil
+}
+
+// UserAsset pointed to by the foreign key.
+func (o *AssetMetadataRefresh) UserAsset(mods ...qm.QueryMod) userAssetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserAssetID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := UserAssets(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets\"")
+
+	return query
+}
+
+// LoadUserAsset allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assetMetadataRefreshL) LoadUserAsset(e boil.Executor, singular bool, maybeAssetMetadataRefresh interface{}, mods queries.Applicator) error {
+	var slice []*AssetMetadataRefresh
+	var object *AssetMetadataRefresh
+
+	if singular {
+		object = maybeAssetMetadataRefresh.(*AssetMetadataRefresh)
+	} else {
+		slice = *maybeAssetMetadataRefresh.(*[]*AssetMetadataRefresh)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetMetadataRefreshR{}
+		}
+		args = append(args, object.UserAssetID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetMetadataRefreshR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserAssetID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserAssetID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets`),
+		qm.WhereIn(`user_assets.id in ?`, args...),
+		qmhelper.WhereIsNull(`user_assets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserAsset")
+	}
+
+	var resultSlice []*UserAsset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserAsset")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_assets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
+	}
+
+	if len(assetMetadataRefreshAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserAsset = foreign
+		if foreign.R == nil {
+			foreign.R = &userAssetR{}
+		}
+		foreign.R.AssetMetadataRefresh = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserAssetID == foreign.ID {
+				local.R.UserAsset = foreign
+				if foreign.R == nil {
+					foreign.R = &userAssetR{}
+				}
+				foreign.R.AssetMetadataRefresh = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserAsset of the assetMetadataRefresh to the related item.
+// Sets o.R.UserAsset to related.
+// Adds o to related.R.AssetMetadataRefresh.
+func (o *AssetMetadataRefresh) SetUserAsset(exec boil.Executor, insert bool, related *UserAsset) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset_metadata_refreshes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assetMetadataRefreshPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserAssetID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserAssetID = related.ID
+	if o.R == nil {
+		o.R = &assetMetadataRefreshR{
+			UserAsset: related,
+		}
+	} else {
+		o.R.UserAsset = related
+	}
+
+	if related.R == nil {
+		related.R = &userAssetR{
+			AssetMetadataRefresh: o,
+		}
+	} else {
+		related.R.AssetMetadataRefresh = o
+	}
+
+	return nil
+}
+
+// AssetMetadataRefreshes retrieves all the records using an executor.
+func AssetMetadataRefreshes(mods ...qm.QueryMod) assetMetadataRefreshQuery {
+	mods = append(mods, qm.From("\"asset_metadata_refreshes\""))
+	return assetMetadataRefreshQuery{NewQuery(mods...)}
+}
+
+// FindAssetMetadataRefresh retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAssetMetadataRefresh(exec boil.Executor, userAssetID string, selectCols ...string) (*AssetMetadataRefresh, error) {
+	assetMetadataRefreshObj := &AssetMetadataRefresh{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"asset_metadata_refreshes\" where \"user_asset_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, userAssetID)
+
+	err := q.Bind(nil, exec, assetMetadataRefreshObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from asset_metadata_refreshes")
+	}
+
+	if err = assetMetadataRefreshObj.doAfterSelectHooks(exec); err != nil {
+		return assetMetadataRefreshObj, err
+	}
+
+	return assetMetadataRefreshObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AssetMetadataRefresh) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no asset_metadata_refreshes provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(assetMetadataRefreshColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	assetMetadataRefreshInsertCacheMut.RLock()
+	cache, cached := assetMetadataRefreshInsertCache[key]
+	assetMetadataRefreshInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			assetMetadataRefreshAllColumns,
+			assetMetadataRefreshColumnsWithDefault,
+			assetMetadataRefreshColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(assetMetadataRefreshType, assetMetadataRefreshMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(assetMetadataRefreshType, assetMetadataRefreshMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"asset_metadata_refreshes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"asset_metadata_refreshes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into asset_metadata_refreshes")
+	}
+
+	if !cached {
+		assetMetadataRefreshInsertCacheMut.Lock()
+		assetMetadataRefreshInsertCache[key] = cache
+		assetMetadataRefreshInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the AssetMetadataRefresh.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AssetMetadataRefresh) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	assetMetadataRefreshUpdateCacheMut.RLock()
+	cache, cached := assetMetadataRefreshUpdateCache[key]
+	assetMetadataRefreshUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			assetMetadataRefreshAllColumns,
+			assetMetadataRefreshPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update asset_metadata_refreshes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"asset_metadata_refreshes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, assetMetadataRefreshPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(assetMetadataRefreshType, assetMetadataRefreshMapping, append(wl, assetMetadataRefreshPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update asset_metadata_refreshes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for asset_metadata_refreshes")
+	}
+
+	if !cached {
+		assetMetadataRefreshUpdateCacheMut.Lock()
+		assetMetadataRefreshUpdateCache[key] = cache
+		assetMetadataRefreshUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q assetMetadataRefreshQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for asset_metadata_refreshes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for asset_metadata_refreshes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AssetMetadataRefreshSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetMetadataRefreshPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"asset_metadata_refreshes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, assetMetadataRefreshPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in assetMetadataRefresh slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all assetMetadataRefresh")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AssetMetadataRefresh) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no asset_metadata_refreshes provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(assetMetadataRefreshColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	assetMetadataRefreshUpsertCacheMut.RLock()
+	cache, cached := assetMetadataRefreshUpsertCache[key]
+	assetMetadataRefreshUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			assetMetadataRefreshAllColumns,
+			assetMetadataRefreshColumnsWithDefault,
+			assetMetadataRefreshColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			assetMetadataRefreshAllColumns,
+			assetMetadataRefreshPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert asset_metadata_refreshes, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(assetMetadataRefreshPrimaryKeyColumns))
+			copy(conflict, assetMetadataRefreshPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"asset_metadata_refreshes\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(assetMetadataRefreshType, assetMetadataRefreshMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(assetMetadataRefreshType, assetMetadataRefreshMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert asset_metadata_refreshes")
+	}
+
+	if !cached {
+		assetMetadataRefreshUpsertCacheMut.Lock()
+		assetMetadataRefreshUpsertCache[key] = cache
+		assetMetadataRefreshUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single AssetMetadataRefresh record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AssetMetadataRefresh) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no AssetMetadataRefresh provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), assetMetadataRefreshPrimaryKeyMapping)
+	sql := "DELETE FROM \"asset_metadata_refreshes\" WHERE \"user_asset_id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from asset_metadata_refreshes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for asset_metadata_refreshes")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q assetMetadataRefreshQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no assetMetadataRefreshQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from asset_metadata_refreshes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for asset_metadata_refreshes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AssetMetadataRefreshSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(assetMetadataRefreshBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetMetadataRefreshPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"asset_metadata_refreshes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, assetMetadataRefreshPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from assetMetadataRefresh slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for asset_metadata_refreshes")
+	}
+
+	if len(assetMetadataRefreshAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AssetMetadataRefresh) Reload(exec boil.Executor) error {
+	ret, err := FindAssetMetadataRefresh(exec, o.UserAssetID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AssetMetadataRefreshSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AssetMetadataRefreshSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetMetadataRefreshPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"asset_metadata_refreshes\".* FROM \"asset_metadata_refreshes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, assetMetadataRefreshPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in AssetMetadataRefreshSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AssetMetadataRefreshExists checks if the AssetMetadataRefresh row exists.
+func AssetMetadataRefreshExists(exec boil.Executor, userAssetID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"asset_metadata_refreshes\" where \"user_asset_id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, userAssetID)
+	}
+	row := exec.QueryRow(sql, userAssetID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if asset_metadata_refreshes exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1909 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AssetRental is an object representing the database table.
+type AssetRental struct {
+	ID                string          `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserAssetID       string          `boiler:"user_asset_id" boil:"user_asset_id" json:"user_asset_id" toml:"user_asset_id" yaml:"user_asset_id"`
+	OwnerID           string          `boiler:"owner_id" boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
+	RenterUserID      null.String     `boiler:"renter_user_id" boil:"renter_user_id" json:"renter_user_id,omitempty" toml:"renter_user_id" yaml:"renter_user_id,omitempty"`
+	RenterSyndicateID null.String     `boiler:"renter_syndicate_id" boil:"renter_syndicate_id" json:"renter_syndicate_id,omitempty" toml:"renter_syndicate_id" yaml:"renter_syndicate_id,omitempty"`
+	Fee               decimal.Decimal `boiler:"fee" boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	FeeTXID           null.String     `boiler:"fee_tx_id" boil:"fee_tx_id" json:"fee_tx_id,omitempty" toml:"fee_tx_id" yaml:"fee_tx_id,omitempty"`
+	DurationHours     int             `boiler:"duration_hours" boil:"duration_hours" json:"duration_hours" toml:"duration_hours" yaml:"duration_hours"`
+	Status            string          `boiler:"status" boil:"status" json:"status" toml:"status" yaml:"status"`
+	StartsAt          null.Time       `boiler:"starts_at" boil:"starts_at" json:"starts_at,omitempty" toml:"starts_at" yaml:"starts_at,omitempty"`
+	EndsAt            null.Time       `boiler:"ends_at" boil:"ends_at" json:"ends_at,omitempty" toml:"ends_at" yaml:"ends_at,omitempty"`
+	EndedAt           null.Time       `boiler:"ended_at" boil:"ended_at" json:"ended_at,omitempty" toml:"ended_at" yaml:"ended_at,omitempty"`
+	UpdatedAt         time.Time       `boiler:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt         time.Time       `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	AcceptedByID      null.String     `boiler:"accepted_by_id" boil:"accepted_by_id" json:"accepted_by_id,omitempty" toml:"accepted_by_id" yaml:"accepted_by_id,omitempty"`
+
+	R *assetRentalR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L assetRentalL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AssetRentalColumns = struct {
+	ID                string
+	UserAssetID       string
+	OwnerID           string
+	RenterUserID      string
+	RenterSyndicateID string
+	Fee               string
+	FeeTXID           string
+	DurationHours     string
+	Status            string
+	StartsAt          string
+	EndsAt            string
+	EndedAt           string
+	UpdatedAt         string
+	CreatedAt         string
+	AcceptedByID      string
+}{
+	ID:                "id",
+	UserAssetID:       "user_asset_id",
+	OwnerID:           "owner_id",
+	RenterUserID:      "renter_user_id",
+	RenterSyndicateID: "renter_syndicate_id",
+	Fee:               "fee",
+	FeeTXID:           "fee_tx_id",
+	DurationHours:     "duration_hours",
+	Status:            "status",
+	StartsAt:          "starts_at",
+	EndsAt:            "ends_at",
+	EndedAt:           "ended_at",
+	UpdatedAt:         "updated_at",
+	CreatedAt:         "created_at",
+	AcceptedByID:      "accepted_by_id",
+}
+
+var AssetRentalTableColumns = struct {
+	ID                string
+	UserAssetID       string
+	OwnerID           string
+	RenterUserID      string
+	RenterSyndicateID string
+	Fee               string
+	FeeTXID           string
+	DurationHours     string
+	Status            string
+	StartsAt          string
+	EndsAt            string
+	EndedAt           string
+	UpdatedAt         string
+	CreatedAt         string
+	AcceptedByID      string
+}{
+	ID:                "asset_rentals.id",
+	UserAssetID:       "asset_rentals.user_asset_id",
+	OwnerID:           "asset_rentals.owner_id",
+	RenterUserID:      "asset_rentals.renter_user_id",
+	RenterSyndicateID: "asset_rentals.renter_syndicate_id",
+	Fee:               "asset_rentals.fee",
+	FeeTXID:           "asset_rentals.fee_tx_id",
+	DurationHours:     "asset_rentals.duration_hours",
+	Status:            "asset_rentals.status",
+	StartsAt:          "asset_rentals.starts_at",
+	EndsAt:            "asset_rentals.ends_at",
+	EndedAt:           "asset_rentals.ended_at",
+	UpdatedAt:         "asset_rentals.updated_at",
+	CreatedAt:         "asset_rentals.created_at",
+	AcceptedByID:      "asset_rentals.accepted_by_id",
+}
+
+// Generated where
+
+var AssetRentalWhere = struct {
+	ID                whereHelperstring
+	UserAssetID       whereHelperstring
+	OwnerID           whereHelperstring
+	RenterUserID      whereHelpernull_String
+	RenterSyndicateID whereHelpernull_String
+	Fee               whereHelperdecimal_Decimal
+	FeeTXID           whereHelpernull_String
+	DurationHours     whereHelperint
+	Status            whereHelperstring
+	StartsAt          whereHelpernull_Time
+	EndsAt            whereHelpernull_Time
+	EndedAt           whereHelpernull_Time
+	UpdatedAt         whereHelpertime_Time
+	CreatedAt         whereHelpertime_Time
+	AcceptedByID      whereHelpernull_String
+}{
+	ID:                whereHelperstring{field: "\"asset_rentals\".\"id\""},
+	UserAssetID:       whereHelperstring{field: "\"asset_rentals\".\"user_asset_id\""},
+	OwnerID:           whereHelperstring{field: "\"asset_rentals\".\"owner_id\""},
+	RenterUserID:      whereHelpernull_String{field: "\"asset_rentals\".\"renter_user_id\""},
+	RenterSyndicateID: whereHelpernull_String{field: "\"asset_rentals\".\"renter_syndicate_id\""},
+	Fee:               whereHelperdecimal_Decimal{field: "\"asset_rentals\".\"fee\""},
+	FeeTXID:           whereHelpernull_String{field: "\"asset_rentals\".\"fee_tx_id\""},
+	DurationHours:     whereHelperint{field: "\"asset_rentals\".\"duration_hours\""},
+	Status:            whereHelperstring{field: "\"asset_rentals\".\"status\""},
+	StartsAt:          whereHelpernull_Time{field: "\"asset_rentals\".\"starts_at\""},
+	EndsAt:            whereHelpernull_Time{field: "\"asset_rentals\".\"ends_at\""},
+	EndedAt:           whereHelpernull_Time{field: "\"asset_rentals\".\"ended_at\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"asset_rentals\".\"updated_at\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"asset_rentals\".\"created_at\""},
+	AcceptedByID:      whereHelpernull_String{field: "\"asset_rentals\".\"accepted_by_id\""},
+}
+
+// AssetRentalRels is where relationship names are stored.
+var AssetRentalRels = struct {
+	AcceptedBy      string
+	Owner           string
+	RenterSyndicate string
+	RenterUser      string
+	UserAsset       string
+}{
+	AcceptedBy:      "AcceptedBy",
+	Owner:           "Owner",
+	RenterSyndicate: "RenterSyndicate",
+	RenterUser:      "RenterUser",
+	UserAsset:       "UserAsset",
+}
+
+// assetRentalR is where relationships are stored.
+type assetRentalR struct {
+	AcceptedBy      *User      `boiler:"AcceptedBy" boil:"AcceptedBy" json:"AcceptedBy" toml:"AcceptedBy" yaml:"AcceptedBy"`
+	Owner           *User      `boiler:"Owner" boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
+	RenterSyndicate *Syndicate `boiler:"RenterSyndicate" boil:"RenterSyndicate" json:"RenterSyndicate" toml:"RenterSyndicate" yaml:"RenterSyndicate"`
+	RenterUser      *User      `boiler:"RenterUser" boil:"RenterUser" json:"RenterUser" toml:"RenterUser" yaml:"RenterUser"`
+	UserAsset       *UserAsset `boiler:"UserAsset" boil:"UserAsset" json:"UserAsset" toml:"UserAsset" yaml:"UserAsset"`
+}
+
+// NewStruct creates a new relationship struct
+func (*assetRentalR) NewStruct() *assetRentalR {
+	return &assetRentalR{}
+}
+
+// assetRentalL is where Load methods for each relationship are stored.
+type assetRentalL struct{}
+
+var (
+	assetRentalAllColumns            = []string{"id", "user_asset_id", "owner_id", "renter_user_id", "renter_syndicate_id", "fee", "fee_tx_id", "duration_hours", "status", "starts_at", "ends_at", "ended_at", "updated_at", "created_at", "accepted_by_id"}
+	assetRentalColumnsWithoutDefault = []string{"user_asset_id", "owner_id", "duration_hours"}
+	assetRentalColumnsWithDefault    = []string{"id", "renter_user_id", "renter_syndicate_id", "fee", "fee_tx_id", "status", "starts_at", "ends_at", "ended_at", "updated_at", "created_at", "accepted_by_id"}
+	assetRentalPrimaryKeyColumns     = []string{"id"}
+	assetRentalGeneratedColumns      = []string{}
+)
+
+type (
+	// AssetRentalSlice is an alias for a slice of pointers to AssetRental.
+	// This should almost always be used instead of []AssetRental.
+	AssetRentalSlice []*AssetRental
+	// AssetRentalHook is the signature for custom AssetRental hook methods
+	AssetRentalHook func(boil.Executor, *AssetRental) error
+
+	assetRentalQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	assetRentalType                 = reflect.TypeOf(&AssetRental{})
+	assetRentalMapping              = queries.MakeStructMapping(assetRentalType)
+	assetRentalPrimaryKeyMapping, _ = queries.BindMapping(assetRentalType, assetRentalMapping, assetRentalPrimaryKeyColumns)
+	assetRentalInsertCacheMut       sync.RWMutex
+	assetRentalInsertCache          = make(map[string]insertCache)
+	assetRentalUpdateCacheMut       sync.RWMutex
+	assetRentalUpdateCache          = make(map[string]updateCache)
+	assetRentalUpsertCacheMut       sync.RWMutex
+	assetRentalUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var assetRentalAfterSelectHooks []AssetRentalHook
+
+var assetRentalBeforeInsertHooks []AssetRentalHook
+var assetRentalAfterInsertHooks []AssetRentalHook
+
+var assetRentalBeforeUpdateHooks []AssetRentalHook
+var assetRentalAfterUpdateHooks []AssetRentalHook
+
+var assetRentalBeforeDeleteHooks []AssetRentalHook
+var assetRentalAfterDeleteHooks []AssetRentalHook
+
+var assetRentalBeforeUpsertHooks []AssetRentalHook
+var assetRentalAfterUpsertHooks []AssetRentalHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AssetRental) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetRentalAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AssetRental) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetRentalBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AssetRental) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetRentalAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AssetRental) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetRentalBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AssetRental) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetRentalAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AssetRental) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetRentalBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AssetRental) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetRentalAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AssetRental) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetRentalBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AssetRental) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetRentalAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAssetRentalHook registers your hook function for all future operations.
+func AddAssetRentalHook(hookPoint boil.HookPoint, assetRentalHook AssetRentalHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		assetRentalAfterSelectHooks = append(assetRentalAfterSelectHooks, assetRentalHook)
+	case boil.BeforeInsertHook:
+		assetRentalBeforeInsertHooks = append(assetRentalBeforeInsertHooks, assetRentalHook)
+	case boil.AfterInsertHook:
+		assetRentalAfterInsertHooks = append(assetRentalAfterInsertHooks, assetRentalHook)
+	case boil.BeforeUpdateHook:
+		assetRentalBeforeUpdateHooks = append(assetRentalBeforeUpdateHooks, assetRentalHook)
+	case boil.AfterUpdateHook:
+		assetRentalAfterUpdateHooks = append(assetRentalAfterUpdateHooks, assetRentalHook)
+	case boil.BeforeDeleteHook:
+		assetRentalBeforeDeleteHooks = append(assetRentalBeforeDeleteHooks, assetRentalHook)
+	case boil.AfterDeleteHook:
+		assetRentalAfterDeleteHooks = append(assetRentalAfterDeleteHooks, assetRentalHook)
+	case boil.BeforeUpsertHook:
+		assetRentalBeforeUpsertHooks = append(assetRentalBeforeUpsertHooks, assetRentalHook)
+	case boil.AfterUpsertHook:
+		assetRentalAfterUpsertHooks = append(assetRentalAfterUpsertHooks, assetRentalHook)
+	}
+}
+
+// One returns a single assetRental record from the query.
+func (q assetRentalQuery) One(exec boil.Executor) (*AssetRental, error) {
+	o := &AssetRental{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for asset_rentals")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AssetRental records from the query.
+func (q assetRentalQuery) All(exec boil.Executor) (AssetRentalSlice, error) {
+	var o []*AssetRental
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to AssetRental slice")
+	}
+
+	if len(assetRentalAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AssetRental records in the query.
+func (q assetRentalQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count asset_rentals rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q assetRentalQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if asset_rentals exists")
+	}
+
+	return count > 0, nil
+}
+
+// AcceptedBy pointed to by the foreign key.
+func (o *AssetRental) AcceptedBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AcceptedByID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// Owner pointed to by the foreign key.
+func (o *AssetRental) Owner(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OwnerID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// RenterSyndicate pointed to by the foreign key.
+func (o *AssetRental) RenterSyndicate(mods ...qm.QueryMod) syndicateQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RenterSyndicateID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Syndicates(queryMods...)
+	queries.SetFrom(query.Query, "\"syndicates\"")
+
+	return query
+}
+
+// RenterUser pointed to by the foreign key.
+func (o *AssetRental) RenterUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RenterUserID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// UserAsset pointed to by the foreign key.
+func (o *AssetRental) UserAsset(mods ...qm.QueryMod) userAssetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserAssetID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := UserAssets(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets\"")
+
+	return query
+}
+
+// LoadAcceptedBy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assetRentalL) LoadAcceptedBy(e boil.Executor, singular bool, maybeAssetRental interface{}, mods queries.Applicator) error {
+	var slice []*AssetRental
+	var object *AssetRental
+
+	if singular {
+		object = maybeAssetRental.(*AssetRental)
+	} else {
+		slice = *maybeAssetRental.(*[]*AssetRental)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetRentalR{}
+		}
+		if !queries.IsNil(object.AcceptedByID) {
+			args = append(args, object.AcceptedByID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetRentalR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.AcceptedByID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.AcceptedByID) {
+				args = append(args, obj.AcceptedByID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(assetRentalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.AcceptedBy = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AcceptedByAssetRentals = append(foreign.R.AcceptedByAssetRentals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AcceptedByID, foreign.ID) {
+				local.R.AcceptedBy = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AcceptedByAssetRentals = append(foreign.R.AcceptedByAssetRentals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwner allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assetRentalL) LoadOwner(e boil.Executor, singular bool, maybeAssetRental interface{}, mods queries.Applicator) error {
+	var slice []*AssetRental
+	var object *AssetRental
+
+	if singular {
+		object = maybeAssetRental.(*AssetRental)
+	} else {
+		slice = *maybeAssetRental.(*[]*AssetRental)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetRentalR{}
+		}
+		args = append(args, object.OwnerID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetRentalR{}
+			}
+
+			for _, a := range args {
+				if a == obj.OwnerID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.OwnerID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(assetRentalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Owner = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.OwnerAssetRentals = append(foreign.R.OwnerAssetRentals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OwnerID == foreign.ID {
+				local.R.Owner = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.OwnerAssetRentals = append(foreign.R.OwnerAssetRentals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRenterSyndicate allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assetRentalL) LoadRenterSyndicate(e boil.Executor, singular bool, maybeAssetRental interface{}, mods queries.Applicator) error {
+	var slice []*AssetRental
+	var object *AssetRental
+
+	if singular {
+		object = maybeAssetRental.(*AssetRental)
+	} else {
+		slice = *maybeAssetRental.(*[]*AssetRental)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetRentalR{}
+		}
+		if !queries.IsNil(object.RenterSyndicateID) {
+			args = append(args, object.RenterSyndicateID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetRentalR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.RenterSyndicateID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.RenterSyndicateID) {
+				args = append(args, obj.RenterSyndicateID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`syndicates`),
+		qm.WhereIn(`syndicates.id in ?`, args...),
+		qmhelper.WhereIsNull(`syndicates.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Syndicate")
+	}
+
+	var resultSlice []*Syndicate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Syndicate")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for syndicates")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for syndicates")
+	}
+
+	if len(assetRentalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.RenterSyndicate = foreign
+		if foreign.R == nil {
+			foreign.R = &syndicateR{}
+		}
+		foreign.R.RenterSyndicateAssetRentals = append(foreign.R.RenterSyndicateAssetRentals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.RenterSyndicateID, foreign.ID) {
+				local.R.RenterSyndicate = foreign
+				if foreign.R == nil {
+					foreign.R = &syndicateR{}
+				}
+				foreign.R.RenterSyndicateAssetRentals = append(foreign.R.RenterSyndicateAssetRentals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRenterUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assetRentalL) LoadRenterUser(e boil.Executor, singular bool, maybeAssetRental interface{}, mods queries.Applicator) error {
+	var slice []*AssetRental
+	var object *AssetRental
+
+	if singular {
+		object = maybeAssetRental.(*AssetRental)
+	} else {
+		slice = *maybeAssetRental.(*[]*AssetRental)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetRentalR{}
+		}
+		if !queries.IsNil(object.RenterUserID) {
+			args = append(args, object.RenterUserID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetRentalR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.RenterUserID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.RenterUserID) {
+				args = append(args, obj.RenterUserID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(assetRentalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.RenterUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.RenterUserAssetRentals = append(foreign.R.RenterUserAssetRentals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.RenterUserID, foreign.ID) {
+				local.R.RenterUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.RenterUserAssetRentals = append(foreign.R.RenterUserAssetRentals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAsset allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assetRentalL) LoadUserAsset(e boil.Executor, singular bool, maybeAssetRental interface{}, mods queries.Applicator) error {
+	var slice []*AssetRental
+	var object *AssetRental
+
+	if singular {
+		object = maybeAssetRental.(*AssetRental)
+	} else {
+		slice = *maybeAssetRental.(*[]*AssetRental)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetRentalR{}
+		}
+		args = append(args, object.UserAssetID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetRentalR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserAssetID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserAssetID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets`),
+		qm.WhereIn(`user_assets.id in ?`, args...),
+		qmhelper.WhereIsNull(`user_assets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserAsset")
+	}
+
+	var resultSlice []*UserAsset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserAsset")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_assets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
+	}
+
+	if len(assetRentalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserAsset = foreign
+		if foreign.R == nil {
+			foreign.R = &userAssetR{}
+		}
+		foreign.R.AssetRentals = append(foreign.R.AssetRentals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserAssetID == foreign.ID {
+				local.R.UserAsset = foreign
+				if foreign.R == nil {
+					foreign.R = &userAssetR{}
+				}
+				foreign.R.AssetRentals = append(foreign.R.AssetRentals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAcceptedBy of the assetRental to the related item.
+// Sets o.R.AcceptedBy to related.
+// Adds o to related.R.AcceptedByAssetRentals.
+func (o *AssetRental) SetAcceptedBy(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset_rentals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"accepted_by_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assetRentalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AcceptedByID, related.ID)
+	if o.R == nil {
+		o.R = &assetRentalR{
+			AcceptedBy: related,
+		}
+	} else {
+		o.R.AcceptedBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AcceptedByAssetRentals: AssetRentalSlice{o},
+		}
+	} else {
+		related.R.AcceptedByAssetRentals = append(related.R.AcceptedByAssetRentals, o)
+	}
+
+	return nil
+}
+
+// RemoveAcceptedBy relationship.
+// Sets o.R.AcceptedBy to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *AssetRental) RemoveAcceptedBy(exec boil.Executor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.AcceptedByID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("accepted_by_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.AcceptedBy = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AcceptedByAssetRentals {
+		if queries.Equal(o.AcceptedByID, ri.AcceptedByID) {
+			continue
+		}
+
+		ln := len(related.R.AcceptedByAssetRentals)
+		if ln > 1 && i < ln-1 {
+			related.R.AcceptedByAssetRentals[i] = related.R.AcceptedByAssetRentals[ln-1]
+		}
+		related.R.AcceptedByAssetRentals = related.R.AcceptedByAssetRentals[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetOwner of the assetRental to the related item.
+// Sets o.R.Owner to related.
+// Adds o to related.R.OwnerAssetRentals.
+func (o *AssetRental) SetOwner(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset_rentals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assetRentalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OwnerID = related.ID
+	if o.R == nil {
+		o.R = &assetRentalR{
+			Owner: related,
+		}
+	} else {
+		o.R.Owner = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OwnerAssetRentals: AssetRentalSlice{o},
+		}
+	} else {
+		related.R.OwnerAssetRentals = append(related.R.OwnerAssetRentals, o)
+	}
+
+	return nil
+}
+
+// SetRenterSyndicate of the assetRental to the related item.
+// Sets o.R.RenterSyndicate to related.
+// Adds o to related.R.RenterSyndicateAssetRentals.
+func (o *AssetRental) SetRenterSyndicate(exec boil.Executor, insert bool, related *Syndicate) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset_rentals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"renter_syndicate_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assetRentalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.RenterSyndicateID, related.ID)
+	if o.R == nil {
+		o.R = &assetRentalR{
+			RenterSyndicate: related,
+		}
+	} else {
+		o.R.RenterSyndicate = related
+	}
+
+	if related.R == nil {
+		related.R = &syndicateR{
+			RenterSyndicateAssetRentals: AssetRentalSlice{o},
+		}
+	} else {
+		related.R.RenterSyndicateAssetRentals = append(related.R.RenterSyndicateAssetRentals, o)
+	}
+
+	return nil
+}
+
+// RemoveRenterSyndicate relationship.
+// Sets o.R.RenterSyndicate to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *AssetRental) RemoveRenterSyndicate(exec boil.Executor, related *Syndicate) error {
+	var err error
+
+	queries.SetScanner(&o.RenterSyndicateID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("renter_syndicate_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.RenterSyndicate = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.RenterSyndicateAssetRentals {
+		if queries.Equal(o.RenterSyndicateID, ri.RenterSyndicateID) {
+			continue
+		}
+
+		ln := len(related.R.RenterSyndicateAssetRentals)
+		if ln > 1 && i < ln-1 {
+			related.R.RenterSyndicateAssetRentals[i] = related.R.RenterSyndicateAssetRentals[ln-1]
+		}
+		related.R.RenterSyndicateAssetRentals = related.R.RenterSyndicateAssetRentals[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetRenterUser of the assetRental to the related item.
+// Sets o.R.RenterUser to related.
+// Adds o to related.R.RenterUserAssetRentals.
+func (o *AssetRental) SetRenterUser(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset_rentals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"renter_user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assetRentalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.RenterUserID, related.ID)
+	if o.R == nil {
+		o.R = &assetRentalR{
+			RenterUser: related,
+		}
+	} else {
+		o.R.RenterUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			RenterUserAssetRentals: AssetRentalSlice{o},
+		}
+	} else {
+		related.R.RenterUserAssetRentals = append(related.R.RenterUserAssetRentals, o)
+	}
+
+	return nil
+}
+
+// RemoveRenterUser relationship.
+// Sets o.R.RenterUser to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *AssetRental) RemoveRenterUser(exec boil.Executor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.RenterUserID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("renter_user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.RenterUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.RenterUserAssetRentals {
+		if queries.Equal(o.RenterUserID, ri.RenterUserID) {
+			continue
+		}
+
+		ln := len(related.R.RenterUserAssetRentals)
+		if ln > 1 && i < ln-1 {
+			related.R.RenterUserAssetRentals[i] = related.R.RenterUserAssetRentals[ln-1]
+		}
+		related.R.RenterUserAssetRentals = related.R.RenterUserAssetRentals[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetUserAsset of the assetRental to the related item.
+// Sets o.R.UserAsset to related.
+// Adds o to related.R.AssetRentals.
+func (o *AssetRental) SetUserAsset(exec boil.Executor, insert bool, related *UserAsset) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset_rentals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assetRentalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserAssetID = related.ID
+	if o.R == nil {
+		o.R = &assetRentalR{
+			UserAsset: related,
+		}
+	} else {
+		o.R.UserAsset = related
+	}
+
+	if related.R == nil {
+		related.R = &userAssetR{
+			AssetRentals: AssetRentalSlice{o},
+		}
+	} else {
+		related.R.AssetRentals = append(related.R.AssetRentals, o)
+	}
+
+	return nil
+}
+
+// AssetRentals retrieves all the records using an executor.
+func AssetRentals(mods ...qm.QueryMod) assetRentalQuery {
+	mods = append(mods, qm.From("\"asset_rentals\""))
+	return assetRentalQuery{NewQuery(mods...)}
+}
+
+// FindAssetRental retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAssetRental(exec boil.Executor, iD string, selectCols ...string) (*AssetRental, error) {
+	assetRentalObj := &AssetRental{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"asset_rentals\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, assetRentalObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from asset_rentals")
+	}
+
+	if err = assetRentalObj.doAfterSelectHooks(exec); err != nil {
+		return assetRentalObj, err
+	}
+
+	return assetRentalObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AssetRental) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no asset_rentals provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(assetRentalColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	assetRentalInsertCacheMut.RLock()
+	cache, cached := assetRentalInsertCache[key]
+	assetRentalInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			assetRentalAllColumns,
+			assetRentalColumnsWithDefault,
+			assetRentalColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(assetRentalType, assetRentalMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(assetRentalType, assetRentalMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"asset_rentals\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"asset_rentals\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into asset_rentals")
+	}
+
+	if !cached {
+		assetRentalInsertCacheMut.Lock()
+		assetRentalInsertCache[key] = cache
+		assetRentalInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the AssetRental.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AssetRental) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	assetRentalUpdateCacheMut.RLock()
+	cache, cached := assetRentalUpdateCache[key]
+	assetRentalUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			assetRentalAllColumns,
+			assetRentalPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update asset_rentals, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"asset_rentals\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, assetRentalPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(assetRentalType, assetRentalMapping, append(wl, assetRentalPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update asset_rentals row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for asset_rentals")
+	}
+
+	if !cached {
+		assetRentalUpdateCacheMut.Lock()
+		assetRentalUpdateCache[key] = cache
+		assetRentalUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q assetRentalQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for asset_rentals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for asset_rentals")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AssetRentalSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetRentalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"asset_rentals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, assetRentalPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in assetRental slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all assetRental")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AssetRental) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no asset_rentals provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(assetRentalColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	assetRentalUpsertCacheMut.RLock()
+	cache, cached := assetRentalUpsertCache[key]
+	assetRentalUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			assetRentalAllColumns,
+			assetRentalColumnsWithDefault,
+			assetRentalColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			assetRentalAllColumns,
+			assetRentalPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert asset_rentals, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(assetRentalPrimaryKeyColumns))
+			copy(conflict, assetRentalPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"asset_rentals\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(assetRentalType, assetRentalMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(assetRentalType, assetRentalMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert asset_rentals")
+	}
+
+	if !cached {
+		assetRentalUpsertCacheMut.Lock()
+		assetRentalUpsertCache[key] = cache
+		assetRentalUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single AssetRental record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AssetRental) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no AssetRental provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), assetRentalPrimaryKeyMapping)
+	sql := "DELETE FROM \"asset_rentals\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from asset_rentals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for asset_rentals")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q assetRentalQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no assetRentalQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from asset_rentals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for asset_rentals")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AssetRentalSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(assetRentalBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetRentalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"asset_rentals\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, assetRentalPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from assetRental slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for asset_rentals")
+	}
+
+	if len(assetRentalAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AssetRental) Reload(exec boil.Executor) error {
+	ret, err := FindAssetRental(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AssetRentalSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AssetRentalSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetRentalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"asset_rentals\".* FROM \"asset_rentals\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, assetRentalPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in AssetRentalSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AssetRentalExists checks if the AssetRental row exists.
+func AssetRentalExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"asset_rentals\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if asset_rentals exists")
+	}
+
+	return exists, nil
+}
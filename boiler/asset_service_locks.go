@@ -0,0 +1,1453 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AssetServiceLock is an object representing the database table.
+type AssetServiceLock struct {
+	ID            string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserAssetID   string      `boiler:"user_asset_id" boil:"user_asset_id" json:"user_asset_id" toml:"user_asset_id" yaml:"user_asset_id"`
+	ServiceID     string      `boiler:"service_id" boil:"service_id" json:"service_id" toml:"service_id" yaml:"service_id"`
+	Reason        string      `boiler:"reason" boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	ExpiresAt     time.Time   `boiler:"expires_at" boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	RenewedAt     null.Time   `boiler:"renewed_at" boil:"renewed_at" json:"renewed_at,omitempty" toml:"renewed_at" yaml:"renewed_at,omitempty"`
+	ReleasedAt    null.Time   `boiler:"released_at" boil:"released_at" json:"released_at,omitempty" toml:"released_at" yaml:"released_at,omitempty"`
+	ReleaseReason null.String `boiler:"release_reason" boil:"release_reason" json:"release_reason,omitempty" toml:"release_reason" yaml:"release_reason,omitempty"`
+	ReleasedByID  null.String `boiler:"released_by_id" boil:"released_by_id" json:"released_by_id,omitempty" toml:"released_by_id" yaml:"released_by_id,omitempty"`
+	CreatedAt     time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *assetServiceLockR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L assetServiceLockL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AssetServiceLockColumns = struct {
+	ID            string
+	UserAssetID   string
+	ServiceID     string
+	Reason        string
+	ExpiresAt     string
+	RenewedAt     string
+	ReleasedAt    string
+	ReleaseReason string
+	ReleasedByID  string
+	CreatedAt     string
+}{
+	ID:            "id",
+	UserAssetID:   "user_asset_id",
+	ServiceID:     "service_id",
+	Reason:        "reason",
+	ExpiresAt:     "expires_at",
+	RenewedAt:     "renewed_at",
+	ReleasedAt:    "released_at",
+	ReleaseReason: "release_reason",
+	ReleasedByID:  "released_by_id",
+	CreatedAt:     "created_at",
+}
+
+var AssetServiceLockTableColumns = struct {
+	ID            string
+	UserAssetID   string
+	ServiceID     string
+	Reason        string
+	ExpiresAt     string
+	RenewedAt     string
+	ReleasedAt    string
+	ReleaseReason string
+	ReleasedByID  string
+	CreatedAt     string
+}{
+	ID:            "asset_service_locks.id",
+	UserAssetID:   "asset_service_locks.user_asset_id",
+	ServiceID:     "asset_service_locks.service_id",
+	Reason:        "asset_service_locks.reason",
+	ExpiresAt:     "asset_service_locks.expires_at",
+	RenewedAt:     "asset_service_locks.renewed_at",
+	ReleasedAt:    "asset_service_locks.released_at",
+	ReleaseReason: "asset_service_locks.release_reason",
+	ReleasedByID:  "asset_service_locks.released_by_id",
+	CreatedAt:     "asset_service_locks.created_at",
+}
+
+// Generated where
+
+var AssetServiceLockWhere = struct {
+	ID            whereHelperstring
+	UserAssetID   whereHelperstring
+	ServiceID     whereHelperstring
+	Reason        whereHelperstring
+	ExpiresAt     whereHelpertime_Time
+	RenewedAt     whereHelpernull_Time
+	ReleasedAt    whereHelpernull_Time
+	ReleaseReason whereHelpernull_String
+	ReleasedByID  whereHelpernull_String
+	CreatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperstring{field: "\"asset_service_locks\".\"id\""},
+	UserAssetID:   whereHelperstring{field: "\"asset_service_locks\".\"user_asset_id\""},
+	ServiceID:     whereHelperstring{field: "\"asset_service_locks\".\"service_id\""},
+	Reason:        whereHelperstring{field: "\"asset_service_locks\".\"reason\""},
+	ExpiresAt:     whereHelpertime_Time{field: "\"asset_service_locks\".\"expires_at\""},
+	RenewedAt:     whereHelpernull_Time{field: "\"asset_service_locks\".\"renewed_at\""},
+	ReleasedAt:    whereHelpernull_Time{field: "\"asset_service_locks\".\"released_at\""},
+	ReleaseReason: whereHelpernull_String{field: "\"asset_service_locks\".\"release_reason\""},
+	ReleasedByID:  whereHelpernull_String{field: "\"asset_service_locks\".\"released_by_id\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"asset_service_locks\".\"created_at\""},
+}
+
+// AssetServiceLockRels is where relationship names are stored.
+var AssetServiceLockRels = struct {
+	ReleasedBy string
+	Service    string
+	UserAsset  string
+}{
+	ReleasedBy: "ReleasedBy",
+	Service:    "Service",
+	UserAsset:  "UserAsset",
+}
+
+// assetServiceLockR is where relationships are stored.
+type assetServiceLockR struct {
+	ReleasedBy *User      `boiler:"ReleasedBy" boil:"ReleasedBy" json:"ReleasedBy" toml:"ReleasedBy" yaml:"ReleasedBy"`
+	Service    *User      `boiler:"Service" boil:"Service" json:"Service" toml:"Service" yaml:"Service"`
+	UserAsset  *UserAsset `boiler:"UserAsset" boil:"UserAsset" json:"UserAsset" toml:"UserAsset" yaml:"UserAsset"`
+}
+
+// NewStruct creates a new relationship struct
+func (*assetServiceLockR) NewStruct() *assetServiceLockR {
+	return &assetServiceLockR{}
+}
+
+// assetServiceLockL is where Load methods for each relationship are stored.
+type assetServiceLockL struct{}
+
+var (
+	assetServiceLockAllColumns            = []string{"id", "user_asset_id", "service_id", "reason", "expires_at", "renewed_at", "released_at", "release_reason", "released_by_id", "created_at"}
+	assetServiceLockColumnsWithoutDefault = []string{"user_asset_id", "service_id", "expires_at"}
+	assetServiceLockColumnsWithDefault    = []string{"id", "reason", "renewed_at", "released_at", "release_reason", "released_by_id", "created_at"}
+	assetServiceLockPrimaryKeyColumns     = []string{"id"}
+	assetServiceLockGeneratedColumns      = []string{}
+)
+
+type (
+	// AssetServiceLockSlice is an alias for a slice of pointers to AssetServiceLock.
+	// This should almost always be used instead of []AssetServiceLock.
+	AssetServiceLockSlice []*AssetServiceLock
+	// AssetServiceLockHook is the signature for custom AssetServiceLock hook methods
+	AssetServiceLockHook func(boil.Executor, *AssetServiceLock) error
+
+	assetServiceLockQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	assetServiceLockType                 = reflect.TypeOf(&AssetServiceLock{})
+	assetServiceLockMapping              = queries.MakeStructMapping(assetServiceLockType)
+	assetServiceLockPrimaryKeyMapping, _ = queries.BindMapping(assetServiceLockType, assetServiceLockMapping, assetServiceLockPrimaryKeyColumns)
+	assetServiceLockInsertCacheMut       sync.RWMutex
+	assetServiceLockInsertCache          = make(map[string]insertCache)
+	assetServiceLockUpdateCacheMut       sync.RWMutex
+	assetServiceLockUpdateCache          = make(map[string]updateCache)
+	assetServiceLockUpsertCacheMut       sync.RWMutex
+	assetServiceLockUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var assetServiceLockAfterSelectHooks []AssetServiceLockHook
+
+var assetServiceLockBeforeInsertHooks []AssetServiceLockHook
+var assetServiceLockAfterInsertHooks []AssetServiceLockHook
+
+var assetServiceLockBeforeUpdateHooks []AssetServiceLockHook
+var assetServiceLockAfterUpdateHooks []AssetServiceLockHook
+
+var assetServiceLockBeforeDeleteHooks []AssetServiceLockHook
+var assetServiceLockAfterDeleteHooks []AssetServiceLockHook
+
+var assetServiceLockBeforeUpsertHooks []AssetServiceLockHook
+var assetServiceLockAfterUpsertHooks []AssetServiceLockHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AssetServiceLock) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetServiceLockAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AssetServiceLock) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetServiceLockBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AssetServiceLock) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetServiceLockAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AssetServiceLock) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetServiceLockBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AssetServiceLock) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetServiceLockAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AssetServiceLock) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetServiceLockBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AssetServiceLock) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetServiceLockAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AssetServiceLock) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetServiceLockBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AssetServiceLock) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetServiceLockAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAssetServiceLockHook registers your hook function for all future operations.
+func AddAssetServiceLockHook(hookPoint boil.HookPoint, assetServiceLockHook AssetServiceLockHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		assetServiceLockAfterSelectHooks = append(assetServiceLockAfterSelectHooks, assetServiceLockHook)
+	case boil.BeforeInsertHook:
+		assetServiceLockBeforeInsertHooks = append(assetServiceLockBeforeInsertHooks, assetServiceLockHook)
+	case boil.AfterInsertHook:
+		assetServiceLockAfterInsertHooks = append(assetServiceLockAfterInsertHooks, assetServiceLockHook)
+	case boil.BeforeUpdateHook:
+		assetServiceLockBeforeUpdateHooks = append(assetServiceLockBeforeUpdateHooks, assetServiceLockHook)
+	case boil.AfterUpdateHook:
+		assetServiceLockAfterUpdateHooks = append(assetServiceLockAfterUpdateHooks, assetServiceLockHook)
+	case boil.BeforeDeleteHook:
+		assetServiceLockBeforeDeleteHooks = append(assetServiceLockBeforeDeleteHooks, assetServiceLockHook)
+	case boil.AfterDeleteHook:
+		assetServiceLockAfterDeleteHooks = append(assetServiceLockAfterDeleteHooks, assetServiceLockHook)
+	case boil.BeforeUpsertHook:
+		assetServiceLockBeforeUpsertHooks = append(assetServiceLockBeforeUpsertHooks, assetServiceLockHook)
+	case boil.AfterUpsertHook:
+		assetServiceLockAfterUpsertHooks = append(assetServiceLockAfterUpsertHooks, assetServiceLockHook)
+	}
+}
+
+// One returns a single assetServiceLock record from the query.
+func (q assetServiceLockQuery) One(exec boil.Executor) (*AssetServiceLock, error) {
+	o := &AssetServiceLock{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for asset_service_locks")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AssetServiceLock records from the query.
+func (q assetServiceLockQuery) All(exec boil.Executor) (AssetServiceLockSlice, error) {
+	var o []*AssetServiceLock
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to AssetServiceLock slice")
+	}
+
+	if len(assetServiceLockAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AssetServiceLock records in the query.
+func (q assetServiceLockQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count asset_service_locks rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q assetServiceLockQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if asset_service_locks exists")
+	}
+
+	return count > 0, nil
+}
+
+// ReleasedBy pointed to by the foreign key.
+func (o *AssetServiceLock) ReleasedBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ReleasedByID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// Service pointed to by the foreign key.
+func (o *AssetServiceLock) Service(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ServiceID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// UserAsset pointed to by the foreign key.
+func (o *AssetServiceLock) UserAsset(mods ...qm.QueryMod) userAssetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserAssetID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := UserAssets(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets\"")
+
+	return query
+}
+
+// LoadReleasedBy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assetServiceLockL) LoadReleasedBy(e boil.Executor, singular bool, maybeAssetServiceLock interface{}, mods queries.Applicator) error {
+	var slice []*AssetServiceLock
+	var object *AssetServiceLock
+
+	if singular {
+		object = maybeAssetServiceLock.(*AssetServiceLock)
+	} else {
+		slice = *maybeAssetServiceLock.(*[]*AssetServiceLock)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetServiceLockR{}
+		}
+		if !queries.IsNil(object.ReleasedByID) {
+			args = append(args, object.ReleasedByID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetServiceLockR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ReleasedByID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ReleasedByID) {
+				args = append(args, obj.ReleasedByID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(assetServiceLockAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ReleasedBy = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ReleasedByAssetServiceLocks = append(foreign.R.ReleasedByAssetServiceLocks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ReleasedByID, foreign.ID) {
+				local.R.ReleasedBy = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ReleasedByAssetServiceLocks = append(foreign.R.ReleasedByAssetServiceLocks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadService allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assetServiceLockL) LoadService(e boil.Executor, singular bool, maybeAssetServiceLock interface{}, mods queries.Applicator) error {
+	var slice []*AssetServiceLock
+	var object *AssetServiceLock
+
+	if singular {
+		object = maybeAssetServiceLock.(*AssetServiceLock)
+	} else {
+		slice = *maybeAssetServiceLock.(*[]*AssetServiceLock)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetServiceLockR{}
+		}
+		args = append(args, object.ServiceID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetServiceLockR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ServiceID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ServiceID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(assetServiceLockAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Service = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ServiceAssetServiceLocks = append(foreign.R.ServiceAssetServiceLocks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ServiceID == foreign.ID {
+				local.R.Service = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ServiceAssetServiceLocks = append(foreign.R.ServiceAssetServiceLocks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAsset allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assetServiceLockL) LoadUserAsset(e boil.Executor, singular bool, maybeAssetServiceLock interface{}, mods queries.Applicator) error {
+	var slice []*AssetServiceLock
+	var object *AssetServiceLock
+
+	if singular {
+		object = maybeAssetServiceLock.(*AssetServiceLock)
+	} else {
+		slice = *maybeAssetServiceLock.(*[]*AssetServiceLock)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetServiceLockR{}
+		}
+		args = append(args, object.UserAssetID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetServiceLockR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserAssetID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserAssetID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets`),
+		qm.WhereIn(`user_assets.id in ?`, args...),
+		qmhelper.WhereIsNull(`user_assets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserAsset")
+	}
+
+	var resultSlice []*UserAsset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserAsset")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_assets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
+	}
+
+	if len(assetServiceLockAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserAsset = foreign
+		if foreign.R == nil {
+			foreign.R = &userAssetR{}
+		}
+		foreign.R.AssetServiceLocks = append(foreign.R.AssetServiceLocks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserAssetID == foreign.ID {
+				local.R.UserAsset = foreign
+				if foreign.R == nil {
+					foreign.R = &userAssetR{}
+				}
+				foreign.R.AssetServiceLocks = append(foreign.R.AssetServiceLocks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetReleasedBy of the assetServiceLock to the related item.
+// Sets o.R.ReleasedBy to related.
+// Adds o to related.R.ReleasedByAssetServiceLocks.
+func (o *AssetServiceLock) SetReleasedBy(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset_service_locks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"released_by_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assetServiceLockPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ReleasedByID, related.ID)
+	if o.R == nil {
+		o.R = &assetServiceLockR{
+			ReleasedBy: related,
+		}
+	} else {
+		o.R.ReleasedBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ReleasedByAssetServiceLocks: AssetServiceLockSlice{o},
+		}
+	} else {
+		related.R.ReleasedByAssetServiceLocks = append(related.R.ReleasedByAssetServiceLocks, o)
+	}
+
+	return nil
+}
+
+// RemoveReleasedBy relationship.
+// Sets o.R.ReleasedBy to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *AssetServiceLock) RemoveReleasedBy(exec boil.Executor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ReleasedByID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("released_by_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ReleasedBy = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ReleasedByAssetServiceLocks {
+		if queries.Equal(o.ReleasedByID, ri.ReleasedByID) {
+			continue
+		}
+
+		ln := len(related.R.ReleasedByAssetServiceLocks)
+		if ln > 1 && i < ln-1 {
+			related.R.ReleasedByAssetServiceLocks[i] = related.R.ReleasedByAssetServiceLocks[ln-1]
+		}
+		related.R.ReleasedByAssetServiceLocks = related.R.ReleasedByAssetServiceLocks[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetService of the assetServiceLock to the related item.
+// Sets o.R.Service to related.
+// Adds o to related.R.ServiceAssetServiceLocks.
+func (o *AssetServiceLock) SetService(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset_service_locks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"service_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assetServiceLockPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ServiceID = related.ID
+	if o.R == nil {
+		o.R = &assetServiceLockR{
+			Service: related,
+		}
+	} else {
+		o.R.Service = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ServiceAssetServiceLocks: AssetServiceLockSlice{o},
+		}
+	} else {
+		related.R.ServiceAssetServiceLocks = append(related.R.ServiceAssetServiceLocks, o)
+	}
+
+	return nil
+}
+
+// SetUserAsset of the assetServiceLock to the related item.
+// Sets o.R.UserAsset to related.
+// Adds o to related.R.AssetServiceLocks.
+func (o *AssetServiceLock) SetUserAsset(exec boil.Executor, insert bool, related *UserAsset) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset_service_locks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assetServiceLockPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserAssetID = related.ID
+	if o.R == nil {
+		o.R = &assetServiceLockR{
+			UserAsset: related,
+		}
+	} else {
+		o.R.UserAsset = related
+	}
+
+	if related.R == nil {
+		related.R = &userAssetR{
+			AssetServiceLocks: AssetServiceLockSlice{o},
+		}
+	} else {
+		related.R.AssetServiceLocks = append(related.R.AssetServiceLocks, o)
+	}
+
+	return nil
+}
+
+// AssetServiceLocks retrieves all the records using an executor.
+func AssetServiceLocks(mods ...qm.QueryMod) assetServiceLockQuery {
+	mods = append(mods, qm.From("\"asset_service_locks\""))
+	return assetServiceLockQuery{NewQuery(mods...)}
+}
+
+// FindAssetServiceLock retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAssetServiceLock(exec boil.Executor, iD string, selectCols ...string) (*AssetServiceLock, error) {
+	assetServiceLockObj := &AssetServiceLock{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"asset_service_locks\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, assetServiceLockObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from asset_service_locks")
+	}
+
+	if err = assetServiceLockObj.doAfterSelectHooks(exec); err != nil {
+		return assetServiceLockObj, err
+	}
+
+	return assetServiceLockObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AssetServiceLock) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no asset_service_locks provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(assetServiceLockColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	assetServiceLockInsertCacheMut.RLock()
+	cache, cached := assetServiceLockInsertCache[key]
+	assetServiceLockInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			assetServiceLockAllColumns,
+			assetServiceLockColumnsWithDefault,
+			assetServiceLockColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(assetServiceLockType, assetServiceLockMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(assetServiceLockType, assetServiceLockMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"asset_service_locks\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"asset_service_locks\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into asset_service_locks")
+	}
+
+	if !cached {
+		assetServiceLockInsertCacheMut.Lock()
+		assetServiceLockInsertCache[key] = cache
+		assetServiceLockInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the AssetServiceLock.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AssetServiceLock) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	assetServiceLockUpdateCacheMut.RLock()
+	cache, cached := assetServiceLockUpdateCache[key]
+	assetServiceLockUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			assetServiceLockAllColumns,
+			assetServiceLockPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update asset_service_locks, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"asset_service_locks\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, assetServiceLockPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(assetServiceLockType, assetServiceLockMapping, append(wl, assetServiceLockPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update asset_service_locks row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for asset_service_locks")
+	}
+
+	if !cached {
+		assetServiceLockUpdateCacheMut.Lock()
+		assetServiceLockUpdateCache[key] = cache
+		assetServiceLockUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q assetServiceLockQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for asset_service_locks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for asset_service_locks")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AssetServiceLockSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetServiceLockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"asset_service_locks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, assetServiceLockPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in assetServiceLock slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all assetServiceLock")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AssetServiceLock) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no asset_service_locks provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(assetServiceLockColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	assetServiceLockUpsertCacheMut.RLock()
+	cache, cached := assetServiceLockUpsertCache[key]
+	assetServiceLockUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			assetServiceLockAllColumns,
+			assetServiceLockColumnsWithDefault,
+			assetServiceLockColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			assetServiceLockAllColumns,
+			assetServiceLockPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert asset_service_locks, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(assetServiceLockPrimaryKeyColumns))
+			copy(conflict, assetServiceLockPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"asset_service_locks\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(assetServiceLockType, assetServiceLockMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(assetServiceLockType, assetServiceLockMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert asset_service_locks")
+	}
+
+	if !cached {
+		assetServiceLockUpsertCacheMut.Lock()
+		assetServiceLockUpsertCache[key] = cache
+		assetServiceLockUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single AssetServiceLock record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AssetServiceLock) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no AssetServiceLock provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), assetServiceLockPrimaryKeyMapping)
+	sql := "DELETE FROM \"asset_service_locks\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from asset_service_locks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for asset_service_locks")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q assetServiceLockQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no assetServiceLockQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from asset_service_locks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for asset_service_locks")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AssetServiceLockSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(assetServiceLockBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetServiceLockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"asset_service_locks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, assetServiceLockPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from assetServiceLock slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for asset_service_locks")
+	}
+
+	if len(assetServiceLockAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AssetServiceLock) Reload(exec boil.Executor) error {
+	ret, err := FindAssetServiceLock(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AssetServiceLockSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AssetServiceLockSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetServiceLockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"asset_service_locks\".* FROM \"asset_service_locks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, assetServiceLockPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in AssetServiceLockSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AssetServiceLockExists checks if the AssetServiceLock row exists.
+func AssetServiceLockExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"asset_service_locks\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if asset_service_locks exists")
+	}
+
+	return exists, nil
+}
@@ -29,7 +29,7 @@ type AssetServiceTransferEvent struct {
 	InitiatedFrom string      `boiler:"initiated_from" boil:"initiated_from" json:"initiated_from" toml:"initiated_from" yaml:"initiated_from"`
 	FromService   null.String `boiler:"from_service" boil:"from_service" json:"from_service,omitempty" toml:"from_service" yaml:"from_service,omitempty"`
 	ToService     null.String `boiler:"to_service" boil:"to_service" json:"to_service,omitempty" toml:"to_service" yaml:"to_service,omitempty"`
-	TransferTXID  string      `boiler:"transfer_tx_id" boil:"transfer_tx_id" json:"transfer_tx_id" toml:"transfer_tx_id" yaml:"transfer_tx_id"`
+	TransferTXID  null.String `boiler:"transfer_tx_id" boil:"transfer_tx_id" json:"transfer_tx_id,omitempty" toml:"transfer_tx_id" yaml:"transfer_tx_id,omitempty"`
 	TransferredAt time.Time   `boiler:"transferred_at" boil:"transferred_at" json:"transferred_at" toml:"transferred_at" yaml:"transferred_at"`
 
 	R *assetServiceTransferEventR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -85,7 +85,7 @@ var AssetServiceTransferEventWhere = struct {
 	InitiatedFrom whereHelperstring
 	FromService   whereHelpernull_String
 	ToService     whereHelpernull_String
-	TransferTXID  whereHelperstring
+	TransferTXID  whereHelpernull_String
 	TransferredAt whereHelpertime_Time
 }{
 	ID:            whereHelperint64{field: "\"asset_service_transfer_events\".\"id\""},
@@ -94,7 +94,7 @@ var AssetServiceTransferEventWhere = struct {
 	InitiatedFrom: whereHelperstring{field: "\"asset_service_transfer_events\".\"initiated_from\""},
 	FromService:   whereHelpernull_String{field: "\"asset_service_transfer_events\".\"from_service\""},
 	ToService:     whereHelpernull_String{field: "\"asset_service_transfer_events\".\"to_service\""},
-	TransferTXID:  whereHelperstring{field: "\"asset_service_transfer_events\".\"transfer_tx_id\""},
+	TransferTXID:  whereHelpernull_String{field: "\"asset_service_transfer_events\".\"transfer_tx_id\""},
 	TransferredAt: whereHelpertime_Time{field: "\"asset_service_transfer_events\".\"transferred_at\""},
 }
 
@@ -129,8 +129,8 @@ type assetServiceTransferEventL struct{}
 
 var (
 	assetServiceTransferEventAllColumns            = []string{"id", "user_asset_id", "user_id", "initiated_from", "from_service", "to_service", "transfer_tx_id", "transferred_at"}
-	assetServiceTransferEventColumnsWithoutDefault = []string{"user_asset_id", "user_id", "transfer_tx_id"}
-	assetServiceTransferEventColumnsWithDefault    = []string{"id", "initiated_from", "from_service", "to_service", "transferred_at"}
+	assetServiceTransferEventColumnsWithoutDefault = []string{"user_asset_id", "user_id"}
+	assetServiceTransferEventColumnsWithDefault    = []string{"id", "initiated_from", "from_service", "to_service", "transfer_tx_id", "transferred_at"}
 	assetServiceTransferEventPrimaryKeyColumns     = []string{"id"}
 	assetServiceTransferEventGeneratedColumns      = []string{}
 )
@@ -0,0 +1,1465 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AssetTradeItem is an object representing the database table.
+type AssetTradeItem struct {
+	ID              string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	TradeID         string      `boiler:"trade_id" boil:"trade_id" json:"trade_id" toml:"trade_id" yaml:"trade_id"`
+	Side            string      `boiler:"side" boil:"side" json:"side" toml:"side" yaml:"side"`
+	UserAssetID     null.String `boiler:"user_asset_id" boil:"user_asset_id" json:"user_asset_id,omitempty" toml:"user_asset_id" yaml:"user_asset_id,omitempty"`
+	UserAsset1155ID null.String `boiler:"user_asset_1155_id" boil:"user_asset_1155_id" json:"user_asset_1155_id,omitempty" toml:"user_asset_1155_id" yaml:"user_asset_1155_id,omitempty"`
+	Amount          int         `boiler:"amount" boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	CreatedAt       time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *assetTradeItemR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L assetTradeItemL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AssetTradeItemColumns = struct {
+	ID              string
+	TradeID         string
+	Side            string
+	UserAssetID     string
+	UserAsset1155ID string
+	Amount          string
+	CreatedAt       string
+}{
+	ID:              "id",
+	TradeID:         "trade_id",
+	Side:            "side",
+	UserAssetID:     "user_asset_id",
+	UserAsset1155ID: "user_asset_1155_id",
+	Amount:          "amount",
+	CreatedAt:       "created_at",
+}
+
+var AssetTradeItemTableColumns = struct {
+	ID              string
+	TradeID         string
+	Side            string
+	UserAssetID     string
+	UserAsset1155ID string
+	Amount          string
+	CreatedAt       string
+}{
+	ID:              "asset_trade_items.id",
+	TradeID:         "asset_trade_items.trade_id",
+	Side:            "asset_trade_items.side",
+	UserAssetID:     "asset_trade_items.user_asset_id",
+	UserAsset1155ID: "asset_trade_items.user_asset_1155_id",
+	Amount:          "asset_trade_items.amount",
+	CreatedAt:       "asset_trade_items.created_at",
+}
+
+// Generated where
+
+var AssetTradeItemWhere = struct {
+	ID              whereHelperstring
+	TradeID         whereHelperstring
+	Side            whereHelperstring
+	UserAssetID     whereHelpernull_String
+	UserAsset1155ID whereHelpernull_String
+	Amount          whereHelperint
+	CreatedAt       whereHelpertime_Time
+}{
+	ID:              whereHelperstring{field: "\"asset_trade_items\".\"id\""},
+	TradeID:         whereHelperstring{field: "\"asset_trade_items\".\"trade_id\""},
+	Side:            whereHelperstring{field: "\"asset_trade_items\".\"side\""},
+	UserAssetID:     whereHelpernull_String{field: "\"asset_trade_items\".\"user_asset_id\""},
+	UserAsset1155ID: whereHelpernull_String{field: "\"asset_trade_items\".\"user_asset_1155_id\""},
+	Amount:          whereHelperint{field: "\"asset_trade_items\".\"amount\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"asset_trade_items\".\"created_at\""},
+}
+
+// AssetTradeItemRels is where relationship names are stored.
+var AssetTradeItemRels = struct {
+	Trade         string
+	UserAsset1155 string
+	UserAsset     string
+}{
+	Trade:         "Trade",
+	UserAsset1155: "UserAsset1155",
+	UserAsset:     "UserAsset",
+}
+
+// assetTradeItemR is where relationships are stored.
+type assetTradeItemR struct {
+	Trade         *AssetTrade     `boiler:"Trade" boil:"Trade" json:"Trade" toml:"Trade" yaml:"Trade"`
+	UserAsset1155 *UserAssets1155 `boiler:"UserAsset1155" boil:"UserAsset1155" json:"UserAsset1155" toml:"UserAsset1155" yaml:"UserAsset1155"`
+	UserAsset     *UserAsset      `boiler:"UserAsset" boil:"UserAsset" json:"UserAsset" toml:"UserAsset" yaml:"UserAsset"`
+}
+
+// NewStruct creates a new relationship struct
+func (*assetTradeItemR) NewStruct() *assetTradeItemR {
+	return &assetTradeItemR{}
+}
+
+// assetTradeItemL is where Load methods for each relationship are stored.
+type assetTradeItemL struct{}
+
+var (
+	assetTradeItemAllColumns            = []string{"id", "trade_id", "side", "user_asset_id", "user_asset_1155_id", "amount", "created_at"}
+	assetTradeItemColumnsWithoutDefault = []string{"trade_id", "side"}
+	assetTradeItemColumnsWithDefault    = []string{"id", "user_asset_id", "user_asset_1155_id", "amount", "created_at"}
+	assetTradeItemPrimaryKeyColumns     = []string{"id"}
+	assetTradeItemGeneratedColumns      = []string{}
+)
+
+type (
+	// AssetTradeItemSlice is an alias for a slice of pointers to AssetTradeItem.
+	// This should almost always be used instead of []AssetTradeItem.
+	AssetTradeItemSlice []*AssetTradeItem
+	// AssetTradeItemHook is the signature for custom AssetTradeItem hook methods
+	AssetTradeItemHook func(boil.Executor, *AssetTradeItem) error
+
+	assetTradeItemQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	assetTradeItemType                 = reflect.TypeOf(&AssetTradeItem{})
+	assetTradeItemMapping              = queries.MakeStructMapping(assetTradeItemType)
+	assetTradeItemPrimaryKeyMapping, _ = queries.BindMapping(assetTradeItemType, assetTradeItemMapping, assetTradeItemPrimaryKeyColumns)
+	assetTradeItemInsertCacheMut       sync.RWMutex
+	assetTradeItemInsertCache          = make(map[string]insertCache)
+	assetTradeItemUpdateCacheMut       sync.RWMutex
+	assetTradeItemUpdateCache          = make(map[string]updateCache)
+	assetTradeItemUpsertCacheMut       sync.RWMutex
+	assetTradeItemUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var assetTradeItemAfterSelectHooks []AssetTradeItemHook
+
+var assetTradeItemBeforeInsertHooks []AssetTradeItemHook
+var assetTradeItemAfterInsertHooks []AssetTradeItemHook
+
+var assetTradeItemBeforeUpdateHooks []AssetTradeItemHook
+var assetTradeItemAfterUpdateHooks []AssetTradeItemHook
+
+var assetTradeItemBeforeDeleteHooks []AssetTradeItemHook
+var assetTradeItemAfterDeleteHooks []AssetTradeItemHook
+
+var assetTradeItemBeforeUpsertHooks []AssetTradeItemHook
+var assetTradeItemAfterUpsertHooks []AssetTradeItemHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AssetTradeItem) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeItemAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AssetTradeItem) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeItemBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AssetTradeItem) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeItemAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AssetTradeItem) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeItemBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AssetTradeItem) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeItemAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AssetTradeItem) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeItemBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AssetTradeItem) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeItemAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AssetTradeItem) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeItemBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AssetTradeItem) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeItemAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAssetTradeItemHook registers your hook function for all future operations.
+func AddAssetTradeItemHook(hookPoint boil.HookPoint, assetTradeItemHook AssetTradeItemHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		assetTradeItemAfterSelectHooks = append(assetTradeItemAfterSelectHooks, assetTradeItemHook)
+	case boil.BeforeInsertHook:
+		assetTradeItemBeforeInsertHooks = append(assetTradeItemBeforeInsertHooks, assetTradeItemHook)
+	case boil.AfterInsertHook:
+		assetTradeItemAfterInsertHooks = append(assetTradeItemAfterInsertHooks, assetTradeItemHook)
+	case boil.BeforeUpdateHook:
+		assetTradeItemBeforeUpdateHooks = append(assetTradeItemBeforeUpdateHooks, assetTradeItemHook)
+	case boil.AfterUpdateHook:
+		assetTradeItemAfterUpdateHooks = append(assetTradeItemAfterUpdateHooks, assetTradeItemHook)
+	case boil.BeforeDeleteHook:
+		assetTradeItemBeforeDeleteHooks = append(assetTradeItemBeforeDeleteHooks, assetTradeItemHook)
+	case boil.AfterDeleteHook:
+		assetTradeItemAfterDeleteHooks = append(assetTradeItemAfterDeleteHooks, assetTradeItemHook)
+	case boil.BeforeUpsertHook:
+		assetTradeItemBeforeUpsertHooks = append(assetTradeItemBeforeUpsertHooks, assetTradeItemHook)
+	case boil.AfterUpsertHook:
+		assetTradeItemAfterUpsertHooks = append(assetTradeItemAfterUpsertHooks, assetTradeItemHook)
+	}
+}
+
+// One returns a single assetTradeItem record from the query.
+func (q assetTradeItemQuery) One(exec boil.Executor) (*AssetTradeItem, error) {
+	o := &AssetTradeItem{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for asset_trade_items")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AssetTradeItem records from the query.
+func (q assetTradeItemQuery) All(exec boil.Executor) (AssetTradeItemSlice, error) {
+	var o []*AssetTradeItem
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to AssetTradeItem slice")
+	}
+
+	if len(assetTradeItemAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AssetTradeItem records in the query.
+func (q assetTradeItemQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count asset_trade_items rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q assetTradeItemQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if asset_trade_items exists")
+	}
+
+	return count > 0, nil
+}
+
+// Trade pointed to by the foreign key.
+func (o *AssetTradeItem) Trade(mods ...qm.QueryMod) assetTradeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TradeID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := AssetTrades(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_trades\"")
+
+	return query
+}
+
+// UserAsset1155 pointed to by the foreign key.
+func (o *AssetTradeItem) UserAsset1155(mods ...qm.QueryMod) userAssets1155Query {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserAsset1155ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := UserAssets1155S(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets_1155\"")
+
+	return query
+}
+
+// UserAsset pointed to by the foreign key.
+func (o *AssetTradeItem) UserAsset(mods ...qm.QueryMod) userAssetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserAssetID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := UserAssets(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets\"")
+
+	return query
+}
+
+// LoadTrade allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assetTradeItemL) LoadTrade(e boil.Executor, singular bool, maybeAssetTradeItem interface{}, mods queries.Applicator) error {
+	var slice []*AssetTradeItem
+	var object *AssetTradeItem
+
+	if singular {
+		object = maybeAssetTradeItem.(*AssetTradeItem)
+	} else {
+		slice = *maybeAssetTradeItem.(*[]*AssetTradeItem)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetTradeItemR{}
+		}
+		args = append(args, object.TradeID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetTradeItemR{}
+			}
+
+			for _, a := range args {
+				if a == obj.TradeID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.TradeID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`asset_trades`),
+		qm.WhereIn(`asset_trades.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load AssetTrade")
+	}
+
+	var resultSlice []*AssetTrade
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice AssetTrade")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for asset_trades")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_trades")
+	}
+
+	if len(assetTradeItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Trade = foreign
+		if foreign.R == nil {
+			foreign.R = &assetTradeR{}
+		}
+		foreign.R.TradeAssetTradeItems = append(foreign.R.TradeAssetTradeItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TradeID == foreign.ID {
+				local.R.Trade = foreign
+				if foreign.R == nil {
+					foreign.R = &assetTradeR{}
+				}
+				foreign.R.TradeAssetTradeItems = append(foreign.R.TradeAssetTradeItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAsset1155 allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assetTradeItemL) LoadUserAsset1155(e boil.Executor, singular bool, maybeAssetTradeItem interface{}, mods queries.Applicator) error {
+	var slice []*AssetTradeItem
+	var object *AssetTradeItem
+
+	if singular {
+		object = maybeAssetTradeItem.(*AssetTradeItem)
+	} else {
+		slice = *maybeAssetTradeItem.(*[]*AssetTradeItem)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetTradeItemR{}
+		}
+		if !queries.IsNil(object.UserAsset1155ID) {
+			args = append(args, object.UserAsset1155ID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetTradeItemR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.UserAsset1155ID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.UserAsset1155ID) {
+				args = append(args, obj.UserAsset1155ID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets_1155`),
+		qm.WhereIn(`user_assets_1155.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserAssets1155")
+	}
+
+	var resultSlice []*UserAssets1155
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserAssets1155")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_assets_1155")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets_1155")
+	}
+
+	if len(assetTradeItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserAsset1155 = foreign
+		if foreign.R == nil {
+			foreign.R = &userAssets1155R{}
+		}
+		foreign.R.UserAsset1155AssetTradeItems = append(foreign.R.UserAsset1155AssetTradeItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserAsset1155ID, foreign.ID) {
+				local.R.UserAsset1155 = foreign
+				if foreign.R == nil {
+					foreign.R = &userAssets1155R{}
+				}
+				foreign.R.UserAsset1155AssetTradeItems = append(foreign.R.UserAsset1155AssetTradeItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAsset allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assetTradeItemL) LoadUserAsset(e boil.Executor, singular bool, maybeAssetTradeItem interface{}, mods queries.Applicator) error {
+	var slice []*AssetTradeItem
+	var object *AssetTradeItem
+
+	if singular {
+		object = maybeAssetTradeItem.(*AssetTradeItem)
+	} else {
+		slice = *maybeAssetTradeItem.(*[]*AssetTradeItem)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetTradeItemR{}
+		}
+		if !queries.IsNil(object.UserAssetID) {
+			args = append(args, object.UserAssetID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetTradeItemR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.UserAssetID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.UserAssetID) {
+				args = append(args, obj.UserAssetID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets`),
+		qm.WhereIn(`user_assets.id in ?`, args...),
+		qmhelper.WhereIsNull(`user_assets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserAsset")
+	}
+
+	var resultSlice []*UserAsset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserAsset")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_assets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
+	}
+
+	if len(assetTradeItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserAsset = foreign
+		if foreign.R == nil {
+			foreign.R = &userAssetR{}
+		}
+		foreign.R.AssetTradeItems = append(foreign.R.AssetTradeItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserAssetID, foreign.ID) {
+				local.R.UserAsset = foreign
+				if foreign.R == nil {
+					foreign.R = &userAssetR{}
+				}
+				foreign.R.AssetTradeItems = append(foreign.R.AssetTradeItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTrade of the assetTradeItem to the related item.
+// Sets o.R.Trade to related.
+// Adds o to related.R.TradeAssetTradeItems.
+func (o *AssetTradeItem) SetTrade(exec boil.Executor, insert bool, related *AssetTrade) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset_trade_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"trade_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assetTradeItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TradeID = related.ID
+	if o.R == nil {
+		o.R = &assetTradeItemR{
+			Trade: related,
+		}
+	} else {
+		o.R.Trade = related
+	}
+
+	if related.R == nil {
+		related.R = &assetTradeR{
+			TradeAssetTradeItems: AssetTradeItemSlice{o},
+		}
+	} else {
+		related.R.TradeAssetTradeItems = append(related.R.TradeAssetTradeItems, o)
+	}
+
+	return nil
+}
+
+// SetUserAsset1155 of the assetTradeItem to the related item.
+// Sets o.R.UserAsset1155 to related.
+// Adds o to related.R.UserAsset1155AssetTradeItems.
+func (o *AssetTradeItem) SetUserAsset1155(exec boil.Executor, insert bool, related *UserAssets1155) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset_trade_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_1155_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assetTradeItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserAsset1155ID, related.ID)
+	if o.R == nil {
+		o.R = &assetTradeItemR{
+			UserAsset1155: related,
+		}
+	} else {
+		o.R.UserAsset1155 = related
+	}
+
+	if related.R == nil {
+		related.R = &userAssets1155R{
+			UserAsset1155AssetTradeItems: AssetTradeItemSlice{o},
+		}
+	} else {
+		related.R.UserAsset1155AssetTradeItems = append(related.R.UserAsset1155AssetTradeItems, o)
+	}
+
+	return nil
+}
+
+// RemoveUserAsset1155 relationship.
+// Sets o.R.UserAsset1155 to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *AssetTradeItem) RemoveUserAsset1155(exec boil.Executor, related *UserAssets1155) error {
+	var err error
+
+	queries.SetScanner(&o.UserAsset1155ID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("user_asset_1155_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.UserAsset1155 = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.UserAsset1155AssetTradeItems {
+		if queries.Equal(o.UserAsset1155ID, ri.UserAsset1155ID) {
+			continue
+		}
+
+		ln := len(related.R.UserAsset1155AssetTradeItems)
+		if ln > 1 && i < ln-1 {
+			related.R.UserAsset1155AssetTradeItems[i] = related.R.UserAsset1155AssetTradeItems[ln-1]
+		}
+		related.R.UserAsset1155AssetTradeItems = related.R.UserAsset1155AssetTradeItems[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetUserAsset of the assetTradeItem to the related item.
+// Sets o.R.UserAsset to related.
+// Adds o to related.R.AssetTradeItems.
+func (o *AssetTradeItem) SetUserAsset(exec boil.Executor, insert bool, related *UserAsset) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset_trade_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assetTradeItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserAssetID, related.ID)
+	if o.R == nil {
+		o.R = &assetTradeItemR{
+			UserAsset: related,
+		}
+	} else {
+		o.R.UserAsset = related
+	}
+
+	if related.R == nil {
+		related.R = &userAssetR{
+			AssetTradeItems: AssetTradeItemSlice{o},
+		}
+	} else {
+		related.R.AssetTradeItems = append(related.R.AssetTradeItems, o)
+	}
+
+	return nil
+}
+
+// RemoveUserAsset relationship.
+// Sets o.R.UserAsset to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *AssetTradeItem) RemoveUserAsset(exec boil.Executor, related *UserAsset) error {
+	var err error
+
+	queries.SetScanner(&o.UserAssetID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("user_asset_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.UserAsset = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AssetTradeItems {
+		if queries.Equal(o.UserAssetID, ri.UserAssetID) {
+			continue
+		}
+
+		ln := len(related.R.AssetTradeItems)
+		if ln > 1 && i < ln-1 {
+			related.R.AssetTradeItems[i] = related.R.AssetTradeItems[ln-1]
+		}
+		related.R.AssetTradeItems = related.R.AssetTradeItems[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AssetTradeItems retrieves all the records using an executor.
+func AssetTradeItems(mods ...qm.QueryMod) assetTradeItemQuery {
+	mods = append(mods, qm.From("\"asset_trade_items\""))
+	return assetTradeItemQuery{NewQuery(mods...)}
+}
+
+// FindAssetTradeItem retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAssetTradeItem(exec boil.Executor, iD string, selectCols ...string) (*AssetTradeItem, error) {
+	assetTradeItemObj := &AssetTradeItem{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"asset_trade_items\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, assetTradeItemObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from asset_trade_items")
+	}
+
+	if err = assetTradeItemObj.doAfterSelectHooks(exec); err != nil {
+		return assetTradeItemObj, err
+	}
+
+	return assetTradeItemObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AssetTradeItem) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no asset_trade_items provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(assetTradeItemColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	assetTradeItemInsertCacheMut.RLock()
+	cache, cached := assetTradeItemInsertCache[key]
+	assetTradeItemInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			assetTradeItemAllColumns,
+			assetTradeItemColumnsWithDefault,
+			assetTradeItemColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(assetTradeItemType, assetTradeItemMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(assetTradeItemType, assetTradeItemMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"asset_trade_items\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"asset_trade_items\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into asset_trade_items")
+	}
+
+	if !cached {
+		assetTradeItemInsertCacheMut.Lock()
+		assetTradeItemInsertCache[key] = cache
+		assetTradeItemInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the AssetTradeItem.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AssetTradeItem) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	assetTradeItemUpdateCacheMut.RLock()
+	cache, cached := assetTradeItemUpdateCache[key]
+	assetTradeItemUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			assetTradeItemAllColumns,
+			assetTradeItemPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update asset_trade_items, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"asset_trade_items\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, assetTradeItemPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(assetTradeItemType, assetTradeItemMapping, append(wl, assetTradeItemPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update asset_trade_items row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for asset_trade_items")
+	}
+
+	if !cached {
+		assetTradeItemUpdateCacheMut.Lock()
+		assetTradeItemUpdateCache[key] = cache
+		assetTradeItemUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q assetTradeItemQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for asset_trade_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for asset_trade_items")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AssetTradeItemSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetTradeItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"asset_trade_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, assetTradeItemPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in assetTradeItem slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all assetTradeItem")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AssetTradeItem) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no asset_trade_items provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(assetTradeItemColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	assetTradeItemUpsertCacheMut.RLock()
+	cache, cached := assetTradeItemUpsertCache[key]
+	assetTradeItemUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			assetTradeItemAllColumns,
+			assetTradeItemColumnsWithDefault,
+			assetTradeItemColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			assetTradeItemAllColumns,
+			assetTradeItemPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert asset_trade_items, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(assetTradeItemPrimaryKeyColumns))
+			copy(conflict, assetTradeItemPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"asset_trade_items\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(assetTradeItemType, assetTradeItemMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(assetTradeItemType, assetTradeItemMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert asset_trade_items")
+	}
+
+	if !cached {
+		assetTradeItemUpsertCacheMut.Lock()
+		assetTradeItemUpsertCache[key] = cache
+		assetTradeItemUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single AssetTradeItem record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AssetTradeItem) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no AssetTradeItem provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), assetTradeItemPrimaryKeyMapping)
+	sql := "DELETE FROM \"asset_trade_items\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from asset_trade_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for asset_trade_items")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q assetTradeItemQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no assetTradeItemQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from asset_trade_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for asset_trade_items")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AssetTradeItemSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(assetTradeItemBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetTradeItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"asset_trade_items\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, assetTradeItemPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from assetTradeItem slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for asset_trade_items")
+	}
+
+	if len(assetTradeItemAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AssetTradeItem) Reload(exec boil.Executor) error {
+	ret, err := FindAssetTradeItem(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AssetTradeItemSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AssetTradeItemSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetTradeItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"asset_trade_items\".* FROM \"asset_trade_items\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, assetTradeItemPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in AssetTradeItemSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AssetTradeItemExists checks if the AssetTradeItem row exists.
+func AssetTradeItemExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"asset_trade_items\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if asset_trade_items exists")
+	}
+
+	return exists, nil
+}
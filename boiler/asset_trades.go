@@ -0,0 +1,1472 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AssetTrade is an object representing the database table.
+type AssetTrade struct {
+	ID                string          `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	OffererID         string          `boiler:"offerer_id" boil:"offerer_id" json:"offerer_id" toml:"offerer_id" yaml:"offerer_id"`
+	RecipientID       string          `boiler:"recipient_id" boil:"recipient_id" json:"recipient_id" toml:"recipient_id" yaml:"recipient_id"`
+	OfferSups         decimal.Decimal `boiler:"offer_sups" boil:"offer_sups" json:"offer_sups" toml:"offer_sups" yaml:"offer_sups"`
+	RequestSups       decimal.Decimal `boiler:"request_sups" boil:"request_sups" json:"request_sups" toml:"request_sups" yaml:"request_sups"`
+	Status            string          `boiler:"status" boil:"status" json:"status" toml:"status" yaml:"status"`
+	OfferTXID         null.String     `boiler:"offer_tx_id" boil:"offer_tx_id" json:"offer_tx_id,omitempty" toml:"offer_tx_id" yaml:"offer_tx_id,omitempty"`
+	RequestTXID       null.String     `boiler:"request_tx_id" boil:"request_tx_id" json:"request_tx_id,omitempty" toml:"request_tx_id" yaml:"request_tx_id,omitempty"`
+	FailedReason      null.String     `boiler:"failed_reason" boil:"failed_reason" json:"failed_reason,omitempty" toml:"failed_reason" yaml:"failed_reason,omitempty"`
+	ExpiresAt         time.Time       `boiler:"expires_at" boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CompletedAt       null.Time       `boiler:"completed_at" boil:"completed_at" json:"completed_at,omitempty" toml:"completed_at" yaml:"completed_at,omitempty"`
+	UpdatedAt         time.Time       `boiler:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt         time.Time       `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	OfferEscrowTXID   null.String     `boiler:"offer_escrow_tx_id" boil:"offer_escrow_tx_id" json:"offer_escrow_tx_id,omitempty" toml:"offer_escrow_tx_id" yaml:"offer_escrow_tx_id,omitempty"`
+	RequestEscrowTXID null.String     `boiler:"request_escrow_tx_id" boil:"request_escrow_tx_id" json:"request_escrow_tx_id,omitempty" toml:"request_escrow_tx_id" yaml:"request_escrow_tx_id,omitempty"`
+	SupsSettledAt     null.Time       `boiler:"sups_settled_at" boil:"sups_settled_at" json:"sups_settled_at,omitempty" toml:"sups_settled_at" yaml:"sups_settled_at,omitempty"`
+
+	R *assetTradeR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L assetTradeL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AssetTradeColumns = struct {
+	ID                string
+	OffererID         string
+	RecipientID       string
+	OfferSups         string
+	RequestSups       string
+	Status            string
+	OfferTXID         string
+	RequestTXID       string
+	FailedReason      string
+	ExpiresAt         string
+	CompletedAt       string
+	UpdatedAt         string
+	CreatedAt         string
+	OfferEscrowTXID   string
+	RequestEscrowTXID string
+	SupsSettledAt     string
+}{
+	ID:                "id",
+	OffererID:         "offerer_id",
+	RecipientID:       "recipient_id",
+	OfferSups:         "offer_sups",
+	RequestSups:       "request_sups",
+	Status:            "status",
+	OfferTXID:         "offer_tx_id",
+	RequestTXID:       "request_tx_id",
+	FailedReason:      "failed_reason",
+	ExpiresAt:         "expires_at",
+	CompletedAt:       "completed_at",
+	UpdatedAt:         "updated_at",
+	CreatedAt:         "created_at",
+	OfferEscrowTXID:   "offer_escrow_tx_id",
+	RequestEscrowTXID: "request_escrow_tx_id",
+	SupsSettledAt:     "sups_settled_at",
+}
+
+var AssetTradeTableColumns = struct {
+	ID                string
+	OffererID         string
+	RecipientID       string
+	OfferSups         string
+	RequestSups       string
+	Status            string
+	OfferTXID         string
+	RequestTXID       string
+	FailedReason      string
+	ExpiresAt         string
+	CompletedAt       string
+	UpdatedAt         string
+	CreatedAt         string
+	OfferEscrowTXID   string
+	RequestEscrowTXID string
+	SupsSettledAt     string
+}{
+	ID:                "asset_trades.id",
+	OffererID:         "asset_trades.offerer_id",
+	RecipientID:       "asset_trades.recipient_id",
+	OfferSups:         "asset_trades.offer_sups",
+	RequestSups:       "asset_trades.request_sups",
+	Status:            "asset_trades.status",
+	OfferTXID:         "asset_trades.offer_tx_id",
+	RequestTXID:       "asset_trades.request_tx_id",
+	FailedReason:      "asset_trades.failed_reason",
+	ExpiresAt:         "asset_trades.expires_at",
+	CompletedAt:       "asset_trades.completed_at",
+	UpdatedAt:         "asset_trades.updated_at",
+	CreatedAt:         "asset_trades.created_at",
+	OfferEscrowTXID:   "asset_trades.offer_escrow_tx_id",
+	RequestEscrowTXID: "asset_trades.request_escrow_tx_id",
+	SupsSettledAt:     "asset_trades.sups_settled_at",
+}
+
+// Generated where
+
+var AssetTradeWhere = struct {
+	ID                whereHelperstring
+	OffererID         whereHelperstring
+	RecipientID       whereHelperstring
+	OfferSups         whereHelperdecimal_Decimal
+	RequestSups       whereHelperdecimal_Decimal
+	Status            whereHelperstring
+	OfferTXID         whereHelpernull_String
+	RequestTXID       whereHelpernull_String
+	FailedReason      whereHelpernull_String
+	ExpiresAt         whereHelpertime_Time
+	CompletedAt       whereHelpernull_Time
+	UpdatedAt         whereHelpertime_Time
+	CreatedAt         whereHelpertime_Time
+	OfferEscrowTXID   whereHelpernull_String
+	RequestEscrowTXID whereHelpernull_String
+	SupsSettledAt     whereHelpernull_Time
+}{
+	ID:                whereHelperstring{field: "\"asset_trades\".\"id\""},
+	OffererID:         whereHelperstring{field: "\"asset_trades\".\"offerer_id\""},
+	RecipientID:       whereHelperstring{field: "\"asset_trades\".\"recipient_id\""},
+	OfferSups:         whereHelperdecimal_Decimal{field: "\"asset_trades\".\"offer_sups\""},
+	RequestSups:       whereHelperdecimal_Decimal{field: "\"asset_trades\".\"request_sups\""},
+	Status:            whereHelperstring{field: "\"asset_trades\".\"status\""},
+	OfferTXID:         whereHelpernull_String{field: "\"asset_trades\".\"offer_tx_id\""},
+	RequestTXID:       whereHelpernull_String{field: "\"asset_trades\".\"request_tx_id\""},
+	FailedReason:      whereHelpernull_String{field: "\"asset_trades\".\"failed_reason\""},
+	ExpiresAt:         whereHelpertime_Time{field: "\"asset_trades\".\"expires_at\""},
+	CompletedAt:       whereHelpernull_Time{field: "\"asset_trades\".\"completed_at\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"asset_trades\".\"updated_at\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"asset_trades\".\"created_at\""},
+	OfferEscrowTXID:   whereHelpernull_String{field: "\"asset_trades\".\"offer_escrow_tx_id\""},
+	RequestEscrowTXID: whereHelpernull_String{field: "\"asset_trades\".\"request_escrow_tx_id\""},
+	SupsSettledAt:     whereHelpernull_Time{field: "\"asset_trades\".\"sups_settled_at\""},
+}
+
+// AssetTradeRels is where relationship names are stored.
+var AssetTradeRels = struct {
+	Offerer              string
+	Recipient            string
+	TradeAssetTradeItems string
+}{
+	Offerer:              "Offerer",
+	Recipient:            "Recipient",
+	TradeAssetTradeItems: "TradeAssetTradeItems",
+}
+
+// assetTradeR is where relationships are stored.
+type assetTradeR struct {
+	Offerer              *User               `boiler:"Offerer" boil:"Offerer" json:"Offerer" toml:"Offerer" yaml:"Offerer"`
+	Recipient            *User               `boiler:"Recipient" boil:"Recipient" json:"Recipient" toml:"Recipient" yaml:"Recipient"`
+	TradeAssetTradeItems AssetTradeItemSlice `boiler:"TradeAssetTradeItems" boil:"TradeAssetTradeItems" json:"TradeAssetTradeItems" toml:"TradeAssetTradeItems" yaml:"TradeAssetTradeItems"`
+}
+
+// NewStruct creates a new relationship struct
+func (*assetTradeR) NewStruct() *assetTradeR {
+	return &assetTradeR{}
+}
+
+// assetTradeL is where Load methods for each relationship are stored.
+type assetTradeL struct{}
+
+var (
+	assetTradeAllColumns            = []string{"id", "offerer_id", "recipient_id", "offer_sups", "request_sups", "status", "offer_tx_id", "request_tx_id", "failed_reason", "expires_at", "completed_at", "updated_at", "created_at", "offer_escrow_tx_id", "request_escrow_tx_id", "sups_settled_at"}
+	assetTradeColumnsWithoutDefault = []string{"offerer_id", "recipient_id", "expires_at"}
+	assetTradeColumnsWithDefault    = []string{"id", "offer_sups", "request_sups", "status", "offer_tx_id", "request_tx_id", "failed_reason", "completed_at", "updated_at", "created_at", "offer_escrow_tx_id", "request_escrow_tx_id", "sups_settled_at"}
+	assetTradePrimaryKeyColumns     = []string{"id"}
+	assetTradeGeneratedColumns      = []string{}
+)
+
+type (
+	// AssetTradeSlice is an alias for a slice of pointers to AssetTrade.
+	// This should almost always be used instead of []AssetTrade.
+	AssetTradeSlice []*AssetTrade
+	// AssetTradeHook is the signature for custom AssetTrade hook methods
+	AssetTradeHook func(boil.Executor, *AssetTrade) error
+
+	assetTradeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	assetTradeType                 = reflect.TypeOf(&AssetTrade{})
+	assetTradeMapping              = queries.MakeStructMapping(assetTradeType)
+	assetTradePrimaryKeyMapping, _ = queries.BindMapping(assetTradeType, assetTradeMapping, assetTradePrimaryKeyColumns)
+	assetTradeInsertCacheMut       sync.RWMutex
+	assetTradeInsertCache          = make(map[string]insertCache)
+	assetTradeUpdateCacheMut       sync.RWMutex
+	assetTradeUpdateCache          = make(map[string]updateCache)
+	assetTradeUpsertCacheMut       sync.RWMutex
+	assetTradeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var assetTradeAfterSelectHooks []AssetTradeHook
+
+var assetTradeBeforeInsertHooks []AssetTradeHook
+var assetTradeAfterInsertHooks []AssetTradeHook
+
+var assetTradeBeforeUpdateHooks []AssetTradeHook
+var assetTradeAfterUpdateHooks []AssetTradeHook
+
+var assetTradeBeforeDeleteHooks []AssetTradeHook
+var assetTradeAfterDeleteHooks []AssetTradeHook
+
+var assetTradeBeforeUpsertHooks []AssetTradeHook
+var assetTradeAfterUpsertHooks []AssetTradeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AssetTrade) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AssetTrade) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AssetTrade) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AssetTrade) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AssetTrade) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AssetTrade) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AssetTrade) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AssetTrade) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AssetTrade) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range assetTradeAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAssetTradeHook registers your hook function for all future operations.
+func AddAssetTradeHook(hookPoint boil.HookPoint, assetTradeHook AssetTradeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		assetTradeAfterSelectHooks = append(assetTradeAfterSelectHooks, assetTradeHook)
+	case boil.BeforeInsertHook:
+		assetTradeBeforeInsertHooks = append(assetTradeBeforeInsertHooks, assetTradeHook)
+	case boil.AfterInsertHook:
+		assetTradeAfterInsertHooks = append(assetTradeAfterInsertHooks, assetTradeHook)
+	case boil.BeforeUpdateHook:
+		assetTradeBeforeUpdateHooks = append(assetTradeBeforeUpdateHooks, assetTradeHook)
+	case boil.AfterUpdateHook:
+		assetTradeAfterUpdateHooks = append(assetTradeAfterUpdateHooks, assetTradeHook)
+	case boil.BeforeDeleteHook:
+		assetTradeBeforeDeleteHooks = append(assetTradeBeforeDeleteHooks, assetTradeHook)
+	case boil.AfterDeleteHook:
+		assetTradeAfterDeleteHooks = append(assetTradeAfterDeleteHooks, assetTradeHook)
+	case boil.BeforeUpsertHook:
+		assetTradeBeforeUpsertHooks = append(assetTradeBeforeUpsertHooks, assetTradeHook)
+	case boil.AfterUpsertHook:
+		assetTradeAfterUpsertHooks = append(assetTradeAfterUpsertHooks, assetTradeHook)
+	}
+}
+
+// One returns a single assetTrade record from the query.
+func (q assetTradeQuery) One(exec boil.Executor) (*AssetTrade, error) {
+	o := &AssetTrade{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for asset_trades")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AssetTrade records from the query.
+func (q assetTradeQuery) All(exec boil.Executor) (AssetTradeSlice, error) {
+	var o []*AssetTrade
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to AssetTrade slice")
+	}
+
+	if len(assetTradeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AssetTrade records in the query.
+func (q assetTradeQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count asset_trades rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q assetTradeQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if asset_trades exists")
+	}
+
+	return count > 0, nil
+}
+
+// Offerer pointed to by the foreign key.
+func (o *AssetTrade) Offerer(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OffererID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// Recipient pointed to by the foreign key.
+func (o *AssetTrade) Recipient(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RecipientID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// TradeAssetTradeItems retrieves all the asset_trade_item's AssetTradeItems with an executor via trade_id column.
+func (o *AssetTrade) TradeAssetTradeItems(mods ...qm.QueryMod) assetTradeItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"asset_trade_items\".\"trade_id\"=?", o.ID),
+	)
+
+	query := AssetTradeItems(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_trade_items\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"asset_trade_items\".*"})
+	}
+
+	return query
+}
+
+// LoadOfferer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assetTradeL) LoadOfferer(e boil.Executor, singular bool, maybeAssetTrade interface{}, mods queries.Applicator) error {
+	var slice []*AssetTrade
+	var object *AssetTrade
+
+	if singular {
+		object = maybeAssetTrade.(*AssetTrade)
+	} else {
+		slice = *maybeAssetTrade.(*[]*AssetTrade)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetTradeR{}
+		}
+		args = append(args, object.OffererID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetTradeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.OffererID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.OffererID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(assetTradeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Offerer = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.OffererAssetTrades = append(foreign.R.OffererAssetTrades, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OffererID == foreign.ID {
+				local.R.Offerer = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.OffererAssetTrades = append(foreign.R.OffererAssetTrades, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRecipient allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assetTradeL) LoadRecipient(e boil.Executor, singular bool, maybeAssetTrade interface{}, mods queries.Applicator) error {
+	var slice []*AssetTrade
+	var object *AssetTrade
+
+	if singular {
+		object = maybeAssetTrade.(*AssetTrade)
+	} else {
+		slice = *maybeAssetTrade.(*[]*AssetTrade)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetTradeR{}
+		}
+		args = append(args, object.RecipientID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetTradeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RecipientID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RecipientID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(assetTradeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Recipient = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.RecipientAssetTrades = append(foreign.R.RecipientAssetTrades, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RecipientID == foreign.ID {
+				local.R.Recipient = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.RecipientAssetTrades = append(foreign.R.RecipientAssetTrades, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTradeAssetTradeItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (assetTradeL) LoadTradeAssetTradeItems(e boil.Executor, singular bool, maybeAssetTrade interface{}, mods queries.Applicator) error {
+	var slice []*AssetTrade
+	var object *AssetTrade
+
+	if singular {
+		object = maybeAssetTrade.(*AssetTrade)
+	} else {
+		slice = *maybeAssetTrade.(*[]*AssetTrade)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetTradeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetTradeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`asset_trade_items`),
+		qm.WhereIn(`asset_trade_items.trade_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load asset_trade_items")
+	}
+
+	var resultSlice []*AssetTradeItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_trade_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on asset_trade_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_trade_items")
+	}
+
+	if len(assetTradeItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TradeAssetTradeItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &assetTradeItemR{}
+			}
+			foreign.R.Trade = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TradeID {
+				local.R.TradeAssetTradeItems = append(local.R.TradeAssetTradeItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &assetTradeItemR{}
+				}
+				foreign.R.Trade = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOfferer of the assetTrade to the related item.
+// Sets o.R.Offerer to related.
+// Adds o to related.R.OffererAssetTrades.
+func (o *AssetTrade) SetOfferer(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset_trades\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"offerer_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assetTradePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OffererID = related.ID
+	if o.R == nil {
+		o.R = &assetTradeR{
+			Offerer: related,
+		}
+	} else {
+		o.R.Offerer = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OffererAssetTrades: AssetTradeSlice{o},
+		}
+	} else {
+		related.R.OffererAssetTrades = append(related.R.OffererAssetTrades, o)
+	}
+
+	return nil
+}
+
+// SetRecipient of the assetTrade to the related item.
+// Sets o.R.Recipient to related.
+// Adds o to related.R.RecipientAssetTrades.
+func (o *AssetTrade) SetRecipient(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"asset_trades\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"recipient_id"}),
+		strmangle.WhereClause("\"", "\"", 2, assetTradePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RecipientID = related.ID
+	if o.R == nil {
+		o.R = &assetTradeR{
+			Recipient: related,
+		}
+	} else {
+		o.R.Recipient = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			RecipientAssetTrades: AssetTradeSlice{o},
+		}
+	} else {
+		related.R.RecipientAssetTrades = append(related.R.RecipientAssetTrades, o)
+	}
+
+	return nil
+}
+
+// AddTradeAssetTradeItems adds the given related objects to the existing relationships
+// of the asset_trade, optionally inserting them as new records.
+// Appends related to o.R.TradeAssetTradeItems.
+// Sets related.R.Trade appropriately.
+func (o *AssetTrade) AddTradeAssetTradeItems(exec boil.Executor, insert bool, related ...*AssetTradeItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TradeID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_trade_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"trade_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetTradeItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TradeID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &assetTradeR{
+			TradeAssetTradeItems: related,
+		}
+	} else {
+		o.R.TradeAssetTradeItems = append(o.R.TradeAssetTradeItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetTradeItemR{
+				Trade: o,
+			}
+		} else {
+			rel.R.Trade = o
+		}
+	}
+	return nil
+}
+
+// AssetTrades retrieves all the records using an executor.
+func AssetTrades(mods ...qm.QueryMod) assetTradeQuery {
+	mods = append(mods, qm.From("\"asset_trades\""))
+	return assetTradeQuery{NewQuery(mods...)}
+}
+
+// FindAssetTrade retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAssetTrade(exec boil.Executor, iD string, selectCols ...string) (*AssetTrade, error) {
+	assetTradeObj := &AssetTrade{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"asset_trades\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, assetTradeObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from asset_trades")
+	}
+
+	if err = assetTradeObj.doAfterSelectHooks(exec); err != nil {
+		return assetTradeObj, err
+	}
+
+	return assetTradeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AssetTrade) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no asset_trades provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(assetTradeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	assetTradeInsertCacheMut.RLock()
+	cache, cached := assetTradeInsertCache[key]
+	assetTradeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			assetTradeAllColumns,
+			assetTradeColumnsWithDefault,
+			assetTradeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(assetTradeType, assetTradeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(assetTradeType, assetTradeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"asset_trades\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"asset_trades\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into asset_trades")
+	}
+
+	if !cached {
+		assetTradeInsertCacheMut.Lock()
+		assetTradeInsertCache[key] = cache
+		assetTradeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the AssetTrade.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AssetTrade) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	assetTradeUpdateCacheMut.RLock()
+	cache, cached := assetTradeUpdateCache[key]
+	assetTradeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			assetTradeAllColumns,
+			assetTradePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update asset_trades, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"asset_trades\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, assetTradePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(assetTradeType, assetTradeMapping, append(wl, assetTradePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update asset_trades row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for asset_trades")
+	}
+
+	if !cached {
+		assetTradeUpdateCacheMut.Lock()
+		assetTradeUpdateCache[key] = cache
+		assetTradeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q assetTradeQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for asset_trades")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for asset_trades")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AssetTradeSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetTradePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"asset_trades\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, assetTradePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in assetTrade slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all assetTrade")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AssetTrade) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no asset_trades provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(assetTradeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	assetTradeUpsertCacheMut.RLock()
+	cache, cached := assetTradeUpsertCache[key]
+	assetTradeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			assetTradeAllColumns,
+			assetTradeColumnsWithDefault,
+			assetTradeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			assetTradeAllColumns,
+			assetTradePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert asset_trades, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(assetTradePrimaryKeyColumns))
+			copy(conflict, assetTradePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"asset_trades\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(assetTradeType, assetTradeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(assetTradeType, assetTradeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert asset_trades")
+	}
+
+	if !cached {
+		assetTradeUpsertCacheMut.Lock()
+		assetTradeUpsertCache[key] = cache
+		assetTradeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single AssetTrade record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AssetTrade) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no AssetTrade provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), assetTradePrimaryKeyMapping)
+	sql := "DELETE FROM \"asset_trades\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from asset_trades")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for asset_trades")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q assetTradeQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no assetTradeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from asset_trades")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for asset_trades")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AssetTradeSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(assetTradeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetTradePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"asset_trades\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, assetTradePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from assetTrade slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for asset_trades")
+	}
+
+	if len(assetTradeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AssetTrade) Reload(exec boil.Executor) error {
+	ret, err := FindAssetTrade(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AssetTradeSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AssetTradeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetTradePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"asset_trades\".* FROM \"asset_trades\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, assetTradePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in AssetTradeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AssetTradeExists checks if the AssetTrade row exists.
+func AssetTradeExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"asset_trades\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if asset_trades exists")
+	}
+
+	return exists, nil
+}
@@ -31,6 +31,7 @@ type AssetTransferEvent struct {
 	InitiatedFrom string      `boiler:"initiated_from" boil:"initiated_from" json:"initiated_from" toml:"initiated_from" yaml:"initiated_from"`
 	TransferTXID  null.String `boiler:"transfer_tx_id" boil:"transfer_tx_id" json:"transfer_tx_id,omitempty" toml:"transfer_tx_id" yaml:"transfer_tx_id,omitempty"`
 	TransferredAt time.Time   `boiler:"transferred_at" boil:"transferred_at" json:"transferred_at" toml:"transferred_at" yaml:"transferred_at"`
+	AvatarCleared bool        `boiler:"avatar_cleared" boil:"avatar_cleared" json:"avatar_cleared" toml:"avatar_cleared" yaml:"avatar_cleared"`
 
 	R *assetTransferEventR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L assetTransferEventL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -45,6 +46,7 @@ var AssetTransferEventColumns = struct {
 	InitiatedFrom string
 	TransferTXID  string
 	TransferredAt string
+	AvatarCleared string
 }{
 	ID:            "id",
 	UserAssetID:   "user_asset_id",
@@ -54,6 +56,7 @@ var AssetTransferEventColumns = struct {
 	InitiatedFrom: "initiated_from",
 	TransferTXID:  "transfer_tx_id",
 	TransferredAt: "transferred_at",
+	AvatarCleared: "avatar_cleared",
 }
 
 var AssetTransferEventTableColumns = struct {
@@ -65,6 +68,7 @@ var AssetTransferEventTableColumns = struct {
 	InitiatedFrom string
 	TransferTXID  string
 	TransferredAt string
+	AvatarCleared string
 }{
 	ID:            "asset_transfer_events.id",
 	UserAssetID:   "asset_transfer_events.user_asset_id",
@@ -74,10 +78,20 @@ var AssetTransferEventTableColumns = struct {
 	InitiatedFrom: "asset_transfer_events.initiated_from",
 	TransferTXID:  "asset_transfer_events.transfer_tx_id",
 	TransferredAt: "asset_transfer_events.transferred_at",
+	AvatarCleared: "asset_transfer_events.avatar_cleared",
 }
 
 // Generated where
 
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
 var AssetTransferEventWhere = struct {
 	ID            whereHelperint64
 	UserAssetID   whereHelperstring
@@ -87,6 +101,7 @@ var AssetTransferEventWhere = struct {
 	InitiatedFrom whereHelperstring
 	TransferTXID  whereHelpernull_String
 	TransferredAt whereHelpertime_Time
+	AvatarCleared whereHelperbool
 }{
 	ID:            whereHelperint64{field: "\"asset_transfer_events\".\"id\""},
 	UserAssetID:   whereHelperstring{field: "\"asset_transfer_events\".\"user_asset_id\""},
@@ -96,27 +111,31 @@ var AssetTransferEventWhere = struct {
 	InitiatedFrom: whereHelperstring{field: "\"asset_transfer_events\".\"initiated_from\""},
 	TransferTXID:  whereHelpernull_String{field: "\"asset_transfer_events\".\"transfer_tx_id\""},
 	TransferredAt: whereHelpertime_Time{field: "\"asset_transfer_events\".\"transferred_at\""},
+	AvatarCleared: whereHelperbool{field: "\"asset_transfer_events\".\"avatar_cleared\""},
 }
 
 // AssetTransferEventRels is where relationship names are stored.
 var AssetTransferEventRels = struct {
-	FromUser               string
-	ToUser                 string
-	UserAssetHashUserAsset string
-	UserAsset              string
+	FromUser                            string
+	ToUser                              string
+	UserAssetHashUserAsset              string
+	UserAsset                           string
+	TransferEventSyndicateAssetTransfer string
 }{
-	FromUser:               "FromUser",
-	ToUser:                 "ToUser",
-	UserAssetHashUserAsset: "UserAssetHashUserAsset",
-	UserAsset:              "UserAsset",
+	FromUser:                            "FromUser",
+	ToUser:                              "ToUser",
+	UserAssetHashUserAsset:              "UserAssetHashUserAsset",
+	UserAsset:                           "UserAsset",
+	TransferEventSyndicateAssetTransfer: "TransferEventSyndicateAssetTransfer",
 }
 
 // assetTransferEventR is where relationships are stored.
 type assetTransferEventR struct {
-	FromUser               *User      `boiler:"FromUser" boil:"FromUser" json:"FromUser" toml:"FromUser" yaml:"FromUser"`
-	ToUser                 *User      `boiler:"ToUser" boil:"ToUser" json:"ToUser" toml:"ToUser" yaml:"ToUser"`
-	UserAssetHashUserAsset *UserAsset `boiler:"UserAssetHashUserAsset" boil:"UserAssetHashUserAsset" json:"UserAssetHashUserAsset" toml:"UserAssetHashUserAsset" yaml:"UserAssetHashUserAsset"`
-	UserAsset              *UserAsset `boiler:"UserAsset" boil:"UserAsset" json:"UserAsset" toml:"UserAsset" yaml:"UserAsset"`
+	FromUser                            *User                   `boiler:"FromUser" boil:"FromUser" json:"FromUser" toml:"FromUser" yaml:"FromUser"`
+	ToUser                              *User                   `boiler:"ToUser" boil:"ToUser" json:"ToUser" toml:"ToUser" yaml:"ToUser"`
+	UserAssetHashUserAsset              *UserAsset              `boiler:"UserAssetHashUserAsset" boil:"UserAssetHashUserAsset" json:"UserAssetHashUserAsset" toml:"UserAssetHashUserAsset" yaml:"UserAssetHashUserAsset"`
+	UserAsset                           *UserAsset              `boiler:"UserAsset" boil:"UserAsset" json:"UserAsset" toml:"UserAsset" yaml:"UserAsset"`
+	TransferEventSyndicateAssetTransfer *SyndicateAssetTransfer `boiler:"TransferEventSyndicateAssetTransfer" boil:"TransferEventSyndicateAssetTransfer" json:"TransferEventSyndicateAssetTransfer" toml:"TransferEventSyndicateAssetTransfer" yaml:"TransferEventSyndicateAssetTransfer"`
 }
 
 // NewStruct creates a new relationship struct
@@ -128,9 +147,9 @@ func (*assetTransferEventR) NewStruct() *assetTransferEventR {
 type assetTransferEventL struct{}
 
 var (
-	assetTransferEventAllColumns            = []string{"id", "user_asset_id", "user_asset_hash", "from_user_id", "to_user_id", "initiated_from", "transfer_tx_id", "transferred_at"}
+	assetTransferEventAllColumns            = []string{"id", "user_asset_id", "user_asset_hash", "from_user_id", "to_user_id", "initiated_from", "transfer_tx_id", "transferred_at", "avatar_cleared"}
 	assetTransferEventColumnsWithoutDefault = []string{"user_asset_id", "user_asset_hash", "from_user_id", "to_user_id"}
-	assetTransferEventColumnsWithDefault    = []string{"id", "initiated_from", "transfer_tx_id", "transferred_at"}
+	assetTransferEventColumnsWithDefault    = []string{"id", "initiated_from", "transfer_tx_id", "transferred_at", "avatar_cleared"}
 	assetTransferEventPrimaryKeyColumns     = []string{"id"}
 	assetTransferEventGeneratedColumns      = []string{}
 )
@@ -437,6 +456,20 @@ func (o *AssetTransferEvent) UserAsset(mods ...qm.QueryMod) userAssetQuery {
 	return query
 }
 
+// TransferEventSyndicateAssetTransfer pointed to by the foreign key.
+func (o *AssetTransferEvent) TransferEventSyndicateAssetTransfer(mods ...qm.QueryMod) syndicateAssetTransferQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"transfer_event_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := SyndicateAssetTransfers(queryMods...)
+	queries.SetFrom(query.Query, "\"syndicate_asset_transfers\"")
+
+	return query
+}
+
 // LoadFromUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (assetTransferEventL) LoadFromUser(e boil.Executor, singular bool, maybeAssetTransferEvent interface{}, mods queries.Applicator) error {
@@ -857,6 +890,107 @@ func (assetTransferEventL) LoadUserAsset(e boil.Executor, singular bool, maybeAs
 	return nil
 }
 
+// LoadTransferEventSyndicateAssetTransfer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (assetTransferEventL) LoadTransferEventSyndicateAssetTransfer(e boil.Executor, singular bool, maybeAssetTransferEvent interface{}, mods queries.Applicator) error {
+	var slice []*AssetTransferEvent
+	var object *AssetTransferEvent
+
+	if singular {
+		object = maybeAssetTransferEvent.(*AssetTransferEvent)
+	} else {
+		slice = *maybeAssetTransferEvent.(*[]*AssetTransferEvent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &assetTransferEventR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetTransferEventR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`syndicate_asset_transfers`),
+		qm.WhereIn(`syndicate_asset_transfers.transfer_event_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load SyndicateAssetTransfer")
+	}
+
+	var resultSlice []*SyndicateAssetTransfer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice SyndicateAssetTransfer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for syndicate_asset_transfers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for syndicate_asset_transfers")
+	}
+
+	if len(assetTransferEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TransferEventSyndicateAssetTransfer = foreign
+		if foreign.R == nil {
+			foreign.R = &syndicateAssetTransferR{}
+		}
+		foreign.R.TransferEvent = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.TransferEventID {
+				local.R.TransferEventSyndicateAssetTransfer = foreign
+				if foreign.R == nil {
+					foreign.R = &syndicateAssetTransferR{}
+				}
+				foreign.R.TransferEvent = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetFromUser of the assetTransferEvent to the related item.
 // Sets o.R.FromUser to related.
 // Adds o to related.R.FromUserAssetTransferEvents.
@@ -1041,6 +1175,56 @@ func (o *AssetTransferEvent) SetUserAsset(exec boil.Executor, insert bool, relat
 	return nil
 }
 
+// SetTransferEventSyndicateAssetTransfer of the assetTransferEvent to the related item.
+// Sets o.R.TransferEventSyndicateAssetTransfer to related.
+// Adds o to related.R.TransferEvent.
+func (o *AssetTransferEvent) SetTransferEventSyndicateAssetTransfer(exec boil.Executor, insert bool, related *SyndicateAssetTransfer) error {
+	var err error
+
+	if insert {
+		related.TransferEventID = o.ID
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"syndicate_asset_transfers\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"transfer_event_id"}),
+			strmangle.WhereClause("\"", "\"", 2, syndicateAssetTransferPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.TransferEventID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.TransferEventID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &assetTransferEventR{
+			TransferEventSyndicateAssetTransfer: related,
+		}
+	} else {
+		o.R.TransferEventSyndicateAssetTransfer = related
+	}
+
+	if related.R == nil {
+		related.R = &syndicateAssetTransferR{
+			TransferEvent: o,
+		}
+	} else {
+		related.R.TransferEvent = o
+	}
+	return nil
+}
+
 // AssetTransferEvents retrieves all the records using an executor.
 func AssetTransferEvents(mods ...qm.QueryMod) assetTransferEventQuery {
 	mods = append(mods, qm.From("\"asset_transfer_events\""))
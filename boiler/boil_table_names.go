@@ -7,80 +7,140 @@ var TableNames = struct {
 	Accounts                       string
 	APIKeys                        string
 	Asset1155ServiceTransferEvents string
+	Asset1155TransferEvents        string
+	AssetMetadataRefreshes         string
+	AssetRentals                   string
+	AssetServiceLocks              string
 	AssetServiceTransferEvents     string
+	AssetTradeItems                string
+	AssetTrades                    string
 	AssetTransferEvents            string
 	Blobs                          string
 	BlockWithdraw                  string
+	CollectionSyncCursors          string
 	Collections                    string
+	CraftingEventItems             string
+	CraftingEvents                 string
+	CraftingRecipeItems            string
+	CraftingRecipes                string
 	DeathAddresses                 string
 	DepositAsset1155Transactions   string
 	DepositTransactions            string
+	ExchangeRates                  string
 	Factions                       string
 	FailedTransactions             string
 	FingerprintIps                 string
 	Fingerprints                   string
+	HeldPurchases                  string
+	HolderSnapshotEntries          string
+	HolderSnapshots                string
 	IssueTokens                    string
 	ItemOnchainTransactions        string
 	KV                             string
+	MarketplaceBids                string
+	MarketplaceListings            string
+	NFTOwners                      string
+	NFTOwnershipChanges            string
 	PasswordHashes                 string
 	Pending1155Rollback            string
 	PendingRefund                  string
+	PendingWithdrawActions         string
+	PurchaseExchangeRates          string
 	PurchasedItemsOld              string
+	ReconciliationDiscrepancies    string
+	ReconciliationRuns             string
 	Roles                          string
 	SaftAgreements                 string
 	SchemaMigrations               string
 	State                          string
 	StoreItems                     string
+	SyndicateAssetTransfers        string
+	SyndicateMembers               string
 	Syndicates                     string
 	Transactions                   string
 	TransactionsOld                string
 	UserActivities                 string
+	UserAssetMetadataVersions      string
 	UserAssetOnChainStatus         string
+	UserAssetOnChainStatusHistory  string
 	UserAssets                     string
 	UserAssets1155                 string
 	UserFingerprints               string
 	UserRecoveryCodes              string
 	UsernameHistory                string
 	Users                          string
+	VestingCohortMembers           string
+	VestingSchedules               string
+	VestingUnlockEvents            string
 	WhitelistedAddresses           string
 }{
 	Accounts:                       "accounts",
 	APIKeys:                        "api_keys",
 	Asset1155ServiceTransferEvents: "asset1155_service_transfer_events",
+	Asset1155TransferEvents:        "asset1155_transfer_events",
+	AssetMetadataRefreshes:         "asset_metadata_refreshes",
+	AssetRentals:                   "asset_rentals",
+	AssetServiceLocks:              "asset_service_locks",
 	AssetServiceTransferEvents:     "asset_service_transfer_events",
+	AssetTradeItems:                "asset_trade_items",
+	AssetTrades:                    "asset_trades",
 	AssetTransferEvents:            "asset_transfer_events",
 	Blobs:                          "blobs",
 	BlockWithdraw:                  "block_withdraw",
+	CollectionSyncCursors:          "collection_sync_cursors",
 	Collections:                    "collections",
+	CraftingEventItems:             "crafting_event_items",
+	CraftingEvents:                 "crafting_events",
+	CraftingRecipeItems:            "crafting_recipe_items",
+	CraftingRecipes:                "crafting_recipes",
 	DeathAddresses:                 "death_addresses",
 	DepositAsset1155Transactions:   "deposit_asset1155_transactions",
 	DepositTransactions:            "deposit_transactions",
+	ExchangeRates:                  "exchange_rates",
 	Factions:                       "factions",
 	FailedTransactions:             "failed_transactions",
 	FingerprintIps:                 "fingerprint_ips",
 	Fingerprints:                   "fingerprints",
+	HeldPurchases:                  "held_purchases",
+	HolderSnapshotEntries:          "holder_snapshot_entries",
+	HolderSnapshots:                "holder_snapshots",
 	IssueTokens:                    "issue_tokens",
 	ItemOnchainTransactions:        "item_onchain_transactions",
 	KV:                             "kv",
+	MarketplaceBids:                "marketplace_bids",
+	MarketplaceListings:            "marketplace_listings",
+	NFTOwners:                      "nft_owners",
+	NFTOwnershipChanges:            "nft_ownership_changes",
 	PasswordHashes:                 "password_hashes",
 	Pending1155Rollback:            "pending_1155_rollback",
 	PendingRefund:                  "pending_refund",
+	PendingWithdrawActions:         "pending_withdraw_actions",
+	PurchaseExchangeRates:          "purchase_exchange_rates",
 	PurchasedItemsOld:              "purchased_items_old",
+	ReconciliationDiscrepancies:    "reconciliation_discrepancies",
+	ReconciliationRuns:             "reconciliation_runs",
 	Roles:                          "roles",
 	SaftAgreements:                 "saft_agreements",
 	SchemaMigrations:               "schema_migrations",
 	State:                          "state",
 	StoreItems:                     "store_items",
+	SyndicateAssetTransfers:        "syndicate_asset_transfers",
+	SyndicateMembers:               "syndicate_members",
 	Syndicates:                     "syndicates",
 	Transactions:                   "transactions",
 	TransactionsOld:                "transactions_old",
 	UserActivities:                 "user_activities",
+	UserAssetMetadataVersions:      "user_asset_metadata_versions",
 	UserAssetOnChainStatus:         "user_asset_on_chain_status",
+	UserAssetOnChainStatusHistory:  "user_asset_on_chain_status_history",
 	UserAssets:                     "user_assets",
 	UserAssets1155:                 "user_assets_1155",
 	UserFingerprints:               "user_fingerprints",
 	UserRecoveryCodes:              "user_recovery_codes",
 	UsernameHistory:                "username_history",
 	Users:                          "users",
+	VestingCohortMembers:           "vesting_cohort_members",
+	VestingSchedules:               "vesting_schedules",
+	VestingUnlockEvents:            "vesting_unlock_events",
 	WhitelistedAddresses:           "whitelisted_addresses",
 }
@@ -0,0 +1,1037 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// CollectionSyncCursor is an object representing the database table.
+type CollectionSyncCursor struct {
+	CollectionID string    `boiler:"collection_id" boil:"collection_id" json:"collection_id" toml:"collection_id" yaml:"collection_id"`
+	CursorType   string    `boiler:"cursor_type" boil:"cursor_type" json:"cursor_type" toml:"cursor_type" yaml:"cursor_type"`
+	BlockNumber  int64     `boiler:"block_number" boil:"block_number" json:"block_number" toml:"block_number" yaml:"block_number"`
+	UpdatedAt    time.Time `boiler:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *collectionSyncCursorR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L collectionSyncCursorL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CollectionSyncCursorColumns = struct {
+	CollectionID string
+	CursorType   string
+	BlockNumber  string
+	UpdatedAt    string
+}{
+	CollectionID: "collection_id",
+	CursorType:   "cursor_type",
+	BlockNumber:  "block_number",
+	UpdatedAt:    "updated_at",
+}
+
+var CollectionSyncCursorTableColumns = struct {
+	CollectionID string
+	CursorType   string
+	BlockNumber  string
+	UpdatedAt    string
+}{
+	CollectionID: "collection_sync_cursors.collection_id",
+	CursorType:   "collection_sync_cursors.cursor_type",
+	BlockNumber:  "collection_sync_cursors.block_number",
+	UpdatedAt:    "collection_sync_cursors.updated_at",
+}
+
+// Generated where
+
+var CollectionSyncCursorWhere = struct {
+	CollectionID whereHelperstring
+	CursorType   whereHelperstring
+	BlockNumber  whereHelperint64
+	UpdatedAt    whereHelpertime_Time
+}{
+	CollectionID: whereHelperstring{field: "\"collection_sync_cursors\".\"collection_id\""},
+	CursorType:   whereHelperstring{field: "\"collection_sync_cursors\".\"cursor_type\""},
+	BlockNumber:  whereHelperint64{field: "\"collection_sync_cursors\".\"block_number\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"collection_sync_cursors\".\"updated_at\""},
+}
+
+// CollectionSyncCursorRels is where relationship names are stored.
+var CollectionSyncCursorRels = struct {
+	Collection string
+}{
+	Collection: "Collection",
+}
+
+// collectionSyncCursorR is where relationships are stored.
+type collectionSyncCursorR struct {
+	Collection *Collection `boiler:"Collection" boil:"Collection" json:"Collection" toml:"Collection" yaml:"Collection"`
+}
+
+// NewStruct creates a new relationship struct
+func (*collectionSyncCursorR) NewStruct() *collectionSyncCursorR {
+	return &collectionSyncCursorR{}
+}
+
+// collectionSyncCursorL is where Load methods for each relationship are stored.
+type collectionSyncCursorL struct{}
+
+var (
+	collectionSyncCursorAllColumns            = []string{"collection_id", "cursor_type", "block_number", "updated_at"}
+	collectionSyncCursorColumnsWithoutDefault = []string{"collection_id", "cursor_type"}
+	collectionSyncCursorColumnsWithDefault    = []string{"block_number", "updated_at"}
+	collectionSyncCursorPrimaryKeyColumns     = []string{"collection_id", "cursor_type"}
+	collectionSyncCursorGeneratedColumns      = []string{}
+)
+
+type (
+	// CollectionSyncCursorSlice is an alias for a slice of pointers to CollectionSyncCursor.
+	// This should almost always be used instead of []CollectionSyncCursor.
+	CollectionSyncCursorSlice []*CollectionSyncCursor
+	// CollectionSyncCursorHook is the signature for custom CollectionSyncCursor hook methods
+	CollectionSyncCursorHook func(boil.Executor, *CollectionSyncCursor) error
+
+	collectionSyncCursorQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	collectionSyncCursorType                 = reflect.TypeOf(&CollectionSyncCursor{})
+	collectionSyncCursorMapping              = queries.MakeStructMapping(collectionSyncCursorType)
+	collectionSyncCursorPrimaryKeyMapping, _ = queries.BindMapping(collectionSyncCursorType, collectionSyncCursorMapping, collectionSyncCursorPrimaryKeyColumns)
+	collectionSyncCursorInsertCacheMut       sync.RWMutex
+	collectionSyncCursorInsertCache          = make(map[string]insertCache)
+	collectionSyncCursorUpdateCacheMut       sync.RWMutex
+	collectionSyncCursorUpdateCache          = make(map[string]updateCache)
+	collectionSyncCursorUpsertCacheMut       sync.RWMutex
+	collectionSyncCursorUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var collectionSyncCursorAfterSelectHooks []CollectionSyncCursorHook
+
+var collectionSyncCursorBeforeInsertHooks []CollectionSyncCursorHook
+var collectionSyncCursorAfterInsertHooks []CollectionSyncCursorHook
+
+var collectionSyncCursorBeforeUpdateHooks []CollectionSyncCursorHook
+var collectionSyncCursorAfterUpdateHooks []CollectionSyncCursorHook
+
+var collectionSyncCursorBeforeDeleteHooks []CollectionSyncCursorHook
+var collectionSyncCursorAfterDeleteHooks []CollectionSyncCursorHook
+
+var collectionSyncCursorBeforeUpsertHooks []CollectionSyncCursorHook
+var collectionSyncCursorAfterUpsertHooks []CollectionSyncCursorHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CollectionSyncCursor) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range collectionSyncCursorAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CollectionSyncCursor) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range collectionSyncCursorBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CollectionSyncCursor) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range collectionSyncCursorAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CollectionSyncCursor) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range collectionSyncCursorBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CollectionSyncCursor) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range collectionSyncCursorAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CollectionSyncCursor) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range collectionSyncCursorBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CollectionSyncCursor) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range collectionSyncCursorAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CollectionSyncCursor) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range collectionSyncCursorBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CollectionSyncCursor) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range collectionSyncCursorAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCollectionSyncCursorHook registers your hook function for all future operations.
+func AddCollectionSyncCursorHook(hookPoint boil.HookPoint, collectionSyncCursorHook CollectionSyncCursorHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		collectionSyncCursorAfterSelectHooks = append(collectionSyncCursorAfterSelectHooks, collectionSyncCursorHook)
+	case boil.BeforeInsertHook:
+		collectionSyncCursorBeforeInsertHooks = append(collectionSyncCursorBeforeInsertHooks, collectionSyncCursorHook)
+	case boil.AfterInsertHook:
+		collectionSyncCursorAfterInsertHooks = append(collectionSyncCursorAfterInsertHooks, collectionSyncCursorHook)
+	case boil.BeforeUpdateHook:
+		collectionSyncCursorBeforeUpdateHooks = append(collectionSyncCursorBeforeUpdateHooks, collectionSyncCursorHook)
+	case boil.AfterUpdateHook:
+		collectionSyncCursorAfterUpdateHooks = append(collectionSyncCursorAfterUpdateHooks, collectionSyncCursorHook)
+	case boil.BeforeDeleteHook:
+		collectionSyncCursorBeforeDeleteHooks = append(collectionSyncCursorBeforeDeleteHooks, collectionSyncCursorHook)
+	case boil.AfterDeleteHook:
+		collectionSyncCursorAfterDeleteHooks = append(collectionSyncCursorAfterDeleteHooks, collectionSyncCursorHook)
+	case boil.BeforeUpsertHook:
+		collectionSyncCursorBeforeUpsertHooks = append(collectionSyncCursorBeforeUpsertHooks, collectionSyncCursorHook)
+	case boil.AfterUpsertHook:
+		collectionSyncCursorAfterUpsertHooks = append(collectionSyncCursorAfterUpsertHooks, collectionSyncCursorHook)
+	}
+}
+
+// One returns a single collectionSyncCursor record from the query.
+func (q collectionSyncCursorQuery) One(exec boil.Executor) (*CollectionSyncCursor, error) {
+	o := &CollectionSyncCursor{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for collection_sync_cursors")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CollectionSyncCursor records from the query.
+func (q collectionSyncCursorQuery) All(exec boil.Executor) (CollectionSyncCursorSlice, error) {
+	var o []*CollectionSyncCursor
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to CollectionSyncCursor slice")
+	}
+
+	if len(collectionSyncCursorAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CollectionSyncCursor records in the query.
+func (q collectionSyncCursorQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count collection_sync_cursors rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q collectionSyncCursorQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if collection_sync_cursors exists")
+	}
+
+	return count > 0, nil
+}
+
+// Collection pointed to by the foreign key.
+func (o *CollectionSyncCursor) Collection(mods ...qm.QueryMod) collectionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CollectionID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Collections(queryMods...)
+	queries.SetFrom(query.Query, "\"collections\"")
+
+	return query
+}
+
+// LoadCollection allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (collectionSyncCursorL) LoadCollection(e boil.Executor, singular bool, maybeCollectionSyncCursor interface{}, mods queries.Applicator) error {
+	var slice []*CollectionSyncCursor
+	var object *CollectionSyncCursor
+
+	if singular {
+		object = maybeCollectionSyncCursor.(*CollectionSyncCursor)
+	} else {
+		slice = *maybeCollectionSyncCursor.(*[]*CollectionSyncCursor)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &collectionSyncCursorR{}
+		}
+		args = append(args, object.CollectionID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collectionSyncCursorR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CollectionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CollectionID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`collections`),
+		qm.WhereIn(`collections.id in ?`, args...),
+		qmhelper.WhereIsNull(`collections.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Collection")
+	}
+
+	var resultSlice []*Collection
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Collection")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for collections")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collections")
+	}
+
+	if len(collectionSyncCursorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Collection = foreign
+		if foreign.R == nil {
+			foreign.R = &collectionR{}
+		}
+		foreign.R.CollectionSyncCursors = append(foreign.R.CollectionSyncCursors, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CollectionID == foreign.ID {
+				local.R.Collection = foreign
+				if foreign.R == nil {
+					foreign.R = &collectionR{}
+				}
+				foreign.R.CollectionSyncCursors = append(foreign.R.CollectionSyncCursors, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCollection of the collectionSyncCursor to the related item.
+// Sets o.R.Collection to related.
+// Adds o to related.R.CollectionSyncCursors.
+func (o *CollectionSyncCursor) SetCollection(exec boil.Executor, insert bool, related *Collection) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"collection_sync_cursors\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+		strmangle.WhereClause("\"", "\"", 2, collectionSyncCursorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.CollectionID, o.CursorType}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CollectionID = related.ID
+	if o.R == nil {
+		o.R = &collectionSyncCursorR{
+			Collection: related,
+		}
+	} else {
+		o.R.Collection = related
+	}
+
+	if related.R == nil {
+		related.R = &collectionR{
+			CollectionSyncCursors: CollectionSyncCursorSlice{o},
+		}
+	} else {
+		related.R.CollectionSyncCursors = append(related.R.CollectionSyncCursors, o)
+	}
+
+	return nil
+}
+
+// CollectionSyncCursors retrieves all the records using an executor.
+func CollectionSyncCursors(mods ...qm.QueryMod) collectionSyncCursorQuery {
+	mods = append(mods, qm.From("\"collection_sync_cursors\""))
+	return collectionSyncCursorQuery{NewQuery(mods...)}
+}
+
+// FindCollectionSyncCursor retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCollectionSyncCursor(exec boil.Executor, collectionID string, cursorType string, selectCols ...string) (*CollectionSyncCursor, error) {
+	collectionSyncCursorObj := &CollectionSyncCursor{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"collection_sync_cursors\" where \"collection_id\"=$1 AND \"cursor_type\"=$2", sel,
+	)
+
+	q := queries.Raw(query, collectionID, cursorType)
+
+	err := q.Bind(nil, exec, collectionSyncCursorObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from collection_sync_cursors")
+	}
+
+	if err = collectionSyncCursorObj.doAfterSelectHooks(exec); err != nil {
+		return collectionSyncCursorObj, err
+	}
+
+	return collectionSyncCursorObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CollectionSyncCursor) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no collection_sync_cursors provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(collectionSyncCursorColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	collectionSyncCursorInsertCacheMut.RLock()
+	cache, cached := collectionSyncCursorInsertCache[key]
+	collectionSyncCursorInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			collectionSyncCursorAllColumns,
+			collectionSyncCursorColumnsWithDefault,
+			collectionSyncCursorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(collectionSyncCursorType, collectionSyncCursorMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(collectionSyncCursorType, collectionSyncCursorMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"collection_sync_cursors\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"collection_sync_cursors\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into collection_sync_cursors")
+	}
+
+	if !cached {
+		collectionSyncCursorInsertCacheMut.Lock()
+		collectionSyncCursorInsertCache[key] = cache
+		collectionSyncCursorInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the CollectionSyncCursor.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CollectionSyncCursor) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	collectionSyncCursorUpdateCacheMut.RLock()
+	cache, cached := collectionSyncCursorUpdateCache[key]
+	collectionSyncCursorUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			collectionSyncCursorAllColumns,
+			collectionSyncCursorPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update collection_sync_cursors, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"collection_sync_cursors\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, collectionSyncCursorPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(collectionSyncCursorType, collectionSyncCursorMapping, append(wl, collectionSyncCursorPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update collection_sync_cursors row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for collection_sync_cursors")
+	}
+
+	if !cached {
+		collectionSyncCursorUpdateCacheMut.Lock()
+		collectionSyncCursorUpdateCache[key] = cache
+		collectionSyncCursorUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q collectionSyncCursorQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for collection_sync_cursors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for collection_sync_cursors")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CollectionSyncCursorSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), collectionSyncCursorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"collection_sync_cursors\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, collectionSyncCursorPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in collectionSyncCursor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all collectionSyncCursor")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CollectionSyncCursor) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no collection_sync_cursors provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(collectionSyncCursorColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	collectionSyncCursorUpsertCacheMut.RLock()
+	cache, cached := collectionSyncCursorUpsertCache[key]
+	collectionSyncCursorUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			collectionSyncCursorAllColumns,
+			collectionSyncCursorColumnsWithDefault,
+			collectionSyncCursorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			collectionSyncCursorAllColumns,
+			collectionSyncCursorPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert collection_sync_cursors, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(collectionSyncCursorPrimaryKeyColumns))
+			copy(conflict, collectionSyncCursorPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"collection_sync_cursors\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(collectionSyncCursorType, collectionSyncCursorMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(collectionSyncCursorType, collectionSyncCursorMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert collection_sync_cursors")
+	}
+
+	if !cached {
+		collectionSyncCursorUpsertCacheMut.Lock()
+		collectionSyncCursorUpsertCache[key] = cache
+		collectionSyncCursorUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single CollectionSyncCursor record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CollectionSyncCursor) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no CollectionSyncCursor provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), collectionSyncCursorPrimaryKeyMapping)
+	sql := "DELETE FROM \"collection_sync_cursors\" WHERE \"collection_id\"=$1 AND \"cursor_type\"=$2"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from collection_sync_cursors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for collection_sync_cursors")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q collectionSyncCursorQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no collectionSyncCursorQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from collection_sync_cursors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for collection_sync_cursors")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CollectionSyncCursorSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(collectionSyncCursorBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), collectionSyncCursorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"collection_sync_cursors\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, collectionSyncCursorPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from collectionSyncCursor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for collection_sync_cursors")
+	}
+
+	if len(collectionSyncCursorAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CollectionSyncCursor) Reload(exec boil.Executor) error {
+	ret, err := FindCollectionSyncCursor(exec, o.CollectionID, o.CursorType)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CollectionSyncCursorSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CollectionSyncCursorSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), collectionSyncCursorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"collection_sync_cursors\".* FROM \"collection_sync_cursors\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, collectionSyncCursorPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in CollectionSyncCursorSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CollectionSyncCursorExists checks if the CollectionSyncCursor row exists.
+func CollectionSyncCursorExists(exec boil.Executor, collectionID string, cursorType string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"collection_sync_cursors\" where \"collection_id\"=$1 AND \"cursor_type\"=$2 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, collectionID, cursorType)
+	}
+	row := exec.QueryRow(sql, collectionID, cursorType)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if collection_sync_cursors exists")
+	}
+
+	return exists, nil
+}
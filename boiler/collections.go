@@ -42,6 +42,10 @@ type Collection struct {
 	Description        null.String      `boiler:"description" boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
 	ExternalTokenIds   types.Int64Array `boiler:"external_token_ids" boil:"external_token_ids" json:"external_token_ids,omitempty" toml:"external_token_ids" yaml:"external_token_ids,omitempty"`
 	TransferContract   null.String      `boiler:"transfer_contract" boil:"transfer_contract" json:"transfer_contract,omitempty" toml:"transfer_contract" yaml:"transfer_contract,omitempty"`
+	ExternalLink       null.String      `boiler:"external_link" boil:"external_link" json:"external_link,omitempty" toml:"external_link" yaml:"external_link,omitempty"`
+	RoyaltyRecipient   null.String      `boiler:"royalty_recipient" boil:"royalty_recipient" json:"royalty_recipient,omitempty" toml:"royalty_recipient" yaml:"royalty_recipient,omitempty"`
+	RoyaltyBasisPoints int              `boiler:"royalty_basis_points" boil:"royalty_basis_points" json:"royalty_basis_points" toml:"royalty_basis_points" yaml:"royalty_basis_points"`
+	Chain              null.String      `boiler:"chain" boil:"chain" json:"chain,omitempty" toml:"chain" yaml:"chain,omitempty"`
 
 	R *collectionR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L collectionL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -66,6 +70,10 @@ var CollectionColumns = struct {
 	Description        string
 	ExternalTokenIds   string
 	TransferContract   string
+	ExternalLink       string
+	RoyaltyRecipient   string
+	RoyaltyBasisPoints string
+	Chain              string
 }{
 	ID:                 "id",
 	Name:               "name",
@@ -85,6 +93,10 @@ var CollectionColumns = struct {
 	Description:        "description",
 	ExternalTokenIds:   "external_token_ids",
 	TransferContract:   "transfer_contract",
+	ExternalLink:       "external_link",
+	RoyaltyRecipient:   "royalty_recipient",
+	RoyaltyBasisPoints: "royalty_basis_points",
+	Chain:              "chain",
 }
 
 var CollectionTableColumns = struct {
@@ -106,6 +118,10 @@ var CollectionTableColumns = struct {
 	Description        string
 	ExternalTokenIds   string
 	TransferContract   string
+	ExternalLink       string
+	RoyaltyRecipient   string
+	RoyaltyBasisPoints string
+	Chain              string
 }{
 	ID:                 "collections.id",
 	Name:               "collections.name",
@@ -125,6 +141,10 @@ var CollectionTableColumns = struct {
 	Description:        "collections.description",
 	ExternalTokenIds:   "collections.external_token_ids",
 	TransferContract:   "collections.transfer_contract",
+	ExternalLink:       "collections.external_link",
+	RoyaltyRecipient:   "collections.royalty_recipient",
+	RoyaltyBasisPoints: "collections.royalty_basis_points",
+	Chain:              "collections.chain",
 }
 
 // Generated where
@@ -196,6 +216,10 @@ var CollectionWhere = struct {
 	Description        whereHelpernull_String
 	ExternalTokenIds   whereHelpertypes_Int64Array
 	TransferContract   whereHelpernull_String
+	ExternalLink       whereHelpernull_String
+	RoyaltyRecipient   whereHelpernull_String
+	RoyaltyBasisPoints whereHelperint
+	Chain              whereHelpernull_String
 }{
 	ID:                 whereHelperstring{field: "\"collections\".\"id\""},
 	Name:               whereHelperstring{field: "\"collections\".\"name\""},
@@ -215,36 +239,61 @@ var CollectionWhere = struct {
 	Description:        whereHelpernull_String{field: "\"collections\".\"description\""},
 	ExternalTokenIds:   whereHelpertypes_Int64Array{field: "\"collections\".\"external_token_ids\""},
 	TransferContract:   whereHelpernull_String{field: "\"collections\".\"transfer_contract\""},
+	ExternalLink:       whereHelpernull_String{field: "\"collections\".\"external_link\""},
+	RoyaltyRecipient:   whereHelpernull_String{field: "\"collections\".\"royalty_recipient\""},
+	RoyaltyBasisPoints: whereHelperint{field: "\"collections\".\"royalty_basis_points\""},
+	Chain:              whereHelpernull_String{field: "\"collections\".\"chain\""},
 }
 
 // CollectionRels is where relationship names are stored.
 var CollectionRels = struct {
-	LogoBlob                 string
-	ItemOnchainTransactions  string
-	PurchasedItemsOlds       string
-	StoreItems               string
-	UserAssetOnChainStatuses string
-	UserAssets               string
-	UserAssets1155S          string
+	LogoBlob                        string
+	Asset1155TransferEvents         string
+	CollectionSyncCursors           string
+	CraftingRecipeItems             string
+	HolderSnapshotEntries           string
+	ItemOnchainTransactions         string
+	NFTOwners                       string
+	NFTOwnershipChanges             string
+	PurchasedItemsOlds              string
+	StoreItems                      string
+	UserAssetOnChainStatuses        string
+	UserAssetOnChainStatusHistories string
+	UserAssets                      string
+	UserAssets1155S                 string
 }{
-	LogoBlob:                 "LogoBlob",
-	ItemOnchainTransactions:  "ItemOnchainTransactions",
-	PurchasedItemsOlds:       "PurchasedItemsOlds",
-	StoreItems:               "StoreItems",
-	UserAssetOnChainStatuses: "UserAssetOnChainStatuses",
-	UserAssets:               "UserAssets",
-	UserAssets1155S:          "UserAssets1155S",
+	LogoBlob:                        "LogoBlob",
+	Asset1155TransferEvents:         "Asset1155TransferEvents",
+	CollectionSyncCursors:           "CollectionSyncCursors",
+	CraftingRecipeItems:             "CraftingRecipeItems",
+	HolderSnapshotEntries:           "HolderSnapshotEntries",
+	ItemOnchainTransactions:         "ItemOnchainTransactions",
+	NFTOwners:                       "NFTOwners",
+	NFTOwnershipChanges:             "NFTOwnershipChanges",
+	PurchasedItemsOlds:              "PurchasedItemsOlds",
+	StoreItems:                      "StoreItems",
+	UserAssetOnChainStatuses:        "UserAssetOnChainStatuses",
+	UserAssetOnChainStatusHistories: "UserAssetOnChainStatusHistories",
+	UserAssets:                      "UserAssets",
+	UserAssets1155S:                 "UserAssets1155S",
 }
 
 // collectionR is where relationships are stored.
 type collectionR struct {
-	LogoBlob                 *Blob                       `boiler:"LogoBlob" boil:"LogoBlob" json:"LogoBlob" toml:"LogoBlob" yaml:"LogoBlob"`
-	ItemOnchainTransactions  ItemOnchainTransactionSlice `boiler:"ItemOnchainTransactions" boil:"ItemOnchainTransactions" json:"ItemOnchainTransactions" toml:"ItemOnchainTransactions" yaml:"ItemOnchainTransactions"`
-	PurchasedItemsOlds       PurchasedItemsOldSlice      `boiler:"PurchasedItemsOlds" boil:"PurchasedItemsOlds" json:"PurchasedItemsOlds" toml:"PurchasedItemsOlds" yaml:"PurchasedItemsOlds"`
-	StoreItems               StoreItemSlice              `boiler:"StoreItems" boil:"StoreItems" json:"StoreItems" toml:"StoreItems" yaml:"StoreItems"`
-	UserAssetOnChainStatuses UserAssetOnChainStatusSlice `boiler:"UserAssetOnChainStatuses" boil:"UserAssetOnChainStatuses" json:"UserAssetOnChainStatuses" toml:"UserAssetOnChainStatuses" yaml:"UserAssetOnChainStatuses"`
-	UserAssets               UserAssetSlice              `boiler:"UserAssets" boil:"UserAssets" json:"UserAssets" toml:"UserAssets" yaml:"UserAssets"`
-	UserAssets1155S          UserAssets1155Slice         `boiler:"UserAssets1155S" boil:"UserAssets1155S" json:"UserAssets1155S" toml:"UserAssets1155S" yaml:"UserAssets1155S"`
+	LogoBlob                        *Blob                              `boiler:"LogoBlob" boil:"LogoBlob" json:"LogoBlob" toml:"LogoBlob" yaml:"LogoBlob"`
+	Asset1155TransferEvents         Asset1155TransferEventSlice        `boiler:"Asset1155TransferEvents" boil:"Asset1155TransferEvents" json:"Asset1155TransferEvents" toml:"Asset1155TransferEvents" yaml:"Asset1155TransferEvents"`
+	CollectionSyncCursors           CollectionSyncCursorSlice          `boiler:"CollectionSyncCursors" boil:"CollectionSyncCursors" json:"CollectionSyncCursors" toml:"CollectionSyncCursors" yaml:"CollectionSyncCursors"`
+	CraftingRecipeItems             CraftingRecipeItemSlice            `boiler:"CraftingRecipeItems" boil:"CraftingRecipeItems" json:"CraftingRecipeItems" toml:"CraftingRecipeItems" yaml:"CraftingRecipeItems"`
+	HolderSnapshotEntries           HolderSnapshotEntrySlice           `boiler:"HolderSnapshotEntries" boil:"HolderSnapshotEntries" json:"HolderSnapshotEntries" toml:"HolderSnapshotEntries" yaml:"HolderSnapshotEntries"`
+	ItemOnchainTransactions         ItemOnchainTransactionSlice        `boiler:"ItemOnchainTransactions" boil:"ItemOnchainTransactions" json:"ItemOnchainTransactions" toml:"ItemOnchainTransactions" yaml:"ItemOnchainTransactions"`
+	NFTOwners                       NFTOwnerSlice                      `boiler:"NFTOwners" boil:"NFTOwners" json:"NFTOwners" toml:"NFTOwners" yaml:"NFTOwners"`
+	NFTOwnershipChanges             NFTOwnershipChangeSlice            `boiler:"NFTOwnershipChanges" boil:"NFTOwnershipChanges" json:"NFTOwnershipChanges" toml:"NFTOwnershipChanges" yaml:"NFTOwnershipChanges"`
+	PurchasedItemsOlds              PurchasedItemsOldSlice             `boiler:"PurchasedItemsOlds" boil:"PurchasedItemsOlds" json:"PurchasedItemsOlds" toml:"PurchasedItemsOlds" yaml:"PurchasedItemsOlds"`
+	StoreItems                      StoreItemSlice                     `boiler:"StoreItems" boil:"StoreItems" json:"StoreItems" toml:"StoreItems" yaml:"StoreItems"`
+	UserAssetOnChainStatuses        UserAssetOnChainStatusSlice        `boiler:"UserAssetOnChainStatuses" boil:"UserAssetOnChainStatuses" json:"UserAssetOnChainStatuses" toml:"UserAssetOnChainStatuses" yaml:"UserAssetOnChainStatuses"`
+	UserAssetOnChainStatusHistories UserAssetOnChainStatusHistorySlice `boiler:"UserAssetOnChainStatusHistories" boil:"UserAssetOnChainStatusHistories" json:"UserAssetOnChainStatusHistories" toml:"UserAssetOnChainStatusHistories" yaml:"UserAssetOnChainStatusHistories"`
+	UserAssets                      UserAssetSlice                     `boiler:"UserAssets" boil:"UserAssets" json:"UserAssets" toml:"UserAssets" yaml:"UserAssets"`
+	UserAssets1155S                 UserAssets1155Slice                `boiler:"UserAssets1155S" boil:"UserAssets1155S" json:"UserAssets1155S" toml:"UserAssets1155S" yaml:"UserAssets1155S"`
 }
 
 // NewStruct creates a new relationship struct
@@ -256,9 +305,9 @@ func (*collectionR) NewStruct() *collectionR {
 type collectionL struct{}
 
 var (
-	collectionAllColumns            = []string{"id", "name", "logo_blob_id", "keywords", "deleted_at", "updated_at", "created_at", "slug", "mint_contract", "stake_contract", "is_visible", "contract_type", "staking_contract_old", "background_url", "logo_url", "description", "external_token_ids", "transfer_contract"}
+	collectionAllColumns            = []string{"id", "name", "logo_blob_id", "keywords", "deleted_at", "updated_at", "created_at", "slug", "mint_contract", "stake_contract", "is_visible", "contract_type", "staking_contract_old", "background_url", "logo_url", "description", "external_token_ids", "transfer_contract", "external_link", "royalty_recipient", "royalty_basis_points", "chain"}
 	collectionColumnsWithoutDefault = []string{"name", "slug"}
-	collectionColumnsWithDefault    = []string{"id", "logo_blob_id", "keywords", "deleted_at", "updated_at", "created_at", "mint_contract", "stake_contract", "is_visible", "contract_type", "staking_contract_old", "background_url", "logo_url", "description", "external_token_ids", "transfer_contract"}
+	collectionColumnsWithDefault    = []string{"id", "logo_blob_id", "keywords", "deleted_at", "updated_at", "created_at", "mint_contract", "stake_contract", "is_visible", "contract_type", "staking_contract_old", "background_url", "logo_url", "description", "external_token_ids", "transfer_contract", "external_link", "royalty_recipient", "royalty_basis_points", "chain"}
 	collectionPrimaryKeyColumns     = []string{"id"}
 	collectionGeneratedColumns      = []string{}
 )
@@ -520,6 +569,90 @@ func (o *Collection) LogoBlob(mods ...qm.QueryMod) blobQuery {
 	return query
 }
 
+// Asset1155TransferEvents retrieves all the asset1155_transfer_event's Asset1155TransferEvents with an executor.
+func (o *Collection) Asset1155TransferEvents(mods ...qm.QueryMod) asset1155TransferEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"asset1155_transfer_events\".\"collection_id\"=?", o.ID),
+	)
+
+	query := Asset1155TransferEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"asset1155_transfer_events\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"asset1155_transfer_events\".*"})
+	}
+
+	return query
+}
+
+// CollectionSyncCursors retrieves all the collection_sync_cursor's CollectionSyncCursors with an executor.
+func (o *Collection) CollectionSyncCursors(mods ...qm.QueryMod) collectionSyncCursorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"collection_sync_cursors\".\"collection_id\"=?", o.ID),
+	)
+
+	query := CollectionSyncCursors(queryMods...)
+	queries.SetFrom(query.Query, "\"collection_sync_cursors\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"collection_sync_cursors\".*"})
+	}
+
+	return query
+}
+
+// CraftingRecipeItems retrieves all the crafting_recipe_item's CraftingRecipeItems with an executor.
+func (o *Collection) CraftingRecipeItems(mods ...qm.QueryMod) craftingRecipeItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"crafting_recipe_items\".\"collection_id\"=?", o.ID),
+	)
+
+	query := CraftingRecipeItems(queryMods...)
+	queries.SetFrom(query.Query, "\"crafting_recipe_items\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"crafting_recipe_items\".*"})
+	}
+
+	return query
+}
+
+// HolderSnapshotEntries retrieves all the holder_snapshot_entry's HolderSnapshotEntries with an executor.
+func (o *Collection) HolderSnapshotEntries(mods ...qm.QueryMod) holderSnapshotEntryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"holder_snapshot_entries\".\"collection_id\"=?", o.ID),
+	)
+
+	query := HolderSnapshotEntries(queryMods...)
+	queries.SetFrom(query.Query, "\"holder_snapshot_entries\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"holder_snapshot_entries\".*"})
+	}
+
+	return query
+}
+
 // ItemOnchainTransactions retrieves all the item_onchain_transaction's ItemOnchainTransactions with an executor.
 func (o *Collection) ItemOnchainTransactions(mods ...qm.QueryMod) itemOnchainTransactionQuery {
 	var queryMods []qm.QueryMod
@@ -542,6 +675,48 @@ func (o *Collection) ItemOnchainTransactions(mods ...qm.QueryMod) itemOnchainTra
 	return query
 }
 
+// NFTOwners retrieves all the nft_owner's NFTOwners with an executor.
+func (o *Collection) NFTOwners(mods ...qm.QueryMod) nftOwnerQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"nft_owners\".\"collection_id\"=?", o.ID),
+	)
+
+	query := NFTOwners(queryMods...)
+	queries.SetFrom(query.Query, "\"nft_owners\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"nft_owners\".*"})
+	}
+
+	return query
+}
+
+// NFTOwnershipChanges retrieves all the nft_ownership_change's NFTOwnershipChanges with an executor.
+func (o *Collection) NFTOwnershipChanges(mods ...qm.QueryMod) nftOwnershipChangeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"nft_ownership_changes\".\"collection_id\"=?", o.ID),
+	)
+
+	query := NFTOwnershipChanges(queryMods...)
+	queries.SetFrom(query.Query, "\"nft_ownership_changes\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"nft_ownership_changes\".*"})
+	}
+
+	return query
+}
+
 // PurchasedItemsOlds retrieves all the purchased_items_old's PurchasedItemsOlds with an executor.
 func (o *Collection) PurchasedItemsOlds(mods ...qm.QueryMod) purchasedItemsOldQuery {
 	var queryMods []qm.QueryMod
@@ -607,6 +782,27 @@ func (o *Collection) UserAssetOnChainStatuses(mods ...qm.QueryMod) userAssetOnCh
 	return query
 }
 
+// UserAssetOnChainStatusHistories retrieves all the user_asset_on_chain_status_history's UserAssetOnChainStatusHistories with an executor.
+func (o *Collection) UserAssetOnChainStatusHistories(mods ...qm.QueryMod) userAssetOnChainStatusHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_asset_on_chain_status_history\".\"collection_id\"=?", o.ID),
+	)
+
+	query := UserAssetOnChainStatusHistories(queryMods...)
+	queries.SetFrom(query.Query, "\"user_asset_on_chain_status_history\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"user_asset_on_chain_status_history\".*"})
+	}
+
+	return query
+}
+
 // UserAssets retrieves all the user_asset's UserAssets with an executor.
 func (o *Collection) UserAssets(mods ...qm.QueryMod) userAssetQuery {
 	var queryMods []qm.QueryMod
@@ -759,9 +955,9 @@ func (collectionL) LoadLogoBlob(e boil.Executor, singular bool, maybeCollection
 	return nil
 }
 
-// LoadItemOnchainTransactions allows an eager lookup of values, cached into the
+// LoadAsset1155TransferEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (collectionL) LoadItemOnchainTransactions(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
+func (collectionL) LoadAsset1155TransferEvents(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
 	var slice []*Collection
 	var object *Collection
 
@@ -799,9 +995,8 @@ func (collectionL) LoadItemOnchainTransactions(e boil.Executor, singular bool, m
 	}
 
 	query := NewQuery(
-		qm.From(`item_onchain_transactions`),
-		qm.WhereIn(`item_onchain_transactions.collection_id in ?`, args...),
-		qmhelper.WhereIsNull(`item_onchain_transactions.deleted_at`),
+		qm.From(`asset1155_transfer_events`),
+		qm.WhereIn(`asset1155_transfer_events.collection_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -809,22 +1004,22 @@ func (collectionL) LoadItemOnchainTransactions(e boil.Executor, singular bool, m
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load item_onchain_transactions")
+		return errors.Wrap(err, "failed to eager load asset1155_transfer_events")
 	}
 
-	var resultSlice []*ItemOnchainTransaction
+	var resultSlice []*Asset1155TransferEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice item_onchain_transactions")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset1155_transfer_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on item_onchain_transactions")
+		return errors.Wrap(err, "failed to close results in eager load on asset1155_transfer_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for item_onchain_transactions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset1155_transfer_events")
 	}
 
-	if len(itemOnchainTransactionAfterSelectHooks) != 0 {
+	if len(asset1155TransferEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -832,10 +1027,10 @@ func (collectionL) LoadItemOnchainTransactions(e boil.Executor, singular bool, m
 		}
 	}
 	if singular {
-		object.R.ItemOnchainTransactions = resultSlice
+		object.R.Asset1155TransferEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &itemOnchainTransactionR{}
+				foreign.R = &asset1155TransferEventR{}
 			}
 			foreign.R.Collection = object
 		}
@@ -845,9 +1040,9 @@ func (collectionL) LoadItemOnchainTransactions(e boil.Executor, singular bool, m
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.CollectionID {
-				local.R.ItemOnchainTransactions = append(local.R.ItemOnchainTransactions, foreign)
+				local.R.Asset1155TransferEvents = append(local.R.Asset1155TransferEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &itemOnchainTransactionR{}
+					foreign.R = &asset1155TransferEventR{}
 				}
 				foreign.R.Collection = local
 				break
@@ -858,9 +1053,9 @@ func (collectionL) LoadItemOnchainTransactions(e boil.Executor, singular bool, m
 	return nil
 }
 
-// LoadPurchasedItemsOlds allows an eager lookup of values, cached into the
+// LoadCollectionSyncCursors allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (collectionL) LoadPurchasedItemsOlds(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
+func (collectionL) LoadCollectionSyncCursors(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
 	var slice []*Collection
 	var object *Collection
 
@@ -898,9 +1093,8 @@ func (collectionL) LoadPurchasedItemsOlds(e boil.Executor, singular bool, maybeC
 	}
 
 	query := NewQuery(
-		qm.From(`purchased_items_old`),
-		qm.WhereIn(`purchased_items_old.collection_id in ?`, args...),
-		qmhelper.WhereIsNull(`purchased_items_old.deleted_at`),
+		qm.From(`collection_sync_cursors`),
+		qm.WhereIn(`collection_sync_cursors.collection_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -908,22 +1102,22 @@ func (collectionL) LoadPurchasedItemsOlds(e boil.Executor, singular bool, maybeC
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load purchased_items_old")
+		return errors.Wrap(err, "failed to eager load collection_sync_cursors")
 	}
 
-	var resultSlice []*PurchasedItemsOld
+	var resultSlice []*CollectionSyncCursor
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice purchased_items_old")
+		return errors.Wrap(err, "failed to bind eager loaded slice collection_sync_cursors")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on purchased_items_old")
+		return errors.Wrap(err, "failed to close results in eager load on collection_sync_cursors")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for purchased_items_old")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collection_sync_cursors")
 	}
 
-	if len(purchasedItemsOldAfterSelectHooks) != 0 {
+	if len(collectionSyncCursorAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -931,10 +1125,10 @@ func (collectionL) LoadPurchasedItemsOlds(e boil.Executor, singular bool, maybeC
 		}
 	}
 	if singular {
-		object.R.PurchasedItemsOlds = resultSlice
+		object.R.CollectionSyncCursors = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &purchasedItemsOldR{}
+				foreign.R = &collectionSyncCursorR{}
 			}
 			foreign.R.Collection = object
 		}
@@ -944,9 +1138,9 @@ func (collectionL) LoadPurchasedItemsOlds(e boil.Executor, singular bool, maybeC
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.CollectionID {
-				local.R.PurchasedItemsOlds = append(local.R.PurchasedItemsOlds, foreign)
+				local.R.CollectionSyncCursors = append(local.R.CollectionSyncCursors, foreign)
 				if foreign.R == nil {
-					foreign.R = &purchasedItemsOldR{}
+					foreign.R = &collectionSyncCursorR{}
 				}
 				foreign.R.Collection = local
 				break
@@ -957,9 +1151,9 @@ func (collectionL) LoadPurchasedItemsOlds(e boil.Executor, singular bool, maybeC
 	return nil
 }
 
-// LoadStoreItems allows an eager lookup of values, cached into the
+// LoadCraftingRecipeItems allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (collectionL) LoadStoreItems(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
+func (collectionL) LoadCraftingRecipeItems(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
 	var slice []*Collection
 	var object *Collection
 
@@ -997,9 +1191,8 @@ func (collectionL) LoadStoreItems(e boil.Executor, singular bool, maybeCollectio
 	}
 
 	query := NewQuery(
-		qm.From(`store_items`),
-		qm.WhereIn(`store_items.collection_id in ?`, args...),
-		qmhelper.WhereIsNull(`store_items.deleted_at`),
+		qm.From(`crafting_recipe_items`),
+		qm.WhereIn(`crafting_recipe_items.collection_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1007,22 +1200,22 @@ func (collectionL) LoadStoreItems(e boil.Executor, singular bool, maybeCollectio
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load store_items")
+		return errors.Wrap(err, "failed to eager load crafting_recipe_items")
 	}
 
-	var resultSlice []*StoreItem
+	var resultSlice []*CraftingRecipeItem
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice store_items")
+		return errors.Wrap(err, "failed to bind eager loaded slice crafting_recipe_items")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on store_items")
+		return errors.Wrap(err, "failed to close results in eager load on crafting_recipe_items")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for store_items")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for crafting_recipe_items")
 	}
 
-	if len(storeItemAfterSelectHooks) != 0 {
+	if len(craftingRecipeItemAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -1030,10 +1223,10 @@ func (collectionL) LoadStoreItems(e boil.Executor, singular bool, maybeCollectio
 		}
 	}
 	if singular {
-		object.R.StoreItems = resultSlice
+		object.R.CraftingRecipeItems = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &storeItemR{}
+				foreign.R = &craftingRecipeItemR{}
 			}
 			foreign.R.Collection = object
 		}
@@ -1043,9 +1236,9 @@ func (collectionL) LoadStoreItems(e boil.Executor, singular bool, maybeCollectio
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.CollectionID {
-				local.R.StoreItems = append(local.R.StoreItems, foreign)
+				local.R.CraftingRecipeItems = append(local.R.CraftingRecipeItems, foreign)
 				if foreign.R == nil {
-					foreign.R = &storeItemR{}
+					foreign.R = &craftingRecipeItemR{}
 				}
 				foreign.R.Collection = local
 				break
@@ -1056,9 +1249,9 @@ func (collectionL) LoadStoreItems(e boil.Executor, singular bool, maybeCollectio
 	return nil
 }
 
-// LoadUserAssetOnChainStatuses allows an eager lookup of values, cached into the
+// LoadHolderSnapshotEntries allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (collectionL) LoadUserAssetOnChainStatuses(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
+func (collectionL) LoadHolderSnapshotEntries(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
 	var slice []*Collection
 	var object *Collection
 
@@ -1096,8 +1289,8 @@ func (collectionL) LoadUserAssetOnChainStatuses(e boil.Executor, singular bool,
 	}
 
 	query := NewQuery(
-		qm.From(`user_asset_on_chain_status`),
-		qm.WhereIn(`user_asset_on_chain_status.collection_id in ?`, args...),
+		qm.From(`holder_snapshot_entries`),
+		qm.WhereIn(`holder_snapshot_entries.collection_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1105,22 +1298,22 @@ func (collectionL) LoadUserAssetOnChainStatuses(e boil.Executor, singular bool,
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_asset_on_chain_status")
+		return errors.Wrap(err, "failed to eager load holder_snapshot_entries")
 	}
 
-	var resultSlice []*UserAssetOnChainStatus
+	var resultSlice []*HolderSnapshotEntry
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_asset_on_chain_status")
+		return errors.Wrap(err, "failed to bind eager loaded slice holder_snapshot_entries")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_asset_on_chain_status")
+		return errors.Wrap(err, "failed to close results in eager load on holder_snapshot_entries")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_asset_on_chain_status")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for holder_snapshot_entries")
 	}
 
-	if len(userAssetOnChainStatusAfterSelectHooks) != 0 {
+	if len(holderSnapshotEntryAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -1128,10 +1321,10 @@ func (collectionL) LoadUserAssetOnChainStatuses(e boil.Executor, singular bool,
 		}
 	}
 	if singular {
-		object.R.UserAssetOnChainStatuses = resultSlice
+		object.R.HolderSnapshotEntries = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userAssetOnChainStatusR{}
+				foreign.R = &holderSnapshotEntryR{}
 			}
 			foreign.R.Collection = object
 		}
@@ -1141,9 +1334,9 @@ func (collectionL) LoadUserAssetOnChainStatuses(e boil.Executor, singular bool,
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.CollectionID {
-				local.R.UserAssetOnChainStatuses = append(local.R.UserAssetOnChainStatuses, foreign)
+				local.R.HolderSnapshotEntries = append(local.R.HolderSnapshotEntries, foreign)
 				if foreign.R == nil {
-					foreign.R = &userAssetOnChainStatusR{}
+					foreign.R = &holderSnapshotEntryR{}
 				}
 				foreign.R.Collection = local
 				break
@@ -1154,9 +1347,9 @@ func (collectionL) LoadUserAssetOnChainStatuses(e boil.Executor, singular bool,
 	return nil
 }
 
-// LoadUserAssets allows an eager lookup of values, cached into the
+// LoadItemOnchainTransactions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (collectionL) LoadUserAssets(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
+func (collectionL) LoadItemOnchainTransactions(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
 	var slice []*Collection
 	var object *Collection
 
@@ -1194,9 +1387,9 @@ func (collectionL) LoadUserAssets(e boil.Executor, singular bool, maybeCollectio
 	}
 
 	query := NewQuery(
-		qm.From(`user_assets`),
-		qm.WhereIn(`user_assets.collection_id in ?`, args...),
-		qmhelper.WhereIsNull(`user_assets.deleted_at`),
+		qm.From(`item_onchain_transactions`),
+		qm.WhereIn(`item_onchain_transactions.collection_id in ?`, args...),
+		qmhelper.WhereIsNull(`item_onchain_transactions.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1204,22 +1397,22 @@ func (collectionL) LoadUserAssets(e boil.Executor, singular bool, maybeCollectio
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_assets")
+		return errors.Wrap(err, "failed to eager load item_onchain_transactions")
 	}
 
-	var resultSlice []*UserAsset
+	var resultSlice []*ItemOnchainTransaction
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_assets")
+		return errors.Wrap(err, "failed to bind eager loaded slice item_onchain_transactions")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_assets")
+		return errors.Wrap(err, "failed to close results in eager load on item_onchain_transactions")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for item_onchain_transactions")
 	}
 
-	if len(userAssetAfterSelectHooks) != 0 {
+	if len(itemOnchainTransactionAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -1227,10 +1420,10 @@ func (collectionL) LoadUserAssets(e boil.Executor, singular bool, maybeCollectio
 		}
 	}
 	if singular {
-		object.R.UserAssets = resultSlice
+		object.R.ItemOnchainTransactions = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userAssetR{}
+				foreign.R = &itemOnchainTransactionR{}
 			}
 			foreign.R.Collection = object
 		}
@@ -1240,9 +1433,9 @@ func (collectionL) LoadUserAssets(e boil.Executor, singular bool, maybeCollectio
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.CollectionID {
-				local.R.UserAssets = append(local.R.UserAssets, foreign)
+				local.R.ItemOnchainTransactions = append(local.R.ItemOnchainTransactions, foreign)
 				if foreign.R == nil {
-					foreign.R = &userAssetR{}
+					foreign.R = &itemOnchainTransactionR{}
 				}
 				foreign.R.Collection = local
 				break
@@ -1253,9 +1446,9 @@ func (collectionL) LoadUserAssets(e boil.Executor, singular bool, maybeCollectio
 	return nil
 }
 
-// LoadUserAssets1155S allows an eager lookup of values, cached into the
+// LoadNFTOwners allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (collectionL) LoadUserAssets1155S(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
+func (collectionL) LoadNFTOwners(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
 	var slice []*Collection
 	var object *Collection
 
@@ -1293,8 +1486,8 @@ func (collectionL) LoadUserAssets1155S(e boil.Executor, singular bool, maybeColl
 	}
 
 	query := NewQuery(
-		qm.From(`user_assets_1155`),
-		qm.WhereIn(`user_assets_1155.collection_id in ?`, args...),
+		qm.From(`nft_owners`),
+		qm.WhereIn(`nft_owners.collection_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1302,22 +1495,22 @@ func (collectionL) LoadUserAssets1155S(e boil.Executor, singular bool, maybeColl
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_assets_1155")
+		return errors.Wrap(err, "failed to eager load nft_owners")
 	}
 
-	var resultSlice []*UserAssets1155
+	var resultSlice []*NFTOwner
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_assets_1155")
+		return errors.Wrap(err, "failed to bind eager loaded slice nft_owners")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_assets_1155")
+		return errors.Wrap(err, "failed to close results in eager load on nft_owners")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets_1155")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for nft_owners")
 	}
 
-	if len(userAssets1155AfterSelectHooks) != 0 {
+	if len(nftOwnerAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -1325,10 +1518,10 @@ func (collectionL) LoadUserAssets1155S(e boil.Executor, singular bool, maybeColl
 		}
 	}
 	if singular {
-		object.R.UserAssets1155S = resultSlice
+		object.R.NFTOwners = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userAssets1155R{}
+				foreign.R = &nftOwnerR{}
 			}
 			foreign.R.Collection = object
 		}
@@ -1338,9 +1531,9 @@ func (collectionL) LoadUserAssets1155S(e boil.Executor, singular bool, maybeColl
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.CollectionID {
-				local.R.UserAssets1155S = append(local.R.UserAssets1155S, foreign)
+				local.R.NFTOwners = append(local.R.NFTOwners, foreign)
 				if foreign.R == nil {
-					foreign.R = &userAssets1155R{}
+					foreign.R = &nftOwnerR{}
 				}
 				foreign.R.Collection = local
 				break
@@ -1351,81 +1544,978 @@ func (collectionL) LoadUserAssets1155S(e boil.Executor, singular bool, maybeColl
 	return nil
 }
 
-// SetLogoBlob of the collection to the related item.
-// Sets o.R.LogoBlob to related.
-// Adds o to related.R.LogoBlobCollections.
-func (o *Collection) SetLogoBlob(exec boil.Executor, insert bool, related *Blob) error {
-	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"collections\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"logo_blob_id"}),
-		strmangle.WhereClause("\"", "\"", 2, collectionPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadNFTOwnershipChanges allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (collectionL) LoadNFTOwnershipChanges(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
+	var slice []*Collection
+	var object *Collection
 
-	queries.Assign(&o.LogoBlobID, related.ID)
-	if o.R == nil {
-		o.R = &collectionR{
-			LogoBlob: related,
-		}
+	if singular {
+		object = maybeCollection.(*Collection)
 	} else {
-		o.R.LogoBlob = related
+		slice = *maybeCollection.(*[]*Collection)
 	}
 
-	if related.R == nil {
-		related.R = &blobR{
-			LogoBlobCollections: CollectionSlice{o},
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &collectionR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		related.R.LogoBlobCollections = append(related.R.LogoBlobCollections, o)
-	}
-
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collectionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`nft_ownership_changes`),
+		qm.WhereIn(`nft_ownership_changes.collection_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load nft_ownership_changes")
+	}
+
+	var resultSlice []*NFTOwnershipChange
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice nft_ownership_changes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on nft_ownership_changes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for nft_ownership_changes")
+	}
+
+	if len(nftOwnershipChangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NFTOwnershipChanges = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &nftOwnershipChangeR{}
+			}
+			foreign.R.Collection = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CollectionID {
+				local.R.NFTOwnershipChanges = append(local.R.NFTOwnershipChanges, foreign)
+				if foreign.R == nil {
+					foreign.R = &nftOwnershipChangeR{}
+				}
+				foreign.R.Collection = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPurchasedItemsOlds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (collectionL) LoadPurchasedItemsOlds(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
+	var slice []*Collection
+	var object *Collection
+
+	if singular {
+		object = maybeCollection.(*Collection)
+	} else {
+		slice = *maybeCollection.(*[]*Collection)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &collectionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collectionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`purchased_items_old`),
+		qm.WhereIn(`purchased_items_old.collection_id in ?`, args...),
+		qmhelper.WhereIsNull(`purchased_items_old.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load purchased_items_old")
+	}
+
+	var resultSlice []*PurchasedItemsOld
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice purchased_items_old")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on purchased_items_old")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for purchased_items_old")
+	}
+
+	if len(purchasedItemsOldAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PurchasedItemsOlds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &purchasedItemsOldR{}
+			}
+			foreign.R.Collection = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CollectionID {
+				local.R.PurchasedItemsOlds = append(local.R.PurchasedItemsOlds, foreign)
+				if foreign.R == nil {
+					foreign.R = &purchasedItemsOldR{}
+				}
+				foreign.R.Collection = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStoreItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (collectionL) LoadStoreItems(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
+	var slice []*Collection
+	var object *Collection
+
+	if singular {
+		object = maybeCollection.(*Collection)
+	} else {
+		slice = *maybeCollection.(*[]*Collection)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &collectionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collectionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`store_items`),
+		qm.WhereIn(`store_items.collection_id in ?`, args...),
+		qmhelper.WhereIsNull(`store_items.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load store_items")
+	}
+
+	var resultSlice []*StoreItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice store_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on store_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for store_items")
+	}
+
+	if len(storeItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.StoreItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &storeItemR{}
+			}
+			foreign.R.Collection = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CollectionID {
+				local.R.StoreItems = append(local.R.StoreItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &storeItemR{}
+				}
+				foreign.R.Collection = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAssetOnChainStatuses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (collectionL) LoadUserAssetOnChainStatuses(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
+	var slice []*Collection
+	var object *Collection
+
+	if singular {
+		object = maybeCollection.(*Collection)
+	} else {
+		slice = *maybeCollection.(*[]*Collection)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &collectionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collectionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_asset_on_chain_status`),
+		qm.WhereIn(`user_asset_on_chain_status.collection_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_asset_on_chain_status")
+	}
+
+	var resultSlice []*UserAssetOnChainStatus
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_asset_on_chain_status")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_asset_on_chain_status")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_asset_on_chain_status")
+	}
+
+	if len(userAssetOnChainStatusAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserAssetOnChainStatuses = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userAssetOnChainStatusR{}
+			}
+			foreign.R.Collection = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CollectionID {
+				local.R.UserAssetOnChainStatuses = append(local.R.UserAssetOnChainStatuses, foreign)
+				if foreign.R == nil {
+					foreign.R = &userAssetOnChainStatusR{}
+				}
+				foreign.R.Collection = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAssetOnChainStatusHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (collectionL) LoadUserAssetOnChainStatusHistories(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
+	var slice []*Collection
+	var object *Collection
+
+	if singular {
+		object = maybeCollection.(*Collection)
+	} else {
+		slice = *maybeCollection.(*[]*Collection)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &collectionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collectionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_asset_on_chain_status_history`),
+		qm.WhereIn(`user_asset_on_chain_status_history.collection_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_asset_on_chain_status_history")
+	}
+
+	var resultSlice []*UserAssetOnChainStatusHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_asset_on_chain_status_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_asset_on_chain_status_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_asset_on_chain_status_history")
+	}
+
+	if len(userAssetOnChainStatusHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserAssetOnChainStatusHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userAssetOnChainStatusHistoryR{}
+			}
+			foreign.R.Collection = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CollectionID {
+				local.R.UserAssetOnChainStatusHistories = append(local.R.UserAssetOnChainStatusHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &userAssetOnChainStatusHistoryR{}
+				}
+				foreign.R.Collection = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAssets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (collectionL) LoadUserAssets(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
+	var slice []*Collection
+	var object *Collection
+
+	if singular {
+		object = maybeCollection.(*Collection)
+	} else {
+		slice = *maybeCollection.(*[]*Collection)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &collectionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collectionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets`),
+		qm.WhereIn(`user_assets.collection_id in ?`, args...),
+		qmhelper.WhereIsNull(`user_assets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_assets")
+	}
+
+	var resultSlice []*UserAsset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_assets")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_assets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
+	}
+
+	if len(userAssetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserAssets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userAssetR{}
+			}
+			foreign.R.Collection = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CollectionID {
+				local.R.UserAssets = append(local.R.UserAssets, foreign)
+				if foreign.R == nil {
+					foreign.R = &userAssetR{}
+				}
+				foreign.R.Collection = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAssets1155S allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (collectionL) LoadUserAssets1155S(e boil.Executor, singular bool, maybeCollection interface{}, mods queries.Applicator) error {
+	var slice []*Collection
+	var object *Collection
+
+	if singular {
+		object = maybeCollection.(*Collection)
+	} else {
+		slice = *maybeCollection.(*[]*Collection)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &collectionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collectionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets_1155`),
+		qm.WhereIn(`user_assets_1155.collection_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_assets_1155")
+	}
+
+	var resultSlice []*UserAssets1155
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_assets_1155")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_assets_1155")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets_1155")
+	}
+
+	if len(userAssets1155AfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserAssets1155S = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userAssets1155R{}
+			}
+			foreign.R.Collection = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CollectionID {
+				local.R.UserAssets1155S = append(local.R.UserAssets1155S, foreign)
+				if foreign.R == nil {
+					foreign.R = &userAssets1155R{}
+				}
+				foreign.R.Collection = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetLogoBlob of the collection to the related item.
+// Sets o.R.LogoBlob to related.
+// Adds o to related.R.LogoBlobCollections.
+func (o *Collection) SetLogoBlob(exec boil.Executor, insert bool, related *Blob) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"collections\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"logo_blob_id"}),
+		strmangle.WhereClause("\"", "\"", 2, collectionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.LogoBlobID, related.ID)
+	if o.R == nil {
+		o.R = &collectionR{
+			LogoBlob: related,
+		}
+	} else {
+		o.R.LogoBlob = related
+	}
+
+	if related.R == nil {
+		related.R = &blobR{
+			LogoBlobCollections: CollectionSlice{o},
+		}
+	} else {
+		related.R.LogoBlobCollections = append(related.R.LogoBlobCollections, o)
+	}
+
+	return nil
+}
+
+// RemoveLogoBlob relationship.
+// Sets o.R.LogoBlob to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Collection) RemoveLogoBlob(exec boil.Executor, related *Blob) error {
+	var err error
+
+	queries.SetScanner(&o.LogoBlobID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("logo_blob_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.LogoBlob = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.LogoBlobCollections {
+		if queries.Equal(o.LogoBlobID, ri.LogoBlobID) {
+			continue
+		}
+
+		ln := len(related.R.LogoBlobCollections)
+		if ln > 1 && i < ln-1 {
+			related.R.LogoBlobCollections[i] = related.R.LogoBlobCollections[ln-1]
+		}
+		related.R.LogoBlobCollections = related.R.LogoBlobCollections[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddAsset1155TransferEvents adds the given related objects to the existing relationships
+// of the collection, optionally inserting them as new records.
+// Appends related to o.R.Asset1155TransferEvents.
+// Sets related.R.Collection appropriately.
+func (o *Collection) AddAsset1155TransferEvents(exec boil.Executor, insert bool, related ...*Asset1155TransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CollectionID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset1155_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+				strmangle.WhereClause("\"", "\"", 2, asset1155TransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CollectionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &collectionR{
+			Asset1155TransferEvents: related,
+		}
+	} else {
+		o.R.Asset1155TransferEvents = append(o.R.Asset1155TransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &asset1155TransferEventR{
+				Collection: o,
+			}
+		} else {
+			rel.R.Collection = o
+		}
+	}
+	return nil
+}
+
+// AddCollectionSyncCursors adds the given related objects to the existing relationships
+// of the collection, optionally inserting them as new records.
+// Appends related to o.R.CollectionSyncCursors.
+// Sets related.R.Collection appropriately.
+func (o *Collection) AddCollectionSyncCursors(exec boil.Executor, insert bool, related ...*CollectionSyncCursor) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CollectionID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"collection_sync_cursors\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+				strmangle.WhereClause("\"", "\"", 2, collectionSyncCursorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.CollectionID, rel.CursorType}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CollectionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &collectionR{
+			CollectionSyncCursors: related,
+		}
+	} else {
+		o.R.CollectionSyncCursors = append(o.R.CollectionSyncCursors, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &collectionSyncCursorR{
+				Collection: o,
+			}
+		} else {
+			rel.R.Collection = o
+		}
+	}
 	return nil
 }
 
-// RemoveLogoBlob relationship.
-// Sets o.R.LogoBlob to nil.
-// Removes o from all passed in related items' relationships struct (Optional).
-func (o *Collection) RemoveLogoBlob(exec boil.Executor, related *Blob) error {
+// AddCraftingRecipeItems adds the given related objects to the existing relationships
+// of the collection, optionally inserting them as new records.
+// Appends related to o.R.CraftingRecipeItems.
+// Sets related.R.Collection appropriately.
+func (o *Collection) AddCraftingRecipeItems(exec boil.Executor, insert bool, related ...*CraftingRecipeItem) error {
 	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CollectionID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"crafting_recipe_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+				strmangle.WhereClause("\"", "\"", 2, craftingRecipeItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	queries.SetScanner(&o.LogoBlobID, nil)
-	if _, err = o.Update(exec, boil.Whitelist("logo_blob_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CollectionID = o.ID
+		}
 	}
 
-	if o.R != nil {
-		o.R.LogoBlob = nil
+	if o.R == nil {
+		o.R = &collectionR{
+			CraftingRecipeItems: related,
+		}
+	} else {
+		o.R.CraftingRecipeItems = append(o.R.CraftingRecipeItems, related...)
 	}
-	if related == nil || related.R == nil {
-		return nil
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &craftingRecipeItemR{
+				Collection: o,
+			}
+		} else {
+			rel.R.Collection = o
+		}
 	}
+	return nil
+}
 
-	for i, ri := range related.R.LogoBlobCollections {
-		if queries.Equal(o.LogoBlobID, ri.LogoBlobID) {
-			continue
+// AddHolderSnapshotEntries adds the given related objects to the existing relationships
+// of the collection, optionally inserting them as new records.
+// Appends related to o.R.HolderSnapshotEntries.
+// Sets related.R.Collection appropriately.
+func (o *Collection) AddHolderSnapshotEntries(exec boil.Executor, insert bool, related ...*HolderSnapshotEntry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CollectionID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"holder_snapshot_entries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+				strmangle.WhereClause("\"", "\"", 2, holderSnapshotEntryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CollectionID = o.ID
 		}
+	}
 
-		ln := len(related.R.LogoBlobCollections)
-		if ln > 1 && i < ln-1 {
-			related.R.LogoBlobCollections[i] = related.R.LogoBlobCollections[ln-1]
+	if o.R == nil {
+		o.R = &collectionR{
+			HolderSnapshotEntries: related,
+		}
+	} else {
+		o.R.HolderSnapshotEntries = append(o.R.HolderSnapshotEntries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &holderSnapshotEntryR{
+				Collection: o,
+			}
+		} else {
+			rel.R.Collection = o
 		}
-		related.R.LogoBlobCollections = related.R.LogoBlobCollections[:ln-1]
-		break
 	}
 	return nil
 }
@@ -1482,6 +2572,110 @@ func (o *Collection) AddItemOnchainTransactions(exec boil.Executor, insert bool,
 	return nil
 }
 
+// AddNFTOwners adds the given related objects to the existing relationships
+// of the collection, optionally inserting them as new records.
+// Appends related to o.R.NFTOwners.
+// Sets related.R.Collection appropriately.
+func (o *Collection) AddNFTOwners(exec boil.Executor, insert bool, related ...*NFTOwner) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CollectionID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"nft_owners\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+				strmangle.WhereClause("\"", "\"", 2, nftOwnerPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.CollectionID, rel.TokenID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CollectionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &collectionR{
+			NFTOwners: related,
+		}
+	} else {
+		o.R.NFTOwners = append(o.R.NFTOwners, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &nftOwnerR{
+				Collection: o,
+			}
+		} else {
+			rel.R.Collection = o
+		}
+	}
+	return nil
+}
+
+// AddNFTOwnershipChanges adds the given related objects to the existing relationships
+// of the collection, optionally inserting them as new records.
+// Appends related to o.R.NFTOwnershipChanges.
+// Sets related.R.Collection appropriately.
+func (o *Collection) AddNFTOwnershipChanges(exec boil.Executor, insert bool, related ...*NFTOwnershipChange) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CollectionID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"nft_ownership_changes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+				strmangle.WhereClause("\"", "\"", 2, nftOwnershipChangePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CollectionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &collectionR{
+			NFTOwnershipChanges: related,
+		}
+	} else {
+		o.R.NFTOwnershipChanges = append(o.R.NFTOwnershipChanges, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &nftOwnershipChangeR{
+				Collection: o,
+			}
+		} else {
+			rel.R.Collection = o
+		}
+	}
+	return nil
+}
+
 // AddPurchasedItemsOlds adds the given related objects to the existing relationships
 // of the collection, optionally inserting them as new records.
 // Appends related to o.R.PurchasedItemsOlds.
@@ -1638,6 +2832,58 @@ func (o *Collection) AddUserAssetOnChainStatuses(exec boil.Executor, insert bool
 	return nil
 }
 
+// AddUserAssetOnChainStatusHistories adds the given related objects to the existing relationships
+// of the collection, optionally inserting them as new records.
+// Appends related to o.R.UserAssetOnChainStatusHistories.
+// Sets related.R.Collection appropriately.
+func (o *Collection) AddUserAssetOnChainStatusHistories(exec boil.Executor, insert bool, related ...*UserAssetOnChainStatusHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CollectionID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_asset_on_chain_status_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userAssetOnChainStatusHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CollectionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &collectionR{
+			UserAssetOnChainStatusHistories: related,
+		}
+	} else {
+		o.R.UserAssetOnChainStatusHistories = append(o.R.UserAssetOnChainStatusHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userAssetOnChainStatusHistoryR{
+				Collection: o,
+			}
+		} else {
+			rel.R.Collection = o
+		}
+	}
+	return nil
+}
+
 // AddUserAssets adds the given related objects to the existing relationships
 // of the collection, optionally inserting them as new records.
 // Appends related to o.R.UserAssets.
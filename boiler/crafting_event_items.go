@@ -0,0 +1,1448 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// CraftingEventItem is an object representing the database table.
+type CraftingEventItem struct {
+	ID              string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	CraftingEventID string      `boiler:"crafting_event_id" boil:"crafting_event_id" json:"crafting_event_id" toml:"crafting_event_id" yaml:"crafting_event_id"`
+	Side            string      `boiler:"side" boil:"side" json:"side" toml:"side" yaml:"side"`
+	UserAssetID     null.String `boiler:"user_asset_id" boil:"user_asset_id" json:"user_asset_id,omitempty" toml:"user_asset_id" yaml:"user_asset_id,omitempty"`
+	UserAsset1155ID null.String `boiler:"user_asset_1155_id" boil:"user_asset_1155_id" json:"user_asset_1155_id,omitempty" toml:"user_asset_1155_id" yaml:"user_asset_1155_id,omitempty"`
+	Amount          int         `boiler:"amount" boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+
+	R *craftingEventItemR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L craftingEventItemL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CraftingEventItemColumns = struct {
+	ID              string
+	CraftingEventID string
+	Side            string
+	UserAssetID     string
+	UserAsset1155ID string
+	Amount          string
+}{
+	ID:              "id",
+	CraftingEventID: "crafting_event_id",
+	Side:            "side",
+	UserAssetID:     "user_asset_id",
+	UserAsset1155ID: "user_asset_1155_id",
+	Amount:          "amount",
+}
+
+var CraftingEventItemTableColumns = struct {
+	ID              string
+	CraftingEventID string
+	Side            string
+	UserAssetID     string
+	UserAsset1155ID string
+	Amount          string
+}{
+	ID:              "crafting_event_items.id",
+	CraftingEventID: "crafting_event_items.crafting_event_id",
+	Side:            "crafting_event_items.side",
+	UserAssetID:     "crafting_event_items.user_asset_id",
+	UserAsset1155ID: "crafting_event_items.user_asset_1155_id",
+	Amount:          "crafting_event_items.amount",
+}
+
+// Generated where
+
+var CraftingEventItemWhere = struct {
+	ID              whereHelperstring
+	CraftingEventID whereHelperstring
+	Side            whereHelperstring
+	UserAssetID     whereHelpernull_String
+	UserAsset1155ID whereHelpernull_String
+	Amount          whereHelperint
+}{
+	ID:              whereHelperstring{field: "\"crafting_event_items\".\"id\""},
+	CraftingEventID: whereHelperstring{field: "\"crafting_event_items\".\"crafting_event_id\""},
+	Side:            whereHelperstring{field: "\"crafting_event_items\".\"side\""},
+	UserAssetID:     whereHelpernull_String{field: "\"crafting_event_items\".\"user_asset_id\""},
+	UserAsset1155ID: whereHelpernull_String{field: "\"crafting_event_items\".\"user_asset_1155_id\""},
+	Amount:          whereHelperint{field: "\"crafting_event_items\".\"amount\""},
+}
+
+// CraftingEventItemRels is where relationship names are stored.
+var CraftingEventItemRels = struct {
+	CraftingEvent string
+	UserAsset1155 string
+	UserAsset     string
+}{
+	CraftingEvent: "CraftingEvent",
+	UserAsset1155: "UserAsset1155",
+	UserAsset:     "UserAsset",
+}
+
+// craftingEventItemR is where relationships are stored.
+type craftingEventItemR struct {
+	CraftingEvent *CraftingEvent  `boiler:"CraftingEvent" boil:"CraftingEvent" json:"CraftingEvent" toml:"CraftingEvent" yaml:"CraftingEvent"`
+	UserAsset1155 *UserAssets1155 `boiler:"UserAsset1155" boil:"UserAsset1155" json:"UserAsset1155" toml:"UserAsset1155" yaml:"UserAsset1155"`
+	UserAsset     *UserAsset      `boiler:"UserAsset" boil:"UserAsset" json:"UserAsset" toml:"UserAsset" yaml:"UserAsset"`
+}
+
+// NewStruct creates a new relationship struct
+func (*craftingEventItemR) NewStruct() *craftingEventItemR {
+	return &craftingEventItemR{}
+}
+
+// craftingEventItemL is where Load methods for each relationship are stored.
+type craftingEventItemL struct{}
+
+var (
+	craftingEventItemAllColumns            = []string{"id", "crafting_event_id", "side", "user_asset_id", "user_asset_1155_id", "amount"}
+	craftingEventItemColumnsWithoutDefault = []string{"crafting_event_id", "side", "amount"}
+	craftingEventItemColumnsWithDefault    = []string{"id", "user_asset_id", "user_asset_1155_id"}
+	craftingEventItemPrimaryKeyColumns     = []string{"id"}
+	craftingEventItemGeneratedColumns      = []string{}
+)
+
+type (
+	// CraftingEventItemSlice is an alias for a slice of pointers to CraftingEventItem.
+	// This should almost always be used instead of []CraftingEventItem.
+	CraftingEventItemSlice []*CraftingEventItem
+	// CraftingEventItemHook is the signature for custom CraftingEventItem hook methods
+	CraftingEventItemHook func(boil.Executor, *CraftingEventItem) error
+
+	craftingEventItemQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	craftingEventItemType                 = reflect.TypeOf(&CraftingEventItem{})
+	craftingEventItemMapping              = queries.MakeStructMapping(craftingEventItemType)
+	craftingEventItemPrimaryKeyMapping, _ = queries.BindMapping(craftingEventItemType, craftingEventItemMapping, craftingEventItemPrimaryKeyColumns)
+	craftingEventItemInsertCacheMut       sync.RWMutex
+	craftingEventItemInsertCache          = make(map[string]insertCache)
+	craftingEventItemUpdateCacheMut       sync.RWMutex
+	craftingEventItemUpdateCache          = make(map[string]updateCache)
+	craftingEventItemUpsertCacheMut       sync.RWMutex
+	craftingEventItemUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var craftingEventItemAfterSelectHooks []CraftingEventItemHook
+
+var craftingEventItemBeforeInsertHooks []CraftingEventItemHook
+var craftingEventItemAfterInsertHooks []CraftingEventItemHook
+
+var craftingEventItemBeforeUpdateHooks []CraftingEventItemHook
+var craftingEventItemAfterUpdateHooks []CraftingEventItemHook
+
+var craftingEventItemBeforeDeleteHooks []CraftingEventItemHook
+var craftingEventItemAfterDeleteHooks []CraftingEventItemHook
+
+var craftingEventItemBeforeUpsertHooks []CraftingEventItemHook
+var craftingEventItemAfterUpsertHooks []CraftingEventItemHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CraftingEventItem) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventItemAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CraftingEventItem) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventItemBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CraftingEventItem) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventItemAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CraftingEventItem) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventItemBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CraftingEventItem) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventItemAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CraftingEventItem) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventItemBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CraftingEventItem) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventItemAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CraftingEventItem) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventItemBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CraftingEventItem) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventItemAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCraftingEventItemHook registers your hook function for all future operations.
+func AddCraftingEventItemHook(hookPoint boil.HookPoint, craftingEventItemHook CraftingEventItemHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		craftingEventItemAfterSelectHooks = append(craftingEventItemAfterSelectHooks, craftingEventItemHook)
+	case boil.BeforeInsertHook:
+		craftingEventItemBeforeInsertHooks = append(craftingEventItemBeforeInsertHooks, craftingEventItemHook)
+	case boil.AfterInsertHook:
+		craftingEventItemAfterInsertHooks = append(craftingEventItemAfterInsertHooks, craftingEventItemHook)
+	case boil.BeforeUpdateHook:
+		craftingEventItemBeforeUpdateHooks = append(craftingEventItemBeforeUpdateHooks, craftingEventItemHook)
+	case boil.AfterUpdateHook:
+		craftingEventItemAfterUpdateHooks = append(craftingEventItemAfterUpdateHooks, craftingEventItemHook)
+	case boil.BeforeDeleteHook:
+		craftingEventItemBeforeDeleteHooks = append(craftingEventItemBeforeDeleteHooks, craftingEventItemHook)
+	case boil.AfterDeleteHook:
+		craftingEventItemAfterDeleteHooks = append(craftingEventItemAfterDeleteHooks, craftingEventItemHook)
+	case boil.BeforeUpsertHook:
+		craftingEventItemBeforeUpsertHooks = append(craftingEventItemBeforeUpsertHooks, craftingEventItemHook)
+	case boil.AfterUpsertHook:
+		craftingEventItemAfterUpsertHooks = append(craftingEventItemAfterUpsertHooks, craftingEventItemHook)
+	}
+}
+
+// One returns a single craftingEventItem record from the query.
+func (q craftingEventItemQuery) One(exec boil.Executor) (*CraftingEventItem, error) {
+	o := &CraftingEventItem{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for crafting_event_items")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CraftingEventItem records from the query.
+func (q craftingEventItemQuery) All(exec boil.Executor) (CraftingEventItemSlice, error) {
+	var o []*CraftingEventItem
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to CraftingEventItem slice")
+	}
+
+	if len(craftingEventItemAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CraftingEventItem records in the query.
+func (q craftingEventItemQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count crafting_event_items rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q craftingEventItemQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if crafting_event_items exists")
+	}
+
+	return count > 0, nil
+}
+
+// CraftingEvent pointed to by the foreign key.
+func (o *CraftingEventItem) CraftingEvent(mods ...qm.QueryMod) craftingEventQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CraftingEventID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := CraftingEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"crafting_events\"")
+
+	return query
+}
+
+// UserAsset1155 pointed to by the foreign key.
+func (o *CraftingEventItem) UserAsset1155(mods ...qm.QueryMod) userAssets1155Query {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserAsset1155ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := UserAssets1155S(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets_1155\"")
+
+	return query
+}
+
+// UserAsset pointed to by the foreign key.
+func (o *CraftingEventItem) UserAsset(mods ...qm.QueryMod) userAssetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserAssetID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := UserAssets(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets\"")
+
+	return query
+}
+
+// LoadCraftingEvent allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (craftingEventItemL) LoadCraftingEvent(e boil.Executor, singular bool, maybeCraftingEventItem interface{}, mods queries.Applicator) error {
+	var slice []*CraftingEventItem
+	var object *CraftingEventItem
+
+	if singular {
+		object = maybeCraftingEventItem.(*CraftingEventItem)
+	} else {
+		slice = *maybeCraftingEventItem.(*[]*CraftingEventItem)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &craftingEventItemR{}
+		}
+		args = append(args, object.CraftingEventID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &craftingEventItemR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CraftingEventID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CraftingEventID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`crafting_events`),
+		qm.WhereIn(`crafting_events.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load CraftingEvent")
+	}
+
+	var resultSlice []*CraftingEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice CraftingEvent")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for crafting_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for crafting_events")
+	}
+
+	if len(craftingEventItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CraftingEvent = foreign
+		if foreign.R == nil {
+			foreign.R = &craftingEventR{}
+		}
+		foreign.R.CraftingEventItems = append(foreign.R.CraftingEventItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CraftingEventID == foreign.ID {
+				local.R.CraftingEvent = foreign
+				if foreign.R == nil {
+					foreign.R = &craftingEventR{}
+				}
+				foreign.R.CraftingEventItems = append(foreign.R.CraftingEventItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAsset1155 allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (craftingEventItemL) LoadUserAsset1155(e boil.Executor, singular bool, maybeCraftingEventItem interface{}, mods queries.Applicator) error {
+	var slice []*CraftingEventItem
+	var object *CraftingEventItem
+
+	if singular {
+		object = maybeCraftingEventItem.(*CraftingEventItem)
+	} else {
+		slice = *maybeCraftingEventItem.(*[]*CraftingEventItem)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &craftingEventItemR{}
+		}
+		if !queries.IsNil(object.UserAsset1155ID) {
+			args = append(args, object.UserAsset1155ID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &craftingEventItemR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.UserAsset1155ID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.UserAsset1155ID) {
+				args = append(args, obj.UserAsset1155ID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets_1155`),
+		qm.WhereIn(`user_assets_1155.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserAssets1155")
+	}
+
+	var resultSlice []*UserAssets1155
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserAssets1155")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_assets_1155")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets_1155")
+	}
+
+	if len(craftingEventItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserAsset1155 = foreign
+		if foreign.R == nil {
+			foreign.R = &userAssets1155R{}
+		}
+		foreign.R.UserAsset1155CraftingEventItems = append(foreign.R.UserAsset1155CraftingEventItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserAsset1155ID, foreign.ID) {
+				local.R.UserAsset1155 = foreign
+				if foreign.R == nil {
+					foreign.R = &userAssets1155R{}
+				}
+				foreign.R.UserAsset1155CraftingEventItems = append(foreign.R.UserAsset1155CraftingEventItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAsset allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (craftingEventItemL) LoadUserAsset(e boil.Executor, singular bool, maybeCraftingEventItem interface{}, mods queries.Applicator) error {
+	var slice []*CraftingEventItem
+	var object *CraftingEventItem
+
+	if singular {
+		object = maybeCraftingEventItem.(*CraftingEventItem)
+	} else {
+		slice = *maybeCraftingEventItem.(*[]*CraftingEventItem)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &craftingEventItemR{}
+		}
+		if !queries.IsNil(object.UserAssetID) {
+			args = append(args, object.UserAssetID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &craftingEventItemR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.UserAssetID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.UserAssetID) {
+				args = append(args, obj.UserAssetID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets`),
+		qm.WhereIn(`user_assets.id in ?`, args...),
+		qmhelper.WhereIsNull(`user_assets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserAsset")
+	}
+
+	var resultSlice []*UserAsset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserAsset")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_assets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
+	}
+
+	if len(craftingEventItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserAsset = foreign
+		if foreign.R == nil {
+			foreign.R = &userAssetR{}
+		}
+		foreign.R.CraftingEventItems = append(foreign.R.CraftingEventItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserAssetID, foreign.ID) {
+				local.R.UserAsset = foreign
+				if foreign.R == nil {
+					foreign.R = &userAssetR{}
+				}
+				foreign.R.CraftingEventItems = append(foreign.R.CraftingEventItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCraftingEvent of the craftingEventItem to the related item.
+// Sets o.R.CraftingEvent to related.
+// Adds o to related.R.CraftingEventItems.
+func (o *CraftingEventItem) SetCraftingEvent(exec boil.Executor, insert bool, related *CraftingEvent) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"crafting_event_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"crafting_event_id"}),
+		strmangle.WhereClause("\"", "\"", 2, craftingEventItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CraftingEventID = related.ID
+	if o.R == nil {
+		o.R = &craftingEventItemR{
+			CraftingEvent: related,
+		}
+	} else {
+		o.R.CraftingEvent = related
+	}
+
+	if related.R == nil {
+		related.R = &craftingEventR{
+			CraftingEventItems: CraftingEventItemSlice{o},
+		}
+	} else {
+		related.R.CraftingEventItems = append(related.R.CraftingEventItems, o)
+	}
+
+	return nil
+}
+
+// SetUserAsset1155 of the craftingEventItem to the related item.
+// Sets o.R.UserAsset1155 to related.
+// Adds o to related.R.UserAsset1155CraftingEventItems.
+func (o *CraftingEventItem) SetUserAsset1155(exec boil.Executor, insert bool, related *UserAssets1155) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"crafting_event_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_1155_id"}),
+		strmangle.WhereClause("\"", "\"", 2, craftingEventItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserAsset1155ID, related.ID)
+	if o.R == nil {
+		o.R = &craftingEventItemR{
+			UserAsset1155: related,
+		}
+	} else {
+		o.R.UserAsset1155 = related
+	}
+
+	if related.R == nil {
+		related.R = &userAssets1155R{
+			UserAsset1155CraftingEventItems: CraftingEventItemSlice{o},
+		}
+	} else {
+		related.R.UserAsset1155CraftingEventItems = append(related.R.UserAsset1155CraftingEventItems, o)
+	}
+
+	return nil
+}
+
+// RemoveUserAsset1155 relationship.
+// Sets o.R.UserAsset1155 to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *CraftingEventItem) RemoveUserAsset1155(exec boil.Executor, related *UserAssets1155) error {
+	var err error
+
+	queries.SetScanner(&o.UserAsset1155ID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("user_asset_1155_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.UserAsset1155 = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.UserAsset1155CraftingEventItems {
+		if queries.Equal(o.UserAsset1155ID, ri.UserAsset1155ID) {
+			continue
+		}
+
+		ln := len(related.R.UserAsset1155CraftingEventItems)
+		if ln > 1 && i < ln-1 {
+			related.R.UserAsset1155CraftingEventItems[i] = related.R.UserAsset1155CraftingEventItems[ln-1]
+		}
+		related.R.UserAsset1155CraftingEventItems = related.R.UserAsset1155CraftingEventItems[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetUserAsset of the craftingEventItem to the related item.
+// Sets o.R.UserAsset to related.
+// Adds o to related.R.CraftingEventItems.
+func (o *CraftingEventItem) SetUserAsset(exec boil.Executor, insert bool, related *UserAsset) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"crafting_event_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+		strmangle.WhereClause("\"", "\"", 2, craftingEventItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserAssetID, related.ID)
+	if o.R == nil {
+		o.R = &craftingEventItemR{
+			UserAsset: related,
+		}
+	} else {
+		o.R.UserAsset = related
+	}
+
+	if related.R == nil {
+		related.R = &userAssetR{
+			CraftingEventItems: CraftingEventItemSlice{o},
+		}
+	} else {
+		related.R.CraftingEventItems = append(related.R.CraftingEventItems, o)
+	}
+
+	return nil
+}
+
+// RemoveUserAsset relationship.
+// Sets o.R.UserAsset to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *CraftingEventItem) RemoveUserAsset(exec boil.Executor, related *UserAsset) error {
+	var err error
+
+	queries.SetScanner(&o.UserAssetID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("user_asset_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.UserAsset = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CraftingEventItems {
+		if queries.Equal(o.UserAssetID, ri.UserAssetID) {
+			continue
+		}
+
+		ln := len(related.R.CraftingEventItems)
+		if ln > 1 && i < ln-1 {
+			related.R.CraftingEventItems[i] = related.R.CraftingEventItems[ln-1]
+		}
+		related.R.CraftingEventItems = related.R.CraftingEventItems[:ln-1]
+		break
+	}
+	return nil
+}
+
+// CraftingEventItems retrieves all the records using an executor.
+func CraftingEventItems(mods ...qm.QueryMod) craftingEventItemQuery {
+	mods = append(mods, qm.From("\"crafting_event_items\""))
+	return craftingEventItemQuery{NewQuery(mods...)}
+}
+
+// FindCraftingEventItem retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCraftingEventItem(exec boil.Executor, iD string, selectCols ...string) (*CraftingEventItem, error) {
+	craftingEventItemObj := &CraftingEventItem{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"crafting_event_items\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, craftingEventItemObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from crafting_event_items")
+	}
+
+	if err = craftingEventItemObj.doAfterSelectHooks(exec); err != nil {
+		return craftingEventItemObj, err
+	}
+
+	return craftingEventItemObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CraftingEventItem) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no crafting_event_items provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(craftingEventItemColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	craftingEventItemInsertCacheMut.RLock()
+	cache, cached := craftingEventItemInsertCache[key]
+	craftingEventItemInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			craftingEventItemAllColumns,
+			craftingEventItemColumnsWithDefault,
+			craftingEventItemColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(craftingEventItemType, craftingEventItemMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(craftingEventItemType, craftingEventItemMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"crafting_event_items\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"crafting_event_items\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into crafting_event_items")
+	}
+
+	if !cached {
+		craftingEventItemInsertCacheMut.Lock()
+		craftingEventItemInsertCache[key] = cache
+		craftingEventItemInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the CraftingEventItem.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CraftingEventItem) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	craftingEventItemUpdateCacheMut.RLock()
+	cache, cached := craftingEventItemUpdateCache[key]
+	craftingEventItemUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			craftingEventItemAllColumns,
+			craftingEventItemPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update crafting_event_items, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"crafting_event_items\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, craftingEventItemPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(craftingEventItemType, craftingEventItemMapping, append(wl, craftingEventItemPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update crafting_event_items row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for crafting_event_items")
+	}
+
+	if !cached {
+		craftingEventItemUpdateCacheMut.Lock()
+		craftingEventItemUpdateCache[key] = cache
+		craftingEventItemUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q craftingEventItemQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for crafting_event_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for crafting_event_items")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CraftingEventItemSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), craftingEventItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"crafting_event_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, craftingEventItemPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in craftingEventItem slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all craftingEventItem")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CraftingEventItem) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no crafting_event_items provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(craftingEventItemColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	craftingEventItemUpsertCacheMut.RLock()
+	cache, cached := craftingEventItemUpsertCache[key]
+	craftingEventItemUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			craftingEventItemAllColumns,
+			craftingEventItemColumnsWithDefault,
+			craftingEventItemColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			craftingEventItemAllColumns,
+			craftingEventItemPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert crafting_event_items, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(craftingEventItemPrimaryKeyColumns))
+			copy(conflict, craftingEventItemPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"crafting_event_items\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(craftingEventItemType, craftingEventItemMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(craftingEventItemType, craftingEventItemMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert crafting_event_items")
+	}
+
+	if !cached {
+		craftingEventItemUpsertCacheMut.Lock()
+		craftingEventItemUpsertCache[key] = cache
+		craftingEventItemUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single CraftingEventItem record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CraftingEventItem) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no CraftingEventItem provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), craftingEventItemPrimaryKeyMapping)
+	sql := "DELETE FROM \"crafting_event_items\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from crafting_event_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for crafting_event_items")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q craftingEventItemQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no craftingEventItemQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from crafting_event_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for crafting_event_items")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CraftingEventItemSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(craftingEventItemBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), craftingEventItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"crafting_event_items\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, craftingEventItemPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from craftingEventItem slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for crafting_event_items")
+	}
+
+	if len(craftingEventItemAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CraftingEventItem) Reload(exec boil.Executor) error {
+	ret, err := FindCraftingEventItem(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CraftingEventItemSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CraftingEventItemSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), craftingEventItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"crafting_event_items\".* FROM \"crafting_event_items\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, craftingEventItemPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in CraftingEventItemSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CraftingEventItemExists checks if the CraftingEventItem row exists.
+func CraftingEventItemExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"crafting_event_items\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if crafting_event_items exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1640 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// CraftingEvent is an object representing the database table.
+type CraftingEvent struct {
+	ID            string          `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	RecipeID      string          `boiler:"recipe_id" boil:"recipe_id" json:"recipe_id" toml:"recipe_id" yaml:"recipe_id"`
+	UserID        string          `boiler:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	ServiceID     null.String     `boiler:"service_id" boil:"service_id" json:"service_id,omitempty" toml:"service_id" yaml:"service_id,omitempty"`
+	SupsCost      decimal.Decimal `boiler:"sups_cost" boil:"sups_cost" json:"sups_cost" toml:"sups_cost" yaml:"sups_cost"`
+	TXID          null.String     `boiler:"tx_id" boil:"tx_id" json:"tx_id,omitempty" toml:"tx_id" yaml:"tx_id,omitempty"`
+	CreatedAt     time.Time       `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	Status        string          `boiler:"status" boil:"status" json:"status" toml:"status" yaml:"status"`
+	FailedReason  null.String     `boiler:"failed_reason" boil:"failed_reason" json:"failed_reason,omitempty" toml:"failed_reason" yaml:"failed_reason,omitempty"`
+	RefundTXID    null.String     `boiler:"refund_tx_id" boil:"refund_tx_id" json:"refund_tx_id,omitempty" toml:"refund_tx_id" yaml:"refund_tx_id,omitempty"`
+	SettledAt     null.Time       `boiler:"settled_at" boil:"settled_at" json:"settled_at,omitempty" toml:"settled_at" yaml:"settled_at,omitempty"`
+	AvatarCleared bool            `boiler:"avatar_cleared" boil:"avatar_cleared" json:"avatar_cleared" toml:"avatar_cleared" yaml:"avatar_cleared"`
+
+	R *craftingEventR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L craftingEventL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CraftingEventColumns = struct {
+	ID            string
+	RecipeID      string
+	UserID        string
+	ServiceID     string
+	SupsCost      string
+	TXID          string
+	CreatedAt     string
+	Status        string
+	FailedReason  string
+	RefundTXID    string
+	SettledAt     string
+	AvatarCleared string
+}{
+	ID:            "id",
+	RecipeID:      "recipe_id",
+	UserID:        "user_id",
+	ServiceID:     "service_id",
+	SupsCost:      "sups_cost",
+	TXID:          "tx_id",
+	CreatedAt:     "created_at",
+	Status:        "status",
+	FailedReason:  "failed_reason",
+	RefundTXID:    "refund_tx_id",
+	SettledAt:     "settled_at",
+	AvatarCleared: "avatar_cleared",
+}
+
+var CraftingEventTableColumns = struct {
+	ID            string
+	RecipeID      string
+	UserID        string
+	ServiceID     string
+	SupsCost      string
+	TXID          string
+	CreatedAt     string
+	Status        string
+	FailedReason  string
+	RefundTXID    string
+	SettledAt     string
+	AvatarCleared string
+}{
+	ID:            "crafting_events.id",
+	RecipeID:      "crafting_events.recipe_id",
+	UserID:        "crafting_events.user_id",
+	ServiceID:     "crafting_events.service_id",
+	SupsCost:      "crafting_events.sups_cost",
+	TXID:          "crafting_events.tx_id",
+	CreatedAt:     "crafting_events.created_at",
+	Status:        "crafting_events.status",
+	FailedReason:  "crafting_events.failed_reason",
+	RefundTXID:    "crafting_events.refund_tx_id",
+	SettledAt:     "crafting_events.settled_at",
+	AvatarCleared: "crafting_events.avatar_cleared",
+}
+
+// Generated where
+
+var CraftingEventWhere = struct {
+	ID            whereHelperstring
+	RecipeID      whereHelperstring
+	UserID        whereHelperstring
+	ServiceID     whereHelpernull_String
+	SupsCost      whereHelperdecimal_Decimal
+	TXID          whereHelpernull_String
+	CreatedAt     whereHelpertime_Time
+	Status        whereHelperstring
+	FailedReason  whereHelpernull_String
+	RefundTXID    whereHelpernull_String
+	SettledAt     whereHelpernull_Time
+	AvatarCleared whereHelperbool
+}{
+	ID:            whereHelperstring{field: "\"crafting_events\".\"id\""},
+	RecipeID:      whereHelperstring{field: "\"crafting_events\".\"recipe_id\""},
+	UserID:        whereHelperstring{field: "\"crafting_events\".\"user_id\""},
+	ServiceID:     whereHelpernull_String{field: "\"crafting_events\".\"service_id\""},
+	SupsCost:      whereHelperdecimal_Decimal{field: "\"crafting_events\".\"sups_cost\""},
+	TXID:          whereHelpernull_String{field: "\"crafting_events\".\"tx_id\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"crafting_events\".\"created_at\""},
+	Status:        whereHelperstring{field: "\"crafting_events\".\"status\""},
+	FailedReason:  whereHelpernull_String{field: "\"crafting_events\".\"failed_reason\""},
+	RefundTXID:    whereHelpernull_String{field: "\"crafting_events\".\"refund_tx_id\""},
+	SettledAt:     whereHelpernull_Time{field: "\"crafting_events\".\"settled_at\""},
+	AvatarCleared: whereHelperbool{field: "\"crafting_events\".\"avatar_cleared\""},
+}
+
+// CraftingEventRels is where relationship names are stored.
+var CraftingEventRels = struct {
+	Recipe             string
+	Service            string
+	User               string
+	CraftingEventItems string
+}{
+	Recipe:             "Recipe",
+	Service:            "Service",
+	User:               "User",
+	CraftingEventItems: "CraftingEventItems",
+}
+
+// craftingEventR is where relationships are stored.
+type craftingEventR struct {
+	Recipe             *CraftingRecipe        `boiler:"Recipe" boil:"Recipe" json:"Recipe" toml:"Recipe" yaml:"Recipe"`
+	Service            *User                  `boiler:"Service" boil:"Service" json:"Service" toml:"Service" yaml:"Service"`
+	User               *User                  `boiler:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+	CraftingEventItems CraftingEventItemSlice `boiler:"CraftingEventItems" boil:"CraftingEventItems" json:"CraftingEventItems" toml:"CraftingEventItems" yaml:"CraftingEventItems"`
+}
+
+// NewStruct creates a new relationship struct
+func (*craftingEventR) NewStruct() *craftingEventR {
+	return &craftingEventR{}
+}
+
+// craftingEventL is where Load methods for each relationship are stored.
+type craftingEventL struct{}
+
+var (
+	craftingEventAllColumns            = []string{"id", "recipe_id", "user_id", "service_id", "sups_cost", "tx_id", "created_at", "status", "failed_reason", "refund_tx_id", "settled_at", "avatar_cleared"}
+	craftingEventColumnsWithoutDefault = []string{"recipe_id", "user_id", "sups_cost"}
+	craftingEventColumnsWithDefault    = []string{"id", "service_id", "tx_id", "created_at", "status", "failed_reason", "refund_tx_id", "settled_at", "avatar_cleared"}
+	craftingEventPrimaryKeyColumns     = []string{"id"}
+	craftingEventGeneratedColumns      = []string{}
+)
+
+type (
+	// CraftingEventSlice is an alias for a slice of pointers to CraftingEvent.
+	// This should almost always be used instead of []CraftingEvent.
+	CraftingEventSlice []*CraftingEvent
+	// CraftingEventHook is the signature for custom CraftingEvent hook methods
+	CraftingEventHook func(boil.Executor, *CraftingEvent) error
+
+	craftingEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	craftingEventType                 = reflect.TypeOf(&CraftingEvent{})
+	craftingEventMapping              = queries.MakeStructMapping(craftingEventType)
+	craftingEventPrimaryKeyMapping, _ = queries.BindMapping(craftingEventType, craftingEventMapping, craftingEventPrimaryKeyColumns)
+	craftingEventInsertCacheMut       sync.RWMutex
+	craftingEventInsertCache          = make(map[string]insertCache)
+	craftingEventUpdateCacheMut       sync.RWMutex
+	craftingEventUpdateCache          = make(map[string]updateCache)
+	craftingEventUpsertCacheMut       sync.RWMutex
+	craftingEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var craftingEventAfterSelectHooks []CraftingEventHook
+
+var craftingEventBeforeInsertHooks []CraftingEventHook
+var craftingEventAfterInsertHooks []CraftingEventHook
+
+var craftingEventBeforeUpdateHooks []CraftingEventHook
+var craftingEventAfterUpdateHooks []CraftingEventHook
+
+var craftingEventBeforeDeleteHooks []CraftingEventHook
+var craftingEventAfterDeleteHooks []CraftingEventHook
+
+var craftingEventBeforeUpsertHooks []CraftingEventHook
+var craftingEventAfterUpsertHooks []CraftingEventHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CraftingEvent) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CraftingEvent) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CraftingEvent) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CraftingEvent) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CraftingEvent) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CraftingEvent) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CraftingEvent) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CraftingEvent) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CraftingEvent) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingEventAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCraftingEventHook registers your hook function for all future operations.
+func AddCraftingEventHook(hookPoint boil.HookPoint, craftingEventHook CraftingEventHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		craftingEventAfterSelectHooks = append(craftingEventAfterSelectHooks, craftingEventHook)
+	case boil.BeforeInsertHook:
+		craftingEventBeforeInsertHooks = append(craftingEventBeforeInsertHooks, craftingEventHook)
+	case boil.AfterInsertHook:
+		craftingEventAfterInsertHooks = append(craftingEventAfterInsertHooks, craftingEventHook)
+	case boil.BeforeUpdateHook:
+		craftingEventBeforeUpdateHooks = append(craftingEventBeforeUpdateHooks, craftingEventHook)
+	case boil.AfterUpdateHook:
+		craftingEventAfterUpdateHooks = append(craftingEventAfterUpdateHooks, craftingEventHook)
+	case boil.BeforeDeleteHook:
+		craftingEventBeforeDeleteHooks = append(craftingEventBeforeDeleteHooks, craftingEventHook)
+	case boil.AfterDeleteHook:
+		craftingEventAfterDeleteHooks = append(craftingEventAfterDeleteHooks, craftingEventHook)
+	case boil.BeforeUpsertHook:
+		craftingEventBeforeUpsertHooks = append(craftingEventBeforeUpsertHooks, craftingEventHook)
+	case boil.AfterUpsertHook:
+		craftingEventAfterUpsertHooks = append(craftingEventAfterUpsertHooks, craftingEventHook)
+	}
+}
+
+// One returns a single craftingEvent record from the query.
+func (q craftingEventQuery) One(exec boil.Executor) (*CraftingEvent, error) {
+	o := &CraftingEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for crafting_events")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CraftingEvent records from the query.
+func (q craftingEventQuery) All(exec boil.Executor) (CraftingEventSlice, error) {
+	var o []*CraftingEvent
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to CraftingEvent slice")
+	}
+
+	if len(craftingEventAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CraftingEvent records in the query.
+func (q craftingEventQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count crafting_events rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q craftingEventQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if crafting_events exists")
+	}
+
+	return count > 0, nil
+}
+
+// Recipe pointed to by the foreign key.
+func (o *CraftingEvent) Recipe(mods ...qm.QueryMod) craftingRecipeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RecipeID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := CraftingRecipes(queryMods...)
+	queries.SetFrom(query.Query, "\"crafting_recipes\"")
+
+	return query
+}
+
+// Service pointed to by the foreign key.
+func (o *CraftingEvent) Service(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ServiceID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// User pointed to by the foreign key.
+func (o *CraftingEvent) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// CraftingEventItems retrieves all the crafting_event_item's CraftingEventItems with an executor.
+func (o *CraftingEvent) CraftingEventItems(mods ...qm.QueryMod) craftingEventItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"crafting_event_items\".\"crafting_event_id\"=?", o.ID),
+	)
+
+	query := CraftingEventItems(queryMods...)
+	queries.SetFrom(query.Query, "\"crafting_event_items\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"crafting_event_items\".*"})
+	}
+
+	return query
+}
+
+// LoadRecipe allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (craftingEventL) LoadRecipe(e boil.Executor, singular bool, maybeCraftingEvent interface{}, mods queries.Applicator) error {
+	var slice []*CraftingEvent
+	var object *CraftingEvent
+
+	if singular {
+		object = maybeCraftingEvent.(*CraftingEvent)
+	} else {
+		slice = *maybeCraftingEvent.(*[]*CraftingEvent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &craftingEventR{}
+		}
+		args = append(args, object.RecipeID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &craftingEventR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RecipeID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RecipeID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`crafting_recipes`),
+		qm.WhereIn(`crafting_recipes.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load CraftingRecipe")
+	}
+
+	var resultSlice []*CraftingRecipe
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice CraftingRecipe")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for crafting_recipes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for crafting_recipes")
+	}
+
+	if len(craftingEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Recipe = foreign
+		if foreign.R == nil {
+			foreign.R = &craftingRecipeR{}
+		}
+		foreign.R.RecipeCraftingEvents = append(foreign.R.RecipeCraftingEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RecipeID == foreign.ID {
+				local.R.Recipe = foreign
+				if foreign.R == nil {
+					foreign.R = &craftingRecipeR{}
+				}
+				foreign.R.RecipeCraftingEvents = append(foreign.R.RecipeCraftingEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadService allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (craftingEventL) LoadService(e boil.Executor, singular bool, maybeCraftingEvent interface{}, mods queries.Applicator) error {
+	var slice []*CraftingEvent
+	var object *CraftingEvent
+
+	if singular {
+		object = maybeCraftingEvent.(*CraftingEvent)
+	} else {
+		slice = *maybeCraftingEvent.(*[]*CraftingEvent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &craftingEventR{}
+		}
+		if !queries.IsNil(object.ServiceID) {
+			args = append(args, object.ServiceID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &craftingEventR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ServiceID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ServiceID) {
+				args = append(args, obj.ServiceID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(craftingEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Service = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ServiceCraftingEvents = append(foreign.R.ServiceCraftingEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ServiceID, foreign.ID) {
+				local.R.Service = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ServiceCraftingEvents = append(foreign.R.ServiceCraftingEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (craftingEventL) LoadUser(e boil.Executor, singular bool, maybeCraftingEvent interface{}, mods queries.Applicator) error {
+	var slice []*CraftingEvent
+	var object *CraftingEvent
+
+	if singular {
+		object = maybeCraftingEvent.(*CraftingEvent)
+	} else {
+		slice = *maybeCraftingEvent.(*[]*CraftingEvent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &craftingEventR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &craftingEventR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(craftingEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CraftingEvents = append(foreign.R.CraftingEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CraftingEvents = append(foreign.R.CraftingEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCraftingEventItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (craftingEventL) LoadCraftingEventItems(e boil.Executor, singular bool, maybeCraftingEvent interface{}, mods queries.Applicator) error {
+	var slice []*CraftingEvent
+	var object *CraftingEvent
+
+	if singular {
+		object = maybeCraftingEvent.(*CraftingEvent)
+	} else {
+		slice = *maybeCraftingEvent.(*[]*CraftingEvent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &craftingEventR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &craftingEventR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`crafting_event_items`),
+		qm.WhereIn(`crafting_event_items.crafting_event_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load crafting_event_items")
+	}
+
+	var resultSlice []*CraftingEventItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice crafting_event_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on crafting_event_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for crafting_event_items")
+	}
+
+	if len(craftingEventItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CraftingEventItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &craftingEventItemR{}
+			}
+			foreign.R.CraftingEvent = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CraftingEventID {
+				local.R.CraftingEventItems = append(local.R.CraftingEventItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &craftingEventItemR{}
+				}
+				foreign.R.CraftingEvent = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRecipe of the craftingEvent to the related item.
+// Sets o.R.Recipe to related.
+// Adds o to related.R.RecipeCraftingEvents.
+func (o *CraftingEvent) SetRecipe(exec boil.Executor, insert bool, related *CraftingRecipe) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"crafting_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"recipe_id"}),
+		strmangle.WhereClause("\"", "\"", 2, craftingEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RecipeID = related.ID
+	if o.R == nil {
+		o.R = &craftingEventR{
+			Recipe: related,
+		}
+	} else {
+		o.R.Recipe = related
+	}
+
+	if related.R == nil {
+		related.R = &craftingRecipeR{
+			RecipeCraftingEvents: CraftingEventSlice{o},
+		}
+	} else {
+		related.R.RecipeCraftingEvents = append(related.R.RecipeCraftingEvents, o)
+	}
+
+	return nil
+}
+
+// SetService of the craftingEvent to the related item.
+// Sets o.R.Service to related.
+// Adds o to related.R.ServiceCraftingEvents.
+func (o *CraftingEvent) SetService(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"crafting_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"service_id"}),
+		strmangle.WhereClause("\"", "\"", 2, craftingEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ServiceID, related.ID)
+	if o.R == nil {
+		o.R = &craftingEventR{
+			Service: related,
+		}
+	} else {
+		o.R.Service = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ServiceCraftingEvents: CraftingEventSlice{o},
+		}
+	} else {
+		related.R.ServiceCraftingEvents = append(related.R.ServiceCraftingEvents, o)
+	}
+
+	return nil
+}
+
+// RemoveService relationship.
+// Sets o.R.Service to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *CraftingEvent) RemoveService(exec boil.Executor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ServiceID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("service_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Service = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ServiceCraftingEvents {
+		if queries.Equal(o.ServiceID, ri.ServiceID) {
+			continue
+		}
+
+		ln := len(related.R.ServiceCraftingEvents)
+		if ln > 1 && i < ln-1 {
+			related.R.ServiceCraftingEvents[i] = related.R.ServiceCraftingEvents[ln-1]
+		}
+		related.R.ServiceCraftingEvents = related.R.ServiceCraftingEvents[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetUser of the craftingEvent to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.CraftingEvents.
+func (o *CraftingEvent) SetUser(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"crafting_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, craftingEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &craftingEventR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CraftingEvents: CraftingEventSlice{o},
+		}
+	} else {
+		related.R.CraftingEvents = append(related.R.CraftingEvents, o)
+	}
+
+	return nil
+}
+
+// AddCraftingEventItems adds the given related objects to the existing relationships
+// of the crafting_event, optionally inserting them as new records.
+// Appends related to o.R.CraftingEventItems.
+// Sets related.R.CraftingEvent appropriately.
+func (o *CraftingEvent) AddCraftingEventItems(exec boil.Executor, insert bool, related ...*CraftingEventItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CraftingEventID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"crafting_event_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"crafting_event_id"}),
+				strmangle.WhereClause("\"", "\"", 2, craftingEventItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CraftingEventID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &craftingEventR{
+			CraftingEventItems: related,
+		}
+	} else {
+		o.R.CraftingEventItems = append(o.R.CraftingEventItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &craftingEventItemR{
+				CraftingEvent: o,
+			}
+		} else {
+			rel.R.CraftingEvent = o
+		}
+	}
+	return nil
+}
+
+// CraftingEvents retrieves all the records using an executor.
+func CraftingEvents(mods ...qm.QueryMod) craftingEventQuery {
+	mods = append(mods, qm.From("\"crafting_events\""))
+	return craftingEventQuery{NewQuery(mods...)}
+}
+
+// FindCraftingEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCraftingEvent(exec boil.Executor, iD string, selectCols ...string) (*CraftingEvent, error) {
+	craftingEventObj := &CraftingEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"crafting_events\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, craftingEventObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from crafting_events")
+	}
+
+	if err = craftingEventObj.doAfterSelectHooks(exec); err != nil {
+		return craftingEventObj, err
+	}
+
+	return craftingEventObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CraftingEvent) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no crafting_events provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(craftingEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	craftingEventInsertCacheMut.RLock()
+	cache, cached := craftingEventInsertCache[key]
+	craftingEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			craftingEventAllColumns,
+			craftingEventColumnsWithDefault,
+			craftingEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(craftingEventType, craftingEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(craftingEventType, craftingEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"crafting_events\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"crafting_events\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into crafting_events")
+	}
+
+	if !cached {
+		craftingEventInsertCacheMut.Lock()
+		craftingEventInsertCache[key] = cache
+		craftingEventInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the CraftingEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CraftingEvent) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	craftingEventUpdateCacheMut.RLock()
+	cache, cached := craftingEventUpdateCache[key]
+	craftingEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			craftingEventAllColumns,
+			craftingEventPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update crafting_events, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"crafting_events\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, craftingEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(craftingEventType, craftingEventMapping, append(wl, craftingEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update crafting_events row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for crafting_events")
+	}
+
+	if !cached {
+		craftingEventUpdateCacheMut.Lock()
+		craftingEventUpdateCache[key] = cache
+		craftingEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q craftingEventQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for crafting_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for crafting_events")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CraftingEventSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), craftingEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"crafting_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, craftingEventPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in craftingEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all craftingEvent")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CraftingEvent) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no crafting_events provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(craftingEventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	craftingEventUpsertCacheMut.RLock()
+	cache, cached := craftingEventUpsertCache[key]
+	craftingEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			craftingEventAllColumns,
+			craftingEventColumnsWithDefault,
+			craftingEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			craftingEventAllColumns,
+			craftingEventPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert crafting_events, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(craftingEventPrimaryKeyColumns))
+			copy(conflict, craftingEventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"crafting_events\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(craftingEventType, craftingEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(craftingEventType, craftingEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert crafting_events")
+	}
+
+	if !cached {
+		craftingEventUpsertCacheMut.Lock()
+		craftingEventUpsertCache[key] = cache
+		craftingEventUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single CraftingEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CraftingEvent) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no CraftingEvent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), craftingEventPrimaryKeyMapping)
+	sql := "DELETE FROM \"crafting_events\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from crafting_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for crafting_events")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q craftingEventQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no craftingEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from crafting_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for crafting_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CraftingEventSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(craftingEventBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), craftingEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"crafting_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, craftingEventPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from craftingEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for crafting_events")
+	}
+
+	if len(craftingEventAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CraftingEvent) Reload(exec boil.Executor) error {
+	ret, err := FindCraftingEvent(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CraftingEventSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CraftingEventSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), craftingEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"crafting_events\".* FROM \"crafting_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, craftingEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in CraftingEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CraftingEventExists checks if the CraftingEvent row exists.
+func CraftingEventExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"crafting_events\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if crafting_events exists")
+	}
+
+	return exists, nil
+}
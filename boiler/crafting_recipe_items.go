@@ -0,0 +1,1255 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// CraftingRecipeItem is an object representing the database table.
+type CraftingRecipeItem struct {
+	ID              string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	RecipeID        string      `boiler:"recipe_id" boil:"recipe_id" json:"recipe_id" toml:"recipe_id" yaml:"recipe_id"`
+	Side            string      `boiler:"side" boil:"side" json:"side" toml:"side" yaml:"side"`
+	CollectionID    string      `boiler:"collection_id" boil:"collection_id" json:"collection_id" toml:"collection_id" yaml:"collection_id"`
+	ExternalTokenID null.Int    `boiler:"external_token_id" boil:"external_token_id" json:"external_token_id,omitempty" toml:"external_token_id" yaml:"external_token_id,omitempty"`
+	Tier            null.String `boiler:"tier" boil:"tier" json:"tier,omitempty" toml:"tier" yaml:"tier,omitempty"`
+	Amount          int         `boiler:"amount" boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	CreatedAt       time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *craftingRecipeItemR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L craftingRecipeItemL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CraftingRecipeItemColumns = struct {
+	ID              string
+	RecipeID        string
+	Side            string
+	CollectionID    string
+	ExternalTokenID string
+	Tier            string
+	Amount          string
+	CreatedAt       string
+}{
+	ID:              "id",
+	RecipeID:        "recipe_id",
+	Side:            "side",
+	CollectionID:    "collection_id",
+	ExternalTokenID: "external_token_id",
+	Tier:            "tier",
+	Amount:          "amount",
+	CreatedAt:       "created_at",
+}
+
+var CraftingRecipeItemTableColumns = struct {
+	ID              string
+	RecipeID        string
+	Side            string
+	CollectionID    string
+	ExternalTokenID string
+	Tier            string
+	Amount          string
+	CreatedAt       string
+}{
+	ID:              "crafting_recipe_items.id",
+	RecipeID:        "crafting_recipe_items.recipe_id",
+	Side:            "crafting_recipe_items.side",
+	CollectionID:    "crafting_recipe_items.collection_id",
+	ExternalTokenID: "crafting_recipe_items.external_token_id",
+	Tier:            "crafting_recipe_items.tier",
+	Amount:          "crafting_recipe_items.amount",
+	CreatedAt:       "crafting_recipe_items.created_at",
+}
+
+// Generated where
+
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var CraftingRecipeItemWhere = struct {
+	ID              whereHelperstring
+	RecipeID        whereHelperstring
+	Side            whereHelperstring
+	CollectionID    whereHelperstring
+	ExternalTokenID whereHelpernull_Int
+	Tier            whereHelpernull_String
+	Amount          whereHelperint
+	CreatedAt       whereHelpertime_Time
+}{
+	ID:              whereHelperstring{field: "\"crafting_recipe_items\".\"id\""},
+	RecipeID:        whereHelperstring{field: "\"crafting_recipe_items\".\"recipe_id\""},
+	Side:            whereHelperstring{field: "\"crafting_recipe_items\".\"side\""},
+	CollectionID:    whereHelperstring{field: "\"crafting_recipe_items\".\"collection_id\""},
+	ExternalTokenID: whereHelpernull_Int{field: "\"crafting_recipe_items\".\"external_token_id\""},
+	Tier:            whereHelpernull_String{field: "\"crafting_recipe_items\".\"tier\""},
+	Amount:          whereHelperint{field: "\"crafting_recipe_items\".\"amount\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"crafting_recipe_items\".\"created_at\""},
+}
+
+// CraftingRecipeItemRels is where relationship names are stored.
+var CraftingRecipeItemRels = struct {
+	Collection string
+	Recipe     string
+}{
+	Collection: "Collection",
+	Recipe:     "Recipe",
+}
+
+// craftingRecipeItemR is where relationships are stored.
+type craftingRecipeItemR struct {
+	Collection *Collection     `boiler:"Collection" boil:"Collection" json:"Collection" toml:"Collection" yaml:"Collection"`
+	Recipe     *CraftingRecipe `boiler:"Recipe" boil:"Recipe" json:"Recipe" toml:"Recipe" yaml:"Recipe"`
+}
+
+// NewStruct creates a new relationship struct
+func (*craftingRecipeItemR) NewStruct() *craftingRecipeItemR {
+	return &craftingRecipeItemR{}
+}
+
+// craftingRecipeItemL is where Load methods for each relationship are stored.
+type craftingRecipeItemL struct{}
+
+var (
+	craftingRecipeItemAllColumns            = []string{"id", "recipe_id", "side", "collection_id", "external_token_id", "tier", "amount", "created_at"}
+	craftingRecipeItemColumnsWithoutDefault = []string{"recipe_id", "side", "collection_id", "amount"}
+	craftingRecipeItemColumnsWithDefault    = []string{"id", "external_token_id", "tier", "created_at"}
+	craftingRecipeItemPrimaryKeyColumns     = []string{"id"}
+	craftingRecipeItemGeneratedColumns      = []string{}
+)
+
+type (
+	// CraftingRecipeItemSlice is an alias for a slice of pointers to CraftingRecipeItem.
+	// This should almost always be used instead of []CraftingRecipeItem.
+	CraftingRecipeItemSlice []*CraftingRecipeItem
+	// CraftingRecipeItemHook is the signature for custom CraftingRecipeItem hook methods
+	CraftingRecipeItemHook func(boil.Executor, *CraftingRecipeItem) error
+
+	craftingRecipeItemQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	craftingRecipeItemType                 = reflect.TypeOf(&CraftingRecipeItem{})
+	craftingRecipeItemMapping              = queries.MakeStructMapping(craftingRecipeItemType)
+	craftingRecipeItemPrimaryKeyMapping, _ = queries.BindMapping(craftingRecipeItemType, craftingRecipeItemMapping, craftingRecipeItemPrimaryKeyColumns)
+	craftingRecipeItemInsertCacheMut       sync.RWMutex
+	craftingRecipeItemInsertCache          = make(map[string]insertCache)
+	craftingRecipeItemUpdateCacheMut       sync.RWMutex
+	craftingRecipeItemUpdateCache          = make(map[string]updateCache)
+	craftingRecipeItemUpsertCacheMut       sync.RWMutex
+	craftingRecipeItemUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var craftingRecipeItemAfterSelectHooks []CraftingRecipeItemHook
+
+var craftingRecipeItemBeforeInsertHooks []CraftingRecipeItemHook
+var craftingRecipeItemAfterInsertHooks []CraftingRecipeItemHook
+
+var craftingRecipeItemBeforeUpdateHooks []CraftingRecipeItemHook
+var craftingRecipeItemAfterUpdateHooks []CraftingRecipeItemHook
+
+var craftingRecipeItemBeforeDeleteHooks []CraftingRecipeItemHook
+var craftingRecipeItemAfterDeleteHooks []CraftingRecipeItemHook
+
+var craftingRecipeItemBeforeUpsertHooks []CraftingRecipeItemHook
+var craftingRecipeItemAfterUpsertHooks []CraftingRecipeItemHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CraftingRecipeItem) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeItemAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CraftingRecipeItem) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeItemBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CraftingRecipeItem) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeItemAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CraftingRecipeItem) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeItemBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CraftingRecipeItem) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeItemAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CraftingRecipeItem) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeItemBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CraftingRecipeItem) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeItemAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CraftingRecipeItem) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeItemBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CraftingRecipeItem) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeItemAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCraftingRecipeItemHook registers your hook function for all future operations.
+func AddCraftingRecipeItemHook(hookPoint boil.HookPoint, craftingRecipeItemHook CraftingRecipeItemHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		craftingRecipeItemAfterSelectHooks = append(craftingRecipeItemAfterSelectHooks, craftingRecipeItemHook)
+	case boil.BeforeInsertHook:
+		craftingRecipeItemBeforeInsertHooks = append(craftingRecipeItemBeforeInsertHooks, craftingRecipeItemHook)
+	case boil.AfterInsertHook:
+		craftingRecipeItemAfterInsertHooks = append(craftingRecipeItemAfterInsertHooks, craftingRecipeItemHook)
+	case boil.BeforeUpdateHook:
+		craftingRecipeItemBeforeUpdateHooks = append(craftingRecipeItemBeforeUpdateHooks, craftingRecipeItemHook)
+	case boil.AfterUpdateHook:
+		craftingRecipeItemAfterUpdateHooks = append(craftingRecipeItemAfterUpdateHooks, craftingRecipeItemHook)
+	case boil.BeforeDeleteHook:
+		craftingRecipeItemBeforeDeleteHooks = append(craftingRecipeItemBeforeDeleteHooks, craftingRecipeItemHook)
+	case boil.AfterDeleteHook:
+		craftingRecipeItemAfterDeleteHooks = append(craftingRecipeItemAfterDeleteHooks, craftingRecipeItemHook)
+	case boil.BeforeUpsertHook:
+		craftingRecipeItemBeforeUpsertHooks = append(craftingRecipeItemBeforeUpsertHooks, craftingRecipeItemHook)
+	case boil.AfterUpsertHook:
+		craftingRecipeItemAfterUpsertHooks = append(craftingRecipeItemAfterUpsertHooks, craftingRecipeItemHook)
+	}
+}
+
+// One returns a single craftingRecipeItem record from the query.
+func (q craftingRecipeItemQuery) One(exec boil.Executor) (*CraftingRecipeItem, error) {
+	o := &CraftingRecipeItem{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for crafting_recipe_items")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CraftingRecipeItem records from the query.
+func (q craftingRecipeItemQuery) All(exec boil.Executor) (CraftingRecipeItemSlice, error) {
+	var o []*CraftingRecipeItem
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to CraftingRecipeItem slice")
+	}
+
+	if len(craftingRecipeItemAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CraftingRecipeItem records in the query.
+func (q craftingRecipeItemQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count crafting_recipe_items rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q craftingRecipeItemQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if crafting_recipe_items exists")
+	}
+
+	return count > 0, nil
+}
+
+// Collection pointed to by the foreign key.
+func (o *CraftingRecipeItem) Collection(mods ...qm.QueryMod) collectionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CollectionID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Collections(queryMods...)
+	queries.SetFrom(query.Query, "\"collections\"")
+
+	return query
+}
+
+// Recipe pointed to by the foreign key.
+func (o *CraftingRecipeItem) Recipe(mods ...qm.QueryMod) craftingRecipeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RecipeID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := CraftingRecipes(queryMods...)
+	queries.SetFrom(query.Query, "\"crafting_recipes\"")
+
+	return query
+}
+
+// LoadCollection allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (craftingRecipeItemL) LoadCollection(e boil.Executor, singular bool, maybeCraftingRecipeItem interface{}, mods queries.Applicator) error {
+	var slice []*CraftingRecipeItem
+	var object *CraftingRecipeItem
+
+	if singular {
+		object = maybeCraftingRecipeItem.(*CraftingRecipeItem)
+	} else {
+		slice = *maybeCraftingRecipeItem.(*[]*CraftingRecipeItem)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &craftingRecipeItemR{}
+		}
+		args = append(args, object.CollectionID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &craftingRecipeItemR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CollectionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CollectionID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`collections`),
+		qm.WhereIn(`collections.id in ?`, args...),
+		qmhelper.WhereIsNull(`collections.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Collection")
+	}
+
+	var resultSlice []*Collection
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Collection")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for collections")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collections")
+	}
+
+	if len(craftingRecipeItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Collection = foreign
+		if foreign.R == nil {
+			foreign.R = &collectionR{}
+		}
+		foreign.R.CraftingRecipeItems = append(foreign.R.CraftingRecipeItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CollectionID == foreign.ID {
+				local.R.Collection = foreign
+				if foreign.R == nil {
+					foreign.R = &collectionR{}
+				}
+				foreign.R.CraftingRecipeItems = append(foreign.R.CraftingRecipeItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRecipe allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (craftingRecipeItemL) LoadRecipe(e boil.Executor, singular bool, maybeCraftingRecipeItem interface{}, mods queries.Applicator) error {
+	var slice []*CraftingRecipeItem
+	var object *CraftingRecipeItem
+
+	if singular {
+		object = maybeCraftingRecipeItem.(*CraftingRecipeItem)
+	} else {
+		slice = *maybeCraftingRecipeItem.(*[]*CraftingRecipeItem)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &craftingRecipeItemR{}
+		}
+		args = append(args, object.RecipeID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &craftingRecipeItemR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RecipeID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RecipeID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`crafting_recipes`),
+		qm.WhereIn(`crafting_recipes.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load CraftingRecipe")
+	}
+
+	var resultSlice []*CraftingRecipe
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice CraftingRecipe")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for crafting_recipes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for crafting_recipes")
+	}
+
+	if len(craftingRecipeItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Recipe = foreign
+		if foreign.R == nil {
+			foreign.R = &craftingRecipeR{}
+		}
+		foreign.R.RecipeCraftingRecipeItems = append(foreign.R.RecipeCraftingRecipeItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RecipeID == foreign.ID {
+				local.R.Recipe = foreign
+				if foreign.R == nil {
+					foreign.R = &craftingRecipeR{}
+				}
+				foreign.R.RecipeCraftingRecipeItems = append(foreign.R.RecipeCraftingRecipeItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCollection of the craftingRecipeItem to the related item.
+// Sets o.R.Collection to related.
+// Adds o to related.R.CraftingRecipeItems.
+func (o *CraftingRecipeItem) SetCollection(exec boil.Executor, insert bool, related *Collection) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"crafting_recipe_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+		strmangle.WhereClause("\"", "\"", 2, craftingRecipeItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CollectionID = related.ID
+	if o.R == nil {
+		o.R = &craftingRecipeItemR{
+			Collection: related,
+		}
+	} else {
+		o.R.Collection = related
+	}
+
+	if related.R == nil {
+		related.R = &collectionR{
+			CraftingRecipeItems: CraftingRecipeItemSlice{o},
+		}
+	} else {
+		related.R.CraftingRecipeItems = append(related.R.CraftingRecipeItems, o)
+	}
+
+	return nil
+}
+
+// SetRecipe of the craftingRecipeItem to the related item.
+// Sets o.R.Recipe to related.
+// Adds o to related.R.RecipeCraftingRecipeItems.
+func (o *CraftingRecipeItem) SetRecipe(exec boil.Executor, insert bool, related *CraftingRecipe) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"crafting_recipe_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"recipe_id"}),
+		strmangle.WhereClause("\"", "\"", 2, craftingRecipeItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RecipeID = related.ID
+	if o.R == nil {
+		o.R = &craftingRecipeItemR{
+			Recipe: related,
+		}
+	} else {
+		o.R.Recipe = related
+	}
+
+	if related.R == nil {
+		related.R = &craftingRecipeR{
+			RecipeCraftingRecipeItems: CraftingRecipeItemSlice{o},
+		}
+	} else {
+		related.R.RecipeCraftingRecipeItems = append(related.R.RecipeCraftingRecipeItems, o)
+	}
+
+	return nil
+}
+
+// CraftingRecipeItems retrieves all the records using an executor.
+func CraftingRecipeItems(mods ...qm.QueryMod) craftingRecipeItemQuery {
+	mods = append(mods, qm.From("\"crafting_recipe_items\""))
+	return craftingRecipeItemQuery{NewQuery(mods...)}
+}
+
+// FindCraftingRecipeItem retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCraftingRecipeItem(exec boil.Executor, iD string, selectCols ...string) (*CraftingRecipeItem, error) {
+	craftingRecipeItemObj := &CraftingRecipeItem{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"crafting_recipe_items\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, craftingRecipeItemObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from crafting_recipe_items")
+	}
+
+	if err = craftingRecipeItemObj.doAfterSelectHooks(exec); err != nil {
+		return craftingRecipeItemObj, err
+	}
+
+	return craftingRecipeItemObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CraftingRecipeItem) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no crafting_recipe_items provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(craftingRecipeItemColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	craftingRecipeItemInsertCacheMut.RLock()
+	cache, cached := craftingRecipeItemInsertCache[key]
+	craftingRecipeItemInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			craftingRecipeItemAllColumns,
+			craftingRecipeItemColumnsWithDefault,
+			craftingRecipeItemColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(craftingRecipeItemType, craftingRecipeItemMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(craftingRecipeItemType, craftingRecipeItemMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"crafting_recipe_items\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"crafting_recipe_items\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into crafting_recipe_items")
+	}
+
+	if !cached {
+		craftingRecipeItemInsertCacheMut.Lock()
+		craftingRecipeItemInsertCache[key] = cache
+		craftingRecipeItemInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the CraftingRecipeItem.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CraftingRecipeItem) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	craftingRecipeItemUpdateCacheMut.RLock()
+	cache, cached := craftingRecipeItemUpdateCache[key]
+	craftingRecipeItemUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			craftingRecipeItemAllColumns,
+			craftingRecipeItemPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update crafting_recipe_items, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"crafting_recipe_items\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, craftingRecipeItemPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(craftingRecipeItemType, craftingRecipeItemMapping, append(wl, craftingRecipeItemPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update crafting_recipe_items row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for crafting_recipe_items")
+	}
+
+	if !cached {
+		craftingRecipeItemUpdateCacheMut.Lock()
+		craftingRecipeItemUpdateCache[key] = cache
+		craftingRecipeItemUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q craftingRecipeItemQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for crafting_recipe_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for crafting_recipe_items")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CraftingRecipeItemSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), craftingRecipeItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"crafting_recipe_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, craftingRecipeItemPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in craftingRecipeItem slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all craftingRecipeItem")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CraftingRecipeItem) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no crafting_recipe_items provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(craftingRecipeItemColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	craftingRecipeItemUpsertCacheMut.RLock()
+	cache, cached := craftingRecipeItemUpsertCache[key]
+	craftingRecipeItemUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			craftingRecipeItemAllColumns,
+			craftingRecipeItemColumnsWithDefault,
+			craftingRecipeItemColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			craftingRecipeItemAllColumns,
+			craftingRecipeItemPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert crafting_recipe_items, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(craftingRecipeItemPrimaryKeyColumns))
+			copy(conflict, craftingRecipeItemPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"crafting_recipe_items\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(craftingRecipeItemType, craftingRecipeItemMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(craftingRecipeItemType, craftingRecipeItemMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert crafting_recipe_items")
+	}
+
+	if !cached {
+		craftingRecipeItemUpsertCacheMut.Lock()
+		craftingRecipeItemUpsertCache[key] = cache
+		craftingRecipeItemUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single CraftingRecipeItem record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CraftingRecipeItem) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no CraftingRecipeItem provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), craftingRecipeItemPrimaryKeyMapping)
+	sql := "DELETE FROM \"crafting_recipe_items\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from crafting_recipe_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for crafting_recipe_items")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q craftingRecipeItemQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no craftingRecipeItemQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from crafting_recipe_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for crafting_recipe_items")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CraftingRecipeItemSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(craftingRecipeItemBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), craftingRecipeItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"crafting_recipe_items\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, craftingRecipeItemPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from craftingRecipeItem slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for crafting_recipe_items")
+	}
+
+	if len(craftingRecipeItemAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CraftingRecipeItem) Reload(exec boil.Executor) error {
+	ret, err := FindCraftingRecipeItem(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CraftingRecipeItemSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CraftingRecipeItemSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), craftingRecipeItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"crafting_recipe_items\".* FROM \"crafting_recipe_items\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, craftingRecipeItemPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in CraftingRecipeItemSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CraftingRecipeItemExists checks if the CraftingRecipeItem row exists.
+func CraftingRecipeItemExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"crafting_recipe_items\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if crafting_recipe_items exists")
+	}
+
+	return exists, nil
+}
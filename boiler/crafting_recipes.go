@@ -0,0 +1,1420 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// CraftingRecipe is an object representing the database table.
+type CraftingRecipe struct {
+	ID          string          `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name        string          `boiler:"name" boil:"name" json:"name" toml:"name" yaml:"name"`
+	Description string          `boiler:"description" boil:"description" json:"description" toml:"description" yaml:"description"`
+	SupsCost    decimal.Decimal `boiler:"sups_cost" boil:"sups_cost" json:"sups_cost" toml:"sups_cost" yaml:"sups_cost"`
+	IsActive    bool            `boiler:"is_active" boil:"is_active" json:"is_active" toml:"is_active" yaml:"is_active"`
+	CreatedByID string          `boiler:"created_by_id" boil:"created_by_id" json:"created_by_id" toml:"created_by_id" yaml:"created_by_id"`
+	UpdatedAt   time.Time       `boiler:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt   time.Time       `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *craftingRecipeR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L craftingRecipeL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CraftingRecipeColumns = struct {
+	ID          string
+	Name        string
+	Description string
+	SupsCost    string
+	IsActive    string
+	CreatedByID string
+	UpdatedAt   string
+	CreatedAt   string
+}{
+	ID:          "id",
+	Name:        "name",
+	Description: "description",
+	SupsCost:    "sups_cost",
+	IsActive:    "is_active",
+	CreatedByID: "created_by_id",
+	UpdatedAt:   "updated_at",
+	CreatedAt:   "created_at",
+}
+
+var CraftingRecipeTableColumns = struct {
+	ID          string
+	Name        string
+	Description string
+	SupsCost    string
+	IsActive    string
+	CreatedByID string
+	UpdatedAt   string
+	CreatedAt   string
+}{
+	ID:          "crafting_recipes.id",
+	Name:        "crafting_recipes.name",
+	Description: "crafting_recipes.description",
+	SupsCost:    "crafting_recipes.sups_cost",
+	IsActive:    "crafting_recipes.is_active",
+	CreatedByID: "crafting_recipes.created_by_id",
+	UpdatedAt:   "crafting_recipes.updated_at",
+	CreatedAt:   "crafting_recipes.created_at",
+}
+
+// Generated where
+
+var CraftingRecipeWhere = struct {
+	ID          whereHelperstring
+	Name        whereHelperstring
+	Description whereHelperstring
+	SupsCost    whereHelperdecimal_Decimal
+	IsActive    whereHelperbool
+	CreatedByID whereHelperstring
+	UpdatedAt   whereHelpertime_Time
+	CreatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperstring{field: "\"crafting_recipes\".\"id\""},
+	Name:        whereHelperstring{field: "\"crafting_recipes\".\"name\""},
+	Description: whereHelperstring{field: "\"crafting_recipes\".\"description\""},
+	SupsCost:    whereHelperdecimal_Decimal{field: "\"crafting_recipes\".\"sups_cost\""},
+	IsActive:    whereHelperbool{field: "\"crafting_recipes\".\"is_active\""},
+	CreatedByID: whereHelperstring{field: "\"crafting_recipes\".\"created_by_id\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"crafting_recipes\".\"updated_at\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"crafting_recipes\".\"created_at\""},
+}
+
+// CraftingRecipeRels is where relationship names are stored.
+var CraftingRecipeRels = struct {
+	CreatedBy                 string
+	RecipeCraftingEvents      string
+	RecipeCraftingRecipeItems string
+}{
+	CreatedBy:                 "CreatedBy",
+	RecipeCraftingEvents:      "RecipeCraftingEvents",
+	RecipeCraftingRecipeItems: "RecipeCraftingRecipeItems",
+}
+
+// craftingRecipeR is where relationships are stored.
+type craftingRecipeR struct {
+	CreatedBy                 *User                   `boiler:"CreatedBy" boil:"CreatedBy" json:"CreatedBy" toml:"CreatedBy" yaml:"CreatedBy"`
+	RecipeCraftingEvents      CraftingEventSlice      `boiler:"RecipeCraftingEvents" boil:"RecipeCraftingEvents" json:"RecipeCraftingEvents" toml:"RecipeCraftingEvents" yaml:"RecipeCraftingEvents"`
+	RecipeCraftingRecipeItems CraftingRecipeItemSlice `boiler:"RecipeCraftingRecipeItems" boil:"RecipeCraftingRecipeItems" json:"RecipeCraftingRecipeItems" toml:"RecipeCraftingRecipeItems" yaml:"RecipeCraftingRecipeItems"`
+}
+
+// NewStruct creates a new relationship struct
+func (*craftingRecipeR) NewStruct() *craftingRecipeR {
+	return &craftingRecipeR{}
+}
+
+// craftingRecipeL is where Load methods for each relationship are stored.
+type craftingRecipeL struct{}
+
+var (
+	craftingRecipeAllColumns            = []string{"id", "name", "description", "sups_cost", "is_active", "created_by_id", "updated_at", "created_at"}
+	craftingRecipeColumnsWithoutDefault = []string{"name", "created_by_id"}
+	craftingRecipeColumnsWithDefault    = []string{"id", "description", "sups_cost", "is_active", "updated_at", "created_at"}
+	craftingRecipePrimaryKeyColumns     = []string{"id"}
+	craftingRecipeGeneratedColumns      = []string{}
+)
+
+type (
+	// CraftingRecipeSlice is an alias for a slice of pointers to CraftingRecipe.
+	// This should almost always be used instead of []CraftingRecipe.
+	CraftingRecipeSlice []*CraftingRecipe
+	// CraftingRecipeHook is the signature for custom CraftingRecipe hook methods
+	CraftingRecipeHook func(boil.Executor, *CraftingRecipe) error
+
+	craftingRecipeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	craftingRecipeType                 = reflect.TypeOf(&CraftingRecipe{})
+	craftingRecipeMapping              = queries.MakeStructMapping(craftingRecipeType)
+	craftingRecipePrimaryKeyMapping, _ = queries.BindMapping(craftingRecipeType, craftingRecipeMapping, craftingRecipePrimaryKeyColumns)
+	craftingRecipeInsertCacheMut       sync.RWMutex
+	craftingRecipeInsertCache          = make(map[string]insertCache)
+	craftingRecipeUpdateCacheMut       sync.RWMutex
+	craftingRecipeUpdateCache          = make(map[string]updateCache)
+	craftingRecipeUpsertCacheMut       sync.RWMutex
+	craftingRecipeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var craftingRecipeAfterSelectHooks []CraftingRecipeHook
+
+var craftingRecipeBeforeInsertHooks []CraftingRecipeHook
+var craftingRecipeAfterInsertHooks []CraftingRecipeHook
+
+var craftingRecipeBeforeUpdateHooks []CraftingRecipeHook
+var craftingRecipeAfterUpdateHooks []CraftingRecipeHook
+
+var craftingRecipeBeforeDeleteHooks []CraftingRecipeHook
+var craftingRecipeAfterDeleteHooks []CraftingRecipeHook
+
+var craftingRecipeBeforeUpsertHooks []CraftingRecipeHook
+var craftingRecipeAfterUpsertHooks []CraftingRecipeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CraftingRecipe) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CraftingRecipe) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CraftingRecipe) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CraftingRecipe) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CraftingRecipe) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CraftingRecipe) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CraftingRecipe) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CraftingRecipe) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CraftingRecipe) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range craftingRecipeAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCraftingRecipeHook registers your hook function for all future operations.
+func AddCraftingRecipeHook(hookPoint boil.HookPoint, craftingRecipeHook CraftingRecipeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		craftingRecipeAfterSelectHooks = append(craftingRecipeAfterSelectHooks, craftingRecipeHook)
+	case boil.BeforeInsertHook:
+		craftingRecipeBeforeInsertHooks = append(craftingRecipeBeforeInsertHooks, craftingRecipeHook)
+	case boil.AfterInsertHook:
+		craftingRecipeAfterInsertHooks = append(craftingRecipeAfterInsertHooks, craftingRecipeHook)
+	case boil.BeforeUpdateHook:
+		craftingRecipeBeforeUpdateHooks = append(craftingRecipeBeforeUpdateHooks, craftingRecipeHook)
+	case boil.AfterUpdateHook:
+		craftingRecipeAfterUpdateHooks = append(craftingRecipeAfterUpdateHooks, craftingRecipeHook)
+	case boil.BeforeDeleteHook:
+		craftingRecipeBeforeDeleteHooks = append(craftingRecipeBeforeDeleteHooks, craftingRecipeHook)
+	case boil.AfterDeleteHook:
+		craftingRecipeAfterDeleteHooks = append(craftingRecipeAfterDeleteHooks, craftingRecipeHook)
+	case boil.BeforeUpsertHook:
+		craftingRecipeBeforeUpsertHooks = append(craftingRecipeBeforeUpsertHooks, craftingRecipeHook)
+	case boil.AfterUpsertHook:
+		craftingRecipeAfterUpsertHooks = append(craftingRecipeAfterUpsertHooks, craftingRecipeHook)
+	}
+}
+
+// One returns a single craftingRecipe record from the query.
+func (q craftingRecipeQuery) One(exec boil.Executor) (*CraftingRecipe, error) {
+	o := &CraftingRecipe{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for crafting_recipes")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CraftingRecipe records from the query.
+func (q craftingRecipeQuery) All(exec boil.Executor) (CraftingRecipeSlice, error) {
+	var o []*CraftingRecipe
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to CraftingRecipe slice")
+	}
+
+	if len(craftingRecipeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CraftingRecipe records in the query.
+func (q craftingRecipeQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count crafting_recipes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q craftingRecipeQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if crafting_recipes exists")
+	}
+
+	return count > 0, nil
+}
+
+// CreatedBy pointed to by the foreign key.
+func (o *CraftingRecipe) CreatedBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CreatedByID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// RecipeCraftingEvents retrieves all the crafting_event's CraftingEvents with an executor via recipe_id column.
+func (o *CraftingRecipe) RecipeCraftingEvents(mods ...qm.QueryMod) craftingEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"crafting_events\".\"recipe_id\"=?", o.ID),
+	)
+
+	query := CraftingEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"crafting_events\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"crafting_events\".*"})
+	}
+
+	return query
+}
+
+// RecipeCraftingRecipeItems retrieves all the crafting_recipe_item's CraftingRecipeItems with an executor via recipe_id column.
+func (o *CraftingRecipe) RecipeCraftingRecipeItems(mods ...qm.QueryMod) craftingRecipeItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"crafting_recipe_items\".\"recipe_id\"=?", o.ID),
+	)
+
+	query := CraftingRecipeItems(queryMods...)
+	queries.SetFrom(query.Query, "\"crafting_recipe_items\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"crafting_recipe_items\".*"})
+	}
+
+	return query
+}
+
+// LoadCreatedBy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (craftingRecipeL) LoadCreatedBy(e boil.Executor, singular bool, maybeCraftingRecipe interface{}, mods queries.Applicator) error {
+	var slice []*CraftingRecipe
+	var object *CraftingRecipe
+
+	if singular {
+		object = maybeCraftingRecipe.(*CraftingRecipe)
+	} else {
+		slice = *maybeCraftingRecipe.(*[]*CraftingRecipe)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &craftingRecipeR{}
+		}
+		args = append(args, object.CreatedByID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &craftingRecipeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CreatedByID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CreatedByID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(craftingRecipeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CreatedBy = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CreatedByCraftingRecipes = append(foreign.R.CreatedByCraftingRecipes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CreatedByID == foreign.ID {
+				local.R.CreatedBy = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CreatedByCraftingRecipes = append(foreign.R.CreatedByCraftingRecipes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRecipeCraftingEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (craftingRecipeL) LoadRecipeCraftingEvents(e boil.Executor, singular bool, maybeCraftingRecipe interface{}, mods queries.Applicator) error {
+	var slice []*CraftingRecipe
+	var object *CraftingRecipe
+
+	if singular {
+		object = maybeCraftingRecipe.(*CraftingRecipe)
+	} else {
+		slice = *maybeCraftingRecipe.(*[]*CraftingRecipe)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &craftingRecipeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &craftingRecipeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`crafting_events`),
+		qm.WhereIn(`crafting_events.recipe_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load crafting_events")
+	}
+
+	var resultSlice []*CraftingEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice crafting_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on crafting_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for crafting_events")
+	}
+
+	if len(craftingEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RecipeCraftingEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &craftingEventR{}
+			}
+			foreign.R.Recipe = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RecipeID {
+				local.R.RecipeCraftingEvents = append(local.R.RecipeCraftingEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &craftingEventR{}
+				}
+				foreign.R.Recipe = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRecipeCraftingRecipeItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (craftingRecipeL) LoadRecipeCraftingRecipeItems(e boil.Executor, singular bool, maybeCraftingRecipe interface{}, mods queries.Applicator) error {
+	var slice []*CraftingRecipe
+	var object *CraftingRecipe
+
+	if singular {
+		object = maybeCraftingRecipe.(*CraftingRecipe)
+	} else {
+		slice = *maybeCraftingRecipe.(*[]*CraftingRecipe)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &craftingRecipeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &craftingRecipeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`crafting_recipe_items`),
+		qm.WhereIn(`crafting_recipe_items.recipe_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load crafting_recipe_items")
+	}
+
+	var resultSlice []*CraftingRecipeItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice crafting_recipe_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on crafting_recipe_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for crafting_recipe_items")
+	}
+
+	if len(craftingRecipeItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RecipeCraftingRecipeItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &craftingRecipeItemR{}
+			}
+			foreign.R.Recipe = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RecipeID {
+				local.R.RecipeCraftingRecipeItems = append(local.R.RecipeCraftingRecipeItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &craftingRecipeItemR{}
+				}
+				foreign.R.Recipe = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCreatedBy of the craftingRecipe to the related item.
+// Sets o.R.CreatedBy to related.
+// Adds o to related.R.CreatedByCraftingRecipes.
+func (o *CraftingRecipe) SetCreatedBy(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"crafting_recipes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+		strmangle.WhereClause("\"", "\"", 2, craftingRecipePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CreatedByID = related.ID
+	if o.R == nil {
+		o.R = &craftingRecipeR{
+			CreatedBy: related,
+		}
+	} else {
+		o.R.CreatedBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatedByCraftingRecipes: CraftingRecipeSlice{o},
+		}
+	} else {
+		related.R.CreatedByCraftingRecipes = append(related.R.CreatedByCraftingRecipes, o)
+	}
+
+	return nil
+}
+
+// AddRecipeCraftingEvents adds the given related objects to the existing relationships
+// of the crafting_recipe, optionally inserting them as new records.
+// Appends related to o.R.RecipeCraftingEvents.
+// Sets related.R.Recipe appropriately.
+func (o *CraftingRecipe) AddRecipeCraftingEvents(exec boil.Executor, insert bool, related ...*CraftingEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RecipeID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"crafting_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"recipe_id"}),
+				strmangle.WhereClause("\"", "\"", 2, craftingEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RecipeID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &craftingRecipeR{
+			RecipeCraftingEvents: related,
+		}
+	} else {
+		o.R.RecipeCraftingEvents = append(o.R.RecipeCraftingEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &craftingEventR{
+				Recipe: o,
+			}
+		} else {
+			rel.R.Recipe = o
+		}
+	}
+	return nil
+}
+
+// AddRecipeCraftingRecipeItems adds the given related objects to the existing relationships
+// of the crafting_recipe, optionally inserting them as new records.
+// Appends related to o.R.RecipeCraftingRecipeItems.
+// Sets related.R.Recipe appropriately.
+func (o *CraftingRecipe) AddRecipeCraftingRecipeItems(exec boil.Executor, insert bool, related ...*CraftingRecipeItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RecipeID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"crafting_recipe_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"recipe_id"}),
+				strmangle.WhereClause("\"", "\"", 2, craftingRecipeItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RecipeID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &craftingRecipeR{
+			RecipeCraftingRecipeItems: related,
+		}
+	} else {
+		o.R.RecipeCraftingRecipeItems = append(o.R.RecipeCraftingRecipeItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &craftingRecipeItemR{
+				Recipe: o,
+			}
+		} else {
+			rel.R.Recipe = o
+		}
+	}
+	return nil
+}
+
+// CraftingRecipes retrieves all the records using an executor.
+func CraftingRecipes(mods ...qm.QueryMod) craftingRecipeQuery {
+	mods = append(mods, qm.From("\"crafting_recipes\""))
+	return craftingRecipeQuery{NewQuery(mods...)}
+}
+
+// FindCraftingRecipe retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCraftingRecipe(exec boil.Executor, iD string, selectCols ...string) (*CraftingRecipe, error) {
+	craftingRecipeObj := &CraftingRecipe{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"crafting_recipes\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, craftingRecipeObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from crafting_recipes")
+	}
+
+	if err = craftingRecipeObj.doAfterSelectHooks(exec); err != nil {
+		return craftingRecipeObj, err
+	}
+
+	return craftingRecipeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CraftingRecipe) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no crafting_recipes provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(craftingRecipeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	craftingRecipeInsertCacheMut.RLock()
+	cache, cached := craftingRecipeInsertCache[key]
+	craftingRecipeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			craftingRecipeAllColumns,
+			craftingRecipeColumnsWithDefault,
+			craftingRecipeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(craftingRecipeType, craftingRecipeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(craftingRecipeType, craftingRecipeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"crafting_recipes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"crafting_recipes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into crafting_recipes")
+	}
+
+	if !cached {
+		craftingRecipeInsertCacheMut.Lock()
+		craftingRecipeInsertCache[key] = cache
+		craftingRecipeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the CraftingRecipe.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CraftingRecipe) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	craftingRecipeUpdateCacheMut.RLock()
+	cache, cached := craftingRecipeUpdateCache[key]
+	craftingRecipeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			craftingRecipeAllColumns,
+			craftingRecipePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update crafting_recipes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"crafting_recipes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, craftingRecipePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(craftingRecipeType, craftingRecipeMapping, append(wl, craftingRecipePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update crafting_recipes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for crafting_recipes")
+	}
+
+	if !cached {
+		craftingRecipeUpdateCacheMut.Lock()
+		craftingRecipeUpdateCache[key] = cache
+		craftingRecipeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q craftingRecipeQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for crafting_recipes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for crafting_recipes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CraftingRecipeSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), craftingRecipePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"crafting_recipes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, craftingRecipePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in craftingRecipe slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all craftingRecipe")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CraftingRecipe) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no crafting_recipes provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(craftingRecipeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	craftingRecipeUpsertCacheMut.RLock()
+	cache, cached := craftingRecipeUpsertCache[key]
+	craftingRecipeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			craftingRecipeAllColumns,
+			craftingRecipeColumnsWithDefault,
+			craftingRecipeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			craftingRecipeAllColumns,
+			craftingRecipePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert crafting_recipes, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(craftingRecipePrimaryKeyColumns))
+			copy(conflict, craftingRecipePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"crafting_recipes\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(craftingRecipeType, craftingRecipeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(craftingRecipeType, craftingRecipeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert crafting_recipes")
+	}
+
+	if !cached {
+		craftingRecipeUpsertCacheMut.Lock()
+		craftingRecipeUpsertCache[key] = cache
+		craftingRecipeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single CraftingRecipe record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CraftingRecipe) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no CraftingRecipe provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), craftingRecipePrimaryKeyMapping)
+	sql := "DELETE FROM \"crafting_recipes\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from crafting_recipes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for crafting_recipes")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q craftingRecipeQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no craftingRecipeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from crafting_recipes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for crafting_recipes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CraftingRecipeSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(craftingRecipeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), craftingRecipePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"crafting_recipes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, craftingRecipePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from craftingRecipe slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for crafting_recipes")
+	}
+
+	if len(craftingRecipeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CraftingRecipe) Reload(exec boil.Executor) error {
+	ret, err := FindCraftingRecipe(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CraftingRecipeSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CraftingRecipeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), craftingRecipePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"crafting_recipes\".* FROM \"crafting_recipes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, craftingRecipePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in CraftingRecipeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CraftingRecipeExists checks if the CraftingRecipe row exists.
+func CraftingRecipeExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"crafting_recipes\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if crafting_recipes exists")
+	}
+
+	return exists, nil
+}
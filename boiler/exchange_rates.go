@@ -0,0 +1,1163 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ExchangeRate is an object representing the database table.
+type ExchangeRate struct {
+	ID          string          `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Symbol      string          `boiler:"symbol" boil:"symbol" json:"symbol" toml:"symbol" yaml:"symbol"`
+	Usd         decimal.Decimal `boiler:"usd" boil:"usd" json:"usd" toml:"usd" yaml:"usd"`
+	MinUsd      decimal.Decimal `boiler:"min_usd" boil:"min_usd" json:"min_usd" toml:"min_usd" yaml:"min_usd"`
+	MaxUsd      decimal.Decimal `boiler:"max_usd" boil:"max_usd" json:"max_usd" toml:"max_usd" yaml:"max_usd"`
+	Sources     string          `boiler:"sources" boil:"sources" json:"sources" toml:"sources" yaml:"sources"`
+	Trusted     bool            `boiler:"trusted" boil:"trusted" json:"trusted" toml:"trusted" yaml:"trusted"`
+	Resolution  string          `boiler:"resolution" boil:"resolution" json:"resolution" toml:"resolution" yaml:"resolution"`
+	SampleCount int             `boiler:"sample_count" boil:"sample_count" json:"sample_count" toml:"sample_count" yaml:"sample_count"`
+	RecordedAt  time.Time       `boiler:"recorded_at" boil:"recorded_at" json:"recorded_at" toml:"recorded_at" yaml:"recorded_at"`
+	CreatedAt   time.Time       `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *exchangeRateR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L exchangeRateL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ExchangeRateColumns = struct {
+	ID          string
+	Symbol      string
+	Usd         string
+	MinUsd      string
+	MaxUsd      string
+	Sources     string
+	Trusted     string
+	Resolution  string
+	SampleCount string
+	RecordedAt  string
+	CreatedAt   string
+}{
+	ID:          "id",
+	Symbol:      "symbol",
+	Usd:         "usd",
+	MinUsd:      "min_usd",
+	MaxUsd:      "max_usd",
+	Sources:     "sources",
+	Trusted:     "trusted",
+	Resolution:  "resolution",
+	SampleCount: "sample_count",
+	RecordedAt:  "recorded_at",
+	CreatedAt:   "created_at",
+}
+
+var ExchangeRateTableColumns = struct {
+	ID          string
+	Symbol      string
+	Usd         string
+	MinUsd      string
+	MaxUsd      string
+	Sources     string
+	Trusted     string
+	Resolution  string
+	SampleCount string
+	RecordedAt  string
+	CreatedAt   string
+}{
+	ID:          "exchange_rates.id",
+	Symbol:      "exchange_rates.symbol",
+	Usd:         "exchange_rates.usd",
+	MinUsd:      "exchange_rates.min_usd",
+	MaxUsd:      "exchange_rates.max_usd",
+	Sources:     "exchange_rates.sources",
+	Trusted:     "exchange_rates.trusted",
+	Resolution:  "exchange_rates.resolution",
+	SampleCount: "exchange_rates.sample_count",
+	RecordedAt:  "exchange_rates.recorded_at",
+	CreatedAt:   "exchange_rates.created_at",
+}
+
+// Generated where
+
+var ExchangeRateWhere = struct {
+	ID          whereHelperstring
+	Symbol      whereHelperstring
+	Usd         whereHelperdecimal_Decimal
+	MinUsd      whereHelperdecimal_Decimal
+	MaxUsd      whereHelperdecimal_Decimal
+	Sources     whereHelperstring
+	Trusted     whereHelperbool
+	Resolution  whereHelperstring
+	SampleCount whereHelperint
+	RecordedAt  whereHelpertime_Time
+	CreatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperstring{field: "\"exchange_rates\".\"id\""},
+	Symbol:      whereHelperstring{field: "\"exchange_rates\".\"symbol\""},
+	Usd:         whereHelperdecimal_Decimal{field: "\"exchange_rates\".\"usd\""},
+	MinUsd:      whereHelperdecimal_Decimal{field: "\"exchange_rates\".\"min_usd\""},
+	MaxUsd:      whereHelperdecimal_Decimal{field: "\"exchange_rates\".\"max_usd\""},
+	Sources:     whereHelperstring{field: "\"exchange_rates\".\"sources\""},
+	Trusted:     whereHelperbool{field: "\"exchange_rates\".\"trusted\""},
+	Resolution:  whereHelperstring{field: "\"exchange_rates\".\"resolution\""},
+	SampleCount: whereHelperint{field: "\"exchange_rates\".\"sample_count\""},
+	RecordedAt:  whereHelpertime_Time{field: "\"exchange_rates\".\"recorded_at\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"exchange_rates\".\"created_at\""},
+}
+
+// ExchangeRateRels is where relationship names are stored.
+var ExchangeRateRels = struct {
+	PurchaseExchangeRates string
+}{
+	PurchaseExchangeRates: "PurchaseExchangeRates",
+}
+
+// exchangeRateR is where relationships are stored.
+type exchangeRateR struct {
+	PurchaseExchangeRates PurchaseExchangeRateSlice `boiler:"PurchaseExchangeRates" boil:"PurchaseExchangeRates" json:"PurchaseExchangeRates" toml:"PurchaseExchangeRates" yaml:"PurchaseExchangeRates"`
+}
+
+// NewStruct creates a new relationship struct
+func (*exchangeRateR) NewStruct() *exchangeRateR {
+	return &exchangeRateR{}
+}
+
+// exchangeRateL is where Load methods for each relationship are stored.
+type exchangeRateL struct{}
+
+var (
+	exchangeRateAllColumns            = []string{"id", "symbol", "usd", "min_usd", "max_usd", "sources", "trusted", "resolution", "sample_count", "recorded_at", "created_at"}
+	exchangeRateColumnsWithoutDefault = []string{"symbol", "usd", "min_usd", "max_usd"}
+	exchangeRateColumnsWithDefault    = []string{"id", "sources", "trusted", "resolution", "sample_count", "recorded_at", "created_at"}
+	exchangeRatePrimaryKeyColumns     = []string{"id"}
+	exchangeRateGeneratedColumns      = []string{}
+)
+
+type (
+	// ExchangeRateSlice is an alias for a slice of pointers to ExchangeRate.
+	// This should almost always be used instead of []ExchangeRate.
+	ExchangeRateSlice []*ExchangeRate
+	// ExchangeRateHook is the signature for custom ExchangeRate hook methods
+	ExchangeRateHook func(boil.Executor, *ExchangeRate) error
+
+	exchangeRateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	exchangeRateType                 = reflect.TypeOf(&ExchangeRate{})
+	exchangeRateMapping              = queries.MakeStructMapping(exchangeRateType)
+	exchangeRatePrimaryKeyMapping, _ = queries.BindMapping(exchangeRateType, exchangeRateMapping, exchangeRatePrimaryKeyColumns)
+	exchangeRateInsertCacheMut       sync.RWMutex
+	exchangeRateInsertCache          = make(map[string]insertCache)
+	exchangeRateUpdateCacheMut       sync.RWMutex
+	exchangeRateUpdateCache          = make(map[string]updateCache)
+	exchangeRateUpsertCacheMut       sync.RWMutex
+	exchangeRateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var exchangeRateAfterSelectHooks []ExchangeRateHook
+
+var exchangeRateBeforeInsertHooks []ExchangeRateHook
+var exchangeRateAfterInsertHooks []ExchangeRateHook
+
+var exchangeRateBeforeUpdateHooks []ExchangeRateHook
+var exchangeRateAfterUpdateHooks []ExchangeRateHook
+
+var exchangeRateBeforeDeleteHooks []ExchangeRateHook
+var exchangeRateAfterDeleteHooks []ExchangeRateHook
+
+var exchangeRateBeforeUpsertHooks []ExchangeRateHook
+var exchangeRateAfterUpsertHooks []ExchangeRateHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ExchangeRate) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range exchangeRateAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ExchangeRate) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range exchangeRateBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ExchangeRate) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range exchangeRateAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ExchangeRate) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range exchangeRateBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ExchangeRate) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range exchangeRateAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ExchangeRate) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range exchangeRateBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ExchangeRate) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range exchangeRateAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ExchangeRate) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range exchangeRateBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ExchangeRate) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range exchangeRateAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddExchangeRateHook registers your hook function for all future operations.
+func AddExchangeRateHook(hookPoint boil.HookPoint, exchangeRateHook ExchangeRateHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		exchangeRateAfterSelectHooks = append(exchangeRateAfterSelectHooks, exchangeRateHook)
+	case boil.BeforeInsertHook:
+		exchangeRateBeforeInsertHooks = append(exchangeRateBeforeInsertHooks, exchangeRateHook)
+	case boil.AfterInsertHook:
+		exchangeRateAfterInsertHooks = append(exchangeRateAfterInsertHooks, exchangeRateHook)
+	case boil.BeforeUpdateHook:
+		exchangeRateBeforeUpdateHooks = append(exchangeRateBeforeUpdateHooks, exchangeRateHook)
+	case boil.AfterUpdateHook:
+		exchangeRateAfterUpdateHooks = append(exchangeRateAfterUpdateHooks, exchangeRateHook)
+	case boil.BeforeDeleteHook:
+		exchangeRateBeforeDeleteHooks = append(exchangeRateBeforeDeleteHooks, exchangeRateHook)
+	case boil.AfterDeleteHook:
+		exchangeRateAfterDeleteHooks = append(exchangeRateAfterDeleteHooks, exchangeRateHook)
+	case boil.BeforeUpsertHook:
+		exchangeRateBeforeUpsertHooks = append(exchangeRateBeforeUpsertHooks, exchangeRateHook)
+	case boil.AfterUpsertHook:
+		exchangeRateAfterUpsertHooks = append(exchangeRateAfterUpsertHooks, exchangeRateHook)
+	}
+}
+
+// One returns a single exchangeRate record from the query.
+func (q exchangeRateQuery) One(exec boil.Executor) (*ExchangeRate, error) {
+	o := &ExchangeRate{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for exchange_rates")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ExchangeRate records from the query.
+func (q exchangeRateQuery) All(exec boil.Executor) (ExchangeRateSlice, error) {
+	var o []*ExchangeRate
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to ExchangeRate slice")
+	}
+
+	if len(exchangeRateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ExchangeRate records in the query.
+func (q exchangeRateQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count exchange_rates rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q exchangeRateQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if exchange_rates exists")
+	}
+
+	return count > 0, nil
+}
+
+// PurchaseExchangeRates retrieves all the purchase_exchange_rate's PurchaseExchangeRates with an executor.
+func (o *ExchangeRate) PurchaseExchangeRates(mods ...qm.QueryMod) purchaseExchangeRateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"purchase_exchange_rates\".\"exchange_rate_id\"=?", o.ID),
+	)
+
+	query := PurchaseExchangeRates(queryMods...)
+	queries.SetFrom(query.Query, "\"purchase_exchange_rates\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"purchase_exchange_rates\".*"})
+	}
+
+	return query
+}
+
+// LoadPurchaseExchangeRates allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeRateL) LoadPurchaseExchangeRates(e boil.Executor, singular bool, maybeExchangeRate interface{}, mods queries.Applicator) error {
+	var slice []*ExchangeRate
+	var object *ExchangeRate
+
+	if singular {
+		object = maybeExchangeRate.(*ExchangeRate)
+	} else {
+		slice = *maybeExchangeRate.(*[]*ExchangeRate)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeRateR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeRateR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`purchase_exchange_rates`),
+		qm.WhereIn(`purchase_exchange_rates.exchange_rate_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load purchase_exchange_rates")
+	}
+
+	var resultSlice []*PurchaseExchangeRate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice purchase_exchange_rates")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on purchase_exchange_rates")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for purchase_exchange_rates")
+	}
+
+	if len(purchaseExchangeRateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PurchaseExchangeRates = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &purchaseExchangeRateR{}
+			}
+			foreign.R.ExchangeRate = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ExchangeRateID) {
+				local.R.PurchaseExchangeRates = append(local.R.PurchaseExchangeRates, foreign)
+				if foreign.R == nil {
+					foreign.R = &purchaseExchangeRateR{}
+				}
+				foreign.R.ExchangeRate = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddPurchaseExchangeRates adds the given related objects to the existing relationships
+// of the exchange_rate, optionally inserting them as new records.
+// Appends related to o.R.PurchaseExchangeRates.
+// Sets related.R.ExchangeRate appropriately.
+func (o *ExchangeRate) AddPurchaseExchangeRates(exec boil.Executor, insert bool, related ...*PurchaseExchangeRate) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ExchangeRateID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"purchase_exchange_rates\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_rate_id"}),
+				strmangle.WhereClause("\"", "\"", 2, purchaseExchangeRatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ExchangeRateID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeRateR{
+			PurchaseExchangeRates: related,
+		}
+	} else {
+		o.R.PurchaseExchangeRates = append(o.R.PurchaseExchangeRates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &purchaseExchangeRateR{
+				ExchangeRate: o,
+			}
+		} else {
+			rel.R.ExchangeRate = o
+		}
+	}
+	return nil
+}
+
+// SetPurchaseExchangeRates removes all previously related items of the
+// exchange_rate replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ExchangeRate's PurchaseExchangeRates accordingly.
+// Replaces o.R.PurchaseExchangeRates with related.
+// Sets related.R.ExchangeRate's PurchaseExchangeRates accordingly.
+func (o *ExchangeRate) SetPurchaseExchangeRates(exec boil.Executor, insert bool, related ...*PurchaseExchangeRate) error {
+	query := "update \"purchase_exchange_rates\" set \"exchange_rate_id\" = null where \"exchange_rate_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.PurchaseExchangeRates {
+			queries.SetScanner(&rel.ExchangeRateID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ExchangeRate = nil
+		}
+
+		o.R.PurchaseExchangeRates = nil
+	}
+	return o.AddPurchaseExchangeRates(exec, insert, related...)
+}
+
+// RemovePurchaseExchangeRates relationships from objects passed in.
+// Removes related items from R.PurchaseExchangeRates (uses pointer comparison, removal does not keep order)
+// Sets related.R.ExchangeRate.
+func (o *ExchangeRate) RemovePurchaseExchangeRates(exec boil.Executor, related ...*PurchaseExchangeRate) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ExchangeRateID, nil)
+		if rel.R != nil {
+			rel.R.ExchangeRate = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("exchange_rate_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.PurchaseExchangeRates {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.PurchaseExchangeRates)
+			if ln > 1 && i < ln-1 {
+				o.R.PurchaseExchangeRates[i] = o.R.PurchaseExchangeRates[ln-1]
+			}
+			o.R.PurchaseExchangeRates = o.R.PurchaseExchangeRates[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// ExchangeRates retrieves all the records using an executor.
+func ExchangeRates(mods ...qm.QueryMod) exchangeRateQuery {
+	mods = append(mods, qm.From("\"exchange_rates\""))
+	return exchangeRateQuery{NewQuery(mods...)}
+}
+
+// FindExchangeRate retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindExchangeRate(exec boil.Executor, iD string, selectCols ...string) (*ExchangeRate, error) {
+	exchangeRateObj := &ExchangeRate{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"exchange_rates\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, exchangeRateObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from exchange_rates")
+	}
+
+	if err = exchangeRateObj.doAfterSelectHooks(exec); err != nil {
+		return exchangeRateObj, err
+	}
+
+	return exchangeRateObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ExchangeRate) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no exchange_rates provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(exchangeRateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	exchangeRateInsertCacheMut.RLock()
+	cache, cached := exchangeRateInsertCache[key]
+	exchangeRateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			exchangeRateAllColumns,
+			exchangeRateColumnsWithDefault,
+			exchangeRateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(exchangeRateType, exchangeRateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(exchangeRateType, exchangeRateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"exchange_rates\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"exchange_rates\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into exchange_rates")
+	}
+
+	if !cached {
+		exchangeRateInsertCacheMut.Lock()
+		exchangeRateInsertCache[key] = cache
+		exchangeRateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the ExchangeRate.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ExchangeRate) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	exchangeRateUpdateCacheMut.RLock()
+	cache, cached := exchangeRateUpdateCache[key]
+	exchangeRateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			exchangeRateAllColumns,
+			exchangeRatePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update exchange_rates, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"exchange_rates\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, exchangeRatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(exchangeRateType, exchangeRateMapping, append(wl, exchangeRatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update exchange_rates row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for exchange_rates")
+	}
+
+	if !cached {
+		exchangeRateUpdateCacheMut.Lock()
+		exchangeRateUpdateCache[key] = cache
+		exchangeRateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q exchangeRateQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for exchange_rates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for exchange_rates")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ExchangeRateSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), exchangeRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"exchange_rates\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, exchangeRatePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in exchangeRate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all exchangeRate")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ExchangeRate) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no exchange_rates provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(exchangeRateColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	exchangeRateUpsertCacheMut.RLock()
+	cache, cached := exchangeRateUpsertCache[key]
+	exchangeRateUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			exchangeRateAllColumns,
+			exchangeRateColumnsWithDefault,
+			exchangeRateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			exchangeRateAllColumns,
+			exchangeRatePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert exchange_rates, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(exchangeRatePrimaryKeyColumns))
+			copy(conflict, exchangeRatePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"exchange_rates\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(exchangeRateType, exchangeRateMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(exchangeRateType, exchangeRateMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert exchange_rates")
+	}
+
+	if !cached {
+		exchangeRateUpsertCacheMut.Lock()
+		exchangeRateUpsertCache[key] = cache
+		exchangeRateUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single ExchangeRate record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ExchangeRate) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no ExchangeRate provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), exchangeRatePrimaryKeyMapping)
+	sql := "DELETE FROM \"exchange_rates\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from exchange_rates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for exchange_rates")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q exchangeRateQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no exchangeRateQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from exchange_rates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for exchange_rates")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ExchangeRateSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(exchangeRateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), exchangeRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"exchange_rates\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, exchangeRatePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from exchangeRate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for exchange_rates")
+	}
+
+	if len(exchangeRateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ExchangeRate) Reload(exec boil.Executor) error {
+	ret, err := FindExchangeRate(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ExchangeRateSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ExchangeRateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), exchangeRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"exchange_rates\".* FROM \"exchange_rates\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, exchangeRatePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in ExchangeRateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ExchangeRateExists checks if the ExchangeRate row exists.
+func ExchangeRateExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"exchange_rates\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if exchange_rates exists")
+	}
+
+	return exists, nil
+}
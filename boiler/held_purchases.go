@@ -0,0 +1,1094 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// HeldPurchase is an object representing the database table.
+type HeldPurchase struct {
+	ID            string              `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	TXHash        string              `boiler:"tx_hash" boil:"tx_hash" json:"tx_hash" toml:"tx_hash" yaml:"tx_hash"`
+	Symbol        string              `boiler:"symbol" boil:"symbol" json:"symbol" toml:"symbol" yaml:"symbol"`
+	UserID        string              `boiler:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Record        types.JSON          `boiler:"record" boil:"record" json:"record" toml:"record" yaml:"record"`
+	Reason        string              `boiler:"reason" boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	PurchasedAt   null.Time           `boiler:"purchased_at" boil:"purchased_at" json:"purchased_at,omitempty" toml:"purchased_at" yaml:"purchased_at,omitempty"`
+	ReleasedAt    null.Time           `boiler:"released_at" boil:"released_at" json:"released_at,omitempty" toml:"released_at" yaml:"released_at,omitempty"`
+	ReleasedBy    null.String         `boiler:"released_by" boil:"released_by" json:"released_by,omitempty" toml:"released_by" yaml:"released_by,omitempty"`
+	SupsUsdRate   decimal.NullDecimal `boiler:"sups_usd_rate" boil:"sups_usd_rate" json:"sups_usd_rate,omitempty" toml:"sups_usd_rate" yaml:"sups_usd_rate,omitempty"`
+	TransactionID null.String         `boiler:"transaction_id" boil:"transaction_id" json:"transaction_id,omitempty" toml:"transaction_id" yaml:"transaction_id,omitempty"`
+	CreatedAt     time.Time           `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *heldPurchaseR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L heldPurchaseL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var HeldPurchaseColumns = struct {
+	ID            string
+	TXHash        string
+	Symbol        string
+	UserID        string
+	Record        string
+	Reason        string
+	PurchasedAt   string
+	ReleasedAt    string
+	ReleasedBy    string
+	SupsUsdRate   string
+	TransactionID string
+	CreatedAt     string
+}{
+	ID:            "id",
+	TXHash:        "tx_hash",
+	Symbol:        "symbol",
+	UserID:        "user_id",
+	Record:        "record",
+	Reason:        "reason",
+	PurchasedAt:   "purchased_at",
+	ReleasedAt:    "released_at",
+	ReleasedBy:    "released_by",
+	SupsUsdRate:   "sups_usd_rate",
+	TransactionID: "transaction_id",
+	CreatedAt:     "created_at",
+}
+
+var HeldPurchaseTableColumns = struct {
+	ID            string
+	TXHash        string
+	Symbol        string
+	UserID        string
+	Record        string
+	Reason        string
+	PurchasedAt   string
+	ReleasedAt    string
+	ReleasedBy    string
+	SupsUsdRate   string
+	TransactionID string
+	CreatedAt     string
+}{
+	ID:            "held_purchases.id",
+	TXHash:        "held_purchases.tx_hash",
+	Symbol:        "held_purchases.symbol",
+	UserID:        "held_purchases.user_id",
+	Record:        "held_purchases.record",
+	Reason:        "held_purchases.reason",
+	PurchasedAt:   "held_purchases.purchased_at",
+	ReleasedAt:    "held_purchases.released_at",
+	ReleasedBy:    "held_purchases.released_by",
+	SupsUsdRate:   "held_purchases.sups_usd_rate",
+	TransactionID: "held_purchases.transaction_id",
+	CreatedAt:     "held_purchases.created_at",
+}
+
+// Generated where
+
+var HeldPurchaseWhere = struct {
+	ID            whereHelperstring
+	TXHash        whereHelperstring
+	Symbol        whereHelperstring
+	UserID        whereHelperstring
+	Record        whereHelpertypes_JSON
+	Reason        whereHelperstring
+	PurchasedAt   whereHelpernull_Time
+	ReleasedAt    whereHelpernull_Time
+	ReleasedBy    whereHelpernull_String
+	SupsUsdRate   whereHelperdecimal_NullDecimal
+	TransactionID whereHelpernull_String
+	CreatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperstring{field: "\"held_purchases\".\"id\""},
+	TXHash:        whereHelperstring{field: "\"held_purchases\".\"tx_hash\""},
+	Symbol:        whereHelperstring{field: "\"held_purchases\".\"symbol\""},
+	UserID:        whereHelperstring{field: "\"held_purchases\".\"user_id\""},
+	Record:        whereHelpertypes_JSON{field: "\"held_purchases\".\"record\""},
+	Reason:        whereHelperstring{field: "\"held_purchases\".\"reason\""},
+	PurchasedAt:   whereHelpernull_Time{field: "\"held_purchases\".\"purchased_at\""},
+	ReleasedAt:    whereHelpernull_Time{field: "\"held_purchases\".\"released_at\""},
+	ReleasedBy:    whereHelpernull_String{field: "\"held_purchases\".\"released_by\""},
+	SupsUsdRate:   whereHelperdecimal_NullDecimal{field: "\"held_purchases\".\"sups_usd_rate\""},
+	TransactionID: whereHelpernull_String{field: "\"held_purchases\".\"transaction_id\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"held_purchases\".\"created_at\""},
+}
+
+// HeldPurchaseRels is where relationship names are stored.
+var HeldPurchaseRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// heldPurchaseR is where relationships are stored.
+type heldPurchaseR struct {
+	User *User `boiler:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*heldPurchaseR) NewStruct() *heldPurchaseR {
+	return &heldPurchaseR{}
+}
+
+// heldPurchaseL is where Load methods for each relationship are stored.
+type heldPurchaseL struct{}
+
+var (
+	heldPurchaseAllColumns            = []string{"id", "tx_hash", "symbol", "user_id", "record", "reason", "purchased_at", "released_at", "released_by", "sups_usd_rate", "transaction_id", "created_at"}
+	heldPurchaseColumnsWithoutDefault = []string{"tx_hash", "symbol", "user_id", "record", "reason"}
+	heldPurchaseColumnsWithDefault    = []string{"id", "purchased_at", "released_at", "released_by", "sups_usd_rate", "transaction_id", "created_at"}
+	heldPurchasePrimaryKeyColumns     = []string{"id"}
+	heldPurchaseGeneratedColumns      = []string{}
+)
+
+type (
+	// HeldPurchaseSlice is an alias for a slice of pointers to HeldPurchase.
+	// This should almost always be used instead of []HeldPurchase.
+	HeldPurchaseSlice []*HeldPurchase
+	// HeldPurchaseHook is the signature for custom HeldPurchase hook methods
+	HeldPurchaseHook func(boil.Executor, *HeldPurchase) error
+
+	heldPurchaseQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	heldPurchaseType                 = reflect.TypeOf(&HeldPurchase{})
+	heldPurchaseMapping              = queries.MakeStructMapping(heldPurchaseType)
+	heldPurchasePrimaryKeyMapping, _ = queries.BindMapping(heldPurchaseType, heldPurchaseMapping, heldPurchasePrimaryKeyColumns)
+	heldPurchaseInsertCacheMut       sync.RWMutex
+	heldPurchaseInsertCache          = make(map[string]insertCache)
+	heldPurchaseUpdateCacheMut       sync.RWMutex
+	heldPurchaseUpdateCache          = make(map[string]updateCache)
+	heldPurchaseUpsertCacheMut       sync.RWMutex
+	heldPurchaseUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var heldPurchaseAfterSelectHooks []HeldPurchaseHook
+
+var heldPurchaseBeforeInsertHooks []HeldPurchaseHook
+var heldPurchaseAfterInsertHooks []HeldPurchaseHook
+
+var heldPurchaseBeforeUpdateHooks []HeldPurchaseHook
+var heldPurchaseAfterUpdateHooks []HeldPurchaseHook
+
+var heldPurchaseBeforeDeleteHooks []HeldPurchaseHook
+var heldPurchaseAfterDeleteHooks []HeldPurchaseHook
+
+var heldPurchaseBeforeUpsertHooks []HeldPurchaseHook
+var heldPurchaseAfterUpsertHooks []HeldPurchaseHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *HeldPurchase) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range heldPurchaseAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *HeldPurchase) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range heldPurchaseBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *HeldPurchase) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range heldPurchaseAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *HeldPurchase) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range heldPurchaseBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *HeldPurchase) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range heldPurchaseAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *HeldPurchase) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range heldPurchaseBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *HeldPurchase) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range heldPurchaseAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *HeldPurchase) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range heldPurchaseBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *HeldPurchase) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range heldPurchaseAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddHeldPurchaseHook registers your hook function for all future operations.
+func AddHeldPurchaseHook(hookPoint boil.HookPoint, heldPurchaseHook HeldPurchaseHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		heldPurchaseAfterSelectHooks = append(heldPurchaseAfterSelectHooks, heldPurchaseHook)
+	case boil.BeforeInsertHook:
+		heldPurchaseBeforeInsertHooks = append(heldPurchaseBeforeInsertHooks, heldPurchaseHook)
+	case boil.AfterInsertHook:
+		heldPurchaseAfterInsertHooks = append(heldPurchaseAfterInsertHooks, heldPurchaseHook)
+	case boil.BeforeUpdateHook:
+		heldPurchaseBeforeUpdateHooks = append(heldPurchaseBeforeUpdateHooks, heldPurchaseHook)
+	case boil.AfterUpdateHook:
+		heldPurchaseAfterUpdateHooks = append(heldPurchaseAfterUpdateHooks, heldPurchaseHook)
+	case boil.BeforeDeleteHook:
+		heldPurchaseBeforeDeleteHooks = append(heldPurchaseBeforeDeleteHooks, heldPurchaseHook)
+	case boil.AfterDeleteHook:
+		heldPurchaseAfterDeleteHooks = append(heldPurchaseAfterDeleteHooks, heldPurchaseHook)
+	case boil.BeforeUpsertHook:
+		heldPurchaseBeforeUpsertHooks = append(heldPurchaseBeforeUpsertHooks, heldPurchaseHook)
+	case boil.AfterUpsertHook:
+		heldPurchaseAfterUpsertHooks = append(heldPurchaseAfterUpsertHooks, heldPurchaseHook)
+	}
+}
+
+// One returns a single heldPurchase record from the query.
+func (q heldPurchaseQuery) One(exec boil.Executor) (*HeldPurchase, error) {
+	o := &HeldPurchase{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for held_purchases")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all HeldPurchase records from the query.
+func (q heldPurchaseQuery) All(exec boil.Executor) (HeldPurchaseSlice, error) {
+	var o []*HeldPurchase
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to HeldPurchase slice")
+	}
+
+	if len(heldPurchaseAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all HeldPurchase records in the query.
+func (q heldPurchaseQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count held_purchases rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q heldPurchaseQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if held_purchases exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *HeldPurchase) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (heldPurchaseL) LoadUser(e boil.Executor, singular bool, maybeHeldPurchase interface{}, mods queries.Applicator) error {
+	var slice []*HeldPurchase
+	var object *HeldPurchase
+
+	if singular {
+		object = maybeHeldPurchase.(*HeldPurchase)
+	} else {
+		slice = *maybeHeldPurchase.(*[]*HeldPurchase)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &heldPurchaseR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &heldPurchaseR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(heldPurchaseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.HeldPurchases = append(foreign.R.HeldPurchases, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.HeldPurchases = append(foreign.R.HeldPurchases, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the heldPurchase to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.HeldPurchases.
+func (o *HeldPurchase) SetUser(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"held_purchases\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, heldPurchasePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &heldPurchaseR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			HeldPurchases: HeldPurchaseSlice{o},
+		}
+	} else {
+		related.R.HeldPurchases = append(related.R.HeldPurchases, o)
+	}
+
+	return nil
+}
+
+// HeldPurchases retrieves all the records using an executor.
+func HeldPurchases(mods ...qm.QueryMod) heldPurchaseQuery {
+	mods = append(mods, qm.From("\"held_purchases\""))
+	return heldPurchaseQuery{NewQuery(mods...)}
+}
+
+// FindHeldPurchase retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindHeldPurchase(exec boil.Executor, iD string, selectCols ...string) (*HeldPurchase, error) {
+	heldPurchaseObj := &HeldPurchase{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"held_purchases\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, heldPurchaseObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from held_purchases")
+	}
+
+	if err = heldPurchaseObj.doAfterSelectHooks(exec); err != nil {
+		return heldPurchaseObj, err
+	}
+
+	return heldPurchaseObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *HeldPurchase) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no held_purchases provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(heldPurchaseColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	heldPurchaseInsertCacheMut.RLock()
+	cache, cached := heldPurchaseInsertCache[key]
+	heldPurchaseInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			heldPurchaseAllColumns,
+			heldPurchaseColumnsWithDefault,
+			heldPurchaseColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(heldPurchaseType, heldPurchaseMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(heldPurchaseType, heldPurchaseMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"held_purchases\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"held_purchases\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into held_purchases")
+	}
+
+	if !cached {
+		heldPurchaseInsertCacheMut.Lock()
+		heldPurchaseInsertCache[key] = cache
+		heldPurchaseInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the HeldPurchase.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *HeldPurchase) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	heldPurchaseUpdateCacheMut.RLock()
+	cache, cached := heldPurchaseUpdateCache[key]
+	heldPurchaseUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			heldPurchaseAllColumns,
+			heldPurchasePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update held_purchases, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"held_purchases\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, heldPurchasePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(heldPurchaseType, heldPurchaseMapping, append(wl, heldPurchasePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update held_purchases row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for held_purchases")
+	}
+
+	if !cached {
+		heldPurchaseUpdateCacheMut.Lock()
+		heldPurchaseUpdateCache[key] = cache
+		heldPurchaseUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q heldPurchaseQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for held_purchases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for held_purchases")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o HeldPurchaseSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), heldPurchasePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"held_purchases\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, heldPurchasePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in heldPurchase slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all heldPurchase")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *HeldPurchase) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no held_purchases provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(heldPurchaseColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	heldPurchaseUpsertCacheMut.RLock()
+	cache, cached := heldPurchaseUpsertCache[key]
+	heldPurchaseUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			heldPurchaseAllColumns,
+			heldPurchaseColumnsWithDefault,
+			heldPurchaseColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			heldPurchaseAllColumns,
+			heldPurchasePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert held_purchases, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(heldPurchasePrimaryKeyColumns))
+			copy(conflict, heldPurchasePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"held_purchases\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(heldPurchaseType, heldPurchaseMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(heldPurchaseType, heldPurchaseMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert held_purchases")
+	}
+
+	if !cached {
+		heldPurchaseUpsertCacheMut.Lock()
+		heldPurchaseUpsertCache[key] = cache
+		heldPurchaseUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single HeldPurchase record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *HeldPurchase) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no HeldPurchase provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), heldPurchasePrimaryKeyMapping)
+	sql := "DELETE FROM \"held_purchases\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from held_purchases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for held_purchases")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q heldPurchaseQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no heldPurchaseQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from held_purchases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for held_purchases")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o HeldPurchaseSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(heldPurchaseBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), heldPurchasePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"held_purchases\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, heldPurchasePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from heldPurchase slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for held_purchases")
+	}
+
+	if len(heldPurchaseAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *HeldPurchase) Reload(exec boil.Executor) error {
+	ret, err := FindHeldPurchase(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *HeldPurchaseSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := HeldPurchaseSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), heldPurchasePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"held_purchases\".* FROM \"held_purchases\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, heldPurchasePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in HeldPurchaseSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// HeldPurchaseExists checks if the HeldPurchase row exists.
+func HeldPurchaseExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"held_purchases\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if held_purchases exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1451 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// HolderSnapshotEntry is an object representing the database table.
+type HolderSnapshotEntry struct {
+	ID             string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	SnapshotID     string      `boiler:"snapshot_id" boil:"snapshot_id" json:"snapshot_id" toml:"snapshot_id" yaml:"snapshot_id"`
+	CollectionID   string      `boiler:"collection_id" boil:"collection_id" json:"collection_id" toml:"collection_id" yaml:"collection_id"`
+	UserID         null.String `boiler:"user_id" boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	PublicAddress  null.String `boiler:"public_address" boil:"public_address" json:"public_address,omitempty" toml:"public_address" yaml:"public_address,omitempty"`
+	CustodialCount int         `boiler:"custodial_count" boil:"custodial_count" json:"custodial_count" toml:"custodial_count" yaml:"custodial_count"`
+	MintedCount    int         `boiler:"minted_count" boil:"minted_count" json:"minted_count" toml:"minted_count" yaml:"minted_count"`
+	StakedCount    int         `boiler:"staked_count" boil:"staked_count" json:"staked_count" toml:"staked_count" yaml:"staked_count"`
+	TotalCount     int         `boiler:"total_count" boil:"total_count" json:"total_count" toml:"total_count" yaml:"total_count"`
+	CreatedAt      time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *holderSnapshotEntryR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L holderSnapshotEntryL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var HolderSnapshotEntryColumns = struct {
+	ID             string
+	SnapshotID     string
+	CollectionID   string
+	UserID         string
+	PublicAddress  string
+	CustodialCount string
+	MintedCount    string
+	StakedCount    string
+	TotalCount     string
+	CreatedAt      string
+}{
+	ID:             "id",
+	SnapshotID:     "snapshot_id",
+	CollectionID:   "collection_id",
+	UserID:         "user_id",
+	PublicAddress:  "public_address",
+	CustodialCount: "custodial_count",
+	MintedCount:    "minted_count",
+	StakedCount:    "staked_count",
+	TotalCount:     "total_count",
+	CreatedAt:      "created_at",
+}
+
+var HolderSnapshotEntryTableColumns = struct {
+	ID             string
+	SnapshotID     string
+	CollectionID   string
+	UserID         string
+	PublicAddress  string
+	CustodialCount string
+	MintedCount    string
+	StakedCount    string
+	TotalCount     string
+	CreatedAt      string
+}{
+	ID:             "holder_snapshot_entries.id",
+	SnapshotID:     "holder_snapshot_entries.snapshot_id",
+	CollectionID:   "holder_snapshot_entries.collection_id",
+	UserID:         "holder_snapshot_entries.user_id",
+	PublicAddress:  "holder_snapshot_entries.public_address",
+	CustodialCount: "holder_snapshot_entries.custodial_count",
+	MintedCount:    "holder_snapshot_entries.minted_count",
+	StakedCount:    "holder_snapshot_entries.staked_count",
+	TotalCount:     "holder_snapshot_entries.total_count",
+	CreatedAt:      "holder_snapshot_entries.created_at",
+}
+
+// Generated where
+
+var HolderSnapshotEntryWhere = struct {
+	ID             whereHelperstring
+	SnapshotID     whereHelperstring
+	CollectionID   whereHelperstring
+	UserID         whereHelpernull_String
+	PublicAddress  whereHelpernull_String
+	CustodialCount whereHelperint
+	MintedCount    whereHelperint
+	StakedCount    whereHelperint
+	TotalCount     whereHelperint
+	CreatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperstring{field: "\"holder_snapshot_entries\".\"id\""},
+	SnapshotID:     whereHelperstring{field: "\"holder_snapshot_entries\".\"snapshot_id\""},
+	CollectionID:   whereHelperstring{field: "\"holder_snapshot_entries\".\"collection_id\""},
+	UserID:         whereHelpernull_String{field: "\"holder_snapshot_entries\".\"user_id\""},
+	PublicAddress:  whereHelpernull_String{field: "\"holder_snapshot_entries\".\"public_address\""},
+	CustodialCount: whereHelperint{field: "\"holder_snapshot_entries\".\"custodial_count\""},
+	MintedCount:    whereHelperint{field: "\"holder_snapshot_entries\".\"minted_count\""},
+	StakedCount:    whereHelperint{field: "\"holder_snapshot_entries\".\"staked_count\""},
+	TotalCount:     whereHelperint{field: "\"holder_snapshot_entries\".\"total_count\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"holder_snapshot_entries\".\"created_at\""},
+}
+
+// HolderSnapshotEntryRels is where relationship names are stored.
+var HolderSnapshotEntryRels = struct {
+	Collection string
+	Snapshot   string
+	User       string
+}{
+	Collection: "Collection",
+	Snapshot:   "Snapshot",
+	User:       "User",
+}
+
+// holderSnapshotEntryR is where relationships are stored.
+type holderSnapshotEntryR struct {
+	Collection *Collection     `boiler:"Collection" boil:"Collection" json:"Collection" toml:"Collection" yaml:"Collection"`
+	Snapshot   *HolderSnapshot `boiler:"Snapshot" boil:"Snapshot" json:"Snapshot" toml:"Snapshot" yaml:"Snapshot"`
+	User       *User           `boiler:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*holderSnapshotEntryR) NewStruct() *holderSnapshotEntryR {
+	return &holderSnapshotEntryR{}
+}
+
+// holderSnapshotEntryL is where Load methods for each relationship are stored.
+type holderSnapshotEntryL struct{}
+
+var (
+	holderSnapshotEntryAllColumns            = []string{"id", "snapshot_id", "collection_id", "user_id", "public_address", "custodial_count", "minted_count", "staked_count", "total_count", "created_at"}
+	holderSnapshotEntryColumnsWithoutDefault = []string{"snapshot_id", "collection_id"}
+	holderSnapshotEntryColumnsWithDefault    = []string{"id", "user_id", "public_address", "custodial_count", "minted_count", "staked_count", "total_count", "created_at"}
+	holderSnapshotEntryPrimaryKeyColumns     = []string{"id"}
+	holderSnapshotEntryGeneratedColumns      = []string{}
+)
+
+type (
+	// HolderSnapshotEntrySlice is an alias for a slice of pointers to HolderSnapshotEntry.
+	// This should almost always be used instead of []HolderSnapshotEntry.
+	HolderSnapshotEntrySlice []*HolderSnapshotEntry
+	// HolderSnapshotEntryHook is the signature for custom HolderSnapshotEntry hook methods
+	HolderSnapshotEntryHook func(boil.Executor, *HolderSnapshotEntry) error
+
+	holderSnapshotEntryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	holderSnapshotEntryType                 = reflect.TypeOf(&HolderSnapshotEntry{})
+	holderSnapshotEntryMapping              = queries.MakeStructMapping(holderSnapshotEntryType)
+	holderSnapshotEntryPrimaryKeyMapping, _ = queries.BindMapping(holderSnapshotEntryType, holderSnapshotEntryMapping, holderSnapshotEntryPrimaryKeyColumns)
+	holderSnapshotEntryInsertCacheMut       sync.RWMutex
+	holderSnapshotEntryInsertCache          = make(map[string]insertCache)
+	holderSnapshotEntryUpdateCacheMut       sync.RWMutex
+	holderSnapshotEntryUpdateCache          = make(map[string]updateCache)
+	holderSnapshotEntryUpsertCacheMut       sync.RWMutex
+	holderSnapshotEntryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var holderSnapshotEntryAfterSelectHooks []HolderSnapshotEntryHook
+
+var holderSnapshotEntryBeforeInsertHooks []HolderSnapshotEntryHook
+var holderSnapshotEntryAfterInsertHooks []HolderSnapshotEntryHook
+
+var holderSnapshotEntryBeforeUpdateHooks []HolderSnapshotEntryHook
+var holderSnapshotEntryAfterUpdateHooks []HolderSnapshotEntryHook
+
+var holderSnapshotEntryBeforeDeleteHooks []HolderSnapshotEntryHook
+var holderSnapshotEntryAfterDeleteHooks []HolderSnapshotEntryHook
+
+var holderSnapshotEntryBeforeUpsertHooks []HolderSnapshotEntryHook
+var holderSnapshotEntryAfterUpsertHooks []HolderSnapshotEntryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *HolderSnapshotEntry) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotEntryAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *HolderSnapshotEntry) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotEntryBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *HolderSnapshotEntry) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotEntryAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *HolderSnapshotEntry) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotEntryBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *HolderSnapshotEntry) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotEntryAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *HolderSnapshotEntry) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotEntryBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *HolderSnapshotEntry) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotEntryAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *HolderSnapshotEntry) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotEntryBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *HolderSnapshotEntry) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotEntryAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddHolderSnapshotEntryHook registers your hook function for all future operations.
+func AddHolderSnapshotEntryHook(hookPoint boil.HookPoint, holderSnapshotEntryHook HolderSnapshotEntryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		holderSnapshotEntryAfterSelectHooks = append(holderSnapshotEntryAfterSelectHooks, holderSnapshotEntryHook)
+	case boil.BeforeInsertHook:
+		holderSnapshotEntryBeforeInsertHooks = append(holderSnapshotEntryBeforeInsertHooks, holderSnapshotEntryHook)
+	case boil.AfterInsertHook:
+		holderSnapshotEntryAfterInsertHooks = append(holderSnapshotEntryAfterInsertHooks, holderSnapshotEntryHook)
+	case boil.BeforeUpdateHook:
+		holderSnapshotEntryBeforeUpdateHooks = append(holderSnapshotEntryBeforeUpdateHooks, holderSnapshotEntryHook)
+	case boil.AfterUpdateHook:
+		holderSnapshotEntryAfterUpdateHooks = append(holderSnapshotEntryAfterUpdateHooks, holderSnapshotEntryHook)
+	case boil.BeforeDeleteHook:
+		holderSnapshotEntryBeforeDeleteHooks = append(holderSnapshotEntryBeforeDeleteHooks, holderSnapshotEntryHook)
+	case boil.AfterDeleteHook:
+		holderSnapshotEntryAfterDeleteHooks = append(holderSnapshotEntryAfterDeleteHooks, holderSnapshotEntryHook)
+	case boil.BeforeUpsertHook:
+		holderSnapshotEntryBeforeUpsertHooks = append(holderSnapshotEntryBeforeUpsertHooks, holderSnapshotEntryHook)
+	case boil.AfterUpsertHook:
+		holderSnapshotEntryAfterUpsertHooks = append(holderSnapshotEntryAfterUpsertHooks, holderSnapshotEntryHook)
+	}
+}
+
+// One returns a single holderSnapshotEntry record from the query.
+func (q holderSnapshotEntryQuery) One(exec boil.Executor) (*HolderSnapshotEntry, error) {
+	o := &HolderSnapshotEntry{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for holder_snapshot_entries")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all HolderSnapshotEntry records from the query.
+func (q holderSnapshotEntryQuery) All(exec boil.Executor) (HolderSnapshotEntrySlice, error) {
+	var o []*HolderSnapshotEntry
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to HolderSnapshotEntry slice")
+	}
+
+	if len(holderSnapshotEntryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all HolderSnapshotEntry records in the query.
+func (q holderSnapshotEntryQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count holder_snapshot_entries rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q holderSnapshotEntryQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if holder_snapshot_entries exists")
+	}
+
+	return count > 0, nil
+}
+
+// Collection pointed to by the foreign key.
+func (o *HolderSnapshotEntry) Collection(mods ...qm.QueryMod) collectionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CollectionID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Collections(queryMods...)
+	queries.SetFrom(query.Query, "\"collections\"")
+
+	return query
+}
+
+// Snapshot pointed to by the foreign key.
+func (o *HolderSnapshotEntry) Snapshot(mods ...qm.QueryMod) holderSnapshotQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SnapshotID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := HolderSnapshots(queryMods...)
+	queries.SetFrom(query.Query, "\"holder_snapshots\"")
+
+	return query
+}
+
+// User pointed to by the foreign key.
+func (o *HolderSnapshotEntry) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// LoadCollection allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (holderSnapshotEntryL) LoadCollection(e boil.Executor, singular bool, maybeHolderSnapshotEntry interface{}, mods queries.Applicator) error {
+	var slice []*HolderSnapshotEntry
+	var object *HolderSnapshotEntry
+
+	if singular {
+		object = maybeHolderSnapshotEntry.(*HolderSnapshotEntry)
+	} else {
+		slice = *maybeHolderSnapshotEntry.(*[]*HolderSnapshotEntry)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &holderSnapshotEntryR{}
+		}
+		args = append(args, object.CollectionID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &holderSnapshotEntryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CollectionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CollectionID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`collections`),
+		qm.WhereIn(`collections.id in ?`, args...),
+		qmhelper.WhereIsNull(`collections.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Collection")
+	}
+
+	var resultSlice []*Collection
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Collection")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for collections")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collections")
+	}
+
+	if len(holderSnapshotEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Collection = foreign
+		if foreign.R == nil {
+			foreign.R = &collectionR{}
+		}
+		foreign.R.HolderSnapshotEntries = append(foreign.R.HolderSnapshotEntries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CollectionID == foreign.ID {
+				local.R.Collection = foreign
+				if foreign.R == nil {
+					foreign.R = &collectionR{}
+				}
+				foreign.R.HolderSnapshotEntries = append(foreign.R.HolderSnapshotEntries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSnapshot allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (holderSnapshotEntryL) LoadSnapshot(e boil.Executor, singular bool, maybeHolderSnapshotEntry interface{}, mods queries.Applicator) error {
+	var slice []*HolderSnapshotEntry
+	var object *HolderSnapshotEntry
+
+	if singular {
+		object = maybeHolderSnapshotEntry.(*HolderSnapshotEntry)
+	} else {
+		slice = *maybeHolderSnapshotEntry.(*[]*HolderSnapshotEntry)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &holderSnapshotEntryR{}
+		}
+		args = append(args, object.SnapshotID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &holderSnapshotEntryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SnapshotID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SnapshotID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`holder_snapshots`),
+		qm.WhereIn(`holder_snapshots.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load HolderSnapshot")
+	}
+
+	var resultSlice []*HolderSnapshot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice HolderSnapshot")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for holder_snapshots")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for holder_snapshots")
+	}
+
+	if len(holderSnapshotEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Snapshot = foreign
+		if foreign.R == nil {
+			foreign.R = &holderSnapshotR{}
+		}
+		foreign.R.SnapshotHolderSnapshotEntries = append(foreign.R.SnapshotHolderSnapshotEntries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SnapshotID == foreign.ID {
+				local.R.Snapshot = foreign
+				if foreign.R == nil {
+					foreign.R = &holderSnapshotR{}
+				}
+				foreign.R.SnapshotHolderSnapshotEntries = append(foreign.R.SnapshotHolderSnapshotEntries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (holderSnapshotEntryL) LoadUser(e boil.Executor, singular bool, maybeHolderSnapshotEntry interface{}, mods queries.Applicator) error {
+	var slice []*HolderSnapshotEntry
+	var object *HolderSnapshotEntry
+
+	if singular {
+		object = maybeHolderSnapshotEntry.(*HolderSnapshotEntry)
+	} else {
+		slice = *maybeHolderSnapshotEntry.(*[]*HolderSnapshotEntry)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &holderSnapshotEntryR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args = append(args, object.UserID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &holderSnapshotEntryR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.UserID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args = append(args, obj.UserID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(holderSnapshotEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.HolderSnapshotEntries = append(foreign.R.HolderSnapshotEntries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.HolderSnapshotEntries = append(foreign.R.HolderSnapshotEntries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCollection of the holderSnapshotEntry to the related item.
+// Sets o.R.Collection to related.
+// Adds o to related.R.HolderSnapshotEntries.
+func (o *HolderSnapshotEntry) SetCollection(exec boil.Executor, insert bool, related *Collection) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"holder_snapshot_entries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+		strmangle.WhereClause("\"", "\"", 2, holderSnapshotEntryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CollectionID = related.ID
+	if o.R == nil {
+		o.R = &holderSnapshotEntryR{
+			Collection: related,
+		}
+	} else {
+		o.R.Collection = related
+	}
+
+	if related.R == nil {
+		related.R = &collectionR{
+			HolderSnapshotEntries: HolderSnapshotEntrySlice{o},
+		}
+	} else {
+		related.R.HolderSnapshotEntries = append(related.R.HolderSnapshotEntries, o)
+	}
+
+	return nil
+}
+
+// SetSnapshot of the holderSnapshotEntry to the related item.
+// Sets o.R.Snapshot to related.
+// Adds o to related.R.SnapshotHolderSnapshotEntries.
+func (o *HolderSnapshotEntry) SetSnapshot(exec boil.Executor, insert bool, related *HolderSnapshot) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"holder_snapshot_entries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"snapshot_id"}),
+		strmangle.WhereClause("\"", "\"", 2, holderSnapshotEntryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SnapshotID = related.ID
+	if o.R == nil {
+		o.R = &holderSnapshotEntryR{
+			Snapshot: related,
+		}
+	} else {
+		o.R.Snapshot = related
+	}
+
+	if related.R == nil {
+		related.R = &holderSnapshotR{
+			SnapshotHolderSnapshotEntries: HolderSnapshotEntrySlice{o},
+		}
+	} else {
+		related.R.SnapshotHolderSnapshotEntries = append(related.R.SnapshotHolderSnapshotEntries, o)
+	}
+
+	return nil
+}
+
+// SetUser of the holderSnapshotEntry to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.HolderSnapshotEntries.
+func (o *HolderSnapshotEntry) SetUser(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"holder_snapshot_entries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, holderSnapshotEntryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &holderSnapshotEntryR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			HolderSnapshotEntries: HolderSnapshotEntrySlice{o},
+		}
+	} else {
+		related.R.HolderSnapshotEntries = append(related.R.HolderSnapshotEntries, o)
+	}
+
+	return nil
+}
+
+// RemoveUser relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *HolderSnapshotEntry) RemoveUser(exec boil.Executor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.UserID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.User = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.HolderSnapshotEntries {
+		if queries.Equal(o.UserID, ri.UserID) {
+			continue
+		}
+
+		ln := len(related.R.HolderSnapshotEntries)
+		if ln > 1 && i < ln-1 {
+			related.R.HolderSnapshotEntries[i] = related.R.HolderSnapshotEntries[ln-1]
+		}
+		related.R.HolderSnapshotEntries = related.R.HolderSnapshotEntries[:ln-1]
+		break
+	}
+	return nil
+}
+
+// HolderSnapshotEntries retrieves all the records using an executor.
+func HolderSnapshotEntries(mods ...qm.QueryMod) holderSnapshotEntryQuery {
+	mods = append(mods, qm.From("\"holder_snapshot_entries\""))
+	return holderSnapshotEntryQuery{NewQuery(mods...)}
+}
+
+// FindHolderSnapshotEntry retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindHolderSnapshotEntry(exec boil.Executor, iD string, selectCols ...string) (*HolderSnapshotEntry, error) {
+	holderSnapshotEntryObj := &HolderSnapshotEntry{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"holder_snapshot_entries\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, holderSnapshotEntryObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from holder_snapshot_entries")
+	}
+
+	if err = holderSnapshotEntryObj.doAfterSelectHooks(exec); err != nil {
+		return holderSnapshotEntryObj, err
+	}
+
+	return holderSnapshotEntryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *HolderSnapshotEntry) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no holder_snapshot_entries provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(holderSnapshotEntryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	holderSnapshotEntryInsertCacheMut.RLock()
+	cache, cached := holderSnapshotEntryInsertCache[key]
+	holderSnapshotEntryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			holderSnapshotEntryAllColumns,
+			holderSnapshotEntryColumnsWithDefault,
+			holderSnapshotEntryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(holderSnapshotEntryType, holderSnapshotEntryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(holderSnapshotEntryType, holderSnapshotEntryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"holder_snapshot_entries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"holder_snapshot_entries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into holder_snapshot_entries")
+	}
+
+	if !cached {
+		holderSnapshotEntryInsertCacheMut.Lock()
+		holderSnapshotEntryInsertCache[key] = cache
+		holderSnapshotEntryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the HolderSnapshotEntry.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *HolderSnapshotEntry) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	holderSnapshotEntryUpdateCacheMut.RLock()
+	cache, cached := holderSnapshotEntryUpdateCache[key]
+	holderSnapshotEntryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			holderSnapshotEntryAllColumns,
+			holderSnapshotEntryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update holder_snapshot_entries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"holder_snapshot_entries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, holderSnapshotEntryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(holderSnapshotEntryType, holderSnapshotEntryMapping, append(wl, holderSnapshotEntryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update holder_snapshot_entries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for holder_snapshot_entries")
+	}
+
+	if !cached {
+		holderSnapshotEntryUpdateCacheMut.Lock()
+		holderSnapshotEntryUpdateCache[key] = cache
+		holderSnapshotEntryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q holderSnapshotEntryQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for holder_snapshot_entries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for holder_snapshot_entries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o HolderSnapshotEntrySlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), holderSnapshotEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"holder_snapshot_entries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, holderSnapshotEntryPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in holderSnapshotEntry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all holderSnapshotEntry")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *HolderSnapshotEntry) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no holder_snapshot_entries provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(holderSnapshotEntryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	holderSnapshotEntryUpsertCacheMut.RLock()
+	cache, cached := holderSnapshotEntryUpsertCache[key]
+	holderSnapshotEntryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			holderSnapshotEntryAllColumns,
+			holderSnapshotEntryColumnsWithDefault,
+			holderSnapshotEntryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			holderSnapshotEntryAllColumns,
+			holderSnapshotEntryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert holder_snapshot_entries, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(holderSnapshotEntryPrimaryKeyColumns))
+			copy(conflict, holderSnapshotEntryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"holder_snapshot_entries\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(holderSnapshotEntryType, holderSnapshotEntryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(holderSnapshotEntryType, holderSnapshotEntryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert holder_snapshot_entries")
+	}
+
+	if !cached {
+		holderSnapshotEntryUpsertCacheMut.Lock()
+		holderSnapshotEntryUpsertCache[key] = cache
+		holderSnapshotEntryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single HolderSnapshotEntry record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *HolderSnapshotEntry) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no HolderSnapshotEntry provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), holderSnapshotEntryPrimaryKeyMapping)
+	sql := "DELETE FROM \"holder_snapshot_entries\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from holder_snapshot_entries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for holder_snapshot_entries")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q holderSnapshotEntryQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no holderSnapshotEntryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from holder_snapshot_entries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for holder_snapshot_entries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o HolderSnapshotEntrySlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(holderSnapshotEntryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), holderSnapshotEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"holder_snapshot_entries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, holderSnapshotEntryPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from holderSnapshotEntry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for holder_snapshot_entries")
+	}
+
+	if len(holderSnapshotEntryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *HolderSnapshotEntry) Reload(exec boil.Executor) error {
+	ret, err := FindHolderSnapshotEntry(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *HolderSnapshotEntrySlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := HolderSnapshotEntrySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), holderSnapshotEntryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"holder_snapshot_entries\".* FROM \"holder_snapshot_entries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, holderSnapshotEntryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in HolderSnapshotEntrySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// HolderSnapshotEntryExists checks if the HolderSnapshotEntry row exists.
+func HolderSnapshotEntryExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"holder_snapshot_entries\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if holder_snapshot_entries exists")
+	}
+
+	return exists, nil
+}
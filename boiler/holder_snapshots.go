@@ -0,0 +1,1319 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// HolderSnapshot is an object representing the database table.
+type HolderSnapshot struct {
+	ID            string            `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name          string            `boiler:"name" boil:"name" json:"name" toml:"name" yaml:"name"`
+	CollectionIds types.StringArray `boiler:"collection_ids" boil:"collection_ids" json:"collection_ids" toml:"collection_ids" yaml:"collection_ids"`
+	TraitFilters  types.JSON        `boiler:"trait_filters" boil:"trait_filters" json:"trait_filters" toml:"trait_filters" yaml:"trait_filters"`
+	SnapshotAt    null.Time         `boiler:"snapshot_at" boil:"snapshot_at" json:"snapshot_at,omitempty" toml:"snapshot_at" yaml:"snapshot_at,omitempty"`
+	BlockNumber   null.Int64        `boiler:"block_number" boil:"block_number" json:"block_number,omitempty" toml:"block_number" yaml:"block_number,omitempty"`
+	Status        string            `boiler:"status" boil:"status" json:"status" toml:"status" yaml:"status"`
+	Error         null.String       `boiler:"error" boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
+	HolderCount   int               `boiler:"holder_count" boil:"holder_count" json:"holder_count" toml:"holder_count" yaml:"holder_count"`
+	AssetCount    int               `boiler:"asset_count" boil:"asset_count" json:"asset_count" toml:"asset_count" yaml:"asset_count"`
+	CreatedByID   string            `boiler:"created_by_id" boil:"created_by_id" json:"created_by_id" toml:"created_by_id" yaml:"created_by_id"`
+	CompletedAt   null.Time         `boiler:"completed_at" boil:"completed_at" json:"completed_at,omitempty" toml:"completed_at" yaml:"completed_at,omitempty"`
+	CreatedAt     time.Time         `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *holderSnapshotR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L holderSnapshotL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var HolderSnapshotColumns = struct {
+	ID            string
+	Name          string
+	CollectionIds string
+	TraitFilters  string
+	SnapshotAt    string
+	BlockNumber   string
+	Status        string
+	Error         string
+	HolderCount   string
+	AssetCount    string
+	CreatedByID   string
+	CompletedAt   string
+	CreatedAt     string
+}{
+	ID:            "id",
+	Name:          "name",
+	CollectionIds: "collection_ids",
+	TraitFilters:  "trait_filters",
+	SnapshotAt:    "snapshot_at",
+	BlockNumber:   "block_number",
+	Status:        "status",
+	Error:         "error",
+	HolderCount:   "holder_count",
+	AssetCount:    "asset_count",
+	CreatedByID:   "created_by_id",
+	CompletedAt:   "completed_at",
+	CreatedAt:     "created_at",
+}
+
+var HolderSnapshotTableColumns = struct {
+	ID            string
+	Name          string
+	CollectionIds string
+	TraitFilters  string
+	SnapshotAt    string
+	BlockNumber   string
+	Status        string
+	Error         string
+	HolderCount   string
+	AssetCount    string
+	CreatedByID   string
+	CompletedAt   string
+	CreatedAt     string
+}{
+	ID:            "holder_snapshots.id",
+	Name:          "holder_snapshots.name",
+	CollectionIds: "holder_snapshots.collection_ids",
+	TraitFilters:  "holder_snapshots.trait_filters",
+	SnapshotAt:    "holder_snapshots.snapshot_at",
+	BlockNumber:   "holder_snapshots.block_number",
+	Status:        "holder_snapshots.status",
+	Error:         "holder_snapshots.error",
+	HolderCount:   "holder_snapshots.holder_count",
+	AssetCount:    "holder_snapshots.asset_count",
+	CreatedByID:   "holder_snapshots.created_by_id",
+	CompletedAt:   "holder_snapshots.completed_at",
+	CreatedAt:     "holder_snapshots.created_at",
+}
+
+// Generated where
+
+type whereHelpertypes_StringArray struct{ field string }
+
+func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_Int64 struct{ field string }
+
+func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var HolderSnapshotWhere = struct {
+	ID            whereHelperstring
+	Name          whereHelperstring
+	CollectionIds whereHelpertypes_StringArray
+	TraitFilters  whereHelpertypes_JSON
+	SnapshotAt    whereHelpernull_Time
+	BlockNumber   whereHelpernull_Int64
+	Status        whereHelperstring
+	Error         whereHelpernull_String
+	HolderCount   whereHelperint
+	AssetCount    whereHelperint
+	CreatedByID   whereHelperstring
+	CompletedAt   whereHelpernull_Time
+	CreatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperstring{field: "\"holder_snapshots\".\"id\""},
+	Name:          whereHelperstring{field: "\"holder_snapshots\".\"name\""},
+	CollectionIds: whereHelpertypes_StringArray{field: "\"holder_snapshots\".\"collection_ids\""},
+	TraitFilters:  whereHelpertypes_JSON{field: "\"holder_snapshots\".\"trait_filters\""},
+	SnapshotAt:    whereHelpernull_Time{field: "\"holder_snapshots\".\"snapshot_at\""},
+	BlockNumber:   whereHelpernull_Int64{field: "\"holder_snapshots\".\"block_number\""},
+	Status:        whereHelperstring{field: "\"holder_snapshots\".\"status\""},
+	Error:         whereHelpernull_String{field: "\"holder_snapshots\".\"error\""},
+	HolderCount:   whereHelperint{field: "\"holder_snapshots\".\"holder_count\""},
+	AssetCount:    whereHelperint{field: "\"holder_snapshots\".\"asset_count\""},
+	CreatedByID:   whereHelperstring{field: "\"holder_snapshots\".\"created_by_id\""},
+	CompletedAt:   whereHelpernull_Time{field: "\"holder_snapshots\".\"completed_at\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"holder_snapshots\".\"created_at\""},
+}
+
+// HolderSnapshotRels is where relationship names are stored.
+var HolderSnapshotRels = struct {
+	CreatedBy                     string
+	SnapshotHolderSnapshotEntries string
+}{
+	CreatedBy:                     "CreatedBy",
+	SnapshotHolderSnapshotEntries: "SnapshotHolderSnapshotEntries",
+}
+
+// holderSnapshotR is where relationships are stored.
+type holderSnapshotR struct {
+	CreatedBy                     *User                    `boiler:"CreatedBy" boil:"CreatedBy" json:"CreatedBy" toml:"CreatedBy" yaml:"CreatedBy"`
+	SnapshotHolderSnapshotEntries HolderSnapshotEntrySlice `boiler:"SnapshotHolderSnapshotEntries" boil:"SnapshotHolderSnapshotEntries" json:"SnapshotHolderSnapshotEntries" toml:"SnapshotHolderSnapshotEntries" yaml:"SnapshotHolderSnapshotEntries"`
+}
+
+// NewStruct creates a new relationship struct
+func (*holderSnapshotR) NewStruct() *holderSnapshotR {
+	return &holderSnapshotR{}
+}
+
+// holderSnapshotL is where Load methods for each relationship are stored.
+type holderSnapshotL struct{}
+
+var (
+	holderSnapshotAllColumns            = []string{"id", "name", "collection_ids", "trait_filters", "snapshot_at", "block_number", "status", "error", "holder_count", "asset_count", "created_by_id", "completed_at", "created_at"}
+	holderSnapshotColumnsWithoutDefault = []string{"name", "collection_ids", "created_by_id"}
+	holderSnapshotColumnsWithDefault    = []string{"id", "trait_filters", "snapshot_at", "block_number", "status", "error", "holder_count", "asset_count", "completed_at", "created_at"}
+	holderSnapshotPrimaryKeyColumns     = []string{"id"}
+	holderSnapshotGeneratedColumns      = []string{}
+)
+
+type (
+	// HolderSnapshotSlice is an alias for a slice of pointers to HolderSnapshot.
+	// This should almost always be used instead of []HolderSnapshot.
+	HolderSnapshotSlice []*HolderSnapshot
+	// HolderSnapshotHook is the signature for custom HolderSnapshot hook methods
+	HolderSnapshotHook func(boil.Executor, *HolderSnapshot) error
+
+	holderSnapshotQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	holderSnapshotType                 = reflect.TypeOf(&HolderSnapshot{})
+	holderSnapshotMapping              = queries.MakeStructMapping(holderSnapshotType)
+	holderSnapshotPrimaryKeyMapping, _ = queries.BindMapping(holderSnapshotType, holderSnapshotMapping, holderSnapshotPrimaryKeyColumns)
+	holderSnapshotInsertCacheMut       sync.RWMutex
+	holderSnapshotInsertCache          = make(map[string]insertCache)
+	holderSnapshotUpdateCacheMut       sync.RWMutex
+	holderSnapshotUpdateCache          = make(map[string]updateCache)
+	holderSnapshotUpsertCacheMut       sync.RWMutex
+	holderSnapshotUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var holderSnapshotAfterSelectHooks []HolderSnapshotHook
+
+var holderSnapshotBeforeInsertHooks []HolderSnapshotHook
+var holderSnapshotAfterInsertHooks []HolderSnapshotHook
+
+var holderSnapshotBeforeUpdateHooks []HolderSnapshotHook
+var holderSnapshotAfterUpdateHooks []HolderSnapshotHook
+
+var holderSnapshotBeforeDeleteHooks []HolderSnapshotHook
+var holderSnapshotAfterDeleteHooks []HolderSnapshotHook
+
+var holderSnapshotBeforeUpsertHooks []HolderSnapshotHook
+var holderSnapshotAfterUpsertHooks []HolderSnapshotHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *HolderSnapshot) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *HolderSnapshot) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *HolderSnapshot) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *HolderSnapshot) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *HolderSnapshot) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *HolderSnapshot) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *HolderSnapshot) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *HolderSnapshot) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *HolderSnapshot) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range holderSnapshotAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddHolderSnapshotHook registers your hook function for all future operations.
+func AddHolderSnapshotHook(hookPoint boil.HookPoint, holderSnapshotHook HolderSnapshotHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		holderSnapshotAfterSelectHooks = append(holderSnapshotAfterSelectHooks, holderSnapshotHook)
+	case boil.BeforeInsertHook:
+		holderSnapshotBeforeInsertHooks = append(holderSnapshotBeforeInsertHooks, holderSnapshotHook)
+	case boil.AfterInsertHook:
+		holderSnapshotAfterInsertHooks = append(holderSnapshotAfterInsertHooks, holderSnapshotHook)
+	case boil.BeforeUpdateHook:
+		holderSnapshotBeforeUpdateHooks = append(holderSnapshotBeforeUpdateHooks, holderSnapshotHook)
+	case boil.AfterUpdateHook:
+		holderSnapshotAfterUpdateHooks = append(holderSnapshotAfterUpdateHooks, holderSnapshotHook)
+	case boil.BeforeDeleteHook:
+		holderSnapshotBeforeDeleteHooks = append(holderSnapshotBeforeDeleteHooks, holderSnapshotHook)
+	case boil.AfterDeleteHook:
+		holderSnapshotAfterDeleteHooks = append(holderSnapshotAfterDeleteHooks, holderSnapshotHook)
+	case boil.BeforeUpsertHook:
+		holderSnapshotBeforeUpsertHooks = append(holderSnapshotBeforeUpsertHooks, holderSnapshotHook)
+	case boil.AfterUpsertHook:
+		holderSnapshotAfterUpsertHooks = append(holderSnapshotAfterUpsertHooks, holderSnapshotHook)
+	}
+}
+
+// One returns a single holderSnapshot record from the query.
+func (q holderSnapshotQuery) One(exec boil.Executor) (*HolderSnapshot, error) {
+	o := &HolderSnapshot{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for holder_snapshots")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all HolderSnapshot records from the query.
+func (q holderSnapshotQuery) All(exec boil.Executor) (HolderSnapshotSlice, error) {
+	var o []*HolderSnapshot
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to HolderSnapshot slice")
+	}
+
+	if len(holderSnapshotAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all HolderSnapshot records in the query.
+func (q holderSnapshotQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count holder_snapshots rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q holderSnapshotQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if holder_snapshots exists")
+	}
+
+	return count > 0, nil
+}
+
+// CreatedBy pointed to by the foreign key.
+func (o *HolderSnapshot) CreatedBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CreatedByID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// SnapshotHolderSnapshotEntries retrieves all the holder_snapshot_entry's HolderSnapshotEntries with an executor via snapshot_id column.
+func (o *HolderSnapshot) SnapshotHolderSnapshotEntries(mods ...qm.QueryMod) holderSnapshotEntryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"holder_snapshot_entries\".\"snapshot_id\"=?", o.ID),
+	)
+
+	query := HolderSnapshotEntries(queryMods...)
+	queries.SetFrom(query.Query, "\"holder_snapshot_entries\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"holder_snapshot_entries\".*"})
+	}
+
+	return query
+}
+
+// LoadCreatedBy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (holderSnapshotL) LoadCreatedBy(e boil.Executor, singular bool, maybeHolderSnapshot interface{}, mods queries.Applicator) error {
+	var slice []*HolderSnapshot
+	var object *HolderSnapshot
+
+	if singular {
+		object = maybeHolderSnapshot.(*HolderSnapshot)
+	} else {
+		slice = *maybeHolderSnapshot.(*[]*HolderSnapshot)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &holderSnapshotR{}
+		}
+		args = append(args, object.CreatedByID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &holderSnapshotR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CreatedByID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CreatedByID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(holderSnapshotAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CreatedBy = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CreatedByHolderSnapshots = append(foreign.R.CreatedByHolderSnapshots, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CreatedByID == foreign.ID {
+				local.R.CreatedBy = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CreatedByHolderSnapshots = append(foreign.R.CreatedByHolderSnapshots, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSnapshotHolderSnapshotEntries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (holderSnapshotL) LoadSnapshotHolderSnapshotEntries(e boil.Executor, singular bool, maybeHolderSnapshot interface{}, mods queries.Applicator) error {
+	var slice []*HolderSnapshot
+	var object *HolderSnapshot
+
+	if singular {
+		object = maybeHolderSnapshot.(*HolderSnapshot)
+	} else {
+		slice = *maybeHolderSnapshot.(*[]*HolderSnapshot)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &holderSnapshotR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &holderSnapshotR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`holder_snapshot_entries`),
+		qm.WhereIn(`holder_snapshot_entries.snapshot_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load holder_snapshot_entries")
+	}
+
+	var resultSlice []*HolderSnapshotEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice holder_snapshot_entries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on holder_snapshot_entries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for holder_snapshot_entries")
+	}
+
+	if len(holderSnapshotEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SnapshotHolderSnapshotEntries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &holderSnapshotEntryR{}
+			}
+			foreign.R.Snapshot = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SnapshotID {
+				local.R.SnapshotHolderSnapshotEntries = append(local.R.SnapshotHolderSnapshotEntries, foreign)
+				if foreign.R == nil {
+					foreign.R = &holderSnapshotEntryR{}
+				}
+				foreign.R.Snapshot = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCreatedBy of the holderSnapshot to the related item.
+// Sets o.R.CreatedBy to related.
+// Adds o to related.R.CreatedByHolderSnapshots.
+func (o *HolderSnapshot) SetCreatedBy(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"holder_snapshots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+		strmangle.WhereClause("\"", "\"", 2, holderSnapshotPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CreatedByID = related.ID
+	if o.R == nil {
+		o.R = &holderSnapshotR{
+			CreatedBy: related,
+		}
+	} else {
+		o.R.CreatedBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatedByHolderSnapshots: HolderSnapshotSlice{o},
+		}
+	} else {
+		related.R.CreatedByHolderSnapshots = append(related.R.CreatedByHolderSnapshots, o)
+	}
+
+	return nil
+}
+
+// AddSnapshotHolderSnapshotEntries adds the given related objects to the existing relationships
+// of the holder_snapshot, optionally inserting them as new records.
+// Appends related to o.R.SnapshotHolderSnapshotEntries.
+// Sets related.R.Snapshot appropriately.
+func (o *HolderSnapshot) AddSnapshotHolderSnapshotEntries(exec boil.Executor, insert bool, related ...*HolderSnapshotEntry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SnapshotID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"holder_snapshot_entries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"snapshot_id"}),
+				strmangle.WhereClause("\"", "\"", 2, holderSnapshotEntryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SnapshotID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &holderSnapshotR{
+			SnapshotHolderSnapshotEntries: related,
+		}
+	} else {
+		o.R.SnapshotHolderSnapshotEntries = append(o.R.SnapshotHolderSnapshotEntries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &holderSnapshotEntryR{
+				Snapshot: o,
+			}
+		} else {
+			rel.R.Snapshot = o
+		}
+	}
+	return nil
+}
+
+// HolderSnapshots retrieves all the records using an executor.
+func HolderSnapshots(mods ...qm.QueryMod) holderSnapshotQuery {
+	mods = append(mods, qm.From("\"holder_snapshots\""))
+	return holderSnapshotQuery{NewQuery(mods...)}
+}
+
+// FindHolderSnapshot retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindHolderSnapshot(exec boil.Executor, iD string, selectCols ...string) (*HolderSnapshot, error) {
+	holderSnapshotObj := &HolderSnapshot{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"holder_snapshots\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, holderSnapshotObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from holder_snapshots")
+	}
+
+	if err = holderSnapshotObj.doAfterSelectHooks(exec); err != nil {
+		return holderSnapshotObj, err
+	}
+
+	return holderSnapshotObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *HolderSnapshot) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no holder_snapshots provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(holderSnapshotColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	holderSnapshotInsertCacheMut.RLock()
+	cache, cached := holderSnapshotInsertCache[key]
+	holderSnapshotInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			holderSnapshotAllColumns,
+			holderSnapshotColumnsWithDefault,
+			holderSnapshotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(holderSnapshotType, holderSnapshotMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(holderSnapshotType, holderSnapshotMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"holder_snapshots\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"holder_snapshots\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into holder_snapshots")
+	}
+
+	if !cached {
+		holderSnapshotInsertCacheMut.Lock()
+		holderSnapshotInsertCache[key] = cache
+		holderSnapshotInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the HolderSnapshot.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *HolderSnapshot) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	holderSnapshotUpdateCacheMut.RLock()
+	cache, cached := holderSnapshotUpdateCache[key]
+	holderSnapshotUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			holderSnapshotAllColumns,
+			holderSnapshotPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update holder_snapshots, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"holder_snapshots\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, holderSnapshotPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(holderSnapshotType, holderSnapshotMapping, append(wl, holderSnapshotPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update holder_snapshots row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for holder_snapshots")
+	}
+
+	if !cached {
+		holderSnapshotUpdateCacheMut.Lock()
+		holderSnapshotUpdateCache[key] = cache
+		holderSnapshotUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q holderSnapshotQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for holder_snapshots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for holder_snapshots")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o HolderSnapshotSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), holderSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"holder_snapshots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, holderSnapshotPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in holderSnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all holderSnapshot")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *HolderSnapshot) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no holder_snapshots provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(holderSnapshotColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	holderSnapshotUpsertCacheMut.RLock()
+	cache, cached := holderSnapshotUpsertCache[key]
+	holderSnapshotUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			holderSnapshotAllColumns,
+			holderSnapshotColumnsWithDefault,
+			holderSnapshotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			holderSnapshotAllColumns,
+			holderSnapshotPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert holder_snapshots, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(holderSnapshotPrimaryKeyColumns))
+			copy(conflict, holderSnapshotPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"holder_snapshots\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(holderSnapshotType, holderSnapshotMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(holderSnapshotType, holderSnapshotMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert holder_snapshots")
+	}
+
+	if !cached {
+		holderSnapshotUpsertCacheMut.Lock()
+		holderSnapshotUpsertCache[key] = cache
+		holderSnapshotUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single HolderSnapshot record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *HolderSnapshot) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no HolderSnapshot provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), holderSnapshotPrimaryKeyMapping)
+	sql := "DELETE FROM \"holder_snapshots\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from holder_snapshots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for holder_snapshots")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q holderSnapshotQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no holderSnapshotQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from holder_snapshots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for holder_snapshots")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o HolderSnapshotSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(holderSnapshotBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), holderSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"holder_snapshots\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, holderSnapshotPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from holderSnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for holder_snapshots")
+	}
+
+	if len(holderSnapshotAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *HolderSnapshot) Reload(exec boil.Executor) error {
+	ret, err := FindHolderSnapshot(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *HolderSnapshotSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := HolderSnapshotSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), holderSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"holder_snapshots\".* FROM \"holder_snapshots\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, holderSnapshotPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in HolderSnapshotSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// HolderSnapshotExists checks if the HolderSnapshot row exists.
+func HolderSnapshotExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"holder_snapshots\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if holder_snapshots exists")
+	}
+
+	return exists, nil
+}
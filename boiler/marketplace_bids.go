@@ -0,0 +1,1239 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MarketplaceBid is an object representing the database table.
+type MarketplaceBid struct {
+	ID           string          `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	ListingID    string          `boiler:"listing_id" boil:"listing_id" json:"listing_id" toml:"listing_id" yaml:"listing_id"`
+	BidderID     string          `boiler:"bidder_id" boil:"bidder_id" json:"bidder_id" toml:"bidder_id" yaml:"bidder_id"`
+	Amount       decimal.Decimal `boiler:"amount" boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	TXID         string          `boiler:"tx_id" boil:"tx_id" json:"tx_id" toml:"tx_id" yaml:"tx_id"`
+	RefundTXID   null.String     `boiler:"refund_tx_id" boil:"refund_tx_id" json:"refund_tx_id,omitempty" toml:"refund_tx_id" yaml:"refund_tx_id,omitempty"`
+	CreatedAt    time.Time       `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	RefundDueAt  null.Time       `boiler:"refund_due_at" boil:"refund_due_at" json:"refund_due_at,omitempty" toml:"refund_due_at" yaml:"refund_due_at,omitempty"`
+	RefundReason null.String     `boiler:"refund_reason" boil:"refund_reason" json:"refund_reason,omitempty" toml:"refund_reason" yaml:"refund_reason,omitempty"`
+
+	R *marketplaceBidR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L marketplaceBidL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MarketplaceBidColumns = struct {
+	ID           string
+	ListingID    string
+	BidderID     string
+	Amount       string
+	TXID         string
+	RefundTXID   string
+	CreatedAt    string
+	RefundDueAt  string
+	RefundReason string
+}{
+	ID:           "id",
+	ListingID:    "listing_id",
+	BidderID:     "bidder_id",
+	Amount:       "amount",
+	TXID:         "tx_id",
+	RefundTXID:   "refund_tx_id",
+	CreatedAt:    "created_at",
+	RefundDueAt:  "refund_due_at",
+	RefundReason: "refund_reason",
+}
+
+var MarketplaceBidTableColumns = struct {
+	ID           string
+	ListingID    string
+	BidderID     string
+	Amount       string
+	TXID         string
+	RefundTXID   string
+	CreatedAt    string
+	RefundDueAt  string
+	RefundReason string
+}{
+	ID:           "marketplace_bids.id",
+	ListingID:    "marketplace_bids.listing_id",
+	BidderID:     "marketplace_bids.bidder_id",
+	Amount:       "marketplace_bids.amount",
+	TXID:         "marketplace_bids.tx_id",
+	RefundTXID:   "marketplace_bids.refund_tx_id",
+	CreatedAt:    "marketplace_bids.created_at",
+	RefundDueAt:  "marketplace_bids.refund_due_at",
+	RefundReason: "marketplace_bids.refund_reason",
+}
+
+// Generated where
+
+var MarketplaceBidWhere = struct {
+	ID           whereHelperstring
+	ListingID    whereHelperstring
+	BidderID     whereHelperstring
+	Amount       whereHelperdecimal_Decimal
+	TXID         whereHelperstring
+	RefundTXID   whereHelpernull_String
+	CreatedAt    whereHelpertime_Time
+	RefundDueAt  whereHelpernull_Time
+	RefundReason whereHelpernull_String
+}{
+	ID:           whereHelperstring{field: "\"marketplace_bids\".\"id\""},
+	ListingID:    whereHelperstring{field: "\"marketplace_bids\".\"listing_id\""},
+	BidderID:     whereHelperstring{field: "\"marketplace_bids\".\"bidder_id\""},
+	Amount:       whereHelperdecimal_Decimal{field: "\"marketplace_bids\".\"amount\""},
+	TXID:         whereHelperstring{field: "\"marketplace_bids\".\"tx_id\""},
+	RefundTXID:   whereHelpernull_String{field: "\"marketplace_bids\".\"refund_tx_id\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"marketplace_bids\".\"created_at\""},
+	RefundDueAt:  whereHelpernull_Time{field: "\"marketplace_bids\".\"refund_due_at\""},
+	RefundReason: whereHelpernull_String{field: "\"marketplace_bids\".\"refund_reason\""},
+}
+
+// MarketplaceBidRels is where relationship names are stored.
+var MarketplaceBidRels = struct {
+	Bidder  string
+	Listing string
+}{
+	Bidder:  "Bidder",
+	Listing: "Listing",
+}
+
+// marketplaceBidR is where relationships are stored.
+type marketplaceBidR struct {
+	Bidder  *User               `boiler:"Bidder" boil:"Bidder" json:"Bidder" toml:"Bidder" yaml:"Bidder"`
+	Listing *MarketplaceListing `boiler:"Listing" boil:"Listing" json:"Listing" toml:"Listing" yaml:"Listing"`
+}
+
+// NewStruct creates a new relationship struct
+func (*marketplaceBidR) NewStruct() *marketplaceBidR {
+	return &marketplaceBidR{}
+}
+
+// marketplaceBidL is where Load methods for each relationship are stored.
+type marketplaceBidL struct{}
+
+var (
+	marketplaceBidAllColumns            = []string{"id", "listing_id", "bidder_id", "amount", "tx_id", "refund_tx_id", "created_at", "refund_due_at", "refund_reason"}
+	marketplaceBidColumnsWithoutDefault = []string{"listing_id", "bidder_id", "amount", "tx_id"}
+	marketplaceBidColumnsWithDefault    = []string{"id", "refund_tx_id", "created_at", "refund_due_at", "refund_reason"}
+	marketplaceBidPrimaryKeyColumns     = []string{"id"}
+	marketplaceBidGeneratedColumns      = []string{}
+)
+
+type (
+	// MarketplaceBidSlice is an alias for a slice of pointers to MarketplaceBid.
+	// This should almost always be used instead of []MarketplaceBid.
+	MarketplaceBidSlice []*MarketplaceBid
+	// MarketplaceBidHook is the signature for custom MarketplaceBid hook methods
+	MarketplaceBidHook func(boil.Executor, *MarketplaceBid) error
+
+	marketplaceBidQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	marketplaceBidType                 = reflect.TypeOf(&MarketplaceBid{})
+	marketplaceBidMapping              = queries.MakeStructMapping(marketplaceBidType)
+	marketplaceBidPrimaryKeyMapping, _ = queries.BindMapping(marketplaceBidType, marketplaceBidMapping, marketplaceBidPrimaryKeyColumns)
+	marketplaceBidInsertCacheMut       sync.RWMutex
+	marketplaceBidInsertCache          = make(map[string]insertCache)
+	marketplaceBidUpdateCacheMut       sync.RWMutex
+	marketplaceBidUpdateCache          = make(map[string]updateCache)
+	marketplaceBidUpsertCacheMut       sync.RWMutex
+	marketplaceBidUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var marketplaceBidAfterSelectHooks []MarketplaceBidHook
+
+var marketplaceBidBeforeInsertHooks []MarketplaceBidHook
+var marketplaceBidAfterInsertHooks []MarketplaceBidHook
+
+var marketplaceBidBeforeUpdateHooks []MarketplaceBidHook
+var marketplaceBidAfterUpdateHooks []MarketplaceBidHook
+
+var marketplaceBidBeforeDeleteHooks []MarketplaceBidHook
+var marketplaceBidAfterDeleteHooks []MarketplaceBidHook
+
+var marketplaceBidBeforeUpsertHooks []MarketplaceBidHook
+var marketplaceBidAfterUpsertHooks []MarketplaceBidHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MarketplaceBid) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceBidAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MarketplaceBid) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceBidBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MarketplaceBid) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceBidAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MarketplaceBid) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceBidBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MarketplaceBid) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceBidAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MarketplaceBid) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceBidBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MarketplaceBid) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceBidAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MarketplaceBid) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceBidBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MarketplaceBid) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceBidAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMarketplaceBidHook registers your hook function for all future operations.
+func AddMarketplaceBidHook(hookPoint boil.HookPoint, marketplaceBidHook MarketplaceBidHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		marketplaceBidAfterSelectHooks = append(marketplaceBidAfterSelectHooks, marketplaceBidHook)
+	case boil.BeforeInsertHook:
+		marketplaceBidBeforeInsertHooks = append(marketplaceBidBeforeInsertHooks, marketplaceBidHook)
+	case boil.AfterInsertHook:
+		marketplaceBidAfterInsertHooks = append(marketplaceBidAfterInsertHooks, marketplaceBidHook)
+	case boil.BeforeUpdateHook:
+		marketplaceBidBeforeUpdateHooks = append(marketplaceBidBeforeUpdateHooks, marketplaceBidHook)
+	case boil.AfterUpdateHook:
+		marketplaceBidAfterUpdateHooks = append(marketplaceBidAfterUpdateHooks, marketplaceBidHook)
+	case boil.BeforeDeleteHook:
+		marketplaceBidBeforeDeleteHooks = append(marketplaceBidBeforeDeleteHooks, marketplaceBidHook)
+	case boil.AfterDeleteHook:
+		marketplaceBidAfterDeleteHooks = append(marketplaceBidAfterDeleteHooks, marketplaceBidHook)
+	case boil.BeforeUpsertHook:
+		marketplaceBidBeforeUpsertHooks = append(marketplaceBidBeforeUpsertHooks, marketplaceBidHook)
+	case boil.AfterUpsertHook:
+		marketplaceBidAfterUpsertHooks = append(marketplaceBidAfterUpsertHooks, marketplaceBidHook)
+	}
+}
+
+// One returns a single marketplaceBid record from the query.
+func (q marketplaceBidQuery) One(exec boil.Executor) (*MarketplaceBid, error) {
+	o := &MarketplaceBid{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for marketplace_bids")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MarketplaceBid records from the query.
+func (q marketplaceBidQuery) All(exec boil.Executor) (MarketplaceBidSlice, error) {
+	var o []*MarketplaceBid
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to MarketplaceBid slice")
+	}
+
+	if len(marketplaceBidAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MarketplaceBid records in the query.
+func (q marketplaceBidQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count marketplace_bids rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q marketplaceBidQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if marketplace_bids exists")
+	}
+
+	return count > 0, nil
+}
+
+// Bidder pointed to by the foreign key.
+func (o *MarketplaceBid) Bidder(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BidderID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// Listing pointed to by the foreign key.
+func (o *MarketplaceBid) Listing(mods ...qm.QueryMod) marketplaceListingQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ListingID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := MarketplaceListings(queryMods...)
+	queries.SetFrom(query.Query, "\"marketplace_listings\"")
+
+	return query
+}
+
+// LoadBidder allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (marketplaceBidL) LoadBidder(e boil.Executor, singular bool, maybeMarketplaceBid interface{}, mods queries.Applicator) error {
+	var slice []*MarketplaceBid
+	var object *MarketplaceBid
+
+	if singular {
+		object = maybeMarketplaceBid.(*MarketplaceBid)
+	} else {
+		slice = *maybeMarketplaceBid.(*[]*MarketplaceBid)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &marketplaceBidR{}
+		}
+		args = append(args, object.BidderID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &marketplaceBidR{}
+			}
+
+			for _, a := range args {
+				if a == obj.BidderID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.BidderID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(marketplaceBidAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Bidder = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.BidderMarketplaceBids = append(foreign.R.BidderMarketplaceBids, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BidderID == foreign.ID {
+				local.R.Bidder = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.BidderMarketplaceBids = append(foreign.R.BidderMarketplaceBids, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListing allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (marketplaceBidL) LoadListing(e boil.Executor, singular bool, maybeMarketplaceBid interface{}, mods queries.Applicator) error {
+	var slice []*MarketplaceBid
+	var object *MarketplaceBid
+
+	if singular {
+		object = maybeMarketplaceBid.(*MarketplaceBid)
+	} else {
+		slice = *maybeMarketplaceBid.(*[]*MarketplaceBid)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &marketplaceBidR{}
+		}
+		args = append(args, object.ListingID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &marketplaceBidR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ListingID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ListingID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`marketplace_listings`),
+		qm.WhereIn(`marketplace_listings.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load MarketplaceListing")
+	}
+
+	var resultSlice []*MarketplaceListing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice MarketplaceListing")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for marketplace_listings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for marketplace_listings")
+	}
+
+	if len(marketplaceBidAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Listing = foreign
+		if foreign.R == nil {
+			foreign.R = &marketplaceListingR{}
+		}
+		foreign.R.ListingMarketplaceBids = append(foreign.R.ListingMarketplaceBids, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ListingID == foreign.ID {
+				local.R.Listing = foreign
+				if foreign.R == nil {
+					foreign.R = &marketplaceListingR{}
+				}
+				foreign.R.ListingMarketplaceBids = append(foreign.R.ListingMarketplaceBids, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBidder of the marketplaceBid to the related item.
+// Sets o.R.Bidder to related.
+// Adds o to related.R.BidderMarketplaceBids.
+func (o *MarketplaceBid) SetBidder(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"marketplace_bids\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"bidder_id"}),
+		strmangle.WhereClause("\"", "\"", 2, marketplaceBidPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BidderID = related.ID
+	if o.R == nil {
+		o.R = &marketplaceBidR{
+			Bidder: related,
+		}
+	} else {
+		o.R.Bidder = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			BidderMarketplaceBids: MarketplaceBidSlice{o},
+		}
+	} else {
+		related.R.BidderMarketplaceBids = append(related.R.BidderMarketplaceBids, o)
+	}
+
+	return nil
+}
+
+// SetListing of the marketplaceBid to the related item.
+// Sets o.R.Listing to related.
+// Adds o to related.R.ListingMarketplaceBids.
+func (o *MarketplaceBid) SetListing(exec boil.Executor, insert bool, related *MarketplaceListing) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"marketplace_bids\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"listing_id"}),
+		strmangle.WhereClause("\"", "\"", 2, marketplaceBidPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ListingID = related.ID
+	if o.R == nil {
+		o.R = &marketplaceBidR{
+			Listing: related,
+		}
+	} else {
+		o.R.Listing = related
+	}
+
+	if related.R == nil {
+		related.R = &marketplaceListingR{
+			ListingMarketplaceBids: MarketplaceBidSlice{o},
+		}
+	} else {
+		related.R.ListingMarketplaceBids = append(related.R.ListingMarketplaceBids, o)
+	}
+
+	return nil
+}
+
+// MarketplaceBids retrieves all the records using an executor.
+func MarketplaceBids(mods ...qm.QueryMod) marketplaceBidQuery {
+	mods = append(mods, qm.From("\"marketplace_bids\""))
+	return marketplaceBidQuery{NewQuery(mods...)}
+}
+
+// FindMarketplaceBid retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMarketplaceBid(exec boil.Executor, iD string, selectCols ...string) (*MarketplaceBid, error) {
+	marketplaceBidObj := &MarketplaceBid{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"marketplace_bids\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, marketplaceBidObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from marketplace_bids")
+	}
+
+	if err = marketplaceBidObj.doAfterSelectHooks(exec); err != nil {
+		return marketplaceBidObj, err
+	}
+
+	return marketplaceBidObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MarketplaceBid) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no marketplace_bids provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(marketplaceBidColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	marketplaceBidInsertCacheMut.RLock()
+	cache, cached := marketplaceBidInsertCache[key]
+	marketplaceBidInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			marketplaceBidAllColumns,
+			marketplaceBidColumnsWithDefault,
+			marketplaceBidColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(marketplaceBidType, marketplaceBidMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(marketplaceBidType, marketplaceBidMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"marketplace_bids\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"marketplace_bids\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into marketplace_bids")
+	}
+
+	if !cached {
+		marketplaceBidInsertCacheMut.Lock()
+		marketplaceBidInsertCache[key] = cache
+		marketplaceBidInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the MarketplaceBid.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MarketplaceBid) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	marketplaceBidUpdateCacheMut.RLock()
+	cache, cached := marketplaceBidUpdateCache[key]
+	marketplaceBidUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			marketplaceBidAllColumns,
+			marketplaceBidPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update marketplace_bids, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"marketplace_bids\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, marketplaceBidPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(marketplaceBidType, marketplaceBidMapping, append(wl, marketplaceBidPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update marketplace_bids row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for marketplace_bids")
+	}
+
+	if !cached {
+		marketplaceBidUpdateCacheMut.Lock()
+		marketplaceBidUpdateCache[key] = cache
+		marketplaceBidUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q marketplaceBidQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for marketplace_bids")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for marketplace_bids")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MarketplaceBidSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), marketplaceBidPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"marketplace_bids\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, marketplaceBidPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in marketplaceBid slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all marketplaceBid")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MarketplaceBid) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no marketplace_bids provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(marketplaceBidColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	marketplaceBidUpsertCacheMut.RLock()
+	cache, cached := marketplaceBidUpsertCache[key]
+	marketplaceBidUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			marketplaceBidAllColumns,
+			marketplaceBidColumnsWithDefault,
+			marketplaceBidColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			marketplaceBidAllColumns,
+			marketplaceBidPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert marketplace_bids, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(marketplaceBidPrimaryKeyColumns))
+			copy(conflict, marketplaceBidPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"marketplace_bids\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(marketplaceBidType, marketplaceBidMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(marketplaceBidType, marketplaceBidMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert marketplace_bids")
+	}
+
+	if !cached {
+		marketplaceBidUpsertCacheMut.Lock()
+		marketplaceBidUpsertCache[key] = cache
+		marketplaceBidUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single MarketplaceBid record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MarketplaceBid) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no MarketplaceBid provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), marketplaceBidPrimaryKeyMapping)
+	sql := "DELETE FROM \"marketplace_bids\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from marketplace_bids")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for marketplace_bids")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q marketplaceBidQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no marketplaceBidQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from marketplace_bids")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for marketplace_bids")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MarketplaceBidSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(marketplaceBidBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), marketplaceBidPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"marketplace_bids\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, marketplaceBidPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from marketplaceBid slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for marketplace_bids")
+	}
+
+	if len(marketplaceBidAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MarketplaceBid) Reload(exec boil.Executor) error {
+	ret, err := FindMarketplaceBid(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MarketplaceBidSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MarketplaceBidSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), marketplaceBidPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"marketplace_bids\".* FROM \"marketplace_bids\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, marketplaceBidPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in MarketplaceBidSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MarketplaceBidExists checks if the MarketplaceBid row exists.
+func MarketplaceBidExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"marketplace_bids\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if marketplace_bids exists")
+	}
+
+	return exists, nil
+}
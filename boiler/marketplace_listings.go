@@ -0,0 +1,2153 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MarketplaceListing is an object representing the database table.
+type MarketplaceListing struct {
+	ID              string              `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	SellerID        string              `boiler:"seller_id" boil:"seller_id" json:"seller_id" toml:"seller_id" yaml:"seller_id"`
+	ListingType     string              `boiler:"listing_type" boil:"listing_type" json:"listing_type" toml:"listing_type" yaml:"listing_type"`
+	UserAssetID     null.String         `boiler:"user_asset_id" boil:"user_asset_id" json:"user_asset_id,omitempty" toml:"user_asset_id" yaml:"user_asset_id,omitempty"`
+	UserAsset1155ID null.String         `boiler:"user_asset_1155_id" boil:"user_asset_1155_id" json:"user_asset_1155_id,omitempty" toml:"user_asset_1155_id" yaml:"user_asset_1155_id,omitempty"`
+	Amount          int                 `boiler:"amount" boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Price           decimal.Decimal     `boiler:"price" boil:"price" json:"price" toml:"price" yaml:"price"`
+	CurrentBid      decimal.NullDecimal `boiler:"current_bid" boil:"current_bid" json:"current_bid,omitempty" toml:"current_bid" yaml:"current_bid,omitempty"`
+	CurrentBidderID null.String         `boiler:"current_bidder_id" boil:"current_bidder_id" json:"current_bidder_id,omitempty" toml:"current_bidder_id" yaml:"current_bidder_id,omitempty"`
+	CurrentBidTXID  null.String         `boiler:"current_bid_tx_id" boil:"current_bid_tx_id" json:"current_bid_tx_id,omitempty" toml:"current_bid_tx_id" yaml:"current_bid_tx_id,omitempty"`
+	Status          string              `boiler:"status" boil:"status" json:"status" toml:"status" yaml:"status"`
+	BuyerID         null.String         `boiler:"buyer_id" boil:"buyer_id" json:"buyer_id,omitempty" toml:"buyer_id" yaml:"buyer_id,omitempty"`
+	SalePrice       decimal.NullDecimal `boiler:"sale_price" boil:"sale_price" json:"sale_price,omitempty" toml:"sale_price" yaml:"sale_price,omitempty"`
+	Fee             decimal.NullDecimal `boiler:"fee" boil:"fee" json:"fee,omitempty" toml:"fee" yaml:"fee,omitempty"`
+	SaleTXID        null.String         `boiler:"sale_tx_id" boil:"sale_tx_id" json:"sale_tx_id,omitempty" toml:"sale_tx_id" yaml:"sale_tx_id,omitempty"`
+	FeeTXID         null.String         `boiler:"fee_tx_id" boil:"fee_tx_id" json:"fee_tx_id,omitempty" toml:"fee_tx_id" yaml:"fee_tx_id,omitempty"`
+	ExpiresAt       time.Time           `boiler:"expires_at" boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CompletedAt     null.Time           `boiler:"completed_at" boil:"completed_at" json:"completed_at,omitempty" toml:"completed_at" yaml:"completed_at,omitempty"`
+	UpdatedAt       time.Time           `boiler:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedAt       time.Time           `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *marketplaceListingR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L marketplaceListingL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MarketplaceListingColumns = struct {
+	ID              string
+	SellerID        string
+	ListingType     string
+	UserAssetID     string
+	UserAsset1155ID string
+	Amount          string
+	Price           string
+	CurrentBid      string
+	CurrentBidderID string
+	CurrentBidTXID  string
+	Status          string
+	BuyerID         string
+	SalePrice       string
+	Fee             string
+	SaleTXID        string
+	FeeTXID         string
+	ExpiresAt       string
+	CompletedAt     string
+	UpdatedAt       string
+	CreatedAt       string
+}{
+	ID:              "id",
+	SellerID:        "seller_id",
+	ListingType:     "listing_type",
+	UserAssetID:     "user_asset_id",
+	UserAsset1155ID: "user_asset_1155_id",
+	Amount:          "amount",
+	Price:           "price",
+	CurrentBid:      "current_bid",
+	CurrentBidderID: "current_bidder_id",
+	CurrentBidTXID:  "current_bid_tx_id",
+	Status:          "status",
+	BuyerID:         "buyer_id",
+	SalePrice:       "sale_price",
+	Fee:             "fee",
+	SaleTXID:        "sale_tx_id",
+	FeeTXID:         "fee_tx_id",
+	ExpiresAt:       "expires_at",
+	CompletedAt:     "completed_at",
+	UpdatedAt:       "updated_at",
+	CreatedAt:       "created_at",
+}
+
+var MarketplaceListingTableColumns = struct {
+	ID              string
+	SellerID        string
+	ListingType     string
+	UserAssetID     string
+	UserAsset1155ID string
+	Amount          string
+	Price           string
+	CurrentBid      string
+	CurrentBidderID string
+	CurrentBidTXID  string
+	Status          string
+	BuyerID         string
+	SalePrice       string
+	Fee             string
+	SaleTXID        string
+	FeeTXID         string
+	ExpiresAt       string
+	CompletedAt     string
+	UpdatedAt       string
+	CreatedAt       string
+}{
+	ID:              "marketplace_listings.id",
+	SellerID:        "marketplace_listings.seller_id",
+	ListingType:     "marketplace_listings.listing_type",
+	UserAssetID:     "marketplace_listings.user_asset_id",
+	UserAsset1155ID: "marketplace_listings.user_asset_1155_id",
+	Amount:          "marketplace_listings.amount",
+	Price:           "marketplace_listings.price",
+	CurrentBid:      "marketplace_listings.current_bid",
+	CurrentBidderID: "marketplace_listings.current_bidder_id",
+	CurrentBidTXID:  "marketplace_listings.current_bid_tx_id",
+	Status:          "marketplace_listings.status",
+	BuyerID:         "marketplace_listings.buyer_id",
+	SalePrice:       "marketplace_listings.sale_price",
+	Fee:             "marketplace_listings.fee",
+	SaleTXID:        "marketplace_listings.sale_tx_id",
+	FeeTXID:         "marketplace_listings.fee_tx_id",
+	ExpiresAt:       "marketplace_listings.expires_at",
+	CompletedAt:     "marketplace_listings.completed_at",
+	UpdatedAt:       "marketplace_listings.updated_at",
+	CreatedAt:       "marketplace_listings.created_at",
+}
+
+// Generated where
+
+var MarketplaceListingWhere = struct {
+	ID              whereHelperstring
+	SellerID        whereHelperstring
+	ListingType     whereHelperstring
+	UserAssetID     whereHelpernull_String
+	UserAsset1155ID whereHelpernull_String
+	Amount          whereHelperint
+	Price           whereHelperdecimal_Decimal
+	CurrentBid      whereHelperdecimal_NullDecimal
+	CurrentBidderID whereHelpernull_String
+	CurrentBidTXID  whereHelpernull_String
+	Status          whereHelperstring
+	BuyerID         whereHelpernull_String
+	SalePrice       whereHelperdecimal_NullDecimal
+	Fee             whereHelperdecimal_NullDecimal
+	SaleTXID        whereHelpernull_String
+	FeeTXID         whereHelpernull_String
+	ExpiresAt       whereHelpertime_Time
+	CompletedAt     whereHelpernull_Time
+	UpdatedAt       whereHelpertime_Time
+	CreatedAt       whereHelpertime_Time
+}{
+	ID:              whereHelperstring{field: "\"marketplace_listings\".\"id\""},
+	SellerID:        whereHelperstring{field: "\"marketplace_listings\".\"seller_id\""},
+	ListingType:     whereHelperstring{field: "\"marketplace_listings\".\"listing_type\""},
+	UserAssetID:     whereHelpernull_String{field: "\"marketplace_listings\".\"user_asset_id\""},
+	UserAsset1155ID: whereHelpernull_String{field: "\"marketplace_listings\".\"user_asset_1155_id\""},
+	Amount:          whereHelperint{field: "\"marketplace_listings\".\"amount\""},
+	Price:           whereHelperdecimal_Decimal{field: "\"marketplace_listings\".\"price\""},
+	CurrentBid:      whereHelperdecimal_NullDecimal{field: "\"marketplace_listings\".\"current_bid\""},
+	CurrentBidderID: whereHelpernull_String{field: "\"marketplace_listings\".\"current_bidder_id\""},
+	CurrentBidTXID:  whereHelpernull_String{field: "\"marketplace_listings\".\"current_bid_tx_id\""},
+	Status:          whereHelperstring{field: "\"marketplace_listings\".\"status\""},
+	BuyerID:         whereHelpernull_String{field: "\"marketplace_listings\".\"buyer_id\""},
+	SalePrice:       whereHelperdecimal_NullDecimal{field: "\"marketplace_listings\".\"sale_price\""},
+	Fee:             whereHelperdecimal_NullDecimal{field: "\"marketplace_listings\".\"fee\""},
+	SaleTXID:        whereHelpernull_String{field: "\"marketplace_listings\".\"sale_tx_id\""},
+	FeeTXID:         whereHelpernull_String{field: "\"marketplace_listings\".\"fee_tx_id\""},
+	ExpiresAt:       whereHelpertime_Time{field: "\"marketplace_listings\".\"expires_at\""},
+	CompletedAt:     whereHelpernull_Time{field: "\"marketplace_listings\".\"completed_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"marketplace_listings\".\"updated_at\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"marketplace_listings\".\"created_at\""},
+}
+
+// MarketplaceListingRels is where relationship names are stored.
+var MarketplaceListingRels = struct {
+	Buyer                  string
+	CurrentBidder          string
+	Seller                 string
+	UserAsset1155          string
+	UserAsset              string
+	ListingMarketplaceBids string
+}{
+	Buyer:                  "Buyer",
+	CurrentBidder:          "CurrentBidder",
+	Seller:                 "Seller",
+	UserAsset1155:          "UserAsset1155",
+	UserAsset:              "UserAsset",
+	ListingMarketplaceBids: "ListingMarketplaceBids",
+}
+
+// marketplaceListingR is where relationships are stored.
+type marketplaceListingR struct {
+	Buyer                  *User               `boiler:"Buyer" boil:"Buyer" json:"Buyer" toml:"Buyer" yaml:"Buyer"`
+	CurrentBidder          *User               `boiler:"CurrentBidder" boil:"CurrentBidder" json:"CurrentBidder" toml:"CurrentBidder" yaml:"CurrentBidder"`
+	Seller                 *User               `boiler:"Seller" boil:"Seller" json:"Seller" toml:"Seller" yaml:"Seller"`
+	UserAsset1155          *UserAssets1155     `boiler:"UserAsset1155" boil:"UserAsset1155" json:"UserAsset1155" toml:"UserAsset1155" yaml:"UserAsset1155"`
+	UserAsset              *UserAsset          `boiler:"UserAsset" boil:"UserAsset" json:"UserAsset" toml:"UserAsset" yaml:"UserAsset"`
+	ListingMarketplaceBids MarketplaceBidSlice `boiler:"ListingMarketplaceBids" boil:"ListingMarketplaceBids" json:"ListingMarketplaceBids" toml:"ListingMarketplaceBids" yaml:"ListingMarketplaceBids"`
+}
+
+// NewStruct creates a new relationship struct
+func (*marketplaceListingR) NewStruct() *marketplaceListingR {
+	return &marketplaceListingR{}
+}
+
+// marketplaceListingL is where Load methods for each relationship are stored.
+type marketplaceListingL struct{}
+
+var (
+	marketplaceListingAllColumns            = []string{"id", "seller_id", "listing_type", "user_asset_id", "user_asset_1155_id", "amount", "price", "current_bid", "current_bidder_id", "current_bid_tx_id", "status", "buyer_id", "sale_price", "fee", "sale_tx_id", "fee_tx_id", "expires_at", "completed_at", "updated_at", "created_at"}
+	marketplaceListingColumnsWithoutDefault = []string{"seller_id", "listing_type", "price", "expires_at"}
+	marketplaceListingColumnsWithDefault    = []string{"id", "user_asset_id", "user_asset_1155_id", "amount", "current_bid", "current_bidder_id", "current_bid_tx_id", "status", "buyer_id", "sale_price", "fee", "sale_tx_id", "fee_tx_id", "completed_at", "updated_at", "created_at"}
+	marketplaceListingPrimaryKeyColumns     = []string{"id"}
+	marketplaceListingGeneratedColumns      = []string{}
+)
+
+type (
+	// MarketplaceListingSlice is an alias for a slice of pointers to MarketplaceListing.
+	// This should almost always be used instead of []MarketplaceListing.
+	MarketplaceListingSlice []*MarketplaceListing
+	// MarketplaceListingHook is the signature for custom MarketplaceListing hook methods
+	MarketplaceListingHook func(boil.Executor, *MarketplaceListing) error
+
+	marketplaceListingQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	marketplaceListingType                 = reflect.TypeOf(&MarketplaceListing{})
+	marketplaceListingMapping              = queries.MakeStructMapping(marketplaceListingType)
+	marketplaceListingPrimaryKeyMapping, _ = queries.BindMapping(marketplaceListingType, marketplaceListingMapping, marketplaceListingPrimaryKeyColumns)
+	marketplaceListingInsertCacheMut       sync.RWMutex
+	marketplaceListingInsertCache          = make(map[string]insertCache)
+	marketplaceListingUpdateCacheMut       sync.RWMutex
+	marketplaceListingUpdateCache          = make(map[string]updateCache)
+	marketplaceListingUpsertCacheMut       sync.RWMutex
+	marketplaceListingUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var marketplaceListingAfterSelectHooks []MarketplaceListingHook
+
+var marketplaceListingBeforeInsertHooks []MarketplaceListingHook
+var marketplaceListingAfterInsertHooks []MarketplaceListingHook
+
+var marketplaceListingBeforeUpdateHooks []MarketplaceListingHook
+var marketplaceListingAfterUpdateHooks []MarketplaceListingHook
+
+var marketplaceListingBeforeDeleteHooks []MarketplaceListingHook
+var marketplaceListingAfterDeleteHooks []MarketplaceListingHook
+
+var marketplaceListingBeforeUpsertHooks []MarketplaceListingHook
+var marketplaceListingAfterUpsertHooks []MarketplaceListingHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MarketplaceListing) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceListingAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MarketplaceListing) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceListingBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MarketplaceListing) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceListingAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MarketplaceListing) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceListingBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MarketplaceListing) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceListingAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MarketplaceListing) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceListingBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MarketplaceListing) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceListingAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MarketplaceListing) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceListingBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MarketplaceListing) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range marketplaceListingAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMarketplaceListingHook registers your hook function for all future operations.
+func AddMarketplaceListingHook(hookPoint boil.HookPoint, marketplaceListingHook MarketplaceListingHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		marketplaceListingAfterSelectHooks = append(marketplaceListingAfterSelectHooks, marketplaceListingHook)
+	case boil.BeforeInsertHook:
+		marketplaceListingBeforeInsertHooks = append(marketplaceListingBeforeInsertHooks, marketplaceListingHook)
+	case boil.AfterInsertHook:
+		marketplaceListingAfterInsertHooks = append(marketplaceListingAfterInsertHooks, marketplaceListingHook)
+	case boil.BeforeUpdateHook:
+		marketplaceListingBeforeUpdateHooks = append(marketplaceListingBeforeUpdateHooks, marketplaceListingHook)
+	case boil.AfterUpdateHook:
+		marketplaceListingAfterUpdateHooks = append(marketplaceListingAfterUpdateHooks, marketplaceListingHook)
+	case boil.BeforeDeleteHook:
+		marketplaceListingBeforeDeleteHooks = append(marketplaceListingBeforeDeleteHooks, marketplaceListingHook)
+	case boil.AfterDeleteHook:
+		marketplaceListingAfterDeleteHooks = append(marketplaceListingAfterDeleteHooks, marketplaceListingHook)
+	case boil.BeforeUpsertHook:
+		marketplaceListingBeforeUpsertHooks = append(marketplaceListingBeforeUpsertHooks, marketplaceListingHook)
+	case boil.AfterUpsertHook:
+		marketplaceListingAfterUpsertHooks = append(marketplaceListingAfterUpsertHooks, marketplaceListingHook)
+	}
+}
+
+// One returns a single marketplaceListing record from the query.
+func (q marketplaceListingQuery) One(exec boil.Executor) (*MarketplaceListing, error) {
+	o := &MarketplaceListing{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for marketplace_listings")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MarketplaceListing records from the query.
+func (q marketplaceListingQuery) All(exec boil.Executor) (MarketplaceListingSlice, error) {
+	var o []*MarketplaceListing
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to MarketplaceListing slice")
+	}
+
+	if len(marketplaceListingAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MarketplaceListing records in the query.
+func (q marketplaceListingQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count marketplace_listings rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q marketplaceListingQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if marketplace_listings exists")
+	}
+
+	return count > 0, nil
+}
+
+// Buyer pointed to by the foreign key.
+func (o *MarketplaceListing) Buyer(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BuyerID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// CurrentBidder pointed to by the foreign key.
+func (o *MarketplaceListing) CurrentBidder(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CurrentBidderID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// Seller pointed to by the foreign key.
+func (o *MarketplaceListing) Seller(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SellerID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// UserAsset1155 pointed to by the foreign key.
+func (o *MarketplaceListing) UserAsset1155(mods ...qm.QueryMod) userAssets1155Query {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserAsset1155ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := UserAssets1155S(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets_1155\"")
+
+	return query
+}
+
+// UserAsset pointed to by the foreign key.
+func (o *MarketplaceListing) UserAsset(mods ...qm.QueryMod) userAssetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserAssetID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := UserAssets(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets\"")
+
+	return query
+}
+
+// ListingMarketplaceBids retrieves all the marketplace_bid's MarketplaceBids with an executor via listing_id column.
+func (o *MarketplaceListing) ListingMarketplaceBids(mods ...qm.QueryMod) marketplaceBidQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"marketplace_bids\".\"listing_id\"=?", o.ID),
+	)
+
+	query := MarketplaceBids(queryMods...)
+	queries.SetFrom(query.Query, "\"marketplace_bids\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"marketplace_bids\".*"})
+	}
+
+	return query
+}
+
+// LoadBuyer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (marketplaceListingL) LoadBuyer(e boil.Executor, singular bool, maybeMarketplaceListing interface{}, mods queries.Applicator) error {
+	var slice []*MarketplaceListing
+	var object *MarketplaceListing
+
+	if singular {
+		object = maybeMarketplaceListing.(*MarketplaceListing)
+	} else {
+		slice = *maybeMarketplaceListing.(*[]*MarketplaceListing)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &marketplaceListingR{}
+		}
+		if !queries.IsNil(object.BuyerID) {
+			args = append(args, object.BuyerID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &marketplaceListingR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.BuyerID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.BuyerID) {
+				args = append(args, obj.BuyerID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(marketplaceListingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Buyer = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.BuyerMarketplaceListings = append(foreign.R.BuyerMarketplaceListings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.BuyerID, foreign.ID) {
+				local.R.Buyer = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.BuyerMarketplaceListings = append(foreign.R.BuyerMarketplaceListings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCurrentBidder allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (marketplaceListingL) LoadCurrentBidder(e boil.Executor, singular bool, maybeMarketplaceListing interface{}, mods queries.Applicator) error {
+	var slice []*MarketplaceListing
+	var object *MarketplaceListing
+
+	if singular {
+		object = maybeMarketplaceListing.(*MarketplaceListing)
+	} else {
+		slice = *maybeMarketplaceListing.(*[]*MarketplaceListing)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &marketplaceListingR{}
+		}
+		if !queries.IsNil(object.CurrentBidderID) {
+			args = append(args, object.CurrentBidderID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &marketplaceListingR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.CurrentBidderID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.CurrentBidderID) {
+				args = append(args, obj.CurrentBidderID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(marketplaceListingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CurrentBidder = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CurrentBidderMarketplaceListings = append(foreign.R.CurrentBidderMarketplaceListings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CurrentBidderID, foreign.ID) {
+				local.R.CurrentBidder = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CurrentBidderMarketplaceListings = append(foreign.R.CurrentBidderMarketplaceListings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeller allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (marketplaceListingL) LoadSeller(e boil.Executor, singular bool, maybeMarketplaceListing interface{}, mods queries.Applicator) error {
+	var slice []*MarketplaceListing
+	var object *MarketplaceListing
+
+	if singular {
+		object = maybeMarketplaceListing.(*MarketplaceListing)
+	} else {
+		slice = *maybeMarketplaceListing.(*[]*MarketplaceListing)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &marketplaceListingR{}
+		}
+		args = append(args, object.SellerID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &marketplaceListingR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SellerID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SellerID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(marketplaceListingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Seller = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.SellerMarketplaceListings = append(foreign.R.SellerMarketplaceListings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SellerID == foreign.ID {
+				local.R.Seller = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.SellerMarketplaceListings = append(foreign.R.SellerMarketplaceListings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAsset1155 allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (marketplaceListingL) LoadUserAsset1155(e boil.Executor, singular bool, maybeMarketplaceListing interface{}, mods queries.Applicator) error {
+	var slice []*MarketplaceListing
+	var object *MarketplaceListing
+
+	if singular {
+		object = maybeMarketplaceListing.(*MarketplaceListing)
+	} else {
+		slice = *maybeMarketplaceListing.(*[]*MarketplaceListing)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &marketplaceListingR{}
+		}
+		if !queries.IsNil(object.UserAsset1155ID) {
+			args = append(args, object.UserAsset1155ID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &marketplaceListingR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.UserAsset1155ID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.UserAsset1155ID) {
+				args = append(args, obj.UserAsset1155ID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets_1155`),
+		qm.WhereIn(`user_assets_1155.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserAssets1155")
+	}
+
+	var resultSlice []*UserAssets1155
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserAssets1155")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_assets_1155")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets_1155")
+	}
+
+	if len(marketplaceListingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserAsset1155 = foreign
+		if foreign.R == nil {
+			foreign.R = &userAssets1155R{}
+		}
+		foreign.R.UserAsset1155MarketplaceListings = append(foreign.R.UserAsset1155MarketplaceListings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserAsset1155ID, foreign.ID) {
+				local.R.UserAsset1155 = foreign
+				if foreign.R == nil {
+					foreign.R = &userAssets1155R{}
+				}
+				foreign.R.UserAsset1155MarketplaceListings = append(foreign.R.UserAsset1155MarketplaceListings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAsset allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (marketplaceListingL) LoadUserAsset(e boil.Executor, singular bool, maybeMarketplaceListing interface{}, mods queries.Applicator) error {
+	var slice []*MarketplaceListing
+	var object *MarketplaceListing
+
+	if singular {
+		object = maybeMarketplaceListing.(*MarketplaceListing)
+	} else {
+		slice = *maybeMarketplaceListing.(*[]*MarketplaceListing)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &marketplaceListingR{}
+		}
+		if !queries.IsNil(object.UserAssetID) {
+			args = append(args, object.UserAssetID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &marketplaceListingR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.UserAssetID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.UserAssetID) {
+				args = append(args, obj.UserAssetID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets`),
+		qm.WhereIn(`user_assets.id in ?`, args...),
+		qmhelper.WhereIsNull(`user_assets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserAsset")
+	}
+
+	var resultSlice []*UserAsset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserAsset")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_assets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
+	}
+
+	if len(marketplaceListingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserAsset = foreign
+		if foreign.R == nil {
+			foreign.R = &userAssetR{}
+		}
+		foreign.R.MarketplaceListings = append(foreign.R.MarketplaceListings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserAssetID, foreign.ID) {
+				local.R.UserAsset = foreign
+				if foreign.R == nil {
+					foreign.R = &userAssetR{}
+				}
+				foreign.R.MarketplaceListings = append(foreign.R.MarketplaceListings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListingMarketplaceBids allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (marketplaceListingL) LoadListingMarketplaceBids(e boil.Executor, singular bool, maybeMarketplaceListing interface{}, mods queries.Applicator) error {
+	var slice []*MarketplaceListing
+	var object *MarketplaceListing
+
+	if singular {
+		object = maybeMarketplaceListing.(*MarketplaceListing)
+	} else {
+		slice = *maybeMarketplaceListing.(*[]*MarketplaceListing)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &marketplaceListingR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &marketplaceListingR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`marketplace_bids`),
+		qm.WhereIn(`marketplace_bids.listing_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load marketplace_bids")
+	}
+
+	var resultSlice []*MarketplaceBid
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice marketplace_bids")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on marketplace_bids")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for marketplace_bids")
+	}
+
+	if len(marketplaceBidAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ListingMarketplaceBids = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &marketplaceBidR{}
+			}
+			foreign.R.Listing = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ListingID {
+				local.R.ListingMarketplaceBids = append(local.R.ListingMarketplaceBids, foreign)
+				if foreign.R == nil {
+					foreign.R = &marketplaceBidR{}
+				}
+				foreign.R.Listing = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBuyer of the marketplaceListing to the related item.
+// Sets o.R.Buyer to related.
+// Adds o to related.R.BuyerMarketplaceListings.
+func (o *MarketplaceListing) SetBuyer(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"marketplace_listings\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"buyer_id"}),
+		strmangle.WhereClause("\"", "\"", 2, marketplaceListingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.BuyerID, related.ID)
+	if o.R == nil {
+		o.R = &marketplaceListingR{
+			Buyer: related,
+		}
+	} else {
+		o.R.Buyer = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			BuyerMarketplaceListings: MarketplaceListingSlice{o},
+		}
+	} else {
+		related.R.BuyerMarketplaceListings = append(related.R.BuyerMarketplaceListings, o)
+	}
+
+	return nil
+}
+
+// RemoveBuyer relationship.
+// Sets o.R.Buyer to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *MarketplaceListing) RemoveBuyer(exec boil.Executor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.BuyerID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("buyer_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Buyer = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.BuyerMarketplaceListings {
+		if queries.Equal(o.BuyerID, ri.BuyerID) {
+			continue
+		}
+
+		ln := len(related.R.BuyerMarketplaceListings)
+		if ln > 1 && i < ln-1 {
+			related.R.BuyerMarketplaceListings[i] = related.R.BuyerMarketplaceListings[ln-1]
+		}
+		related.R.BuyerMarketplaceListings = related.R.BuyerMarketplaceListings[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetCurrentBidder of the marketplaceListing to the related item.
+// Sets o.R.CurrentBidder to related.
+// Adds o to related.R.CurrentBidderMarketplaceListings.
+func (o *MarketplaceListing) SetCurrentBidder(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"marketplace_listings\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"current_bidder_id"}),
+		strmangle.WhereClause("\"", "\"", 2, marketplaceListingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CurrentBidderID, related.ID)
+	if o.R == nil {
+		o.R = &marketplaceListingR{
+			CurrentBidder: related,
+		}
+	} else {
+		o.R.CurrentBidder = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CurrentBidderMarketplaceListings: MarketplaceListingSlice{o},
+		}
+	} else {
+		related.R.CurrentBidderMarketplaceListings = append(related.R.CurrentBidderMarketplaceListings, o)
+	}
+
+	return nil
+}
+
+// RemoveCurrentBidder relationship.
+// Sets o.R.CurrentBidder to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *MarketplaceListing) RemoveCurrentBidder(exec boil.Executor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.CurrentBidderID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("current_bidder_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.CurrentBidder = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CurrentBidderMarketplaceListings {
+		if queries.Equal(o.CurrentBidderID, ri.CurrentBidderID) {
+			continue
+		}
+
+		ln := len(related.R.CurrentBidderMarketplaceListings)
+		if ln > 1 && i < ln-1 {
+			related.R.CurrentBidderMarketplaceListings[i] = related.R.CurrentBidderMarketplaceListings[ln-1]
+		}
+		related.R.CurrentBidderMarketplaceListings = related.R.CurrentBidderMarketplaceListings[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetSeller of the marketplaceListing to the related item.
+// Sets o.R.Seller to related.
+// Adds o to related.R.SellerMarketplaceListings.
+func (o *MarketplaceListing) SetSeller(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"marketplace_listings\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"seller_id"}),
+		strmangle.WhereClause("\"", "\"", 2, marketplaceListingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SellerID = related.ID
+	if o.R == nil {
+		o.R = &marketplaceListingR{
+			Seller: related,
+		}
+	} else {
+		o.R.Seller = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			SellerMarketplaceListings: MarketplaceListingSlice{o},
+		}
+	} else {
+		related.R.SellerMarketplaceListings = append(related.R.SellerMarketplaceListings, o)
+	}
+
+	return nil
+}
+
+// SetUserAsset1155 of the marketplaceListing to the related item.
+// Sets o.R.UserAsset1155 to related.
+// Adds o to related.R.UserAsset1155MarketplaceListings.
+func (o *MarketplaceListing) SetUserAsset1155(exec boil.Executor, insert bool, related *UserAssets1155) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"marketplace_listings\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_1155_id"}),
+		strmangle.WhereClause("\"", "\"", 2, marketplaceListingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserAsset1155ID, related.ID)
+	if o.R == nil {
+		o.R = &marketplaceListingR{
+			UserAsset1155: related,
+		}
+	} else {
+		o.R.UserAsset1155 = related
+	}
+
+	if related.R == nil {
+		related.R = &userAssets1155R{
+			UserAsset1155MarketplaceListings: MarketplaceListingSlice{o},
+		}
+	} else {
+		related.R.UserAsset1155MarketplaceListings = append(related.R.UserAsset1155MarketplaceListings, o)
+	}
+
+	return nil
+}
+
+// RemoveUserAsset1155 relationship.
+// Sets o.R.UserAsset1155 to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *MarketplaceListing) RemoveUserAsset1155(exec boil.Executor, related *UserAssets1155) error {
+	var err error
+
+	queries.SetScanner(&o.UserAsset1155ID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("user_asset_1155_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.UserAsset1155 = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.UserAsset1155MarketplaceListings {
+		if queries.Equal(o.UserAsset1155ID, ri.UserAsset1155ID) {
+			continue
+		}
+
+		ln := len(related.R.UserAsset1155MarketplaceListings)
+		if ln > 1 && i < ln-1 {
+			related.R.UserAsset1155MarketplaceListings[i] = related.R.UserAsset1155MarketplaceListings[ln-1]
+		}
+		related.R.UserAsset1155MarketplaceListings = related.R.UserAsset1155MarketplaceListings[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetUserAsset of the marketplaceListing to the related item.
+// Sets o.R.UserAsset to related.
+// Adds o to related.R.MarketplaceListings.
+func (o *MarketplaceListing) SetUserAsset(exec boil.Executor, insert bool, related *UserAsset) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"marketplace_listings\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+		strmangle.WhereClause("\"", "\"", 2, marketplaceListingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserAssetID, related.ID)
+	if o.R == nil {
+		o.R = &marketplaceListingR{
+			UserAsset: related,
+		}
+	} else {
+		o.R.UserAsset = related
+	}
+
+	if related.R == nil {
+		related.R = &userAssetR{
+			MarketplaceListings: MarketplaceListingSlice{o},
+		}
+	} else {
+		related.R.MarketplaceListings = append(related.R.MarketplaceListings, o)
+	}
+
+	return nil
+}
+
+// RemoveUserAsset relationship.
+// Sets o.R.UserAsset to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *MarketplaceListing) RemoveUserAsset(exec boil.Executor, related *UserAsset) error {
+	var err error
+
+	queries.SetScanner(&o.UserAssetID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("user_asset_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.UserAsset = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.MarketplaceListings {
+		if queries.Equal(o.UserAssetID, ri.UserAssetID) {
+			continue
+		}
+
+		ln := len(related.R.MarketplaceListings)
+		if ln > 1 && i < ln-1 {
+			related.R.MarketplaceListings[i] = related.R.MarketplaceListings[ln-1]
+		}
+		related.R.MarketplaceListings = related.R.MarketplaceListings[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddListingMarketplaceBids adds the given related objects to the existing relationships
+// of the marketplace_listing, optionally inserting them as new records.
+// Appends related to o.R.ListingMarketplaceBids.
+// Sets related.R.Listing appropriately.
+func (o *MarketplaceListing) AddListingMarketplaceBids(exec boil.Executor, insert bool, related ...*MarketplaceBid) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ListingID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"marketplace_bids\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"listing_id"}),
+				strmangle.WhereClause("\"", "\"", 2, marketplaceBidPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ListingID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &marketplaceListingR{
+			ListingMarketplaceBids: related,
+		}
+	} else {
+		o.R.ListingMarketplaceBids = append(o.R.ListingMarketplaceBids, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &marketplaceBidR{
+				Listing: o,
+			}
+		} else {
+			rel.R.Listing = o
+		}
+	}
+	return nil
+}
+
+// MarketplaceListings retrieves all the records using an executor.
+func MarketplaceListings(mods ...qm.QueryMod) marketplaceListingQuery {
+	mods = append(mods, qm.From("\"marketplace_listings\""))
+	return marketplaceListingQuery{NewQuery(mods...)}
+}
+
+// FindMarketplaceListing retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMarketplaceListing(exec boil.Executor, iD string, selectCols ...string) (*MarketplaceListing, error) {
+	marketplaceListingObj := &MarketplaceListing{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"marketplace_listings\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, marketplaceListingObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from marketplace_listings")
+	}
+
+	if err = marketplaceListingObj.doAfterSelectHooks(exec); err != nil {
+		return marketplaceListingObj, err
+	}
+
+	return marketplaceListingObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MarketplaceListing) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no marketplace_listings provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(marketplaceListingColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	marketplaceListingInsertCacheMut.RLock()
+	cache, cached := marketplaceListingInsertCache[key]
+	marketplaceListingInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			marketplaceListingAllColumns,
+			marketplaceListingColumnsWithDefault,
+			marketplaceListingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(marketplaceListingType, marketplaceListingMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(marketplaceListingType, marketplaceListingMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"marketplace_listings\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"marketplace_listings\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into marketplace_listings")
+	}
+
+	if !cached {
+		marketplaceListingInsertCacheMut.Lock()
+		marketplaceListingInsertCache[key] = cache
+		marketplaceListingInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the MarketplaceListing.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MarketplaceListing) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	marketplaceListingUpdateCacheMut.RLock()
+	cache, cached := marketplaceListingUpdateCache[key]
+	marketplaceListingUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			marketplaceListingAllColumns,
+			marketplaceListingPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update marketplace_listings, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"marketplace_listings\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, marketplaceListingPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(marketplaceListingType, marketplaceListingMapping, append(wl, marketplaceListingPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update marketplace_listings row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for marketplace_listings")
+	}
+
+	if !cached {
+		marketplaceListingUpdateCacheMut.Lock()
+		marketplaceListingUpdateCache[key] = cache
+		marketplaceListingUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q marketplaceListingQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for marketplace_listings")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for marketplace_listings")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MarketplaceListingSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), marketplaceListingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"marketplace_listings\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, marketplaceListingPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in marketplaceListing slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all marketplaceListing")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MarketplaceListing) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no marketplace_listings provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(marketplaceListingColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	marketplaceListingUpsertCacheMut.RLock()
+	cache, cached := marketplaceListingUpsertCache[key]
+	marketplaceListingUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			marketplaceListingAllColumns,
+			marketplaceListingColumnsWithDefault,
+			marketplaceListingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			marketplaceListingAllColumns,
+			marketplaceListingPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert marketplace_listings, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(marketplaceListingPrimaryKeyColumns))
+			copy(conflict, marketplaceListingPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"marketplace_listings\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(marketplaceListingType, marketplaceListingMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(marketplaceListingType, marketplaceListingMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert marketplace_listings")
+	}
+
+	if !cached {
+		marketplaceListingUpsertCacheMut.Lock()
+		marketplaceListingUpsertCache[key] = cache
+		marketplaceListingUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single MarketplaceListing record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MarketplaceListing) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no MarketplaceListing provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), marketplaceListingPrimaryKeyMapping)
+	sql := "DELETE FROM \"marketplace_listings\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from marketplace_listings")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for marketplace_listings")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q marketplaceListingQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no marketplaceListingQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from marketplace_listings")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for marketplace_listings")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MarketplaceListingSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(marketplaceListingBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), marketplaceListingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"marketplace_listings\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, marketplaceListingPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from marketplaceListing slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for marketplace_listings")
+	}
+
+	if len(marketplaceListingAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MarketplaceListing) Reload(exec boil.Executor) error {
+	ret, err := FindMarketplaceListing(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MarketplaceListingSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MarketplaceListingSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), marketplaceListingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"marketplace_listings\".* FROM \"marketplace_listings\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, marketplaceListingPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in MarketplaceListingSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MarketplaceListingExists checks if the MarketplaceListing row exists.
+func MarketplaceListingExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"marketplace_listings\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if marketplace_listings exists")
+	}
+
+	return exists, nil
+}
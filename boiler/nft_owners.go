@@ -0,0 +1,1072 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// NFTOwner is an object representing the database table.
+type NFTOwner struct {
+	CollectionID  string    `boiler:"collection_id" boil:"collection_id" json:"collection_id" toml:"collection_id" yaml:"collection_id"`
+	TokenID       int64     `boiler:"token_id" boil:"token_id" json:"token_id" toml:"token_id" yaml:"token_id"`
+	OwnerAddress  string    `boiler:"owner_address" boil:"owner_address" json:"owner_address" toml:"owner_address" yaml:"owner_address"`
+	HolderAddress string    `boiler:"holder_address" boil:"holder_address" json:"holder_address" toml:"holder_address" yaml:"holder_address"`
+	OnChainStatus string    `boiler:"on_chain_status" boil:"on_chain_status" json:"on_chain_status" toml:"on_chain_status" yaml:"on_chain_status"`
+	TXHash        string    `boiler:"tx_hash" boil:"tx_hash" json:"tx_hash" toml:"tx_hash" yaml:"tx_hash"`
+	BlockNumber   int64     `boiler:"block_number" boil:"block_number" json:"block_number" toml:"block_number" yaml:"block_number"`
+	LogIndex      int       `boiler:"log_index" boil:"log_index" json:"log_index" toml:"log_index" yaml:"log_index"`
+	UpdatedAt     time.Time `boiler:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *nftOwnerR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L nftOwnerL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var NFTOwnerColumns = struct {
+	CollectionID  string
+	TokenID       string
+	OwnerAddress  string
+	HolderAddress string
+	OnChainStatus string
+	TXHash        string
+	BlockNumber   string
+	LogIndex      string
+	UpdatedAt     string
+}{
+	CollectionID:  "collection_id",
+	TokenID:       "token_id",
+	OwnerAddress:  "owner_address",
+	HolderAddress: "holder_address",
+	OnChainStatus: "on_chain_status",
+	TXHash:        "tx_hash",
+	BlockNumber:   "block_number",
+	LogIndex:      "log_index",
+	UpdatedAt:     "updated_at",
+}
+
+var NFTOwnerTableColumns = struct {
+	CollectionID  string
+	TokenID       string
+	OwnerAddress  string
+	HolderAddress string
+	OnChainStatus string
+	TXHash        string
+	BlockNumber   string
+	LogIndex      string
+	UpdatedAt     string
+}{
+	CollectionID:  "nft_owners.collection_id",
+	TokenID:       "nft_owners.token_id",
+	OwnerAddress:  "nft_owners.owner_address",
+	HolderAddress: "nft_owners.holder_address",
+	OnChainStatus: "nft_owners.on_chain_status",
+	TXHash:        "nft_owners.tx_hash",
+	BlockNumber:   "nft_owners.block_number",
+	LogIndex:      "nft_owners.log_index",
+	UpdatedAt:     "nft_owners.updated_at",
+}
+
+// Generated where
+
+var NFTOwnerWhere = struct {
+	CollectionID  whereHelperstring
+	TokenID       whereHelperint64
+	OwnerAddress  whereHelperstring
+	HolderAddress whereHelperstring
+	OnChainStatus whereHelperstring
+	TXHash        whereHelperstring
+	BlockNumber   whereHelperint64
+	LogIndex      whereHelperint
+	UpdatedAt     whereHelpertime_Time
+}{
+	CollectionID:  whereHelperstring{field: "\"nft_owners\".\"collection_id\""},
+	TokenID:       whereHelperint64{field: "\"nft_owners\".\"token_id\""},
+	OwnerAddress:  whereHelperstring{field: "\"nft_owners\".\"owner_address\""},
+	HolderAddress: whereHelperstring{field: "\"nft_owners\".\"holder_address\""},
+	OnChainStatus: whereHelperstring{field: "\"nft_owners\".\"on_chain_status\""},
+	TXHash:        whereHelperstring{field: "\"nft_owners\".\"tx_hash\""},
+	BlockNumber:   whereHelperint64{field: "\"nft_owners\".\"block_number\""},
+	LogIndex:      whereHelperint{field: "\"nft_owners\".\"log_index\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"nft_owners\".\"updated_at\""},
+}
+
+// NFTOwnerRels is where relationship names are stored.
+var NFTOwnerRels = struct {
+	Collection string
+}{
+	Collection: "Collection",
+}
+
+// nftOwnerR is where relationships are stored.
+type nftOwnerR struct {
+	Collection *Collection `boiler:"Collection" boil:"Collection" json:"Collection" toml:"Collection" yaml:"Collection"`
+}
+
+// NewStruct creates a new relationship struct
+func (*nftOwnerR) NewStruct() *nftOwnerR {
+	return &nftOwnerR{}
+}
+
+// nftOwnerL is where Load methods for each relationship are stored.
+type nftOwnerL struct{}
+
+var (
+	nftOwnerAllColumns            = []string{"collection_id", "token_id", "owner_address", "holder_address", "on_chain_status", "tx_hash", "block_number", "log_index", "updated_at"}
+	nftOwnerColumnsWithoutDefault = []string{"collection_id", "token_id", "owner_address", "holder_address", "on_chain_status", "tx_hash", "block_number", "log_index"}
+	nftOwnerColumnsWithDefault    = []string{"updated_at"}
+	nftOwnerPrimaryKeyColumns     = []string{"collection_id", "token_id"}
+	nftOwnerGeneratedColumns      = []string{}
+)
+
+type (
+	// NFTOwnerSlice is an alias for a slice of pointers to NFTOwner.
+	// This should almost always be used instead of []NFTOwner.
+	NFTOwnerSlice []*NFTOwner
+	// NFTOwnerHook is the signature for custom NFTOwner hook methods
+	NFTOwnerHook func(boil.Executor, *NFTOwner) error
+
+	nftOwnerQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	nftOwnerType                 = reflect.TypeOf(&NFTOwner{})
+	nftOwnerMapping              = queries.MakeStructMapping(nftOwnerType)
+	nftOwnerPrimaryKeyMapping, _ = queries.BindMapping(nftOwnerType, nftOwnerMapping, nftOwnerPrimaryKeyColumns)
+	nftOwnerInsertCacheMut       sync.RWMutex
+	nftOwnerInsertCache          = make(map[string]insertCache)
+	nftOwnerUpdateCacheMut       sync.RWMutex
+	nftOwnerUpdateCache          = make(map[string]updateCache)
+	nftOwnerUpsertCacheMut       sync.RWMutex
+	nftOwnerUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var nftOwnerAfterSelectHooks []NFTOwnerHook
+
+var nftOwnerBeforeInsertHooks []NFTOwnerHook
+var nftOwnerAfterInsertHooks []NFTOwnerHook
+
+var nftOwnerBeforeUpdateHooks []NFTOwnerHook
+var nftOwnerAfterUpdateHooks []NFTOwnerHook
+
+var nftOwnerBeforeDeleteHooks []NFTOwnerHook
+var nftOwnerAfterDeleteHooks []NFTOwnerHook
+
+var nftOwnerBeforeUpsertHooks []NFTOwnerHook
+var nftOwnerAfterUpsertHooks []NFTOwnerHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *NFTOwner) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnerAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *NFTOwner) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnerBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *NFTOwner) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnerAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *NFTOwner) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnerBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *NFTOwner) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnerAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *NFTOwner) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnerBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *NFTOwner) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnerAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *NFTOwner) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnerBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *NFTOwner) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnerAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddNFTOwnerHook registers your hook function for all future operations.
+func AddNFTOwnerHook(hookPoint boil.HookPoint, nftOwnerHook NFTOwnerHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		nftOwnerAfterSelectHooks = append(nftOwnerAfterSelectHooks, nftOwnerHook)
+	case boil.BeforeInsertHook:
+		nftOwnerBeforeInsertHooks = append(nftOwnerBeforeInsertHooks, nftOwnerHook)
+	case boil.AfterInsertHook:
+		nftOwnerAfterInsertHooks = append(nftOwnerAfterInsertHooks, nftOwnerHook)
+	case boil.BeforeUpdateHook:
+		nftOwnerBeforeUpdateHooks = append(nftOwnerBeforeUpdateHooks, nftOwnerHook)
+	case boil.AfterUpdateHook:
+		nftOwnerAfterUpdateHooks = append(nftOwnerAfterUpdateHooks, nftOwnerHook)
+	case boil.BeforeDeleteHook:
+		nftOwnerBeforeDeleteHooks = append(nftOwnerBeforeDeleteHooks, nftOwnerHook)
+	case boil.AfterDeleteHook:
+		nftOwnerAfterDeleteHooks = append(nftOwnerAfterDeleteHooks, nftOwnerHook)
+	case boil.BeforeUpsertHook:
+		nftOwnerBeforeUpsertHooks = append(nftOwnerBeforeUpsertHooks, nftOwnerHook)
+	case boil.AfterUpsertHook:
+		nftOwnerAfterUpsertHooks = append(nftOwnerAfterUpsertHooks, nftOwnerHook)
+	}
+}
+
+// One returns a single nftOwner record from the query.
+func (q nftOwnerQuery) One(exec boil.Executor) (*NFTOwner, error) {
+	o := &NFTOwner{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for nft_owners")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all NFTOwner records from the query.
+func (q nftOwnerQuery) All(exec boil.Executor) (NFTOwnerSlice, error) {
+	var o []*NFTOwner
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to NFTOwner slice")
+	}
+
+	if len(nftOwnerAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all NFTOwner records in the query.
+func (q nftOwnerQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count nft_owners rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q nftOwnerQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if nft_owners exists")
+	}
+
+	return count > 0, nil
+}
+
+// Collection pointed to by the foreign key.
+func (o *NFTOwner) Collection(mods ...qm.QueryMod) collectionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CollectionID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Collections(queryMods...)
+	queries.SetFrom(query.Query, "\"collections\"")
+
+	return query
+}
+
+// LoadCollection allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (nftOwnerL) LoadCollection(e boil.Executor, singular bool, maybeNFTOwner interface{}, mods queries.Applicator) error {
+	var slice []*NFTOwner
+	var object *NFTOwner
+
+	if singular {
+		object = maybeNFTOwner.(*NFTOwner)
+	} else {
+		slice = *maybeNFTOwner.(*[]*NFTOwner)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &nftOwnerR{}
+		}
+		args = append(args, object.CollectionID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &nftOwnerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CollectionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CollectionID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`collections`),
+		qm.WhereIn(`collections.id in ?`, args...),
+		qmhelper.WhereIsNull(`collections.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Collection")
+	}
+
+	var resultSlice []*Collection
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Collection")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for collections")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collections")
+	}
+
+	if len(nftOwnerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Collection = foreign
+		if foreign.R == nil {
+			foreign.R = &collectionR{}
+		}
+		foreign.R.NFTOwners = append(foreign.R.NFTOwners, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CollectionID == foreign.ID {
+				local.R.Collection = foreign
+				if foreign.R == nil {
+					foreign.R = &collectionR{}
+				}
+				foreign.R.NFTOwners = append(foreign.R.NFTOwners, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCollection of the nftOwner to the related item.
+// Sets o.R.Collection to related.
+// Adds o to related.R.NFTOwners.
+func (o *NFTOwner) SetCollection(exec boil.Executor, insert bool, related *Collection) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"nft_owners\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+		strmangle.WhereClause("\"", "\"", 2, nftOwnerPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.CollectionID, o.TokenID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CollectionID = related.ID
+	if o.R == nil {
+		o.R = &nftOwnerR{
+			Collection: related,
+		}
+	} else {
+		o.R.Collection = related
+	}
+
+	if related.R == nil {
+		related.R = &collectionR{
+			NFTOwners: NFTOwnerSlice{o},
+		}
+	} else {
+		related.R.NFTOwners = append(related.R.NFTOwners, o)
+	}
+
+	return nil
+}
+
+// NFTOwners retrieves all the records using an executor.
+func NFTOwners(mods ...qm.QueryMod) nftOwnerQuery {
+	mods = append(mods, qm.From("\"nft_owners\""))
+	return nftOwnerQuery{NewQuery(mods...)}
+}
+
+// FindNFTOwner retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindNFTOwner(exec boil.Executor, collectionID string, tokenID int64, selectCols ...string) (*NFTOwner, error) {
+	nftOwnerObj := &NFTOwner{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"nft_owners\" where \"collection_id\"=$1 AND \"token_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, collectionID, tokenID)
+
+	err := q.Bind(nil, exec, nftOwnerObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from nft_owners")
+	}
+
+	if err = nftOwnerObj.doAfterSelectHooks(exec); err != nil {
+		return nftOwnerObj, err
+	}
+
+	return nftOwnerObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *NFTOwner) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no nft_owners provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(nftOwnerColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	nftOwnerInsertCacheMut.RLock()
+	cache, cached := nftOwnerInsertCache[key]
+	nftOwnerInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			nftOwnerAllColumns,
+			nftOwnerColumnsWithDefault,
+			nftOwnerColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(nftOwnerType, nftOwnerMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(nftOwnerType, nftOwnerMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"nft_owners\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"nft_owners\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into nft_owners")
+	}
+
+	if !cached {
+		nftOwnerInsertCacheMut.Lock()
+		nftOwnerInsertCache[key] = cache
+		nftOwnerInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the NFTOwner.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *NFTOwner) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	nftOwnerUpdateCacheMut.RLock()
+	cache, cached := nftOwnerUpdateCache[key]
+	nftOwnerUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			nftOwnerAllColumns,
+			nftOwnerPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update nft_owners, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"nft_owners\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, nftOwnerPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(nftOwnerType, nftOwnerMapping, append(wl, nftOwnerPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update nft_owners row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for nft_owners")
+	}
+
+	if !cached {
+		nftOwnerUpdateCacheMut.Lock()
+		nftOwnerUpdateCache[key] = cache
+		nftOwnerUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q nftOwnerQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for nft_owners")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for nft_owners")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o NFTOwnerSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), nftOwnerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"nft_owners\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, nftOwnerPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in nftOwner slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all nftOwner")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *NFTOwner) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no nft_owners provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(nftOwnerColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	nftOwnerUpsertCacheMut.RLock()
+	cache, cached := nftOwnerUpsertCache[key]
+	nftOwnerUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			nftOwnerAllColumns,
+			nftOwnerColumnsWithDefault,
+			nftOwnerColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			nftOwnerAllColumns,
+			nftOwnerPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert nft_owners, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(nftOwnerPrimaryKeyColumns))
+			copy(conflict, nftOwnerPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"nft_owners\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(nftOwnerType, nftOwnerMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(nftOwnerType, nftOwnerMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert nft_owners")
+	}
+
+	if !cached {
+		nftOwnerUpsertCacheMut.Lock()
+		nftOwnerUpsertCache[key] = cache
+		nftOwnerUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single NFTOwner record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *NFTOwner) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no NFTOwner provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), nftOwnerPrimaryKeyMapping)
+	sql := "DELETE FROM \"nft_owners\" WHERE \"collection_id\"=$1 AND \"token_id\"=$2"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from nft_owners")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for nft_owners")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q nftOwnerQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no nftOwnerQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from nft_owners")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for nft_owners")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o NFTOwnerSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(nftOwnerBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), nftOwnerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"nft_owners\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, nftOwnerPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from nftOwner slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for nft_owners")
+	}
+
+	if len(nftOwnerAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *NFTOwner) Reload(exec boil.Executor) error {
+	ret, err := FindNFTOwner(exec, o.CollectionID, o.TokenID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *NFTOwnerSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := NFTOwnerSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), nftOwnerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"nft_owners\".* FROM \"nft_owners\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, nftOwnerPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in NFTOwnerSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// NFTOwnerExists checks if the NFTOwner row exists.
+func NFTOwnerExists(exec boil.Executor, collectionID string, tokenID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"nft_owners\" where \"collection_id\"=$1 AND \"token_id\"=$2 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, collectionID, tokenID)
+	}
+	row := exec.QueryRow(sql, collectionID, tokenID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if nft_owners exists")
+	}
+
+	return exists, nil
+}
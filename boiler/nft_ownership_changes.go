@@ -0,0 +1,1106 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// NFTOwnershipChange is an object representing the database table.
+type NFTOwnershipChange struct {
+	ID             int64       `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	CollectionID   string      `boiler:"collection_id" boil:"collection_id" json:"collection_id" toml:"collection_id" yaml:"collection_id"`
+	TokenID        int64       `boiler:"token_id" boil:"token_id" json:"token_id" toml:"token_id" yaml:"token_id"`
+	FromAddress    string      `boiler:"from_address" boil:"from_address" json:"from_address" toml:"from_address" yaml:"from_address"`
+	ToAddress      string      `boiler:"to_address" boil:"to_address" json:"to_address" toml:"to_address" yaml:"to_address"`
+	OwnerAddress   string      `boiler:"owner_address" boil:"owner_address" json:"owner_address" toml:"owner_address" yaml:"owner_address"`
+	OnChainStatus  string      `boiler:"on_chain_status" boil:"on_chain_status" json:"on_chain_status" toml:"on_chain_status" yaml:"on_chain_status"`
+	TXHash         string      `boiler:"tx_hash" boil:"tx_hash" json:"tx_hash" toml:"tx_hash" yaml:"tx_hash"`
+	LogIndex       int         `boiler:"log_index" boil:"log_index" json:"log_index" toml:"log_index" yaml:"log_index"`
+	BlockNumber    int64       `boiler:"block_number" boil:"block_number" json:"block_number" toml:"block_number" yaml:"block_number"`
+	BlockTimestamp time.Time   `boiler:"block_timestamp" boil:"block_timestamp" json:"block_timestamp" toml:"block_timestamp" yaml:"block_timestamp"`
+	AppliedAt      null.Time   `boiler:"applied_at" boil:"applied_at" json:"applied_at,omitempty" toml:"applied_at" yaml:"applied_at,omitempty"`
+	ApplyError     null.String `boiler:"apply_error" boil:"apply_error" json:"apply_error,omitempty" toml:"apply_error" yaml:"apply_error,omitempty"`
+	CreatedAt      time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *nftOwnershipChangeR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L nftOwnershipChangeL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var NFTOwnershipChangeColumns = struct {
+	ID             string
+	CollectionID   string
+	TokenID        string
+	FromAddress    string
+	ToAddress      string
+	OwnerAddress   string
+	OnChainStatus  string
+	TXHash         string
+	LogIndex       string
+	BlockNumber    string
+	BlockTimestamp string
+	AppliedAt      string
+	ApplyError     string
+	CreatedAt      string
+}{
+	ID:             "id",
+	CollectionID:   "collection_id",
+	TokenID:        "token_id",
+	FromAddress:    "from_address",
+	ToAddress:      "to_address",
+	OwnerAddress:   "owner_address",
+	OnChainStatus:  "on_chain_status",
+	TXHash:         "tx_hash",
+	LogIndex:       "log_index",
+	BlockNumber:    "block_number",
+	BlockTimestamp: "block_timestamp",
+	AppliedAt:      "applied_at",
+	ApplyError:     "apply_error",
+	CreatedAt:      "created_at",
+}
+
+var NFTOwnershipChangeTableColumns = struct {
+	ID             string
+	CollectionID   string
+	TokenID        string
+	FromAddress    string
+	ToAddress      string
+	OwnerAddress   string
+	OnChainStatus  string
+	TXHash         string
+	LogIndex       string
+	BlockNumber    string
+	BlockTimestamp string
+	AppliedAt      string
+	ApplyError     string
+	CreatedAt      string
+}{
+	ID:             "nft_ownership_changes.id",
+	CollectionID:   "nft_ownership_changes.collection_id",
+	TokenID:        "nft_ownership_changes.token_id",
+	FromAddress:    "nft_ownership_changes.from_address",
+	ToAddress:      "nft_ownership_changes.to_address",
+	OwnerAddress:   "nft_ownership_changes.owner_address",
+	OnChainStatus:  "nft_ownership_changes.on_chain_status",
+	TXHash:         "nft_ownership_changes.tx_hash",
+	LogIndex:       "nft_ownership_changes.log_index",
+	BlockNumber:    "nft_ownership_changes.block_number",
+	BlockTimestamp: "nft_ownership_changes.block_timestamp",
+	AppliedAt:      "nft_ownership_changes.applied_at",
+	ApplyError:     "nft_ownership_changes.apply_error",
+	CreatedAt:      "nft_ownership_changes.created_at",
+}
+
+// Generated where
+
+var NFTOwnershipChangeWhere = struct {
+	ID             whereHelperint64
+	CollectionID   whereHelperstring
+	TokenID        whereHelperint64
+	FromAddress    whereHelperstring
+	ToAddress      whereHelperstring
+	OwnerAddress   whereHelperstring
+	OnChainStatus  whereHelperstring
+	TXHash         whereHelperstring
+	LogIndex       whereHelperint
+	BlockNumber    whereHelperint64
+	BlockTimestamp whereHelpertime_Time
+	AppliedAt      whereHelpernull_Time
+	ApplyError     whereHelpernull_String
+	CreatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperint64{field: "\"nft_ownership_changes\".\"id\""},
+	CollectionID:   whereHelperstring{field: "\"nft_ownership_changes\".\"collection_id\""},
+	TokenID:        whereHelperint64{field: "\"nft_ownership_changes\".\"token_id\""},
+	FromAddress:    whereHelperstring{field: "\"nft_ownership_changes\".\"from_address\""},
+	ToAddress:      whereHelperstring{field: "\"nft_ownership_changes\".\"to_address\""},
+	OwnerAddress:   whereHelperstring{field: "\"nft_ownership_changes\".\"owner_address\""},
+	OnChainStatus:  whereHelperstring{field: "\"nft_ownership_changes\".\"on_chain_status\""},
+	TXHash:         whereHelperstring{field: "\"nft_ownership_changes\".\"tx_hash\""},
+	LogIndex:       whereHelperint{field: "\"nft_ownership_changes\".\"log_index\""},
+	BlockNumber:    whereHelperint64{field: "\"nft_ownership_changes\".\"block_number\""},
+	BlockTimestamp: whereHelpertime_Time{field: "\"nft_ownership_changes\".\"block_timestamp\""},
+	AppliedAt:      whereHelpernull_Time{field: "\"nft_ownership_changes\".\"applied_at\""},
+	ApplyError:     whereHelpernull_String{field: "\"nft_ownership_changes\".\"apply_error\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"nft_ownership_changes\".\"created_at\""},
+}
+
+// NFTOwnershipChangeRels is where relationship names are stored.
+var NFTOwnershipChangeRels = struct {
+	Collection string
+}{
+	Collection: "Collection",
+}
+
+// nftOwnershipChangeR is where relationships are stored.
+type nftOwnershipChangeR struct {
+	Collection *Collection `boiler:"Collection" boil:"Collection" json:"Collection" toml:"Collection" yaml:"Collection"`
+}
+
+// NewStruct creates a new relationship struct
+func (*nftOwnershipChangeR) NewStruct() *nftOwnershipChangeR {
+	return &nftOwnershipChangeR{}
+}
+
+// nftOwnershipChangeL is where Load methods for each relationship are stored.
+type nftOwnershipChangeL struct{}
+
+var (
+	nftOwnershipChangeAllColumns            = []string{"id", "collection_id", "token_id", "from_address", "to_address", "owner_address", "on_chain_status", "tx_hash", "log_index", "block_number", "block_timestamp", "applied_at", "apply_error", "created_at"}
+	nftOwnershipChangeColumnsWithoutDefault = []string{"collection_id", "token_id", "from_address", "to_address", "owner_address", "on_chain_status", "tx_hash", "log_index", "block_number", "block_timestamp"}
+	nftOwnershipChangeColumnsWithDefault    = []string{"id", "applied_at", "apply_error", "created_at"}
+	nftOwnershipChangePrimaryKeyColumns     = []string{"id"}
+	nftOwnershipChangeGeneratedColumns      = []string{}
+)
+
+type (
+	// NFTOwnershipChangeSlice is an alias for a slice of pointers to NFTOwnershipChange.
+	// This should almost always be used instead of []NFTOwnershipChange.
+	NFTOwnershipChangeSlice []*NFTOwnershipChange
+	// NFTOwnershipChangeHook is the signature for custom NFTOwnershipChange hook methods
+	NFTOwnershipChangeHook func(boil.Executor, *NFTOwnershipChange) error
+
+	nftOwnershipChangeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	nftOwnershipChangeType                 = reflect.TypeOf(&NFTOwnershipChange{})
+	nftOwnershipChangeMapping              = queries.MakeStructMapping(nftOwnershipChangeType)
+	nftOwnershipChangePrimaryKeyMapping, _ = queries.BindMapping(nftOwnershipChangeType, nftOwnershipChangeMapping, nftOwnershipChangePrimaryKeyColumns)
+	nftOwnershipChangeInsertCacheMut       sync.RWMutex
+	nftOwnershipChangeInsertCache          = make(map[string]insertCache)
+	nftOwnershipChangeUpdateCacheMut       sync.RWMutex
+	nftOwnershipChangeUpdateCache          = make(map[string]updateCache)
+	nftOwnershipChangeUpsertCacheMut       sync.RWMutex
+	nftOwnershipChangeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var nftOwnershipChangeAfterSelectHooks []NFTOwnershipChangeHook
+
+var nftOwnershipChangeBeforeInsertHooks []NFTOwnershipChangeHook
+var nftOwnershipChangeAfterInsertHooks []NFTOwnershipChangeHook
+
+var nftOwnershipChangeBeforeUpdateHooks []NFTOwnershipChangeHook
+var nftOwnershipChangeAfterUpdateHooks []NFTOwnershipChangeHook
+
+var nftOwnershipChangeBeforeDeleteHooks []NFTOwnershipChangeHook
+var nftOwnershipChangeAfterDeleteHooks []NFTOwnershipChangeHook
+
+var nftOwnershipChangeBeforeUpsertHooks []NFTOwnershipChangeHook
+var nftOwnershipChangeAfterUpsertHooks []NFTOwnershipChangeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *NFTOwnershipChange) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnershipChangeAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *NFTOwnershipChange) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnershipChangeBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *NFTOwnershipChange) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnershipChangeAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *NFTOwnershipChange) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnershipChangeBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *NFTOwnershipChange) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnershipChangeAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *NFTOwnershipChange) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnershipChangeBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *NFTOwnershipChange) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnershipChangeAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *NFTOwnershipChange) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnershipChangeBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *NFTOwnershipChange) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range nftOwnershipChangeAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddNFTOwnershipChangeHook registers your hook function for all future operations.
+func AddNFTOwnershipChangeHook(hookPoint boil.HookPoint, nftOwnershipChangeHook NFTOwnershipChangeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		nftOwnershipChangeAfterSelectHooks = append(nftOwnershipChangeAfterSelectHooks, nftOwnershipChangeHook)
+	case boil.BeforeInsertHook:
+		nftOwnershipChangeBeforeInsertHooks = append(nftOwnershipChangeBeforeInsertHooks, nftOwnershipChangeHook)
+	case boil.AfterInsertHook:
+		nftOwnershipChangeAfterInsertHooks = append(nftOwnershipChangeAfterInsertHooks, nftOwnershipChangeHook)
+	case boil.BeforeUpdateHook:
+		nftOwnershipChangeBeforeUpdateHooks = append(nftOwnershipChangeBeforeUpdateHooks, nftOwnershipChangeHook)
+	case boil.AfterUpdateHook:
+		nftOwnershipChangeAfterUpdateHooks = append(nftOwnershipChangeAfterUpdateHooks, nftOwnershipChangeHook)
+	case boil.BeforeDeleteHook:
+		nftOwnershipChangeBeforeDeleteHooks = append(nftOwnershipChangeBeforeDeleteHooks, nftOwnershipChangeHook)
+	case boil.AfterDeleteHook:
+		nftOwnershipChangeAfterDeleteHooks = append(nftOwnershipChangeAfterDeleteHooks, nftOwnershipChangeHook)
+	case boil.BeforeUpsertHook:
+		nftOwnershipChangeBeforeUpsertHooks = append(nftOwnershipChangeBeforeUpsertHooks, nftOwnershipChangeHook)
+	case boil.AfterUpsertHook:
+		nftOwnershipChangeAfterUpsertHooks = append(nftOwnershipChangeAfterUpsertHooks, nftOwnershipChangeHook)
+	}
+}
+
+// One returns a single nftOwnershipChange record from the query.
+func (q nftOwnershipChangeQuery) One(exec boil.Executor) (*NFTOwnershipChange, error) {
+	o := &NFTOwnershipChange{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for nft_ownership_changes")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all NFTOwnershipChange records from the query.
+func (q nftOwnershipChangeQuery) All(exec boil.Executor) (NFTOwnershipChangeSlice, error) {
+	var o []*NFTOwnershipChange
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to NFTOwnershipChange slice")
+	}
+
+	if len(nftOwnershipChangeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all NFTOwnershipChange records in the query.
+func (q nftOwnershipChangeQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count nft_ownership_changes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q nftOwnershipChangeQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if nft_ownership_changes exists")
+	}
+
+	return count > 0, nil
+}
+
+// Collection pointed to by the foreign key.
+func (o *NFTOwnershipChange) Collection(mods ...qm.QueryMod) collectionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CollectionID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Collections(queryMods...)
+	queries.SetFrom(query.Query, "\"collections\"")
+
+	return query
+}
+
+// LoadCollection allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (nftOwnershipChangeL) LoadCollection(e boil.Executor, singular bool, maybeNFTOwnershipChange interface{}, mods queries.Applicator) error {
+	var slice []*NFTOwnershipChange
+	var object *NFTOwnershipChange
+
+	if singular {
+		object = maybeNFTOwnershipChange.(*NFTOwnershipChange)
+	} else {
+		slice = *maybeNFTOwnershipChange.(*[]*NFTOwnershipChange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &nftOwnershipChangeR{}
+		}
+		args = append(args, object.CollectionID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &nftOwnershipChangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CollectionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CollectionID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`collections`),
+		qm.WhereIn(`collections.id in ?`, args...),
+		qmhelper.WhereIsNull(`collections.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Collection")
+	}
+
+	var resultSlice []*Collection
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Collection")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for collections")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collections")
+	}
+
+	if len(nftOwnershipChangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Collection = foreign
+		if foreign.R == nil {
+			foreign.R = &collectionR{}
+		}
+		foreign.R.NFTOwnershipChanges = append(foreign.R.NFTOwnershipChanges, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CollectionID == foreign.ID {
+				local.R.Collection = foreign
+				if foreign.R == nil {
+					foreign.R = &collectionR{}
+				}
+				foreign.R.NFTOwnershipChanges = append(foreign.R.NFTOwnershipChanges, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCollection of the nftOwnershipChange to the related item.
+// Sets o.R.Collection to related.
+// Adds o to related.R.NFTOwnershipChanges.
+func (o *NFTOwnershipChange) SetCollection(exec boil.Executor, insert bool, related *Collection) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"nft_ownership_changes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+		strmangle.WhereClause("\"", "\"", 2, nftOwnershipChangePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CollectionID = related.ID
+	if o.R == nil {
+		o.R = &nftOwnershipChangeR{
+			Collection: related,
+		}
+	} else {
+		o.R.Collection = related
+	}
+
+	if related.R == nil {
+		related.R = &collectionR{
+			NFTOwnershipChanges: NFTOwnershipChangeSlice{o},
+		}
+	} else {
+		related.R.NFTOwnershipChanges = append(related.R.NFTOwnershipChanges, o)
+	}
+
+	return nil
+}
+
+// NFTOwnershipChanges retrieves all the records using an executor.
+func NFTOwnershipChanges(mods ...qm.QueryMod) nftOwnershipChangeQuery {
+	mods = append(mods, qm.From("\"nft_ownership_changes\""))
+	return nftOwnershipChangeQuery{NewQuery(mods...)}
+}
+
+// FindNFTOwnershipChange retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindNFTOwnershipChange(exec boil.Executor, iD int64, selectCols ...string) (*NFTOwnershipChange, error) {
+	nftOwnershipChangeObj := &NFTOwnershipChange{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"nft_ownership_changes\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, nftOwnershipChangeObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from nft_ownership_changes")
+	}
+
+	if err = nftOwnershipChangeObj.doAfterSelectHooks(exec); err != nil {
+		return nftOwnershipChangeObj, err
+	}
+
+	return nftOwnershipChangeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *NFTOwnershipChange) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no nft_ownership_changes provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(nftOwnershipChangeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	nftOwnershipChangeInsertCacheMut.RLock()
+	cache, cached := nftOwnershipChangeInsertCache[key]
+	nftOwnershipChangeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			nftOwnershipChangeAllColumns,
+			nftOwnershipChangeColumnsWithDefault,
+			nftOwnershipChangeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(nftOwnershipChangeType, nftOwnershipChangeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(nftOwnershipChangeType, nftOwnershipChangeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"nft_ownership_changes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"nft_ownership_changes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into nft_ownership_changes")
+	}
+
+	if !cached {
+		nftOwnershipChangeInsertCacheMut.Lock()
+		nftOwnershipChangeInsertCache[key] = cache
+		nftOwnershipChangeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the NFTOwnershipChange.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *NFTOwnershipChange) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	nftOwnershipChangeUpdateCacheMut.RLock()
+	cache, cached := nftOwnershipChangeUpdateCache[key]
+	nftOwnershipChangeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			nftOwnershipChangeAllColumns,
+			nftOwnershipChangePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update nft_ownership_changes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"nft_ownership_changes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, nftOwnershipChangePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(nftOwnershipChangeType, nftOwnershipChangeMapping, append(wl, nftOwnershipChangePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update nft_ownership_changes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for nft_ownership_changes")
+	}
+
+	if !cached {
+		nftOwnershipChangeUpdateCacheMut.Lock()
+		nftOwnershipChangeUpdateCache[key] = cache
+		nftOwnershipChangeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q nftOwnershipChangeQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for nft_ownership_changes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for nft_ownership_changes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o NFTOwnershipChangeSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), nftOwnershipChangePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"nft_ownership_changes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, nftOwnershipChangePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in nftOwnershipChange slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all nftOwnershipChange")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *NFTOwnershipChange) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no nft_ownership_changes provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(nftOwnershipChangeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	nftOwnershipChangeUpsertCacheMut.RLock()
+	cache, cached := nftOwnershipChangeUpsertCache[key]
+	nftOwnershipChangeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			nftOwnershipChangeAllColumns,
+			nftOwnershipChangeColumnsWithDefault,
+			nftOwnershipChangeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			nftOwnershipChangeAllColumns,
+			nftOwnershipChangePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert nft_ownership_changes, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(nftOwnershipChangePrimaryKeyColumns))
+			copy(conflict, nftOwnershipChangePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"nft_ownership_changes\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(nftOwnershipChangeType, nftOwnershipChangeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(nftOwnershipChangeType, nftOwnershipChangeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert nft_ownership_changes")
+	}
+
+	if !cached {
+		nftOwnershipChangeUpsertCacheMut.Lock()
+		nftOwnershipChangeUpsertCache[key] = cache
+		nftOwnershipChangeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single NFTOwnershipChange record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *NFTOwnershipChange) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no NFTOwnershipChange provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), nftOwnershipChangePrimaryKeyMapping)
+	sql := "DELETE FROM \"nft_ownership_changes\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from nft_ownership_changes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for nft_ownership_changes")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q nftOwnershipChangeQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no nftOwnershipChangeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from nft_ownership_changes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for nft_ownership_changes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o NFTOwnershipChangeSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(nftOwnershipChangeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), nftOwnershipChangePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"nft_ownership_changes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, nftOwnershipChangePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from nftOwnershipChange slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for nft_ownership_changes")
+	}
+
+	if len(nftOwnershipChangeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *NFTOwnershipChange) Reload(exec boil.Executor) error {
+	ret, err := FindNFTOwnershipChange(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *NFTOwnershipChangeSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := NFTOwnershipChangeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), nftOwnershipChangePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"nft_ownership_changes\".* FROM \"nft_ownership_changes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, nftOwnershipChangePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in NFTOwnershipChangeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// NFTOwnershipChangeExists checks if the NFTOwnershipChange row exists.
+func NFTOwnershipChangeExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"nft_ownership_changes\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if nft_ownership_changes exists")
+	}
+
+	return exists, nil
+}
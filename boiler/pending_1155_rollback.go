@@ -114,17 +114,20 @@ var Pending1155RollbackWhere = struct {
 
 // Pending1155RollbackRels is where relationship names are stored.
 var Pending1155RollbackRels = struct {
-	Asset string
-	User  string
+	Asset                  string
+	User                   string
+	PendingWithdrawActions string
 }{
-	Asset: "Asset",
-	User:  "User",
+	Asset:                  "Asset",
+	User:                   "User",
+	PendingWithdrawActions: "PendingWithdrawActions",
 }
 
 // pending1155RollbackR is where relationships are stored.
 type pending1155RollbackR struct {
-	Asset *UserAssets1155 `boiler:"Asset" boil:"Asset" json:"Asset" toml:"Asset" yaml:"Asset"`
-	User  *User           `boiler:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+	Asset                  *UserAssets1155            `boiler:"Asset" boil:"Asset" json:"Asset" toml:"Asset" yaml:"Asset"`
+	User                   *User                      `boiler:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+	PendingWithdrawActions PendingWithdrawActionSlice `boiler:"PendingWithdrawActions" boil:"PendingWithdrawActions" json:"PendingWithdrawActions" toml:"PendingWithdrawActions" yaml:"PendingWithdrawActions"`
 }
 
 // NewStruct creates a new relationship struct
@@ -414,6 +417,27 @@ func (o *Pending1155Rollback) User(mods ...qm.QueryMod) userQuery {
 	return query
 }
 
+// PendingWithdrawActions retrieves all the pending_withdraw_action's PendingWithdrawActions with an executor.
+func (o *Pending1155Rollback) PendingWithdrawActions(mods ...qm.QueryMod) pendingWithdrawActionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"pending_withdraw_actions\".\"pending_1155_rollback_id\"=?", o.ID),
+	)
+
+	query := PendingWithdrawActions(queryMods...)
+	queries.SetFrom(query.Query, "\"pending_withdraw_actions\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"pending_withdraw_actions\".*"})
+	}
+
+	return query
+}
+
 // LoadAsset allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (pending1155RollbackL) LoadAsset(e boil.Executor, singular bool, maybePending1155Rollback interface{}, mods queries.Applicator) error {
@@ -623,6 +647,104 @@ func (pending1155RollbackL) LoadUser(e boil.Executor, singular bool, maybePendin
 	return nil
 }
 
+// LoadPendingWithdrawActions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pending1155RollbackL) LoadPendingWithdrawActions(e boil.Executor, singular bool, maybePending1155Rollback interface{}, mods queries.Applicator) error {
+	var slice []*Pending1155Rollback
+	var object *Pending1155Rollback
+
+	if singular {
+		object = maybePending1155Rollback.(*Pending1155Rollback)
+	} else {
+		slice = *maybePending1155Rollback.(*[]*Pending1155Rollback)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &pending1155RollbackR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pending1155RollbackR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`pending_withdraw_actions`),
+		qm.WhereIn(`pending_withdraw_actions.pending_1155_rollback_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load pending_withdraw_actions")
+	}
+
+	var resultSlice []*PendingWithdrawAction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice pending_withdraw_actions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on pending_withdraw_actions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pending_withdraw_actions")
+	}
+
+	if len(pendingWithdrawActionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PendingWithdrawActions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pendingWithdrawActionR{}
+			}
+			foreign.R.Pending1155Rollback = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.Pending1155RollbackID) {
+				local.R.PendingWithdrawActions = append(local.R.PendingWithdrawActions, foreign)
+				if foreign.R == nil {
+					foreign.R = &pendingWithdrawActionR{}
+				}
+				foreign.R.Pending1155Rollback = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetAsset of the pending1155Rollback to the related item.
 // Sets o.R.Asset to related.
 // Adds o to related.R.AssetPending1155Rollbacks.
@@ -715,6 +837,131 @@ func (o *Pending1155Rollback) SetUser(exec boil.Executor, insert bool, related *
 	return nil
 }
 
+// AddPendingWithdrawActions adds the given related objects to the existing relationships
+// of the pending_1155_rollback, optionally inserting them as new records.
+// Appends related to o.R.PendingWithdrawActions.
+// Sets related.R.Pending1155Rollback appropriately.
+func (o *Pending1155Rollback) AddPendingWithdrawActions(exec boil.Executor, insert bool, related ...*PendingWithdrawAction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.Pending1155RollbackID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"pending_withdraw_actions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"pending_1155_rollback_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pendingWithdrawActionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.Pending1155RollbackID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pending1155RollbackR{
+			PendingWithdrawActions: related,
+		}
+	} else {
+		o.R.PendingWithdrawActions = append(o.R.PendingWithdrawActions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pendingWithdrawActionR{
+				Pending1155Rollback: o,
+			}
+		} else {
+			rel.R.Pending1155Rollback = o
+		}
+	}
+	return nil
+}
+
+// SetPendingWithdrawActions removes all previously related items of the
+// pending_1155_rollback replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Pending1155Rollback's PendingWithdrawActions accordingly.
+// Replaces o.R.PendingWithdrawActions with related.
+// Sets related.R.Pending1155Rollback's PendingWithdrawActions accordingly.
+func (o *Pending1155Rollback) SetPendingWithdrawActions(exec boil.Executor, insert bool, related ...*PendingWithdrawAction) error {
+	query := "update \"pending_withdraw_actions\" set \"pending_1155_rollback_id\" = null where \"pending_1155_rollback_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.PendingWithdrawActions {
+			queries.SetScanner(&rel.Pending1155RollbackID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Pending1155Rollback = nil
+		}
+
+		o.R.PendingWithdrawActions = nil
+	}
+	return o.AddPendingWithdrawActions(exec, insert, related...)
+}
+
+// RemovePendingWithdrawActions relationships from objects passed in.
+// Removes related items from R.PendingWithdrawActions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Pending1155Rollback.
+func (o *Pending1155Rollback) RemovePendingWithdrawActions(exec boil.Executor, related ...*PendingWithdrawAction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.Pending1155RollbackID, nil)
+		if rel.R != nil {
+			rel.R.Pending1155Rollback = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("pending_1155_rollback_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.PendingWithdrawActions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.PendingWithdrawActions)
+			if ln > 1 && i < ln-1 {
+				o.R.PendingWithdrawActions[i] = o.R.PendingWithdrawActions[ln-1]
+			}
+			o.R.PendingWithdrawActions = o.R.PendingWithdrawActions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Pending1155Rollbacks retrieves all the records using an executor.
 func Pending1155Rollbacks(mods ...qm.QueryMod) pending1155RollbackQuery {
 	mods = append(mods, qm.From("\"pending_1155_rollback\""), qmhelper.WhereIsNull("\"pending_1155_rollback\".\"deleted_at\""))
@@ -37,6 +37,7 @@ type PendingRefund struct {
 	CreatedAt             time.Time       `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	WithdrawTransactionID null.String     `boiler:"withdraw_transaction_id" boil:"withdraw_transaction_id" json:"withdraw_transaction_id,omitempty" toml:"withdraw_transaction_id" yaml:"withdraw_transaction_id,omitempty"`
 	ReversalTransactionID null.String     `boiler:"reversal_transaction_id" boil:"reversal_transaction_id" json:"reversal_transaction_id,omitempty" toml:"reversal_transaction_id" yaml:"reversal_transaction_id,omitempty"`
+	RollbackClaimedAt     null.Time       `boiler:"rollback_claimed_at" boil:"rollback_claimed_at" json:"rollback_claimed_at,omitempty" toml:"rollback_claimed_at" yaml:"rollback_claimed_at,omitempty"`
 
 	R *pendingRefundR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L pendingRefundL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -56,6 +57,7 @@ var PendingRefundColumns = struct {
 	CreatedAt             string
 	WithdrawTransactionID string
 	ReversalTransactionID string
+	RollbackClaimedAt     string
 }{
 	ID:                    "id",
 	UserID:                "user_id",
@@ -70,6 +72,7 @@ var PendingRefundColumns = struct {
 	CreatedAt:             "created_at",
 	WithdrawTransactionID: "withdraw_transaction_id",
 	ReversalTransactionID: "reversal_transaction_id",
+	RollbackClaimedAt:     "rollback_claimed_at",
 }
 
 var PendingRefundTableColumns = struct {
@@ -86,6 +89,7 @@ var PendingRefundTableColumns = struct {
 	CreatedAt             string
 	WithdrawTransactionID string
 	ReversalTransactionID string
+	RollbackClaimedAt     string
 }{
 	ID:                    "pending_refund.id",
 	UserID:                "pending_refund.user_id",
@@ -100,6 +104,7 @@ var PendingRefundTableColumns = struct {
 	CreatedAt:             "pending_refund.created_at",
 	WithdrawTransactionID: "pending_refund.withdraw_transaction_id",
 	ReversalTransactionID: "pending_refund.reversal_transaction_id",
+	RollbackClaimedAt:     "pending_refund.rollback_claimed_at",
 }
 
 // Generated where
@@ -118,6 +123,7 @@ var PendingRefundWhere = struct {
 	CreatedAt             whereHelpertime_Time
 	WithdrawTransactionID whereHelpernull_String
 	ReversalTransactionID whereHelpernull_String
+	RollbackClaimedAt     whereHelpernull_Time
 }{
 	ID:                    whereHelperstring{field: "\"pending_refund\".\"id\""},
 	UserID:                whereHelperstring{field: "\"pending_refund\".\"user_id\""},
@@ -132,18 +138,22 @@ var PendingRefundWhere = struct {
 	CreatedAt:             whereHelpertime_Time{field: "\"pending_refund\".\"created_at\""},
 	WithdrawTransactionID: whereHelpernull_String{field: "\"pending_refund\".\"withdraw_transaction_id\""},
 	ReversalTransactionID: whereHelpernull_String{field: "\"pending_refund\".\"reversal_transaction_id\""},
+	RollbackClaimedAt:     whereHelpernull_Time{field: "\"pending_refund\".\"rollback_claimed_at\""},
 }
 
 // PendingRefundRels is where relationship names are stored.
 var PendingRefundRels = struct {
-	User string
+	User                   string
+	PendingWithdrawActions string
 }{
-	User: "User",
+	User:                   "User",
+	PendingWithdrawActions: "PendingWithdrawActions",
 }
 
 // pendingRefundR is where relationships are stored.
 type pendingRefundR struct {
-	User *User `boiler:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+	User                   *User                      `boiler:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+	PendingWithdrawActions PendingWithdrawActionSlice `boiler:"PendingWithdrawActions" boil:"PendingWithdrawActions" json:"PendingWithdrawActions" toml:"PendingWithdrawActions" yaml:"PendingWithdrawActions"`
 }
 
 // NewStruct creates a new relationship struct
@@ -155,9 +165,9 @@ func (*pendingRefundR) NewStruct() *pendingRefundR {
 type pendingRefundL struct{}
 
 var (
-	pendingRefundAllColumns            = []string{"id", "user_id", "amount_sups", "refunded_at", "is_refunded", "refund_canceled_at", "tx_hash", "transaction_reference", "deleted_at", "updated_at", "created_at", "withdraw_transaction_id", "reversal_transaction_id"}
+	pendingRefundAllColumns            = []string{"id", "user_id", "amount_sups", "refunded_at", "is_refunded", "refund_canceled_at", "tx_hash", "transaction_reference", "deleted_at", "updated_at", "created_at", "withdraw_transaction_id", "reversal_transaction_id", "rollback_claimed_at"}
 	pendingRefundColumnsWithoutDefault = []string{"user_id", "amount_sups", "refunded_at", "transaction_reference"}
-	pendingRefundColumnsWithDefault    = []string{"id", "is_refunded", "refund_canceled_at", "tx_hash", "deleted_at", "updated_at", "created_at", "withdraw_transaction_id", "reversal_transaction_id"}
+	pendingRefundColumnsWithDefault    = []string{"id", "is_refunded", "refund_canceled_at", "tx_hash", "deleted_at", "updated_at", "created_at", "withdraw_transaction_id", "reversal_transaction_id", "rollback_claimed_at"}
 	pendingRefundPrimaryKeyColumns     = []string{"id"}
 	pendingRefundGeneratedColumns      = []string{}
 )
@@ -419,6 +429,27 @@ func (o *PendingRefund) User(mods ...qm.QueryMod) userQuery {
 	return query
 }
 
+// PendingWithdrawActions retrieves all the pending_withdraw_action's PendingWithdrawActions with an executor.
+func (o *PendingRefund) PendingWithdrawActions(mods ...qm.QueryMod) pendingWithdrawActionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"pending_withdraw_actions\".\"pending_refund_id\"=?", o.ID),
+	)
+
+	query := PendingWithdrawActions(queryMods...)
+	queries.SetFrom(query.Query, "\"pending_withdraw_actions\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"pending_withdraw_actions\".*"})
+	}
+
+	return query
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (pendingRefundL) LoadUser(e boil.Executor, singular bool, maybePendingRefund interface{}, mods queries.Applicator) error {
@@ -524,6 +555,104 @@ func (pendingRefundL) LoadUser(e boil.Executor, singular bool, maybePendingRefun
 	return nil
 }
 
+// LoadPendingWithdrawActions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pendingRefundL) LoadPendingWithdrawActions(e boil.Executor, singular bool, maybePendingRefund interface{}, mods queries.Applicator) error {
+	var slice []*PendingRefund
+	var object *PendingRefund
+
+	if singular {
+		object = maybePendingRefund.(*PendingRefund)
+	} else {
+		slice = *maybePendingRefund.(*[]*PendingRefund)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &pendingRefundR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pendingRefundR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`pending_withdraw_actions`),
+		qm.WhereIn(`pending_withdraw_actions.pending_refund_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load pending_withdraw_actions")
+	}
+
+	var resultSlice []*PendingWithdrawAction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice pending_withdraw_actions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on pending_withdraw_actions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pending_withdraw_actions")
+	}
+
+	if len(pendingWithdrawActionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PendingWithdrawActions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pendingWithdrawActionR{}
+			}
+			foreign.R.PendingRefund = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.PendingRefundID) {
+				local.R.PendingWithdrawActions = append(local.R.PendingWithdrawActions, foreign)
+				if foreign.R == nil {
+					foreign.R = &pendingWithdrawActionR{}
+				}
+				foreign.R.PendingRefund = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetUser of the pendingRefund to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.PendingRefunds.
@@ -570,6 +699,131 @@ func (o *PendingRefund) SetUser(exec boil.Executor, insert bool, related *User)
 	return nil
 }
 
+// AddPendingWithdrawActions adds the given related objects to the existing relationships
+// of the pending_refund, optionally inserting them as new records.
+// Appends related to o.R.PendingWithdrawActions.
+// Sets related.R.PendingRefund appropriately.
+func (o *PendingRefund) AddPendingWithdrawActions(exec boil.Executor, insert bool, related ...*PendingWithdrawAction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.PendingRefundID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"pending_withdraw_actions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"pending_refund_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pendingWithdrawActionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.PendingRefundID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pendingRefundR{
+			PendingWithdrawActions: related,
+		}
+	} else {
+		o.R.PendingWithdrawActions = append(o.R.PendingWithdrawActions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pendingWithdrawActionR{
+				PendingRefund: o,
+			}
+		} else {
+			rel.R.PendingRefund = o
+		}
+	}
+	return nil
+}
+
+// SetPendingWithdrawActions removes all previously related items of the
+// pending_refund replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.PendingRefund's PendingWithdrawActions accordingly.
+// Replaces o.R.PendingWithdrawActions with related.
+// Sets related.R.PendingRefund's PendingWithdrawActions accordingly.
+func (o *PendingRefund) SetPendingWithdrawActions(exec boil.Executor, insert bool, related ...*PendingWithdrawAction) error {
+	query := "update \"pending_withdraw_actions\" set \"pending_refund_id\" = null where \"pending_refund_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.PendingWithdrawActions {
+			queries.SetScanner(&rel.PendingRefundID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.PendingRefund = nil
+		}
+
+		o.R.PendingWithdrawActions = nil
+	}
+	return o.AddPendingWithdrawActions(exec, insert, related...)
+}
+
+// RemovePendingWithdrawActions relationships from objects passed in.
+// Removes related items from R.PendingWithdrawActions (uses pointer comparison, removal does not keep order)
+// Sets related.R.PendingRefund.
+func (o *PendingRefund) RemovePendingWithdrawActions(exec boil.Executor, related ...*PendingWithdrawAction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.PendingRefundID, nil)
+		if rel.R != nil {
+			rel.R.PendingRefund = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("pending_refund_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.PendingWithdrawActions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.PendingWithdrawActions)
+			if ln > 1 && i < ln-1 {
+				o.R.PendingWithdrawActions[i] = o.R.PendingWithdrawActions[ln-1]
+			}
+			o.R.PendingWithdrawActions = o.R.PendingWithdrawActions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // PendingRefunds retrieves all the records using an executor.
 func PendingRefunds(mods ...qm.QueryMod) pendingRefundQuery {
 	mods = append(mods, qm.From("\"pending_refund\""), qmhelper.WhereIsNull("\"pending_refund\".\"deleted_at\""))
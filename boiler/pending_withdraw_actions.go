@@ -0,0 +1,1527 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PendingWithdrawAction is an object representing the database table.
+type PendingWithdrawAction struct {
+	ID                    string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	PendingRefundID       null.String `boiler:"pending_refund_id" boil:"pending_refund_id" json:"pending_refund_id,omitempty" toml:"pending_refund_id" yaml:"pending_refund_id,omitempty"`
+	Pending1155RollbackID null.String `boiler:"pending_1155_rollback_id" boil:"pending_1155_rollback_id" json:"pending_1155_rollback_id,omitempty" toml:"pending_1155_rollback_id" yaml:"pending_1155_rollback_id,omitempty"`
+	Action                string      `boiler:"action" boil:"action" json:"action" toml:"action" yaml:"action"`
+	TXHash                null.String `boiler:"tx_hash" boil:"tx_hash" json:"tx_hash,omitempty" toml:"tx_hash" yaml:"tx_hash,omitempty"`
+	ReversalTransactionID null.String `boiler:"reversal_transaction_id" boil:"reversal_transaction_id" json:"reversal_transaction_id,omitempty" toml:"reversal_transaction_id" yaml:"reversal_transaction_id,omitempty"`
+	Reason                string      `boiler:"reason" boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	Operator              string      `boiler:"operator" boil:"operator" json:"operator" toml:"operator" yaml:"operator"`
+	OperatorUserID        null.String `boiler:"operator_user_id" boil:"operator_user_id" json:"operator_user_id,omitempty" toml:"operator_user_id" yaml:"operator_user_id,omitempty"`
+	CreatedAt             time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *pendingWithdrawActionR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L pendingWithdrawActionL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PendingWithdrawActionColumns = struct {
+	ID                    string
+	PendingRefundID       string
+	Pending1155RollbackID string
+	Action                string
+	TXHash                string
+	ReversalTransactionID string
+	Reason                string
+	Operator              string
+	OperatorUserID        string
+	CreatedAt             string
+}{
+	ID:                    "id",
+	PendingRefundID:       "pending_refund_id",
+	Pending1155RollbackID: "pending_1155_rollback_id",
+	Action:                "action",
+	TXHash:                "tx_hash",
+	ReversalTransactionID: "reversal_transaction_id",
+	Reason:                "reason",
+	Operator:              "operator",
+	OperatorUserID:        "operator_user_id",
+	CreatedAt:             "created_at",
+}
+
+var PendingWithdrawActionTableColumns = struct {
+	ID                    string
+	PendingRefundID       string
+	Pending1155RollbackID string
+	Action                string
+	TXHash                string
+	ReversalTransactionID string
+	Reason                string
+	Operator              string
+	OperatorUserID        string
+	CreatedAt             string
+}{
+	ID:                    "pending_withdraw_actions.id",
+	PendingRefundID:       "pending_withdraw_actions.pending_refund_id",
+	Pending1155RollbackID: "pending_withdraw_actions.pending_1155_rollback_id",
+	Action:                "pending_withdraw_actions.action",
+	TXHash:                "pending_withdraw_actions.tx_hash",
+	ReversalTransactionID: "pending_withdraw_actions.reversal_transaction_id",
+	Reason:                "pending_withdraw_actions.reason",
+	Operator:              "pending_withdraw_actions.operator",
+	OperatorUserID:        "pending_withdraw_actions.operator_user_id",
+	CreatedAt:             "pending_withdraw_actions.created_at",
+}
+
+// Generated where
+
+var PendingWithdrawActionWhere = struct {
+	ID                    whereHelperstring
+	PendingRefundID       whereHelpernull_String
+	Pending1155RollbackID whereHelpernull_String
+	Action                whereHelperstring
+	TXHash                whereHelpernull_String
+	ReversalTransactionID whereHelpernull_String
+	Reason                whereHelperstring
+	Operator              whereHelperstring
+	OperatorUserID        whereHelpernull_String
+	CreatedAt             whereHelpertime_Time
+}{
+	ID:                    whereHelperstring{field: "\"pending_withdraw_actions\".\"id\""},
+	PendingRefundID:       whereHelpernull_String{field: "\"pending_withdraw_actions\".\"pending_refund_id\""},
+	Pending1155RollbackID: whereHelpernull_String{field: "\"pending_withdraw_actions\".\"pending_1155_rollback_id\""},
+	Action:                whereHelperstring{field: "\"pending_withdraw_actions\".\"action\""},
+	TXHash:                whereHelpernull_String{field: "\"pending_withdraw_actions\".\"tx_hash\""},
+	ReversalTransactionID: whereHelpernull_String{field: "\"pending_withdraw_actions\".\"reversal_transaction_id\""},
+	Reason:                whereHelperstring{field: "\"pending_withdraw_actions\".\"reason\""},
+	Operator:              whereHelperstring{field: "\"pending_withdraw_actions\".\"operator\""},
+	OperatorUserID:        whereHelpernull_String{field: "\"pending_withdraw_actions\".\"operator_user_id\""},
+	CreatedAt:             whereHelpertime_Time{field: "\"pending_withdraw_actions\".\"created_at\""},
+}
+
+// PendingWithdrawActionRels is where relationship names are stored.
+var PendingWithdrawActionRels = struct {
+	OperatorUser        string
+	Pending1155Rollback string
+	PendingRefund       string
+}{
+	OperatorUser:        "OperatorUser",
+	Pending1155Rollback: "Pending1155Rollback",
+	PendingRefund:       "PendingRefund",
+}
+
+// pendingWithdrawActionR is where relationships are stored.
+type pendingWithdrawActionR struct {
+	OperatorUser        *User                `boiler:"OperatorUser" boil:"OperatorUser" json:"OperatorUser" toml:"OperatorUser" yaml:"OperatorUser"`
+	Pending1155Rollback *Pending1155Rollback `boiler:"Pending1155Rollback" boil:"Pending1155Rollback" json:"Pending1155Rollback" toml:"Pending1155Rollback" yaml:"Pending1155Rollback"`
+	PendingRefund       *PendingRefund       `boiler:"PendingRefund" boil:"PendingRefund" json:"PendingRefund" toml:"PendingRefund" yaml:"PendingRefund"`
+}
+
+// NewStruct creates a new relationship struct
+func (*pendingWithdrawActionR) NewStruct() *pendingWithdrawActionR {
+	return &pendingWithdrawActionR{}
+}
+
+// pendingWithdrawActionL is where Load methods for each relationship are stored.
+type pendingWithdrawActionL struct{}
+
+var (
+	pendingWithdrawActionAllColumns            = []string{"id", "pending_refund_id", "pending_1155_rollback_id", "action", "tx_hash", "reversal_transaction_id", "reason", "operator", "operator_user_id", "created_at"}
+	pendingWithdrawActionColumnsWithoutDefault = []string{"action", "reason", "operator"}
+	pendingWithdrawActionColumnsWithDefault    = []string{"id", "pending_refund_id", "pending_1155_rollback_id", "tx_hash", "reversal_transaction_id", "operator_user_id", "created_at"}
+	pendingWithdrawActionPrimaryKeyColumns     = []string{"id"}
+	pendingWithdrawActionGeneratedColumns      = []string{}
+)
+
+type (
+	// PendingWithdrawActionSlice is an alias for a slice of pointers to PendingWithdrawAction.
+	// This should almost always be used instead of []PendingWithdrawAction.
+	PendingWithdrawActionSlice []*PendingWithdrawAction
+	// PendingWithdrawActionHook is the signature for custom PendingWithdrawAction hook methods
+	PendingWithdrawActionHook func(boil.Executor, *PendingWithdrawAction) error
+
+	pendingWithdrawActionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	pendingWithdrawActionType                 = reflect.TypeOf(&PendingWithdrawAction{})
+	pendingWithdrawActionMapping              = queries.MakeStructMapping(pendingWithdrawActionType)
+	pendingWithdrawActionPrimaryKeyMapping, _ = queries.BindMapping(pendingWithdrawActionType, pendingWithdrawActionMapping, pendingWithdrawActionPrimaryKeyColumns)
+	pendingWithdrawActionInsertCacheMut       sync.RWMutex
+	pendingWithdrawActionInsertCache          = make(map[string]insertCache)
+	pendingWithdrawActionUpdateCacheMut       sync.RWMutex
+	pendingWithdrawActionUpdateCache          = make(map[string]updateCache)
+	pendingWithdrawActionUpsertCacheMut       sync.RWMutex
+	pendingWithdrawActionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var pendingWithdrawActionAfterSelectHooks []PendingWithdrawActionHook
+
+var pendingWithdrawActionBeforeInsertHooks []PendingWithdrawActionHook
+var pendingWithdrawActionAfterInsertHooks []PendingWithdrawActionHook
+
+var pendingWithdrawActionBeforeUpdateHooks []PendingWithdrawActionHook
+var pendingWithdrawActionAfterUpdateHooks []PendingWithdrawActionHook
+
+var pendingWithdrawActionBeforeDeleteHooks []PendingWithdrawActionHook
+var pendingWithdrawActionAfterDeleteHooks []PendingWithdrawActionHook
+
+var pendingWithdrawActionBeforeUpsertHooks []PendingWithdrawActionHook
+var pendingWithdrawActionAfterUpsertHooks []PendingWithdrawActionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PendingWithdrawAction) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range pendingWithdrawActionAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PendingWithdrawAction) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range pendingWithdrawActionBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PendingWithdrawAction) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range pendingWithdrawActionAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PendingWithdrawAction) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range pendingWithdrawActionBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PendingWithdrawAction) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range pendingWithdrawActionAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PendingWithdrawAction) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range pendingWithdrawActionBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PendingWithdrawAction) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range pendingWithdrawActionAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PendingWithdrawAction) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range pendingWithdrawActionBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PendingWithdrawAction) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range pendingWithdrawActionAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPendingWithdrawActionHook registers your hook function for all future operations.
+func AddPendingWithdrawActionHook(hookPoint boil.HookPoint, pendingWithdrawActionHook PendingWithdrawActionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		pendingWithdrawActionAfterSelectHooks = append(pendingWithdrawActionAfterSelectHooks, pendingWithdrawActionHook)
+	case boil.BeforeInsertHook:
+		pendingWithdrawActionBeforeInsertHooks = append(pendingWithdrawActionBeforeInsertHooks, pendingWithdrawActionHook)
+	case boil.AfterInsertHook:
+		pendingWithdrawActionAfterInsertHooks = append(pendingWithdrawActionAfterInsertHooks, pendingWithdrawActionHook)
+	case boil.BeforeUpdateHook:
+		pendingWithdrawActionBeforeUpdateHooks = append(pendingWithdrawActionBeforeUpdateHooks, pendingWithdrawActionHook)
+	case boil.AfterUpdateHook:
+		pendingWithdrawActionAfterUpdateHooks = append(pendingWithdrawActionAfterUpdateHooks, pendingWithdrawActionHook)
+	case boil.BeforeDeleteHook:
+		pendingWithdrawActionBeforeDeleteHooks = append(pendingWithdrawActionBeforeDeleteHooks, pendingWithdrawActionHook)
+	case boil.AfterDeleteHook:
+		pendingWithdrawActionAfterDeleteHooks = append(pendingWithdrawActionAfterDeleteHooks, pendingWithdrawActionHook)
+	case boil.BeforeUpsertHook:
+		pendingWithdrawActionBeforeUpsertHooks = append(pendingWithdrawActionBeforeUpsertHooks, pendingWithdrawActionHook)
+	case boil.AfterUpsertHook:
+		pendingWithdrawActionAfterUpsertHooks = append(pendingWithdrawActionAfterUpsertHooks, pendingWithdrawActionHook)
+	}
+}
+
+// One returns a single pendingWithdrawAction record from the query.
+func (q pendingWithdrawActionQuery) One(exec boil.Executor) (*PendingWithdrawAction, error) {
+	o := &PendingWithdrawAction{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for pending_withdraw_actions")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PendingWithdrawAction records from the query.
+func (q pendingWithdrawActionQuery) All(exec boil.Executor) (PendingWithdrawActionSlice, error) {
+	var o []*PendingWithdrawAction
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to PendingWithdrawAction slice")
+	}
+
+	if len(pendingWithdrawActionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PendingWithdrawAction records in the query.
+func (q pendingWithdrawActionQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count pending_withdraw_actions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q pendingWithdrawActionQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if pending_withdraw_actions exists")
+	}
+
+	return count > 0, nil
+}
+
+// OperatorUser pointed to by the foreign key.
+func (o *PendingWithdrawAction) OperatorUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OperatorUserID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// Pending1155Rollback pointed to by the foreign key.
+func (o *PendingWithdrawAction) Pending1155Rollback(mods ...qm.QueryMod) pending1155RollbackQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.Pending1155RollbackID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Pending1155Rollbacks(queryMods...)
+	queries.SetFrom(query.Query, "\"pending_1155_rollback\"")
+
+	return query
+}
+
+// PendingRefund pointed to by the foreign key.
+func (o *PendingWithdrawAction) PendingRefund(mods ...qm.QueryMod) pendingRefundQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PendingRefundID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := PendingRefunds(queryMods...)
+	queries.SetFrom(query.Query, "\"pending_refund\"")
+
+	return query
+}
+
+// LoadOperatorUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pendingWithdrawActionL) LoadOperatorUser(e boil.Executor, singular bool, maybePendingWithdrawAction interface{}, mods queries.Applicator) error {
+	var slice []*PendingWithdrawAction
+	var object *PendingWithdrawAction
+
+	if singular {
+		object = maybePendingWithdrawAction.(*PendingWithdrawAction)
+	} else {
+		slice = *maybePendingWithdrawAction.(*[]*PendingWithdrawAction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &pendingWithdrawActionR{}
+		}
+		if !queries.IsNil(object.OperatorUserID) {
+			args = append(args, object.OperatorUserID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pendingWithdrawActionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.OperatorUserID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.OperatorUserID) {
+				args = append(args, obj.OperatorUserID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(pendingWithdrawActionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OperatorUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.OperatorUserPendingWithdrawActions = append(foreign.R.OperatorUserPendingWithdrawActions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.OperatorUserID, foreign.ID) {
+				local.R.OperatorUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.OperatorUserPendingWithdrawActions = append(foreign.R.OperatorUserPendingWithdrawActions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPending1155Rollback allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pendingWithdrawActionL) LoadPending1155Rollback(e boil.Executor, singular bool, maybePendingWithdrawAction interface{}, mods queries.Applicator) error {
+	var slice []*PendingWithdrawAction
+	var object *PendingWithdrawAction
+
+	if singular {
+		object = maybePendingWithdrawAction.(*PendingWithdrawAction)
+	} else {
+		slice = *maybePendingWithdrawAction.(*[]*PendingWithdrawAction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &pendingWithdrawActionR{}
+		}
+		if !queries.IsNil(object.Pending1155RollbackID) {
+			args = append(args, object.Pending1155RollbackID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pendingWithdrawActionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.Pending1155RollbackID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.Pending1155RollbackID) {
+				args = append(args, obj.Pending1155RollbackID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`pending_1155_rollback`),
+		qm.WhereIn(`pending_1155_rollback.id in ?`, args...),
+		qmhelper.WhereIsNull(`pending_1155_rollback.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Pending1155Rollback")
+	}
+
+	var resultSlice []*Pending1155Rollback
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Pending1155Rollback")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for pending_1155_rollback")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pending_1155_rollback")
+	}
+
+	if len(pendingWithdrawActionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Pending1155Rollback = foreign
+		if foreign.R == nil {
+			foreign.R = &pending1155RollbackR{}
+		}
+		foreign.R.PendingWithdrawActions = append(foreign.R.PendingWithdrawActions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.Pending1155RollbackID, foreign.ID) {
+				local.R.Pending1155Rollback = foreign
+				if foreign.R == nil {
+					foreign.R = &pending1155RollbackR{}
+				}
+				foreign.R.PendingWithdrawActions = append(foreign.R.PendingWithdrawActions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPendingRefund allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pendingWithdrawActionL) LoadPendingRefund(e boil.Executor, singular bool, maybePendingWithdrawAction interface{}, mods queries.Applicator) error {
+	var slice []*PendingWithdrawAction
+	var object *PendingWithdrawAction
+
+	if singular {
+		object = maybePendingWithdrawAction.(*PendingWithdrawAction)
+	} else {
+		slice = *maybePendingWithdrawAction.(*[]*PendingWithdrawAction)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &pendingWithdrawActionR{}
+		}
+		if !queries.IsNil(object.PendingRefundID) {
+			args = append(args, object.PendingRefundID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pendingWithdrawActionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.PendingRefundID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.PendingRefundID) {
+				args = append(args, obj.PendingRefundID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`pending_refund`),
+		qm.WhereIn(`pending_refund.id in ?`, args...),
+		qmhelper.WhereIsNull(`pending_refund.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load PendingRefund")
+	}
+
+	var resultSlice []*PendingRefund
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice PendingRefund")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for pending_refund")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pending_refund")
+	}
+
+	if len(pendingWithdrawActionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PendingRefund = foreign
+		if foreign.R == nil {
+			foreign.R = &pendingRefundR{}
+		}
+		foreign.R.PendingWithdrawActions = append(foreign.R.PendingWithdrawActions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.PendingRefundID, foreign.ID) {
+				local.R.PendingRefund = foreign
+				if foreign.R == nil {
+					foreign.R = &pendingRefundR{}
+				}
+				foreign.R.PendingWithdrawActions = append(foreign.R.PendingWithdrawActions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOperatorUser of the pendingWithdrawAction to the related item.
+// Sets o.R.OperatorUser to related.
+// Adds o to related.R.OperatorUserPendingWithdrawActions.
+func (o *PendingWithdrawAction) SetOperatorUser(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"pending_withdraw_actions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"operator_user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pendingWithdrawActionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.OperatorUserID, related.ID)
+	if o.R == nil {
+		o.R = &pendingWithdrawActionR{
+			OperatorUser: related,
+		}
+	} else {
+		o.R.OperatorUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OperatorUserPendingWithdrawActions: PendingWithdrawActionSlice{o},
+		}
+	} else {
+		related.R.OperatorUserPendingWithdrawActions = append(related.R.OperatorUserPendingWithdrawActions, o)
+	}
+
+	return nil
+}
+
+// RemoveOperatorUser relationship.
+// Sets o.R.OperatorUser to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *PendingWithdrawAction) RemoveOperatorUser(exec boil.Executor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.OperatorUserID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("operator_user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.OperatorUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.OperatorUserPendingWithdrawActions {
+		if queries.Equal(o.OperatorUserID, ri.OperatorUserID) {
+			continue
+		}
+
+		ln := len(related.R.OperatorUserPendingWithdrawActions)
+		if ln > 1 && i < ln-1 {
+			related.R.OperatorUserPendingWithdrawActions[i] = related.R.OperatorUserPendingWithdrawActions[ln-1]
+		}
+		related.R.OperatorUserPendingWithdrawActions = related.R.OperatorUserPendingWithdrawActions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetPending1155Rollback of the pendingWithdrawAction to the related item.
+// Sets o.R.Pending1155Rollback to related.
+// Adds o to related.R.PendingWithdrawActions.
+func (o *PendingWithdrawAction) SetPending1155Rollback(exec boil.Executor, insert bool, related *Pending1155Rollback) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"pending_withdraw_actions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"pending_1155_rollback_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pendingWithdrawActionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.Pending1155RollbackID, related.ID)
+	if o.R == nil {
+		o.R = &pendingWithdrawActionR{
+			Pending1155Rollback: related,
+		}
+	} else {
+		o.R.Pending1155Rollback = related
+	}
+
+	if related.R == nil {
+		related.R = &pending1155RollbackR{
+			PendingWithdrawActions: PendingWithdrawActionSlice{o},
+		}
+	} else {
+		related.R.PendingWithdrawActions = append(related.R.PendingWithdrawActions, o)
+	}
+
+	return nil
+}
+
+// RemovePending1155Rollback relationship.
+// Sets o.R.Pending1155Rollback to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *PendingWithdrawAction) RemovePending1155Rollback(exec boil.Executor, related *Pending1155Rollback) error {
+	var err error
+
+	queries.SetScanner(&o.Pending1155RollbackID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("pending_1155_rollback_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Pending1155Rollback = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.PendingWithdrawActions {
+		if queries.Equal(o.Pending1155RollbackID, ri.Pending1155RollbackID) {
+			continue
+		}
+
+		ln := len(related.R.PendingWithdrawActions)
+		if ln > 1 && i < ln-1 {
+			related.R.PendingWithdrawActions[i] = related.R.PendingWithdrawActions[ln-1]
+		}
+		related.R.PendingWithdrawActions = related.R.PendingWithdrawActions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetPendingRefund of the pendingWithdrawAction to the related item.
+// Sets o.R.PendingRefund to related.
+// Adds o to related.R.PendingWithdrawActions.
+func (o *PendingWithdrawAction) SetPendingRefund(exec boil.Executor, insert bool, related *PendingRefund) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"pending_withdraw_actions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"pending_refund_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pendingWithdrawActionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.PendingRefundID, related.ID)
+	if o.R == nil {
+		o.R = &pendingWithdrawActionR{
+			PendingRefund: related,
+		}
+	} else {
+		o.R.PendingRefund = related
+	}
+
+	if related.R == nil {
+		related.R = &pendingRefundR{
+			PendingWithdrawActions: PendingWithdrawActionSlice{o},
+		}
+	} else {
+		related.R.PendingWithdrawActions = append(related.R.PendingWithdrawActions, o)
+	}
+
+	return nil
+}
+
+// RemovePendingRefund relationship.
+// Sets o.R.PendingRefund to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *PendingWithdrawAction) RemovePendingRefund(exec boil.Executor, related *PendingRefund) error {
+	var err error
+
+	queries.SetScanner(&o.PendingRefundID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("pending_refund_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.PendingRefund = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.PendingWithdrawActions {
+		if queries.Equal(o.PendingRefundID, ri.PendingRefundID) {
+			continue
+		}
+
+		ln := len(related.R.PendingWithdrawActions)
+		if ln > 1 && i < ln-1 {
+			related.R.PendingWithdrawActions[i] = related.R.PendingWithdrawActions[ln-1]
+		}
+		related.R.PendingWithdrawActions = related.R.PendingWithdrawActions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// PendingWithdrawActions retrieves all the records using an executor.
+func PendingWithdrawActions(mods ...qm.QueryMod) pendingWithdrawActionQuery {
+	mods = append(mods, qm.From("\"pending_withdraw_actions\""))
+	return pendingWithdrawActionQuery{NewQuery(mods...)}
+}
+
+// FindPendingWithdrawAction retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPendingWithdrawAction(exec boil.Executor, iD string, selectCols ...string) (*PendingWithdrawAction, error) {
+	pendingWithdrawActionObj := &PendingWithdrawAction{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"pending_withdraw_actions\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, pendingWithdrawActionObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from pending_withdraw_actions")
+	}
+
+	if err = pendingWithdrawActionObj.doAfterSelectHooks(exec); err != nil {
+		return pendingWithdrawActionObj, err
+	}
+
+	return pendingWithdrawActionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PendingWithdrawAction) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no pending_withdraw_actions provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pendingWithdrawActionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	pendingWithdrawActionInsertCacheMut.RLock()
+	cache, cached := pendingWithdrawActionInsertCache[key]
+	pendingWithdrawActionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			pendingWithdrawActionAllColumns,
+			pendingWithdrawActionColumnsWithDefault,
+			pendingWithdrawActionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(pendingWithdrawActionType, pendingWithdrawActionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(pendingWithdrawActionType, pendingWithdrawActionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"pending_withdraw_actions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"pending_withdraw_actions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into pending_withdraw_actions")
+	}
+
+	if !cached {
+		pendingWithdrawActionInsertCacheMut.Lock()
+		pendingWithdrawActionInsertCache[key] = cache
+		pendingWithdrawActionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the PendingWithdrawAction.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PendingWithdrawAction) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	pendingWithdrawActionUpdateCacheMut.RLock()
+	cache, cached := pendingWithdrawActionUpdateCache[key]
+	pendingWithdrawActionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			pendingWithdrawActionAllColumns,
+			pendingWithdrawActionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update pending_withdraw_actions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"pending_withdraw_actions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, pendingWithdrawActionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(pendingWithdrawActionType, pendingWithdrawActionMapping, append(wl, pendingWithdrawActionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update pending_withdraw_actions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for pending_withdraw_actions")
+	}
+
+	if !cached {
+		pendingWithdrawActionUpdateCacheMut.Lock()
+		pendingWithdrawActionUpdateCache[key] = cache
+		pendingWithdrawActionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q pendingWithdrawActionQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for pending_withdraw_actions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for pending_withdraw_actions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PendingWithdrawActionSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pendingWithdrawActionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"pending_withdraw_actions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, pendingWithdrawActionPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in pendingWithdrawAction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all pendingWithdrawAction")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PendingWithdrawAction) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no pending_withdraw_actions provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pendingWithdrawActionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	pendingWithdrawActionUpsertCacheMut.RLock()
+	cache, cached := pendingWithdrawActionUpsertCache[key]
+	pendingWithdrawActionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			pendingWithdrawActionAllColumns,
+			pendingWithdrawActionColumnsWithDefault,
+			pendingWithdrawActionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			pendingWithdrawActionAllColumns,
+			pendingWithdrawActionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert pending_withdraw_actions, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(pendingWithdrawActionPrimaryKeyColumns))
+			copy(conflict, pendingWithdrawActionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"pending_withdraw_actions\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(pendingWithdrawActionType, pendingWithdrawActionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(pendingWithdrawActionType, pendingWithdrawActionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert pending_withdraw_actions")
+	}
+
+	if !cached {
+		pendingWithdrawActionUpsertCacheMut.Lock()
+		pendingWithdrawActionUpsertCache[key] = cache
+		pendingWithdrawActionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single PendingWithdrawAction record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PendingWithdrawAction) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no PendingWithdrawAction provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), pendingWithdrawActionPrimaryKeyMapping)
+	sql := "DELETE FROM \"pending_withdraw_actions\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from pending_withdraw_actions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for pending_withdraw_actions")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q pendingWithdrawActionQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no pendingWithdrawActionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from pending_withdraw_actions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for pending_withdraw_actions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PendingWithdrawActionSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(pendingWithdrawActionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pendingWithdrawActionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"pending_withdraw_actions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pendingWithdrawActionPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from pendingWithdrawAction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for pending_withdraw_actions")
+	}
+
+	if len(pendingWithdrawActionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PendingWithdrawAction) Reload(exec boil.Executor) error {
+	ret, err := FindPendingWithdrawAction(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PendingWithdrawActionSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PendingWithdrawActionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pendingWithdrawActionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"pending_withdraw_actions\".* FROM \"pending_withdraw_actions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pendingWithdrawActionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in PendingWithdrawActionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PendingWithdrawActionExists checks if the PendingWithdrawAction row exists.
+func PendingWithdrawActionExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"pending_withdraw_actions\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if pending_withdraw_actions exists")
+	}
+
+	return exists, nil
+}
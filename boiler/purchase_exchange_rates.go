@@ -0,0 +1,1100 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PurchaseExchangeRate is an object representing the database table.
+type PurchaseExchangeRate struct {
+	ID             string              `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	TransactionID  string              `boiler:"transaction_id" boil:"transaction_id" json:"transaction_id" toml:"transaction_id" yaml:"transaction_id"`
+	TXHash         string              `boiler:"tx_hash" boil:"tx_hash" json:"tx_hash" toml:"tx_hash" yaml:"tx_hash"`
+	Symbol         string              `boiler:"symbol" boil:"symbol" json:"symbol" toml:"symbol" yaml:"symbol"`
+	TokenUsdRate   decimal.NullDecimal `boiler:"token_usd_rate" boil:"token_usd_rate" json:"token_usd_rate,omitempty" toml:"token_usd_rate" yaml:"token_usd_rate,omitempty"`
+	SupsUsdRate    decimal.Decimal     `boiler:"sups_usd_rate" boil:"sups_usd_rate" json:"sups_usd_rate" toml:"sups_usd_rate" yaml:"sups_usd_rate"`
+	ExchangeRateID null.String         `boiler:"exchange_rate_id" boil:"exchange_rate_id" json:"exchange_rate_id,omitempty" toml:"exchange_rate_id" yaml:"exchange_rate_id,omitempty"`
+	CreatedAt      time.Time           `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *purchaseExchangeRateR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L purchaseExchangeRateL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PurchaseExchangeRateColumns = struct {
+	ID             string
+	TransactionID  string
+	TXHash         string
+	Symbol         string
+	TokenUsdRate   string
+	SupsUsdRate    string
+	ExchangeRateID string
+	CreatedAt      string
+}{
+	ID:             "id",
+	TransactionID:  "transaction_id",
+	TXHash:         "tx_hash",
+	Symbol:         "symbol",
+	TokenUsdRate:   "token_usd_rate",
+	SupsUsdRate:    "sups_usd_rate",
+	ExchangeRateID: "exchange_rate_id",
+	CreatedAt:      "created_at",
+}
+
+var PurchaseExchangeRateTableColumns = struct {
+	ID             string
+	TransactionID  string
+	TXHash         string
+	Symbol         string
+	TokenUsdRate   string
+	SupsUsdRate    string
+	ExchangeRateID string
+	CreatedAt      string
+}{
+	ID:             "purchase_exchange_rates.id",
+	TransactionID:  "purchase_exchange_rates.transaction_id",
+	TXHash:         "purchase_exchange_rates.tx_hash",
+	Symbol:         "purchase_exchange_rates.symbol",
+	TokenUsdRate:   "purchase_exchange_rates.token_usd_rate",
+	SupsUsdRate:    "purchase_exchange_rates.sups_usd_rate",
+	ExchangeRateID: "purchase_exchange_rates.exchange_rate_id",
+	CreatedAt:      "purchase_exchange_rates.created_at",
+}
+
+// Generated where
+
+var PurchaseExchangeRateWhere = struct {
+	ID             whereHelperstring
+	TransactionID  whereHelperstring
+	TXHash         whereHelperstring
+	Symbol         whereHelperstring
+	TokenUsdRate   whereHelperdecimal_NullDecimal
+	SupsUsdRate    whereHelperdecimal_Decimal
+	ExchangeRateID whereHelpernull_String
+	CreatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperstring{field: "\"purchase_exchange_rates\".\"id\""},
+	TransactionID:  whereHelperstring{field: "\"purchase_exchange_rates\".\"transaction_id\""},
+	TXHash:         whereHelperstring{field: "\"purchase_exchange_rates\".\"tx_hash\""},
+	Symbol:         whereHelperstring{field: "\"purchase_exchange_rates\".\"symbol\""},
+	TokenUsdRate:   whereHelperdecimal_NullDecimal{field: "\"purchase_exchange_rates\".\"token_usd_rate\""},
+	SupsUsdRate:    whereHelperdecimal_Decimal{field: "\"purchase_exchange_rates\".\"sups_usd_rate\""},
+	ExchangeRateID: whereHelpernull_String{field: "\"purchase_exchange_rates\".\"exchange_rate_id\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"purchase_exchange_rates\".\"created_at\""},
+}
+
+// PurchaseExchangeRateRels is where relationship names are stored.
+var PurchaseExchangeRateRels = struct {
+	ExchangeRate string
+}{
+	ExchangeRate: "ExchangeRate",
+}
+
+// purchaseExchangeRateR is where relationships are stored.
+type purchaseExchangeRateR struct {
+	ExchangeRate *ExchangeRate `boiler:"ExchangeRate" boil:"ExchangeRate" json:"ExchangeRate" toml:"ExchangeRate" yaml:"ExchangeRate"`
+}
+
+// NewStruct creates a new relationship struct
+func (*purchaseExchangeRateR) NewStruct() *purchaseExchangeRateR {
+	return &purchaseExchangeRateR{}
+}
+
+// purchaseExchangeRateL is where Load methods for each relationship are stored.
+type purchaseExchangeRateL struct{}
+
+var (
+	purchaseExchangeRateAllColumns            = []string{"id", "transaction_id", "tx_hash", "symbol", "token_usd_rate", "sups_usd_rate", "exchange_rate_id", "created_at"}
+	purchaseExchangeRateColumnsWithoutDefault = []string{"transaction_id", "tx_hash", "symbol", "sups_usd_rate"}
+	purchaseExchangeRateColumnsWithDefault    = []string{"id", "token_usd_rate", "exchange_rate_id", "created_at"}
+	purchaseExchangeRatePrimaryKeyColumns     = []string{"id"}
+	purchaseExchangeRateGeneratedColumns      = []string{}
+)
+
+type (
+	// PurchaseExchangeRateSlice is an alias for a slice of pointers to PurchaseExchangeRate.
+	// This should almost always be used instead of []PurchaseExchangeRate.
+	PurchaseExchangeRateSlice []*PurchaseExchangeRate
+	// PurchaseExchangeRateHook is the signature for custom PurchaseExchangeRate hook methods
+	PurchaseExchangeRateHook func(boil.Executor, *PurchaseExchangeRate) error
+
+	purchaseExchangeRateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	purchaseExchangeRateType                 = reflect.TypeOf(&PurchaseExchangeRate{})
+	purchaseExchangeRateMapping              = queries.MakeStructMapping(purchaseExchangeRateType)
+	purchaseExchangeRatePrimaryKeyMapping, _ = queries.BindMapping(purchaseExchangeRateType, purchaseExchangeRateMapping, purchaseExchangeRatePrimaryKeyColumns)
+	purchaseExchangeRateInsertCacheMut       sync.RWMutex
+	purchaseExchangeRateInsertCache          = make(map[string]insertCache)
+	purchaseExchangeRateUpdateCacheMut       sync.RWMutex
+	purchaseExchangeRateUpdateCache          = make(map[string]updateCache)
+	purchaseExchangeRateUpsertCacheMut       sync.RWMutex
+	purchaseExchangeRateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var purchaseExchangeRateAfterSelectHooks []PurchaseExchangeRateHook
+
+var purchaseExchangeRateBeforeInsertHooks []PurchaseExchangeRateHook
+var purchaseExchangeRateAfterInsertHooks []PurchaseExchangeRateHook
+
+var purchaseExchangeRateBeforeUpdateHooks []PurchaseExchangeRateHook
+var purchaseExchangeRateAfterUpdateHooks []PurchaseExchangeRateHook
+
+var purchaseExchangeRateBeforeDeleteHooks []PurchaseExchangeRateHook
+var purchaseExchangeRateAfterDeleteHooks []PurchaseExchangeRateHook
+
+var purchaseExchangeRateBeforeUpsertHooks []PurchaseExchangeRateHook
+var purchaseExchangeRateAfterUpsertHooks []PurchaseExchangeRateHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PurchaseExchangeRate) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range purchaseExchangeRateAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PurchaseExchangeRate) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range purchaseExchangeRateBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PurchaseExchangeRate) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range purchaseExchangeRateAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PurchaseExchangeRate) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range purchaseExchangeRateBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PurchaseExchangeRate) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range purchaseExchangeRateAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PurchaseExchangeRate) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range purchaseExchangeRateBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PurchaseExchangeRate) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range purchaseExchangeRateAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PurchaseExchangeRate) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range purchaseExchangeRateBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PurchaseExchangeRate) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range purchaseExchangeRateAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPurchaseExchangeRateHook registers your hook function for all future operations.
+func AddPurchaseExchangeRateHook(hookPoint boil.HookPoint, purchaseExchangeRateHook PurchaseExchangeRateHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		purchaseExchangeRateAfterSelectHooks = append(purchaseExchangeRateAfterSelectHooks, purchaseExchangeRateHook)
+	case boil.BeforeInsertHook:
+		purchaseExchangeRateBeforeInsertHooks = append(purchaseExchangeRateBeforeInsertHooks, purchaseExchangeRateHook)
+	case boil.AfterInsertHook:
+		purchaseExchangeRateAfterInsertHooks = append(purchaseExchangeRateAfterInsertHooks, purchaseExchangeRateHook)
+	case boil.BeforeUpdateHook:
+		purchaseExchangeRateBeforeUpdateHooks = append(purchaseExchangeRateBeforeUpdateHooks, purchaseExchangeRateHook)
+	case boil.AfterUpdateHook:
+		purchaseExchangeRateAfterUpdateHooks = append(purchaseExchangeRateAfterUpdateHooks, purchaseExchangeRateHook)
+	case boil.BeforeDeleteHook:
+		purchaseExchangeRateBeforeDeleteHooks = append(purchaseExchangeRateBeforeDeleteHooks, purchaseExchangeRateHook)
+	case boil.AfterDeleteHook:
+		purchaseExchangeRateAfterDeleteHooks = append(purchaseExchangeRateAfterDeleteHooks, purchaseExchangeRateHook)
+	case boil.BeforeUpsertHook:
+		purchaseExchangeRateBeforeUpsertHooks = append(purchaseExchangeRateBeforeUpsertHooks, purchaseExchangeRateHook)
+	case boil.AfterUpsertHook:
+		purchaseExchangeRateAfterUpsertHooks = append(purchaseExchangeRateAfterUpsertHooks, purchaseExchangeRateHook)
+	}
+}
+
+// One returns a single purchaseExchangeRate record from the query.
+func (q purchaseExchangeRateQuery) One(exec boil.Executor) (*PurchaseExchangeRate, error) {
+	o := &PurchaseExchangeRate{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for purchase_exchange_rates")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PurchaseExchangeRate records from the query.
+func (q purchaseExchangeRateQuery) All(exec boil.Executor) (PurchaseExchangeRateSlice, error) {
+	var o []*PurchaseExchangeRate
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to PurchaseExchangeRate slice")
+	}
+
+	if len(purchaseExchangeRateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PurchaseExchangeRate records in the query.
+func (q purchaseExchangeRateQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count purchase_exchange_rates rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q purchaseExchangeRateQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if purchase_exchange_rates exists")
+	}
+
+	return count > 0, nil
+}
+
+// ExchangeRate pointed to by the foreign key.
+func (o *PurchaseExchangeRate) ExchangeRate(mods ...qm.QueryMod) exchangeRateQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ExchangeRateID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := ExchangeRates(queryMods...)
+	queries.SetFrom(query.Query, "\"exchange_rates\"")
+
+	return query
+}
+
+// LoadExchangeRate allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (purchaseExchangeRateL) LoadExchangeRate(e boil.Executor, singular bool, maybePurchaseExchangeRate interface{}, mods queries.Applicator) error {
+	var slice []*PurchaseExchangeRate
+	var object *PurchaseExchangeRate
+
+	if singular {
+		object = maybePurchaseExchangeRate.(*PurchaseExchangeRate)
+	} else {
+		slice = *maybePurchaseExchangeRate.(*[]*PurchaseExchangeRate)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &purchaseExchangeRateR{}
+		}
+		if !queries.IsNil(object.ExchangeRateID) {
+			args = append(args, object.ExchangeRateID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &purchaseExchangeRateR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ExchangeRateID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ExchangeRateID) {
+				args = append(args, obj.ExchangeRateID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`exchange_rates`),
+		qm.WhereIn(`exchange_rates.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ExchangeRate")
+	}
+
+	var resultSlice []*ExchangeRate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ExchangeRate")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for exchange_rates")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for exchange_rates")
+	}
+
+	if len(purchaseExchangeRateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeRate = foreign
+		if foreign.R == nil {
+			foreign.R = &exchangeRateR{}
+		}
+		foreign.R.PurchaseExchangeRates = append(foreign.R.PurchaseExchangeRates, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ExchangeRateID, foreign.ID) {
+				local.R.ExchangeRate = foreign
+				if foreign.R == nil {
+					foreign.R = &exchangeRateR{}
+				}
+				foreign.R.PurchaseExchangeRates = append(foreign.R.PurchaseExchangeRates, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetExchangeRate of the purchaseExchangeRate to the related item.
+// Sets o.R.ExchangeRate to related.
+// Adds o to related.R.PurchaseExchangeRates.
+func (o *PurchaseExchangeRate) SetExchangeRate(exec boil.Executor, insert bool, related *ExchangeRate) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"purchase_exchange_rates\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_rate_id"}),
+		strmangle.WhereClause("\"", "\"", 2, purchaseExchangeRatePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ExchangeRateID, related.ID)
+	if o.R == nil {
+		o.R = &purchaseExchangeRateR{
+			ExchangeRate: related,
+		}
+	} else {
+		o.R.ExchangeRate = related
+	}
+
+	if related.R == nil {
+		related.R = &exchangeRateR{
+			PurchaseExchangeRates: PurchaseExchangeRateSlice{o},
+		}
+	} else {
+		related.R.PurchaseExchangeRates = append(related.R.PurchaseExchangeRates, o)
+	}
+
+	return nil
+}
+
+// RemoveExchangeRate relationship.
+// Sets o.R.ExchangeRate to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *PurchaseExchangeRate) RemoveExchangeRate(exec boil.Executor, related *ExchangeRate) error {
+	var err error
+
+	queries.SetScanner(&o.ExchangeRateID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("exchange_rate_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ExchangeRate = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.PurchaseExchangeRates {
+		if queries.Equal(o.ExchangeRateID, ri.ExchangeRateID) {
+			continue
+		}
+
+		ln := len(related.R.PurchaseExchangeRates)
+		if ln > 1 && i < ln-1 {
+			related.R.PurchaseExchangeRates[i] = related.R.PurchaseExchangeRates[ln-1]
+		}
+		related.R.PurchaseExchangeRates = related.R.PurchaseExchangeRates[:ln-1]
+		break
+	}
+	return nil
+}
+
+// PurchaseExchangeRates retrieves all the records using an executor.
+func PurchaseExchangeRates(mods ...qm.QueryMod) purchaseExchangeRateQuery {
+	mods = append(mods, qm.From("\"purchase_exchange_rates\""))
+	return purchaseExchangeRateQuery{NewQuery(mods...)}
+}
+
+// FindPurchaseExchangeRate retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPurchaseExchangeRate(exec boil.Executor, iD string, selectCols ...string) (*PurchaseExchangeRate, error) {
+	purchaseExchangeRateObj := &PurchaseExchangeRate{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"purchase_exchange_rates\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, purchaseExchangeRateObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from purchase_exchange_rates")
+	}
+
+	if err = purchaseExchangeRateObj.doAfterSelectHooks(exec); err != nil {
+		return purchaseExchangeRateObj, err
+	}
+
+	return purchaseExchangeRateObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PurchaseExchangeRate) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no purchase_exchange_rates provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(purchaseExchangeRateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	purchaseExchangeRateInsertCacheMut.RLock()
+	cache, cached := purchaseExchangeRateInsertCache[key]
+	purchaseExchangeRateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			purchaseExchangeRateAllColumns,
+			purchaseExchangeRateColumnsWithDefault,
+			purchaseExchangeRateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(purchaseExchangeRateType, purchaseExchangeRateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(purchaseExchangeRateType, purchaseExchangeRateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"purchase_exchange_rates\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"purchase_exchange_rates\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into purchase_exchange_rates")
+	}
+
+	if !cached {
+		purchaseExchangeRateInsertCacheMut.Lock()
+		purchaseExchangeRateInsertCache[key] = cache
+		purchaseExchangeRateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the PurchaseExchangeRate.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PurchaseExchangeRate) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	purchaseExchangeRateUpdateCacheMut.RLock()
+	cache, cached := purchaseExchangeRateUpdateCache[key]
+	purchaseExchangeRateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			purchaseExchangeRateAllColumns,
+			purchaseExchangeRatePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update purchase_exchange_rates, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"purchase_exchange_rates\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, purchaseExchangeRatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(purchaseExchangeRateType, purchaseExchangeRateMapping, append(wl, purchaseExchangeRatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update purchase_exchange_rates row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for purchase_exchange_rates")
+	}
+
+	if !cached {
+		purchaseExchangeRateUpdateCacheMut.Lock()
+		purchaseExchangeRateUpdateCache[key] = cache
+		purchaseExchangeRateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q purchaseExchangeRateQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for purchase_exchange_rates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for purchase_exchange_rates")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PurchaseExchangeRateSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), purchaseExchangeRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"purchase_exchange_rates\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, purchaseExchangeRatePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in purchaseExchangeRate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all purchaseExchangeRate")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PurchaseExchangeRate) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no purchase_exchange_rates provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(purchaseExchangeRateColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	purchaseExchangeRateUpsertCacheMut.RLock()
+	cache, cached := purchaseExchangeRateUpsertCache[key]
+	purchaseExchangeRateUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			purchaseExchangeRateAllColumns,
+			purchaseExchangeRateColumnsWithDefault,
+			purchaseExchangeRateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			purchaseExchangeRateAllColumns,
+			purchaseExchangeRatePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert purchase_exchange_rates, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(purchaseExchangeRatePrimaryKeyColumns))
+			copy(conflict, purchaseExchangeRatePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"purchase_exchange_rates\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(purchaseExchangeRateType, purchaseExchangeRateMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(purchaseExchangeRateType, purchaseExchangeRateMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert purchase_exchange_rates")
+	}
+
+	if !cached {
+		purchaseExchangeRateUpsertCacheMut.Lock()
+		purchaseExchangeRateUpsertCache[key] = cache
+		purchaseExchangeRateUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single PurchaseExchangeRate record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PurchaseExchangeRate) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no PurchaseExchangeRate provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), purchaseExchangeRatePrimaryKeyMapping)
+	sql := "DELETE FROM \"purchase_exchange_rates\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from purchase_exchange_rates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for purchase_exchange_rates")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q purchaseExchangeRateQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no purchaseExchangeRateQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from purchase_exchange_rates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for purchase_exchange_rates")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PurchaseExchangeRateSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(purchaseExchangeRateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), purchaseExchangeRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"purchase_exchange_rates\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, purchaseExchangeRatePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from purchaseExchangeRate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for purchase_exchange_rates")
+	}
+
+	if len(purchaseExchangeRateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PurchaseExchangeRate) Reload(exec boil.Executor) error {
+	ret, err := FindPurchaseExchangeRate(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PurchaseExchangeRateSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PurchaseExchangeRateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), purchaseExchangeRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"purchase_exchange_rates\".* FROM \"purchase_exchange_rates\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, purchaseExchangeRatePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in PurchaseExchangeRateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PurchaseExchangeRateExists checks if the PurchaseExchangeRate row exists.
+func PurchaseExchangeRateExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"purchase_exchange_rates\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if purchase_exchange_rates exists")
+	}
+
+	return exists, nil
+}
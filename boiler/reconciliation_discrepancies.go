@@ -0,0 +1,1479 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ReconciliationDiscrepancy is an object representing the database table.
+type ReconciliationDiscrepancy struct {
+	ID              string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	RunID           string      `boiler:"run_id" boil:"run_id" json:"run_id" toml:"run_id" yaml:"run_id"`
+	UserAssetID     string      `boiler:"user_asset_id" boil:"user_asset_id" json:"user_asset_id" toml:"user_asset_id" yaml:"user_asset_id"`
+	AssetHash       string      `boiler:"asset_hash" boil:"asset_hash" json:"asset_hash" toml:"asset_hash" yaml:"asset_hash"`
+	Kind            string      `boiler:"kind" boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	PassportValue   string      `boiler:"passport_value" boil:"passport_value" json:"passport_value" toml:"passport_value" yaml:"passport_value"`
+	GameserverValue string      `boiler:"gameserver_value" boil:"gameserver_value" json:"gameserver_value" toml:"gameserver_value" yaml:"gameserver_value"`
+	SuggestedFix    string      `boiler:"suggested_fix" boil:"suggested_fix" json:"suggested_fix" toml:"suggested_fix" yaml:"suggested_fix"`
+	Status          string      `boiler:"status" boil:"status" json:"status" toml:"status" yaml:"status"`
+	AppliedFix      null.String `boiler:"applied_fix" boil:"applied_fix" json:"applied_fix,omitempty" toml:"applied_fix" yaml:"applied_fix,omitempty"`
+	RepairError     null.String `boiler:"repair_error" boil:"repair_error" json:"repair_error,omitempty" toml:"repair_error" yaml:"repair_error,omitempty"`
+	ResolvedByID    null.String `boiler:"resolved_by_id" boil:"resolved_by_id" json:"resolved_by_id,omitempty" toml:"resolved_by_id" yaml:"resolved_by_id,omitempty"`
+	ResolvedAt      null.Time   `boiler:"resolved_at" boil:"resolved_at" json:"resolved_at,omitempty" toml:"resolved_at" yaml:"resolved_at,omitempty"`
+	CreatedAt       time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *reconciliationDiscrepancyR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L reconciliationDiscrepancyL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReconciliationDiscrepancyColumns = struct {
+	ID              string
+	RunID           string
+	UserAssetID     string
+	AssetHash       string
+	Kind            string
+	PassportValue   string
+	GameserverValue string
+	SuggestedFix    string
+	Status          string
+	AppliedFix      string
+	RepairError     string
+	ResolvedByID    string
+	ResolvedAt      string
+	CreatedAt       string
+}{
+	ID:              "id",
+	RunID:           "run_id",
+	UserAssetID:     "user_asset_id",
+	AssetHash:       "asset_hash",
+	Kind:            "kind",
+	PassportValue:   "passport_value",
+	GameserverValue: "gameserver_value",
+	SuggestedFix:    "suggested_fix",
+	Status:          "status",
+	AppliedFix:      "applied_fix",
+	RepairError:     "repair_error",
+	ResolvedByID:    "resolved_by_id",
+	ResolvedAt:      "resolved_at",
+	CreatedAt:       "created_at",
+}
+
+var ReconciliationDiscrepancyTableColumns = struct {
+	ID              string
+	RunID           string
+	UserAssetID     string
+	AssetHash       string
+	Kind            string
+	PassportValue   string
+	GameserverValue string
+	SuggestedFix    string
+	Status          string
+	AppliedFix      string
+	RepairError     string
+	ResolvedByID    string
+	ResolvedAt      string
+	CreatedAt       string
+}{
+	ID:              "reconciliation_discrepancies.id",
+	RunID:           "reconciliation_discrepancies.run_id",
+	UserAssetID:     "reconciliation_discrepancies.user_asset_id",
+	AssetHash:       "reconciliation_discrepancies.asset_hash",
+	Kind:            "reconciliation_discrepancies.kind",
+	PassportValue:   "reconciliation_discrepancies.passport_value",
+	GameserverValue: "reconciliation_discrepancies.gameserver_value",
+	SuggestedFix:    "reconciliation_discrepancies.suggested_fix",
+	Status:          "reconciliation_discrepancies.status",
+	AppliedFix:      "reconciliation_discrepancies.applied_fix",
+	RepairError:     "reconciliation_discrepancies.repair_error",
+	ResolvedByID:    "reconciliation_discrepancies.resolved_by_id",
+	ResolvedAt:      "reconciliation_discrepancies.resolved_at",
+	CreatedAt:       "reconciliation_discrepancies.created_at",
+}
+
+// Generated where
+
+var ReconciliationDiscrepancyWhere = struct {
+	ID              whereHelperstring
+	RunID           whereHelperstring
+	UserAssetID     whereHelperstring
+	AssetHash       whereHelperstring
+	Kind            whereHelperstring
+	PassportValue   whereHelperstring
+	GameserverValue whereHelperstring
+	SuggestedFix    whereHelperstring
+	Status          whereHelperstring
+	AppliedFix      whereHelpernull_String
+	RepairError     whereHelpernull_String
+	ResolvedByID    whereHelpernull_String
+	ResolvedAt      whereHelpernull_Time
+	CreatedAt       whereHelpertime_Time
+}{
+	ID:              whereHelperstring{field: "\"reconciliation_discrepancies\".\"id\""},
+	RunID:           whereHelperstring{field: "\"reconciliation_discrepancies\".\"run_id\""},
+	UserAssetID:     whereHelperstring{field: "\"reconciliation_discrepancies\".\"user_asset_id\""},
+	AssetHash:       whereHelperstring{field: "\"reconciliation_discrepancies\".\"asset_hash\""},
+	Kind:            whereHelperstring{field: "\"reconciliation_discrepancies\".\"kind\""},
+	PassportValue:   whereHelperstring{field: "\"reconciliation_discrepancies\".\"passport_value\""},
+	GameserverValue: whereHelperstring{field: "\"reconciliation_discrepancies\".\"gameserver_value\""},
+	SuggestedFix:    whereHelperstring{field: "\"reconciliation_discrepancies\".\"suggested_fix\""},
+	Status:          whereHelperstring{field: "\"reconciliation_discrepancies\".\"status\""},
+	AppliedFix:      whereHelpernull_String{field: "\"reconciliation_discrepancies\".\"applied_fix\""},
+	RepairError:     whereHelpernull_String{field: "\"reconciliation_discrepancies\".\"repair_error\""},
+	ResolvedByID:    whereHelpernull_String{field: "\"reconciliation_discrepancies\".\"resolved_by_id\""},
+	ResolvedAt:      whereHelpernull_Time{field: "\"reconciliation_discrepancies\".\"resolved_at\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"reconciliation_discrepancies\".\"created_at\""},
+}
+
+// ReconciliationDiscrepancyRels is where relationship names are stored.
+var ReconciliationDiscrepancyRels = struct {
+	ResolvedBy string
+	Run        string
+	UserAsset  string
+}{
+	ResolvedBy: "ResolvedBy",
+	Run:        "Run",
+	UserAsset:  "UserAsset",
+}
+
+// reconciliationDiscrepancyR is where relationships are stored.
+type reconciliationDiscrepancyR struct {
+	ResolvedBy *User              `boiler:"ResolvedBy" boil:"ResolvedBy" json:"ResolvedBy" toml:"ResolvedBy" yaml:"ResolvedBy"`
+	Run        *ReconciliationRun `boiler:"Run" boil:"Run" json:"Run" toml:"Run" yaml:"Run"`
+	UserAsset  *UserAsset         `boiler:"UserAsset" boil:"UserAsset" json:"UserAsset" toml:"UserAsset" yaml:"UserAsset"`
+}
+
+// NewStruct creates a new relationship struct
+func (*reconciliationDiscrepancyR) NewStruct() *reconciliationDiscrepancyR {
+	return &reconciliationDiscrepancyR{}
+}
+
+// reconciliationDiscrepancyL is where Load methods for each relationship are stored.
+type reconciliationDiscrepancyL struct{}
+
+var (
+	reconciliationDiscrepancyAllColumns            = []string{"id", "run_id", "user_asset_id", "asset_hash", "kind", "passport_value", "gameserver_value", "suggested_fix", "status", "applied_fix", "repair_error", "resolved_by_id", "resolved_at", "created_at"}
+	reconciliationDiscrepancyColumnsWithoutDefault = []string{"run_id", "user_asset_id", "asset_hash", "kind", "suggested_fix"}
+	reconciliationDiscrepancyColumnsWithDefault    = []string{"id", "passport_value", "gameserver_value", "status", "applied_fix", "repair_error", "resolved_by_id", "resolved_at", "created_at"}
+	reconciliationDiscrepancyPrimaryKeyColumns     = []string{"id"}
+	reconciliationDiscrepancyGeneratedColumns      = []string{}
+)
+
+type (
+	// ReconciliationDiscrepancySlice is an alias for a slice of pointers to ReconciliationDiscrepancy.
+	// This should almost always be used instead of []ReconciliationDiscrepancy.
+	ReconciliationDiscrepancySlice []*ReconciliationDiscrepancy
+	// ReconciliationDiscrepancyHook is the signature for custom ReconciliationDiscrepancy hook methods
+	ReconciliationDiscrepancyHook func(boil.Executor, *ReconciliationDiscrepancy) error
+
+	reconciliationDiscrepancyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	reconciliationDiscrepancyType                 = reflect.TypeOf(&ReconciliationDiscrepancy{})
+	reconciliationDiscrepancyMapping              = queries.MakeStructMapping(reconciliationDiscrepancyType)
+	reconciliationDiscrepancyPrimaryKeyMapping, _ = queries.BindMapping(reconciliationDiscrepancyType, reconciliationDiscrepancyMapping, reconciliationDiscrepancyPrimaryKeyColumns)
+	reconciliationDiscrepancyInsertCacheMut       sync.RWMutex
+	reconciliationDiscrepancyInsertCache          = make(map[string]insertCache)
+	reconciliationDiscrepancyUpdateCacheMut       sync.RWMutex
+	reconciliationDiscrepancyUpdateCache          = make(map[string]updateCache)
+	reconciliationDiscrepancyUpsertCacheMut       sync.RWMutex
+	reconciliationDiscrepancyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var reconciliationDiscrepancyAfterSelectHooks []ReconciliationDiscrepancyHook
+
+var reconciliationDiscrepancyBeforeInsertHooks []ReconciliationDiscrepancyHook
+var reconciliationDiscrepancyAfterInsertHooks []ReconciliationDiscrepancyHook
+
+var reconciliationDiscrepancyBeforeUpdateHooks []ReconciliationDiscrepancyHook
+var reconciliationDiscrepancyAfterUpdateHooks []ReconciliationDiscrepancyHook
+
+var reconciliationDiscrepancyBeforeDeleteHooks []ReconciliationDiscrepancyHook
+var reconciliationDiscrepancyAfterDeleteHooks []ReconciliationDiscrepancyHook
+
+var reconciliationDiscrepancyBeforeUpsertHooks []ReconciliationDiscrepancyHook
+var reconciliationDiscrepancyAfterUpsertHooks []ReconciliationDiscrepancyHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ReconciliationDiscrepancy) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationDiscrepancyAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ReconciliationDiscrepancy) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationDiscrepancyBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ReconciliationDiscrepancy) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationDiscrepancyAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ReconciliationDiscrepancy) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationDiscrepancyBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ReconciliationDiscrepancy) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationDiscrepancyAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ReconciliationDiscrepancy) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationDiscrepancyBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ReconciliationDiscrepancy) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationDiscrepancyAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ReconciliationDiscrepancy) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationDiscrepancyBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ReconciliationDiscrepancy) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationDiscrepancyAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddReconciliationDiscrepancyHook registers your hook function for all future operations.
+func AddReconciliationDiscrepancyHook(hookPoint boil.HookPoint, reconciliationDiscrepancyHook ReconciliationDiscrepancyHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		reconciliationDiscrepancyAfterSelectHooks = append(reconciliationDiscrepancyAfterSelectHooks, reconciliationDiscrepancyHook)
+	case boil.BeforeInsertHook:
+		reconciliationDiscrepancyBeforeInsertHooks = append(reconciliationDiscrepancyBeforeInsertHooks, reconciliationDiscrepancyHook)
+	case boil.AfterInsertHook:
+		reconciliationDiscrepancyAfterInsertHooks = append(reconciliationDiscrepancyAfterInsertHooks, reconciliationDiscrepancyHook)
+	case boil.BeforeUpdateHook:
+		reconciliationDiscrepancyBeforeUpdateHooks = append(reconciliationDiscrepancyBeforeUpdateHooks, reconciliationDiscrepancyHook)
+	case boil.AfterUpdateHook:
+		reconciliationDiscrepancyAfterUpdateHooks = append(reconciliationDiscrepancyAfterUpdateHooks, reconciliationDiscrepancyHook)
+	case boil.BeforeDeleteHook:
+		reconciliationDiscrepancyBeforeDeleteHooks = append(reconciliationDiscrepancyBeforeDeleteHooks, reconciliationDiscrepancyHook)
+	case boil.AfterDeleteHook:
+		reconciliationDiscrepancyAfterDeleteHooks = append(reconciliationDiscrepancyAfterDeleteHooks, reconciliationDiscrepancyHook)
+	case boil.BeforeUpsertHook:
+		reconciliationDiscrepancyBeforeUpsertHooks = append(reconciliationDiscrepancyBeforeUpsertHooks, reconciliationDiscrepancyHook)
+	case boil.AfterUpsertHook:
+		reconciliationDiscrepancyAfterUpsertHooks = append(reconciliationDiscrepancyAfterUpsertHooks, reconciliationDiscrepancyHook)
+	}
+}
+
+// One returns a single reconciliationDiscrepancy record from the query.
+func (q reconciliationDiscrepancyQuery) One(exec boil.Executor) (*ReconciliationDiscrepancy, error) {
+	o := &ReconciliationDiscrepancy{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for reconciliation_discrepancies")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ReconciliationDiscrepancy records from the query.
+func (q reconciliationDiscrepancyQuery) All(exec boil.Executor) (ReconciliationDiscrepancySlice, error) {
+	var o []*ReconciliationDiscrepancy
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to ReconciliationDiscrepancy slice")
+	}
+
+	if len(reconciliationDiscrepancyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ReconciliationDiscrepancy records in the query.
+func (q reconciliationDiscrepancyQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count reconciliation_discrepancies rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q reconciliationDiscrepancyQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if reconciliation_discrepancies exists")
+	}
+
+	return count > 0, nil
+}
+
+// ResolvedBy pointed to by the foreign key.
+func (o *ReconciliationDiscrepancy) ResolvedBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ResolvedByID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// Run pointed to by the foreign key.
+func (o *ReconciliationDiscrepancy) Run(mods ...qm.QueryMod) reconciliationRunQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RunID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := ReconciliationRuns(queryMods...)
+	queries.SetFrom(query.Query, "\"reconciliation_runs\"")
+
+	return query
+}
+
+// UserAsset pointed to by the foreign key.
+func (o *ReconciliationDiscrepancy) UserAsset(mods ...qm.QueryMod) userAssetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserAssetID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := UserAssets(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets\"")
+
+	return query
+}
+
+// LoadResolvedBy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reconciliationDiscrepancyL) LoadResolvedBy(e boil.Executor, singular bool, maybeReconciliationDiscrepancy interface{}, mods queries.Applicator) error {
+	var slice []*ReconciliationDiscrepancy
+	var object *ReconciliationDiscrepancy
+
+	if singular {
+		object = maybeReconciliationDiscrepancy.(*ReconciliationDiscrepancy)
+	} else {
+		slice = *maybeReconciliationDiscrepancy.(*[]*ReconciliationDiscrepancy)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &reconciliationDiscrepancyR{}
+		}
+		if !queries.IsNil(object.ResolvedByID) {
+			args = append(args, object.ResolvedByID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reconciliationDiscrepancyR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ResolvedByID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ResolvedByID) {
+				args = append(args, obj.ResolvedByID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(reconciliationDiscrepancyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ResolvedBy = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ResolvedByReconciliationDiscrepancies = append(foreign.R.ResolvedByReconciliationDiscrepancies, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ResolvedByID, foreign.ID) {
+				local.R.ResolvedBy = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ResolvedByReconciliationDiscrepancies = append(foreign.R.ResolvedByReconciliationDiscrepancies, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRun allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reconciliationDiscrepancyL) LoadRun(e boil.Executor, singular bool, maybeReconciliationDiscrepancy interface{}, mods queries.Applicator) error {
+	var slice []*ReconciliationDiscrepancy
+	var object *ReconciliationDiscrepancy
+
+	if singular {
+		object = maybeReconciliationDiscrepancy.(*ReconciliationDiscrepancy)
+	} else {
+		slice = *maybeReconciliationDiscrepancy.(*[]*ReconciliationDiscrepancy)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &reconciliationDiscrepancyR{}
+		}
+		args = append(args, object.RunID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reconciliationDiscrepancyR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RunID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RunID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`reconciliation_runs`),
+		qm.WhereIn(`reconciliation_runs.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ReconciliationRun")
+	}
+
+	var resultSlice []*ReconciliationRun
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ReconciliationRun")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for reconciliation_runs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reconciliation_runs")
+	}
+
+	if len(reconciliationDiscrepancyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Run = foreign
+		if foreign.R == nil {
+			foreign.R = &reconciliationRunR{}
+		}
+		foreign.R.RunReconciliationDiscrepancies = append(foreign.R.RunReconciliationDiscrepancies, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RunID == foreign.ID {
+				local.R.Run = foreign
+				if foreign.R == nil {
+					foreign.R = &reconciliationRunR{}
+				}
+				foreign.R.RunReconciliationDiscrepancies = append(foreign.R.RunReconciliationDiscrepancies, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAsset allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reconciliationDiscrepancyL) LoadUserAsset(e boil.Executor, singular bool, maybeReconciliationDiscrepancy interface{}, mods queries.Applicator) error {
+	var slice []*ReconciliationDiscrepancy
+	var object *ReconciliationDiscrepancy
+
+	if singular {
+		object = maybeReconciliationDiscrepancy.(*ReconciliationDiscrepancy)
+	} else {
+		slice = *maybeReconciliationDiscrepancy.(*[]*ReconciliationDiscrepancy)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &reconciliationDiscrepancyR{}
+		}
+		args = append(args, object.UserAssetID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reconciliationDiscrepancyR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserAssetID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserAssetID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets`),
+		qm.WhereIn(`user_assets.id in ?`, args...),
+		qmhelper.WhereIsNull(`user_assets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserAsset")
+	}
+
+	var resultSlice []*UserAsset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserAsset")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_assets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
+	}
+
+	if len(reconciliationDiscrepancyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserAsset = foreign
+		if foreign.R == nil {
+			foreign.R = &userAssetR{}
+		}
+		foreign.R.ReconciliationDiscrepancies = append(foreign.R.ReconciliationDiscrepancies, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserAssetID == foreign.ID {
+				local.R.UserAsset = foreign
+				if foreign.R == nil {
+					foreign.R = &userAssetR{}
+				}
+				foreign.R.ReconciliationDiscrepancies = append(foreign.R.ReconciliationDiscrepancies, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetResolvedBy of the reconciliationDiscrepancy to the related item.
+// Sets o.R.ResolvedBy to related.
+// Adds o to related.R.ResolvedByReconciliationDiscrepancies.
+func (o *ReconciliationDiscrepancy) SetResolvedBy(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"reconciliation_discrepancies\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"resolved_by_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reconciliationDiscrepancyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ResolvedByID, related.ID)
+	if o.R == nil {
+		o.R = &reconciliationDiscrepancyR{
+			ResolvedBy: related,
+		}
+	} else {
+		o.R.ResolvedBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ResolvedByReconciliationDiscrepancies: ReconciliationDiscrepancySlice{o},
+		}
+	} else {
+		related.R.ResolvedByReconciliationDiscrepancies = append(related.R.ResolvedByReconciliationDiscrepancies, o)
+	}
+
+	return nil
+}
+
+// RemoveResolvedBy relationship.
+// Sets o.R.ResolvedBy to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *ReconciliationDiscrepancy) RemoveResolvedBy(exec boil.Executor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ResolvedByID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("resolved_by_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ResolvedBy = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ResolvedByReconciliationDiscrepancies {
+		if queries.Equal(o.ResolvedByID, ri.ResolvedByID) {
+			continue
+		}
+
+		ln := len(related.R.ResolvedByReconciliationDiscrepancies)
+		if ln > 1 && i < ln-1 {
+			related.R.ResolvedByReconciliationDiscrepancies[i] = related.R.ResolvedByReconciliationDiscrepancies[ln-1]
+		}
+		related.R.ResolvedByReconciliationDiscrepancies = related.R.ResolvedByReconciliationDiscrepancies[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetRun of the reconciliationDiscrepancy to the related item.
+// Sets o.R.Run to related.
+// Adds o to related.R.RunReconciliationDiscrepancies.
+func (o *ReconciliationDiscrepancy) SetRun(exec boil.Executor, insert bool, related *ReconciliationRun) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"reconciliation_discrepancies\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reconciliationDiscrepancyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RunID = related.ID
+	if o.R == nil {
+		o.R = &reconciliationDiscrepancyR{
+			Run: related,
+		}
+	} else {
+		o.R.Run = related
+	}
+
+	if related.R == nil {
+		related.R = &reconciliationRunR{
+			RunReconciliationDiscrepancies: ReconciliationDiscrepancySlice{o},
+		}
+	} else {
+		related.R.RunReconciliationDiscrepancies = append(related.R.RunReconciliationDiscrepancies, o)
+	}
+
+	return nil
+}
+
+// SetUserAsset of the reconciliationDiscrepancy to the related item.
+// Sets o.R.UserAsset to related.
+// Adds o to related.R.ReconciliationDiscrepancies.
+func (o *ReconciliationDiscrepancy) SetUserAsset(exec boil.Executor, insert bool, related *UserAsset) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"reconciliation_discrepancies\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reconciliationDiscrepancyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserAssetID = related.ID
+	if o.R == nil {
+		o.R = &reconciliationDiscrepancyR{
+			UserAsset: related,
+		}
+	} else {
+		o.R.UserAsset = related
+	}
+
+	if related.R == nil {
+		related.R = &userAssetR{
+			ReconciliationDiscrepancies: ReconciliationDiscrepancySlice{o},
+		}
+	} else {
+		related.R.ReconciliationDiscrepancies = append(related.R.ReconciliationDiscrepancies, o)
+	}
+
+	return nil
+}
+
+// ReconciliationDiscrepancies retrieves all the records using an executor.
+func ReconciliationDiscrepancies(mods ...qm.QueryMod) reconciliationDiscrepancyQuery {
+	mods = append(mods, qm.From("\"reconciliation_discrepancies\""))
+	return reconciliationDiscrepancyQuery{NewQuery(mods...)}
+}
+
+// FindReconciliationDiscrepancy retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReconciliationDiscrepancy(exec boil.Executor, iD string, selectCols ...string) (*ReconciliationDiscrepancy, error) {
+	reconciliationDiscrepancyObj := &ReconciliationDiscrepancy{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"reconciliation_discrepancies\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, reconciliationDiscrepancyObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from reconciliation_discrepancies")
+	}
+
+	if err = reconciliationDiscrepancyObj.doAfterSelectHooks(exec); err != nil {
+		return reconciliationDiscrepancyObj, err
+	}
+
+	return reconciliationDiscrepancyObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ReconciliationDiscrepancy) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no reconciliation_discrepancies provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reconciliationDiscrepancyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	reconciliationDiscrepancyInsertCacheMut.RLock()
+	cache, cached := reconciliationDiscrepancyInsertCache[key]
+	reconciliationDiscrepancyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			reconciliationDiscrepancyAllColumns,
+			reconciliationDiscrepancyColumnsWithDefault,
+			reconciliationDiscrepancyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(reconciliationDiscrepancyType, reconciliationDiscrepancyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(reconciliationDiscrepancyType, reconciliationDiscrepancyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"reconciliation_discrepancies\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"reconciliation_discrepancies\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into reconciliation_discrepancies")
+	}
+
+	if !cached {
+		reconciliationDiscrepancyInsertCacheMut.Lock()
+		reconciliationDiscrepancyInsertCache[key] = cache
+		reconciliationDiscrepancyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the ReconciliationDiscrepancy.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ReconciliationDiscrepancy) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	reconciliationDiscrepancyUpdateCacheMut.RLock()
+	cache, cached := reconciliationDiscrepancyUpdateCache[key]
+	reconciliationDiscrepancyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			reconciliationDiscrepancyAllColumns,
+			reconciliationDiscrepancyPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update reconciliation_discrepancies, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"reconciliation_discrepancies\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, reconciliationDiscrepancyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(reconciliationDiscrepancyType, reconciliationDiscrepancyMapping, append(wl, reconciliationDiscrepancyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update reconciliation_discrepancies row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for reconciliation_discrepancies")
+	}
+
+	if !cached {
+		reconciliationDiscrepancyUpdateCacheMut.Lock()
+		reconciliationDiscrepancyUpdateCache[key] = cache
+		reconciliationDiscrepancyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q reconciliationDiscrepancyQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for reconciliation_discrepancies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for reconciliation_discrepancies")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReconciliationDiscrepancySlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reconciliationDiscrepancyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"reconciliation_discrepancies\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, reconciliationDiscrepancyPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in reconciliationDiscrepancy slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all reconciliationDiscrepancy")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ReconciliationDiscrepancy) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no reconciliation_discrepancies provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reconciliationDiscrepancyColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	reconciliationDiscrepancyUpsertCacheMut.RLock()
+	cache, cached := reconciliationDiscrepancyUpsertCache[key]
+	reconciliationDiscrepancyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			reconciliationDiscrepancyAllColumns,
+			reconciliationDiscrepancyColumnsWithDefault,
+			reconciliationDiscrepancyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			reconciliationDiscrepancyAllColumns,
+			reconciliationDiscrepancyPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert reconciliation_discrepancies, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(reconciliationDiscrepancyPrimaryKeyColumns))
+			copy(conflict, reconciliationDiscrepancyPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"reconciliation_discrepancies\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(reconciliationDiscrepancyType, reconciliationDiscrepancyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(reconciliationDiscrepancyType, reconciliationDiscrepancyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert reconciliation_discrepancies")
+	}
+
+	if !cached {
+		reconciliationDiscrepancyUpsertCacheMut.Lock()
+		reconciliationDiscrepancyUpsertCache[key] = cache
+		reconciliationDiscrepancyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single ReconciliationDiscrepancy record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ReconciliationDiscrepancy) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no ReconciliationDiscrepancy provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), reconciliationDiscrepancyPrimaryKeyMapping)
+	sql := "DELETE FROM \"reconciliation_discrepancies\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from reconciliation_discrepancies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for reconciliation_discrepancies")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q reconciliationDiscrepancyQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no reconciliationDiscrepancyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from reconciliation_discrepancies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for reconciliation_discrepancies")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReconciliationDiscrepancySlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(reconciliationDiscrepancyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reconciliationDiscrepancyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"reconciliation_discrepancies\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reconciliationDiscrepancyPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from reconciliationDiscrepancy slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for reconciliation_discrepancies")
+	}
+
+	if len(reconciliationDiscrepancyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ReconciliationDiscrepancy) Reload(exec boil.Executor) error {
+	ret, err := FindReconciliationDiscrepancy(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReconciliationDiscrepancySlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReconciliationDiscrepancySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reconciliationDiscrepancyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"reconciliation_discrepancies\".* FROM \"reconciliation_discrepancies\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reconciliationDiscrepancyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in ReconciliationDiscrepancySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReconciliationDiscrepancyExists checks if the ReconciliationDiscrepancy row exists.
+func ReconciliationDiscrepancyExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"reconciliation_discrepancies\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if reconciliation_discrepancies exists")
+	}
+
+	return exists, nil
+}
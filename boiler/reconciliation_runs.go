@@ -0,0 +1,1260 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// ReconciliationRun is an object representing the database table.
+type ReconciliationRun struct {
+	ID               string            `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	CollectionIds    types.StringArray `boiler:"collection_ids" boil:"collection_ids" json:"collection_ids" toml:"collection_ids" yaml:"collection_ids"`
+	Status           string            `boiler:"status" boil:"status" json:"status" toml:"status" yaml:"status"`
+	LastHash         string            `boiler:"last_hash" boil:"last_hash" json:"last_hash" toml:"last_hash" yaml:"last_hash"`
+	CheckedCount     int               `boiler:"checked_count" boil:"checked_count" json:"checked_count" toml:"checked_count" yaml:"checked_count"`
+	DiscrepancyCount int               `boiler:"discrepancy_count" boil:"discrepancy_count" json:"discrepancy_count" toml:"discrepancy_count" yaml:"discrepancy_count"`
+	Error            null.String       `boiler:"error" boil:"error" json:"error,omitempty" toml:"error" yaml:"error,omitempty"`
+	CreatedByID      string            `boiler:"created_by_id" boil:"created_by_id" json:"created_by_id" toml:"created_by_id" yaml:"created_by_id"`
+	StartedAt        null.Time         `boiler:"started_at" boil:"started_at" json:"started_at,omitempty" toml:"started_at" yaml:"started_at,omitempty"`
+	CompletedAt      null.Time         `boiler:"completed_at" boil:"completed_at" json:"completed_at,omitempty" toml:"completed_at" yaml:"completed_at,omitempty"`
+	CreatedAt        time.Time         `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *reconciliationRunR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L reconciliationRunL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReconciliationRunColumns = struct {
+	ID               string
+	CollectionIds    string
+	Status           string
+	LastHash         string
+	CheckedCount     string
+	DiscrepancyCount string
+	Error            string
+	CreatedByID      string
+	StartedAt        string
+	CompletedAt      string
+	CreatedAt        string
+}{
+	ID:               "id",
+	CollectionIds:    "collection_ids",
+	Status:           "status",
+	LastHash:         "last_hash",
+	CheckedCount:     "checked_count",
+	DiscrepancyCount: "discrepancy_count",
+	Error:            "error",
+	CreatedByID:      "created_by_id",
+	StartedAt:        "started_at",
+	CompletedAt:      "completed_at",
+	CreatedAt:        "created_at",
+}
+
+var ReconciliationRunTableColumns = struct {
+	ID               string
+	CollectionIds    string
+	Status           string
+	LastHash         string
+	CheckedCount     string
+	DiscrepancyCount string
+	Error            string
+	CreatedByID      string
+	StartedAt        string
+	CompletedAt      string
+	CreatedAt        string
+}{
+	ID:               "reconciliation_runs.id",
+	CollectionIds:    "reconciliation_runs.collection_ids",
+	Status:           "reconciliation_runs.status",
+	LastHash:         "reconciliation_runs.last_hash",
+	CheckedCount:     "reconciliation_runs.checked_count",
+	DiscrepancyCount: "reconciliation_runs.discrepancy_count",
+	Error:            "reconciliation_runs.error",
+	CreatedByID:      "reconciliation_runs.created_by_id",
+	StartedAt:        "reconciliation_runs.started_at",
+	CompletedAt:      "reconciliation_runs.completed_at",
+	CreatedAt:        "reconciliation_runs.created_at",
+}
+
+// Generated where
+
+var ReconciliationRunWhere = struct {
+	ID               whereHelperstring
+	CollectionIds    whereHelpertypes_StringArray
+	Status           whereHelperstring
+	LastHash         whereHelperstring
+	CheckedCount     whereHelperint
+	DiscrepancyCount whereHelperint
+	Error            whereHelpernull_String
+	CreatedByID      whereHelperstring
+	StartedAt        whereHelpernull_Time
+	CompletedAt      whereHelpernull_Time
+	CreatedAt        whereHelpertime_Time
+}{
+	ID:               whereHelperstring{field: "\"reconciliation_runs\".\"id\""},
+	CollectionIds:    whereHelpertypes_StringArray{field: "\"reconciliation_runs\".\"collection_ids\""},
+	Status:           whereHelperstring{field: "\"reconciliation_runs\".\"status\""},
+	LastHash:         whereHelperstring{field: "\"reconciliation_runs\".\"last_hash\""},
+	CheckedCount:     whereHelperint{field: "\"reconciliation_runs\".\"checked_count\""},
+	DiscrepancyCount: whereHelperint{field: "\"reconciliation_runs\".\"discrepancy_count\""},
+	Error:            whereHelpernull_String{field: "\"reconciliation_runs\".\"error\""},
+	CreatedByID:      whereHelperstring{field: "\"reconciliation_runs\".\"created_by_id\""},
+	StartedAt:        whereHelpernull_Time{field: "\"reconciliation_runs\".\"started_at\""},
+	CompletedAt:      whereHelpernull_Time{field: "\"reconciliation_runs\".\"completed_at\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"reconciliation_runs\".\"created_at\""},
+}
+
+// ReconciliationRunRels is where relationship names are stored.
+var ReconciliationRunRels = struct {
+	CreatedBy                      string
+	RunReconciliationDiscrepancies string
+}{
+	CreatedBy:                      "CreatedBy",
+	RunReconciliationDiscrepancies: "RunReconciliationDiscrepancies",
+}
+
+// reconciliationRunR is where relationships are stored.
+type reconciliationRunR struct {
+	CreatedBy                      *User                          `boiler:"CreatedBy" boil:"CreatedBy" json:"CreatedBy" toml:"CreatedBy" yaml:"CreatedBy"`
+	RunReconciliationDiscrepancies ReconciliationDiscrepancySlice `boiler:"RunReconciliationDiscrepancies" boil:"RunReconciliationDiscrepancies" json:"RunReconciliationDiscrepancies" toml:"RunReconciliationDiscrepancies" yaml:"RunReconciliationDiscrepancies"`
+}
+
+// NewStruct creates a new relationship struct
+func (*reconciliationRunR) NewStruct() *reconciliationRunR {
+	return &reconciliationRunR{}
+}
+
+// reconciliationRunL is where Load methods for each relationship are stored.
+type reconciliationRunL struct{}
+
+var (
+	reconciliationRunAllColumns            = []string{"id", "collection_ids", "status", "last_hash", "checked_count", "discrepancy_count", "error", "created_by_id", "started_at", "completed_at", "created_at"}
+	reconciliationRunColumnsWithoutDefault = []string{"collection_ids", "created_by_id"}
+	reconciliationRunColumnsWithDefault    = []string{"id", "status", "last_hash", "checked_count", "discrepancy_count", "error", "started_at", "completed_at", "created_at"}
+	reconciliationRunPrimaryKeyColumns     = []string{"id"}
+	reconciliationRunGeneratedColumns      = []string{}
+)
+
+type (
+	// ReconciliationRunSlice is an alias for a slice of pointers to ReconciliationRun.
+	// This should almost always be used instead of []ReconciliationRun.
+	ReconciliationRunSlice []*ReconciliationRun
+	// ReconciliationRunHook is the signature for custom ReconciliationRun hook methods
+	ReconciliationRunHook func(boil.Executor, *ReconciliationRun) error
+
+	reconciliationRunQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	reconciliationRunType                 = reflect.TypeOf(&ReconciliationRun{})
+	reconciliationRunMapping              = queries.MakeStructMapping(reconciliationRunType)
+	reconciliationRunPrimaryKeyMapping, _ = queries.BindMapping(reconciliationRunType, reconciliationRunMapping, reconciliationRunPrimaryKeyColumns)
+	reconciliationRunInsertCacheMut       sync.RWMutex
+	reconciliationRunInsertCache          = make(map[string]insertCache)
+	reconciliationRunUpdateCacheMut       sync.RWMutex
+	reconciliationRunUpdateCache          = make(map[string]updateCache)
+	reconciliationRunUpsertCacheMut       sync.RWMutex
+	reconciliationRunUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var reconciliationRunAfterSelectHooks []ReconciliationRunHook
+
+var reconciliationRunBeforeInsertHooks []ReconciliationRunHook
+var reconciliationRunAfterInsertHooks []ReconciliationRunHook
+
+var reconciliationRunBeforeUpdateHooks []ReconciliationRunHook
+var reconciliationRunAfterUpdateHooks []ReconciliationRunHook
+
+var reconciliationRunBeforeDeleteHooks []ReconciliationRunHook
+var reconciliationRunAfterDeleteHooks []ReconciliationRunHook
+
+var reconciliationRunBeforeUpsertHooks []ReconciliationRunHook
+var reconciliationRunAfterUpsertHooks []ReconciliationRunHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ReconciliationRun) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationRunAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ReconciliationRun) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationRunBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ReconciliationRun) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationRunAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ReconciliationRun) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationRunBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ReconciliationRun) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationRunAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ReconciliationRun) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationRunBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ReconciliationRun) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationRunAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ReconciliationRun) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationRunBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ReconciliationRun) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range reconciliationRunAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddReconciliationRunHook registers your hook function for all future operations.
+func AddReconciliationRunHook(hookPoint boil.HookPoint, reconciliationRunHook ReconciliationRunHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		reconciliationRunAfterSelectHooks = append(reconciliationRunAfterSelectHooks, reconciliationRunHook)
+	case boil.BeforeInsertHook:
+		reconciliationRunBeforeInsertHooks = append(reconciliationRunBeforeInsertHooks, reconciliationRunHook)
+	case boil.AfterInsertHook:
+		reconciliationRunAfterInsertHooks = append(reconciliationRunAfterInsertHooks, reconciliationRunHook)
+	case boil.BeforeUpdateHook:
+		reconciliationRunBeforeUpdateHooks = append(reconciliationRunBeforeUpdateHooks, reconciliationRunHook)
+	case boil.AfterUpdateHook:
+		reconciliationRunAfterUpdateHooks = append(reconciliationRunAfterUpdateHooks, reconciliationRunHook)
+	case boil.BeforeDeleteHook:
+		reconciliationRunBeforeDeleteHooks = append(reconciliationRunBeforeDeleteHooks, reconciliationRunHook)
+	case boil.AfterDeleteHook:
+		reconciliationRunAfterDeleteHooks = append(reconciliationRunAfterDeleteHooks, reconciliationRunHook)
+	case boil.BeforeUpsertHook:
+		reconciliationRunBeforeUpsertHooks = append(reconciliationRunBeforeUpsertHooks, reconciliationRunHook)
+	case boil.AfterUpsertHook:
+		reconciliationRunAfterUpsertHooks = append(reconciliationRunAfterUpsertHooks, reconciliationRunHook)
+	}
+}
+
+// One returns a single reconciliationRun record from the query.
+func (q reconciliationRunQuery) One(exec boil.Executor) (*ReconciliationRun, error) {
+	o := &ReconciliationRun{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for reconciliation_runs")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ReconciliationRun records from the query.
+func (q reconciliationRunQuery) All(exec boil.Executor) (ReconciliationRunSlice, error) {
+	var o []*ReconciliationRun
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to ReconciliationRun slice")
+	}
+
+	if len(reconciliationRunAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ReconciliationRun records in the query.
+func (q reconciliationRunQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count reconciliation_runs rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q reconciliationRunQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if reconciliation_runs exists")
+	}
+
+	return count > 0, nil
+}
+
+// CreatedBy pointed to by the foreign key.
+func (o *ReconciliationRun) CreatedBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CreatedByID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// RunReconciliationDiscrepancies retrieves all the reconciliation_discrepancy's ReconciliationDiscrepancies with an executor via run_id column.
+func (o *ReconciliationRun) RunReconciliationDiscrepancies(mods ...qm.QueryMod) reconciliationDiscrepancyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reconciliation_discrepancies\".\"run_id\"=?", o.ID),
+	)
+
+	query := ReconciliationDiscrepancies(queryMods...)
+	queries.SetFrom(query.Query, "\"reconciliation_discrepancies\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"reconciliation_discrepancies\".*"})
+	}
+
+	return query
+}
+
+// LoadCreatedBy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reconciliationRunL) LoadCreatedBy(e boil.Executor, singular bool, maybeReconciliationRun interface{}, mods queries.Applicator) error {
+	var slice []*ReconciliationRun
+	var object *ReconciliationRun
+
+	if singular {
+		object = maybeReconciliationRun.(*ReconciliationRun)
+	} else {
+		slice = *maybeReconciliationRun.(*[]*ReconciliationRun)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &reconciliationRunR{}
+		}
+		args = append(args, object.CreatedByID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reconciliationRunR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CreatedByID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CreatedByID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(reconciliationRunAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CreatedBy = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CreatedByReconciliationRuns = append(foreign.R.CreatedByReconciliationRuns, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CreatedByID == foreign.ID {
+				local.R.CreatedBy = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CreatedByReconciliationRuns = append(foreign.R.CreatedByReconciliationRuns, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRunReconciliationDiscrepancies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (reconciliationRunL) LoadRunReconciliationDiscrepancies(e boil.Executor, singular bool, maybeReconciliationRun interface{}, mods queries.Applicator) error {
+	var slice []*ReconciliationRun
+	var object *ReconciliationRun
+
+	if singular {
+		object = maybeReconciliationRun.(*ReconciliationRun)
+	} else {
+		slice = *maybeReconciliationRun.(*[]*ReconciliationRun)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &reconciliationRunR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reconciliationRunR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`reconciliation_discrepancies`),
+		qm.WhereIn(`reconciliation_discrepancies.run_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reconciliation_discrepancies")
+	}
+
+	var resultSlice []*ReconciliationDiscrepancy
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reconciliation_discrepancies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reconciliation_discrepancies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reconciliation_discrepancies")
+	}
+
+	if len(reconciliationDiscrepancyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RunReconciliationDiscrepancies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reconciliationDiscrepancyR{}
+			}
+			foreign.R.Run = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RunID {
+				local.R.RunReconciliationDiscrepancies = append(local.R.RunReconciliationDiscrepancies, foreign)
+				if foreign.R == nil {
+					foreign.R = &reconciliationDiscrepancyR{}
+				}
+				foreign.R.Run = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCreatedBy of the reconciliationRun to the related item.
+// Sets o.R.CreatedBy to related.
+// Adds o to related.R.CreatedByReconciliationRuns.
+func (o *ReconciliationRun) SetCreatedBy(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"reconciliation_runs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reconciliationRunPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CreatedByID = related.ID
+	if o.R == nil {
+		o.R = &reconciliationRunR{
+			CreatedBy: related,
+		}
+	} else {
+		o.R.CreatedBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatedByReconciliationRuns: ReconciliationRunSlice{o},
+		}
+	} else {
+		related.R.CreatedByReconciliationRuns = append(related.R.CreatedByReconciliationRuns, o)
+	}
+
+	return nil
+}
+
+// AddRunReconciliationDiscrepancies adds the given related objects to the existing relationships
+// of the reconciliation_run, optionally inserting them as new records.
+// Appends related to o.R.RunReconciliationDiscrepancies.
+// Sets related.R.Run appropriately.
+func (o *ReconciliationRun) AddRunReconciliationDiscrepancies(exec boil.Executor, insert bool, related ...*ReconciliationDiscrepancy) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RunID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reconciliation_discrepancies\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reconciliationDiscrepancyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RunID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &reconciliationRunR{
+			RunReconciliationDiscrepancies: related,
+		}
+	} else {
+		o.R.RunReconciliationDiscrepancies = append(o.R.RunReconciliationDiscrepancies, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reconciliationDiscrepancyR{
+				Run: o,
+			}
+		} else {
+			rel.R.Run = o
+		}
+	}
+	return nil
+}
+
+// ReconciliationRuns retrieves all the records using an executor.
+func ReconciliationRuns(mods ...qm.QueryMod) reconciliationRunQuery {
+	mods = append(mods, qm.From("\"reconciliation_runs\""))
+	return reconciliationRunQuery{NewQuery(mods...)}
+}
+
+// FindReconciliationRun retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReconciliationRun(exec boil.Executor, iD string, selectCols ...string) (*ReconciliationRun, error) {
+	reconciliationRunObj := &ReconciliationRun{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"reconciliation_runs\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, reconciliationRunObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from reconciliation_runs")
+	}
+
+	if err = reconciliationRunObj.doAfterSelectHooks(exec); err != nil {
+		return reconciliationRunObj, err
+	}
+
+	return reconciliationRunObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ReconciliationRun) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no reconciliation_runs provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reconciliationRunColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	reconciliationRunInsertCacheMut.RLock()
+	cache, cached := reconciliationRunInsertCache[key]
+	reconciliationRunInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			reconciliationRunAllColumns,
+			reconciliationRunColumnsWithDefault,
+			reconciliationRunColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(reconciliationRunType, reconciliationRunMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(reconciliationRunType, reconciliationRunMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"reconciliation_runs\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"reconciliation_runs\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into reconciliation_runs")
+	}
+
+	if !cached {
+		reconciliationRunInsertCacheMut.Lock()
+		reconciliationRunInsertCache[key] = cache
+		reconciliationRunInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the ReconciliationRun.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ReconciliationRun) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	reconciliationRunUpdateCacheMut.RLock()
+	cache, cached := reconciliationRunUpdateCache[key]
+	reconciliationRunUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			reconciliationRunAllColumns,
+			reconciliationRunPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update reconciliation_runs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"reconciliation_runs\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, reconciliationRunPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(reconciliationRunType, reconciliationRunMapping, append(wl, reconciliationRunPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update reconciliation_runs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for reconciliation_runs")
+	}
+
+	if !cached {
+		reconciliationRunUpdateCacheMut.Lock()
+		reconciliationRunUpdateCache[key] = cache
+		reconciliationRunUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q reconciliationRunQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for reconciliation_runs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for reconciliation_runs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReconciliationRunSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reconciliationRunPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"reconciliation_runs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, reconciliationRunPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in reconciliationRun slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all reconciliationRun")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ReconciliationRun) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no reconciliation_runs provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reconciliationRunColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	reconciliationRunUpsertCacheMut.RLock()
+	cache, cached := reconciliationRunUpsertCache[key]
+	reconciliationRunUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			reconciliationRunAllColumns,
+			reconciliationRunColumnsWithDefault,
+			reconciliationRunColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			reconciliationRunAllColumns,
+			reconciliationRunPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert reconciliation_runs, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(reconciliationRunPrimaryKeyColumns))
+			copy(conflict, reconciliationRunPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"reconciliation_runs\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(reconciliationRunType, reconciliationRunMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(reconciliationRunType, reconciliationRunMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert reconciliation_runs")
+	}
+
+	if !cached {
+		reconciliationRunUpsertCacheMut.Lock()
+		reconciliationRunUpsertCache[key] = cache
+		reconciliationRunUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single ReconciliationRun record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ReconciliationRun) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no ReconciliationRun provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), reconciliationRunPrimaryKeyMapping)
+	sql := "DELETE FROM \"reconciliation_runs\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from reconciliation_runs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for reconciliation_runs")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q reconciliationRunQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no reconciliationRunQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from reconciliation_runs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for reconciliation_runs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReconciliationRunSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(reconciliationRunBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reconciliationRunPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"reconciliation_runs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reconciliationRunPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from reconciliationRun slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for reconciliation_runs")
+	}
+
+	if len(reconciliationRunAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ReconciliationRun) Reload(exec boil.Executor) error {
+	ret, err := FindReconciliationRun(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReconciliationRunSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReconciliationRunSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reconciliationRunPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"reconciliation_runs\".* FROM \"reconciliation_runs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reconciliationRunPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in ReconciliationRunSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReconciliationRunExists checks if the ReconciliationRun row exists.
+func ReconciliationRunExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"reconciliation_runs\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if reconciliation_runs exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1378 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SyndicateAssetTransfer is an object representing the database table.
+type SyndicateAssetTransfer struct {
+	TransferEventID int64     `boiler:"transfer_event_id" boil:"transfer_event_id" json:"transfer_event_id" toml:"transfer_event_id" yaml:"transfer_event_id"`
+	SyndicateID     string    `boiler:"syndicate_id" boil:"syndicate_id" json:"syndicate_id" toml:"syndicate_id" yaml:"syndicate_id"`
+	MemberID        string    `boiler:"member_id" boil:"member_id" json:"member_id" toml:"member_id" yaml:"member_id"`
+	Kind            string    `boiler:"kind" boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	CreatedAt       time.Time `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *syndicateAssetTransferR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L syndicateAssetTransferL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SyndicateAssetTransferColumns = struct {
+	TransferEventID string
+	SyndicateID     string
+	MemberID        string
+	Kind            string
+	CreatedAt       string
+}{
+	TransferEventID: "transfer_event_id",
+	SyndicateID:     "syndicate_id",
+	MemberID:        "member_id",
+	Kind:            "kind",
+	CreatedAt:       "created_at",
+}
+
+var SyndicateAssetTransferTableColumns = struct {
+	TransferEventID string
+	SyndicateID     string
+	MemberID        string
+	Kind            string
+	CreatedAt       string
+}{
+	TransferEventID: "syndicate_asset_transfers.transfer_event_id",
+	SyndicateID:     "syndicate_asset_transfers.syndicate_id",
+	MemberID:        "syndicate_asset_transfers.member_id",
+	Kind:            "syndicate_asset_transfers.kind",
+	CreatedAt:       "syndicate_asset_transfers.created_at",
+}
+
+// Generated where
+
+var SyndicateAssetTransferWhere = struct {
+	TransferEventID whereHelperint64
+	SyndicateID     whereHelperstring
+	MemberID        whereHelperstring
+	Kind            whereHelperstring
+	CreatedAt       whereHelpertime_Time
+}{
+	TransferEventID: whereHelperint64{field: "\"syndicate_asset_transfers\".\"transfer_event_id\""},
+	SyndicateID:     whereHelperstring{field: "\"syndicate_asset_transfers\".\"syndicate_id\""},
+	MemberID:        whereHelperstring{field: "\"syndicate_asset_transfers\".\"member_id\""},
+	Kind:            whereHelperstring{field: "\"syndicate_asset_transfers\".\"kind\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"syndicate_asset_transfers\".\"created_at\""},
+}
+
+// SyndicateAssetTransferRels is where relationship names are stored.
+var SyndicateAssetTransferRels = struct {
+	Member        string
+	Syndicate     string
+	TransferEvent string
+}{
+	Member:        "Member",
+	Syndicate:     "Syndicate",
+	TransferEvent: "TransferEvent",
+}
+
+// syndicateAssetTransferR is where relationships are stored.
+type syndicateAssetTransferR struct {
+	Member        *User               `boiler:"Member" boil:"Member" json:"Member" toml:"Member" yaml:"Member"`
+	Syndicate     *Syndicate          `boiler:"Syndicate" boil:"Syndicate" json:"Syndicate" toml:"Syndicate" yaml:"Syndicate"`
+	TransferEvent *AssetTransferEvent `boiler:"TransferEvent" boil:"TransferEvent" json:"TransferEvent" toml:"TransferEvent" yaml:"TransferEvent"`
+}
+
+// NewStruct creates a new relationship struct
+func (*syndicateAssetTransferR) NewStruct() *syndicateAssetTransferR {
+	return &syndicateAssetTransferR{}
+}
+
+// syndicateAssetTransferL is where Load methods for each relationship are stored.
+type syndicateAssetTransferL struct{}
+
+var (
+	syndicateAssetTransferAllColumns            = []string{"transfer_event_id", "syndicate_id", "member_id", "kind", "created_at"}
+	syndicateAssetTransferColumnsWithoutDefault = []string{"transfer_event_id", "syndicate_id", "member_id", "kind"}
+	syndicateAssetTransferColumnsWithDefault    = []string{"created_at"}
+	syndicateAssetTransferPrimaryKeyColumns     = []string{"transfer_event_id"}
+	syndicateAssetTransferGeneratedColumns      = []string{}
+)
+
+type (
+	// SyndicateAssetTransferSlice is an alias for a slice of pointers to SyndicateAssetTransfer.
+	// This should almost always be used instead of []SyndicateAssetTransfer.
+	SyndicateAssetTransferSlice []*SyndicateAssetTransfer
+	// SyndicateAssetTransferHook is the signature for custom SyndicateAssetTransfer hook methods
+	SyndicateAssetTransferHook func(boil.Executor, *SyndicateAssetTransfer) error
+
+	syndicateAssetTransferQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	syndicateAssetTransferType                 = reflect.TypeOf(&SyndicateAssetTransfer{})
+	syndicateAssetTransferMapping              = queries.MakeStructMapping(syndicateAssetTransferType)
+	syndicateAssetTransferPrimaryKeyMapping, _ = queries.BindMapping(syndicateAssetTransferType, syndicateAssetTransferMapping, syndicateAssetTransferPrimaryKeyColumns)
+	syndicateAssetTransferInsertCacheMut       sync.RWMutex
+	syndicateAssetTransferInsertCache          = make(map[string]insertCache)
+	syndicateAssetTransferUpdateCacheMut       sync.RWMutex
+	syndicateAssetTransferUpdateCache          = make(map[string]updateCache)
+	syndicateAssetTransferUpsertCacheMut       sync.RWMutex
+	syndicateAssetTransferUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var syndicateAssetTransferAfterSelectHooks []SyndicateAssetTransferHook
+
+var syndicateAssetTransferBeforeInsertHooks []SyndicateAssetTransferHook
+var syndicateAssetTransferAfterInsertHooks []SyndicateAssetTransferHook
+
+var syndicateAssetTransferBeforeUpdateHooks []SyndicateAssetTransferHook
+var syndicateAssetTransferAfterUpdateHooks []SyndicateAssetTransferHook
+
+var syndicateAssetTransferBeforeDeleteHooks []SyndicateAssetTransferHook
+var syndicateAssetTransferAfterDeleteHooks []SyndicateAssetTransferHook
+
+var syndicateAssetTransferBeforeUpsertHooks []SyndicateAssetTransferHook
+var syndicateAssetTransferAfterUpsertHooks []SyndicateAssetTransferHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SyndicateAssetTransfer) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateAssetTransferAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SyndicateAssetTransfer) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateAssetTransferBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SyndicateAssetTransfer) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateAssetTransferAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SyndicateAssetTransfer) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateAssetTransferBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SyndicateAssetTransfer) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateAssetTransferAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SyndicateAssetTransfer) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateAssetTransferBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SyndicateAssetTransfer) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateAssetTransferAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SyndicateAssetTransfer) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateAssetTransferBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SyndicateAssetTransfer) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateAssetTransferAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSyndicateAssetTransferHook registers your hook function for all future operations.
+func AddSyndicateAssetTransferHook(hookPoint boil.HookPoint, syndicateAssetTransferHook SyndicateAssetTransferHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		syndicateAssetTransferAfterSelectHooks = append(syndicateAssetTransferAfterSelectHooks, syndicateAssetTransferHook)
+	case boil.BeforeInsertHook:
+		syndicateAssetTransferBeforeInsertHooks = append(syndicateAssetTransferBeforeInsertHooks, syndicateAssetTransferHook)
+	case boil.AfterInsertHook:
+		syndicateAssetTransferAfterInsertHooks = append(syndicateAssetTransferAfterInsertHooks, syndicateAssetTransferHook)
+	case boil.BeforeUpdateHook:
+		syndicateAssetTransferBeforeUpdateHooks = append(syndicateAssetTransferBeforeUpdateHooks, syndicateAssetTransferHook)
+	case boil.AfterUpdateHook:
+		syndicateAssetTransferAfterUpdateHooks = append(syndicateAssetTransferAfterUpdateHooks, syndicateAssetTransferHook)
+	case boil.BeforeDeleteHook:
+		syndicateAssetTransferBeforeDeleteHooks = append(syndicateAssetTransferBeforeDeleteHooks, syndicateAssetTransferHook)
+	case boil.AfterDeleteHook:
+		syndicateAssetTransferAfterDeleteHooks = append(syndicateAssetTransferAfterDeleteHooks, syndicateAssetTransferHook)
+	case boil.BeforeUpsertHook:
+		syndicateAssetTransferBeforeUpsertHooks = append(syndicateAssetTransferBeforeUpsertHooks, syndicateAssetTransferHook)
+	case boil.AfterUpsertHook:
+		syndicateAssetTransferAfterUpsertHooks = append(syndicateAssetTransferAfterUpsertHooks, syndicateAssetTransferHook)
+	}
+}
+
+// One returns a single syndicateAssetTransfer record from the query.
+func (q syndicateAssetTransferQuery) One(exec boil.Executor) (*SyndicateAssetTransfer, error) {
+	o := &SyndicateAssetTransfer{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for syndicate_asset_transfers")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SyndicateAssetTransfer records from the query.
+func (q syndicateAssetTransferQuery) All(exec boil.Executor) (SyndicateAssetTransferSlice, error) {
+	var o []*SyndicateAssetTransfer
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to SyndicateAssetTransfer slice")
+	}
+
+	if len(syndicateAssetTransferAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SyndicateAssetTransfer records in the query.
+func (q syndicateAssetTransferQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count syndicate_asset_transfers rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q syndicateAssetTransferQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if syndicate_asset_transfers exists")
+	}
+
+	return count > 0, nil
+}
+
+// Member pointed to by the foreign key.
+func (o *SyndicateAssetTransfer) Member(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.MemberID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// Syndicate pointed to by the foreign key.
+func (o *SyndicateAssetTransfer) Syndicate(mods ...qm.QueryMod) syndicateQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SyndicateID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Syndicates(queryMods...)
+	queries.SetFrom(query.Query, "\"syndicates\"")
+
+	return query
+}
+
+// TransferEvent pointed to by the foreign key.
+func (o *SyndicateAssetTransfer) TransferEvent(mods ...qm.QueryMod) assetTransferEventQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TransferEventID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := AssetTransferEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_transfer_events\"")
+
+	return query
+}
+
+// LoadMember allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (syndicateAssetTransferL) LoadMember(e boil.Executor, singular bool, maybeSyndicateAssetTransfer interface{}, mods queries.Applicator) error {
+	var slice []*SyndicateAssetTransfer
+	var object *SyndicateAssetTransfer
+
+	if singular {
+		object = maybeSyndicateAssetTransfer.(*SyndicateAssetTransfer)
+	} else {
+		slice = *maybeSyndicateAssetTransfer.(*[]*SyndicateAssetTransfer)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &syndicateAssetTransferR{}
+		}
+		args = append(args, object.MemberID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &syndicateAssetTransferR{}
+			}
+
+			for _, a := range args {
+				if a == obj.MemberID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.MemberID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(syndicateAssetTransferAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Member = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.MemberSyndicateAssetTransfers = append(foreign.R.MemberSyndicateAssetTransfers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.MemberID == foreign.ID {
+				local.R.Member = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.MemberSyndicateAssetTransfers = append(foreign.R.MemberSyndicateAssetTransfers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSyndicate allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (syndicateAssetTransferL) LoadSyndicate(e boil.Executor, singular bool, maybeSyndicateAssetTransfer interface{}, mods queries.Applicator) error {
+	var slice []*SyndicateAssetTransfer
+	var object *SyndicateAssetTransfer
+
+	if singular {
+		object = maybeSyndicateAssetTransfer.(*SyndicateAssetTransfer)
+	} else {
+		slice = *maybeSyndicateAssetTransfer.(*[]*SyndicateAssetTransfer)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &syndicateAssetTransferR{}
+		}
+		args = append(args, object.SyndicateID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &syndicateAssetTransferR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SyndicateID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SyndicateID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`syndicates`),
+		qm.WhereIn(`syndicates.id in ?`, args...),
+		qmhelper.WhereIsNull(`syndicates.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Syndicate")
+	}
+
+	var resultSlice []*Syndicate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Syndicate")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for syndicates")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for syndicates")
+	}
+
+	if len(syndicateAssetTransferAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Syndicate = foreign
+		if foreign.R == nil {
+			foreign.R = &syndicateR{}
+		}
+		foreign.R.SyndicateAssetTransfers = append(foreign.R.SyndicateAssetTransfers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SyndicateID == foreign.ID {
+				local.R.Syndicate = foreign
+				if foreign.R == nil {
+					foreign.R = &syndicateR{}
+				}
+				foreign.R.SyndicateAssetTransfers = append(foreign.R.SyndicateAssetTransfers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTransferEvent allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (syndicateAssetTransferL) LoadTransferEvent(e boil.Executor, singular bool, maybeSyndicateAssetTransfer interface{}, mods queries.Applicator) error {
+	var slice []*SyndicateAssetTransfer
+	var object *SyndicateAssetTransfer
+
+	if singular {
+		object = maybeSyndicateAssetTransfer.(*SyndicateAssetTransfer)
+	} else {
+		slice = *maybeSyndicateAssetTransfer.(*[]*SyndicateAssetTransfer)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &syndicateAssetTransferR{}
+		}
+		args = append(args, object.TransferEventID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &syndicateAssetTransferR{}
+			}
+
+			for _, a := range args {
+				if a == obj.TransferEventID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.TransferEventID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`asset_transfer_events`),
+		qm.WhereIn(`asset_transfer_events.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load AssetTransferEvent")
+	}
+
+	var resultSlice []*AssetTransferEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice AssetTransferEvent")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for asset_transfer_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_transfer_events")
+	}
+
+	if len(syndicateAssetTransferAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TransferEvent = foreign
+		if foreign.R == nil {
+			foreign.R = &assetTransferEventR{}
+		}
+		foreign.R.TransferEventSyndicateAssetTransfer = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TransferEventID == foreign.ID {
+				local.R.TransferEvent = foreign
+				if foreign.R == nil {
+					foreign.R = &assetTransferEventR{}
+				}
+				foreign.R.TransferEventSyndicateAssetTransfer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetMember of the syndicateAssetTransfer to the related item.
+// Sets o.R.Member to related.
+// Adds o to related.R.MemberSyndicateAssetTransfers.
+func (o *SyndicateAssetTransfer) SetMember(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"syndicate_asset_transfers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"member_id"}),
+		strmangle.WhereClause("\"", "\"", 2, syndicateAssetTransferPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TransferEventID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.MemberID = related.ID
+	if o.R == nil {
+		o.R = &syndicateAssetTransferR{
+			Member: related,
+		}
+	} else {
+		o.R.Member = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			MemberSyndicateAssetTransfers: SyndicateAssetTransferSlice{o},
+		}
+	} else {
+		related.R.MemberSyndicateAssetTransfers = append(related.R.MemberSyndicateAssetTransfers, o)
+	}
+
+	return nil
+}
+
+// SetSyndicate of the syndicateAssetTransfer to the related item.
+// Sets o.R.Syndicate to related.
+// Adds o to related.R.SyndicateAssetTransfers.
+func (o *SyndicateAssetTransfer) SetSyndicate(exec boil.Executor, insert bool, related *Syndicate) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"syndicate_asset_transfers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"syndicate_id"}),
+		strmangle.WhereClause("\"", "\"", 2, syndicateAssetTransferPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TransferEventID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SyndicateID = related.ID
+	if o.R == nil {
+		o.R = &syndicateAssetTransferR{
+			Syndicate: related,
+		}
+	} else {
+		o.R.Syndicate = related
+	}
+
+	if related.R == nil {
+		related.R = &syndicateR{
+			SyndicateAssetTransfers: SyndicateAssetTransferSlice{o},
+		}
+	} else {
+		related.R.SyndicateAssetTransfers = append(related.R.SyndicateAssetTransfers, o)
+	}
+
+	return nil
+}
+
+// SetTransferEvent of the syndicateAssetTransfer to the related item.
+// Sets o.R.TransferEvent to related.
+// Adds o to related.R.TransferEventSyndicateAssetTransfer.
+func (o *SyndicateAssetTransfer) SetTransferEvent(exec boil.Executor, insert bool, related *AssetTransferEvent) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"syndicate_asset_transfers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"transfer_event_id"}),
+		strmangle.WhereClause("\"", "\"", 2, syndicateAssetTransferPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TransferEventID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TransferEventID = related.ID
+	if o.R == nil {
+		o.R = &syndicateAssetTransferR{
+			TransferEvent: related,
+		}
+	} else {
+		o.R.TransferEvent = related
+	}
+
+	if related.R == nil {
+		related.R = &assetTransferEventR{
+			TransferEventSyndicateAssetTransfer: o,
+		}
+	} else {
+		related.R.TransferEventSyndicateAssetTransfer = o
+	}
+
+	return nil
+}
+
+// SyndicateAssetTransfers retrieves all the records using an executor.
+func SyndicateAssetTransfers(mods ...qm.QueryMod) syndicateAssetTransferQuery {
+	mods = append(mods, qm.From("\"syndicate_asset_transfers\""))
+	return syndicateAssetTransferQuery{NewQuery(mods...)}
+}
+
+// FindSyndicateAssetTransfer retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSyndicateAssetTransfer(exec boil.Executor, transferEventID int64, selectCols ...string) (*SyndicateAssetTransfer, error) {
+	syndicateAssetTransferObj := &SyndicateAssetTransfer{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"syndicate_asset_transfers\" where \"transfer_event_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, transferEventID)
+
+	err := q.Bind(nil, exec, syndicateAssetTransferObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from syndicate_asset_transfers")
+	}
+
+	if err = syndicateAssetTransferObj.doAfterSelectHooks(exec); err != nil {
+		return syndicateAssetTransferObj, err
+	}
+
+	return syndicateAssetTransferObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SyndicateAssetTransfer) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no syndicate_asset_transfers provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(syndicateAssetTransferColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	syndicateAssetTransferInsertCacheMut.RLock()
+	cache, cached := syndicateAssetTransferInsertCache[key]
+	syndicateAssetTransferInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			syndicateAssetTransferAllColumns,
+			syndicateAssetTransferColumnsWithDefault,
+			syndicateAssetTransferColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(syndicateAssetTransferType, syndicateAssetTransferMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(syndicateAssetTransferType, syndicateAssetTransferMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"syndicate_asset_transfers\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"syndicate_asset_transfers\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into syndicate_asset_transfers")
+	}
+
+	if !cached {
+		syndicateAssetTransferInsertCacheMut.Lock()
+		syndicateAssetTransferInsertCache[key] = cache
+		syndicateAssetTransferInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the SyndicateAssetTransfer.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SyndicateAssetTransfer) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	syndicateAssetTransferUpdateCacheMut.RLock()
+	cache, cached := syndicateAssetTransferUpdateCache[key]
+	syndicateAssetTransferUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			syndicateAssetTransferAllColumns,
+			syndicateAssetTransferPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update syndicate_asset_transfers, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"syndicate_asset_transfers\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, syndicateAssetTransferPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(syndicateAssetTransferType, syndicateAssetTransferMapping, append(wl, syndicateAssetTransferPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update syndicate_asset_transfers row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for syndicate_asset_transfers")
+	}
+
+	if !cached {
+		syndicateAssetTransferUpdateCacheMut.Lock()
+		syndicateAssetTransferUpdateCache[key] = cache
+		syndicateAssetTransferUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q syndicateAssetTransferQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for syndicate_asset_transfers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for syndicate_asset_transfers")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SyndicateAssetTransferSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), syndicateAssetTransferPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"syndicate_asset_transfers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, syndicateAssetTransferPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in syndicateAssetTransfer slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all syndicateAssetTransfer")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SyndicateAssetTransfer) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no syndicate_asset_transfers provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(syndicateAssetTransferColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	syndicateAssetTransferUpsertCacheMut.RLock()
+	cache, cached := syndicateAssetTransferUpsertCache[key]
+	syndicateAssetTransferUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			syndicateAssetTransferAllColumns,
+			syndicateAssetTransferColumnsWithDefault,
+			syndicateAssetTransferColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			syndicateAssetTransferAllColumns,
+			syndicateAssetTransferPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert syndicate_asset_transfers, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(syndicateAssetTransferPrimaryKeyColumns))
+			copy(conflict, syndicateAssetTransferPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"syndicate_asset_transfers\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(syndicateAssetTransferType, syndicateAssetTransferMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(syndicateAssetTransferType, syndicateAssetTransferMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert syndicate_asset_transfers")
+	}
+
+	if !cached {
+		syndicateAssetTransferUpsertCacheMut.Lock()
+		syndicateAssetTransferUpsertCache[key] = cache
+		syndicateAssetTransferUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single SyndicateAssetTransfer record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SyndicateAssetTransfer) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no SyndicateAssetTransfer provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), syndicateAssetTransferPrimaryKeyMapping)
+	sql := "DELETE FROM \"syndicate_asset_transfers\" WHERE \"transfer_event_id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from syndicate_asset_transfers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for syndicate_asset_transfers")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q syndicateAssetTransferQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no syndicateAssetTransferQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from syndicate_asset_transfers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for syndicate_asset_transfers")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SyndicateAssetTransferSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(syndicateAssetTransferBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), syndicateAssetTransferPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"syndicate_asset_transfers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, syndicateAssetTransferPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from syndicateAssetTransfer slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for syndicate_asset_transfers")
+	}
+
+	if len(syndicateAssetTransferAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SyndicateAssetTransfer) Reload(exec boil.Executor) error {
+	ret, err := FindSyndicateAssetTransfer(exec, o.TransferEventID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SyndicateAssetTransferSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SyndicateAssetTransferSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), syndicateAssetTransferPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"syndicate_asset_transfers\".* FROM \"syndicate_asset_transfers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, syndicateAssetTransferPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in SyndicateAssetTransferSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SyndicateAssetTransferExists checks if the SyndicateAssetTransfer row exists.
+func SyndicateAssetTransferExists(exec boil.Executor, transferEventID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"syndicate_asset_transfers\" where \"transfer_event_id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, transferEventID)
+	}
+	row := exec.QueryRow(sql, transferEventID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if syndicate_asset_transfers exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1208 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SyndicateMember is an object representing the database table.
+type SyndicateMember struct {
+	SyndicateID     string    `boiler:"syndicate_id" boil:"syndicate_id" json:"syndicate_id" toml:"syndicate_id" yaml:"syndicate_id"`
+	UserID          string    `boiler:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Role            string    `boiler:"role" boil:"role" json:"role" toml:"role" yaml:"role"`
+	CanSpendFunds   bool      `boiler:"can_spend_funds" boil:"can_spend_funds" json:"can_spend_funds" toml:"can_spend_funds" yaml:"can_spend_funds"`
+	CanManageAssets bool      `boiler:"can_manage_assets" boil:"can_manage_assets" json:"can_manage_assets" toml:"can_manage_assets" yaml:"can_manage_assets"`
+	SyncedAt        time.Time `boiler:"synced_at" boil:"synced_at" json:"synced_at" toml:"synced_at" yaml:"synced_at"`
+
+	R *syndicateMemberR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L syndicateMemberL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SyndicateMemberColumns = struct {
+	SyndicateID     string
+	UserID          string
+	Role            string
+	CanSpendFunds   string
+	CanManageAssets string
+	SyncedAt        string
+}{
+	SyndicateID:     "syndicate_id",
+	UserID:          "user_id",
+	Role:            "role",
+	CanSpendFunds:   "can_spend_funds",
+	CanManageAssets: "can_manage_assets",
+	SyncedAt:        "synced_at",
+}
+
+var SyndicateMemberTableColumns = struct {
+	SyndicateID     string
+	UserID          string
+	Role            string
+	CanSpendFunds   string
+	CanManageAssets string
+	SyncedAt        string
+}{
+	SyndicateID:     "syndicate_members.syndicate_id",
+	UserID:          "syndicate_members.user_id",
+	Role:            "syndicate_members.role",
+	CanSpendFunds:   "syndicate_members.can_spend_funds",
+	CanManageAssets: "syndicate_members.can_manage_assets",
+	SyncedAt:        "syndicate_members.synced_at",
+}
+
+// Generated where
+
+var SyndicateMemberWhere = struct {
+	SyndicateID     whereHelperstring
+	UserID          whereHelperstring
+	Role            whereHelperstring
+	CanSpendFunds   whereHelperbool
+	CanManageAssets whereHelperbool
+	SyncedAt        whereHelpertime_Time
+}{
+	SyndicateID:     whereHelperstring{field: "\"syndicate_members\".\"syndicate_id\""},
+	UserID:          whereHelperstring{field: "\"syndicate_members\".\"user_id\""},
+	Role:            whereHelperstring{field: "\"syndicate_members\".\"role\""},
+	CanSpendFunds:   whereHelperbool{field: "\"syndicate_members\".\"can_spend_funds\""},
+	CanManageAssets: whereHelperbool{field: "\"syndicate_members\".\"can_manage_assets\""},
+	SyncedAt:        whereHelpertime_Time{field: "\"syndicate_members\".\"synced_at\""},
+}
+
+// SyndicateMemberRels is where relationship names are stored.
+var SyndicateMemberRels = struct {
+	Syndicate string
+	User      string
+}{
+	Syndicate: "Syndicate",
+	User:      "User",
+}
+
+// syndicateMemberR is where relationships are stored.
+type syndicateMemberR struct {
+	Syndicate *Syndicate `boiler:"Syndicate" boil:"Syndicate" json:"Syndicate" toml:"Syndicate" yaml:"Syndicate"`
+	User      *User      `boiler:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*syndicateMemberR) NewStruct() *syndicateMemberR {
+	return &syndicateMemberR{}
+}
+
+// syndicateMemberL is where Load methods for each relationship are stored.
+type syndicateMemberL struct{}
+
+var (
+	syndicateMemberAllColumns            = []string{"syndicate_id", "user_id", "role", "can_spend_funds", "can_manage_assets", "synced_at"}
+	syndicateMemberColumnsWithoutDefault = []string{"syndicate_id", "user_id"}
+	syndicateMemberColumnsWithDefault    = []string{"role", "can_spend_funds", "can_manage_assets", "synced_at"}
+	syndicateMemberPrimaryKeyColumns     = []string{"syndicate_id", "user_id"}
+	syndicateMemberGeneratedColumns      = []string{}
+)
+
+type (
+	// SyndicateMemberSlice is an alias for a slice of pointers to SyndicateMember.
+	// This should almost always be used instead of []SyndicateMember.
+	SyndicateMemberSlice []*SyndicateMember
+	// SyndicateMemberHook is the signature for custom SyndicateMember hook methods
+	SyndicateMemberHook func(boil.Executor, *SyndicateMember) error
+
+	syndicateMemberQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	syndicateMemberType                 = reflect.TypeOf(&SyndicateMember{})
+	syndicateMemberMapping              = queries.MakeStructMapping(syndicateMemberType)
+	syndicateMemberPrimaryKeyMapping, _ = queries.BindMapping(syndicateMemberType, syndicateMemberMapping, syndicateMemberPrimaryKeyColumns)
+	syndicateMemberInsertCacheMut       sync.RWMutex
+	syndicateMemberInsertCache          = make(map[string]insertCache)
+	syndicateMemberUpdateCacheMut       sync.RWMutex
+	syndicateMemberUpdateCache          = make(map[string]updateCache)
+	syndicateMemberUpsertCacheMut       sync.RWMutex
+	syndicateMemberUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var syndicateMemberAfterSelectHooks []SyndicateMemberHook
+
+var syndicateMemberBeforeInsertHooks []SyndicateMemberHook
+var syndicateMemberAfterInsertHooks []SyndicateMemberHook
+
+var syndicateMemberBeforeUpdateHooks []SyndicateMemberHook
+var syndicateMemberAfterUpdateHooks []SyndicateMemberHook
+
+var syndicateMemberBeforeDeleteHooks []SyndicateMemberHook
+var syndicateMemberAfterDeleteHooks []SyndicateMemberHook
+
+var syndicateMemberBeforeUpsertHooks []SyndicateMemberHook
+var syndicateMemberAfterUpsertHooks []SyndicateMemberHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SyndicateMember) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateMemberAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SyndicateMember) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateMemberBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SyndicateMember) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateMemberAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SyndicateMember) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateMemberBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SyndicateMember) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateMemberAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SyndicateMember) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateMemberBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SyndicateMember) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateMemberAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SyndicateMember) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateMemberBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SyndicateMember) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range syndicateMemberAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSyndicateMemberHook registers your hook function for all future operations.
+func AddSyndicateMemberHook(hookPoint boil.HookPoint, syndicateMemberHook SyndicateMemberHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		syndicateMemberAfterSelectHooks = append(syndicateMemberAfterSelectHooks, syndicateMemberHook)
+	case boil.BeforeInsertHook:
+		syndicateMemberBeforeInsertHooks = append(syndicateMemberBeforeInsertHooks, syndicateMemberHook)
+	case boil.AfterInsertHook:
+		syndicateMemberAfterInsertHooks = append(syndicateMemberAfterInsertHooks, syndicateMemberHook)
+	case boil.BeforeUpdateHook:
+		syndicateMemberBeforeUpdateHooks = append(syndicateMemberBeforeUpdateHooks, syndicateMemberHook)
+	case boil.AfterUpdateHook:
+		syndicateMemberAfterUpdateHooks = append(syndicateMemberAfterUpdateHooks, syndicateMemberHook)
+	case boil.BeforeDeleteHook:
+		syndicateMemberBeforeDeleteHooks = append(syndicateMemberBeforeDeleteHooks, syndicateMemberHook)
+	case boil.AfterDeleteHook:
+		syndicateMemberAfterDeleteHooks = append(syndicateMemberAfterDeleteHooks, syndicateMemberHook)
+	case boil.BeforeUpsertHook:
+		syndicateMemberBeforeUpsertHooks = append(syndicateMemberBeforeUpsertHooks, syndicateMemberHook)
+	case boil.AfterUpsertHook:
+		syndicateMemberAfterUpsertHooks = append(syndicateMemberAfterUpsertHooks, syndicateMemberHook)
+	}
+}
+
+// One returns a single syndicateMember record from the query.
+func (q syndicateMemberQuery) One(exec boil.Executor) (*SyndicateMember, error) {
+	o := &SyndicateMember{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for syndicate_members")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SyndicateMember records from the query.
+func (q syndicateMemberQuery) All(exec boil.Executor) (SyndicateMemberSlice, error) {
+	var o []*SyndicateMember
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to SyndicateMember slice")
+	}
+
+	if len(syndicateMemberAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SyndicateMember records in the query.
+func (q syndicateMemberQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count syndicate_members rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q syndicateMemberQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if syndicate_members exists")
+	}
+
+	return count > 0, nil
+}
+
+// Syndicate pointed to by the foreign key.
+func (o *SyndicateMember) Syndicate(mods ...qm.QueryMod) syndicateQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SyndicateID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Syndicates(queryMods...)
+	queries.SetFrom(query.Query, "\"syndicates\"")
+
+	return query
+}
+
+// User pointed to by the foreign key.
+func (o *SyndicateMember) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// LoadSyndicate allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (syndicateMemberL) LoadSyndicate(e boil.Executor, singular bool, maybeSyndicateMember interface{}, mods queries.Applicator) error {
+	var slice []*SyndicateMember
+	var object *SyndicateMember
+
+	if singular {
+		object = maybeSyndicateMember.(*SyndicateMember)
+	} else {
+		slice = *maybeSyndicateMember.(*[]*SyndicateMember)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &syndicateMemberR{}
+		}
+		args = append(args, object.SyndicateID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &syndicateMemberR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SyndicateID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SyndicateID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`syndicates`),
+		qm.WhereIn(`syndicates.id in ?`, args...),
+		qmhelper.WhereIsNull(`syndicates.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Syndicate")
+	}
+
+	var resultSlice []*Syndicate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Syndicate")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for syndicates")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for syndicates")
+	}
+
+	if len(syndicateMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Syndicate = foreign
+		if foreign.R == nil {
+			foreign.R = &syndicateR{}
+		}
+		foreign.R.SyndicateMembers = append(foreign.R.SyndicateMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SyndicateID == foreign.ID {
+				local.R.Syndicate = foreign
+				if foreign.R == nil {
+					foreign.R = &syndicateR{}
+				}
+				foreign.R.SyndicateMembers = append(foreign.R.SyndicateMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (syndicateMemberL) LoadUser(e boil.Executor, singular bool, maybeSyndicateMember interface{}, mods queries.Applicator) error {
+	var slice []*SyndicateMember
+	var object *SyndicateMember
+
+	if singular {
+		object = maybeSyndicateMember.(*SyndicateMember)
+	} else {
+		slice = *maybeSyndicateMember.(*[]*SyndicateMember)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &syndicateMemberR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &syndicateMemberR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(syndicateMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.SyndicateMembers = append(foreign.R.SyndicateMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.SyndicateMembers = append(foreign.R.SyndicateMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSyndicate of the syndicateMember to the related item.
+// Sets o.R.Syndicate to related.
+// Adds o to related.R.SyndicateMembers.
+func (o *SyndicateMember) SetSyndicate(exec boil.Executor, insert bool, related *Syndicate) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"syndicate_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"syndicate_id"}),
+		strmangle.WhereClause("\"", "\"", 2, syndicateMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.SyndicateID, o.UserID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SyndicateID = related.ID
+	if o.R == nil {
+		o.R = &syndicateMemberR{
+			Syndicate: related,
+		}
+	} else {
+		o.R.Syndicate = related
+	}
+
+	if related.R == nil {
+		related.R = &syndicateR{
+			SyndicateMembers: SyndicateMemberSlice{o},
+		}
+	} else {
+		related.R.SyndicateMembers = append(related.R.SyndicateMembers, o)
+	}
+
+	return nil
+}
+
+// SetUser of the syndicateMember to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.SyndicateMembers.
+func (o *SyndicateMember) SetUser(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"syndicate_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, syndicateMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.SyndicateID, o.UserID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &syndicateMemberR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			SyndicateMembers: SyndicateMemberSlice{o},
+		}
+	} else {
+		related.R.SyndicateMembers = append(related.R.SyndicateMembers, o)
+	}
+
+	return nil
+}
+
+// SyndicateMembers retrieves all the records using an executor.
+func SyndicateMembers(mods ...qm.QueryMod) syndicateMemberQuery {
+	mods = append(mods, qm.From("\"syndicate_members\""))
+	return syndicateMemberQuery{NewQuery(mods...)}
+}
+
+// FindSyndicateMember retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSyndicateMember(exec boil.Executor, syndicateID string, userID string, selectCols ...string) (*SyndicateMember, error) {
+	syndicateMemberObj := &SyndicateMember{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"syndicate_members\" where \"syndicate_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, syndicateID, userID)
+
+	err := q.Bind(nil, exec, syndicateMemberObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from syndicate_members")
+	}
+
+	if err = syndicateMemberObj.doAfterSelectHooks(exec); err != nil {
+		return syndicateMemberObj, err
+	}
+
+	return syndicateMemberObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SyndicateMember) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no syndicate_members provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(syndicateMemberColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	syndicateMemberInsertCacheMut.RLock()
+	cache, cached := syndicateMemberInsertCache[key]
+	syndicateMemberInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			syndicateMemberAllColumns,
+			syndicateMemberColumnsWithDefault,
+			syndicateMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(syndicateMemberType, syndicateMemberMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(syndicateMemberType, syndicateMemberMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"syndicate_members\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"syndicate_members\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into syndicate_members")
+	}
+
+	if !cached {
+		syndicateMemberInsertCacheMut.Lock()
+		syndicateMemberInsertCache[key] = cache
+		syndicateMemberInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the SyndicateMember.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SyndicateMember) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	syndicateMemberUpdateCacheMut.RLock()
+	cache, cached := syndicateMemberUpdateCache[key]
+	syndicateMemberUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			syndicateMemberAllColumns,
+			syndicateMemberPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update syndicate_members, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"syndicate_members\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, syndicateMemberPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(syndicateMemberType, syndicateMemberMapping, append(wl, syndicateMemberPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update syndicate_members row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for syndicate_members")
+	}
+
+	if !cached {
+		syndicateMemberUpdateCacheMut.Lock()
+		syndicateMemberUpdateCache[key] = cache
+		syndicateMemberUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q syndicateMemberQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for syndicate_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for syndicate_members")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SyndicateMemberSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), syndicateMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"syndicate_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, syndicateMemberPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in syndicateMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all syndicateMember")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SyndicateMember) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no syndicate_members provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(syndicateMemberColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	syndicateMemberUpsertCacheMut.RLock()
+	cache, cached := syndicateMemberUpsertCache[key]
+	syndicateMemberUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			syndicateMemberAllColumns,
+			syndicateMemberColumnsWithDefault,
+			syndicateMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			syndicateMemberAllColumns,
+			syndicateMemberPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert syndicate_members, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(syndicateMemberPrimaryKeyColumns))
+			copy(conflict, syndicateMemberPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"syndicate_members\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(syndicateMemberType, syndicateMemberMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(syndicateMemberType, syndicateMemberMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert syndicate_members")
+	}
+
+	if !cached {
+		syndicateMemberUpsertCacheMut.Lock()
+		syndicateMemberUpsertCache[key] = cache
+		syndicateMemberUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single SyndicateMember record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SyndicateMember) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no SyndicateMember provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), syndicateMemberPrimaryKeyMapping)
+	sql := "DELETE FROM \"syndicate_members\" WHERE \"syndicate_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from syndicate_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for syndicate_members")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q syndicateMemberQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no syndicateMemberQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from syndicate_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for syndicate_members")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SyndicateMemberSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(syndicateMemberBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), syndicateMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"syndicate_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, syndicateMemberPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from syndicateMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for syndicate_members")
+	}
+
+	if len(syndicateMemberAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SyndicateMember) Reload(exec boil.Executor) error {
+	ret, err := FindSyndicateMember(exec, o.SyndicateID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SyndicateMemberSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SyndicateMemberSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), syndicateMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"syndicate_members\".* FROM \"syndicate_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, syndicateMemberPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in SyndicateMemberSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SyndicateMemberExists checks if the SyndicateMember row exists.
+func SyndicateMemberExists(exec boil.Executor, syndicateID string, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"syndicate_members\" where \"syndicate_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, syndicateID, userID)
+	}
+	row := exec.QueryRow(sql, syndicateID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if syndicate_members exists")
+	}
+
+	return exists, nil
+}
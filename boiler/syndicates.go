@@ -100,20 +100,29 @@ var SyndicateWhere = struct {
 
 // SyndicateRels is where relationship names are stored.
 var SyndicateRels = struct {
-	Account   string
-	Faction   string
-	FoundedBy string
+	Account                     string
+	Faction                     string
+	FoundedBy                   string
+	RenterSyndicateAssetRentals string
+	SyndicateAssetTransfers     string
+	SyndicateMembers            string
 }{
-	Account:   "Account",
-	Faction:   "Faction",
-	FoundedBy: "FoundedBy",
+	Account:                     "Account",
+	Faction:                     "Faction",
+	FoundedBy:                   "FoundedBy",
+	RenterSyndicateAssetRentals: "RenterSyndicateAssetRentals",
+	SyndicateAssetTransfers:     "SyndicateAssetTransfers",
+	SyndicateMembers:            "SyndicateMembers",
 }
 
 // syndicateR is where relationships are stored.
 type syndicateR struct {
-	Account   *Account `boiler:"Account" boil:"Account" json:"Account" toml:"Account" yaml:"Account"`
-	Faction   *Faction `boiler:"Faction" boil:"Faction" json:"Faction" toml:"Faction" yaml:"Faction"`
-	FoundedBy *User    `boiler:"FoundedBy" boil:"FoundedBy" json:"FoundedBy" toml:"FoundedBy" yaml:"FoundedBy"`
+	Account                     *Account                    `boiler:"Account" boil:"Account" json:"Account" toml:"Account" yaml:"Account"`
+	Faction                     *Faction                    `boiler:"Faction" boil:"Faction" json:"Faction" toml:"Faction" yaml:"Faction"`
+	FoundedBy                   *User                       `boiler:"FoundedBy" boil:"FoundedBy" json:"FoundedBy" toml:"FoundedBy" yaml:"FoundedBy"`
+	RenterSyndicateAssetRentals AssetRentalSlice            `boiler:"RenterSyndicateAssetRentals" boil:"RenterSyndicateAssetRentals" json:"RenterSyndicateAssetRentals" toml:"RenterSyndicateAssetRentals" yaml:"RenterSyndicateAssetRentals"`
+	SyndicateAssetTransfers     SyndicateAssetTransferSlice `boiler:"SyndicateAssetTransfers" boil:"SyndicateAssetTransfers" json:"SyndicateAssetTransfers" toml:"SyndicateAssetTransfers" yaml:"SyndicateAssetTransfers"`
+	SyndicateMembers            SyndicateMemberSlice        `boiler:"SyndicateMembers" boil:"SyndicateMembers" json:"SyndicateMembers" toml:"SyndicateMembers" yaml:"SyndicateMembers"`
 }
 
 // NewStruct creates a new relationship struct
@@ -418,6 +427,69 @@ func (o *Syndicate) FoundedBy(mods ...qm.QueryMod) userQuery {
 	return query
 }
 
+// RenterSyndicateAssetRentals retrieves all the asset_rental's AssetRentals with an executor via renter_syndicate_id column.
+func (o *Syndicate) RenterSyndicateAssetRentals(mods ...qm.QueryMod) assetRentalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"asset_rentals\".\"renter_syndicate_id\"=?", o.ID),
+	)
+
+	query := AssetRentals(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_rentals\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"asset_rentals\".*"})
+	}
+
+	return query
+}
+
+// SyndicateAssetTransfers retrieves all the syndicate_asset_transfer's SyndicateAssetTransfers with an executor.
+func (o *Syndicate) SyndicateAssetTransfers(mods ...qm.QueryMod) syndicateAssetTransferQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"syndicate_asset_transfers\".\"syndicate_id\"=?", o.ID),
+	)
+
+	query := SyndicateAssetTransfers(queryMods...)
+	queries.SetFrom(query.Query, "\"syndicate_asset_transfers\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"syndicate_asset_transfers\".*"})
+	}
+
+	return query
+}
+
+// SyndicateMembers retrieves all the syndicate_member's SyndicateMembers with an executor.
+func (o *Syndicate) SyndicateMembers(mods ...qm.QueryMod) syndicateMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"syndicate_members\".\"syndicate_id\"=?", o.ID),
+	)
+
+	query := SyndicateMembers(queryMods...)
+	queries.SetFrom(query.Query, "\"syndicate_members\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"syndicate_members\".*"})
+	}
+
+	return query
+}
+
 // LoadAccount allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (syndicateL) LoadAccount(e boil.Executor, singular bool, maybeSyndicate interface{}, mods queries.Applicator) error {
@@ -732,6 +804,300 @@ func (syndicateL) LoadFoundedBy(e boil.Executor, singular bool, maybeSyndicate i
 	return nil
 }
 
+// LoadRenterSyndicateAssetRentals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (syndicateL) LoadRenterSyndicateAssetRentals(e boil.Executor, singular bool, maybeSyndicate interface{}, mods queries.Applicator) error {
+	var slice []*Syndicate
+	var object *Syndicate
+
+	if singular {
+		object = maybeSyndicate.(*Syndicate)
+	} else {
+		slice = *maybeSyndicate.(*[]*Syndicate)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &syndicateR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &syndicateR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`asset_rentals`),
+		qm.WhereIn(`asset_rentals.renter_syndicate_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load asset_rentals")
+	}
+
+	var resultSlice []*AssetRental
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_rentals")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on asset_rentals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_rentals")
+	}
+
+	if len(assetRentalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RenterSyndicateAssetRentals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &assetRentalR{}
+			}
+			foreign.R.RenterSyndicate = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.RenterSyndicateID) {
+				local.R.RenterSyndicateAssetRentals = append(local.R.RenterSyndicateAssetRentals, foreign)
+				if foreign.R == nil {
+					foreign.R = &assetRentalR{}
+				}
+				foreign.R.RenterSyndicate = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSyndicateAssetTransfers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (syndicateL) LoadSyndicateAssetTransfers(e boil.Executor, singular bool, maybeSyndicate interface{}, mods queries.Applicator) error {
+	var slice []*Syndicate
+	var object *Syndicate
+
+	if singular {
+		object = maybeSyndicate.(*Syndicate)
+	} else {
+		slice = *maybeSyndicate.(*[]*Syndicate)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &syndicateR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &syndicateR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`syndicate_asset_transfers`),
+		qm.WhereIn(`syndicate_asset_transfers.syndicate_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load syndicate_asset_transfers")
+	}
+
+	var resultSlice []*SyndicateAssetTransfer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice syndicate_asset_transfers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on syndicate_asset_transfers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for syndicate_asset_transfers")
+	}
+
+	if len(syndicateAssetTransferAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SyndicateAssetTransfers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &syndicateAssetTransferR{}
+			}
+			foreign.R.Syndicate = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SyndicateID {
+				local.R.SyndicateAssetTransfers = append(local.R.SyndicateAssetTransfers, foreign)
+				if foreign.R == nil {
+					foreign.R = &syndicateAssetTransferR{}
+				}
+				foreign.R.Syndicate = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSyndicateMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (syndicateL) LoadSyndicateMembers(e boil.Executor, singular bool, maybeSyndicate interface{}, mods queries.Applicator) error {
+	var slice []*Syndicate
+	var object *Syndicate
+
+	if singular {
+		object = maybeSyndicate.(*Syndicate)
+	} else {
+		slice = *maybeSyndicate.(*[]*Syndicate)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &syndicateR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &syndicateR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`syndicate_members`),
+		qm.WhereIn(`syndicate_members.syndicate_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load syndicate_members")
+	}
+
+	var resultSlice []*SyndicateMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice syndicate_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on syndicate_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for syndicate_members")
+	}
+
+	if len(syndicateMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SyndicateMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &syndicateMemberR{}
+			}
+			foreign.R.Syndicate = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SyndicateID {
+				local.R.SyndicateMembers = append(local.R.SyndicateMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &syndicateMemberR{}
+				}
+				foreign.R.Syndicate = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetAccount of the syndicate to the related item.
 // Sets o.R.Account to related.
 // Adds o to related.R.Syndicates.
@@ -870,6 +1236,235 @@ func (o *Syndicate) SetFoundedBy(exec boil.Executor, insert bool, related *User)
 	return nil
 }
 
+// AddRenterSyndicateAssetRentals adds the given related objects to the existing relationships
+// of the syndicate, optionally inserting them as new records.
+// Appends related to o.R.RenterSyndicateAssetRentals.
+// Sets related.R.RenterSyndicate appropriately.
+func (o *Syndicate) AddRenterSyndicateAssetRentals(exec boil.Executor, insert bool, related ...*AssetRental) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.RenterSyndicateID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_rentals\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"renter_syndicate_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetRentalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.RenterSyndicateID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &syndicateR{
+			RenterSyndicateAssetRentals: related,
+		}
+	} else {
+		o.R.RenterSyndicateAssetRentals = append(o.R.RenterSyndicateAssetRentals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetRentalR{
+				RenterSyndicate: o,
+			}
+		} else {
+			rel.R.RenterSyndicate = o
+		}
+	}
+	return nil
+}
+
+// SetRenterSyndicateAssetRentals removes all previously related items of the
+// syndicate replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.RenterSyndicate's RenterSyndicateAssetRentals accordingly.
+// Replaces o.R.RenterSyndicateAssetRentals with related.
+// Sets related.R.RenterSyndicate's RenterSyndicateAssetRentals accordingly.
+func (o *Syndicate) SetRenterSyndicateAssetRentals(exec boil.Executor, insert bool, related ...*AssetRental) error {
+	query := "update \"asset_rentals\" set \"renter_syndicate_id\" = null where \"renter_syndicate_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.RenterSyndicateAssetRentals {
+			queries.SetScanner(&rel.RenterSyndicateID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.RenterSyndicate = nil
+		}
+
+		o.R.RenterSyndicateAssetRentals = nil
+	}
+	return o.AddRenterSyndicateAssetRentals(exec, insert, related...)
+}
+
+// RemoveRenterSyndicateAssetRentals relationships from objects passed in.
+// Removes related items from R.RenterSyndicateAssetRentals (uses pointer comparison, removal does not keep order)
+// Sets related.R.RenterSyndicate.
+func (o *Syndicate) RemoveRenterSyndicateAssetRentals(exec boil.Executor, related ...*AssetRental) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.RenterSyndicateID, nil)
+		if rel.R != nil {
+			rel.R.RenterSyndicate = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("renter_syndicate_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.RenterSyndicateAssetRentals {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.RenterSyndicateAssetRentals)
+			if ln > 1 && i < ln-1 {
+				o.R.RenterSyndicateAssetRentals[i] = o.R.RenterSyndicateAssetRentals[ln-1]
+			}
+			o.R.RenterSyndicateAssetRentals = o.R.RenterSyndicateAssetRentals[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSyndicateAssetTransfers adds the given related objects to the existing relationships
+// of the syndicate, optionally inserting them as new records.
+// Appends related to o.R.SyndicateAssetTransfers.
+// Sets related.R.Syndicate appropriately.
+func (o *Syndicate) AddSyndicateAssetTransfers(exec boil.Executor, insert bool, related ...*SyndicateAssetTransfer) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SyndicateID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"syndicate_asset_transfers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"syndicate_id"}),
+				strmangle.WhereClause("\"", "\"", 2, syndicateAssetTransferPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.TransferEventID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SyndicateID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &syndicateR{
+			SyndicateAssetTransfers: related,
+		}
+	} else {
+		o.R.SyndicateAssetTransfers = append(o.R.SyndicateAssetTransfers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &syndicateAssetTransferR{
+				Syndicate: o,
+			}
+		} else {
+			rel.R.Syndicate = o
+		}
+	}
+	return nil
+}
+
+// AddSyndicateMembers adds the given related objects to the existing relationships
+// of the syndicate, optionally inserting them as new records.
+// Appends related to o.R.SyndicateMembers.
+// Sets related.R.Syndicate appropriately.
+func (o *Syndicate) AddSyndicateMembers(exec boil.Executor, insert bool, related ...*SyndicateMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SyndicateID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"syndicate_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"syndicate_id"}),
+				strmangle.WhereClause("\"", "\"", 2, syndicateMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.SyndicateID, rel.UserID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SyndicateID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &syndicateR{
+			SyndicateMembers: related,
+		}
+	} else {
+		o.R.SyndicateMembers = append(o.R.SyndicateMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &syndicateMemberR{
+				Syndicate: o,
+			}
+		} else {
+			rel.R.Syndicate = o
+		}
+	}
+	return nil
+}
+
 // Syndicates retrieves all the records using an executor.
 func Syndicates(mods ...qm.QueryMod) syndicateQuery {
 	mods = append(mods, qm.From("\"syndicates\""), qmhelper.WhereIsNull("\"syndicates\".\"deleted_at\""))
@@ -0,0 +1,1057 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// UserAssetMetadataVersion is an object representing the database table.
+type UserAssetMetadataVersion struct {
+	ID          string     `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserAssetID string     `boiler:"user_asset_id" boil:"user_asset_id" json:"user_asset_id" toml:"user_asset_id" yaml:"user_asset_id"`
+	Version     int        `boiler:"version" boil:"version" json:"version" toml:"version" yaml:"version"`
+	Source      string     `boiler:"source" boil:"source" json:"source" toml:"source" yaml:"source"`
+	Metadata    types.JSON `boiler:"metadata" boil:"metadata" json:"metadata" toml:"metadata" yaml:"metadata"`
+	Diff        types.JSON `boiler:"diff" boil:"diff" json:"diff" toml:"diff" yaml:"diff"`
+	CreatedAt   time.Time  `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *userAssetMetadataVersionR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L userAssetMetadataVersionL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UserAssetMetadataVersionColumns = struct {
+	ID          string
+	UserAssetID string
+	Version     string
+	Source      string
+	Metadata    string
+	Diff        string
+	CreatedAt   string
+}{
+	ID:          "id",
+	UserAssetID: "user_asset_id",
+	Version:     "version",
+	Source:      "source",
+	Metadata:    "metadata",
+	Diff:        "diff",
+	CreatedAt:   "created_at",
+}
+
+var UserAssetMetadataVersionTableColumns = struct {
+	ID          string
+	UserAssetID string
+	Version     string
+	Source      string
+	Metadata    string
+	Diff        string
+	CreatedAt   string
+}{
+	ID:          "user_asset_metadata_versions.id",
+	UserAssetID: "user_asset_metadata_versions.user_asset_id",
+	Version:     "user_asset_metadata_versions.version",
+	Source:      "user_asset_metadata_versions.source",
+	Metadata:    "user_asset_metadata_versions.metadata",
+	Diff:        "user_asset_metadata_versions.diff",
+	CreatedAt:   "user_asset_metadata_versions.created_at",
+}
+
+// Generated where
+
+var UserAssetMetadataVersionWhere = struct {
+	ID          whereHelperstring
+	UserAssetID whereHelperstring
+	Version     whereHelperint
+	Source      whereHelperstring
+	Metadata    whereHelpertypes_JSON
+	Diff        whereHelpertypes_JSON
+	CreatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperstring{field: "\"user_asset_metadata_versions\".\"id\""},
+	UserAssetID: whereHelperstring{field: "\"user_asset_metadata_versions\".\"user_asset_id\""},
+	Version:     whereHelperint{field: "\"user_asset_metadata_versions\".\"version\""},
+	Source:      whereHelperstring{field: "\"user_asset_metadata_versions\".\"source\""},
+	Metadata:    whereHelpertypes_JSON{field: "\"user_asset_metadata_versions\".\"metadata\""},
+	Diff:        whereHelpertypes_JSON{field: "\"user_asset_metadata_versions\".\"diff\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"user_asset_metadata_versions\".\"created_at\""},
+}
+
+// UserAssetMetadataVersionRels is where relationship names are stored.
+var UserAssetMetadataVersionRels = struct {
+	UserAsset string
+}{
+	UserAsset: "UserAsset",
+}
+
+// userAssetMetadataVersionR is where relationships are stored.
+type userAssetMetadataVersionR struct {
+	UserAsset *UserAsset `boiler:"UserAsset" boil:"UserAsset" json:"UserAsset" toml:"UserAsset" yaml:"UserAsset"`
+}
+
+// NewStruct creates a new relationship struct
+func (*userAssetMetadataVersionR) NewStruct() *userAssetMetadataVersionR {
+	return &userAssetMetadataVersionR{}
+}
+
+// userAssetMetadataVersionL is where Load methods for each relationship are stored.
+type userAssetMetadataVersionL struct{}
+
+var (
+	userAssetMetadataVersionAllColumns            = []string{"id", "user_asset_id", "version", "source", "metadata", "diff", "created_at"}
+	userAssetMetadataVersionColumnsWithoutDefault = []string{"user_asset_id", "version", "source", "metadata"}
+	userAssetMetadataVersionColumnsWithDefault    = []string{"id", "diff", "created_at"}
+	userAssetMetadataVersionPrimaryKeyColumns     = []string{"id"}
+	userAssetMetadataVersionGeneratedColumns      = []string{}
+)
+
+type (
+	// UserAssetMetadataVersionSlice is an alias for a slice of pointers to UserAssetMetadataVersion.
+	// This should almost always be used instead of []UserAssetMetadataVersion.
+	UserAssetMetadataVersionSlice []*UserAssetMetadataVersion
+	// UserAssetMetadataVersionHook is the signature for custom UserAssetMetadataVersion hook methods
+	UserAssetMetadataVersionHook func(boil.Executor, *UserAssetMetadataVersion) error
+
+	userAssetMetadataVersionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	userAssetMetadataVersionType                 = reflect.TypeOf(&UserAssetMetadataVersion{})
+	userAssetMetadataVersionMapping              = queries.MakeStructMapping(userAssetMetadataVersionType)
+	userAssetMetadataVersionPrimaryKeyMapping, _ = queries.BindMapping(userAssetMetadataVersionType, userAssetMetadataVersionMapping, userAssetMetadataVersionPrimaryKeyColumns)
+	userAssetMetadataVersionInsertCacheMut       sync.RWMutex
+	userAssetMetadataVersionInsertCache          = make(map[string]insertCache)
+	userAssetMetadataVersionUpdateCacheMut       sync.RWMutex
+	userAssetMetadataVersionUpdateCache          = make(map[string]updateCache)
+	userAssetMetadataVersionUpsertCacheMut       sync.RWMutex
+	userAssetMetadataVersionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var userAssetMetadataVersionAfterSelectHooks []UserAssetMetadataVersionHook
+
+var userAssetMetadataVersionBeforeInsertHooks []UserAssetMetadataVersionHook
+var userAssetMetadataVersionAfterInsertHooks []UserAssetMetadataVersionHook
+
+var userAssetMetadataVersionBeforeUpdateHooks []UserAssetMetadataVersionHook
+var userAssetMetadataVersionAfterUpdateHooks []UserAssetMetadataVersionHook
+
+var userAssetMetadataVersionBeforeDeleteHooks []UserAssetMetadataVersionHook
+var userAssetMetadataVersionAfterDeleteHooks []UserAssetMetadataVersionHook
+
+var userAssetMetadataVersionBeforeUpsertHooks []UserAssetMetadataVersionHook
+var userAssetMetadataVersionAfterUpsertHooks []UserAssetMetadataVersionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *UserAssetMetadataVersion) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetMetadataVersionAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *UserAssetMetadataVersion) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetMetadataVersionBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *UserAssetMetadataVersion) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetMetadataVersionAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *UserAssetMetadataVersion) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetMetadataVersionBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *UserAssetMetadataVersion) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetMetadataVersionAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *UserAssetMetadataVersion) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetMetadataVersionBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *UserAssetMetadataVersion) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetMetadataVersionAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *UserAssetMetadataVersion) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetMetadataVersionBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *UserAssetMetadataVersion) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetMetadataVersionAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUserAssetMetadataVersionHook registers your hook function for all future operations.
+func AddUserAssetMetadataVersionHook(hookPoint boil.HookPoint, userAssetMetadataVersionHook UserAssetMetadataVersionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		userAssetMetadataVersionAfterSelectHooks = append(userAssetMetadataVersionAfterSelectHooks, userAssetMetadataVersionHook)
+	case boil.BeforeInsertHook:
+		userAssetMetadataVersionBeforeInsertHooks = append(userAssetMetadataVersionBeforeInsertHooks, userAssetMetadataVersionHook)
+	case boil.AfterInsertHook:
+		userAssetMetadataVersionAfterInsertHooks = append(userAssetMetadataVersionAfterInsertHooks, userAssetMetadataVersionHook)
+	case boil.BeforeUpdateHook:
+		userAssetMetadataVersionBeforeUpdateHooks = append(userAssetMetadataVersionBeforeUpdateHooks, userAssetMetadataVersionHook)
+	case boil.AfterUpdateHook:
+		userAssetMetadataVersionAfterUpdateHooks = append(userAssetMetadataVersionAfterUpdateHooks, userAssetMetadataVersionHook)
+	case boil.BeforeDeleteHook:
+		userAssetMetadataVersionBeforeDeleteHooks = append(userAssetMetadataVersionBeforeDeleteHooks, userAssetMetadataVersionHook)
+	case boil.AfterDeleteHook:
+		userAssetMetadataVersionAfterDeleteHooks = append(userAssetMetadataVersionAfterDeleteHooks, userAssetMetadataVersionHook)
+	case boil.BeforeUpsertHook:
+		userAssetMetadataVersionBeforeUpsertHooks = append(userAssetMetadataVersionBeforeUpsertHooks, userAssetMetadataVersionHook)
+	case boil.AfterUpsertHook:
+		userAssetMetadataVersionAfterUpsertHooks = append(userAssetMetadataVersionAfterUpsertHooks, userAssetMetadataVersionHook)
+	}
+}
+
+// One returns a single userAssetMetadataVersion record from the query.
+func (q userAssetMetadataVersionQuery) One(exec boil.Executor) (*UserAssetMetadataVersion, error) {
+	o := &UserAssetMetadataVersion{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for user_asset_metadata_versions")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all UserAssetMetadataVersion records from the query.
+func (q userAssetMetadataVersionQuery) All(exec boil.Executor) (UserAssetMetadataVersionSlice, error) {
+	var o []*UserAssetMetadataVersion
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to UserAssetMetadataVersion slice")
+	}
+
+	if len(userAssetMetadataVersionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all UserAssetMetadataVersion records in the query.
+func (q userAssetMetadataVersionQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count user_asset_metadata_versions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q userAssetMetadataVersionQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if user_asset_metadata_versions exists")
+	}
+
+	return count > 0, nil
+}
+
+// UserAsset pointed to by the foreign key.
+func (o *UserAssetMetadataVersion) UserAsset(mods ...qm.QueryMod) userAssetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserAssetID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := UserAssets(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets\"")
+
+	return query
+}
+
+// LoadUserAsset allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userAssetMetadataVersionL) LoadUserAsset(e boil.Executor, singular bool, maybeUserAssetMetadataVersion interface{}, mods queries.Applicator) error {
+	var slice []*UserAssetMetadataVersion
+	var object *UserAssetMetadataVersion
+
+	if singular {
+		object = maybeUserAssetMetadataVersion.(*UserAssetMetadataVersion)
+	} else {
+		slice = *maybeUserAssetMetadataVersion.(*[]*UserAssetMetadataVersion)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssetMetadataVersionR{}
+		}
+		args = append(args, object.UserAssetID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssetMetadataVersionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserAssetID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserAssetID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets`),
+		qm.WhereIn(`user_assets.id in ?`, args...),
+		qmhelper.WhereIsNull(`user_assets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserAsset")
+	}
+
+	var resultSlice []*UserAsset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserAsset")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_assets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
+	}
+
+	if len(userAssetMetadataVersionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserAsset = foreign
+		if foreign.R == nil {
+			foreign.R = &userAssetR{}
+		}
+		foreign.R.UserAssetMetadataVersions = append(foreign.R.UserAssetMetadataVersions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserAssetID == foreign.ID {
+				local.R.UserAsset = foreign
+				if foreign.R == nil {
+					foreign.R = &userAssetR{}
+				}
+				foreign.R.UserAssetMetadataVersions = append(foreign.R.UserAssetMetadataVersions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserAsset of the userAssetMetadataVersion to the related item.
+// Sets o.R.UserAsset to related.
+// Adds o to related.R.UserAssetMetadataVersions.
+func (o *UserAssetMetadataVersion) SetUserAsset(exec boil.Executor, insert bool, related *UserAsset) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_asset_metadata_versions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userAssetMetadataVersionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserAssetID = related.ID
+	if o.R == nil {
+		o.R = &userAssetMetadataVersionR{
+			UserAsset: related,
+		}
+	} else {
+		o.R.UserAsset = related
+	}
+
+	if related.R == nil {
+		related.R = &userAssetR{
+			UserAssetMetadataVersions: UserAssetMetadataVersionSlice{o},
+		}
+	} else {
+		related.R.UserAssetMetadataVersions = append(related.R.UserAssetMetadataVersions, o)
+	}
+
+	return nil
+}
+
+// UserAssetMetadataVersions retrieves all the records using an executor.
+func UserAssetMetadataVersions(mods ...qm.QueryMod) userAssetMetadataVersionQuery {
+	mods = append(mods, qm.From("\"user_asset_metadata_versions\""))
+	return userAssetMetadataVersionQuery{NewQuery(mods...)}
+}
+
+// FindUserAssetMetadataVersion retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUserAssetMetadataVersion(exec boil.Executor, iD string, selectCols ...string) (*UserAssetMetadataVersion, error) {
+	userAssetMetadataVersionObj := &UserAssetMetadataVersion{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"user_asset_metadata_versions\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, userAssetMetadataVersionObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from user_asset_metadata_versions")
+	}
+
+	if err = userAssetMetadataVersionObj.doAfterSelectHooks(exec); err != nil {
+		return userAssetMetadataVersionObj, err
+	}
+
+	return userAssetMetadataVersionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UserAssetMetadataVersion) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no user_asset_metadata_versions provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userAssetMetadataVersionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	userAssetMetadataVersionInsertCacheMut.RLock()
+	cache, cached := userAssetMetadataVersionInsertCache[key]
+	userAssetMetadataVersionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			userAssetMetadataVersionAllColumns,
+			userAssetMetadataVersionColumnsWithDefault,
+			userAssetMetadataVersionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userAssetMetadataVersionType, userAssetMetadataVersionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(userAssetMetadataVersionType, userAssetMetadataVersionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"user_asset_metadata_versions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"user_asset_metadata_versions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into user_asset_metadata_versions")
+	}
+
+	if !cached {
+		userAssetMetadataVersionInsertCacheMut.Lock()
+		userAssetMetadataVersionInsertCache[key] = cache
+		userAssetMetadataVersionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the UserAssetMetadataVersion.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UserAssetMetadataVersion) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	userAssetMetadataVersionUpdateCacheMut.RLock()
+	cache, cached := userAssetMetadataVersionUpdateCache[key]
+	userAssetMetadataVersionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			userAssetMetadataVersionAllColumns,
+			userAssetMetadataVersionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update user_asset_metadata_versions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"user_asset_metadata_versions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, userAssetMetadataVersionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(userAssetMetadataVersionType, userAssetMetadataVersionMapping, append(wl, userAssetMetadataVersionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update user_asset_metadata_versions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for user_asset_metadata_versions")
+	}
+
+	if !cached {
+		userAssetMetadataVersionUpdateCacheMut.Lock()
+		userAssetMetadataVersionUpdateCache[key] = cache
+		userAssetMetadataVersionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q userAssetMetadataVersionQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for user_asset_metadata_versions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for user_asset_metadata_versions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UserAssetMetadataVersionSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userAssetMetadataVersionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"user_asset_metadata_versions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, userAssetMetadataVersionPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in userAssetMetadataVersion slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all userAssetMetadataVersion")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UserAssetMetadataVersion) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no user_asset_metadata_versions provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userAssetMetadataVersionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	userAssetMetadataVersionUpsertCacheMut.RLock()
+	cache, cached := userAssetMetadataVersionUpsertCache[key]
+	userAssetMetadataVersionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			userAssetMetadataVersionAllColumns,
+			userAssetMetadataVersionColumnsWithDefault,
+			userAssetMetadataVersionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			userAssetMetadataVersionAllColumns,
+			userAssetMetadataVersionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert user_asset_metadata_versions, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(userAssetMetadataVersionPrimaryKeyColumns))
+			copy(conflict, userAssetMetadataVersionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"user_asset_metadata_versions\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(userAssetMetadataVersionType, userAssetMetadataVersionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(userAssetMetadataVersionType, userAssetMetadataVersionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert user_asset_metadata_versions")
+	}
+
+	if !cached {
+		userAssetMetadataVersionUpsertCacheMut.Lock()
+		userAssetMetadataVersionUpsertCache[key] = cache
+		userAssetMetadataVersionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single UserAssetMetadataVersion record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UserAssetMetadataVersion) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no UserAssetMetadataVersion provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userAssetMetadataVersionPrimaryKeyMapping)
+	sql := "DELETE FROM \"user_asset_metadata_versions\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from user_asset_metadata_versions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for user_asset_metadata_versions")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q userAssetMetadataVersionQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no userAssetMetadataVersionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from user_asset_metadata_versions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for user_asset_metadata_versions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UserAssetMetadataVersionSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(userAssetMetadataVersionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userAssetMetadataVersionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"user_asset_metadata_versions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userAssetMetadataVersionPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from userAssetMetadataVersion slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for user_asset_metadata_versions")
+	}
+
+	if len(userAssetMetadataVersionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UserAssetMetadataVersion) Reload(exec boil.Executor) error {
+	ret, err := FindUserAssetMetadataVersion(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserAssetMetadataVersionSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UserAssetMetadataVersionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userAssetMetadataVersionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"user_asset_metadata_versions\".* FROM \"user_asset_metadata_versions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userAssetMetadataVersionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in UserAssetMetadataVersionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UserAssetMetadataVersionExists checks if the UserAssetMetadataVersion row exists.
+func UserAssetMetadataVersionExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"user_asset_metadata_versions\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if user_asset_metadata_versions exists")
+	}
+
+	return exists, nil
+}
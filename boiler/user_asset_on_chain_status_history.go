@@ -0,0 +1,1453 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// UserAssetOnChainStatusHistory is an object representing the database table.
+type UserAssetOnChainStatusHistory struct {
+	ID           string      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	AssetHash    string      `boiler:"asset_hash" boil:"asset_hash" json:"asset_hash" toml:"asset_hash" yaml:"asset_hash"`
+	CollectionID string      `boiler:"collection_id" boil:"collection_id" json:"collection_id" toml:"collection_id" yaml:"collection_id"`
+	FromStatus   null.String `boiler:"from_status" boil:"from_status" json:"from_status,omitempty" toml:"from_status" yaml:"from_status,omitempty"`
+	ToStatus     string      `boiler:"to_status" boil:"to_status" json:"to_status" toml:"to_status" yaml:"to_status"`
+	Source       string      `boiler:"source" boil:"source" json:"source" toml:"source" yaml:"source"`
+	TXHash       null.String `boiler:"tx_hash" boil:"tx_hash" json:"tx_hash,omitempty" toml:"tx_hash" yaml:"tx_hash,omitempty"`
+	ChangedByID  null.String `boiler:"changed_by_id" boil:"changed_by_id" json:"changed_by_id,omitempty" toml:"changed_by_id" yaml:"changed_by_id,omitempty"`
+	Note         null.String `boiler:"note" boil:"note" json:"note,omitempty" toml:"note" yaml:"note,omitempty"`
+	CreatedAt    time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *userAssetOnChainStatusHistoryR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L userAssetOnChainStatusHistoryL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UserAssetOnChainStatusHistoryColumns = struct {
+	ID           string
+	AssetHash    string
+	CollectionID string
+	FromStatus   string
+	ToStatus     string
+	Source       string
+	TXHash       string
+	ChangedByID  string
+	Note         string
+	CreatedAt    string
+}{
+	ID:           "id",
+	AssetHash:    "asset_hash",
+	CollectionID: "collection_id",
+	FromStatus:   "from_status",
+	ToStatus:     "to_status",
+	Source:       "source",
+	TXHash:       "tx_hash",
+	ChangedByID:  "changed_by_id",
+	Note:         "note",
+	CreatedAt:    "created_at",
+}
+
+var UserAssetOnChainStatusHistoryTableColumns = struct {
+	ID           string
+	AssetHash    string
+	CollectionID string
+	FromStatus   string
+	ToStatus     string
+	Source       string
+	TXHash       string
+	ChangedByID  string
+	Note         string
+	CreatedAt    string
+}{
+	ID:           "user_asset_on_chain_status_history.id",
+	AssetHash:    "user_asset_on_chain_status_history.asset_hash",
+	CollectionID: "user_asset_on_chain_status_history.collection_id",
+	FromStatus:   "user_asset_on_chain_status_history.from_status",
+	ToStatus:     "user_asset_on_chain_status_history.to_status",
+	Source:       "user_asset_on_chain_status_history.source",
+	TXHash:       "user_asset_on_chain_status_history.tx_hash",
+	ChangedByID:  "user_asset_on_chain_status_history.changed_by_id",
+	Note:         "user_asset_on_chain_status_history.note",
+	CreatedAt:    "user_asset_on_chain_status_history.created_at",
+}
+
+// Generated where
+
+var UserAssetOnChainStatusHistoryWhere = struct {
+	ID           whereHelperstring
+	AssetHash    whereHelperstring
+	CollectionID whereHelperstring
+	FromStatus   whereHelpernull_String
+	ToStatus     whereHelperstring
+	Source       whereHelperstring
+	TXHash       whereHelpernull_String
+	ChangedByID  whereHelpernull_String
+	Note         whereHelpernull_String
+	CreatedAt    whereHelpertime_Time
+}{
+	ID:           whereHelperstring{field: "\"user_asset_on_chain_status_history\".\"id\""},
+	AssetHash:    whereHelperstring{field: "\"user_asset_on_chain_status_history\".\"asset_hash\""},
+	CollectionID: whereHelperstring{field: "\"user_asset_on_chain_status_history\".\"collection_id\""},
+	FromStatus:   whereHelpernull_String{field: "\"user_asset_on_chain_status_history\".\"from_status\""},
+	ToStatus:     whereHelperstring{field: "\"user_asset_on_chain_status_history\".\"to_status\""},
+	Source:       whereHelperstring{field: "\"user_asset_on_chain_status_history\".\"source\""},
+	TXHash:       whereHelpernull_String{field: "\"user_asset_on_chain_status_history\".\"tx_hash\""},
+	ChangedByID:  whereHelpernull_String{field: "\"user_asset_on_chain_status_history\".\"changed_by_id\""},
+	Note:         whereHelpernull_String{field: "\"user_asset_on_chain_status_history\".\"note\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"user_asset_on_chain_status_history\".\"created_at\""},
+}
+
+// UserAssetOnChainStatusHistoryRels is where relationship names are stored.
+var UserAssetOnChainStatusHistoryRels = struct {
+	AssetHashUserAsset string
+	ChangedBy          string
+	Collection         string
+}{
+	AssetHashUserAsset: "AssetHashUserAsset",
+	ChangedBy:          "ChangedBy",
+	Collection:         "Collection",
+}
+
+// userAssetOnChainStatusHistoryR is where relationships are stored.
+type userAssetOnChainStatusHistoryR struct {
+	AssetHashUserAsset *UserAsset  `boiler:"AssetHashUserAsset" boil:"AssetHashUserAsset" json:"AssetHashUserAsset" toml:"AssetHashUserAsset" yaml:"AssetHashUserAsset"`
+	ChangedBy          *User       `boiler:"ChangedBy" boil:"ChangedBy" json:"ChangedBy" toml:"ChangedBy" yaml:"ChangedBy"`
+	Collection         *Collection `boiler:"Collection" boil:"Collection" json:"Collection" toml:"Collection" yaml:"Collection"`
+}
+
+// NewStruct creates a new relationship struct
+func (*userAssetOnChainStatusHistoryR) NewStruct() *userAssetOnChainStatusHistoryR {
+	return &userAssetOnChainStatusHistoryR{}
+}
+
+// userAssetOnChainStatusHistoryL is where Load methods for each relationship are stored.
+type userAssetOnChainStatusHistoryL struct{}
+
+var (
+	userAssetOnChainStatusHistoryAllColumns            = []string{"id", "asset_hash", "collection_id", "from_status", "to_status", "source", "tx_hash", "changed_by_id", "note", "created_at"}
+	userAssetOnChainStatusHistoryColumnsWithoutDefault = []string{"asset_hash", "collection_id", "to_status", "source"}
+	userAssetOnChainStatusHistoryColumnsWithDefault    = []string{"id", "from_status", "tx_hash", "changed_by_id", "note", "created_at"}
+	userAssetOnChainStatusHistoryPrimaryKeyColumns     = []string{"id"}
+	userAssetOnChainStatusHistoryGeneratedColumns      = []string{}
+)
+
+type (
+	// UserAssetOnChainStatusHistorySlice is an alias for a slice of pointers to UserAssetOnChainStatusHistory.
+	// This should almost always be used instead of []UserAssetOnChainStatusHistory.
+	UserAssetOnChainStatusHistorySlice []*UserAssetOnChainStatusHistory
+	// UserAssetOnChainStatusHistoryHook is the signature for custom UserAssetOnChainStatusHistory hook methods
+	UserAssetOnChainStatusHistoryHook func(boil.Executor, *UserAssetOnChainStatusHistory) error
+
+	userAssetOnChainStatusHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	userAssetOnChainStatusHistoryType                 = reflect.TypeOf(&UserAssetOnChainStatusHistory{})
+	userAssetOnChainStatusHistoryMapping              = queries.MakeStructMapping(userAssetOnChainStatusHistoryType)
+	userAssetOnChainStatusHistoryPrimaryKeyMapping, _ = queries.BindMapping(userAssetOnChainStatusHistoryType, userAssetOnChainStatusHistoryMapping, userAssetOnChainStatusHistoryPrimaryKeyColumns)
+	userAssetOnChainStatusHistoryInsertCacheMut       sync.RWMutex
+	userAssetOnChainStatusHistoryInsertCache          = make(map[string]insertCache)
+	userAssetOnChainStatusHistoryUpdateCacheMut       sync.RWMutex
+	userAssetOnChainStatusHistoryUpdateCache          = make(map[string]updateCache)
+	userAssetOnChainStatusHistoryUpsertCacheMut       sync.RWMutex
+	userAssetOnChainStatusHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var userAssetOnChainStatusHistoryAfterSelectHooks []UserAssetOnChainStatusHistoryHook
+
+var userAssetOnChainStatusHistoryBeforeInsertHooks []UserAssetOnChainStatusHistoryHook
+var userAssetOnChainStatusHistoryAfterInsertHooks []UserAssetOnChainStatusHistoryHook
+
+var userAssetOnChainStatusHistoryBeforeUpdateHooks []UserAssetOnChainStatusHistoryHook
+var userAssetOnChainStatusHistoryAfterUpdateHooks []UserAssetOnChainStatusHistoryHook
+
+var userAssetOnChainStatusHistoryBeforeDeleteHooks []UserAssetOnChainStatusHistoryHook
+var userAssetOnChainStatusHistoryAfterDeleteHooks []UserAssetOnChainStatusHistoryHook
+
+var userAssetOnChainStatusHistoryBeforeUpsertHooks []UserAssetOnChainStatusHistoryHook
+var userAssetOnChainStatusHistoryAfterUpsertHooks []UserAssetOnChainStatusHistoryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *UserAssetOnChainStatusHistory) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetOnChainStatusHistoryAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *UserAssetOnChainStatusHistory) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetOnChainStatusHistoryBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *UserAssetOnChainStatusHistory) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetOnChainStatusHistoryAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *UserAssetOnChainStatusHistory) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetOnChainStatusHistoryBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *UserAssetOnChainStatusHistory) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetOnChainStatusHistoryAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *UserAssetOnChainStatusHistory) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetOnChainStatusHistoryBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *UserAssetOnChainStatusHistory) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetOnChainStatusHistoryAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *UserAssetOnChainStatusHistory) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetOnChainStatusHistoryBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *UserAssetOnChainStatusHistory) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAssetOnChainStatusHistoryAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUserAssetOnChainStatusHistoryHook registers your hook function for all future operations.
+func AddUserAssetOnChainStatusHistoryHook(hookPoint boil.HookPoint, userAssetOnChainStatusHistoryHook UserAssetOnChainStatusHistoryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		userAssetOnChainStatusHistoryAfterSelectHooks = append(userAssetOnChainStatusHistoryAfterSelectHooks, userAssetOnChainStatusHistoryHook)
+	case boil.BeforeInsertHook:
+		userAssetOnChainStatusHistoryBeforeInsertHooks = append(userAssetOnChainStatusHistoryBeforeInsertHooks, userAssetOnChainStatusHistoryHook)
+	case boil.AfterInsertHook:
+		userAssetOnChainStatusHistoryAfterInsertHooks = append(userAssetOnChainStatusHistoryAfterInsertHooks, userAssetOnChainStatusHistoryHook)
+	case boil.BeforeUpdateHook:
+		userAssetOnChainStatusHistoryBeforeUpdateHooks = append(userAssetOnChainStatusHistoryBeforeUpdateHooks, userAssetOnChainStatusHistoryHook)
+	case boil.AfterUpdateHook:
+		userAssetOnChainStatusHistoryAfterUpdateHooks = append(userAssetOnChainStatusHistoryAfterUpdateHooks, userAssetOnChainStatusHistoryHook)
+	case boil.BeforeDeleteHook:
+		userAssetOnChainStatusHistoryBeforeDeleteHooks = append(userAssetOnChainStatusHistoryBeforeDeleteHooks, userAssetOnChainStatusHistoryHook)
+	case boil.AfterDeleteHook:
+		userAssetOnChainStatusHistoryAfterDeleteHooks = append(userAssetOnChainStatusHistoryAfterDeleteHooks, userAssetOnChainStatusHistoryHook)
+	case boil.BeforeUpsertHook:
+		userAssetOnChainStatusHistoryBeforeUpsertHooks = append(userAssetOnChainStatusHistoryBeforeUpsertHooks, userAssetOnChainStatusHistoryHook)
+	case boil.AfterUpsertHook:
+		userAssetOnChainStatusHistoryAfterUpsertHooks = append(userAssetOnChainStatusHistoryAfterUpsertHooks, userAssetOnChainStatusHistoryHook)
+	}
+}
+
+// One returns a single userAssetOnChainStatusHistory record from the query.
+func (q userAssetOnChainStatusHistoryQuery) One(exec boil.Executor) (*UserAssetOnChainStatusHistory, error) {
+	o := &UserAssetOnChainStatusHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for user_asset_on_chain_status_history")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all UserAssetOnChainStatusHistory records from the query.
+func (q userAssetOnChainStatusHistoryQuery) All(exec boil.Executor) (UserAssetOnChainStatusHistorySlice, error) {
+	var o []*UserAssetOnChainStatusHistory
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to UserAssetOnChainStatusHistory slice")
+	}
+
+	if len(userAssetOnChainStatusHistoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all UserAssetOnChainStatusHistory records in the query.
+func (q userAssetOnChainStatusHistoryQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count user_asset_on_chain_status_history rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q userAssetOnChainStatusHistoryQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if user_asset_on_chain_status_history exists")
+	}
+
+	return count > 0, nil
+}
+
+// AssetHashUserAsset pointed to by the foreign key.
+func (o *UserAssetOnChainStatusHistory) AssetHashUserAsset(mods ...qm.QueryMod) userAssetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"hash\" = ?", o.AssetHash),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := UserAssets(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets\"")
+
+	return query
+}
+
+// ChangedBy pointed to by the foreign key.
+func (o *UserAssetOnChainStatusHistory) ChangedBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ChangedByID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	return query
+}
+
+// Collection pointed to by the foreign key.
+func (o *UserAssetOnChainStatusHistory) Collection(mods ...qm.QueryMod) collectionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CollectionID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Collections(queryMods...)
+	queries.SetFrom(query.Query, "\"collections\"")
+
+	return query
+}
+
+// LoadAssetHashUserAsset allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userAssetOnChainStatusHistoryL) LoadAssetHashUserAsset(e boil.Executor, singular bool, maybeUserAssetOnChainStatusHistory interface{}, mods queries.Applicator) error {
+	var slice []*UserAssetOnChainStatusHistory
+	var object *UserAssetOnChainStatusHistory
+
+	if singular {
+		object = maybeUserAssetOnChainStatusHistory.(*UserAssetOnChainStatusHistory)
+	} else {
+		slice = *maybeUserAssetOnChainStatusHistory.(*[]*UserAssetOnChainStatusHistory)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssetOnChainStatusHistoryR{}
+		}
+		args = append(args, object.AssetHash)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssetOnChainStatusHistoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AssetHash {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AssetHash)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets`),
+		qm.WhereIn(`user_assets.hash in ?`, args...),
+		qmhelper.WhereIsNull(`user_assets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserAsset")
+	}
+
+	var resultSlice []*UserAsset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserAsset")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_assets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
+	}
+
+	if len(userAssetOnChainStatusHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.AssetHashUserAsset = foreign
+		if foreign.R == nil {
+			foreign.R = &userAssetR{}
+		}
+		foreign.R.AssetHashUserAssetOnChainStatusHistories = append(foreign.R.AssetHashUserAssetOnChainStatusHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AssetHash == foreign.Hash {
+				local.R.AssetHashUserAsset = foreign
+				if foreign.R == nil {
+					foreign.R = &userAssetR{}
+				}
+				foreign.R.AssetHashUserAssetOnChainStatusHistories = append(foreign.R.AssetHashUserAssetOnChainStatusHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChangedBy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userAssetOnChainStatusHistoryL) LoadChangedBy(e boil.Executor, singular bool, maybeUserAssetOnChainStatusHistory interface{}, mods queries.Applicator) error {
+	var slice []*UserAssetOnChainStatusHistory
+	var object *UserAssetOnChainStatusHistory
+
+	if singular {
+		object = maybeUserAssetOnChainStatusHistory.(*UserAssetOnChainStatusHistory)
+	} else {
+		slice = *maybeUserAssetOnChainStatusHistory.(*[]*UserAssetOnChainStatusHistory)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssetOnChainStatusHistoryR{}
+		}
+		if !queries.IsNil(object.ChangedByID) {
+			args = append(args, object.ChangedByID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssetOnChainStatusHistoryR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ChangedByID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ChangedByID) {
+				args = append(args, obj.ChangedByID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAssetOnChainStatusHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ChangedBy = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ChangedByUserAssetOnChainStatusHistories = append(foreign.R.ChangedByUserAssetOnChainStatusHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ChangedByID, foreign.ID) {
+				local.R.ChangedBy = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ChangedByUserAssetOnChainStatusHistories = append(foreign.R.ChangedByUserAssetOnChainStatusHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCollection allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userAssetOnChainStatusHistoryL) LoadCollection(e boil.Executor, singular bool, maybeUserAssetOnChainStatusHistory interface{}, mods queries.Applicator) error {
+	var slice []*UserAssetOnChainStatusHistory
+	var object *UserAssetOnChainStatusHistory
+
+	if singular {
+		object = maybeUserAssetOnChainStatusHistory.(*UserAssetOnChainStatusHistory)
+	} else {
+		slice = *maybeUserAssetOnChainStatusHistory.(*[]*UserAssetOnChainStatusHistory)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssetOnChainStatusHistoryR{}
+		}
+		args = append(args, object.CollectionID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssetOnChainStatusHistoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CollectionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CollectionID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`collections`),
+		qm.WhereIn(`collections.id in ?`, args...),
+		qmhelper.WhereIsNull(`collections.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Collection")
+	}
+
+	var resultSlice []*Collection
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Collection")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for collections")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collections")
+	}
+
+	if len(userAssetOnChainStatusHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Collection = foreign
+		if foreign.R == nil {
+			foreign.R = &collectionR{}
+		}
+		foreign.R.UserAssetOnChainStatusHistories = append(foreign.R.UserAssetOnChainStatusHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CollectionID == foreign.ID {
+				local.R.Collection = foreign
+				if foreign.R == nil {
+					foreign.R = &collectionR{}
+				}
+				foreign.R.UserAssetOnChainStatusHistories = append(foreign.R.UserAssetOnChainStatusHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAssetHashUserAsset of the userAssetOnChainStatusHistory to the related item.
+// Sets o.R.AssetHashUserAsset to related.
+// Adds o to related.R.AssetHashUserAssetOnChainStatusHistories.
+func (o *UserAssetOnChainStatusHistory) SetAssetHashUserAsset(exec boil.Executor, insert bool, related *UserAsset) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_asset_on_chain_status_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"asset_hash"}),
+		strmangle.WhereClause("\"", "\"", 2, userAssetOnChainStatusHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.Hash, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AssetHash = related.Hash
+	if o.R == nil {
+		o.R = &userAssetOnChainStatusHistoryR{
+			AssetHashUserAsset: related,
+		}
+	} else {
+		o.R.AssetHashUserAsset = related
+	}
+
+	if related.R == nil {
+		related.R = &userAssetR{
+			AssetHashUserAssetOnChainStatusHistories: UserAssetOnChainStatusHistorySlice{o},
+		}
+	} else {
+		related.R.AssetHashUserAssetOnChainStatusHistories = append(related.R.AssetHashUserAssetOnChainStatusHistories, o)
+	}
+
+	return nil
+}
+
+// SetChangedBy of the userAssetOnChainStatusHistory to the related item.
+// Sets o.R.ChangedBy to related.
+// Adds o to related.R.ChangedByUserAssetOnChainStatusHistories.
+func (o *UserAssetOnChainStatusHistory) SetChangedBy(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_asset_on_chain_status_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"changed_by_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userAssetOnChainStatusHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ChangedByID, related.ID)
+	if o.R == nil {
+		o.R = &userAssetOnChainStatusHistoryR{
+			ChangedBy: related,
+		}
+	} else {
+		o.R.ChangedBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ChangedByUserAssetOnChainStatusHistories: UserAssetOnChainStatusHistorySlice{o},
+		}
+	} else {
+		related.R.ChangedByUserAssetOnChainStatusHistories = append(related.R.ChangedByUserAssetOnChainStatusHistories, o)
+	}
+
+	return nil
+}
+
+// RemoveChangedBy relationship.
+// Sets o.R.ChangedBy to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *UserAssetOnChainStatusHistory) RemoveChangedBy(exec boil.Executor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ChangedByID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("changed_by_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ChangedBy = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ChangedByUserAssetOnChainStatusHistories {
+		if queries.Equal(o.ChangedByID, ri.ChangedByID) {
+			continue
+		}
+
+		ln := len(related.R.ChangedByUserAssetOnChainStatusHistories)
+		if ln > 1 && i < ln-1 {
+			related.R.ChangedByUserAssetOnChainStatusHistories[i] = related.R.ChangedByUserAssetOnChainStatusHistories[ln-1]
+		}
+		related.R.ChangedByUserAssetOnChainStatusHistories = related.R.ChangedByUserAssetOnChainStatusHistories[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetCollection of the userAssetOnChainStatusHistory to the related item.
+// Sets o.R.Collection to related.
+// Adds o to related.R.UserAssetOnChainStatusHistories.
+func (o *UserAssetOnChainStatusHistory) SetCollection(exec boil.Executor, insert bool, related *Collection) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_asset_on_chain_status_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userAssetOnChainStatusHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CollectionID = related.ID
+	if o.R == nil {
+		o.R = &userAssetOnChainStatusHistoryR{
+			Collection: related,
+		}
+	} else {
+		o.R.Collection = related
+	}
+
+	if related.R == nil {
+		related.R = &collectionR{
+			UserAssetOnChainStatusHistories: UserAssetOnChainStatusHistorySlice{o},
+		}
+	} else {
+		related.R.UserAssetOnChainStatusHistories = append(related.R.UserAssetOnChainStatusHistories, o)
+	}
+
+	return nil
+}
+
+// UserAssetOnChainStatusHistories retrieves all the records using an executor.
+func UserAssetOnChainStatusHistories(mods ...qm.QueryMod) userAssetOnChainStatusHistoryQuery {
+	mods = append(mods, qm.From("\"user_asset_on_chain_status_history\""))
+	return userAssetOnChainStatusHistoryQuery{NewQuery(mods...)}
+}
+
+// FindUserAssetOnChainStatusHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUserAssetOnChainStatusHistory(exec boil.Executor, iD string, selectCols ...string) (*UserAssetOnChainStatusHistory, error) {
+	userAssetOnChainStatusHistoryObj := &UserAssetOnChainStatusHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"user_asset_on_chain_status_history\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, userAssetOnChainStatusHistoryObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from user_asset_on_chain_status_history")
+	}
+
+	if err = userAssetOnChainStatusHistoryObj.doAfterSelectHooks(exec); err != nil {
+		return userAssetOnChainStatusHistoryObj, err
+	}
+
+	return userAssetOnChainStatusHistoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UserAssetOnChainStatusHistory) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no user_asset_on_chain_status_history provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userAssetOnChainStatusHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	userAssetOnChainStatusHistoryInsertCacheMut.RLock()
+	cache, cached := userAssetOnChainStatusHistoryInsertCache[key]
+	userAssetOnChainStatusHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			userAssetOnChainStatusHistoryAllColumns,
+			userAssetOnChainStatusHistoryColumnsWithDefault,
+			userAssetOnChainStatusHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userAssetOnChainStatusHistoryType, userAssetOnChainStatusHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(userAssetOnChainStatusHistoryType, userAssetOnChainStatusHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"user_asset_on_chain_status_history\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"user_asset_on_chain_status_history\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into user_asset_on_chain_status_history")
+	}
+
+	if !cached {
+		userAssetOnChainStatusHistoryInsertCacheMut.Lock()
+		userAssetOnChainStatusHistoryInsertCache[key] = cache
+		userAssetOnChainStatusHistoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the UserAssetOnChainStatusHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UserAssetOnChainStatusHistory) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	userAssetOnChainStatusHistoryUpdateCacheMut.RLock()
+	cache, cached := userAssetOnChainStatusHistoryUpdateCache[key]
+	userAssetOnChainStatusHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			userAssetOnChainStatusHistoryAllColumns,
+			userAssetOnChainStatusHistoryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update user_asset_on_chain_status_history, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"user_asset_on_chain_status_history\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, userAssetOnChainStatusHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(userAssetOnChainStatusHistoryType, userAssetOnChainStatusHistoryMapping, append(wl, userAssetOnChainStatusHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update user_asset_on_chain_status_history row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for user_asset_on_chain_status_history")
+	}
+
+	if !cached {
+		userAssetOnChainStatusHistoryUpdateCacheMut.Lock()
+		userAssetOnChainStatusHistoryUpdateCache[key] = cache
+		userAssetOnChainStatusHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q userAssetOnChainStatusHistoryQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for user_asset_on_chain_status_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for user_asset_on_chain_status_history")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UserAssetOnChainStatusHistorySlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userAssetOnChainStatusHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"user_asset_on_chain_status_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, userAssetOnChainStatusHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in userAssetOnChainStatusHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all userAssetOnChainStatusHistory")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UserAssetOnChainStatusHistory) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no user_asset_on_chain_status_history provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userAssetOnChainStatusHistoryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	userAssetOnChainStatusHistoryUpsertCacheMut.RLock()
+	cache, cached := userAssetOnChainStatusHistoryUpsertCache[key]
+	userAssetOnChainStatusHistoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			userAssetOnChainStatusHistoryAllColumns,
+			userAssetOnChainStatusHistoryColumnsWithDefault,
+			userAssetOnChainStatusHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			userAssetOnChainStatusHistoryAllColumns,
+			userAssetOnChainStatusHistoryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert user_asset_on_chain_status_history, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(userAssetOnChainStatusHistoryPrimaryKeyColumns))
+			copy(conflict, userAssetOnChainStatusHistoryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"user_asset_on_chain_status_history\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(userAssetOnChainStatusHistoryType, userAssetOnChainStatusHistoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(userAssetOnChainStatusHistoryType, userAssetOnChainStatusHistoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert user_asset_on_chain_status_history")
+	}
+
+	if !cached {
+		userAssetOnChainStatusHistoryUpsertCacheMut.Lock()
+		userAssetOnChainStatusHistoryUpsertCache[key] = cache
+		userAssetOnChainStatusHistoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single UserAssetOnChainStatusHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UserAssetOnChainStatusHistory) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no UserAssetOnChainStatusHistory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userAssetOnChainStatusHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"user_asset_on_chain_status_history\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from user_asset_on_chain_status_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for user_asset_on_chain_status_history")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q userAssetOnChainStatusHistoryQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no userAssetOnChainStatusHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from user_asset_on_chain_status_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for user_asset_on_chain_status_history")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UserAssetOnChainStatusHistorySlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(userAssetOnChainStatusHistoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userAssetOnChainStatusHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"user_asset_on_chain_status_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userAssetOnChainStatusHistoryPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from userAssetOnChainStatusHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for user_asset_on_chain_status_history")
+	}
+
+	if len(userAssetOnChainStatusHistoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UserAssetOnChainStatusHistory) Reload(exec boil.Executor) error {
+	ret, err := FindUserAssetOnChainStatusHistory(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserAssetOnChainStatusHistorySlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UserAssetOnChainStatusHistorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userAssetOnChainStatusHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"user_asset_on_chain_status_history\".* FROM \"user_asset_on_chain_status_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userAssetOnChainStatusHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in UserAssetOnChainStatusHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UserAssetOnChainStatusHistoryExists checks if the UserAssetOnChainStatusHistory row exists.
+func UserAssetOnChainStatusHistoryExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"user_asset_on_chain_status_history\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if user_asset_on_chain_status_history exists")
+	}
+
+	return exists, nil
+}
@@ -52,6 +52,8 @@ type UserAsset struct {
 	CreatedAt        time.Time   `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	LockedToService  null.String `boiler:"locked_to_service" boil:"locked_to_service" json:"locked_to_service,omitempty" toml:"locked_to_service" yaml:"locked_to_service,omitempty"`
 	Keywords         null.String `boiler:"keywords" boil:"keywords" json:"keywords,omitempty" toml:"keywords" yaml:"keywords,omitempty"`
+	ImageBlurhash    null.String `boiler:"image_blurhash" boil:"image_blurhash" json:"image_blurhash,omitempty" toml:"image_blurhash" yaml:"image_blurhash,omitempty"`
+	ImageBlurhashURL null.String `boiler:"image_blurhash_url" boil:"image_blurhash_url" json:"image_blurhash_url,omitempty" toml:"image_blurhash_url" yaml:"image_blurhash_url,omitempty"`
 
 	R *userAssetR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userAssetL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -86,6 +88,8 @@ var UserAssetColumns = struct {
 	CreatedAt        string
 	LockedToService  string
 	Keywords         string
+	ImageBlurhash    string
+	ImageBlurhashURL string
 }{
 	ID:               "id",
 	CollectionID:     "collection_id",
@@ -115,6 +119,8 @@ var UserAssetColumns = struct {
 	CreatedAt:        "created_at",
 	LockedToService:  "locked_to_service",
 	Keywords:         "keywords",
+	ImageBlurhash:    "image_blurhash",
+	ImageBlurhashURL: "image_blurhash_url",
 }
 
 var UserAssetTableColumns = struct {
@@ -146,6 +152,8 @@ var UserAssetTableColumns = struct {
 	CreatedAt        string
 	LockedToService  string
 	Keywords         string
+	ImageBlurhash    string
+	ImageBlurhashURL string
 }{
 	ID:               "user_assets.id",
 	CollectionID:     "user_assets.collection_id",
@@ -175,6 +183,8 @@ var UserAssetTableColumns = struct {
 	CreatedAt:        "user_assets.created_at",
 	LockedToService:  "user_assets.locked_to_service",
 	Keywords:         "user_assets.keywords",
+	ImageBlurhash:    "user_assets.image_blurhash",
+	ImageBlurhashURL: "user_assets.image_blurhash_url",
 }
 
 // Generated where
@@ -208,6 +218,8 @@ var UserAssetWhere = struct {
 	CreatedAt        whereHelpertime_Time
 	LockedToService  whereHelpernull_String
 	Keywords         whereHelpernull_String
+	ImageBlurhash    whereHelpernull_String
+	ImageBlurhashURL whereHelpernull_String
 }{
 	ID:               whereHelperstring{field: "\"user_assets\".\"id\""},
 	CollectionID:     whereHelperstring{field: "\"user_assets\".\"collection_id\""},
@@ -237,36 +249,68 @@ var UserAssetWhere = struct {
 	CreatedAt:        whereHelpertime_Time{field: "\"user_assets\".\"created_at\""},
 	LockedToService:  whereHelpernull_String{field: "\"user_assets\".\"locked_to_service\""},
 	Keywords:         whereHelpernull_String{field: "\"user_assets\".\"keywords\""},
+	ImageBlurhash:    whereHelpernull_String{field: "\"user_assets\".\"image_blurhash\""},
+	ImageBlurhashURL: whereHelpernull_String{field: "\"user_assets\".\"image_blurhash_url\""},
 }
 
 // UserAssetRels is where relationship names are stored.
 var UserAssetRels = struct {
-	Collection                        string
-	LockedToServiceUser               string
-	Owner                             string
-	AssetServiceTransferEvents        string
-	UserAssetHashAssetTransferEvents  string
-	AssetTransferEvents               string
-	AssetHashUserAssetOnChainStatuses string
+	Collection                               string
+	LockedToServiceUser                      string
+	Owner                                    string
+	AssetMetadataRefresh                     string
+	AssetRentals                             string
+	AssetServiceLocks                        string
+	AssetServiceTransferEvents               string
+	AssetTradeItems                          string
+	UserAssetHashAssetTransferEvents         string
+	AssetTransferEvents                      string
+	CraftingEventItems                       string
+	MarketplaceListings                      string
+	ReconciliationDiscrepancies              string
+	UserAssetMetadataVersions                string
+	AssetHashUserAssetOnChainStatuses        string
+	AssetHashUserAssetOnChainStatusHistories string
+	AvatarUserAssetUsers                     string
 }{
-	Collection:                        "Collection",
-	LockedToServiceUser:               "LockedToServiceUser",
-	Owner:                             "Owner",
-	AssetServiceTransferEvents:        "AssetServiceTransferEvents",
-	UserAssetHashAssetTransferEvents:  "UserAssetHashAssetTransferEvents",
-	AssetTransferEvents:               "AssetTransferEvents",
-	AssetHashUserAssetOnChainStatuses: "AssetHashUserAssetOnChainStatuses",
+	Collection:                               "Collection",
+	LockedToServiceUser:                      "LockedToServiceUser",
+	Owner:                                    "Owner",
+	AssetMetadataRefresh:                     "AssetMetadataRefresh",
+	AssetRentals:                             "AssetRentals",
+	AssetServiceLocks:                        "AssetServiceLocks",
+	AssetServiceTransferEvents:               "AssetServiceTransferEvents",
+	AssetTradeItems:                          "AssetTradeItems",
+	UserAssetHashAssetTransferEvents:         "UserAssetHashAssetTransferEvents",
+	AssetTransferEvents:                      "AssetTransferEvents",
+	CraftingEventItems:                       "CraftingEventItems",
+	MarketplaceListings:                      "MarketplaceListings",
+	ReconciliationDiscrepancies:              "ReconciliationDiscrepancies",
+	UserAssetMetadataVersions:                "UserAssetMetadataVersions",
+	AssetHashUserAssetOnChainStatuses:        "AssetHashUserAssetOnChainStatuses",
+	AssetHashUserAssetOnChainStatusHistories: "AssetHashUserAssetOnChainStatusHistories",
+	AvatarUserAssetUsers:                     "AvatarUserAssetUsers",
 }
 
 // userAssetR is where relationships are stored.
 type userAssetR struct {
-	Collection                        *Collection                    `boiler:"Collection" boil:"Collection" json:"Collection" toml:"Collection" yaml:"Collection"`
-	LockedToServiceUser               *User                          `boiler:"LockedToServiceUser" boil:"LockedToServiceUser" json:"LockedToServiceUser" toml:"LockedToServiceUser" yaml:"LockedToServiceUser"`
-	Owner                             *User                          `boiler:"Owner" boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
-	AssetServiceTransferEvents        AssetServiceTransferEventSlice `boiler:"AssetServiceTransferEvents" boil:"AssetServiceTransferEvents" json:"AssetServiceTransferEvents" toml:"AssetServiceTransferEvents" yaml:"AssetServiceTransferEvents"`
-	UserAssetHashAssetTransferEvents  AssetTransferEventSlice        `boiler:"UserAssetHashAssetTransferEvents" boil:"UserAssetHashAssetTransferEvents" json:"UserAssetHashAssetTransferEvents" toml:"UserAssetHashAssetTransferEvents" yaml:"UserAssetHashAssetTransferEvents"`
-	AssetTransferEvents               AssetTransferEventSlice        `boiler:"AssetTransferEvents" boil:"AssetTransferEvents" json:"AssetTransferEvents" toml:"AssetTransferEvents" yaml:"AssetTransferEvents"`
-	AssetHashUserAssetOnChainStatuses UserAssetOnChainStatusSlice    `boiler:"AssetHashUserAssetOnChainStatuses" boil:"AssetHashUserAssetOnChainStatuses" json:"AssetHashUserAssetOnChainStatuses" toml:"AssetHashUserAssetOnChainStatuses" yaml:"AssetHashUserAssetOnChainStatuses"`
+	Collection                               *Collection                        `boiler:"Collection" boil:"Collection" json:"Collection" toml:"Collection" yaml:"Collection"`
+	LockedToServiceUser                      *User                              `boiler:"LockedToServiceUser" boil:"LockedToServiceUser" json:"LockedToServiceUser" toml:"LockedToServiceUser" yaml:"LockedToServiceUser"`
+	Owner                                    *User                              `boiler:"Owner" boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
+	AssetMetadataRefresh                     *AssetMetadataRefresh              `boiler:"AssetMetadataRefresh" boil:"AssetMetadataRefresh" json:"AssetMetadataRefresh" toml:"AssetMetadataRefresh" yaml:"AssetMetadataRefresh"`
+	AssetRentals                             AssetRentalSlice                   `boiler:"AssetRentals" boil:"AssetRentals" json:"AssetRentals" toml:"AssetRentals" yaml:"AssetRentals"`
+	AssetServiceLocks                        AssetServiceLockSlice              `boiler:"AssetServiceLocks" boil:"AssetServiceLocks" json:"AssetServiceLocks" toml:"AssetServiceLocks" yaml:"AssetServiceLocks"`
+	AssetServiceTransferEvents               AssetServiceTransferEventSlice     `boiler:"AssetServiceTransferEvents" boil:"AssetServiceTransferEvents" json:"AssetServiceTransferEvents" toml:"AssetServiceTransferEvents" yaml:"AssetServiceTransferEvents"`
+	AssetTradeItems                          AssetTradeItemSlice                `boiler:"AssetTradeItems" boil:"AssetTradeItems" json:"AssetTradeItems" toml:"AssetTradeItems" yaml:"AssetTradeItems"`
+	UserAssetHashAssetTransferEvents         AssetTransferEventSlice            `boiler:"UserAssetHashAssetTransferEvents" boil:"UserAssetHashAssetTransferEvents" json:"UserAssetHashAssetTransferEvents" toml:"UserAssetHashAssetTransferEvents" yaml:"UserAssetHashAssetTransferEvents"`
+	AssetTransferEvents                      AssetTransferEventSlice            `boiler:"AssetTransferEvents" boil:"AssetTransferEvents" json:"AssetTransferEvents" toml:"AssetTransferEvents" yaml:"AssetTransferEvents"`
+	CraftingEventItems                       CraftingEventItemSlice             `boiler:"CraftingEventItems" boil:"CraftingEventItems" json:"CraftingEventItems" toml:"CraftingEventItems" yaml:"CraftingEventItems"`
+	MarketplaceListings                      MarketplaceListingSlice            `boiler:"MarketplaceListings" boil:"MarketplaceListings" json:"MarketplaceListings" toml:"MarketplaceListings" yaml:"MarketplaceListings"`
+	ReconciliationDiscrepancies              ReconciliationDiscrepancySlice     `boiler:"ReconciliationDiscrepancies" boil:"ReconciliationDiscrepancies" json:"ReconciliationDiscrepancies" toml:"ReconciliationDiscrepancies" yaml:"ReconciliationDiscrepancies"`
+	UserAssetMetadataVersions                UserAssetMetadataVersionSlice      `boiler:"UserAssetMetadataVersions" boil:"UserAssetMetadataVersions" json:"UserAssetMetadataVersions" toml:"UserAssetMetadataVersions" yaml:"UserAssetMetadataVersions"`
+	AssetHashUserAssetOnChainStatuses        UserAssetOnChainStatusSlice        `boiler:"AssetHashUserAssetOnChainStatuses" boil:"AssetHashUserAssetOnChainStatuses" json:"AssetHashUserAssetOnChainStatuses" toml:"AssetHashUserAssetOnChainStatuses" yaml:"AssetHashUserAssetOnChainStatuses"`
+	AssetHashUserAssetOnChainStatusHistories UserAssetOnChainStatusHistorySlice `boiler:"AssetHashUserAssetOnChainStatusHistories" boil:"AssetHashUserAssetOnChainStatusHistories" json:"AssetHashUserAssetOnChainStatusHistories" toml:"AssetHashUserAssetOnChainStatusHistories" yaml:"AssetHashUserAssetOnChainStatusHistories"`
+	AvatarUserAssetUsers                     UserSlice                          `boiler:"AvatarUserAssetUsers" boil:"AvatarUserAssetUsers" json:"AvatarUserAssetUsers" toml:"AvatarUserAssetUsers" yaml:"AvatarUserAssetUsers"`
 }
 
 // NewStruct creates a new relationship struct
@@ -278,9 +322,9 @@ func (*userAssetR) NewStruct() *userAssetR {
 type userAssetL struct{}
 
 var (
-	userAssetAllColumns            = []string{"id", "collection_id", "token_id", "tier", "hash", "owner_id", "data", "attributes", "name", "asset_type", "image_url", "external_url", "card_animation_url", "avatar_url", "large_image_url", "description", "background_color", "animation_url", "youtube_url", "unlocked_at", "minted_at", "on_chain_status_old", "deleted_at", "data_refreshed_at", "updated_at", "created_at", "locked_to_service", "keywords"}
+	userAssetAllColumns            = []string{"id", "collection_id", "token_id", "tier", "hash", "owner_id", "data", "attributes", "name", "asset_type", "image_url", "external_url", "card_animation_url", "avatar_url", "large_image_url", "description", "background_color", "animation_url", "youtube_url", "unlocked_at", "minted_at", "on_chain_status_old", "deleted_at", "data_refreshed_at", "updated_at", "created_at", "locked_to_service", "keywords", "image_blurhash", "image_blurhash_url"}
 	userAssetColumnsWithoutDefault = []string{"collection_id", "token_id", "tier", "hash", "owner_id", "name"}
-	userAssetColumnsWithDefault    = []string{"id", "data", "attributes", "asset_type", "image_url", "external_url", "card_animation_url", "avatar_url", "large_image_url", "description", "background_color", "animation_url", "youtube_url", "unlocked_at", "minted_at", "on_chain_status_old", "deleted_at", "data_refreshed_at", "updated_at", "created_at", "locked_to_service", "keywords"}
+	userAssetColumnsWithDefault    = []string{"id", "data", "attributes", "asset_type", "image_url", "external_url", "card_animation_url", "avatar_url", "large_image_url", "description", "background_color", "animation_url", "youtube_url", "unlocked_at", "minted_at", "on_chain_status_old", "deleted_at", "data_refreshed_at", "updated_at", "created_at", "locked_to_service", "keywords", "image_blurhash", "image_blurhash_url"}
 	userAssetPrimaryKeyColumns     = []string{"id"}
 	userAssetGeneratedColumns      = []string{}
 )
@@ -572,6 +616,62 @@ func (o *UserAsset) Owner(mods ...qm.QueryMod) userQuery {
 	return query
 }
 
+// AssetMetadataRefresh pointed to by the foreign key.
+func (o *UserAsset) AssetMetadataRefresh(mods ...qm.QueryMod) assetMetadataRefreshQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_asset_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := AssetMetadataRefreshes(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_metadata_refreshes\"")
+
+	return query
+}
+
+// AssetRentals retrieves all the asset_rental's AssetRentals with an executor.
+func (o *UserAsset) AssetRentals(mods ...qm.QueryMod) assetRentalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"asset_rentals\".\"user_asset_id\"=?", o.ID),
+	)
+
+	query := AssetRentals(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_rentals\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"asset_rentals\".*"})
+	}
+
+	return query
+}
+
+// AssetServiceLocks retrieves all the asset_service_lock's AssetServiceLocks with an executor.
+func (o *UserAsset) AssetServiceLocks(mods ...qm.QueryMod) assetServiceLockQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"asset_service_locks\".\"user_asset_id\"=?", o.ID),
+	)
+
+	query := AssetServiceLocks(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_service_locks\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"asset_service_locks\".*"})
+	}
+
+	return query
+}
+
 // AssetServiceTransferEvents retrieves all the asset_service_transfer_event's AssetServiceTransferEvents with an executor.
 func (o *UserAsset) AssetServiceTransferEvents(mods ...qm.QueryMod) assetServiceTransferEventQuery {
 	var queryMods []qm.QueryMod
@@ -593,6 +693,27 @@ func (o *UserAsset) AssetServiceTransferEvents(mods ...qm.QueryMod) assetService
 	return query
 }
 
+// AssetTradeItems retrieves all the asset_trade_item's AssetTradeItems with an executor.
+func (o *UserAsset) AssetTradeItems(mods ...qm.QueryMod) assetTradeItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"asset_trade_items\".\"user_asset_id\"=?", o.ID),
+	)
+
+	query := AssetTradeItems(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_trade_items\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"asset_trade_items\".*"})
+	}
+
+	return query
+}
+
 // UserAssetHashAssetTransferEvents retrieves all the asset_transfer_event's AssetTransferEvents with an executor via user_asset_hash column.
 func (o *UserAsset) UserAssetHashAssetTransferEvents(mods ...qm.QueryMod) assetTransferEventQuery {
 	var queryMods []qm.QueryMod
@@ -635,6 +756,90 @@ func (o *UserAsset) AssetTransferEvents(mods ...qm.QueryMod) assetTransferEventQ
 	return query
 }
 
+// CraftingEventItems retrieves all the crafting_event_item's CraftingEventItems with an executor.
+func (o *UserAsset) CraftingEventItems(mods ...qm.QueryMod) craftingEventItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"crafting_event_items\".\"user_asset_id\"=?", o.ID),
+	)
+
+	query := CraftingEventItems(queryMods...)
+	queries.SetFrom(query.Query, "\"crafting_event_items\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"crafting_event_items\".*"})
+	}
+
+	return query
+}
+
+// MarketplaceListings retrieves all the marketplace_listing's MarketplaceListings with an executor.
+func (o *UserAsset) MarketplaceListings(mods ...qm.QueryMod) marketplaceListingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"marketplace_listings\".\"user_asset_id\"=?", o.ID),
+	)
+
+	query := MarketplaceListings(queryMods...)
+	queries.SetFrom(query.Query, "\"marketplace_listings\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"marketplace_listings\".*"})
+	}
+
+	return query
+}
+
+// ReconciliationDiscrepancies retrieves all the reconciliation_discrepancy's ReconciliationDiscrepancies with an executor.
+func (o *UserAsset) ReconciliationDiscrepancies(mods ...qm.QueryMod) reconciliationDiscrepancyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reconciliation_discrepancies\".\"user_asset_id\"=?", o.ID),
+	)
+
+	query := ReconciliationDiscrepancies(queryMods...)
+	queries.SetFrom(query.Query, "\"reconciliation_discrepancies\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"reconciliation_discrepancies\".*"})
+	}
+
+	return query
+}
+
+// UserAssetMetadataVersions retrieves all the user_asset_metadata_version's UserAssetMetadataVersions with an executor.
+func (o *UserAsset) UserAssetMetadataVersions(mods ...qm.QueryMod) userAssetMetadataVersionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_asset_metadata_versions\".\"user_asset_id\"=?", o.ID),
+	)
+
+	query := UserAssetMetadataVersions(queryMods...)
+	queries.SetFrom(query.Query, "\"user_asset_metadata_versions\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"user_asset_metadata_versions\".*"})
+	}
+
+	return query
+}
+
 // AssetHashUserAssetOnChainStatuses retrieves all the user_asset_on_chain_status's UserAssetOnChainStatuses with an executor via asset_hash column.
 func (o *UserAsset) AssetHashUserAssetOnChainStatuses(mods ...qm.QueryMod) userAssetOnChainStatusQuery {
 	var queryMods []qm.QueryMod
@@ -656,6 +861,49 @@ func (o *UserAsset) AssetHashUserAssetOnChainStatuses(mods ...qm.QueryMod) userA
 	return query
 }
 
+// AssetHashUserAssetOnChainStatusHistories retrieves all the user_asset_on_chain_status_history's UserAssetOnChainStatusHistories with an executor via asset_hash column.
+func (o *UserAsset) AssetHashUserAssetOnChainStatusHistories(mods ...qm.QueryMod) userAssetOnChainStatusHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_asset_on_chain_status_history\".\"asset_hash\"=?", o.Hash),
+	)
+
+	query := UserAssetOnChainStatusHistories(queryMods...)
+	queries.SetFrom(query.Query, "\"user_asset_on_chain_status_history\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"user_asset_on_chain_status_history\".*"})
+	}
+
+	return query
+}
+
+// AvatarUserAssetUsers retrieves all the user's Users with an executor via avatar_user_asset_id column.
+func (o *UserAsset) AvatarUserAssetUsers(mods ...qm.QueryMod) userQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"users\".\"avatar_user_asset_id\"=?", o.ID),
+		qmhelper.WhereIsNull("\"users\".\"deleted_at\""),
+	)
+
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "\"users\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"users\".*"})
+	}
+
+	return query
+}
+
 // LoadCollection allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (userAssetL) LoadCollection(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
@@ -975,9 +1223,9 @@ func (userAssetL) LoadOwner(e boil.Executor, singular bool, maybeUserAsset inter
 	return nil
 }
 
-// LoadAssetServiceTransferEvents allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userAssetL) LoadAssetServiceTransferEvents(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
+// LoadAssetMetadataRefresh allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userAssetL) LoadAssetMetadataRefresh(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
 	var slice []*UserAsset
 	var object *UserAsset
 
@@ -1015,8 +1263,8 @@ func (userAssetL) LoadAssetServiceTransferEvents(e boil.Executor, singular bool,
 	}
 
 	query := NewQuery(
-		qm.From(`asset_service_transfer_events`),
-		qm.WhereIn(`asset_service_transfer_events.user_asset_id in ?`, args...),
+		qm.From(`asset_metadata_refreshes`),
+		qm.WhereIn(`asset_metadata_refreshes.user_asset_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1024,45 +1272,48 @@ func (userAssetL) LoadAssetServiceTransferEvents(e boil.Executor, singular bool,
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load asset_service_transfer_events")
+		return errors.Wrap(err, "failed to eager load AssetMetadataRefresh")
 	}
 
-	var resultSlice []*AssetServiceTransferEvent
+	var resultSlice []*AssetMetadataRefresh
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice asset_service_transfer_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice AssetMetadataRefresh")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on asset_service_transfer_events")
+		return errors.Wrap(err, "failed to close results of eager load for asset_metadata_refreshes")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_service_transfer_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_metadata_refreshes")
 	}
 
-	if len(assetServiceTransferEventAfterSelectHooks) != 0 {
+	if len(userAssetAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.AssetServiceTransferEvents = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &assetServiceTransferEventR{}
-			}
-			foreign.R.UserAsset = object
+		foreign := resultSlice[0]
+		object.R.AssetMetadataRefresh = foreign
+		if foreign.R == nil {
+			foreign.R = &assetMetadataRefreshR{}
 		}
-		return nil
+		foreign.R.UserAsset = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
 			if local.ID == foreign.UserAssetID {
-				local.R.AssetServiceTransferEvents = append(local.R.AssetServiceTransferEvents, foreign)
+				local.R.AssetMetadataRefresh = foreign
 				if foreign.R == nil {
-					foreign.R = &assetServiceTransferEventR{}
+					foreign.R = &assetMetadataRefreshR{}
 				}
 				foreign.R.UserAsset = local
 				break
@@ -1073,9 +1324,9 @@ func (userAssetL) LoadAssetServiceTransferEvents(e boil.Executor, singular bool,
 	return nil
 }
 
-// LoadUserAssetHashAssetTransferEvents allows an eager lookup of values, cached into the
+// LoadAssetRentals allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userAssetL) LoadUserAssetHashAssetTransferEvents(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
+func (userAssetL) LoadAssetRentals(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
 	var slice []*UserAsset
 	var object *UserAsset
 
@@ -1090,7 +1341,7 @@ func (userAssetL) LoadUserAssetHashAssetTransferEvents(e boil.Executor, singular
 		if object.R == nil {
 			object.R = &userAssetR{}
 		}
-		args = append(args, object.Hash)
+		args = append(args, object.ID)
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -1099,12 +1350,12 @@ func (userAssetL) LoadUserAssetHashAssetTransferEvents(e boil.Executor, singular
 			}
 
 			for _, a := range args {
-				if a == obj.Hash {
+				if a == obj.ID {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.Hash)
+			args = append(args, obj.ID)
 		}
 	}
 
@@ -1113,8 +1364,8 @@ func (userAssetL) LoadUserAssetHashAssetTransferEvents(e boil.Executor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`asset_transfer_events`),
-		qm.WhereIn(`asset_transfer_events.user_asset_hash in ?`, args...),
+		qm.From(`asset_rentals`),
+		qm.WhereIn(`asset_rentals.user_asset_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1122,22 +1373,22 @@ func (userAssetL) LoadUserAssetHashAssetTransferEvents(e boil.Executor, singular
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load asset_transfer_events")
+		return errors.Wrap(err, "failed to eager load asset_rentals")
 	}
 
-	var resultSlice []*AssetTransferEvent
+	var resultSlice []*AssetRental
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice asset_transfer_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_rentals")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on asset_transfer_events")
+		return errors.Wrap(err, "failed to close results in eager load on asset_rentals")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_transfer_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_rentals")
 	}
 
-	if len(assetTransferEventAfterSelectHooks) != 0 {
+	if len(assetRentalAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -1145,24 +1396,24 @@ func (userAssetL) LoadUserAssetHashAssetTransferEvents(e boil.Executor, singular
 		}
 	}
 	if singular {
-		object.R.UserAssetHashAssetTransferEvents = resultSlice
+		object.R.AssetRentals = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &assetTransferEventR{}
+				foreign.R = &assetRentalR{}
 			}
-			foreign.R.UserAssetHashUserAsset = object
+			foreign.R.UserAsset = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.Hash == foreign.UserAssetHash {
-				local.R.UserAssetHashAssetTransferEvents = append(local.R.UserAssetHashAssetTransferEvents, foreign)
+			if local.ID == foreign.UserAssetID {
+				local.R.AssetRentals = append(local.R.AssetRentals, foreign)
 				if foreign.R == nil {
-					foreign.R = &assetTransferEventR{}
+					foreign.R = &assetRentalR{}
 				}
-				foreign.R.UserAssetHashUserAsset = local
+				foreign.R.UserAsset = local
 				break
 			}
 		}
@@ -1171,9 +1422,9 @@ func (userAssetL) LoadUserAssetHashAssetTransferEvents(e boil.Executor, singular
 	return nil
 }
 
-// LoadAssetTransferEvents allows an eager lookup of values, cached into the
+// LoadAssetServiceLocks allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userAssetL) LoadAssetTransferEvents(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
+func (userAssetL) LoadAssetServiceLocks(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
 	var slice []*UserAsset
 	var object *UserAsset
 
@@ -1211,8 +1462,8 @@ func (userAssetL) LoadAssetTransferEvents(e boil.Executor, singular bool, maybeU
 	}
 
 	query := NewQuery(
-		qm.From(`asset_transfer_events`),
-		qm.WhereIn(`asset_transfer_events.user_asset_id in ?`, args...),
+		qm.From(`asset_service_locks`),
+		qm.WhereIn(`asset_service_locks.user_asset_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1220,22 +1471,22 @@ func (userAssetL) LoadAssetTransferEvents(e boil.Executor, singular bool, maybeU
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load asset_transfer_events")
+		return errors.Wrap(err, "failed to eager load asset_service_locks")
 	}
 
-	var resultSlice []*AssetTransferEvent
+	var resultSlice []*AssetServiceLock
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice asset_transfer_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_service_locks")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on asset_transfer_events")
+		return errors.Wrap(err, "failed to close results in eager load on asset_service_locks")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_transfer_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_service_locks")
 	}
 
-	if len(assetTransferEventAfterSelectHooks) != 0 {
+	if len(assetServiceLockAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -1243,10 +1494,10 @@ func (userAssetL) LoadAssetTransferEvents(e boil.Executor, singular bool, maybeU
 		}
 	}
 	if singular {
-		object.R.AssetTransferEvents = resultSlice
+		object.R.AssetServiceLocks = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &assetTransferEventR{}
+				foreign.R = &assetServiceLockR{}
 			}
 			foreign.R.UserAsset = object
 		}
@@ -1256,9 +1507,9 @@ func (userAssetL) LoadAssetTransferEvents(e boil.Executor, singular bool, maybeU
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserAssetID {
-				local.R.AssetTransferEvents = append(local.R.AssetTransferEvents, foreign)
+				local.R.AssetServiceLocks = append(local.R.AssetServiceLocks, foreign)
 				if foreign.R == nil {
-					foreign.R = &assetTransferEventR{}
+					foreign.R = &assetServiceLockR{}
 				}
 				foreign.R.UserAsset = local
 				break
@@ -1269,9 +1520,9 @@ func (userAssetL) LoadAssetTransferEvents(e boil.Executor, singular bool, maybeU
 	return nil
 }
 
-// LoadAssetHashUserAssetOnChainStatuses allows an eager lookup of values, cached into the
+// LoadAssetServiceTransferEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userAssetL) LoadAssetHashUserAssetOnChainStatuses(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
+func (userAssetL) LoadAssetServiceTransferEvents(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
 	var slice []*UserAsset
 	var object *UserAsset
 
@@ -1286,7 +1537,7 @@ func (userAssetL) LoadAssetHashUserAssetOnChainStatuses(e boil.Executor, singula
 		if object.R == nil {
 			object.R = &userAssetR{}
 		}
-		args = append(args, object.Hash)
+		args = append(args, object.ID)
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -1295,12 +1546,12 @@ func (userAssetL) LoadAssetHashUserAssetOnChainStatuses(e boil.Executor, singula
 			}
 
 			for _, a := range args {
-				if a == obj.Hash {
+				if a == obj.ID {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.Hash)
+			args = append(args, obj.ID)
 		}
 	}
 
@@ -1309,8 +1560,8 @@ func (userAssetL) LoadAssetHashUserAssetOnChainStatuses(e boil.Executor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`user_asset_on_chain_status`),
-		qm.WhereIn(`user_asset_on_chain_status.asset_hash in ?`, args...),
+		qm.From(`asset_service_transfer_events`),
+		qm.WhereIn(`asset_service_transfer_events.user_asset_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1318,22 +1569,22 @@ func (userAssetL) LoadAssetHashUserAssetOnChainStatuses(e boil.Executor, singula
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_asset_on_chain_status")
+		return errors.Wrap(err, "failed to eager load asset_service_transfer_events")
 	}
 
-	var resultSlice []*UserAssetOnChainStatus
+	var resultSlice []*AssetServiceTransferEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_asset_on_chain_status")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_service_transfer_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_asset_on_chain_status")
+		return errors.Wrap(err, "failed to close results in eager load on asset_service_transfer_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_asset_on_chain_status")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_service_transfer_events")
 	}
 
-	if len(userAssetOnChainStatusAfterSelectHooks) != 0 {
+	if len(assetServiceTransferEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -1341,24 +1592,220 @@ func (userAssetL) LoadAssetHashUserAssetOnChainStatuses(e boil.Executor, singula
 		}
 	}
 	if singular {
-		object.R.AssetHashUserAssetOnChainStatuses = resultSlice
+		object.R.AssetServiceTransferEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userAssetOnChainStatusR{}
+				foreign.R = &assetServiceTransferEventR{}
 			}
-			foreign.R.AssetHashUserAsset = object
+			foreign.R.UserAsset = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.Hash == foreign.AssetHash {
-				local.R.AssetHashUserAssetOnChainStatuses = append(local.R.AssetHashUserAssetOnChainStatuses, foreign)
+			if local.ID == foreign.UserAssetID {
+				local.R.AssetServiceTransferEvents = append(local.R.AssetServiceTransferEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &userAssetOnChainStatusR{}
+					foreign.R = &assetServiceTransferEventR{}
 				}
-				foreign.R.AssetHashUserAsset = local
+				foreign.R.UserAsset = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAssetTradeItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAssetL) LoadAssetTradeItems(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
+	var slice []*UserAsset
+	var object *UserAsset
+
+	if singular {
+		object = maybeUserAsset.(*UserAsset)
+	} else {
+		slice = *maybeUserAsset.(*[]*UserAsset)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssetR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssetR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`asset_trade_items`),
+		qm.WhereIn(`asset_trade_items.user_asset_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load asset_trade_items")
+	}
+
+	var resultSlice []*AssetTradeItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_trade_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on asset_trade_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_trade_items")
+	}
+
+	if len(assetTradeItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AssetTradeItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &assetTradeItemR{}
+			}
+			foreign.R.UserAsset = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserAssetID) {
+				local.R.AssetTradeItems = append(local.R.AssetTradeItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &assetTradeItemR{}
+				}
+				foreign.R.UserAsset = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAssetHashAssetTransferEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAssetL) LoadUserAssetHashAssetTransferEvents(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
+	var slice []*UserAsset
+	var object *UserAsset
+
+	if singular {
+		object = maybeUserAsset.(*UserAsset)
+	} else {
+		slice = *maybeUserAsset.(*[]*UserAsset)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssetR{}
+		}
+		args = append(args, object.Hash)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssetR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Hash {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Hash)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`asset_transfer_events`),
+		qm.WhereIn(`asset_transfer_events.user_asset_hash in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load asset_transfer_events")
+	}
+
+	var resultSlice []*AssetTransferEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_transfer_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on asset_transfer_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_transfer_events")
+	}
+
+	if len(assetTransferEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserAssetHashAssetTransferEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &assetTransferEventR{}
+			}
+			foreign.R.UserAssetHashUserAsset = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.Hash == foreign.UserAssetHash {
+				local.R.UserAssetHashAssetTransferEvents = append(local.R.UserAssetHashAssetTransferEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &assetTransferEventR{}
+				}
+				foreign.R.UserAssetHashUserAsset = local
 				break
 			}
 		}
@@ -1367,182 +1814,1704 @@ func (userAssetL) LoadAssetHashUserAssetOnChainStatuses(e boil.Executor, singula
 	return nil
 }
 
-// SetCollection of the userAsset to the related item.
-// Sets o.R.Collection to related.
-// Adds o to related.R.UserAssets.
-func (o *UserAsset) SetCollection(exec boil.Executor, insert bool, related *Collection) error {
+// LoadAssetTransferEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAssetL) LoadAssetTransferEvents(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
+	var slice []*UserAsset
+	var object *UserAsset
+
+	if singular {
+		object = maybeUserAsset.(*UserAsset)
+	} else {
+		slice = *maybeUserAsset.(*[]*UserAsset)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssetR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssetR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`asset_transfer_events`),
+		qm.WhereIn(`asset_transfer_events.user_asset_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load asset_transfer_events")
+	}
+
+	var resultSlice []*AssetTransferEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_transfer_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on asset_transfer_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_transfer_events")
+	}
+
+	if len(assetTransferEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AssetTransferEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &assetTransferEventR{}
+			}
+			foreign.R.UserAsset = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserAssetID {
+				local.R.AssetTransferEvents = append(local.R.AssetTransferEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &assetTransferEventR{}
+				}
+				foreign.R.UserAsset = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCraftingEventItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAssetL) LoadCraftingEventItems(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
+	var slice []*UserAsset
+	var object *UserAsset
+
+	if singular {
+		object = maybeUserAsset.(*UserAsset)
+	} else {
+		slice = *maybeUserAsset.(*[]*UserAsset)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssetR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssetR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`crafting_event_items`),
+		qm.WhereIn(`crafting_event_items.user_asset_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load crafting_event_items")
+	}
+
+	var resultSlice []*CraftingEventItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice crafting_event_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on crafting_event_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for crafting_event_items")
+	}
+
+	if len(craftingEventItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CraftingEventItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &craftingEventItemR{}
+			}
+			foreign.R.UserAsset = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserAssetID) {
+				local.R.CraftingEventItems = append(local.R.CraftingEventItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &craftingEventItemR{}
+				}
+				foreign.R.UserAsset = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMarketplaceListings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAssetL) LoadMarketplaceListings(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
+	var slice []*UserAsset
+	var object *UserAsset
+
+	if singular {
+		object = maybeUserAsset.(*UserAsset)
+	} else {
+		slice = *maybeUserAsset.(*[]*UserAsset)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssetR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssetR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`marketplace_listings`),
+		qm.WhereIn(`marketplace_listings.user_asset_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load marketplace_listings")
+	}
+
+	var resultSlice []*MarketplaceListing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice marketplace_listings")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on marketplace_listings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for marketplace_listings")
+	}
+
+	if len(marketplaceListingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MarketplaceListings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &marketplaceListingR{}
+			}
+			foreign.R.UserAsset = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserAssetID) {
+				local.R.MarketplaceListings = append(local.R.MarketplaceListings, foreign)
+				if foreign.R == nil {
+					foreign.R = &marketplaceListingR{}
+				}
+				foreign.R.UserAsset = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReconciliationDiscrepancies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAssetL) LoadReconciliationDiscrepancies(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
+	var slice []*UserAsset
+	var object *UserAsset
+
+	if singular {
+		object = maybeUserAsset.(*UserAsset)
+	} else {
+		slice = *maybeUserAsset.(*[]*UserAsset)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssetR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssetR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`reconciliation_discrepancies`),
+		qm.WhereIn(`reconciliation_discrepancies.user_asset_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reconciliation_discrepancies")
+	}
+
+	var resultSlice []*ReconciliationDiscrepancy
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reconciliation_discrepancies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reconciliation_discrepancies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reconciliation_discrepancies")
+	}
+
+	if len(reconciliationDiscrepancyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReconciliationDiscrepancies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reconciliationDiscrepancyR{}
+			}
+			foreign.R.UserAsset = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserAssetID {
+				local.R.ReconciliationDiscrepancies = append(local.R.ReconciliationDiscrepancies, foreign)
+				if foreign.R == nil {
+					foreign.R = &reconciliationDiscrepancyR{}
+				}
+				foreign.R.UserAsset = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAssetMetadataVersions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAssetL) LoadUserAssetMetadataVersions(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
+	var slice []*UserAsset
+	var object *UserAsset
+
+	if singular {
+		object = maybeUserAsset.(*UserAsset)
+	} else {
+		slice = *maybeUserAsset.(*[]*UserAsset)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssetR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssetR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_asset_metadata_versions`),
+		qm.WhereIn(`user_asset_metadata_versions.user_asset_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_asset_metadata_versions")
+	}
+
+	var resultSlice []*UserAssetMetadataVersion
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_asset_metadata_versions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_asset_metadata_versions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_asset_metadata_versions")
+	}
+
+	if len(userAssetMetadataVersionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserAssetMetadataVersions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userAssetMetadataVersionR{}
+			}
+			foreign.R.UserAsset = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserAssetID {
+				local.R.UserAssetMetadataVersions = append(local.R.UserAssetMetadataVersions, foreign)
+				if foreign.R == nil {
+					foreign.R = &userAssetMetadataVersionR{}
+				}
+				foreign.R.UserAsset = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAssetHashUserAssetOnChainStatuses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAssetL) LoadAssetHashUserAssetOnChainStatuses(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
+	var slice []*UserAsset
+	var object *UserAsset
+
+	if singular {
+		object = maybeUserAsset.(*UserAsset)
+	} else {
+		slice = *maybeUserAsset.(*[]*UserAsset)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssetR{}
+		}
+		args = append(args, object.Hash)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssetR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Hash {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Hash)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_asset_on_chain_status`),
+		qm.WhereIn(`user_asset_on_chain_status.asset_hash in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_asset_on_chain_status")
+	}
+
+	var resultSlice []*UserAssetOnChainStatus
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_asset_on_chain_status")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_asset_on_chain_status")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_asset_on_chain_status")
+	}
+
+	if len(userAssetOnChainStatusAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AssetHashUserAssetOnChainStatuses = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userAssetOnChainStatusR{}
+			}
+			foreign.R.AssetHashUserAsset = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.Hash == foreign.AssetHash {
+				local.R.AssetHashUserAssetOnChainStatuses = append(local.R.AssetHashUserAssetOnChainStatuses, foreign)
+				if foreign.R == nil {
+					foreign.R = &userAssetOnChainStatusR{}
+				}
+				foreign.R.AssetHashUserAsset = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAssetHashUserAssetOnChainStatusHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAssetL) LoadAssetHashUserAssetOnChainStatusHistories(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
+	var slice []*UserAsset
+	var object *UserAsset
+
+	if singular {
+		object = maybeUserAsset.(*UserAsset)
+	} else {
+		slice = *maybeUserAsset.(*[]*UserAsset)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssetR{}
+		}
+		args = append(args, object.Hash)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssetR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Hash {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Hash)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_asset_on_chain_status_history`),
+		qm.WhereIn(`user_asset_on_chain_status_history.asset_hash in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_asset_on_chain_status_history")
+	}
+
+	var resultSlice []*UserAssetOnChainStatusHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_asset_on_chain_status_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_asset_on_chain_status_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_asset_on_chain_status_history")
+	}
+
+	if len(userAssetOnChainStatusHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AssetHashUserAssetOnChainStatusHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userAssetOnChainStatusHistoryR{}
+			}
+			foreign.R.AssetHashUserAsset = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.Hash == foreign.AssetHash {
+				local.R.AssetHashUserAssetOnChainStatusHistories = append(local.R.AssetHashUserAssetOnChainStatusHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &userAssetOnChainStatusHistoryR{}
+				}
+				foreign.R.AssetHashUserAsset = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAvatarUserAssetUsers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAssetL) LoadAvatarUserAssetUsers(e boil.Executor, singular bool, maybeUserAsset interface{}, mods queries.Applicator) error {
+	var slice []*UserAsset
+	var object *UserAsset
+
+	if singular {
+		object = maybeUserAsset.(*UserAsset)
+	} else {
+		slice = *maybeUserAsset.(*[]*UserAsset)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssetR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssetR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.avatar_user_asset_id in ?`, args...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load users")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice users")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AvatarUserAssetUsers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userR{}
+			}
+			foreign.R.AvatarUserAsset = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AvatarUserAssetID) {
+				local.R.AvatarUserAssetUsers = append(local.R.AvatarUserAssetUsers, foreign)
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AvatarUserAsset = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCollection of the userAsset to the related item.
+// Sets o.R.Collection to related.
+// Adds o to related.R.UserAssets.
+func (o *UserAsset) SetCollection(exec boil.Executor, insert bool, related *Collection) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_assets\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userAssetPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CollectionID = related.ID
+	if o.R == nil {
+		o.R = &userAssetR{
+			Collection: related,
+		}
+	} else {
+		o.R.Collection = related
+	}
+
+	if related.R == nil {
+		related.R = &collectionR{
+			UserAssets: UserAssetSlice{o},
+		}
+	} else {
+		related.R.UserAssets = append(related.R.UserAssets, o)
+	}
+
+	return nil
+}
+
+// SetLockedToServiceUser of the userAsset to the related item.
+// Sets o.R.LockedToServiceUser to related.
+// Adds o to related.R.LockedToServiceUserAssets.
+func (o *UserAsset) SetLockedToServiceUser(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_assets\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"locked_to_service"}),
+		strmangle.WhereClause("\"", "\"", 2, userAssetPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.LockedToService, related.ID)
+	if o.R == nil {
+		o.R = &userAssetR{
+			LockedToServiceUser: related,
+		}
+	} else {
+		o.R.LockedToServiceUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			LockedToServiceUserAssets: UserAssetSlice{o},
+		}
+	} else {
+		related.R.LockedToServiceUserAssets = append(related.R.LockedToServiceUserAssets, o)
+	}
+
+	return nil
+}
+
+// RemoveLockedToServiceUser relationship.
+// Sets o.R.LockedToServiceUser to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *UserAsset) RemoveLockedToServiceUser(exec boil.Executor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.LockedToService, nil)
+	if _, err = o.Update(exec, boil.Whitelist("locked_to_service")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.LockedToServiceUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.LockedToServiceUserAssets {
+		if queries.Equal(o.LockedToService, ri.LockedToService) {
+			continue
+		}
+
+		ln := len(related.R.LockedToServiceUserAssets)
+		if ln > 1 && i < ln-1 {
+			related.R.LockedToServiceUserAssets[i] = related.R.LockedToServiceUserAssets[ln-1]
+		}
+		related.R.LockedToServiceUserAssets = related.R.LockedToServiceUserAssets[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetOwner of the userAsset to the related item.
+// Sets o.R.Owner to related.
+// Adds o to related.R.OwnerUserAssets.
+func (o *UserAsset) SetOwner(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_assets\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userAssetPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OwnerID = related.ID
+	if o.R == nil {
+		o.R = &userAssetR{
+			Owner: related,
+		}
+	} else {
+		o.R.Owner = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OwnerUserAssets: UserAssetSlice{o},
+		}
+	} else {
+		related.R.OwnerUserAssets = append(related.R.OwnerUserAssets, o)
+	}
+
+	return nil
+}
+
+// SetAssetMetadataRefresh of the userAsset to the related item.
+// Sets o.R.AssetMetadataRefresh to related.
+// Adds o to related.R.UserAsset.
+func (o *UserAsset) SetAssetMetadataRefresh(exec boil.Executor, insert bool, related *AssetMetadataRefresh) error {
+	var err error
+
+	if insert {
+		related.UserAssetID = o.ID
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"asset_metadata_refreshes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+			strmangle.WhereClause("\"", "\"", 2, assetMetadataRefreshPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserAssetID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserAssetID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &userAssetR{
+			AssetMetadataRefresh: related,
+		}
+	} else {
+		o.R.AssetMetadataRefresh = related
+	}
+
+	if related.R == nil {
+		related.R = &assetMetadataRefreshR{
+			UserAsset: o,
+		}
+	} else {
+		related.R.UserAsset = o
+	}
+	return nil
+}
+
+// AddAssetRentals adds the given related objects to the existing relationships
+// of the user_asset, optionally inserting them as new records.
+// Appends related to o.R.AssetRentals.
+// Sets related.R.UserAsset appropriately.
+func (o *UserAsset) AddAssetRentals(exec boil.Executor, insert bool, related ...*AssetRental) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAssetID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_rentals\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetRentalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAssetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAssetR{
+			AssetRentals: related,
+		}
+	} else {
+		o.R.AssetRentals = append(o.R.AssetRentals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetRentalR{
+				UserAsset: o,
+			}
+		} else {
+			rel.R.UserAsset = o
+		}
+	}
+	return nil
+}
+
+// AddAssetServiceLocks adds the given related objects to the existing relationships
+// of the user_asset, optionally inserting them as new records.
+// Appends related to o.R.AssetServiceLocks.
+// Sets related.R.UserAsset appropriately.
+func (o *UserAsset) AddAssetServiceLocks(exec boil.Executor, insert bool, related ...*AssetServiceLock) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAssetID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_service_locks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetServiceLockPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAssetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAssetR{
+			AssetServiceLocks: related,
+		}
+	} else {
+		o.R.AssetServiceLocks = append(o.R.AssetServiceLocks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetServiceLockR{
+				UserAsset: o,
+			}
+		} else {
+			rel.R.UserAsset = o
+		}
+	}
+	return nil
+}
+
+// AddAssetServiceTransferEvents adds the given related objects to the existing relationships
+// of the user_asset, optionally inserting them as new records.
+// Appends related to o.R.AssetServiceTransferEvents.
+// Sets related.R.UserAsset appropriately.
+func (o *UserAsset) AddAssetServiceTransferEvents(exec boil.Executor, insert bool, related ...*AssetServiceTransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAssetID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_service_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetServiceTransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAssetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAssetR{
+			AssetServiceTransferEvents: related,
+		}
+	} else {
+		o.R.AssetServiceTransferEvents = append(o.R.AssetServiceTransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetServiceTransferEventR{
+				UserAsset: o,
+			}
+		} else {
+			rel.R.UserAsset = o
+		}
+	}
+	return nil
+}
+
+// AddAssetTradeItems adds the given related objects to the existing relationships
+// of the user_asset, optionally inserting them as new records.
+// Appends related to o.R.AssetTradeItems.
+// Sets related.R.UserAsset appropriately.
+func (o *UserAsset) AddAssetTradeItems(exec boil.Executor, insert bool, related ...*AssetTradeItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserAssetID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_trade_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetTradeItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserAssetID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAssetR{
+			AssetTradeItems: related,
+		}
+	} else {
+		o.R.AssetTradeItems = append(o.R.AssetTradeItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetTradeItemR{
+				UserAsset: o,
+			}
+		} else {
+			rel.R.UserAsset = o
+		}
+	}
+	return nil
+}
+
+// SetAssetTradeItems removes all previously related items of the
+// user_asset replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.UserAsset's AssetTradeItems accordingly.
+// Replaces o.R.AssetTradeItems with related.
+// Sets related.R.UserAsset's AssetTradeItems accordingly.
+func (o *UserAsset) SetAssetTradeItems(exec boil.Executor, insert bool, related ...*AssetTradeItem) error {
+	query := "update \"asset_trade_items\" set \"user_asset_id\" = null where \"user_asset_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AssetTradeItems {
+			queries.SetScanner(&rel.UserAssetID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.UserAsset = nil
+		}
+
+		o.R.AssetTradeItems = nil
+	}
+	return o.AddAssetTradeItems(exec, insert, related...)
+}
+
+// RemoveAssetTradeItems relationships from objects passed in.
+// Removes related items from R.AssetTradeItems (uses pointer comparison, removal does not keep order)
+// Sets related.R.UserAsset.
+func (o *UserAsset) RemoveAssetTradeItems(exec boil.Executor, related ...*AssetTradeItem) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserAssetID, nil)
+		if rel.R != nil {
+			rel.R.UserAsset = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("user_asset_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AssetTradeItems {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AssetTradeItems)
+			if ln > 1 && i < ln-1 {
+				o.R.AssetTradeItems[i] = o.R.AssetTradeItems[ln-1]
+			}
+			o.R.AssetTradeItems = o.R.AssetTradeItems[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddUserAssetHashAssetTransferEvents adds the given related objects to the existing relationships
+// of the user_asset, optionally inserting them as new records.
+// Appends related to o.R.UserAssetHashAssetTransferEvents.
+// Sets related.R.UserAssetHashUserAsset appropriately.
+func (o *UserAsset) AddUserAssetHashAssetTransferEvents(exec boil.Executor, insert bool, related ...*AssetTransferEvent) error {
 	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	for _, rel := range related {
+		if insert {
+			rel.UserAssetHash = o.Hash
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_hash"}),
+				strmangle.WhereClause("\"", "\"", 2, assetTransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.Hash, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAssetHash = o.Hash
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"user_assets\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
-		strmangle.WhereClause("\"", "\"", 2, userAssetPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+	if o.R == nil {
+		o.R = &userAssetR{
+			UserAssetHashAssetTransferEvents: related,
+		}
+	} else {
+		o.R.UserAssetHashAssetTransferEvents = append(o.R.UserAssetHashAssetTransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetTransferEventR{
+				UserAssetHashUserAsset: o,
+			}
+		} else {
+			rel.R.UserAssetHashUserAsset = o
+		}
+	}
+	return nil
+}
+
+// AddAssetTransferEvents adds the given related objects to the existing relationships
+// of the user_asset, optionally inserting them as new records.
+// Appends related to o.R.AssetTransferEvents.
+// Sets related.R.UserAsset appropriately.
+func (o *UserAsset) AddAssetTransferEvents(exec boil.Executor, insert bool, related ...*AssetTransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAssetID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetTransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAssetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAssetR{
+			AssetTransferEvents: related,
+		}
+	} else {
+		o.R.AssetTransferEvents = append(o.R.AssetTransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetTransferEventR{
+				UserAsset: o,
+			}
+		} else {
+			rel.R.UserAsset = o
+		}
+	}
+	return nil
+}
+
+// AddCraftingEventItems adds the given related objects to the existing relationships
+// of the user_asset, optionally inserting them as new records.
+// Appends related to o.R.CraftingEventItems.
+// Sets related.R.UserAsset appropriately.
+func (o *UserAsset) AddCraftingEventItems(exec boil.Executor, insert bool, related ...*CraftingEventItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserAssetID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"crafting_event_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+				strmangle.WhereClause("\"", "\"", 2, craftingEventItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserAssetID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAssetR{
+			CraftingEventItems: related,
+		}
+	} else {
+		o.R.CraftingEventItems = append(o.R.CraftingEventItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &craftingEventItemR{
+				UserAsset: o,
+			}
+		} else {
+			rel.R.UserAsset = o
+		}
+	}
+	return nil
+}
 
+// SetCraftingEventItems removes all previously related items of the
+// user_asset replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.UserAsset's CraftingEventItems accordingly.
+// Replaces o.R.CraftingEventItems with related.
+// Sets related.R.UserAsset's CraftingEventItems accordingly.
+func (o *UserAsset) SetCraftingEventItems(exec boil.Executor, insert bool, related ...*CraftingEventItem) error {
+	query := "update \"crafting_event_items\" set \"user_asset_id\" = null where \"user_asset_id\" = $1"
+	values := []interface{}{o.ID}
 	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, query)
 		fmt.Fprintln(boil.DebugWriter, values)
 	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CraftingEventItems {
+			queries.SetScanner(&rel.UserAssetID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.UserAsset = nil
+		}
+
+		o.R.CraftingEventItems = nil
+	}
+	return o.AddCraftingEventItems(exec, insert, related...)
+}
+
+// RemoveCraftingEventItems relationships from objects passed in.
+// Removes related items from R.CraftingEventItems (uses pointer comparison, removal does not keep order)
+// Sets related.R.UserAsset.
+func (o *UserAsset) RemoveCraftingEventItems(exec boil.Executor, related ...*CraftingEventItem) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserAssetID, nil)
+		if rel.R != nil {
+			rel.R.UserAsset = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("user_asset_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CraftingEventItems {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CraftingEventItems)
+			if ln > 1 && i < ln-1 {
+				o.R.CraftingEventItems[i] = o.R.CraftingEventItems[ln-1]
+			}
+			o.R.CraftingEventItems = o.R.CraftingEventItems[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddMarketplaceListings adds the given related objects to the existing relationships
+// of the user_asset, optionally inserting them as new records.
+// Appends related to o.R.MarketplaceListings.
+// Sets related.R.UserAsset appropriately.
+func (o *UserAsset) AddMarketplaceListings(exec boil.Executor, insert bool, related ...*MarketplaceListing) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserAssetID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"marketplace_listings\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+				strmangle.WhereClause("\"", "\"", 2, marketplaceListingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserAssetID, o.ID)
+		}
 	}
 
-	o.CollectionID = related.ID
 	if o.R == nil {
 		o.R = &userAssetR{
-			Collection: related,
+			MarketplaceListings: related,
 		}
 	} else {
-		o.R.Collection = related
+		o.R.MarketplaceListings = append(o.R.MarketplaceListings, related...)
 	}
 
-	if related.R == nil {
-		related.R = &collectionR{
-			UserAssets: UserAssetSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &marketplaceListingR{
+				UserAsset: o,
+			}
+		} else {
+			rel.R.UserAsset = o
 		}
-	} else {
-		related.R.UserAssets = append(related.R.UserAssets, o)
 	}
-
 	return nil
 }
 
-// SetLockedToServiceUser of the userAsset to the related item.
-// Sets o.R.LockedToServiceUser to related.
-// Adds o to related.R.LockedToServiceUserAssets.
-func (o *UserAsset) SetLockedToServiceUser(exec boil.Executor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"user_assets\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"locked_to_service"}),
-		strmangle.WhereClause("\"", "\"", 2, userAssetPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
+// SetMarketplaceListings removes all previously related items of the
+// user_asset replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.UserAsset's MarketplaceListings accordingly.
+// Replaces o.R.MarketplaceListings with related.
+// Sets related.R.UserAsset's MarketplaceListings accordingly.
+func (o *UserAsset) SetMarketplaceListings(exec boil.Executor, insert bool, related ...*MarketplaceListing) error {
+	query := "update \"marketplace_listings\" set \"user_asset_id\" = null where \"user_asset_id\" = $1"
+	values := []interface{}{o.ID}
 	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, query)
 		fmt.Fprintln(boil.DebugWriter, values)
 	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	queries.Assign(&o.LockedToService, related.ID)
-	if o.R == nil {
-		o.R = &userAssetR{
-			LockedToServiceUser: related,
-		}
-	} else {
-		o.R.LockedToServiceUser = related
-	}
+	if o.R != nil {
+		for _, rel := range o.R.MarketplaceListings {
+			queries.SetScanner(&rel.UserAssetID, nil)
+			if rel.R == nil {
+				continue
+			}
 
-	if related.R == nil {
-		related.R = &userR{
-			LockedToServiceUserAssets: UserAssetSlice{o},
+			rel.R.UserAsset = nil
 		}
-	} else {
-		related.R.LockedToServiceUserAssets = append(related.R.LockedToServiceUserAssets, o)
-	}
 
-	return nil
+		o.R.MarketplaceListings = nil
+	}
+	return o.AddMarketplaceListings(exec, insert, related...)
 }
 
-// RemoveLockedToServiceUser relationship.
-// Sets o.R.LockedToServiceUser to nil.
-// Removes o from all passed in related items' relationships struct (Optional).
-func (o *UserAsset) RemoveLockedToServiceUser(exec boil.Executor, related *User) error {
-	var err error
-
-	queries.SetScanner(&o.LockedToService, nil)
-	if _, err = o.Update(exec, boil.Whitelist("locked_to_service")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+// RemoveMarketplaceListings relationships from objects passed in.
+// Removes related items from R.MarketplaceListings (uses pointer comparison, removal does not keep order)
+// Sets related.R.UserAsset.
+func (o *UserAsset) RemoveMarketplaceListings(exec boil.Executor, related ...*MarketplaceListing) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	if o.R != nil {
-		o.R.LockedToServiceUser = nil
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserAssetID, nil)
+		if rel.R != nil {
+			rel.R.UserAsset = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("user_asset_id")); err != nil {
+			return err
+		}
 	}
-	if related == nil || related.R == nil {
+	if o.R == nil {
 		return nil
 	}
 
-	for i, ri := range related.R.LockedToServiceUserAssets {
-		if queries.Equal(o.LockedToService, ri.LockedToService) {
-			continue
-		}
+	for _, rel := range related {
+		for i, ri := range o.R.MarketplaceListings {
+			if rel != ri {
+				continue
+			}
 
-		ln := len(related.R.LockedToServiceUserAssets)
-		if ln > 1 && i < ln-1 {
-			related.R.LockedToServiceUserAssets[i] = related.R.LockedToServiceUserAssets[ln-1]
+			ln := len(o.R.MarketplaceListings)
+			if ln > 1 && i < ln-1 {
+				o.R.MarketplaceListings[i] = o.R.MarketplaceListings[ln-1]
+			}
+			o.R.MarketplaceListings = o.R.MarketplaceListings[:ln-1]
+			break
 		}
-		related.R.LockedToServiceUserAssets = related.R.LockedToServiceUserAssets[:ln-1]
-		break
 	}
+
 	return nil
 }
 
-// SetOwner of the userAsset to the related item.
-// Sets o.R.Owner to related.
-// Adds o to related.R.OwnerUserAssets.
-func (o *UserAsset) SetOwner(exec boil.Executor, insert bool, related *User) error {
+// AddReconciliationDiscrepancies adds the given related objects to the existing relationships
+// of the user_asset, optionally inserting them as new records.
+// Appends related to o.R.ReconciliationDiscrepancies.
+// Sets related.R.UserAsset appropriately.
+func (o *UserAsset) AddReconciliationDiscrepancies(exec boil.Executor, insert bool, related ...*ReconciliationDiscrepancy) error {
 	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
+	for _, rel := range related {
+		if insert {
+			rel.UserAssetID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reconciliation_discrepancies\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reconciliationDiscrepancyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"user_assets\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
-		strmangle.WhereClause("\"", "\"", 2, userAssetPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			rel.UserAssetID = o.ID
+		}
 	}
 
-	o.OwnerID = related.ID
 	if o.R == nil {
 		o.R = &userAssetR{
-			Owner: related,
+			ReconciliationDiscrepancies: related,
 		}
 	} else {
-		o.R.Owner = related
+		o.R.ReconciliationDiscrepancies = append(o.R.ReconciliationDiscrepancies, related...)
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			OwnerUserAssets: UserAssetSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reconciliationDiscrepancyR{
+				UserAsset: o,
+			}
+		} else {
+			rel.R.UserAsset = o
 		}
-	} else {
-		related.R.OwnerUserAssets = append(related.R.OwnerUserAssets, o)
 	}
-
 	return nil
 }
 
-// AddAssetServiceTransferEvents adds the given related objects to the existing relationships
+// AddUserAssetMetadataVersions adds the given related objects to the existing relationships
 // of the user_asset, optionally inserting them as new records.
-// Appends related to o.R.AssetServiceTransferEvents.
+// Appends related to o.R.UserAssetMetadataVersions.
 // Sets related.R.UserAsset appropriately.
-func (o *UserAsset) AddAssetServiceTransferEvents(exec boil.Executor, insert bool, related ...*AssetServiceTransferEvent) error {
+func (o *UserAsset) AddUserAssetMetadataVersions(exec boil.Executor, insert bool, related ...*UserAssetMetadataVersion) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1552,9 +3521,9 @@ func (o *UserAsset) AddAssetServiceTransferEvents(exec boil.Executor, insert boo
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"asset_service_transfer_events\" SET %s WHERE %s",
+				"UPDATE \"user_asset_metadata_versions\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
-				strmangle.WhereClause("\"", "\"", 2, assetServiceTransferEventPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, userAssetMetadataVersionPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1572,15 +3541,15 @@ func (o *UserAsset) AddAssetServiceTransferEvents(exec boil.Executor, insert boo
 
 	if o.R == nil {
 		o.R = &userAssetR{
-			AssetServiceTransferEvents: related,
+			UserAssetMetadataVersions: related,
 		}
 	} else {
-		o.R.AssetServiceTransferEvents = append(o.R.AssetServiceTransferEvents, related...)
+		o.R.UserAssetMetadataVersions = append(o.R.UserAssetMetadataVersions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &assetServiceTransferEventR{
+			rel.R = &userAssetMetadataVersionR{
 				UserAsset: o,
 			}
 		} else {
@@ -1590,23 +3559,23 @@ func (o *UserAsset) AddAssetServiceTransferEvents(exec boil.Executor, insert boo
 	return nil
 }
 
-// AddUserAssetHashAssetTransferEvents adds the given related objects to the existing relationships
+// AddAssetHashUserAssetOnChainStatuses adds the given related objects to the existing relationships
 // of the user_asset, optionally inserting them as new records.
-// Appends related to o.R.UserAssetHashAssetTransferEvents.
-// Sets related.R.UserAssetHashUserAsset appropriately.
-func (o *UserAsset) AddUserAssetHashAssetTransferEvents(exec boil.Executor, insert bool, related ...*AssetTransferEvent) error {
+// Appends related to o.R.AssetHashUserAssetOnChainStatuses.
+// Sets related.R.AssetHashUserAsset appropriately.
+func (o *UserAsset) AddAssetHashUserAssetOnChainStatuses(exec boil.Executor, insert bool, related ...*UserAssetOnChainStatus) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserAssetHash = o.Hash
+			rel.AssetHash = o.Hash
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"asset_transfer_events\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_hash"}),
-				strmangle.WhereClause("\"", "\"", 2, assetTransferEventPrimaryKeyColumns),
+				"UPDATE \"user_asset_on_chain_status\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"asset_hash"}),
+				strmangle.WhereClause("\"", "\"", 2, userAssetOnChainStatusPrimaryKeyColumns),
 			)
 			values := []interface{}{o.Hash, rel.ID}
 
@@ -1618,49 +3587,49 @@ func (o *UserAsset) AddUserAssetHashAssetTransferEvents(exec boil.Executor, inse
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserAssetHash = o.Hash
+			rel.AssetHash = o.Hash
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userAssetR{
-			UserAssetHashAssetTransferEvents: related,
+			AssetHashUserAssetOnChainStatuses: related,
 		}
 	} else {
-		o.R.UserAssetHashAssetTransferEvents = append(o.R.UserAssetHashAssetTransferEvents, related...)
+		o.R.AssetHashUserAssetOnChainStatuses = append(o.R.AssetHashUserAssetOnChainStatuses, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &assetTransferEventR{
-				UserAssetHashUserAsset: o,
+			rel.R = &userAssetOnChainStatusR{
+				AssetHashUserAsset: o,
 			}
 		} else {
-			rel.R.UserAssetHashUserAsset = o
+			rel.R.AssetHashUserAsset = o
 		}
 	}
 	return nil
 }
 
-// AddAssetTransferEvents adds the given related objects to the existing relationships
+// AddAssetHashUserAssetOnChainStatusHistories adds the given related objects to the existing relationships
 // of the user_asset, optionally inserting them as new records.
-// Appends related to o.R.AssetTransferEvents.
-// Sets related.R.UserAsset appropriately.
-func (o *UserAsset) AddAssetTransferEvents(exec boil.Executor, insert bool, related ...*AssetTransferEvent) error {
+// Appends related to o.R.AssetHashUserAssetOnChainStatusHistories.
+// Sets related.R.AssetHashUserAsset appropriately.
+func (o *UserAsset) AddAssetHashUserAssetOnChainStatusHistories(exec boil.Executor, insert bool, related ...*UserAssetOnChainStatusHistory) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserAssetID = o.ID
+			rel.AssetHash = o.Hash
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"asset_transfer_events\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_id"}),
-				strmangle.WhereClause("\"", "\"", 2, assetTransferEventPrimaryKeyColumns),
+				"UPDATE \"user_asset_on_chain_status_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"asset_hash"}),
+				strmangle.WhereClause("\"", "\"", 2, userAssetOnChainStatusHistoryPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.ID}
+			values := []interface{}{o.Hash, rel.ID}
 
 			if boil.DebugMode {
 				fmt.Fprintln(boil.DebugWriter, updateQuery)
@@ -1670,49 +3639,49 @@ func (o *UserAsset) AddAssetTransferEvents(exec boil.Executor, insert bool, rela
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserAssetID = o.ID
+			rel.AssetHash = o.Hash
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userAssetR{
-			AssetTransferEvents: related,
+			AssetHashUserAssetOnChainStatusHistories: related,
 		}
 	} else {
-		o.R.AssetTransferEvents = append(o.R.AssetTransferEvents, related...)
+		o.R.AssetHashUserAssetOnChainStatusHistories = append(o.R.AssetHashUserAssetOnChainStatusHistories, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &assetTransferEventR{
-				UserAsset: o,
+			rel.R = &userAssetOnChainStatusHistoryR{
+				AssetHashUserAsset: o,
 			}
 		} else {
-			rel.R.UserAsset = o
+			rel.R.AssetHashUserAsset = o
 		}
 	}
 	return nil
 }
 
-// AddAssetHashUserAssetOnChainStatuses adds the given related objects to the existing relationships
+// AddAvatarUserAssetUsers adds the given related objects to the existing relationships
 // of the user_asset, optionally inserting them as new records.
-// Appends related to o.R.AssetHashUserAssetOnChainStatuses.
-// Sets related.R.AssetHashUserAsset appropriately.
-func (o *UserAsset) AddAssetHashUserAssetOnChainStatuses(exec boil.Executor, insert bool, related ...*UserAssetOnChainStatus) error {
+// Appends related to o.R.AvatarUserAssetUsers.
+// Sets related.R.AvatarUserAsset appropriately.
+func (o *UserAsset) AddAvatarUserAssetUsers(exec boil.Executor, insert bool, related ...*User) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.AssetHash = o.Hash
+			queries.Assign(&rel.AvatarUserAssetID, o.ID)
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"user_asset_on_chain_status\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"asset_hash"}),
-				strmangle.WhereClause("\"", "\"", 2, userAssetOnChainStatusPrimaryKeyColumns),
+				"UPDATE \"users\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"avatar_user_asset_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
 			)
-			values := []interface{}{o.Hash, rel.ID}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.DebugMode {
 				fmt.Fprintln(boil.DebugWriter, updateQuery)
@@ -1722,27 +3691,100 @@ func (o *UserAsset) AddAssetHashUserAssetOnChainStatuses(exec boil.Executor, ins
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.AssetHash = o.Hash
+			queries.Assign(&rel.AvatarUserAssetID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userAssetR{
-			AssetHashUserAssetOnChainStatuses: related,
+			AvatarUserAssetUsers: related,
 		}
 	} else {
-		o.R.AssetHashUserAssetOnChainStatuses = append(o.R.AssetHashUserAssetOnChainStatuses, related...)
+		o.R.AvatarUserAssetUsers = append(o.R.AvatarUserAssetUsers, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &userAssetOnChainStatusR{
-				AssetHashUserAsset: o,
+			rel.R = &userR{
+				AvatarUserAsset: o,
 			}
 		} else {
-			rel.R.AssetHashUserAsset = o
+			rel.R.AvatarUserAsset = o
+		}
+	}
+	return nil
+}
+
+// SetAvatarUserAssetUsers removes all previously related items of the
+// user_asset replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.AvatarUserAsset's AvatarUserAssetUsers accordingly.
+// Replaces o.R.AvatarUserAssetUsers with related.
+// Sets related.R.AvatarUserAsset's AvatarUserAssetUsers accordingly.
+func (o *UserAsset) SetAvatarUserAssetUsers(exec boil.Executor, insert bool, related ...*User) error {
+	query := "update \"users\" set \"avatar_user_asset_id\" = null where \"avatar_user_asset_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AvatarUserAssetUsers {
+			queries.SetScanner(&rel.AvatarUserAssetID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.AvatarUserAsset = nil
+		}
+
+		o.R.AvatarUserAssetUsers = nil
+	}
+	return o.AddAvatarUserAssetUsers(exec, insert, related...)
+}
+
+// RemoveAvatarUserAssetUsers relationships from objects passed in.
+// Removes related items from R.AvatarUserAssetUsers (uses pointer comparison, removal does not keep order)
+// Sets related.R.AvatarUserAsset.
+func (o *UserAsset) RemoveAvatarUserAssetUsers(exec boil.Executor, related ...*User) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AvatarUserAssetID, nil)
+		if rel.R != nil {
+			rel.R.AvatarUserAsset = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("avatar_user_asset_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AvatarUserAssetUsers {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AvatarUserAssetUsers)
+			if ln > 1 && i < ln-1 {
+				o.R.AvatarUserAssetUsers[i] = o.R.AvatarUserAssetUsers[ln-1]
+			}
+			o.R.AvatarUserAssetUsers = o.R.AvatarUserAssetUsers[:ln-1]
+			break
 		}
 	}
+
 	return nil
 }
 
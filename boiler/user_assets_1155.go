@@ -139,11 +139,21 @@ var UserAssets1155Rels = struct {
 	Collection                                  string
 	Owner                                       string
 	User1155AssetAsset1155ServiceTransferEvents string
+	FromAssetAsset1155TransferEvents            string
+	ToAssetAsset1155TransferEvents              string
+	UserAsset1155AssetTradeItems                string
+	UserAsset1155CraftingEventItems             string
+	UserAsset1155MarketplaceListings            string
 	AssetPending1155Rollbacks                   string
 }{
 	Collection: "Collection",
 	Owner:      "Owner",
 	User1155AssetAsset1155ServiceTransferEvents: "User1155AssetAsset1155ServiceTransferEvents",
+	FromAssetAsset1155TransferEvents:            "FromAssetAsset1155TransferEvents",
+	ToAssetAsset1155TransferEvents:              "ToAssetAsset1155TransferEvents",
+	UserAsset1155AssetTradeItems:                "UserAsset1155AssetTradeItems",
+	UserAsset1155CraftingEventItems:             "UserAsset1155CraftingEventItems",
+	UserAsset1155MarketplaceListings:            "UserAsset1155MarketplaceListings",
 	AssetPending1155Rollbacks:                   "AssetPending1155Rollbacks",
 }
 
@@ -152,6 +162,11 @@ type userAssets1155R struct {
 	Collection                                  *Collection                        `boiler:"Collection" boil:"Collection" json:"Collection" toml:"Collection" yaml:"Collection"`
 	Owner                                       *User                              `boiler:"Owner" boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
 	User1155AssetAsset1155ServiceTransferEvents Asset1155ServiceTransferEventSlice `boiler:"User1155AssetAsset1155ServiceTransferEvents" boil:"User1155AssetAsset1155ServiceTransferEvents" json:"User1155AssetAsset1155ServiceTransferEvents" toml:"User1155AssetAsset1155ServiceTransferEvents" yaml:"User1155AssetAsset1155ServiceTransferEvents"`
+	FromAssetAsset1155TransferEvents            Asset1155TransferEventSlice        `boiler:"FromAssetAsset1155TransferEvents" boil:"FromAssetAsset1155TransferEvents" json:"FromAssetAsset1155TransferEvents" toml:"FromAssetAsset1155TransferEvents" yaml:"FromAssetAsset1155TransferEvents"`
+	ToAssetAsset1155TransferEvents              Asset1155TransferEventSlice        `boiler:"ToAssetAsset1155TransferEvents" boil:"ToAssetAsset1155TransferEvents" json:"ToAssetAsset1155TransferEvents" toml:"ToAssetAsset1155TransferEvents" yaml:"ToAssetAsset1155TransferEvents"`
+	UserAsset1155AssetTradeItems                AssetTradeItemSlice                `boiler:"UserAsset1155AssetTradeItems" boil:"UserAsset1155AssetTradeItems" json:"UserAsset1155AssetTradeItems" toml:"UserAsset1155AssetTradeItems" yaml:"UserAsset1155AssetTradeItems"`
+	UserAsset1155CraftingEventItems             CraftingEventItemSlice             `boiler:"UserAsset1155CraftingEventItems" boil:"UserAsset1155CraftingEventItems" json:"UserAsset1155CraftingEventItems" toml:"UserAsset1155CraftingEventItems" yaml:"UserAsset1155CraftingEventItems"`
+	UserAsset1155MarketplaceListings            MarketplaceListingSlice            `boiler:"UserAsset1155MarketplaceListings" boil:"UserAsset1155MarketplaceListings" json:"UserAsset1155MarketplaceListings" toml:"UserAsset1155MarketplaceListings" yaml:"UserAsset1155MarketplaceListings"`
 	AssetPending1155Rollbacks                   Pending1155RollbackSlice           `boiler:"AssetPending1155Rollbacks" boil:"AssetPending1155Rollbacks" json:"AssetPending1155Rollbacks" toml:"AssetPending1155Rollbacks" yaml:"AssetPending1155Rollbacks"`
 }
 
@@ -464,6 +479,111 @@ func (o *UserAssets1155) User1155AssetAsset1155ServiceTransferEvents(mods ...qm.
 	return query
 }
 
+// FromAssetAsset1155TransferEvents retrieves all the asset1155_transfer_event's Asset1155TransferEvents with an executor via from_asset_id column.
+func (o *UserAssets1155) FromAssetAsset1155TransferEvents(mods ...qm.QueryMod) asset1155TransferEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"asset1155_transfer_events\".\"from_asset_id\"=?", o.ID),
+	)
+
+	query := Asset1155TransferEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"asset1155_transfer_events\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"asset1155_transfer_events\".*"})
+	}
+
+	return query
+}
+
+// ToAssetAsset1155TransferEvents retrieves all the asset1155_transfer_event's Asset1155TransferEvents with an executor via to_asset_id column.
+func (o *UserAssets1155) ToAssetAsset1155TransferEvents(mods ...qm.QueryMod) asset1155TransferEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"asset1155_transfer_events\".\"to_asset_id\"=?", o.ID),
+	)
+
+	query := Asset1155TransferEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"asset1155_transfer_events\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"asset1155_transfer_events\".*"})
+	}
+
+	return query
+}
+
+// UserAsset1155AssetTradeItems retrieves all the asset_trade_item's AssetTradeItems with an executor via user_asset_1155_id column.
+func (o *UserAssets1155) UserAsset1155AssetTradeItems(mods ...qm.QueryMod) assetTradeItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"asset_trade_items\".\"user_asset_1155_id\"=?", o.ID),
+	)
+
+	query := AssetTradeItems(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_trade_items\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"asset_trade_items\".*"})
+	}
+
+	return query
+}
+
+// UserAsset1155CraftingEventItems retrieves all the crafting_event_item's CraftingEventItems with an executor via user_asset_1155_id column.
+func (o *UserAssets1155) UserAsset1155CraftingEventItems(mods ...qm.QueryMod) craftingEventItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"crafting_event_items\".\"user_asset_1155_id\"=?", o.ID),
+	)
+
+	query := CraftingEventItems(queryMods...)
+	queries.SetFrom(query.Query, "\"crafting_event_items\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"crafting_event_items\".*"})
+	}
+
+	return query
+}
+
+// UserAsset1155MarketplaceListings retrieves all the marketplace_listing's MarketplaceListings with an executor via user_asset_1155_id column.
+func (o *UserAssets1155) UserAsset1155MarketplaceListings(mods ...qm.QueryMod) marketplaceListingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"marketplace_listings\".\"user_asset_1155_id\"=?", o.ID),
+	)
+
+	query := MarketplaceListings(queryMods...)
+	queries.SetFrom(query.Query, "\"marketplace_listings\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"marketplace_listings\".*"})
+	}
+
+	return query
+}
+
 // AssetPending1155Rollbacks retrieves all the pending_1155_rollback's Pending1155Rollbacks with an executor via asset_id column.
 func (o *UserAssets1155) AssetPending1155Rollbacks(mods ...qm.QueryMod) pending1155RollbackQuery {
 	var queryMods []qm.QueryMod
@@ -794,9 +914,9 @@ func (userAssets1155L) LoadUser1155AssetAsset1155ServiceTransferEvents(e boil.Ex
 	return nil
 }
 
-// LoadAssetPending1155Rollbacks allows an eager lookup of values, cached into the
+// LoadFromAssetAsset1155TransferEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userAssets1155L) LoadAssetPending1155Rollbacks(e boil.Executor, singular bool, maybeUserAssets1155 interface{}, mods queries.Applicator) error {
+func (userAssets1155L) LoadFromAssetAsset1155TransferEvents(e boil.Executor, singular bool, maybeUserAssets1155 interface{}, mods queries.Applicator) error {
 	var slice []*UserAssets1155
 	var object *UserAssets1155
 
@@ -834,9 +954,8 @@ func (userAssets1155L) LoadAssetPending1155Rollbacks(e boil.Executor, singular b
 	}
 
 	query := NewQuery(
-		qm.From(`pending_1155_rollback`),
-		qm.WhereIn(`pending_1155_rollback.asset_id in ?`, args...),
-		qmhelper.WhereIsNull(`pending_1155_rollback.deleted_at`),
+		qm.From(`asset1155_transfer_events`),
+		qm.WhereIn(`asset1155_transfer_events.from_asset_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -844,22 +963,22 @@ func (userAssets1155L) LoadAssetPending1155Rollbacks(e boil.Executor, singular b
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load pending_1155_rollback")
+		return errors.Wrap(err, "failed to eager load asset1155_transfer_events")
 	}
 
-	var resultSlice []*Pending1155Rollback
+	var resultSlice []*Asset1155TransferEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice pending_1155_rollback")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset1155_transfer_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on pending_1155_rollback")
+		return errors.Wrap(err, "failed to close results in eager load on asset1155_transfer_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pending_1155_rollback")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset1155_transfer_events")
 	}
 
-	if len(pending1155RollbackAfterSelectHooks) != 0 {
+	if len(asset1155TransferEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -867,24 +986,24 @@ func (userAssets1155L) LoadAssetPending1155Rollbacks(e boil.Executor, singular b
 		}
 	}
 	if singular {
-		object.R.AssetPending1155Rollbacks = resultSlice
+		object.R.FromAssetAsset1155TransferEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &pending1155RollbackR{}
+				foreign.R = &asset1155TransferEventR{}
 			}
-			foreign.R.Asset = object
+			foreign.R.FromAsset = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.AssetID {
-				local.R.AssetPending1155Rollbacks = append(local.R.AssetPending1155Rollbacks, foreign)
+			if local.ID == foreign.FromAssetID {
+				local.R.FromAssetAsset1155TransferEvents = append(local.R.FromAssetAsset1155TransferEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &pending1155RollbackR{}
+					foreign.R = &asset1155TransferEventR{}
 				}
-				foreign.R.Asset = local
+				foreign.R.FromAsset = local
 				break
 			}
 		}
@@ -893,147 +1012,1117 @@ func (userAssets1155L) LoadAssetPending1155Rollbacks(e boil.Executor, singular b
 	return nil
 }
 
-// SetCollection of the userAssets1155 to the related item.
-// Sets o.R.Collection to related.
-// Adds o to related.R.UserAssets1155S.
-func (o *UserAssets1155) SetCollection(exec boil.Executor, insert bool, related *Collection) error {
-	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"user_assets_1155\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
-		strmangle.WhereClause("\"", "\"", 2, userAssets1155PrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadToAssetAsset1155TransferEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAssets1155L) LoadToAssetAsset1155TransferEvents(e boil.Executor, singular bool, maybeUserAssets1155 interface{}, mods queries.Applicator) error {
+	var slice []*UserAssets1155
+	var object *UserAssets1155
 
-	o.CollectionID = related.ID
-	if o.R == nil {
-		o.R = &userAssets1155R{
-			Collection: related,
-		}
+	if singular {
+		object = maybeUserAssets1155.(*UserAssets1155)
 	} else {
-		o.R.Collection = related
+		slice = *maybeUserAssets1155.(*[]*UserAssets1155)
 	}
 
-	if related.R == nil {
-		related.R = &collectionR{
-			UserAssets1155S: UserAssets1155Slice{o},
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssets1155R{}
 		}
+		args = append(args, object.ID)
 	} else {
-		related.R.UserAssets1155S = append(related.R.UserAssets1155S, o)
-	}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssets1155R{}
+			}
 
-	return nil
-}
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
 
-// SetOwner of the userAssets1155 to the related item.
-// Sets o.R.Owner to related.
-// Adds o to related.R.OwnerUserAssets1155S.
-func (o *UserAssets1155) SetOwner(exec boil.Executor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+			args = append(args, obj.ID)
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"user_assets_1155\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
-		strmangle.WhereClause("\"", "\"", 2, userAssets1155PrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	if len(args) == 0 {
+		return nil
 	}
 
-	o.OwnerID = related.ID
-	if o.R == nil {
-		o.R = &userAssets1155R{
-			Owner: related,
-		}
-	} else {
-		o.R.Owner = related
+	query := NewQuery(
+		qm.From(`asset1155_transfer_events`),
+		qm.WhereIn(`asset1155_transfer_events.to_asset_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			OwnerUserAssets1155S: UserAssets1155Slice{o},
-		}
-	} else {
-		related.R.OwnerUserAssets1155S = append(related.R.OwnerUserAssets1155S, o)
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load asset1155_transfer_events")
 	}
 
-	return nil
-}
+	var resultSlice []*Asset1155TransferEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice asset1155_transfer_events")
+	}
 
-// AddUser1155AssetAsset1155ServiceTransferEvents adds the given related objects to the existing relationships
-// of the user_assets_1155, optionally inserting them as new records.
-// Appends related to o.R.User1155AssetAsset1155ServiceTransferEvents.
-// Sets related.R.User1155Asset appropriately.
-func (o *UserAssets1155) AddUser1155AssetAsset1155ServiceTransferEvents(exec boil.Executor, insert bool, related ...*Asset1155ServiceTransferEvent) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.User1155AssetID = o.ID
-			if err = rel.Insert(exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"asset1155_service_transfer_events\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user1155_asset_id"}),
-				strmangle.WhereClause("\"", "\"", 2, asset1155ServiceTransferEventPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on asset1155_transfer_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset1155_transfer_events")
+	}
 
-			if boil.DebugMode {
-				fmt.Fprintln(boil.DebugWriter, updateQuery)
-				fmt.Fprintln(boil.DebugWriter, values)
-			}
-			if _, err = exec.Exec(updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+	if len(asset1155TransferEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
 			}
-
-			rel.User1155AssetID = o.ID
 		}
 	}
-
-	if o.R == nil {
-		o.R = &userAssets1155R{
-			User1155AssetAsset1155ServiceTransferEvents: related,
+	if singular {
+		object.R.ToAssetAsset1155TransferEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &asset1155TransferEventR{}
+			}
+			foreign.R.ToAsset = object
 		}
-	} else {
-		o.R.User1155AssetAsset1155ServiceTransferEvents = append(o.R.User1155AssetAsset1155ServiceTransferEvents, related...)
+		return nil
 	}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &asset1155ServiceTransferEventR{
-				User1155Asset: o,
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ToAssetID {
+				local.R.ToAssetAsset1155TransferEvents = append(local.R.ToAssetAsset1155TransferEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &asset1155TransferEventR{}
+				}
+				foreign.R.ToAsset = local
+				break
 			}
-		} else {
-			rel.R.User1155Asset = o
 		}
 	}
+
+	return nil
+}
+
+// LoadUserAsset1155AssetTradeItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAssets1155L) LoadUserAsset1155AssetTradeItems(e boil.Executor, singular bool, maybeUserAssets1155 interface{}, mods queries.Applicator) error {
+	var slice []*UserAssets1155
+	var object *UserAssets1155
+
+	if singular {
+		object = maybeUserAssets1155.(*UserAssets1155)
+	} else {
+		slice = *maybeUserAssets1155.(*[]*UserAssets1155)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssets1155R{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssets1155R{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`asset_trade_items`),
+		qm.WhereIn(`asset_trade_items.user_asset_1155_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load asset_trade_items")
+	}
+
+	var resultSlice []*AssetTradeItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_trade_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on asset_trade_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_trade_items")
+	}
+
+	if len(assetTradeItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserAsset1155AssetTradeItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &assetTradeItemR{}
+			}
+			foreign.R.UserAsset1155 = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserAsset1155ID) {
+				local.R.UserAsset1155AssetTradeItems = append(local.R.UserAsset1155AssetTradeItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &assetTradeItemR{}
+				}
+				foreign.R.UserAsset1155 = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAsset1155CraftingEventItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAssets1155L) LoadUserAsset1155CraftingEventItems(e boil.Executor, singular bool, maybeUserAssets1155 interface{}, mods queries.Applicator) error {
+	var slice []*UserAssets1155
+	var object *UserAssets1155
+
+	if singular {
+		object = maybeUserAssets1155.(*UserAssets1155)
+	} else {
+		slice = *maybeUserAssets1155.(*[]*UserAssets1155)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssets1155R{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssets1155R{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`crafting_event_items`),
+		qm.WhereIn(`crafting_event_items.user_asset_1155_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load crafting_event_items")
+	}
+
+	var resultSlice []*CraftingEventItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice crafting_event_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on crafting_event_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for crafting_event_items")
+	}
+
+	if len(craftingEventItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserAsset1155CraftingEventItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &craftingEventItemR{}
+			}
+			foreign.R.UserAsset1155 = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserAsset1155ID) {
+				local.R.UserAsset1155CraftingEventItems = append(local.R.UserAsset1155CraftingEventItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &craftingEventItemR{}
+				}
+				foreign.R.UserAsset1155 = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserAsset1155MarketplaceListings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAssets1155L) LoadUserAsset1155MarketplaceListings(e boil.Executor, singular bool, maybeUserAssets1155 interface{}, mods queries.Applicator) error {
+	var slice []*UserAssets1155
+	var object *UserAssets1155
+
+	if singular {
+		object = maybeUserAssets1155.(*UserAssets1155)
+	} else {
+		slice = *maybeUserAssets1155.(*[]*UserAssets1155)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssets1155R{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssets1155R{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`marketplace_listings`),
+		qm.WhereIn(`marketplace_listings.user_asset_1155_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load marketplace_listings")
+	}
+
+	var resultSlice []*MarketplaceListing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice marketplace_listings")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on marketplace_listings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for marketplace_listings")
+	}
+
+	if len(marketplaceListingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserAsset1155MarketplaceListings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &marketplaceListingR{}
+			}
+			foreign.R.UserAsset1155 = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserAsset1155ID) {
+				local.R.UserAsset1155MarketplaceListings = append(local.R.UserAsset1155MarketplaceListings, foreign)
+				if foreign.R == nil {
+					foreign.R = &marketplaceListingR{}
+				}
+				foreign.R.UserAsset1155 = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAssetPending1155Rollbacks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAssets1155L) LoadAssetPending1155Rollbacks(e boil.Executor, singular bool, maybeUserAssets1155 interface{}, mods queries.Applicator) error {
+	var slice []*UserAssets1155
+	var object *UserAssets1155
+
+	if singular {
+		object = maybeUserAssets1155.(*UserAssets1155)
+	} else {
+		slice = *maybeUserAssets1155.(*[]*UserAssets1155)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userAssets1155R{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAssets1155R{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`pending_1155_rollback`),
+		qm.WhereIn(`pending_1155_rollback.asset_id in ?`, args...),
+		qmhelper.WhereIsNull(`pending_1155_rollback.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load pending_1155_rollback")
+	}
+
+	var resultSlice []*Pending1155Rollback
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice pending_1155_rollback")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on pending_1155_rollback")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pending_1155_rollback")
+	}
+
+	if len(pending1155RollbackAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AssetPending1155Rollbacks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pending1155RollbackR{}
+			}
+			foreign.R.Asset = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AssetID {
+				local.R.AssetPending1155Rollbacks = append(local.R.AssetPending1155Rollbacks, foreign)
+				if foreign.R == nil {
+					foreign.R = &pending1155RollbackR{}
+				}
+				foreign.R.Asset = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCollection of the userAssets1155 to the related item.
+// Sets o.R.Collection to related.
+// Adds o to related.R.UserAssets1155S.
+func (o *UserAssets1155) SetCollection(exec boil.Executor, insert bool, related *Collection) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_assets_1155\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userAssets1155PrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CollectionID = related.ID
+	if o.R == nil {
+		o.R = &userAssets1155R{
+			Collection: related,
+		}
+	} else {
+		o.R.Collection = related
+	}
+
+	if related.R == nil {
+		related.R = &collectionR{
+			UserAssets1155S: UserAssets1155Slice{o},
+		}
+	} else {
+		related.R.UserAssets1155S = append(related.R.UserAssets1155S, o)
+	}
+
+	return nil
+}
+
+// SetOwner of the userAssets1155 to the related item.
+// Sets o.R.Owner to related.
+// Adds o to related.R.OwnerUserAssets1155S.
+func (o *UserAssets1155) SetOwner(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_assets_1155\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userAssets1155PrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OwnerID = related.ID
+	if o.R == nil {
+		o.R = &userAssets1155R{
+			Owner: related,
+		}
+	} else {
+		o.R.Owner = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OwnerUserAssets1155S: UserAssets1155Slice{o},
+		}
+	} else {
+		related.R.OwnerUserAssets1155S = append(related.R.OwnerUserAssets1155S, o)
+	}
+
+	return nil
+}
+
+// AddUser1155AssetAsset1155ServiceTransferEvents adds the given related objects to the existing relationships
+// of the user_assets_1155, optionally inserting them as new records.
+// Appends related to o.R.User1155AssetAsset1155ServiceTransferEvents.
+// Sets related.R.User1155Asset appropriately.
+func (o *UserAssets1155) AddUser1155AssetAsset1155ServiceTransferEvents(exec boil.Executor, insert bool, related ...*Asset1155ServiceTransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.User1155AssetID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset1155_service_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user1155_asset_id"}),
+				strmangle.WhereClause("\"", "\"", 2, asset1155ServiceTransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.User1155AssetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAssets1155R{
+			User1155AssetAsset1155ServiceTransferEvents: related,
+		}
+	} else {
+		o.R.User1155AssetAsset1155ServiceTransferEvents = append(o.R.User1155AssetAsset1155ServiceTransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &asset1155ServiceTransferEventR{
+				User1155Asset: o,
+			}
+		} else {
+			rel.R.User1155Asset = o
+		}
+	}
+	return nil
+}
+
+// AddFromAssetAsset1155TransferEvents adds the given related objects to the existing relationships
+// of the user_assets_1155, optionally inserting them as new records.
+// Appends related to o.R.FromAssetAsset1155TransferEvents.
+// Sets related.R.FromAsset appropriately.
+func (o *UserAssets1155) AddFromAssetAsset1155TransferEvents(exec boil.Executor, insert bool, related ...*Asset1155TransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FromAssetID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset1155_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"from_asset_id"}),
+				strmangle.WhereClause("\"", "\"", 2, asset1155TransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FromAssetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAssets1155R{
+			FromAssetAsset1155TransferEvents: related,
+		}
+	} else {
+		o.R.FromAssetAsset1155TransferEvents = append(o.R.FromAssetAsset1155TransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &asset1155TransferEventR{
+				FromAsset: o,
+			}
+		} else {
+			rel.R.FromAsset = o
+		}
+	}
+	return nil
+}
+
+// AddToAssetAsset1155TransferEvents adds the given related objects to the existing relationships
+// of the user_assets_1155, optionally inserting them as new records.
+// Appends related to o.R.ToAssetAsset1155TransferEvents.
+// Sets related.R.ToAsset appropriately.
+func (o *UserAssets1155) AddToAssetAsset1155TransferEvents(exec boil.Executor, insert bool, related ...*Asset1155TransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ToAssetID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset1155_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"to_asset_id"}),
+				strmangle.WhereClause("\"", "\"", 2, asset1155TransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ToAssetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAssets1155R{
+			ToAssetAsset1155TransferEvents: related,
+		}
+	} else {
+		o.R.ToAssetAsset1155TransferEvents = append(o.R.ToAssetAsset1155TransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &asset1155TransferEventR{
+				ToAsset: o,
+			}
+		} else {
+			rel.R.ToAsset = o
+		}
+	}
+	return nil
+}
+
+// AddUserAsset1155AssetTradeItems adds the given related objects to the existing relationships
+// of the user_assets_1155, optionally inserting them as new records.
+// Appends related to o.R.UserAsset1155AssetTradeItems.
+// Sets related.R.UserAsset1155 appropriately.
+func (o *UserAssets1155) AddUserAsset1155AssetTradeItems(exec boil.Executor, insert bool, related ...*AssetTradeItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserAsset1155ID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_trade_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_1155_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetTradeItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserAsset1155ID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAssets1155R{
+			UserAsset1155AssetTradeItems: related,
+		}
+	} else {
+		o.R.UserAsset1155AssetTradeItems = append(o.R.UserAsset1155AssetTradeItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetTradeItemR{
+				UserAsset1155: o,
+			}
+		} else {
+			rel.R.UserAsset1155 = o
+		}
+	}
+	return nil
+}
+
+// SetUserAsset1155AssetTradeItems removes all previously related items of the
+// user_assets_1155 replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.UserAsset1155's UserAsset1155AssetTradeItems accordingly.
+// Replaces o.R.UserAsset1155AssetTradeItems with related.
+// Sets related.R.UserAsset1155's UserAsset1155AssetTradeItems accordingly.
+func (o *UserAssets1155) SetUserAsset1155AssetTradeItems(exec boil.Executor, insert bool, related ...*AssetTradeItem) error {
+	query := "update \"asset_trade_items\" set \"user_asset_1155_id\" = null where \"user_asset_1155_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.UserAsset1155AssetTradeItems {
+			queries.SetScanner(&rel.UserAsset1155ID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.UserAsset1155 = nil
+		}
+
+		o.R.UserAsset1155AssetTradeItems = nil
+	}
+	return o.AddUserAsset1155AssetTradeItems(exec, insert, related...)
+}
+
+// RemoveUserAsset1155AssetTradeItems relationships from objects passed in.
+// Removes related items from R.UserAsset1155AssetTradeItems (uses pointer comparison, removal does not keep order)
+// Sets related.R.UserAsset1155.
+func (o *UserAssets1155) RemoveUserAsset1155AssetTradeItems(exec boil.Executor, related ...*AssetTradeItem) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserAsset1155ID, nil)
+		if rel.R != nil {
+			rel.R.UserAsset1155 = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("user_asset_1155_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.UserAsset1155AssetTradeItems {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.UserAsset1155AssetTradeItems)
+			if ln > 1 && i < ln-1 {
+				o.R.UserAsset1155AssetTradeItems[i] = o.R.UserAsset1155AssetTradeItems[ln-1]
+			}
+			o.R.UserAsset1155AssetTradeItems = o.R.UserAsset1155AssetTradeItems[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddUserAsset1155CraftingEventItems adds the given related objects to the existing relationships
+// of the user_assets_1155, optionally inserting them as new records.
+// Appends related to o.R.UserAsset1155CraftingEventItems.
+// Sets related.R.UserAsset1155 appropriately.
+func (o *UserAssets1155) AddUserAsset1155CraftingEventItems(exec boil.Executor, insert bool, related ...*CraftingEventItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserAsset1155ID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"crafting_event_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_1155_id"}),
+				strmangle.WhereClause("\"", "\"", 2, craftingEventItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserAsset1155ID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAssets1155R{
+			UserAsset1155CraftingEventItems: related,
+		}
+	} else {
+		o.R.UserAsset1155CraftingEventItems = append(o.R.UserAsset1155CraftingEventItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &craftingEventItemR{
+				UserAsset1155: o,
+			}
+		} else {
+			rel.R.UserAsset1155 = o
+		}
+	}
+	return nil
+}
+
+// SetUserAsset1155CraftingEventItems removes all previously related items of the
+// user_assets_1155 replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.UserAsset1155's UserAsset1155CraftingEventItems accordingly.
+// Replaces o.R.UserAsset1155CraftingEventItems with related.
+// Sets related.R.UserAsset1155's UserAsset1155CraftingEventItems accordingly.
+func (o *UserAssets1155) SetUserAsset1155CraftingEventItems(exec boil.Executor, insert bool, related ...*CraftingEventItem) error {
+	query := "update \"crafting_event_items\" set \"user_asset_1155_id\" = null where \"user_asset_1155_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.UserAsset1155CraftingEventItems {
+			queries.SetScanner(&rel.UserAsset1155ID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.UserAsset1155 = nil
+		}
+
+		o.R.UserAsset1155CraftingEventItems = nil
+	}
+	return o.AddUserAsset1155CraftingEventItems(exec, insert, related...)
+}
+
+// RemoveUserAsset1155CraftingEventItems relationships from objects passed in.
+// Removes related items from R.UserAsset1155CraftingEventItems (uses pointer comparison, removal does not keep order)
+// Sets related.R.UserAsset1155.
+func (o *UserAssets1155) RemoveUserAsset1155CraftingEventItems(exec boil.Executor, related ...*CraftingEventItem) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserAsset1155ID, nil)
+		if rel.R != nil {
+			rel.R.UserAsset1155 = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("user_asset_1155_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.UserAsset1155CraftingEventItems {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.UserAsset1155CraftingEventItems)
+			if ln > 1 && i < ln-1 {
+				o.R.UserAsset1155CraftingEventItems[i] = o.R.UserAsset1155CraftingEventItems[ln-1]
+			}
+			o.R.UserAsset1155CraftingEventItems = o.R.UserAsset1155CraftingEventItems[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddUserAsset1155MarketplaceListings adds the given related objects to the existing relationships
+// of the user_assets_1155, optionally inserting them as new records.
+// Appends related to o.R.UserAsset1155MarketplaceListings.
+// Sets related.R.UserAsset1155 appropriately.
+func (o *UserAssets1155) AddUserAsset1155MarketplaceListings(exec boil.Executor, insert bool, related ...*MarketplaceListing) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserAsset1155ID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"marketplace_listings\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_asset_1155_id"}),
+				strmangle.WhereClause("\"", "\"", 2, marketplaceListingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserAsset1155ID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAssets1155R{
+			UserAsset1155MarketplaceListings: related,
+		}
+	} else {
+		o.R.UserAsset1155MarketplaceListings = append(o.R.UserAsset1155MarketplaceListings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &marketplaceListingR{
+				UserAsset1155: o,
+			}
+		} else {
+			rel.R.UserAsset1155 = o
+		}
+	}
+	return nil
+}
+
+// SetUserAsset1155MarketplaceListings removes all previously related items of the
+// user_assets_1155 replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.UserAsset1155's UserAsset1155MarketplaceListings accordingly.
+// Replaces o.R.UserAsset1155MarketplaceListings with related.
+// Sets related.R.UserAsset1155's UserAsset1155MarketplaceListings accordingly.
+func (o *UserAssets1155) SetUserAsset1155MarketplaceListings(exec boil.Executor, insert bool, related ...*MarketplaceListing) error {
+	query := "update \"marketplace_listings\" set \"user_asset_1155_id\" = null where \"user_asset_1155_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.UserAsset1155MarketplaceListings {
+			queries.SetScanner(&rel.UserAsset1155ID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.UserAsset1155 = nil
+		}
+
+		o.R.UserAsset1155MarketplaceListings = nil
+	}
+	return o.AddUserAsset1155MarketplaceListings(exec, insert, related...)
+}
+
+// RemoveUserAsset1155MarketplaceListings relationships from objects passed in.
+// Removes related items from R.UserAsset1155MarketplaceListings (uses pointer comparison, removal does not keep order)
+// Sets related.R.UserAsset1155.
+func (o *UserAssets1155) RemoveUserAsset1155MarketplaceListings(exec boil.Executor, related ...*MarketplaceListing) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserAsset1155ID, nil)
+		if rel.R != nil {
+			rel.R.UserAsset1155 = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("user_asset_1155_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.UserAsset1155MarketplaceListings {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.UserAsset1155MarketplaceListings)
+			if ln > 1 && i < ln-1 {
+				o.R.UserAsset1155MarketplaceListings[i] = o.R.UserAsset1155MarketplaceListings[ln-1]
+			}
+			o.R.UserAsset1155MarketplaceListings = o.R.UserAsset1155MarketplaceListings[:ln-1]
+			break
+		}
+	}
+
 	return nil
 }
 
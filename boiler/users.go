@@ -59,6 +59,7 @@ type User struct {
 	AcceptsMarketing                 null.Bool   `boiler:"accepts_marketing" boil:"accepts_marketing" json:"accepts_marketing,omitempty" toml:"accepts_marketing" yaml:"accepts_marketing,omitempty"`
 	AccountID                        string      `boiler:"account_id" boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
 	LegacyAccountID                  null.String `boiler:"legacy_account_id" boil:"legacy_account_id" json:"legacy_account_id,omitempty" toml:"legacy_account_id" yaml:"legacy_account_id,omitempty"`
+	AvatarUserAssetID                null.String `boiler:"avatar_user_asset_id" boil:"avatar_user_asset_id" json:"avatar_user_asset_id,omitempty" toml:"avatar_user_asset_id" yaml:"avatar_user_asset_id,omitempty"`
 
 	R *userR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -101,6 +102,7 @@ var UserColumns = struct {
 	AcceptsMarketing                 string
 	AccountID                        string
 	LegacyAccountID                  string
+	AvatarUserAssetID                string
 }{
 	ID:                               "id",
 	Username:                         "username",
@@ -138,6 +140,7 @@ var UserColumns = struct {
 	AcceptsMarketing:                 "accepts_marketing",
 	AccountID:                        "account_id",
 	LegacyAccountID:                  "legacy_account_id",
+	AvatarUserAssetID:                "avatar_user_asset_id",
 }
 
 var UserTableColumns = struct {
@@ -177,6 +180,7 @@ var UserTableColumns = struct {
 	AcceptsMarketing                 string
 	AccountID                        string
 	LegacyAccountID                  string
+	AvatarUserAssetID                string
 }{
 	ID:                               "users.id",
 	Username:                         "users.username",
@@ -214,6 +218,7 @@ var UserTableColumns = struct {
 	AcceptsMarketing:                 "users.accepts_marketing",
 	AccountID:                        "users.account_id",
 	LegacyAccountID:                  "users.legacy_account_id",
+	AvatarUserAssetID:                "users.avatar_user_asset_id",
 }
 
 // Generated where
@@ -255,6 +260,7 @@ var UserWhere = struct {
 	AcceptsMarketing                 whereHelpernull_Bool
 	AccountID                        whereHelperstring
 	LegacyAccountID                  whereHelpernull_String
+	AvatarUserAssetID                whereHelpernull_String
 }{
 	ID:                               whereHelperstring{field: "\"users\".\"id\""},
 	Username:                         whereHelperstring{field: "\"users\".\"username\""},
@@ -292,12 +298,14 @@ var UserWhere = struct {
 	AcceptsMarketing:                 whereHelpernull_Bool{field: "\"users\".\"accepts_marketing\""},
 	AccountID:                        whereHelperstring{field: "\"users\".\"account_id\""},
 	LegacyAccountID:                  whereHelpernull_String{field: "\"users\".\"legacy_account_id\""},
+	AvatarUserAssetID:                whereHelpernull_String{field: "\"users\".\"avatar_user_asset_id\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
 	Account                                   string
 	Avatar                                    string
+	AvatarUserAsset                           string
 	Faction                                   string
 	LegacyAccount                             string
 	Role                                      string
@@ -306,25 +314,51 @@ var UserRels = struct {
 	FromServiceAsset1155ServiceTransferEvents string
 	ToServiceAsset1155ServiceTransferEvents   string
 	Asset1155ServiceTransferEvents            string
+	FromUserAsset1155TransferEvents           string
+	ServiceAsset1155TransferEvents            string
+	ToUserAsset1155TransferEvents             string
+	AcceptedByAssetRentals                    string
+	OwnerAssetRentals                         string
+	RenterUserAssetRentals                    string
+	ReleasedByAssetServiceLocks               string
+	ServiceAssetServiceLocks                  string
 	FromServiceAssetServiceTransferEvents     string
 	ToServiceAssetServiceTransferEvents       string
 	AssetServiceTransferEvents                string
+	OffererAssetTrades                        string
+	RecipientAssetTrades                      string
 	FromUserAssetTransferEvents               string
 	ToUserAssetTransferEvents                 string
+	ServiceCraftingEvents                     string
+	CraftingEvents                            string
+	CreatedByCraftingRecipes                  string
 	DepositAsset1155Transactions              string
 	DepositTransactions                       string
 	CreditFailedTransactions                  string
 	DebitFailedTransactions                   string
+	HeldPurchases                             string
+	HolderSnapshotEntries                     string
+	CreatedByHolderSnapshots                  string
 	IssueTokens                               string
+	BidderMarketplaceBids                     string
+	BuyerMarketplaceListings                  string
+	CurrentBidderMarketplaceListings          string
+	SellerMarketplaceListings                 string
 	Pending1155Rollbacks                      string
 	PendingRefunds                            string
+	OperatorUserPendingWithdrawActions        string
 	OwnerPurchasedItemsOlds                   string
+	ResolvedByReconciliationDiscrepancies     string
+	CreatedByReconciliationRuns               string
+	MemberSyndicateAssetTransfers             string
+	SyndicateMembers                          string
 	FoundedBySyndicates                       string
 	ServiceTransactions                       string
 	CreditTransactionsOlds                    string
 	DebitTransactionsOlds                     string
 	ServiceTransactionsOlds                   string
 	UserActivities                            string
+	ChangedByUserAssetOnChainStatusHistories  string
 	LockedToServiceUserAssets                 string
 	OwnerUserAssets                           string
 	OwnerUserAssets1155S                      string
@@ -332,35 +366,62 @@ var UserRels = struct {
 	UserRecoveryCodes                         string
 	UsernameHistories                         string
 }{
-	Account:       "Account",
-	Avatar:        "Avatar",
-	Faction:       "Faction",
-	LegacyAccount: "LegacyAccount",
-	Role:          "Role",
-	PasswordHash:  "PasswordHash",
-	APIKeys:       "APIKeys",
+	Account:         "Account",
+	Avatar:          "Avatar",
+	AvatarUserAsset: "AvatarUserAsset",
+	Faction:         "Faction",
+	LegacyAccount:   "LegacyAccount",
+	Role:            "Role",
+	PasswordHash:    "PasswordHash",
+	APIKeys:         "APIKeys",
 	FromServiceAsset1155ServiceTransferEvents: "FromServiceAsset1155ServiceTransferEvents",
 	ToServiceAsset1155ServiceTransferEvents:   "ToServiceAsset1155ServiceTransferEvents",
 	Asset1155ServiceTransferEvents:            "Asset1155ServiceTransferEvents",
+	FromUserAsset1155TransferEvents:           "FromUserAsset1155TransferEvents",
+	ServiceAsset1155TransferEvents:            "ServiceAsset1155TransferEvents",
+	ToUserAsset1155TransferEvents:             "ToUserAsset1155TransferEvents",
+	AcceptedByAssetRentals:                    "AcceptedByAssetRentals",
+	OwnerAssetRentals:                         "OwnerAssetRentals",
+	RenterUserAssetRentals:                    "RenterUserAssetRentals",
+	ReleasedByAssetServiceLocks:               "ReleasedByAssetServiceLocks",
+	ServiceAssetServiceLocks:                  "ServiceAssetServiceLocks",
 	FromServiceAssetServiceTransferEvents:     "FromServiceAssetServiceTransferEvents",
 	ToServiceAssetServiceTransferEvents:       "ToServiceAssetServiceTransferEvents",
 	AssetServiceTransferEvents:                "AssetServiceTransferEvents",
+	OffererAssetTrades:                        "OffererAssetTrades",
+	RecipientAssetTrades:                      "RecipientAssetTrades",
 	FromUserAssetTransferEvents:               "FromUserAssetTransferEvents",
 	ToUserAssetTransferEvents:                 "ToUserAssetTransferEvents",
+	ServiceCraftingEvents:                     "ServiceCraftingEvents",
+	CraftingEvents:                            "CraftingEvents",
+	CreatedByCraftingRecipes:                  "CreatedByCraftingRecipes",
 	DepositAsset1155Transactions:              "DepositAsset1155Transactions",
 	DepositTransactions:                       "DepositTransactions",
 	CreditFailedTransactions:                  "CreditFailedTransactions",
 	DebitFailedTransactions:                   "DebitFailedTransactions",
+	HeldPurchases:                             "HeldPurchases",
+	HolderSnapshotEntries:                     "HolderSnapshotEntries",
+	CreatedByHolderSnapshots:                  "CreatedByHolderSnapshots",
 	IssueTokens:                               "IssueTokens",
+	BidderMarketplaceBids:                     "BidderMarketplaceBids",
+	BuyerMarketplaceListings:                  "BuyerMarketplaceListings",
+	CurrentBidderMarketplaceListings:          "CurrentBidderMarketplaceListings",
+	SellerMarketplaceListings:                 "SellerMarketplaceListings",
 	Pending1155Rollbacks:                      "Pending1155Rollbacks",
 	PendingRefunds:                            "PendingRefunds",
+	OperatorUserPendingWithdrawActions:        "OperatorUserPendingWithdrawActions",
 	OwnerPurchasedItemsOlds:                   "OwnerPurchasedItemsOlds",
+	ResolvedByReconciliationDiscrepancies:     "ResolvedByReconciliationDiscrepancies",
+	CreatedByReconciliationRuns:               "CreatedByReconciliationRuns",
+	MemberSyndicateAssetTransfers:             "MemberSyndicateAssetTransfers",
+	SyndicateMembers:                          "SyndicateMembers",
 	FoundedBySyndicates:                       "FoundedBySyndicates",
 	ServiceTransactions:                       "ServiceTransactions",
 	CreditTransactionsOlds:                    "CreditTransactionsOlds",
 	DebitTransactionsOlds:                     "DebitTransactionsOlds",
 	ServiceTransactionsOlds:                   "ServiceTransactionsOlds",
 	UserActivities:                            "UserActivities",
+	ChangedByUserAssetOnChainStatusHistories:  "ChangedByUserAssetOnChainStatusHistories",
 	LockedToServiceUserAssets:                 "LockedToServiceUserAssets",
 	OwnerUserAssets:                           "OwnerUserAssets",
 	OwnerUserAssets1155S:                      "OwnerUserAssets1155S",
@@ -373,6 +434,7 @@ var UserRels = struct {
 type userR struct {
 	Account                                   *Account                           `boiler:"Account" boil:"Account" json:"Account" toml:"Account" yaml:"Account"`
 	Avatar                                    *Blob                              `boiler:"Avatar" boil:"Avatar" json:"Avatar" toml:"Avatar" yaml:"Avatar"`
+	AvatarUserAsset                           *UserAsset                         `boiler:"AvatarUserAsset" boil:"AvatarUserAsset" json:"AvatarUserAsset" toml:"AvatarUserAsset" yaml:"AvatarUserAsset"`
 	Faction                                   *Faction                           `boiler:"Faction" boil:"Faction" json:"Faction" toml:"Faction" yaml:"Faction"`
 	LegacyAccount                             *Account                           `boiler:"LegacyAccount" boil:"LegacyAccount" json:"LegacyAccount" toml:"LegacyAccount" yaml:"LegacyAccount"`
 	Role                                      *Role                              `boiler:"Role" boil:"Role" json:"Role" toml:"Role" yaml:"Role"`
@@ -381,25 +443,51 @@ type userR struct {
 	FromServiceAsset1155ServiceTransferEvents Asset1155ServiceTransferEventSlice `boiler:"FromServiceAsset1155ServiceTransferEvents" boil:"FromServiceAsset1155ServiceTransferEvents" json:"FromServiceAsset1155ServiceTransferEvents" toml:"FromServiceAsset1155ServiceTransferEvents" yaml:"FromServiceAsset1155ServiceTransferEvents"`
 	ToServiceAsset1155ServiceTransferEvents   Asset1155ServiceTransferEventSlice `boiler:"ToServiceAsset1155ServiceTransferEvents" boil:"ToServiceAsset1155ServiceTransferEvents" json:"ToServiceAsset1155ServiceTransferEvents" toml:"ToServiceAsset1155ServiceTransferEvents" yaml:"ToServiceAsset1155ServiceTransferEvents"`
 	Asset1155ServiceTransferEvents            Asset1155ServiceTransferEventSlice `boiler:"Asset1155ServiceTransferEvents" boil:"Asset1155ServiceTransferEvents" json:"Asset1155ServiceTransferEvents" toml:"Asset1155ServiceTransferEvents" yaml:"Asset1155ServiceTransferEvents"`
+	FromUserAsset1155TransferEvents           Asset1155TransferEventSlice        `boiler:"FromUserAsset1155TransferEvents" boil:"FromUserAsset1155TransferEvents" json:"FromUserAsset1155TransferEvents" toml:"FromUserAsset1155TransferEvents" yaml:"FromUserAsset1155TransferEvents"`
+	ServiceAsset1155TransferEvents            Asset1155TransferEventSlice        `boiler:"ServiceAsset1155TransferEvents" boil:"ServiceAsset1155TransferEvents" json:"ServiceAsset1155TransferEvents" toml:"ServiceAsset1155TransferEvents" yaml:"ServiceAsset1155TransferEvents"`
+	ToUserAsset1155TransferEvents             Asset1155TransferEventSlice        `boiler:"ToUserAsset1155TransferEvents" boil:"ToUserAsset1155TransferEvents" json:"ToUserAsset1155TransferEvents" toml:"ToUserAsset1155TransferEvents" yaml:"ToUserAsset1155TransferEvents"`
+	AcceptedByAssetRentals                    AssetRentalSlice                   `boiler:"AcceptedByAssetRentals" boil:"AcceptedByAssetRentals" json:"AcceptedByAssetRentals" toml:"AcceptedByAssetRentals" yaml:"AcceptedByAssetRentals"`
+	OwnerAssetRentals                         AssetRentalSlice                   `boiler:"OwnerAssetRentals" boil:"OwnerAssetRentals" json:"OwnerAssetRentals" toml:"OwnerAssetRentals" yaml:"OwnerAssetRentals"`
+	RenterUserAssetRentals                    AssetRentalSlice                   `boiler:"RenterUserAssetRentals" boil:"RenterUserAssetRentals" json:"RenterUserAssetRentals" toml:"RenterUserAssetRentals" yaml:"RenterUserAssetRentals"`
+	ReleasedByAssetServiceLocks               AssetServiceLockSlice              `boiler:"ReleasedByAssetServiceLocks" boil:"ReleasedByAssetServiceLocks" json:"ReleasedByAssetServiceLocks" toml:"ReleasedByAssetServiceLocks" yaml:"ReleasedByAssetServiceLocks"`
+	ServiceAssetServiceLocks                  AssetServiceLockSlice              `boiler:"ServiceAssetServiceLocks" boil:"ServiceAssetServiceLocks" json:"ServiceAssetServiceLocks" toml:"ServiceAssetServiceLocks" yaml:"ServiceAssetServiceLocks"`
 	FromServiceAssetServiceTransferEvents     AssetServiceTransferEventSlice     `boiler:"FromServiceAssetServiceTransferEvents" boil:"FromServiceAssetServiceTransferEvents" json:"FromServiceAssetServiceTransferEvents" toml:"FromServiceAssetServiceTransferEvents" yaml:"FromServiceAssetServiceTransferEvents"`
 	ToServiceAssetServiceTransferEvents       AssetServiceTransferEventSlice     `boiler:"ToServiceAssetServiceTransferEvents" boil:"ToServiceAssetServiceTransferEvents" json:"ToServiceAssetServiceTransferEvents" toml:"ToServiceAssetServiceTransferEvents" yaml:"ToServiceAssetServiceTransferEvents"`
 	AssetServiceTransferEvents                AssetServiceTransferEventSlice     `boiler:"AssetServiceTransferEvents" boil:"AssetServiceTransferEvents" json:"AssetServiceTransferEvents" toml:"AssetServiceTransferEvents" yaml:"AssetServiceTransferEvents"`
+	OffererAssetTrades                        AssetTradeSlice                    `boiler:"OffererAssetTrades" boil:"OffererAssetTrades" json:"OffererAssetTrades" toml:"OffererAssetTrades" yaml:"OffererAssetTrades"`
+	RecipientAssetTrades                      AssetTradeSlice                    `boiler:"RecipientAssetTrades" boil:"RecipientAssetTrades" json:"RecipientAssetTrades" toml:"RecipientAssetTrades" yaml:"RecipientAssetTrades"`
 	FromUserAssetTransferEvents               AssetTransferEventSlice            `boiler:"FromUserAssetTransferEvents" boil:"FromUserAssetTransferEvents" json:"FromUserAssetTransferEvents" toml:"FromUserAssetTransferEvents" yaml:"FromUserAssetTransferEvents"`
 	ToUserAssetTransferEvents                 AssetTransferEventSlice            `boiler:"ToUserAssetTransferEvents" boil:"ToUserAssetTransferEvents" json:"ToUserAssetTransferEvents" toml:"ToUserAssetTransferEvents" yaml:"ToUserAssetTransferEvents"`
+	ServiceCraftingEvents                     CraftingEventSlice                 `boiler:"ServiceCraftingEvents" boil:"ServiceCraftingEvents" json:"ServiceCraftingEvents" toml:"ServiceCraftingEvents" yaml:"ServiceCraftingEvents"`
+	CraftingEvents                            CraftingEventSlice                 `boiler:"CraftingEvents" boil:"CraftingEvents" json:"CraftingEvents" toml:"CraftingEvents" yaml:"CraftingEvents"`
+	CreatedByCraftingRecipes                  CraftingRecipeSlice                `boiler:"CreatedByCraftingRecipes" boil:"CreatedByCraftingRecipes" json:"CreatedByCraftingRecipes" toml:"CreatedByCraftingRecipes" yaml:"CreatedByCraftingRecipes"`
 	DepositAsset1155Transactions              DepositAsset1155TransactionSlice   `boiler:"DepositAsset1155Transactions" boil:"DepositAsset1155Transactions" json:"DepositAsset1155Transactions" toml:"DepositAsset1155Transactions" yaml:"DepositAsset1155Transactions"`
 	DepositTransactions                       DepositTransactionSlice            `boiler:"DepositTransactions" boil:"DepositTransactions" json:"DepositTransactions" toml:"DepositTransactions" yaml:"DepositTransactions"`
 	CreditFailedTransactions                  FailedTransactionSlice             `boiler:"CreditFailedTransactions" boil:"CreditFailedTransactions" json:"CreditFailedTransactions" toml:"CreditFailedTransactions" yaml:"CreditFailedTransactions"`
 	DebitFailedTransactions                   FailedTransactionSlice             `boiler:"DebitFailedTransactions" boil:"DebitFailedTransactions" json:"DebitFailedTransactions" toml:"DebitFailedTransactions" yaml:"DebitFailedTransactions"`
+	HeldPurchases                             HeldPurchaseSlice                  `boiler:"HeldPurchases" boil:"HeldPurchases" json:"HeldPurchases" toml:"HeldPurchases" yaml:"HeldPurchases"`
+	HolderSnapshotEntries                     HolderSnapshotEntrySlice           `boiler:"HolderSnapshotEntries" boil:"HolderSnapshotEntries" json:"HolderSnapshotEntries" toml:"HolderSnapshotEntries" yaml:"HolderSnapshotEntries"`
+	CreatedByHolderSnapshots                  HolderSnapshotSlice                `boiler:"CreatedByHolderSnapshots" boil:"CreatedByHolderSnapshots" json:"CreatedByHolderSnapshots" toml:"CreatedByHolderSnapshots" yaml:"CreatedByHolderSnapshots"`
 	IssueTokens                               IssueTokenSlice                    `boiler:"IssueTokens" boil:"IssueTokens" json:"IssueTokens" toml:"IssueTokens" yaml:"IssueTokens"`
+	BidderMarketplaceBids                     MarketplaceBidSlice                `boiler:"BidderMarketplaceBids" boil:"BidderMarketplaceBids" json:"BidderMarketplaceBids" toml:"BidderMarketplaceBids" yaml:"BidderMarketplaceBids"`
+	BuyerMarketplaceListings                  MarketplaceListingSlice            `boiler:"BuyerMarketplaceListings" boil:"BuyerMarketplaceListings" json:"BuyerMarketplaceListings" toml:"BuyerMarketplaceListings" yaml:"BuyerMarketplaceListings"`
+	CurrentBidderMarketplaceListings          MarketplaceListingSlice            `boiler:"CurrentBidderMarketplaceListings" boil:"CurrentBidderMarketplaceListings" json:"CurrentBidderMarketplaceListings" toml:"CurrentBidderMarketplaceListings" yaml:"CurrentBidderMarketplaceListings"`
+	SellerMarketplaceListings                 MarketplaceListingSlice            `boiler:"SellerMarketplaceListings" boil:"SellerMarketplaceListings" json:"SellerMarketplaceListings" toml:"SellerMarketplaceListings" yaml:"SellerMarketplaceListings"`
 	Pending1155Rollbacks                      Pending1155RollbackSlice           `boiler:"Pending1155Rollbacks" boil:"Pending1155Rollbacks" json:"Pending1155Rollbacks" toml:"Pending1155Rollbacks" yaml:"Pending1155Rollbacks"`
 	PendingRefunds                            PendingRefundSlice                 `boiler:"PendingRefunds" boil:"PendingRefunds" json:"PendingRefunds" toml:"PendingRefunds" yaml:"PendingRefunds"`
+	OperatorUserPendingWithdrawActions        PendingWithdrawActionSlice         `boiler:"OperatorUserPendingWithdrawActions" boil:"OperatorUserPendingWithdrawActions" json:"OperatorUserPendingWithdrawActions" toml:"OperatorUserPendingWithdrawActions" yaml:"OperatorUserPendingWithdrawActions"`
 	OwnerPurchasedItemsOlds                   PurchasedItemsOldSlice             `boiler:"OwnerPurchasedItemsOlds" boil:"OwnerPurchasedItemsOlds" json:"OwnerPurchasedItemsOlds" toml:"OwnerPurchasedItemsOlds" yaml:"OwnerPurchasedItemsOlds"`
+	ResolvedByReconciliationDiscrepancies     ReconciliationDiscrepancySlice     `boiler:"ResolvedByReconciliationDiscrepancies" boil:"ResolvedByReconciliationDiscrepancies" json:"ResolvedByReconciliationDiscrepancies" toml:"ResolvedByReconciliationDiscrepancies" yaml:"ResolvedByReconciliationDiscrepancies"`
+	CreatedByReconciliationRuns               ReconciliationRunSlice             `boiler:"CreatedByReconciliationRuns" boil:"CreatedByReconciliationRuns" json:"CreatedByReconciliationRuns" toml:"CreatedByReconciliationRuns" yaml:"CreatedByReconciliationRuns"`
+	MemberSyndicateAssetTransfers             SyndicateAssetTransferSlice        `boiler:"MemberSyndicateAssetTransfers" boil:"MemberSyndicateAssetTransfers" json:"MemberSyndicateAssetTransfers" toml:"MemberSyndicateAssetTransfers" yaml:"MemberSyndicateAssetTransfers"`
+	SyndicateMembers                          SyndicateMemberSlice               `boiler:"SyndicateMembers" boil:"SyndicateMembers" json:"SyndicateMembers" toml:"SyndicateMembers" yaml:"SyndicateMembers"`
 	FoundedBySyndicates                       SyndicateSlice                     `boiler:"FoundedBySyndicates" boil:"FoundedBySyndicates" json:"FoundedBySyndicates" toml:"FoundedBySyndicates" yaml:"FoundedBySyndicates"`
 	ServiceTransactions                       TransactionSlice                   `boiler:"ServiceTransactions" boil:"ServiceTransactions" json:"ServiceTransactions" toml:"ServiceTransactions" yaml:"ServiceTransactions"`
 	CreditTransactionsOlds                    TransactionsOldSlice               `boiler:"CreditTransactionsOlds" boil:"CreditTransactionsOlds" json:"CreditTransactionsOlds" toml:"CreditTransactionsOlds" yaml:"CreditTransactionsOlds"`
 	DebitTransactionsOlds                     TransactionsOldSlice               `boiler:"DebitTransactionsOlds" boil:"DebitTransactionsOlds" json:"DebitTransactionsOlds" toml:"DebitTransactionsOlds" yaml:"DebitTransactionsOlds"`
 	ServiceTransactionsOlds                   TransactionsOldSlice               `boiler:"ServiceTransactionsOlds" boil:"ServiceTransactionsOlds" json:"ServiceTransactionsOlds" toml:"ServiceTransactionsOlds" yaml:"ServiceTransactionsOlds"`
 	UserActivities                            UserActivitySlice                  `boiler:"UserActivities" boil:"UserActivities" json:"UserActivities" toml:"UserActivities" yaml:"UserActivities"`
+	ChangedByUserAssetOnChainStatusHistories  UserAssetOnChainStatusHistorySlice `boiler:"ChangedByUserAssetOnChainStatusHistories" boil:"ChangedByUserAssetOnChainStatusHistories" json:"ChangedByUserAssetOnChainStatusHistories" toml:"ChangedByUserAssetOnChainStatusHistories" yaml:"ChangedByUserAssetOnChainStatusHistories"`
 	LockedToServiceUserAssets                 UserAssetSlice                     `boiler:"LockedToServiceUserAssets" boil:"LockedToServiceUserAssets" json:"LockedToServiceUserAssets" toml:"LockedToServiceUserAssets" yaml:"LockedToServiceUserAssets"`
 	OwnerUserAssets                           UserAssetSlice                     `boiler:"OwnerUserAssets" boil:"OwnerUserAssets" json:"OwnerUserAssets" toml:"OwnerUserAssets" yaml:"OwnerUserAssets"`
 	OwnerUserAssets1155S                      UserAssets1155Slice                `boiler:"OwnerUserAssets1155S" boil:"OwnerUserAssets1155S" json:"OwnerUserAssets1155S" toml:"OwnerUserAssets1155S" yaml:"OwnerUserAssets1155S"`
@@ -417,9 +505,9 @@ func (*userR) NewStruct() *userR {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "username", "role_id", "avatar_id", "facebook_id", "google_id", "twitch_id", "twitter_id", "discord_id", "faction_id", "email", "first_name", "last_name", "verified", "old_password_required", "two_factor_authentication_activated", "two_factor_authentication_secret", "two_factor_authentication_is_set", "public_address", "private_address", "nonce", "keywords", "deleted_at", "updated_at", "created_at", "metadata", "mobile_number", "chat_banned_until", "rename_banned", "withdraw_lock", "mint_lock", "total_lock", "permissions", "accepts_marketing", "account_id", "legacy_account_id"}
+	userAllColumns            = []string{"id", "username", "role_id", "avatar_id", "facebook_id", "google_id", "twitch_id", "twitter_id", "discord_id", "faction_id", "email", "first_name", "last_name", "verified", "old_password_required", "two_factor_authentication_activated", "two_factor_authentication_secret", "two_factor_authentication_is_set", "public_address", "private_address", "nonce", "keywords", "deleted_at", "updated_at", "created_at", "metadata", "mobile_number", "chat_banned_until", "rename_banned", "withdraw_lock", "mint_lock", "total_lock", "permissions", "accepts_marketing", "account_id", "legacy_account_id", "avatar_user_asset_id"}
 	userColumnsWithoutDefault = []string{"username", "account_id"}
-	userColumnsWithDefault    = []string{"id", "role_id", "avatar_id", "facebook_id", "google_id", "twitch_id", "twitter_id", "discord_id", "faction_id", "email", "first_name", "last_name", "verified", "old_password_required", "two_factor_authentication_activated", "two_factor_authentication_secret", "two_factor_authentication_is_set", "public_address", "private_address", "nonce", "keywords", "deleted_at", "updated_at", "created_at", "metadata", "mobile_number", "chat_banned_until", "rename_banned", "withdraw_lock", "mint_lock", "total_lock", "permissions", "accepts_marketing", "legacy_account_id"}
+	userColumnsWithDefault    = []string{"id", "role_id", "avatar_id", "facebook_id", "google_id", "twitch_id", "twitter_id", "discord_id", "faction_id", "email", "first_name", "last_name", "verified", "old_password_required", "two_factor_authentication_activated", "two_factor_authentication_secret", "two_factor_authentication_is_set", "public_address", "private_address", "nonce", "keywords", "deleted_at", "updated_at", "created_at", "metadata", "mobile_number", "chat_banned_until", "rename_banned", "withdraw_lock", "mint_lock", "total_lock", "permissions", "accepts_marketing", "legacy_account_id", "avatar_user_asset_id"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -696,6 +784,21 @@ func (o *User) Avatar(mods ...qm.QueryMod) blobQuery {
 	return query
 }
 
+// AvatarUserAsset pointed to by the foreign key.
+func (o *User) AvatarUserAsset(mods ...qm.QueryMod) userAssetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AvatarUserAssetID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := UserAssets(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets\"")
+
+	return query
+}
+
 // Faction pointed to by the foreign key.
 func (o *User) Faction(mods ...qm.QueryMod) factionQuery {
 	queryMods := []qm.QueryMod{
@@ -840,1285 +943,1090 @@ func (o *User) Asset1155ServiceTransferEvents(mods ...qm.QueryMod) asset1155Serv
 	return query
 }
 
-// FromServiceAssetServiceTransferEvents retrieves all the asset_service_transfer_event's AssetServiceTransferEvents with an executor via from_service column.
-func (o *User) FromServiceAssetServiceTransferEvents(mods ...qm.QueryMod) assetServiceTransferEventQuery {
+// FromUserAsset1155TransferEvents retrieves all the asset1155_transfer_event's Asset1155TransferEvents with an executor via from_user_id column.
+func (o *User) FromUserAsset1155TransferEvents(mods ...qm.QueryMod) asset1155TransferEventQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"asset_service_transfer_events\".\"from_service\"=?", o.ID),
+		qm.Where("\"asset1155_transfer_events\".\"from_user_id\"=?", o.ID),
 	)
 
-	query := AssetServiceTransferEvents(queryMods...)
-	queries.SetFrom(query.Query, "\"asset_service_transfer_events\"")
+	query := Asset1155TransferEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"asset1155_transfer_events\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"asset_service_transfer_events\".*"})
+		queries.SetSelect(query.Query, []string{"\"asset1155_transfer_events\".*"})
 	}
 
 	return query
 }
 
-// ToServiceAssetServiceTransferEvents retrieves all the asset_service_transfer_event's AssetServiceTransferEvents with an executor via to_service column.
-func (o *User) ToServiceAssetServiceTransferEvents(mods ...qm.QueryMod) assetServiceTransferEventQuery {
+// ServiceAsset1155TransferEvents retrieves all the asset1155_transfer_event's Asset1155TransferEvents with an executor via service_id column.
+func (o *User) ServiceAsset1155TransferEvents(mods ...qm.QueryMod) asset1155TransferEventQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"asset_service_transfer_events\".\"to_service\"=?", o.ID),
+		qm.Where("\"asset1155_transfer_events\".\"service_id\"=?", o.ID),
 	)
 
-	query := AssetServiceTransferEvents(queryMods...)
-	queries.SetFrom(query.Query, "\"asset_service_transfer_events\"")
+	query := Asset1155TransferEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"asset1155_transfer_events\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"asset_service_transfer_events\".*"})
+		queries.SetSelect(query.Query, []string{"\"asset1155_transfer_events\".*"})
 	}
 
 	return query
 }
 
-// AssetServiceTransferEvents retrieves all the asset_service_transfer_event's AssetServiceTransferEvents with an executor.
-func (o *User) AssetServiceTransferEvents(mods ...qm.QueryMod) assetServiceTransferEventQuery {
+// ToUserAsset1155TransferEvents retrieves all the asset1155_transfer_event's Asset1155TransferEvents with an executor via to_user_id column.
+func (o *User) ToUserAsset1155TransferEvents(mods ...qm.QueryMod) asset1155TransferEventQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"asset_service_transfer_events\".\"user_id\"=?", o.ID),
+		qm.Where("\"asset1155_transfer_events\".\"to_user_id\"=?", o.ID),
 	)
 
-	query := AssetServiceTransferEvents(queryMods...)
-	queries.SetFrom(query.Query, "\"asset_service_transfer_events\"")
+	query := Asset1155TransferEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"asset1155_transfer_events\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"asset_service_transfer_events\".*"})
+		queries.SetSelect(query.Query, []string{"\"asset1155_transfer_events\".*"})
 	}
 
 	return query
 }
 
-// FromUserAssetTransferEvents retrieves all the asset_transfer_event's AssetTransferEvents with an executor via from_user_id column.
-func (o *User) FromUserAssetTransferEvents(mods ...qm.QueryMod) assetTransferEventQuery {
+// AcceptedByAssetRentals retrieves all the asset_rental's AssetRentals with an executor via accepted_by_id column.
+func (o *User) AcceptedByAssetRentals(mods ...qm.QueryMod) assetRentalQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"asset_transfer_events\".\"from_user_id\"=?", o.ID),
+		qm.Where("\"asset_rentals\".\"accepted_by_id\"=?", o.ID),
 	)
 
-	query := AssetTransferEvents(queryMods...)
-	queries.SetFrom(query.Query, "\"asset_transfer_events\"")
+	query := AssetRentals(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_rentals\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"asset_transfer_events\".*"})
+		queries.SetSelect(query.Query, []string{"\"asset_rentals\".*"})
 	}
 
 	return query
 }
 
-// ToUserAssetTransferEvents retrieves all the asset_transfer_event's AssetTransferEvents with an executor via to_user_id column.
-func (o *User) ToUserAssetTransferEvents(mods ...qm.QueryMod) assetTransferEventQuery {
+// OwnerAssetRentals retrieves all the asset_rental's AssetRentals with an executor via owner_id column.
+func (o *User) OwnerAssetRentals(mods ...qm.QueryMod) assetRentalQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"asset_transfer_events\".\"to_user_id\"=?", o.ID),
+		qm.Where("\"asset_rentals\".\"owner_id\"=?", o.ID),
 	)
 
-	query := AssetTransferEvents(queryMods...)
-	queries.SetFrom(query.Query, "\"asset_transfer_events\"")
+	query := AssetRentals(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_rentals\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"asset_transfer_events\".*"})
+		queries.SetSelect(query.Query, []string{"\"asset_rentals\".*"})
 	}
 
 	return query
 }
 
-// DepositAsset1155Transactions retrieves all the deposit_asset1155_transaction's DepositAsset1155Transactions with an executor.
-func (o *User) DepositAsset1155Transactions(mods ...qm.QueryMod) depositAsset1155TransactionQuery {
+// RenterUserAssetRentals retrieves all the asset_rental's AssetRentals with an executor via renter_user_id column.
+func (o *User) RenterUserAssetRentals(mods ...qm.QueryMod) assetRentalQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"deposit_asset1155_transactions\".\"user_id\"=?", o.ID),
+		qm.Where("\"asset_rentals\".\"renter_user_id\"=?", o.ID),
 	)
 
-	query := DepositAsset1155Transactions(queryMods...)
-	queries.SetFrom(query.Query, "\"deposit_asset1155_transactions\"")
+	query := AssetRentals(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_rentals\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"deposit_asset1155_transactions\".*"})
+		queries.SetSelect(query.Query, []string{"\"asset_rentals\".*"})
 	}
 
 	return query
 }
 
-// DepositTransactions retrieves all the deposit_transaction's DepositTransactions with an executor.
-func (o *User) DepositTransactions(mods ...qm.QueryMod) depositTransactionQuery {
+// ReleasedByAssetServiceLocks retrieves all the asset_service_lock's AssetServiceLocks with an executor via released_by_id column.
+func (o *User) ReleasedByAssetServiceLocks(mods ...qm.QueryMod) assetServiceLockQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"deposit_transactions\".\"user_id\"=?", o.ID),
-		qmhelper.WhereIsNull("\"deposit_transactions\".\"deleted_at\""),
+		qm.Where("\"asset_service_locks\".\"released_by_id\"=?", o.ID),
 	)
 
-	query := DepositTransactions(queryMods...)
-	queries.SetFrom(query.Query, "\"deposit_transactions\"")
+	query := AssetServiceLocks(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_service_locks\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"deposit_transactions\".*"})
+		queries.SetSelect(query.Query, []string{"\"asset_service_locks\".*"})
 	}
 
 	return query
 }
 
-// CreditFailedTransactions retrieves all the failed_transaction's FailedTransactions with an executor via credit column.
-func (o *User) CreditFailedTransactions(mods ...qm.QueryMod) failedTransactionQuery {
+// ServiceAssetServiceLocks retrieves all the asset_service_lock's AssetServiceLocks with an executor via service_id column.
+func (o *User) ServiceAssetServiceLocks(mods ...qm.QueryMod) assetServiceLockQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"failed_transactions\".\"credit\"=?", o.ID),
+		qm.Where("\"asset_service_locks\".\"service_id\"=?", o.ID),
 	)
 
-	query := FailedTransactions(queryMods...)
-	queries.SetFrom(query.Query, "\"failed_transactions\"")
+	query := AssetServiceLocks(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_service_locks\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"failed_transactions\".*"})
+		queries.SetSelect(query.Query, []string{"\"asset_service_locks\".*"})
 	}
 
 	return query
 }
 
-// DebitFailedTransactions retrieves all the failed_transaction's FailedTransactions with an executor via debit column.
-func (o *User) DebitFailedTransactions(mods ...qm.QueryMod) failedTransactionQuery {
+// FromServiceAssetServiceTransferEvents retrieves all the asset_service_transfer_event's AssetServiceTransferEvents with an executor via from_service column.
+func (o *User) FromServiceAssetServiceTransferEvents(mods ...qm.QueryMod) assetServiceTransferEventQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"failed_transactions\".\"debit\"=?", o.ID),
+		qm.Where("\"asset_service_transfer_events\".\"from_service\"=?", o.ID),
 	)
 
-	query := FailedTransactions(queryMods...)
-	queries.SetFrom(query.Query, "\"failed_transactions\"")
+	query := AssetServiceTransferEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_service_transfer_events\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"failed_transactions\".*"})
+		queries.SetSelect(query.Query, []string{"\"asset_service_transfer_events\".*"})
 	}
 
 	return query
 }
 
-// IssueTokens retrieves all the issue_token's IssueTokens with an executor.
-func (o *User) IssueTokens(mods ...qm.QueryMod) issueTokenQuery {
+// ToServiceAssetServiceTransferEvents retrieves all the asset_service_transfer_event's AssetServiceTransferEvents with an executor via to_service column.
+func (o *User) ToServiceAssetServiceTransferEvents(mods ...qm.QueryMod) assetServiceTransferEventQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"issue_tokens\".\"user_id\"=?", o.ID),
-		qmhelper.WhereIsNull("\"issue_tokens\".\"deleted_at\""),
+		qm.Where("\"asset_service_transfer_events\".\"to_service\"=?", o.ID),
 	)
 
-	query := IssueTokens(queryMods...)
-	queries.SetFrom(query.Query, "\"issue_tokens\"")
+	query := AssetServiceTransferEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_service_transfer_events\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"issue_tokens\".*"})
+		queries.SetSelect(query.Query, []string{"\"asset_service_transfer_events\".*"})
 	}
 
 	return query
 }
 
-// Pending1155Rollbacks retrieves all the pending_1155_rollback's Pending1155Rollbacks with an executor.
-func (o *User) Pending1155Rollbacks(mods ...qm.QueryMod) pending1155RollbackQuery {
+// AssetServiceTransferEvents retrieves all the asset_service_transfer_event's AssetServiceTransferEvents with an executor.
+func (o *User) AssetServiceTransferEvents(mods ...qm.QueryMod) assetServiceTransferEventQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"pending_1155_rollback\".\"user_id\"=?", o.ID),
-		qmhelper.WhereIsNull("\"pending_1155_rollback\".\"deleted_at\""),
+		qm.Where("\"asset_service_transfer_events\".\"user_id\"=?", o.ID),
 	)
 
-	query := Pending1155Rollbacks(queryMods...)
-	queries.SetFrom(query.Query, "\"pending_1155_rollback\"")
+	query := AssetServiceTransferEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_service_transfer_events\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"pending_1155_rollback\".*"})
+		queries.SetSelect(query.Query, []string{"\"asset_service_transfer_events\".*"})
 	}
 
 	return query
 }
 
-// PendingRefunds retrieves all the pending_refund's PendingRefunds with an executor.
-func (o *User) PendingRefunds(mods ...qm.QueryMod) pendingRefundQuery {
+// OffererAssetTrades retrieves all the asset_trade's AssetTrades with an executor via offerer_id column.
+func (o *User) OffererAssetTrades(mods ...qm.QueryMod) assetTradeQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"pending_refund\".\"user_id\"=?", o.ID),
-		qmhelper.WhereIsNull("\"pending_refund\".\"deleted_at\""),
+		qm.Where("\"asset_trades\".\"offerer_id\"=?", o.ID),
 	)
 
-	query := PendingRefunds(queryMods...)
-	queries.SetFrom(query.Query, "\"pending_refund\"")
+	query := AssetTrades(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_trades\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"pending_refund\".*"})
+		queries.SetSelect(query.Query, []string{"\"asset_trades\".*"})
 	}
 
 	return query
 }
 
-// OwnerPurchasedItemsOlds retrieves all the purchased_items_old's PurchasedItemsOlds with an executor via owner_id column.
-func (o *User) OwnerPurchasedItemsOlds(mods ...qm.QueryMod) purchasedItemsOldQuery {
+// RecipientAssetTrades retrieves all the asset_trade's AssetTrades with an executor via recipient_id column.
+func (o *User) RecipientAssetTrades(mods ...qm.QueryMod) assetTradeQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"purchased_items_old\".\"owner_id\"=?", o.ID),
-		qmhelper.WhereIsNull("\"purchased_items_old\".\"deleted_at\""),
+		qm.Where("\"asset_trades\".\"recipient_id\"=?", o.ID),
 	)
 
-	query := PurchasedItemsOlds(queryMods...)
-	queries.SetFrom(query.Query, "\"purchased_items_old\"")
+	query := AssetTrades(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_trades\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"purchased_items_old\".*"})
+		queries.SetSelect(query.Query, []string{"\"asset_trades\".*"})
 	}
 
 	return query
 }
 
-// FoundedBySyndicates retrieves all the syndicate's Syndicates with an executor via founded_by_id column.
-func (o *User) FoundedBySyndicates(mods ...qm.QueryMod) syndicateQuery {
+// FromUserAssetTransferEvents retrieves all the asset_transfer_event's AssetTransferEvents with an executor via from_user_id column.
+func (o *User) FromUserAssetTransferEvents(mods ...qm.QueryMod) assetTransferEventQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"syndicates\".\"founded_by_id\"=?", o.ID),
-		qmhelper.WhereIsNull("\"syndicates\".\"deleted_at\""),
+		qm.Where("\"asset_transfer_events\".\"from_user_id\"=?", o.ID),
 	)
 
-	query := Syndicates(queryMods...)
-	queries.SetFrom(query.Query, "\"syndicates\"")
+	query := AssetTransferEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_transfer_events\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"syndicates\".*"})
+		queries.SetSelect(query.Query, []string{"\"asset_transfer_events\".*"})
 	}
 
 	return query
 }
 
-// ServiceTransactions retrieves all the transaction's Transactions with an executor via service_id column.
-func (o *User) ServiceTransactions(mods ...qm.QueryMod) transactionQuery {
+// ToUserAssetTransferEvents retrieves all the asset_transfer_event's AssetTransferEvents with an executor via to_user_id column.
+func (o *User) ToUserAssetTransferEvents(mods ...qm.QueryMod) assetTransferEventQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"transactions\".\"service_id\"=?", o.ID),
+		qm.Where("\"asset_transfer_events\".\"to_user_id\"=?", o.ID),
 	)
 
-	query := Transactions(queryMods...)
-	queries.SetFrom(query.Query, "\"transactions\"")
+	query := AssetTransferEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"asset_transfer_events\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"transactions\".*"})
+		queries.SetSelect(query.Query, []string{"\"asset_transfer_events\".*"})
 	}
 
 	return query
 }
 
-// CreditTransactionsOlds retrieves all the transactions_old's TransactionsOlds with an executor via credit column.
-func (o *User) CreditTransactionsOlds(mods ...qm.QueryMod) transactionsOldQuery {
+// ServiceCraftingEvents retrieves all the crafting_event's CraftingEvents with an executor via service_id column.
+func (o *User) ServiceCraftingEvents(mods ...qm.QueryMod) craftingEventQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"transactions_old\".\"credit\"=?", o.ID),
+		qm.Where("\"crafting_events\".\"service_id\"=?", o.ID),
 	)
 
-	query := TransactionsOlds(queryMods...)
-	queries.SetFrom(query.Query, "\"transactions_old\"")
+	query := CraftingEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"crafting_events\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"transactions_old\".*"})
+		queries.SetSelect(query.Query, []string{"\"crafting_events\".*"})
 	}
 
 	return query
 }
 
-// DebitTransactionsOlds retrieves all the transactions_old's TransactionsOlds with an executor via debit column.
-func (o *User) DebitTransactionsOlds(mods ...qm.QueryMod) transactionsOldQuery {
+// CraftingEvents retrieves all the crafting_event's CraftingEvents with an executor.
+func (o *User) CraftingEvents(mods ...qm.QueryMod) craftingEventQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"transactions_old\".\"debit\"=?", o.ID),
+		qm.Where("\"crafting_events\".\"user_id\"=?", o.ID),
 	)
 
-	query := TransactionsOlds(queryMods...)
-	queries.SetFrom(query.Query, "\"transactions_old\"")
+	query := CraftingEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"crafting_events\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"transactions_old\".*"})
+		queries.SetSelect(query.Query, []string{"\"crafting_events\".*"})
 	}
 
 	return query
 }
 
-// ServiceTransactionsOlds retrieves all the transactions_old's TransactionsOlds with an executor via service_id column.
-func (o *User) ServiceTransactionsOlds(mods ...qm.QueryMod) transactionsOldQuery {
+// CreatedByCraftingRecipes retrieves all the crafting_recipe's CraftingRecipes with an executor via created_by_id column.
+func (o *User) CreatedByCraftingRecipes(mods ...qm.QueryMod) craftingRecipeQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"transactions_old\".\"service_id\"=?", o.ID),
+		qm.Where("\"crafting_recipes\".\"created_by_id\"=?", o.ID),
 	)
 
-	query := TransactionsOlds(queryMods...)
-	queries.SetFrom(query.Query, "\"transactions_old\"")
+	query := CraftingRecipes(queryMods...)
+	queries.SetFrom(query.Query, "\"crafting_recipes\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"transactions_old\".*"})
+		queries.SetSelect(query.Query, []string{"\"crafting_recipes\".*"})
 	}
 
 	return query
 }
 
-// UserActivities retrieves all the user_activity's UserActivities with an executor.
-func (o *User) UserActivities(mods ...qm.QueryMod) userActivityQuery {
+// DepositAsset1155Transactions retrieves all the deposit_asset1155_transaction's DepositAsset1155Transactions with an executor.
+func (o *User) DepositAsset1155Transactions(mods ...qm.QueryMod) depositAsset1155TransactionQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"user_activities\".\"user_id\"=?", o.ID),
+		qm.Where("\"deposit_asset1155_transactions\".\"user_id\"=?", o.ID),
 	)
 
-	query := UserActivities(queryMods...)
-	queries.SetFrom(query.Query, "\"user_activities\"")
+	query := DepositAsset1155Transactions(queryMods...)
+	queries.SetFrom(query.Query, "\"deposit_asset1155_transactions\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"user_activities\".*"})
+		queries.SetSelect(query.Query, []string{"\"deposit_asset1155_transactions\".*"})
 	}
 
 	return query
 }
 
-// LockedToServiceUserAssets retrieves all the user_asset's UserAssets with an executor via locked_to_service column.
-func (o *User) LockedToServiceUserAssets(mods ...qm.QueryMod) userAssetQuery {
+// DepositTransactions retrieves all the deposit_transaction's DepositTransactions with an executor.
+func (o *User) DepositTransactions(mods ...qm.QueryMod) depositTransactionQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"user_assets\".\"locked_to_service\"=?", o.ID),
-		qmhelper.WhereIsNull("\"user_assets\".\"deleted_at\""),
+		qm.Where("\"deposit_transactions\".\"user_id\"=?", o.ID),
+		qmhelper.WhereIsNull("\"deposit_transactions\".\"deleted_at\""),
 	)
 
-	query := UserAssets(queryMods...)
-	queries.SetFrom(query.Query, "\"user_assets\"")
+	query := DepositTransactions(queryMods...)
+	queries.SetFrom(query.Query, "\"deposit_transactions\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"user_assets\".*"})
+		queries.SetSelect(query.Query, []string{"\"deposit_transactions\".*"})
 	}
 
 	return query
 }
 
-// OwnerUserAssets retrieves all the user_asset's UserAssets with an executor via owner_id column.
-func (o *User) OwnerUserAssets(mods ...qm.QueryMod) userAssetQuery {
+// CreditFailedTransactions retrieves all the failed_transaction's FailedTransactions with an executor via credit column.
+func (o *User) CreditFailedTransactions(mods ...qm.QueryMod) failedTransactionQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"user_assets\".\"owner_id\"=?", o.ID),
-		qmhelper.WhereIsNull("\"user_assets\".\"deleted_at\""),
+		qm.Where("\"failed_transactions\".\"credit\"=?", o.ID),
 	)
 
-	query := UserAssets(queryMods...)
-	queries.SetFrom(query.Query, "\"user_assets\"")
+	query := FailedTransactions(queryMods...)
+	queries.SetFrom(query.Query, "\"failed_transactions\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"user_assets\".*"})
+		queries.SetSelect(query.Query, []string{"\"failed_transactions\".*"})
 	}
 
 	return query
 }
 
-// OwnerUserAssets1155S retrieves all the user_assets_1155's UserAssets1155S with an executor via owner_id column.
-func (o *User) OwnerUserAssets1155S(mods ...qm.QueryMod) userAssets1155Query {
+// DebitFailedTransactions retrieves all the failed_transaction's FailedTransactions with an executor via debit column.
+func (o *User) DebitFailedTransactions(mods ...qm.QueryMod) failedTransactionQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"user_assets_1155\".\"owner_id\"=?", o.ID),
+		qm.Where("\"failed_transactions\".\"debit\"=?", o.ID),
 	)
 
-	query := UserAssets1155S(queryMods...)
-	queries.SetFrom(query.Query, "\"user_assets_1155\"")
+	query := FailedTransactions(queryMods...)
+	queries.SetFrom(query.Query, "\"failed_transactions\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"user_assets_1155\".*"})
+		queries.SetSelect(query.Query, []string{"\"failed_transactions\".*"})
 	}
 
 	return query
 }
 
-// UserFingerprints retrieves all the user_fingerprint's UserFingerprints with an executor.
-func (o *User) UserFingerprints(mods ...qm.QueryMod) userFingerprintQuery {
+// HeldPurchases retrieves all the held_purchase's HeldPurchases with an executor.
+func (o *User) HeldPurchases(mods ...qm.QueryMod) heldPurchaseQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"user_fingerprints\".\"user_id\"=?", o.ID),
-		qmhelper.WhereIsNull("\"user_fingerprints\".\"deleted_at\""),
+		qm.Where("\"held_purchases\".\"user_id\"=?", o.ID),
 	)
 
-	query := UserFingerprints(queryMods...)
-	queries.SetFrom(query.Query, "\"user_fingerprints\"")
+	query := HeldPurchases(queryMods...)
+	queries.SetFrom(query.Query, "\"held_purchases\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"user_fingerprints\".*"})
+		queries.SetSelect(query.Query, []string{"\"held_purchases\".*"})
 	}
 
 	return query
 }
 
-// UserRecoveryCodes retrieves all the user_recovery_code's UserRecoveryCodes with an executor.
-func (o *User) UserRecoveryCodes(mods ...qm.QueryMod) userRecoveryCodeQuery {
+// HolderSnapshotEntries retrieves all the holder_snapshot_entry's HolderSnapshotEntries with an executor.
+func (o *User) HolderSnapshotEntries(mods ...qm.QueryMod) holderSnapshotEntryQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"user_recovery_codes\".\"user_id\"=?", o.ID),
-		qmhelper.WhereIsNull("\"user_recovery_codes\".\"deleted_at\""),
+		qm.Where("\"holder_snapshot_entries\".\"user_id\"=?", o.ID),
 	)
 
-	query := UserRecoveryCodes(queryMods...)
-	queries.SetFrom(query.Query, "\"user_recovery_codes\"")
+	query := HolderSnapshotEntries(queryMods...)
+	queries.SetFrom(query.Query, "\"holder_snapshot_entries\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"user_recovery_codes\".*"})
+		queries.SetSelect(query.Query, []string{"\"holder_snapshot_entries\".*"})
 	}
 
 	return query
 }
 
-// UsernameHistories retrieves all the username_history's UsernameHistories with an executor.
-func (o *User) UsernameHistories(mods ...qm.QueryMod) usernameHistoryQuery {
+// CreatedByHolderSnapshots retrieves all the holder_snapshot's HolderSnapshots with an executor via created_by_id column.
+func (o *User) CreatedByHolderSnapshots(mods ...qm.QueryMod) holderSnapshotQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"username_history\".\"user_id\"=?", o.ID),
+		qm.Where("\"holder_snapshots\".\"created_by_id\"=?", o.ID),
 	)
 
-	query := UsernameHistories(queryMods...)
-	queries.SetFrom(query.Query, "\"username_history\"")
+	query := HolderSnapshots(queryMods...)
+	queries.SetFrom(query.Query, "\"holder_snapshots\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"username_history\".*"})
+		queries.SetSelect(query.Query, []string{"\"holder_snapshots\".*"})
 	}
 
 	return query
 }
 
-// LoadAccount allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for an N-1 relationship.
-func (userL) LoadAccount(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
+// IssueTokens retrieves all the issue_token's IssueTokens with an executor.
+func (o *User) IssueTokens(mods ...qm.QueryMod) issueTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args = append(args, object.AccountID)
+	queryMods = append(queryMods,
+		qm.Where("\"issue_tokens\".\"user_id\"=?", o.ID),
+		qmhelper.WhereIsNull("\"issue_tokens\".\"deleted_at\""),
+	)
 
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
-
-			for _, a := range args {
-				if a == obj.AccountID {
-					continue Outer
-				}
-			}
-
-			args = append(args, obj.AccountID)
+	query := IssueTokens(queryMods...)
+	queries.SetFrom(query.Query, "\"issue_tokens\"")
 
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"issue_tokens\".*"})
 	}
 
-	if len(args) == 0 {
-		return nil
+	return query
+}
+
+// BidderMarketplaceBids retrieves all the marketplace_bid's MarketplaceBids with an executor via bidder_id column.
+func (o *User) BidderMarketplaceBids(mods ...qm.QueryMod) marketplaceBidQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	query := NewQuery(
-		qm.From(`accounts`),
-		qm.WhereIn(`accounts.id in ?`, args...),
-		qmhelper.WhereIsNull(`accounts.deleted_at`),
+	queryMods = append(queryMods,
+		qm.Where("\"marketplace_bids\".\"bidder_id\"=?", o.ID),
 	)
-	if mods != nil {
-		mods.Apply(query)
-	}
 
-	results, err := query.Query(e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load Account")
-	}
+	query := MarketplaceBids(queryMods...)
+	queries.SetFrom(query.Query, "\"marketplace_bids\"")
 
-	var resultSlice []*Account
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Account")
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"marketplace_bids\".*"})
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for accounts")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for accounts")
-	}
+	return query
+}
 
-	if len(userAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(e); err != nil {
-				return err
-			}
-		}
+// BuyerMarketplaceListings retrieves all the marketplace_listing's MarketplaceListings with an executor via buyer_id column.
+func (o *User) BuyerMarketplaceListings(mods ...qm.QueryMod) marketplaceListingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if len(resultSlice) == 0 {
-		return nil
-	}
+	queryMods = append(queryMods,
+		qm.Where("\"marketplace_listings\".\"buyer_id\"=?", o.ID),
+	)
 
-	if singular {
-		foreign := resultSlice[0]
-		object.R.Account = foreign
-		if foreign.R == nil {
-			foreign.R = &accountR{}
-		}
-		foreign.R.Users = append(foreign.R.Users, object)
-		return nil
-	}
+	query := MarketplaceListings(queryMods...)
+	queries.SetFrom(query.Query, "\"marketplace_listings\"")
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
-			if local.AccountID == foreign.ID {
-				local.R.Account = foreign
-				if foreign.R == nil {
-					foreign.R = &accountR{}
-				}
-				foreign.R.Users = append(foreign.R.Users, local)
-				break
-			}
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"marketplace_listings\".*"})
 	}
 
-	return nil
+	return query
 }
 
-// LoadAvatar allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for an N-1 relationship.
-func (userL) LoadAvatar(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
+// CurrentBidderMarketplaceListings retrieves all the marketplace_listing's MarketplaceListings with an executor via current_bidder_id column.
+func (o *User) CurrentBidderMarketplaceListings(mods ...qm.QueryMod) marketplaceListingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		if !queries.IsNil(object.AvatarID) {
-			args = append(args, object.AvatarID)
-		}
-
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
-
-			for _, a := range args {
-				if queries.Equal(a, obj.AvatarID) {
-					continue Outer
-				}
-			}
+	queryMods = append(queryMods,
+		qm.Where("\"marketplace_listings\".\"current_bidder_id\"=?", o.ID),
+	)
 
-			if !queries.IsNil(obj.AvatarID) {
-				args = append(args, obj.AvatarID)
-			}
+	query := MarketplaceListings(queryMods...)
+	queries.SetFrom(query.Query, "\"marketplace_listings\"")
 
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"marketplace_listings\".*"})
 	}
 
-	if len(args) == 0 {
-		return nil
+	return query
+}
+
+// SellerMarketplaceListings retrieves all the marketplace_listing's MarketplaceListings with an executor via seller_id column.
+func (o *User) SellerMarketplaceListings(mods ...qm.QueryMod) marketplaceListingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	query := NewQuery(
-		qm.From(`blobs`),
-		qm.WhereIn(`blobs.id in ?`, args...),
-		qmhelper.WhereIsNull(`blobs.deleted_at`),
+	queryMods = append(queryMods,
+		qm.Where("\"marketplace_listings\".\"seller_id\"=?", o.ID),
 	)
-	if mods != nil {
-		mods.Apply(query)
-	}
 
-	results, err := query.Query(e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load Blob")
-	}
+	query := MarketplaceListings(queryMods...)
+	queries.SetFrom(query.Query, "\"marketplace_listings\"")
 
-	var resultSlice []*Blob
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Blob")
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"marketplace_listings\".*"})
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for blobs")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for blobs")
-	}
+	return query
+}
 
-	if len(userAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(e); err != nil {
-				return err
-			}
-		}
+// Pending1155Rollbacks retrieves all the pending_1155_rollback's Pending1155Rollbacks with an executor.
+func (o *User) Pending1155Rollbacks(mods ...qm.QueryMod) pending1155RollbackQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if len(resultSlice) == 0 {
-		return nil
-	}
+	queryMods = append(queryMods,
+		qm.Where("\"pending_1155_rollback\".\"user_id\"=?", o.ID),
+		qmhelper.WhereIsNull("\"pending_1155_rollback\".\"deleted_at\""),
+	)
 
-	if singular {
-		foreign := resultSlice[0]
-		object.R.Avatar = foreign
-		if foreign.R == nil {
-			foreign.R = &blobR{}
-		}
-		foreign.R.AvatarUsers = append(foreign.R.AvatarUsers, object)
-		return nil
-	}
+	query := Pending1155Rollbacks(queryMods...)
+	queries.SetFrom(query.Query, "\"pending_1155_rollback\"")
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
-			if queries.Equal(local.AvatarID, foreign.ID) {
-				local.R.Avatar = foreign
-				if foreign.R == nil {
-					foreign.R = &blobR{}
-				}
-				foreign.R.AvatarUsers = append(foreign.R.AvatarUsers, local)
-				break
-			}
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"pending_1155_rollback\".*"})
 	}
 
-	return nil
+	return query
 }
 
-// LoadFaction allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for an N-1 relationship.
-func (userL) LoadFaction(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
+// PendingRefunds retrieves all the pending_refund's PendingRefunds with an executor.
+func (o *User) PendingRefunds(mods ...qm.QueryMod) pendingRefundQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		if !queries.IsNil(object.FactionID) {
-			args = append(args, object.FactionID)
-		}
+	queryMods = append(queryMods,
+		qm.Where("\"pending_refund\".\"user_id\"=?", o.ID),
+		qmhelper.WhereIsNull("\"pending_refund\".\"deleted_at\""),
+	)
 
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
+	query := PendingRefunds(queryMods...)
+	queries.SetFrom(query.Query, "\"pending_refund\"")
 
-			for _, a := range args {
-				if queries.Equal(a, obj.FactionID) {
-					continue Outer
-				}
-			}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"pending_refund\".*"})
+	}
 
-			if !queries.IsNil(obj.FactionID) {
-				args = append(args, obj.FactionID)
-			}
+	return query
+}
 
-		}
+// OperatorUserPendingWithdrawActions retrieves all the pending_withdraw_action's PendingWithdrawActions with an executor via operator_user_id column.
+func (o *User) OperatorUserPendingWithdrawActions(mods ...qm.QueryMod) pendingWithdrawActionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if len(args) == 0 {
-		return nil
-	}
-
-	query := NewQuery(
-		qm.From(`factions`),
-		qm.WhereIn(`factions.id in ?`, args...),
+	queryMods = append(queryMods,
+		qm.Where("\"pending_withdraw_actions\".\"operator_user_id\"=?", o.ID),
 	)
-	if mods != nil {
-		mods.Apply(query)
-	}
 
-	results, err := query.Query(e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load Faction")
-	}
+	query := PendingWithdrawActions(queryMods...)
+	queries.SetFrom(query.Query, "\"pending_withdraw_actions\"")
 
-	var resultSlice []*Faction
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Faction")
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"pending_withdraw_actions\".*"})
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for factions")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for factions")
-	}
+	return query
+}
 
-	if len(userAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(e); err != nil {
-				return err
-			}
-		}
+// OwnerPurchasedItemsOlds retrieves all the purchased_items_old's PurchasedItemsOlds with an executor via owner_id column.
+func (o *User) OwnerPurchasedItemsOlds(mods ...qm.QueryMod) purchasedItemsOldQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if len(resultSlice) == 0 {
-		return nil
-	}
+	queryMods = append(queryMods,
+		qm.Where("\"purchased_items_old\".\"owner_id\"=?", o.ID),
+		qmhelper.WhereIsNull("\"purchased_items_old\".\"deleted_at\""),
+	)
 
-	if singular {
-		foreign := resultSlice[0]
-		object.R.Faction = foreign
-		if foreign.R == nil {
-			foreign.R = &factionR{}
-		}
-		foreign.R.Users = append(foreign.R.Users, object)
-		return nil
-	}
+	query := PurchasedItemsOlds(queryMods...)
+	queries.SetFrom(query.Query, "\"purchased_items_old\"")
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
-			if queries.Equal(local.FactionID, foreign.ID) {
-				local.R.Faction = foreign
-				if foreign.R == nil {
-					foreign.R = &factionR{}
-				}
-				foreign.R.Users = append(foreign.R.Users, local)
-				break
-			}
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"purchased_items_old\".*"})
 	}
 
-	return nil
+	return query
 }
 
-// LoadLegacyAccount allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for an N-1 relationship.
-func (userL) LoadLegacyAccount(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
+// ResolvedByReconciliationDiscrepancies retrieves all the reconciliation_discrepancy's ReconciliationDiscrepancies with an executor via resolved_by_id column.
+func (o *User) ResolvedByReconciliationDiscrepancies(mods ...qm.QueryMod) reconciliationDiscrepancyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		if !queries.IsNil(object.LegacyAccountID) {
-			args = append(args, object.LegacyAccountID)
-		}
-
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
-
-			for _, a := range args {
-				if queries.Equal(a, obj.LegacyAccountID) {
-					continue Outer
-				}
-			}
+	queryMods = append(queryMods,
+		qm.Where("\"reconciliation_discrepancies\".\"resolved_by_id\"=?", o.ID),
+	)
 
-			if !queries.IsNil(obj.LegacyAccountID) {
-				args = append(args, obj.LegacyAccountID)
-			}
+	query := ReconciliationDiscrepancies(queryMods...)
+	queries.SetFrom(query.Query, "\"reconciliation_discrepancies\"")
 
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"reconciliation_discrepancies\".*"})
 	}
 
-	if len(args) == 0 {
-		return nil
+	return query
+}
+
+// CreatedByReconciliationRuns retrieves all the reconciliation_run's ReconciliationRuns with an executor via created_by_id column.
+func (o *User) CreatedByReconciliationRuns(mods ...qm.QueryMod) reconciliationRunQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	query := NewQuery(
-		qm.From(`accounts`),
-		qm.WhereIn(`accounts.id in ?`, args...),
-		qmhelper.WhereIsNull(`accounts.deleted_at`),
+	queryMods = append(queryMods,
+		qm.Where("\"reconciliation_runs\".\"created_by_id\"=?", o.ID),
 	)
-	if mods != nil {
-		mods.Apply(query)
-	}
 
-	results, err := query.Query(e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load Account")
-	}
+	query := ReconciliationRuns(queryMods...)
+	queries.SetFrom(query.Query, "\"reconciliation_runs\"")
 
-	var resultSlice []*Account
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Account")
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"reconciliation_runs\".*"})
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for accounts")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for accounts")
-	}
+	return query
+}
 
-	if len(userAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(e); err != nil {
-				return err
-			}
-		}
+// MemberSyndicateAssetTransfers retrieves all the syndicate_asset_transfer's SyndicateAssetTransfers with an executor via member_id column.
+func (o *User) MemberSyndicateAssetTransfers(mods ...qm.QueryMod) syndicateAssetTransferQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if len(resultSlice) == 0 {
-		return nil
-	}
+	queryMods = append(queryMods,
+		qm.Where("\"syndicate_asset_transfers\".\"member_id\"=?", o.ID),
+	)
 
-	if singular {
-		foreign := resultSlice[0]
-		object.R.LegacyAccount = foreign
-		if foreign.R == nil {
-			foreign.R = &accountR{}
-		}
-		foreign.R.LegacyAccountUsers = append(foreign.R.LegacyAccountUsers, object)
-		return nil
-	}
+	query := SyndicateAssetTransfers(queryMods...)
+	queries.SetFrom(query.Query, "\"syndicate_asset_transfers\"")
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
-			if queries.Equal(local.LegacyAccountID, foreign.ID) {
-				local.R.LegacyAccount = foreign
-				if foreign.R == nil {
-					foreign.R = &accountR{}
-				}
-				foreign.R.LegacyAccountUsers = append(foreign.R.LegacyAccountUsers, local)
-				break
-			}
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"syndicate_asset_transfers\".*"})
 	}
 
-	return nil
+	return query
 }
 
-// LoadRole allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for an N-1 relationship.
-func (userL) LoadRole(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
+// SyndicateMembers retrieves all the syndicate_member's SyndicateMembers with an executor.
+func (o *User) SyndicateMembers(mods ...qm.QueryMod) syndicateMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		if !queries.IsNil(object.RoleID) {
-			args = append(args, object.RoleID)
-		}
-
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
-
-			for _, a := range args {
-				if queries.Equal(a, obj.RoleID) {
-					continue Outer
-				}
-			}
+	queryMods = append(queryMods,
+		qm.Where("\"syndicate_members\".\"user_id\"=?", o.ID),
+	)
 
-			if !queries.IsNil(obj.RoleID) {
-				args = append(args, obj.RoleID)
-			}
+	query := SyndicateMembers(queryMods...)
+	queries.SetFrom(query.Query, "\"syndicate_members\"")
 
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"syndicate_members\".*"})
 	}
 
-	if len(args) == 0 {
-		return nil
+	return query
+}
+
+// FoundedBySyndicates retrieves all the syndicate's Syndicates with an executor via founded_by_id column.
+func (o *User) FoundedBySyndicates(mods ...qm.QueryMod) syndicateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	query := NewQuery(
-		qm.From(`roles`),
-		qm.WhereIn(`roles.id in ?`, args...),
-		qmhelper.WhereIsNull(`roles.deleted_at`),
+	queryMods = append(queryMods,
+		qm.Where("\"syndicates\".\"founded_by_id\"=?", o.ID),
+		qmhelper.WhereIsNull("\"syndicates\".\"deleted_at\""),
 	)
-	if mods != nil {
-		mods.Apply(query)
-	}
 
-	results, err := query.Query(e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load Role")
-	}
+	query := Syndicates(queryMods...)
+	queries.SetFrom(query.Query, "\"syndicates\"")
 
-	var resultSlice []*Role
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Role")
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"syndicates\".*"})
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for roles")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
-	}
+	return query
+}
 
-	if len(userAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(e); err != nil {
-				return err
-			}
-		}
+// ServiceTransactions retrieves all the transaction's Transactions with an executor via service_id column.
+func (o *User) ServiceTransactions(mods ...qm.QueryMod) transactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if len(resultSlice) == 0 {
-		return nil
-	}
+	queryMods = append(queryMods,
+		qm.Where("\"transactions\".\"service_id\"=?", o.ID),
+	)
 
-	if singular {
-		foreign := resultSlice[0]
-		object.R.Role = foreign
-		if foreign.R == nil {
-			foreign.R = &roleR{}
-		}
-		foreign.R.Users = append(foreign.R.Users, object)
-		return nil
-	}
+	query := Transactions(queryMods...)
+	queries.SetFrom(query.Query, "\"transactions\"")
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
-			if queries.Equal(local.RoleID, foreign.ID) {
-				local.R.Role = foreign
-				if foreign.R == nil {
-					foreign.R = &roleR{}
-				}
-				foreign.R.Users = append(foreign.R.Users, local)
-				break
-			}
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"transactions\".*"})
 	}
 
-	return nil
+	return query
 }
 
-// LoadPasswordHash allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-1 relationship.
-func (userL) LoadPasswordHash(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
+// CreditTransactionsOlds retrieves all the transactions_old's TransactionsOlds with an executor via credit column.
+func (o *User) CreditTransactionsOlds(mods ...qm.QueryMod) transactionsOldQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args = append(args, object.ID)
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
+	queryMods = append(queryMods,
+		qm.Where("\"transactions_old\".\"credit\"=?", o.ID),
+	)
 
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
-			}
+	query := TransactionsOlds(queryMods...)
+	queries.SetFrom(query.Query, "\"transactions_old\"")
 
-			args = append(args, obj.ID)
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"transactions_old\".*"})
 	}
 
-	if len(args) == 0 {
-		return nil
+	return query
+}
+
+// DebitTransactionsOlds retrieves all the transactions_old's TransactionsOlds with an executor via debit column.
+func (o *User) DebitTransactionsOlds(mods ...qm.QueryMod) transactionsOldQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	query := NewQuery(
-		qm.From(`password_hashes`),
-		qm.WhereIn(`password_hashes.user_id in ?`, args...),
-		qmhelper.WhereIsNull(`password_hashes.deleted_at`),
+	queryMods = append(queryMods,
+		qm.Where("\"transactions_old\".\"debit\"=?", o.ID),
 	)
-	if mods != nil {
-		mods.Apply(query)
-	}
 
-	results, err := query.Query(e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load PasswordHash")
-	}
+	query := TransactionsOlds(queryMods...)
+	queries.SetFrom(query.Query, "\"transactions_old\"")
 
-	var resultSlice []*PasswordHash
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice PasswordHash")
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"transactions_old\".*"})
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for password_hashes")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_hashes")
-	}
+	return query
+}
 
-	if len(userAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(e); err != nil {
-				return err
-			}
-		}
+// ServiceTransactionsOlds retrieves all the transactions_old's TransactionsOlds with an executor via service_id column.
+func (o *User) ServiceTransactionsOlds(mods ...qm.QueryMod) transactionsOldQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if len(resultSlice) == 0 {
-		return nil
-	}
+	queryMods = append(queryMods,
+		qm.Where("\"transactions_old\".\"service_id\"=?", o.ID),
+	)
 
-	if singular {
-		foreign := resultSlice[0]
-		object.R.PasswordHash = foreign
-		if foreign.R == nil {
-			foreign.R = &passwordHashR{}
-		}
-		foreign.R.User = object
-	}
+	query := TransactionsOlds(queryMods...)
+	queries.SetFrom(query.Query, "\"transactions_old\"")
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
-			if local.ID == foreign.UserID {
-				local.R.PasswordHash = foreign
-				if foreign.R == nil {
-					foreign.R = &passwordHashR{}
-				}
-				foreign.R.User = local
-				break
-			}
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"transactions_old\".*"})
 	}
 
-	return nil
+	return query
 }
 
-// LoadAPIKeys allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadAPIKeys(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		object = maybeUser.(*User)
-	} else {
-		slice = *maybeUser.(*[]*User)
+// UserActivities retrieves all the user_activity's UserActivities with an executor.
+func (o *User) UserActivities(mods ...qm.QueryMod) userActivityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args = append(args, object.ID)
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
+	queryMods = append(queryMods,
+		qm.Where("\"user_activities\".\"user_id\"=?", o.ID),
+	)
 
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
-			}
+	query := UserActivities(queryMods...)
+	queries.SetFrom(query.Query, "\"user_activities\"")
 
-			args = append(args, obj.ID)
-		}
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"user_activities\".*"})
 	}
 
-	if len(args) == 0 {
-		return nil
+	return query
+}
+
+// ChangedByUserAssetOnChainStatusHistories retrieves all the user_asset_on_chain_status_history's UserAssetOnChainStatusHistories with an executor via changed_by_id column.
+func (o *User) ChangedByUserAssetOnChainStatusHistories(mods ...qm.QueryMod) userAssetOnChainStatusHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	query := NewQuery(
-		qm.From(`api_keys`),
-		qm.WhereIn(`api_keys.user_id in ?`, args...),
-		qmhelper.WhereIsNull(`api_keys.deleted_at`),
+	queryMods = append(queryMods,
+		qm.Where("\"user_asset_on_chain_status_history\".\"changed_by_id\"=?", o.ID),
 	)
-	if mods != nil {
-		mods.Apply(query)
-	}
 
-	results, err := query.Query(e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load api_keys")
-	}
+	query := UserAssetOnChainStatusHistories(queryMods...)
+	queries.SetFrom(query.Query, "\"user_asset_on_chain_status_history\"")
 
-	var resultSlice []*APIKey
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice api_keys")
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"user_asset_on_chain_status_history\".*"})
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on api_keys")
+	return query
+}
+
+// LockedToServiceUserAssets retrieves all the user_asset's UserAssets with an executor via locked_to_service column.
+func (o *User) LockedToServiceUserAssets(mods ...qm.QueryMod) userAssetQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for api_keys")
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_assets\".\"locked_to_service\"=?", o.ID),
+		qmhelper.WhereIsNull("\"user_assets\".\"deleted_at\""),
+	)
+
+	query := UserAssets(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"user_assets\".*"})
 	}
 
-	if len(apiKeyAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(e); err != nil {
-				return err
-			}
-		}
+	return query
+}
+
+// OwnerUserAssets retrieves all the user_asset's UserAssets with an executor via owner_id column.
+func (o *User) OwnerUserAssets(mods ...qm.QueryMod) userAssetQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
-	if singular {
-		object.R.APIKeys = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &apiKeyR{}
-			}
-			foreign.R.User = object
-		}
-		return nil
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_assets\".\"owner_id\"=?", o.ID),
+		qmhelper.WhereIsNull("\"user_assets\".\"deleted_at\""),
+	)
+
+	query := UserAssets(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"user_assets\".*"})
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.APIKeys = append(local.R.APIKeys, foreign)
-				if foreign.R == nil {
-					foreign.R = &apiKeyR{}
-				}
-				foreign.R.User = local
-				break
-			}
-		}
+	return query
+}
+
+// OwnerUserAssets1155S retrieves all the user_assets_1155's UserAssets1155S with an executor via owner_id column.
+func (o *User) OwnerUserAssets1155S(mods ...qm.QueryMod) userAssets1155Query {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	return nil
+	queryMods = append(queryMods,
+		qm.Where("\"user_assets_1155\".\"owner_id\"=?", o.ID),
+	)
+
+	query := UserAssets1155S(queryMods...)
+	queries.SetFrom(query.Query, "\"user_assets_1155\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"user_assets_1155\".*"})
+	}
+
+	return query
 }
 
-// LoadFromServiceAsset1155ServiceTransferEvents allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadFromServiceAsset1155ServiceTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// UserFingerprints retrieves all the user_fingerprint's UserFingerprints with an executor.
+func (o *User) UserFingerprints(mods ...qm.QueryMod) userFingerprintQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_fingerprints\".\"user_id\"=?", o.ID),
+		qmhelper.WhereIsNull("\"user_fingerprints\".\"deleted_at\""),
+	)
+
+	query := UserFingerprints(queryMods...)
+	queries.SetFrom(query.Query, "\"user_fingerprints\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"user_fingerprints\".*"})
+	}
+
+	return query
+}
+
+// UserRecoveryCodes retrieves all the user_recovery_code's UserRecoveryCodes with an executor.
+func (o *User) UserRecoveryCodes(mods ...qm.QueryMod) userRecoveryCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_recovery_codes\".\"user_id\"=?", o.ID),
+		qmhelper.WhereIsNull("\"user_recovery_codes\".\"deleted_at\""),
+	)
+
+	query := UserRecoveryCodes(queryMods...)
+	queries.SetFrom(query.Query, "\"user_recovery_codes\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"user_recovery_codes\".*"})
+	}
+
+	return query
+}
+
+// UsernameHistories retrieves all the username_history's UsernameHistories with an executor.
+func (o *User) UsernameHistories(mods ...qm.QueryMod) usernameHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"username_history\".\"user_id\"=?", o.ID),
+	)
+
+	query := UsernameHistories(queryMods...)
+	queries.SetFrom(query.Query, "\"username_history\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"username_history\".*"})
+	}
+
+	return query
+}
+
+// LoadAccount allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userL) LoadAccount(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2133,7 +2041,8 @@ func (userL) LoadFromServiceAsset1155ServiceTransferEvents(e boil.Executor, sing
 		if object.R == nil {
 			object.R = &userR{}
 		}
-		args = append(args, object.ID)
+		args = append(args, object.AccountID)
+
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -2142,12 +2051,13 @@ func (userL) LoadFromServiceAsset1155ServiceTransferEvents(e boil.Executor, sing
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
+				if a == obj.AccountID {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ID)
+			args = append(args, obj.AccountID)
+
 		}
 	}
 
@@ -2156,8 +2066,9 @@ func (userL) LoadFromServiceAsset1155ServiceTransferEvents(e boil.Executor, sing
 	}
 
 	query := NewQuery(
-		qm.From(`asset1155_service_transfer_events`),
-		qm.WhereIn(`asset1155_service_transfer_events.from_service in ?`, args...),
+		qm.From(`accounts`),
+		qm.WhereIn(`accounts.id in ?`, args...),
+		qmhelper.WhereIsNull(`accounts.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2165,47 +2076,51 @@ func (userL) LoadFromServiceAsset1155ServiceTransferEvents(e boil.Executor, sing
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load asset1155_service_transfer_events")
+		return errors.Wrap(err, "failed to eager load Account")
 	}
 
-	var resultSlice []*Asset1155ServiceTransferEvent
+	var resultSlice []*Account
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice asset1155_service_transfer_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice Account")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on asset1155_service_transfer_events")
+		return errors.Wrap(err, "failed to close results of eager load for accounts")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset1155_service_transfer_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for accounts")
 	}
 
-	if len(asset1155ServiceTransferEventAfterSelectHooks) != 0 {
+	if len(userAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.FromServiceAsset1155ServiceTransferEvents = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &asset1155ServiceTransferEventR{}
-			}
-			foreign.R.FromServiceUser = object
+		foreign := resultSlice[0]
+		object.R.Account = foreign
+		if foreign.R == nil {
+			foreign.R = &accountR{}
 		}
+		foreign.R.Users = append(foreign.R.Users, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.FromService) {
-				local.R.FromServiceAsset1155ServiceTransferEvents = append(local.R.FromServiceAsset1155ServiceTransferEvents, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AccountID == foreign.ID {
+				local.R.Account = foreign
 				if foreign.R == nil {
-					foreign.R = &asset1155ServiceTransferEventR{}
+					foreign.R = &accountR{}
 				}
-				foreign.R.FromServiceUser = local
+				foreign.R.Users = append(foreign.R.Users, local)
 				break
 			}
 		}
@@ -2214,9 +2129,9 @@ func (userL) LoadFromServiceAsset1155ServiceTransferEvents(e boil.Executor, sing
 	return nil
 }
 
-// LoadToServiceAsset1155ServiceTransferEvents allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadToServiceAsset1155ServiceTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// LoadAvatar allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userL) LoadAvatar(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2231,7 +2146,10 @@ func (userL) LoadToServiceAsset1155ServiceTransferEvents(e boil.Executor, singul
 		if object.R == nil {
 			object.R = &userR{}
 		}
-		args = append(args, object.ID)
+		if !queries.IsNil(object.AvatarID) {
+			args = append(args, object.AvatarID)
+		}
+
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -2240,12 +2158,15 @@ func (userL) LoadToServiceAsset1155ServiceTransferEvents(e boil.Executor, singul
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
+				if queries.Equal(a, obj.AvatarID) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ID)
+			if !queries.IsNil(obj.AvatarID) {
+				args = append(args, obj.AvatarID)
+			}
+
 		}
 	}
 
@@ -2254,8 +2175,9 @@ func (userL) LoadToServiceAsset1155ServiceTransferEvents(e boil.Executor, singul
 	}
 
 	query := NewQuery(
-		qm.From(`asset1155_service_transfer_events`),
-		qm.WhereIn(`asset1155_service_transfer_events.to_service in ?`, args...),
+		qm.From(`blobs`),
+		qm.WhereIn(`blobs.id in ?`, args...),
+		qmhelper.WhereIsNull(`blobs.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2263,47 +2185,51 @@ func (userL) LoadToServiceAsset1155ServiceTransferEvents(e boil.Executor, singul
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load asset1155_service_transfer_events")
+		return errors.Wrap(err, "failed to eager load Blob")
 	}
 
-	var resultSlice []*Asset1155ServiceTransferEvent
+	var resultSlice []*Blob
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice asset1155_service_transfer_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice Blob")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on asset1155_service_transfer_events")
+		return errors.Wrap(err, "failed to close results of eager load for blobs")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset1155_service_transfer_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for blobs")
 	}
 
-	if len(asset1155ServiceTransferEventAfterSelectHooks) != 0 {
+	if len(userAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.ToServiceAsset1155ServiceTransferEvents = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &asset1155ServiceTransferEventR{}
-			}
-			foreign.R.ToServiceUser = object
+		foreign := resultSlice[0]
+		object.R.Avatar = foreign
+		if foreign.R == nil {
+			foreign.R = &blobR{}
 		}
+		foreign.R.AvatarUsers = append(foreign.R.AvatarUsers, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.ToService) {
-				local.R.ToServiceAsset1155ServiceTransferEvents = append(local.R.ToServiceAsset1155ServiceTransferEvents, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AvatarID, foreign.ID) {
+				local.R.Avatar = foreign
 				if foreign.R == nil {
-					foreign.R = &asset1155ServiceTransferEventR{}
+					foreign.R = &blobR{}
 				}
-				foreign.R.ToServiceUser = local
+				foreign.R.AvatarUsers = append(foreign.R.AvatarUsers, local)
 				break
 			}
 		}
@@ -2312,9 +2238,9 @@ func (userL) LoadToServiceAsset1155ServiceTransferEvents(e boil.Executor, singul
 	return nil
 }
 
-// LoadAsset1155ServiceTransferEvents allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadAsset1155ServiceTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// LoadAvatarUserAsset allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userL) LoadAvatarUserAsset(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2329,7 +2255,10 @@ func (userL) LoadAsset1155ServiceTransferEvents(e boil.Executor, singular bool,
 		if object.R == nil {
 			object.R = &userR{}
 		}
-		args = append(args, object.ID)
+		if !queries.IsNil(object.AvatarUserAssetID) {
+			args = append(args, object.AvatarUserAssetID)
+		}
+
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -2338,12 +2267,15 @@ func (userL) LoadAsset1155ServiceTransferEvents(e boil.Executor, singular bool,
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.AvatarUserAssetID) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ID)
+			if !queries.IsNil(obj.AvatarUserAssetID) {
+				args = append(args, obj.AvatarUserAssetID)
+			}
+
 		}
 	}
 
@@ -2352,8 +2284,9 @@ func (userL) LoadAsset1155ServiceTransferEvents(e boil.Executor, singular bool,
 	}
 
 	query := NewQuery(
-		qm.From(`asset1155_service_transfer_events`),
-		qm.WhereIn(`asset1155_service_transfer_events.user_id in ?`, args...),
+		qm.From(`user_assets`),
+		qm.WhereIn(`user_assets.id in ?`, args...),
+		qmhelper.WhereIsNull(`user_assets.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2361,47 +2294,51 @@ func (userL) LoadAsset1155ServiceTransferEvents(e boil.Executor, singular bool,
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load asset1155_service_transfer_events")
+		return errors.Wrap(err, "failed to eager load UserAsset")
 	}
 
-	var resultSlice []*Asset1155ServiceTransferEvent
+	var resultSlice []*UserAsset
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice asset1155_service_transfer_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice UserAsset")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on asset1155_service_transfer_events")
+		return errors.Wrap(err, "failed to close results of eager load for user_assets")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset1155_service_transfer_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
 	}
 
-	if len(asset1155ServiceTransferEventAfterSelectHooks) != 0 {
+	if len(userAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Asset1155ServiceTransferEvents = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &asset1155ServiceTransferEventR{}
-			}
-			foreign.R.User = object
+		foreign := resultSlice[0]
+		object.R.AvatarUserAsset = foreign
+		if foreign.R == nil {
+			foreign.R = &userAssetR{}
 		}
+		foreign.R.AvatarUserAssetUsers = append(foreign.R.AvatarUserAssetUsers, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.Asset1155ServiceTransferEvents = append(local.R.Asset1155ServiceTransferEvents, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AvatarUserAssetID, foreign.ID) {
+				local.R.AvatarUserAsset = foreign
 				if foreign.R == nil {
-					foreign.R = &asset1155ServiceTransferEventR{}
+					foreign.R = &userAssetR{}
 				}
-				foreign.R.User = local
+				foreign.R.AvatarUserAssetUsers = append(foreign.R.AvatarUserAssetUsers, local)
 				break
 			}
 		}
@@ -2410,9 +2347,9 @@ func (userL) LoadAsset1155ServiceTransferEvents(e boil.Executor, singular bool,
 	return nil
 }
 
-// LoadFromServiceAssetServiceTransferEvents allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadFromServiceAssetServiceTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// LoadFaction allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userL) LoadFaction(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2427,7 +2364,10 @@ func (userL) LoadFromServiceAssetServiceTransferEvents(e boil.Executor, singular
 		if object.R == nil {
 			object.R = &userR{}
 		}
-		args = append(args, object.ID)
+		if !queries.IsNil(object.FactionID) {
+			args = append(args, object.FactionID)
+		}
+
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -2436,12 +2376,15 @@ func (userL) LoadFromServiceAssetServiceTransferEvents(e boil.Executor, singular
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
+				if queries.Equal(a, obj.FactionID) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ID)
+			if !queries.IsNil(obj.FactionID) {
+				args = append(args, obj.FactionID)
+			}
+
 		}
 	}
 
@@ -2450,8 +2393,8 @@ func (userL) LoadFromServiceAssetServiceTransferEvents(e boil.Executor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`asset_service_transfer_events`),
-		qm.WhereIn(`asset_service_transfer_events.from_service in ?`, args...),
+		qm.From(`factions`),
+		qm.WhereIn(`factions.id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2459,47 +2402,51 @@ func (userL) LoadFromServiceAssetServiceTransferEvents(e boil.Executor, singular
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load asset_service_transfer_events")
+		return errors.Wrap(err, "failed to eager load Faction")
 	}
 
-	var resultSlice []*AssetServiceTransferEvent
+	var resultSlice []*Faction
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice asset_service_transfer_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice Faction")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on asset_service_transfer_events")
+		return errors.Wrap(err, "failed to close results of eager load for factions")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_service_transfer_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for factions")
 	}
 
-	if len(assetServiceTransferEventAfterSelectHooks) != 0 {
+	if len(userAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.FromServiceAssetServiceTransferEvents = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &assetServiceTransferEventR{}
-			}
-			foreign.R.FromServiceUser = object
+		foreign := resultSlice[0]
+		object.R.Faction = foreign
+		if foreign.R == nil {
+			foreign.R = &factionR{}
 		}
+		foreign.R.Users = append(foreign.R.Users, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.FromService) {
-				local.R.FromServiceAssetServiceTransferEvents = append(local.R.FromServiceAssetServiceTransferEvents, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.FactionID, foreign.ID) {
+				local.R.Faction = foreign
 				if foreign.R == nil {
-					foreign.R = &assetServiceTransferEventR{}
+					foreign.R = &factionR{}
 				}
-				foreign.R.FromServiceUser = local
+				foreign.R.Users = append(foreign.R.Users, local)
 				break
 			}
 		}
@@ -2508,9 +2455,9 @@ func (userL) LoadFromServiceAssetServiceTransferEvents(e boil.Executor, singular
 	return nil
 }
 
-// LoadToServiceAssetServiceTransferEvents allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadToServiceAssetServiceTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// LoadLegacyAccount allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userL) LoadLegacyAccount(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2525,7 +2472,10 @@ func (userL) LoadToServiceAssetServiceTransferEvents(e boil.Executor, singular b
 		if object.R == nil {
 			object.R = &userR{}
 		}
-		args = append(args, object.ID)
+		if !queries.IsNil(object.LegacyAccountID) {
+			args = append(args, object.LegacyAccountID)
+		}
+
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -2534,12 +2484,15 @@ func (userL) LoadToServiceAssetServiceTransferEvents(e boil.Executor, singular b
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
+				if queries.Equal(a, obj.LegacyAccountID) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ID)
+			if !queries.IsNil(obj.LegacyAccountID) {
+				args = append(args, obj.LegacyAccountID)
+			}
+
 		}
 	}
 
@@ -2548,8 +2501,9 @@ func (userL) LoadToServiceAssetServiceTransferEvents(e boil.Executor, singular b
 	}
 
 	query := NewQuery(
-		qm.From(`asset_service_transfer_events`),
-		qm.WhereIn(`asset_service_transfer_events.to_service in ?`, args...),
+		qm.From(`accounts`),
+		qm.WhereIn(`accounts.id in ?`, args...),
+		qmhelper.WhereIsNull(`accounts.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2557,47 +2511,51 @@ func (userL) LoadToServiceAssetServiceTransferEvents(e boil.Executor, singular b
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load asset_service_transfer_events")
+		return errors.Wrap(err, "failed to eager load Account")
 	}
 
-	var resultSlice []*AssetServiceTransferEvent
+	var resultSlice []*Account
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice asset_service_transfer_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice Account")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on asset_service_transfer_events")
+		return errors.Wrap(err, "failed to close results of eager load for accounts")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_service_transfer_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for accounts")
 	}
 
-	if len(assetServiceTransferEventAfterSelectHooks) != 0 {
+	if len(userAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.ToServiceAssetServiceTransferEvents = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &assetServiceTransferEventR{}
-			}
-			foreign.R.ToServiceUser = object
+		foreign := resultSlice[0]
+		object.R.LegacyAccount = foreign
+		if foreign.R == nil {
+			foreign.R = &accountR{}
 		}
+		foreign.R.LegacyAccountUsers = append(foreign.R.LegacyAccountUsers, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.ToService) {
-				local.R.ToServiceAssetServiceTransferEvents = append(local.R.ToServiceAssetServiceTransferEvents, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.LegacyAccountID, foreign.ID) {
+				local.R.LegacyAccount = foreign
 				if foreign.R == nil {
-					foreign.R = &assetServiceTransferEventR{}
+					foreign.R = &accountR{}
 				}
-				foreign.R.ToServiceUser = local
+				foreign.R.LegacyAccountUsers = append(foreign.R.LegacyAccountUsers, local)
 				break
 			}
 		}
@@ -2606,9 +2564,9 @@ func (userL) LoadToServiceAssetServiceTransferEvents(e boil.Executor, singular b
 	return nil
 }
 
-// LoadAssetServiceTransferEvents allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadAssetServiceTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// LoadRole allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userL) LoadRole(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2623,7 +2581,10 @@ func (userL) LoadAssetServiceTransferEvents(e boil.Executor, singular bool, mayb
 		if object.R == nil {
 			object.R = &userR{}
 		}
-		args = append(args, object.ID)
+		if !queries.IsNil(object.RoleID) {
+			args = append(args, object.RoleID)
+		}
+
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -2632,12 +2593,15 @@ func (userL) LoadAssetServiceTransferEvents(e boil.Executor, singular bool, mayb
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.RoleID) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ID)
+			if !queries.IsNil(obj.RoleID) {
+				args = append(args, obj.RoleID)
+			}
+
 		}
 	}
 
@@ -2646,8 +2610,9 @@ func (userL) LoadAssetServiceTransferEvents(e boil.Executor, singular bool, mayb
 	}
 
 	query := NewQuery(
-		qm.From(`asset_service_transfer_events`),
-		qm.WhereIn(`asset_service_transfer_events.user_id in ?`, args...),
+		qm.From(`roles`),
+		qm.WhereIn(`roles.id in ?`, args...),
+		qmhelper.WhereIsNull(`roles.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2655,47 +2620,51 @@ func (userL) LoadAssetServiceTransferEvents(e boil.Executor, singular bool, mayb
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load asset_service_transfer_events")
+		return errors.Wrap(err, "failed to eager load Role")
 	}
 
-	var resultSlice []*AssetServiceTransferEvent
+	var resultSlice []*Role
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice asset_service_transfer_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice Role")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on asset_service_transfer_events")
+		return errors.Wrap(err, "failed to close results of eager load for roles")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_service_transfer_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
 	}
 
-	if len(assetServiceTransferEventAfterSelectHooks) != 0 {
+	if len(userAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.AssetServiceTransferEvents = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &assetServiceTransferEventR{}
-			}
-			foreign.R.User = object
+		foreign := resultSlice[0]
+		object.R.Role = foreign
+		if foreign.R == nil {
+			foreign.R = &roleR{}
 		}
+		foreign.R.Users = append(foreign.R.Users, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.AssetServiceTransferEvents = append(local.R.AssetServiceTransferEvents, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.RoleID, foreign.ID) {
+				local.R.Role = foreign
 				if foreign.R == nil {
-					foreign.R = &assetServiceTransferEventR{}
+					foreign.R = &roleR{}
 				}
-				foreign.R.User = local
+				foreign.R.Users = append(foreign.R.Users, local)
 				break
 			}
 		}
@@ -2704,9 +2673,9 @@ func (userL) LoadAssetServiceTransferEvents(e boil.Executor, singular bool, mayb
 	return nil
 }
 
-// LoadFromUserAssetTransferEvents allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadFromUserAssetTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// LoadPasswordHash allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadPasswordHash(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2744,8 +2713,9 @@ func (userL) LoadFromUserAssetTransferEvents(e boil.Executor, singular bool, may
 	}
 
 	query := NewQuery(
-		qm.From(`asset_transfer_events`),
-		qm.WhereIn(`asset_transfer_events.from_user_id in ?`, args...),
+		qm.From(`password_hashes`),
+		qm.WhereIn(`password_hashes.user_id in ?`, args...),
+		qmhelper.WhereIsNull(`password_hashes.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2753,47 +2723,50 @@ func (userL) LoadFromUserAssetTransferEvents(e boil.Executor, singular bool, may
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load asset_transfer_events")
+		return errors.Wrap(err, "failed to eager load PasswordHash")
 	}
 
-	var resultSlice []*AssetTransferEvent
+	var resultSlice []*PasswordHash
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice asset_transfer_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice PasswordHash")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on asset_transfer_events")
+		return errors.Wrap(err, "failed to close results of eager load for password_hashes")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_transfer_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_hashes")
 	}
 
-	if len(assetTransferEventAfterSelectHooks) != 0 {
+	if len(userAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
 			}
 		}
 	}
-	if singular {
-		object.R.FromUserAssetTransferEvents = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &assetTransferEventR{}
-			}
-			foreign.R.FromUser = object
-		}
+
+	if len(resultSlice) == 0 {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.FromUserID {
-				local.R.FromUserAssetTransferEvents = append(local.R.FromUserAssetTransferEvents, foreign)
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PasswordHash = foreign
+		if foreign.R == nil {
+			foreign.R = &passwordHashR{}
+		}
+		foreign.R.User = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.UserID {
+				local.R.PasswordHash = foreign
 				if foreign.R == nil {
-					foreign.R = &assetTransferEventR{}
+					foreign.R = &passwordHashR{}
 				}
-				foreign.R.FromUser = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -2802,9 +2775,9 @@ func (userL) LoadFromUserAssetTransferEvents(e boil.Executor, singular bool, may
 	return nil
 }
 
-// LoadToUserAssetTransferEvents allows an eager lookup of values, cached into the
+// LoadAPIKeys allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadToUserAssetTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadAPIKeys(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2842,8 +2815,9 @@ func (userL) LoadToUserAssetTransferEvents(e boil.Executor, singular bool, maybe
 	}
 
 	query := NewQuery(
-		qm.From(`asset_transfer_events`),
-		qm.WhereIn(`asset_transfer_events.to_user_id in ?`, args...),
+		qm.From(`api_keys`),
+		qm.WhereIn(`api_keys.user_id in ?`, args...),
+		qmhelper.WhereIsNull(`api_keys.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2851,22 +2825,22 @@ func (userL) LoadToUserAssetTransferEvents(e boil.Executor, singular bool, maybe
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load asset_transfer_events")
+		return errors.Wrap(err, "failed to eager load api_keys")
 	}
 
-	var resultSlice []*AssetTransferEvent
+	var resultSlice []*APIKey
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice asset_transfer_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice api_keys")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on asset_transfer_events")
+		return errors.Wrap(err, "failed to close results in eager load on api_keys")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_transfer_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for api_keys")
 	}
 
-	if len(assetTransferEventAfterSelectHooks) != 0 {
+	if len(apiKeyAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -2874,24 +2848,24 @@ func (userL) LoadToUserAssetTransferEvents(e boil.Executor, singular bool, maybe
 		}
 	}
 	if singular {
-		object.R.ToUserAssetTransferEvents = resultSlice
+		object.R.APIKeys = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &assetTransferEventR{}
+				foreign.R = &apiKeyR{}
 			}
-			foreign.R.ToUser = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.ToUserID {
-				local.R.ToUserAssetTransferEvents = append(local.R.ToUserAssetTransferEvents, foreign)
+			if local.ID == foreign.UserID {
+				local.R.APIKeys = append(local.R.APIKeys, foreign)
 				if foreign.R == nil {
-					foreign.R = &assetTransferEventR{}
+					foreign.R = &apiKeyR{}
 				}
-				foreign.R.ToUser = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -2900,9 +2874,9 @@ func (userL) LoadToUserAssetTransferEvents(e boil.Executor, singular bool, maybe
 	return nil
 }
 
-// LoadDepositAsset1155Transactions allows an eager lookup of values, cached into the
+// LoadFromServiceAsset1155ServiceTransferEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadDepositAsset1155Transactions(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadFromServiceAsset1155ServiceTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2926,7 +2900,7 @@ func (userL) LoadDepositAsset1155Transactions(e boil.Executor, singular bool, ma
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.ID) {
 					continue Outer
 				}
 			}
@@ -2940,8 +2914,8 @@ func (userL) LoadDepositAsset1155Transactions(e boil.Executor, singular bool, ma
 	}
 
 	query := NewQuery(
-		qm.From(`deposit_asset1155_transactions`),
-		qm.WhereIn(`deposit_asset1155_transactions.user_id in ?`, args...),
+		qm.From(`asset1155_service_transfer_events`),
+		qm.WhereIn(`asset1155_service_transfer_events.from_service in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2949,22 +2923,22 @@ func (userL) LoadDepositAsset1155Transactions(e boil.Executor, singular bool, ma
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load deposit_asset1155_transactions")
+		return errors.Wrap(err, "failed to eager load asset1155_service_transfer_events")
 	}
 
-	var resultSlice []*DepositAsset1155Transaction
+	var resultSlice []*Asset1155ServiceTransferEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice deposit_asset1155_transactions")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset1155_service_transfer_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on deposit_asset1155_transactions")
+		return errors.Wrap(err, "failed to close results in eager load on asset1155_service_transfer_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for deposit_asset1155_transactions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset1155_service_transfer_events")
 	}
 
-	if len(depositAsset1155TransactionAfterSelectHooks) != 0 {
+	if len(asset1155ServiceTransferEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -2972,24 +2946,24 @@ func (userL) LoadDepositAsset1155Transactions(e boil.Executor, singular bool, ma
 		}
 	}
 	if singular {
-		object.R.DepositAsset1155Transactions = resultSlice
+		object.R.FromServiceAsset1155ServiceTransferEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &depositAsset1155TransactionR{}
+				foreign.R = &asset1155ServiceTransferEventR{}
 			}
-			foreign.R.User = object
+			foreign.R.FromServiceUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.DepositAsset1155Transactions = append(local.R.DepositAsset1155Transactions, foreign)
+			if queries.Equal(local.ID, foreign.FromService) {
+				local.R.FromServiceAsset1155ServiceTransferEvents = append(local.R.FromServiceAsset1155ServiceTransferEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &depositAsset1155TransactionR{}
+					foreign.R = &asset1155ServiceTransferEventR{}
 				}
-				foreign.R.User = local
+				foreign.R.FromServiceUser = local
 				break
 			}
 		}
@@ -2998,9 +2972,9 @@ func (userL) LoadDepositAsset1155Transactions(e boil.Executor, singular bool, ma
 	return nil
 }
 
-// LoadDepositTransactions allows an eager lookup of values, cached into the
+// LoadToServiceAsset1155ServiceTransferEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadDepositTransactions(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadToServiceAsset1155ServiceTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -3024,7 +2998,7 @@ func (userL) LoadDepositTransactions(e boil.Executor, singular bool, maybeUser i
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.ID) {
 					continue Outer
 				}
 			}
@@ -3038,9 +3012,8 @@ func (userL) LoadDepositTransactions(e boil.Executor, singular bool, maybeUser i
 	}
 
 	query := NewQuery(
-		qm.From(`deposit_transactions`),
-		qm.WhereIn(`deposit_transactions.user_id in ?`, args...),
-		qmhelper.WhereIsNull(`deposit_transactions.deleted_at`),
+		qm.From(`asset1155_service_transfer_events`),
+		qm.WhereIn(`asset1155_service_transfer_events.to_service in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -3048,22 +3021,22 @@ func (userL) LoadDepositTransactions(e boil.Executor, singular bool, maybeUser i
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load deposit_transactions")
+		return errors.Wrap(err, "failed to eager load asset1155_service_transfer_events")
 	}
 
-	var resultSlice []*DepositTransaction
+	var resultSlice []*Asset1155ServiceTransferEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice deposit_transactions")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset1155_service_transfer_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on deposit_transactions")
+		return errors.Wrap(err, "failed to close results in eager load on asset1155_service_transfer_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for deposit_transactions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset1155_service_transfer_events")
 	}
 
-	if len(depositTransactionAfterSelectHooks) != 0 {
+	if len(asset1155ServiceTransferEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -3071,24 +3044,24 @@ func (userL) LoadDepositTransactions(e boil.Executor, singular bool, maybeUser i
 		}
 	}
 	if singular {
-		object.R.DepositTransactions = resultSlice
+		object.R.ToServiceAsset1155ServiceTransferEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &depositTransactionR{}
+				foreign.R = &asset1155ServiceTransferEventR{}
 			}
-			foreign.R.User = object
+			foreign.R.ToServiceUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.DepositTransactions = append(local.R.DepositTransactions, foreign)
+			if queries.Equal(local.ID, foreign.ToService) {
+				local.R.ToServiceAsset1155ServiceTransferEvents = append(local.R.ToServiceAsset1155ServiceTransferEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &depositTransactionR{}
+					foreign.R = &asset1155ServiceTransferEventR{}
 				}
-				foreign.R.User = local
+				foreign.R.ToServiceUser = local
 				break
 			}
 		}
@@ -3097,9 +3070,9 @@ func (userL) LoadDepositTransactions(e boil.Executor, singular bool, maybeUser i
 	return nil
 }
 
-// LoadCreditFailedTransactions allows an eager lookup of values, cached into the
+// LoadAsset1155ServiceTransferEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreditFailedTransactions(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadAsset1155ServiceTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -3137,8 +3110,8 @@ func (userL) LoadCreditFailedTransactions(e boil.Executor, singular bool, maybeU
 	}
 
 	query := NewQuery(
-		qm.From(`failed_transactions`),
-		qm.WhereIn(`failed_transactions.credit in ?`, args...),
+		qm.From(`asset1155_service_transfer_events`),
+		qm.WhereIn(`asset1155_service_transfer_events.user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -3146,22 +3119,22 @@ func (userL) LoadCreditFailedTransactions(e boil.Executor, singular bool, maybeU
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load failed_transactions")
+		return errors.Wrap(err, "failed to eager load asset1155_service_transfer_events")
 	}
 
-	var resultSlice []*FailedTransaction
+	var resultSlice []*Asset1155ServiceTransferEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice failed_transactions")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset1155_service_transfer_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on failed_transactions")
+		return errors.Wrap(err, "failed to close results in eager load on asset1155_service_transfer_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for failed_transactions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset1155_service_transfer_events")
 	}
 
-	if len(failedTransactionAfterSelectHooks) != 0 {
+	if len(asset1155ServiceTransferEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -3169,24 +3142,24 @@ func (userL) LoadCreditFailedTransactions(e boil.Executor, singular bool, maybeU
 		}
 	}
 	if singular {
-		object.R.CreditFailedTransactions = resultSlice
+		object.R.Asset1155ServiceTransferEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &failedTransactionR{}
+				foreign.R = &asset1155ServiceTransferEventR{}
 			}
-			foreign.R.CreditUser = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.Credit {
-				local.R.CreditFailedTransactions = append(local.R.CreditFailedTransactions, foreign)
+			if local.ID == foreign.UserID {
+				local.R.Asset1155ServiceTransferEvents = append(local.R.Asset1155ServiceTransferEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &failedTransactionR{}
+					foreign.R = &asset1155ServiceTransferEventR{}
 				}
-				foreign.R.CreditUser = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -3195,9 +3168,9 @@ func (userL) LoadCreditFailedTransactions(e boil.Executor, singular bool, maybeU
 	return nil
 }
 
-// LoadDebitFailedTransactions allows an eager lookup of values, cached into the
+// LoadFromUserAsset1155TransferEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadDebitFailedTransactions(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadFromUserAsset1155TransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -3235,8 +3208,8 @@ func (userL) LoadDebitFailedTransactions(e boil.Executor, singular bool, maybeUs
 	}
 
 	query := NewQuery(
-		qm.From(`failed_transactions`),
-		qm.WhereIn(`failed_transactions.debit in ?`, args...),
+		qm.From(`asset1155_transfer_events`),
+		qm.WhereIn(`asset1155_transfer_events.from_user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -3244,22 +3217,22 @@ func (userL) LoadDebitFailedTransactions(e boil.Executor, singular bool, maybeUs
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load failed_transactions")
+		return errors.Wrap(err, "failed to eager load asset1155_transfer_events")
 	}
 
-	var resultSlice []*FailedTransaction
+	var resultSlice []*Asset1155TransferEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice failed_transactions")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset1155_transfer_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on failed_transactions")
+		return errors.Wrap(err, "failed to close results in eager load on asset1155_transfer_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for failed_transactions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset1155_transfer_events")
 	}
 
-	if len(failedTransactionAfterSelectHooks) != 0 {
+	if len(asset1155TransferEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -3267,24 +3240,24 @@ func (userL) LoadDebitFailedTransactions(e boil.Executor, singular bool, maybeUs
 		}
 	}
 	if singular {
-		object.R.DebitFailedTransactions = resultSlice
+		object.R.FromUserAsset1155TransferEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &failedTransactionR{}
+				foreign.R = &asset1155TransferEventR{}
 			}
-			foreign.R.DebitUser = object
+			foreign.R.FromUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.Debit {
-				local.R.DebitFailedTransactions = append(local.R.DebitFailedTransactions, foreign)
+			if local.ID == foreign.FromUserID {
+				local.R.FromUserAsset1155TransferEvents = append(local.R.FromUserAsset1155TransferEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &failedTransactionR{}
+					foreign.R = &asset1155TransferEventR{}
 				}
-				foreign.R.DebitUser = local
+				foreign.R.FromUser = local
 				break
 			}
 		}
@@ -3293,9 +3266,9 @@ func (userL) LoadDebitFailedTransactions(e boil.Executor, singular bool, maybeUs
 	return nil
 }
 
-// LoadIssueTokens allows an eager lookup of values, cached into the
+// LoadServiceAsset1155TransferEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadIssueTokens(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadServiceAsset1155TransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -3319,7 +3292,7 @@ func (userL) LoadIssueTokens(e boil.Executor, singular bool, maybeUser interface
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.ID) {
 					continue Outer
 				}
 			}
@@ -3333,9 +3306,8 @@ func (userL) LoadIssueTokens(e boil.Executor, singular bool, maybeUser interface
 	}
 
 	query := NewQuery(
-		qm.From(`issue_tokens`),
-		qm.WhereIn(`issue_tokens.user_id in ?`, args...),
-		qmhelper.WhereIsNull(`issue_tokens.deleted_at`),
+		qm.From(`asset1155_transfer_events`),
+		qm.WhereIn(`asset1155_transfer_events.service_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -3343,22 +3315,22 @@ func (userL) LoadIssueTokens(e boil.Executor, singular bool, maybeUser interface
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load issue_tokens")
+		return errors.Wrap(err, "failed to eager load asset1155_transfer_events")
 	}
 
-	var resultSlice []*IssueToken
+	var resultSlice []*Asset1155TransferEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice issue_tokens")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset1155_transfer_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on issue_tokens")
+		return errors.Wrap(err, "failed to close results in eager load on asset1155_transfer_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for issue_tokens")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset1155_transfer_events")
 	}
 
-	if len(issueTokenAfterSelectHooks) != 0 {
+	if len(asset1155TransferEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -3366,24 +3338,24 @@ func (userL) LoadIssueTokens(e boil.Executor, singular bool, maybeUser interface
 		}
 	}
 	if singular {
-		object.R.IssueTokens = resultSlice
+		object.R.ServiceAsset1155TransferEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &issueTokenR{}
+				foreign.R = &asset1155TransferEventR{}
 			}
-			foreign.R.User = object
+			foreign.R.Service = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.IssueTokens = append(local.R.IssueTokens, foreign)
+			if queries.Equal(local.ID, foreign.ServiceID) {
+				local.R.ServiceAsset1155TransferEvents = append(local.R.ServiceAsset1155TransferEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &issueTokenR{}
+					foreign.R = &asset1155TransferEventR{}
 				}
-				foreign.R.User = local
+				foreign.R.Service = local
 				break
 			}
 		}
@@ -3392,9 +3364,9 @@ func (userL) LoadIssueTokens(e boil.Executor, singular bool, maybeUser interface
 	return nil
 }
 
-// LoadPending1155Rollbacks allows an eager lookup of values, cached into the
+// LoadToUserAsset1155TransferEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadPending1155Rollbacks(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadToUserAsset1155TransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -3432,9 +3404,8 @@ func (userL) LoadPending1155Rollbacks(e boil.Executor, singular bool, maybeUser
 	}
 
 	query := NewQuery(
-		qm.From(`pending_1155_rollback`),
-		qm.WhereIn(`pending_1155_rollback.user_id in ?`, args...),
-		qmhelper.WhereIsNull(`pending_1155_rollback.deleted_at`),
+		qm.From(`asset1155_transfer_events`),
+		qm.WhereIn(`asset1155_transfer_events.to_user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -3442,22 +3413,22 @@ func (userL) LoadPending1155Rollbacks(e boil.Executor, singular bool, maybeUser
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load pending_1155_rollback")
+		return errors.Wrap(err, "failed to eager load asset1155_transfer_events")
 	}
 
-	var resultSlice []*Pending1155Rollback
+	var resultSlice []*Asset1155TransferEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice pending_1155_rollback")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset1155_transfer_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on pending_1155_rollback")
+		return errors.Wrap(err, "failed to close results in eager load on asset1155_transfer_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pending_1155_rollback")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset1155_transfer_events")
 	}
 
-	if len(pending1155RollbackAfterSelectHooks) != 0 {
+	if len(asset1155TransferEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -3465,24 +3436,24 @@ func (userL) LoadPending1155Rollbacks(e boil.Executor, singular bool, maybeUser
 		}
 	}
 	if singular {
-		object.R.Pending1155Rollbacks = resultSlice
+		object.R.ToUserAsset1155TransferEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &pending1155RollbackR{}
+				foreign.R = &asset1155TransferEventR{}
 			}
-			foreign.R.User = object
+			foreign.R.ToUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.Pending1155Rollbacks = append(local.R.Pending1155Rollbacks, foreign)
+			if local.ID == foreign.ToUserID {
+				local.R.ToUserAsset1155TransferEvents = append(local.R.ToUserAsset1155TransferEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &pending1155RollbackR{}
+					foreign.R = &asset1155TransferEventR{}
 				}
-				foreign.R.User = local
+				foreign.R.ToUser = local
 				break
 			}
 		}
@@ -3491,9 +3462,9 @@ func (userL) LoadPending1155Rollbacks(e boil.Executor, singular bool, maybeUser
 	return nil
 }
 
-// LoadPendingRefunds allows an eager lookup of values, cached into the
+// LoadAcceptedByAssetRentals allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadPendingRefunds(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadAcceptedByAssetRentals(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -3517,7 +3488,7 @@ func (userL) LoadPendingRefunds(e boil.Executor, singular bool, maybeUser interf
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.ID) {
 					continue Outer
 				}
 			}
@@ -3531,9 +3502,8 @@ func (userL) LoadPendingRefunds(e boil.Executor, singular bool, maybeUser interf
 	}
 
 	query := NewQuery(
-		qm.From(`pending_refund`),
-		qm.WhereIn(`pending_refund.user_id in ?`, args...),
-		qmhelper.WhereIsNull(`pending_refund.deleted_at`),
+		qm.From(`asset_rentals`),
+		qm.WhereIn(`asset_rentals.accepted_by_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -3541,22 +3511,22 @@ func (userL) LoadPendingRefunds(e boil.Executor, singular bool, maybeUser interf
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load pending_refund")
+		return errors.Wrap(err, "failed to eager load asset_rentals")
 	}
 
-	var resultSlice []*PendingRefund
+	var resultSlice []*AssetRental
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice pending_refund")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_rentals")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on pending_refund")
+		return errors.Wrap(err, "failed to close results in eager load on asset_rentals")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pending_refund")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_rentals")
 	}
 
-	if len(pendingRefundAfterSelectHooks) != 0 {
+	if len(assetRentalAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -3564,24 +3534,24 @@ func (userL) LoadPendingRefunds(e boil.Executor, singular bool, maybeUser interf
 		}
 	}
 	if singular {
-		object.R.PendingRefunds = resultSlice
+		object.R.AcceptedByAssetRentals = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &pendingRefundR{}
+				foreign.R = &assetRentalR{}
 			}
-			foreign.R.User = object
+			foreign.R.AcceptedBy = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.PendingRefunds = append(local.R.PendingRefunds, foreign)
+			if queries.Equal(local.ID, foreign.AcceptedByID) {
+				local.R.AcceptedByAssetRentals = append(local.R.AcceptedByAssetRentals, foreign)
 				if foreign.R == nil {
-					foreign.R = &pendingRefundR{}
+					foreign.R = &assetRentalR{}
 				}
-				foreign.R.User = local
+				foreign.R.AcceptedBy = local
 				break
 			}
 		}
@@ -3590,9 +3560,9 @@ func (userL) LoadPendingRefunds(e boil.Executor, singular bool, maybeUser interf
 	return nil
 }
 
-// LoadOwnerPurchasedItemsOlds allows an eager lookup of values, cached into the
+// LoadOwnerAssetRentals allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadOwnerPurchasedItemsOlds(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadOwnerAssetRentals(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -3630,9 +3600,8 @@ func (userL) LoadOwnerPurchasedItemsOlds(e boil.Executor, singular bool, maybeUs
 	}
 
 	query := NewQuery(
-		qm.From(`purchased_items_old`),
-		qm.WhereIn(`purchased_items_old.owner_id in ?`, args...),
-		qmhelper.WhereIsNull(`purchased_items_old.deleted_at`),
+		qm.From(`asset_rentals`),
+		qm.WhereIn(`asset_rentals.owner_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -3640,22 +3609,22 @@ func (userL) LoadOwnerPurchasedItemsOlds(e boil.Executor, singular bool, maybeUs
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load purchased_items_old")
+		return errors.Wrap(err, "failed to eager load asset_rentals")
 	}
 
-	var resultSlice []*PurchasedItemsOld
+	var resultSlice []*AssetRental
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice purchased_items_old")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_rentals")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on purchased_items_old")
+		return errors.Wrap(err, "failed to close results in eager load on asset_rentals")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for purchased_items_old")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_rentals")
 	}
 
-	if len(purchasedItemsOldAfterSelectHooks) != 0 {
+	if len(assetRentalAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -3663,10 +3632,10 @@ func (userL) LoadOwnerPurchasedItemsOlds(e boil.Executor, singular bool, maybeUs
 		}
 	}
 	if singular {
-		object.R.OwnerPurchasedItemsOlds = resultSlice
+		object.R.OwnerAssetRentals = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &purchasedItemsOldR{}
+				foreign.R = &assetRentalR{}
 			}
 			foreign.R.Owner = object
 		}
@@ -3676,9 +3645,9 @@ func (userL) LoadOwnerPurchasedItemsOlds(e boil.Executor, singular bool, maybeUs
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.OwnerID {
-				local.R.OwnerPurchasedItemsOlds = append(local.R.OwnerPurchasedItemsOlds, foreign)
+				local.R.OwnerAssetRentals = append(local.R.OwnerAssetRentals, foreign)
 				if foreign.R == nil {
-					foreign.R = &purchasedItemsOldR{}
+					foreign.R = &assetRentalR{}
 				}
 				foreign.R.Owner = local
 				break
@@ -3689,9 +3658,9 @@ func (userL) LoadOwnerPurchasedItemsOlds(e boil.Executor, singular bool, maybeUs
 	return nil
 }
 
-// LoadFoundedBySyndicates allows an eager lookup of values, cached into the
+// LoadRenterUserAssetRentals allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadFoundedBySyndicates(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadRenterUserAssetRentals(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -3715,7 +3684,7 @@ func (userL) LoadFoundedBySyndicates(e boil.Executor, singular bool, maybeUser i
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.ID) {
 					continue Outer
 				}
 			}
@@ -3729,9 +3698,8 @@ func (userL) LoadFoundedBySyndicates(e boil.Executor, singular bool, maybeUser i
 	}
 
 	query := NewQuery(
-		qm.From(`syndicates`),
-		qm.WhereIn(`syndicates.founded_by_id in ?`, args...),
-		qmhelper.WhereIsNull(`syndicates.deleted_at`),
+		qm.From(`asset_rentals`),
+		qm.WhereIn(`asset_rentals.renter_user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -3739,22 +3707,22 @@ func (userL) LoadFoundedBySyndicates(e boil.Executor, singular bool, maybeUser i
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load syndicates")
+		return errors.Wrap(err, "failed to eager load asset_rentals")
 	}
 
-	var resultSlice []*Syndicate
+	var resultSlice []*AssetRental
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice syndicates")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_rentals")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on syndicates")
+		return errors.Wrap(err, "failed to close results in eager load on asset_rentals")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for syndicates")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_rentals")
 	}
 
-	if len(syndicateAfterSelectHooks) != 0 {
+	if len(assetRentalAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -3762,24 +3730,24 @@ func (userL) LoadFoundedBySyndicates(e boil.Executor, singular bool, maybeUser i
 		}
 	}
 	if singular {
-		object.R.FoundedBySyndicates = resultSlice
+		object.R.RenterUserAssetRentals = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &syndicateR{}
+				foreign.R = &assetRentalR{}
 			}
-			foreign.R.FoundedBy = object
+			foreign.R.RenterUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.FoundedByID {
-				local.R.FoundedBySyndicates = append(local.R.FoundedBySyndicates, foreign)
+			if queries.Equal(local.ID, foreign.RenterUserID) {
+				local.R.RenterUserAssetRentals = append(local.R.RenterUserAssetRentals, foreign)
 				if foreign.R == nil {
-					foreign.R = &syndicateR{}
+					foreign.R = &assetRentalR{}
 				}
-				foreign.R.FoundedBy = local
+				foreign.R.RenterUser = local
 				break
 			}
 		}
@@ -3788,9 +3756,9 @@ func (userL) LoadFoundedBySyndicates(e boil.Executor, singular bool, maybeUser i
 	return nil
 }
 
-// LoadServiceTransactions allows an eager lookup of values, cached into the
+// LoadReleasedByAssetServiceLocks allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadServiceTransactions(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadReleasedByAssetServiceLocks(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -3828,8 +3796,8 @@ func (userL) LoadServiceTransactions(e boil.Executor, singular bool, maybeUser i
 	}
 
 	query := NewQuery(
-		qm.From(`transactions`),
-		qm.WhereIn(`transactions.service_id in ?`, args...),
+		qm.From(`asset_service_locks`),
+		qm.WhereIn(`asset_service_locks.released_by_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -3837,22 +3805,22 @@ func (userL) LoadServiceTransactions(e boil.Executor, singular bool, maybeUser i
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load transactions")
+		return errors.Wrap(err, "failed to eager load asset_service_locks")
 	}
 
-	var resultSlice []*Transaction
+	var resultSlice []*AssetServiceLock
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice transactions")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_service_locks")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on transactions")
+		return errors.Wrap(err, "failed to close results in eager load on asset_service_locks")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_service_locks")
 	}
 
-	if len(transactionAfterSelectHooks) != 0 {
+	if len(assetServiceLockAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -3860,24 +3828,24 @@ func (userL) LoadServiceTransactions(e boil.Executor, singular bool, maybeUser i
 		}
 	}
 	if singular {
-		object.R.ServiceTransactions = resultSlice
+		object.R.ReleasedByAssetServiceLocks = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &transactionR{}
+				foreign.R = &assetServiceLockR{}
 			}
-			foreign.R.Service = object
+			foreign.R.ReleasedBy = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.ServiceID) {
-				local.R.ServiceTransactions = append(local.R.ServiceTransactions, foreign)
+			if queries.Equal(local.ID, foreign.ReleasedByID) {
+				local.R.ReleasedByAssetServiceLocks = append(local.R.ReleasedByAssetServiceLocks, foreign)
 				if foreign.R == nil {
-					foreign.R = &transactionR{}
+					foreign.R = &assetServiceLockR{}
 				}
-				foreign.R.Service = local
+				foreign.R.ReleasedBy = local
 				break
 			}
 		}
@@ -3886,9 +3854,9 @@ func (userL) LoadServiceTransactions(e boil.Executor, singular bool, maybeUser i
 	return nil
 }
 
-// LoadCreditTransactionsOlds allows an eager lookup of values, cached into the
+// LoadServiceAssetServiceLocks allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreditTransactionsOlds(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadServiceAssetServiceLocks(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -3926,8 +3894,8 @@ func (userL) LoadCreditTransactionsOlds(e boil.Executor, singular bool, maybeUse
 	}
 
 	query := NewQuery(
-		qm.From(`transactions_old`),
-		qm.WhereIn(`transactions_old.credit in ?`, args...),
+		qm.From(`asset_service_locks`),
+		qm.WhereIn(`asset_service_locks.service_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -3935,22 +3903,22 @@ func (userL) LoadCreditTransactionsOlds(e boil.Executor, singular bool, maybeUse
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load transactions_old")
+		return errors.Wrap(err, "failed to eager load asset_service_locks")
 	}
 
-	var resultSlice []*TransactionsOld
+	var resultSlice []*AssetServiceLock
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice transactions_old")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_service_locks")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on transactions_old")
+		return errors.Wrap(err, "failed to close results in eager load on asset_service_locks")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions_old")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_service_locks")
 	}
 
-	if len(transactionsOldAfterSelectHooks) != 0 {
+	if len(assetServiceLockAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -3958,24 +3926,24 @@ func (userL) LoadCreditTransactionsOlds(e boil.Executor, singular bool, maybeUse
 		}
 	}
 	if singular {
-		object.R.CreditTransactionsOlds = resultSlice
+		object.R.ServiceAssetServiceLocks = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &transactionsOldR{}
+				foreign.R = &assetServiceLockR{}
 			}
-			foreign.R.CreditUser = object
+			foreign.R.Service = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.Credit {
-				local.R.CreditTransactionsOlds = append(local.R.CreditTransactionsOlds, foreign)
+			if local.ID == foreign.ServiceID {
+				local.R.ServiceAssetServiceLocks = append(local.R.ServiceAssetServiceLocks, foreign)
 				if foreign.R == nil {
-					foreign.R = &transactionsOldR{}
+					foreign.R = &assetServiceLockR{}
 				}
-				foreign.R.CreditUser = local
+				foreign.R.Service = local
 				break
 			}
 		}
@@ -3984,9 +3952,9 @@ func (userL) LoadCreditTransactionsOlds(e boil.Executor, singular bool, maybeUse
 	return nil
 }
 
-// LoadDebitTransactionsOlds allows an eager lookup of values, cached into the
+// LoadFromServiceAssetServiceTransferEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadDebitTransactionsOlds(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadFromServiceAssetServiceTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -4010,7 +3978,7 @@ func (userL) LoadDebitTransactionsOlds(e boil.Executor, singular bool, maybeUser
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.ID) {
 					continue Outer
 				}
 			}
@@ -4024,8 +3992,8 @@ func (userL) LoadDebitTransactionsOlds(e boil.Executor, singular bool, maybeUser
 	}
 
 	query := NewQuery(
-		qm.From(`transactions_old`),
-		qm.WhereIn(`transactions_old.debit in ?`, args...),
+		qm.From(`asset_service_transfer_events`),
+		qm.WhereIn(`asset_service_transfer_events.from_service in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -4033,22 +4001,22 @@ func (userL) LoadDebitTransactionsOlds(e boil.Executor, singular bool, maybeUser
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load transactions_old")
+		return errors.Wrap(err, "failed to eager load asset_service_transfer_events")
 	}
 
-	var resultSlice []*TransactionsOld
+	var resultSlice []*AssetServiceTransferEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice transactions_old")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_service_transfer_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on transactions_old")
+		return errors.Wrap(err, "failed to close results in eager load on asset_service_transfer_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions_old")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_service_transfer_events")
 	}
 
-	if len(transactionsOldAfterSelectHooks) != 0 {
+	if len(assetServiceTransferEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -4056,24 +4024,24 @@ func (userL) LoadDebitTransactionsOlds(e boil.Executor, singular bool, maybeUser
 		}
 	}
 	if singular {
-		object.R.DebitTransactionsOlds = resultSlice
+		object.R.FromServiceAssetServiceTransferEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &transactionsOldR{}
+				foreign.R = &assetServiceTransferEventR{}
 			}
-			foreign.R.DebitUser = object
+			foreign.R.FromServiceUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.Debit {
-				local.R.DebitTransactionsOlds = append(local.R.DebitTransactionsOlds, foreign)
+			if queries.Equal(local.ID, foreign.FromService) {
+				local.R.FromServiceAssetServiceTransferEvents = append(local.R.FromServiceAssetServiceTransferEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &transactionsOldR{}
+					foreign.R = &assetServiceTransferEventR{}
 				}
-				foreign.R.DebitUser = local
+				foreign.R.FromServiceUser = local
 				break
 			}
 		}
@@ -4082,9 +4050,9 @@ func (userL) LoadDebitTransactionsOlds(e boil.Executor, singular bool, maybeUser
 	return nil
 }
 
-// LoadServiceTransactionsOlds allows an eager lookup of values, cached into the
+// LoadToServiceAssetServiceTransferEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadServiceTransactionsOlds(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadToServiceAssetServiceTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -4122,8 +4090,8 @@ func (userL) LoadServiceTransactionsOlds(e boil.Executor, singular bool, maybeUs
 	}
 
 	query := NewQuery(
-		qm.From(`transactions_old`),
-		qm.WhereIn(`transactions_old.service_id in ?`, args...),
+		qm.From(`asset_service_transfer_events`),
+		qm.WhereIn(`asset_service_transfer_events.to_service in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -4131,22 +4099,22 @@ func (userL) LoadServiceTransactionsOlds(e boil.Executor, singular bool, maybeUs
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load transactions_old")
+		return errors.Wrap(err, "failed to eager load asset_service_transfer_events")
 	}
 
-	var resultSlice []*TransactionsOld
+	var resultSlice []*AssetServiceTransferEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice transactions_old")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_service_transfer_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on transactions_old")
+		return errors.Wrap(err, "failed to close results in eager load on asset_service_transfer_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions_old")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_service_transfer_events")
 	}
 
-	if len(transactionsOldAfterSelectHooks) != 0 {
+	if len(assetServiceTransferEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -4154,24 +4122,24 @@ func (userL) LoadServiceTransactionsOlds(e boil.Executor, singular bool, maybeUs
 		}
 	}
 	if singular {
-		object.R.ServiceTransactionsOlds = resultSlice
+		object.R.ToServiceAssetServiceTransferEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &transactionsOldR{}
+				foreign.R = &assetServiceTransferEventR{}
 			}
-			foreign.R.Service = object
+			foreign.R.ToServiceUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.ServiceID) {
-				local.R.ServiceTransactionsOlds = append(local.R.ServiceTransactionsOlds, foreign)
+			if queries.Equal(local.ID, foreign.ToService) {
+				local.R.ToServiceAssetServiceTransferEvents = append(local.R.ToServiceAssetServiceTransferEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &transactionsOldR{}
+					foreign.R = &assetServiceTransferEventR{}
 				}
-				foreign.R.Service = local
+				foreign.R.ToServiceUser = local
 				break
 			}
 		}
@@ -4180,9 +4148,9 @@ func (userL) LoadServiceTransactionsOlds(e boil.Executor, singular bool, maybeUs
 	return nil
 }
 
-// LoadUserActivities allows an eager lookup of values, cached into the
+// LoadAssetServiceTransferEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUserActivities(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadAssetServiceTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -4220,8 +4188,8 @@ func (userL) LoadUserActivities(e boil.Executor, singular bool, maybeUser interf
 	}
 
 	query := NewQuery(
-		qm.From(`user_activities`),
-		qm.WhereIn(`user_activities.user_id in ?`, args...),
+		qm.From(`asset_service_transfer_events`),
+		qm.WhereIn(`asset_service_transfer_events.user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -4229,22 +4197,22 @@ func (userL) LoadUserActivities(e boil.Executor, singular bool, maybeUser interf
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_activities")
+		return errors.Wrap(err, "failed to eager load asset_service_transfer_events")
 	}
 
-	var resultSlice []*UserActivity
+	var resultSlice []*AssetServiceTransferEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_activities")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_service_transfer_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_activities")
+		return errors.Wrap(err, "failed to close results in eager load on asset_service_transfer_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_activities")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_service_transfer_events")
 	}
 
-	if len(userActivityAfterSelectHooks) != 0 {
+	if len(assetServiceTransferEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -4252,10 +4220,10 @@ func (userL) LoadUserActivities(e boil.Executor, singular bool, maybeUser interf
 		}
 	}
 	if singular {
-		object.R.UserActivities = resultSlice
+		object.R.AssetServiceTransferEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userActivityR{}
+				foreign.R = &assetServiceTransferEventR{}
 			}
 			foreign.R.User = object
 		}
@@ -4265,9 +4233,9 @@ func (userL) LoadUserActivities(e boil.Executor, singular bool, maybeUser interf
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.UserActivities = append(local.R.UserActivities, foreign)
+				local.R.AssetServiceTransferEvents = append(local.R.AssetServiceTransferEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &userActivityR{}
+					foreign.R = &assetServiceTransferEventR{}
 				}
 				foreign.R.User = local
 				break
@@ -4278,9 +4246,9 @@ func (userL) LoadUserActivities(e boil.Executor, singular bool, maybeUser interf
 	return nil
 }
 
-// LoadLockedToServiceUserAssets allows an eager lookup of values, cached into the
+// LoadOffererAssetTrades allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadLockedToServiceUserAssets(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadOffererAssetTrades(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -4304,7 +4272,7 @@ func (userL) LoadLockedToServiceUserAssets(e boil.Executor, singular bool, maybe
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
+				if a == obj.ID {
 					continue Outer
 				}
 			}
@@ -4318,9 +4286,8 @@ func (userL) LoadLockedToServiceUserAssets(e boil.Executor, singular bool, maybe
 	}
 
 	query := NewQuery(
-		qm.From(`user_assets`),
-		qm.WhereIn(`user_assets.locked_to_service in ?`, args...),
-		qmhelper.WhereIsNull(`user_assets.deleted_at`),
+		qm.From(`asset_trades`),
+		qm.WhereIn(`asset_trades.offerer_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -4328,22 +4295,22 @@ func (userL) LoadLockedToServiceUserAssets(e boil.Executor, singular bool, maybe
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_assets")
+		return errors.Wrap(err, "failed to eager load asset_trades")
 	}
 
-	var resultSlice []*UserAsset
+	var resultSlice []*AssetTrade
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_assets")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_trades")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_assets")
+		return errors.Wrap(err, "failed to close results in eager load on asset_trades")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_trades")
 	}
 
-	if len(userAssetAfterSelectHooks) != 0 {
+	if len(assetTradeAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -4351,24 +4318,24 @@ func (userL) LoadLockedToServiceUserAssets(e boil.Executor, singular bool, maybe
 		}
 	}
 	if singular {
-		object.R.LockedToServiceUserAssets = resultSlice
+		object.R.OffererAssetTrades = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userAssetR{}
+				foreign.R = &assetTradeR{}
 			}
-			foreign.R.LockedToServiceUser = object
+			foreign.R.Offerer = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.LockedToService) {
-				local.R.LockedToServiceUserAssets = append(local.R.LockedToServiceUserAssets, foreign)
+			if local.ID == foreign.OffererID {
+				local.R.OffererAssetTrades = append(local.R.OffererAssetTrades, foreign)
 				if foreign.R == nil {
-					foreign.R = &userAssetR{}
+					foreign.R = &assetTradeR{}
 				}
-				foreign.R.LockedToServiceUser = local
+				foreign.R.Offerer = local
 				break
 			}
 		}
@@ -4377,9 +4344,9 @@ func (userL) LoadLockedToServiceUserAssets(e boil.Executor, singular bool, maybe
 	return nil
 }
 
-// LoadOwnerUserAssets allows an eager lookup of values, cached into the
+// LoadRecipientAssetTrades allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadOwnerUserAssets(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadRecipientAssetTrades(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -4417,9 +4384,8 @@ func (userL) LoadOwnerUserAssets(e boil.Executor, singular bool, maybeUser inter
 	}
 
 	query := NewQuery(
-		qm.From(`user_assets`),
-		qm.WhereIn(`user_assets.owner_id in ?`, args...),
-		qmhelper.WhereIsNull(`user_assets.deleted_at`),
+		qm.From(`asset_trades`),
+		qm.WhereIn(`asset_trades.recipient_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -4427,22 +4393,22 @@ func (userL) LoadOwnerUserAssets(e boil.Executor, singular bool, maybeUser inter
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_assets")
+		return errors.Wrap(err, "failed to eager load asset_trades")
 	}
 
-	var resultSlice []*UserAsset
+	var resultSlice []*AssetTrade
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_assets")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_trades")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_assets")
+		return errors.Wrap(err, "failed to close results in eager load on asset_trades")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_trades")
 	}
 
-	if len(userAssetAfterSelectHooks) != 0 {
+	if len(assetTradeAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -4450,24 +4416,24 @@ func (userL) LoadOwnerUserAssets(e boil.Executor, singular bool, maybeUser inter
 		}
 	}
 	if singular {
-		object.R.OwnerUserAssets = resultSlice
+		object.R.RecipientAssetTrades = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userAssetR{}
+				foreign.R = &assetTradeR{}
 			}
-			foreign.R.Owner = object
+			foreign.R.Recipient = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.OwnerID {
-				local.R.OwnerUserAssets = append(local.R.OwnerUserAssets, foreign)
+			if local.ID == foreign.RecipientID {
+				local.R.RecipientAssetTrades = append(local.R.RecipientAssetTrades, foreign)
 				if foreign.R == nil {
-					foreign.R = &userAssetR{}
+					foreign.R = &assetTradeR{}
 				}
-				foreign.R.Owner = local
+				foreign.R.Recipient = local
 				break
 			}
 		}
@@ -4476,9 +4442,9 @@ func (userL) LoadOwnerUserAssets(e boil.Executor, singular bool, maybeUser inter
 	return nil
 }
 
-// LoadOwnerUserAssets1155S allows an eager lookup of values, cached into the
+// LoadFromUserAssetTransferEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadOwnerUserAssets1155S(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadFromUserAssetTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -4516,8 +4482,8 @@ func (userL) LoadOwnerUserAssets1155S(e boil.Executor, singular bool, maybeUser
 	}
 
 	query := NewQuery(
-		qm.From(`user_assets_1155`),
-		qm.WhereIn(`user_assets_1155.owner_id in ?`, args...),
+		qm.From(`asset_transfer_events`),
+		qm.WhereIn(`asset_transfer_events.from_user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -4525,22 +4491,22 @@ func (userL) LoadOwnerUserAssets1155S(e boil.Executor, singular bool, maybeUser
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_assets_1155")
+		return errors.Wrap(err, "failed to eager load asset_transfer_events")
 	}
 
-	var resultSlice []*UserAssets1155
+	var resultSlice []*AssetTransferEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_assets_1155")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_transfer_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_assets_1155")
+		return errors.Wrap(err, "failed to close results in eager load on asset_transfer_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets_1155")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_transfer_events")
 	}
 
-	if len(userAssets1155AfterSelectHooks) != 0 {
+	if len(assetTransferEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -4548,24 +4514,24 @@ func (userL) LoadOwnerUserAssets1155S(e boil.Executor, singular bool, maybeUser
 		}
 	}
 	if singular {
-		object.R.OwnerUserAssets1155S = resultSlice
+		object.R.FromUserAssetTransferEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userAssets1155R{}
+				foreign.R = &assetTransferEventR{}
 			}
-			foreign.R.Owner = object
+			foreign.R.FromUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.OwnerID {
-				local.R.OwnerUserAssets1155S = append(local.R.OwnerUserAssets1155S, foreign)
+			if local.ID == foreign.FromUserID {
+				local.R.FromUserAssetTransferEvents = append(local.R.FromUserAssetTransferEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &userAssets1155R{}
+					foreign.R = &assetTransferEventR{}
 				}
-				foreign.R.Owner = local
+				foreign.R.FromUser = local
 				break
 			}
 		}
@@ -4574,9 +4540,9 @@ func (userL) LoadOwnerUserAssets1155S(e boil.Executor, singular bool, maybeUser
 	return nil
 }
 
-// LoadUserFingerprints allows an eager lookup of values, cached into the
+// LoadToUserAssetTransferEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUserFingerprints(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadToUserAssetTransferEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -4614,9 +4580,8 @@ func (userL) LoadUserFingerprints(e boil.Executor, singular bool, maybeUser inte
 	}
 
 	query := NewQuery(
-		qm.From(`user_fingerprints`),
-		qm.WhereIn(`user_fingerprints.user_id in ?`, args...),
-		qmhelper.WhereIsNull(`user_fingerprints.deleted_at`),
+		qm.From(`asset_transfer_events`),
+		qm.WhereIn(`asset_transfer_events.to_user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -4624,22 +4589,22 @@ func (userL) LoadUserFingerprints(e boil.Executor, singular bool, maybeUser inte
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_fingerprints")
+		return errors.Wrap(err, "failed to eager load asset_transfer_events")
 	}
 
-	var resultSlice []*UserFingerprint
+	var resultSlice []*AssetTransferEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_fingerprints")
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_transfer_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_fingerprints")
+		return errors.Wrap(err, "failed to close results in eager load on asset_transfer_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_fingerprints")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_transfer_events")
 	}
 
-	if len(userFingerprintAfterSelectHooks) != 0 {
+	if len(assetTransferEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -4647,24 +4612,24 @@ func (userL) LoadUserFingerprints(e boil.Executor, singular bool, maybeUser inte
 		}
 	}
 	if singular {
-		object.R.UserFingerprints = resultSlice
+		object.R.ToUserAssetTransferEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userFingerprintR{}
+				foreign.R = &assetTransferEventR{}
 			}
-			foreign.R.User = object
+			foreign.R.ToUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.UserFingerprints = append(local.R.UserFingerprints, foreign)
+			if local.ID == foreign.ToUserID {
+				local.R.ToUserAssetTransferEvents = append(local.R.ToUserAssetTransferEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &userFingerprintR{}
+					foreign.R = &assetTransferEventR{}
 				}
-				foreign.R.User = local
+				foreign.R.ToUser = local
 				break
 			}
 		}
@@ -4673,9 +4638,107 @@ func (userL) LoadUserFingerprints(e boil.Executor, singular bool, maybeUser inte
 	return nil
 }
 
-// LoadUserRecoveryCodes allows an eager lookup of values, cached into the
+// LoadServiceCraftingEvents allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUserRecoveryCodes(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadServiceCraftingEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`crafting_events`),
+		qm.WhereIn(`crafting_events.service_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load crafting_events")
+	}
+
+	var resultSlice []*CraftingEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice crafting_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on crafting_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for crafting_events")
+	}
+
+	if len(craftingEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ServiceCraftingEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &craftingEventR{}
+			}
+			foreign.R.Service = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ServiceID) {
+				local.R.ServiceCraftingEvents = append(local.R.ServiceCraftingEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &craftingEventR{}
+				}
+				foreign.R.Service = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCraftingEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCraftingEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -4713,9 +4776,8 @@ func (userL) LoadUserRecoveryCodes(e boil.Executor, singular bool, maybeUser int
 	}
 
 	query := NewQuery(
-		qm.From(`user_recovery_codes`),
-		qm.WhereIn(`user_recovery_codes.user_id in ?`, args...),
-		qmhelper.WhereIsNull(`user_recovery_codes.deleted_at`),
+		qm.From(`crafting_events`),
+		qm.WhereIn(`crafting_events.user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -4723,22 +4785,22 @@ func (userL) LoadUserRecoveryCodes(e boil.Executor, singular bool, maybeUser int
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_recovery_codes")
+		return errors.Wrap(err, "failed to eager load crafting_events")
 	}
 
-	var resultSlice []*UserRecoveryCode
+	var resultSlice []*CraftingEvent
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_recovery_codes")
+		return errors.Wrap(err, "failed to bind eager loaded slice crafting_events")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_recovery_codes")
+		return errors.Wrap(err, "failed to close results in eager load on crafting_events")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_recovery_codes")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for crafting_events")
 	}
 
-	if len(userRecoveryCodeAfterSelectHooks) != 0 {
+	if len(craftingEventAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -4746,10 +4808,10 @@ func (userL) LoadUserRecoveryCodes(e boil.Executor, singular bool, maybeUser int
 		}
 	}
 	if singular {
-		object.R.UserRecoveryCodes = resultSlice
+		object.R.CraftingEvents = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userRecoveryCodeR{}
+				foreign.R = &craftingEventR{}
 			}
 			foreign.R.User = object
 		}
@@ -4759,9 +4821,9 @@ func (userL) LoadUserRecoveryCodes(e boil.Executor, singular bool, maybeUser int
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.UserRecoveryCodes = append(local.R.UserRecoveryCodes, foreign)
+				local.R.CraftingEvents = append(local.R.CraftingEvents, foreign)
 				if foreign.R == nil {
-					foreign.R = &userRecoveryCodeR{}
+					foreign.R = &craftingEventR{}
 				}
 				foreign.R.User = local
 				break
@@ -4772,9 +4834,9 @@ func (userL) LoadUserRecoveryCodes(e boil.Executor, singular bool, maybeUser int
 	return nil
 }
 
-// LoadUsernameHistories allows an eager lookup of values, cached into the
+// LoadCreatedByCraftingRecipes allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUsernameHistories(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadCreatedByCraftingRecipes(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -4812,8 +4874,8 @@ func (userL) LoadUsernameHistories(e boil.Executor, singular bool, maybeUser int
 	}
 
 	query := NewQuery(
-		qm.From(`username_history`),
-		qm.WhereIn(`username_history.user_id in ?`, args...),
+		qm.From(`crafting_recipes`),
+		qm.WhereIn(`crafting_recipes.created_by_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -4821,472 +4883,5804 @@ func (userL) LoadUsernameHistories(e boil.Executor, singular bool, maybeUser int
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load username_history")
+		return errors.Wrap(err, "failed to eager load crafting_recipes")
 	}
 
-	var resultSlice []*UsernameHistory
+	var resultSlice []*CraftingRecipe
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice username_history")
+		return errors.Wrap(err, "failed to bind eager loaded slice crafting_recipes")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on username_history")
+		return errors.Wrap(err, "failed to close results in eager load on crafting_recipes")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for username_history")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for crafting_recipes")
+	}
+
+	if len(craftingRecipeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByCraftingRecipes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &craftingRecipeR{}
+			}
+			foreign.R.CreatedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreatedByID {
+				local.R.CreatedByCraftingRecipes = append(local.R.CreatedByCraftingRecipes, foreign)
+				if foreign.R == nil {
+					foreign.R = &craftingRecipeR{}
+				}
+				foreign.R.CreatedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDepositAsset1155Transactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDepositAsset1155Transactions(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`deposit_asset1155_transactions`),
+		qm.WhereIn(`deposit_asset1155_transactions.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load deposit_asset1155_transactions")
+	}
+
+	var resultSlice []*DepositAsset1155Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice deposit_asset1155_transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on deposit_asset1155_transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for deposit_asset1155_transactions")
+	}
+
+	if len(depositAsset1155TransactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DepositAsset1155Transactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &depositAsset1155TransactionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.DepositAsset1155Transactions = append(local.R.DepositAsset1155Transactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &depositAsset1155TransactionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
 	}
 
-	if len(usernameHistoryAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(e); err != nil {
-				return err
+	return nil
+}
+
+// LoadDepositTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDepositTransactions(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`deposit_transactions`),
+		qm.WhereIn(`deposit_transactions.user_id in ?`, args...),
+		qmhelper.WhereIsNull(`deposit_transactions.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load deposit_transactions")
+	}
+
+	var resultSlice []*DepositTransaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice deposit_transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on deposit_transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for deposit_transactions")
+	}
+
+	if len(depositTransactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DepositTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &depositTransactionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.DepositTransactions = append(local.R.DepositTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &depositTransactionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreditFailedTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreditFailedTransactions(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`failed_transactions`),
+		qm.WhereIn(`failed_transactions.credit in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load failed_transactions")
+	}
+
+	var resultSlice []*FailedTransaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice failed_transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on failed_transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for failed_transactions")
+	}
+
+	if len(failedTransactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreditFailedTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &failedTransactionR{}
+			}
+			foreign.R.CreditUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Credit {
+				local.R.CreditFailedTransactions = append(local.R.CreditFailedTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &failedTransactionR{}
+				}
+				foreign.R.CreditUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDebitFailedTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDebitFailedTransactions(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`failed_transactions`),
+		qm.WhereIn(`failed_transactions.debit in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load failed_transactions")
+	}
+
+	var resultSlice []*FailedTransaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice failed_transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on failed_transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for failed_transactions")
+	}
+
+	if len(failedTransactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DebitFailedTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &failedTransactionR{}
+			}
+			foreign.R.DebitUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Debit {
+				local.R.DebitFailedTransactions = append(local.R.DebitFailedTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &failedTransactionR{}
+				}
+				foreign.R.DebitUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadHeldPurchases allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadHeldPurchases(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`held_purchases`),
+		qm.WhereIn(`held_purchases.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load held_purchases")
+	}
+
+	var resultSlice []*HeldPurchase
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice held_purchases")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on held_purchases")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for held_purchases")
+	}
+
+	if len(heldPurchaseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.HeldPurchases = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &heldPurchaseR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.HeldPurchases = append(local.R.HeldPurchases, foreign)
+				if foreign.R == nil {
+					foreign.R = &heldPurchaseR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadHolderSnapshotEntries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadHolderSnapshotEntries(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`holder_snapshot_entries`),
+		qm.WhereIn(`holder_snapshot_entries.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load holder_snapshot_entries")
+	}
+
+	var resultSlice []*HolderSnapshotEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice holder_snapshot_entries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on holder_snapshot_entries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for holder_snapshot_entries")
+	}
+
+	if len(holderSnapshotEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.HolderSnapshotEntries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &holderSnapshotEntryR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.HolderSnapshotEntries = append(local.R.HolderSnapshotEntries, foreign)
+				if foreign.R == nil {
+					foreign.R = &holderSnapshotEntryR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByHolderSnapshots allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByHolderSnapshots(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`holder_snapshots`),
+		qm.WhereIn(`holder_snapshots.created_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load holder_snapshots")
+	}
+
+	var resultSlice []*HolderSnapshot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice holder_snapshots")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on holder_snapshots")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for holder_snapshots")
+	}
+
+	if len(holderSnapshotAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByHolderSnapshots = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &holderSnapshotR{}
+			}
+			foreign.R.CreatedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreatedByID {
+				local.R.CreatedByHolderSnapshots = append(local.R.CreatedByHolderSnapshots, foreign)
+				if foreign.R == nil {
+					foreign.R = &holderSnapshotR{}
+				}
+				foreign.R.CreatedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadIssueTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadIssueTokens(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`issue_tokens`),
+		qm.WhereIn(`issue_tokens.user_id in ?`, args...),
+		qmhelper.WhereIsNull(`issue_tokens.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load issue_tokens")
+	}
+
+	var resultSlice []*IssueToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice issue_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on issue_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for issue_tokens")
+	}
+
+	if len(issueTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.IssueTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &issueTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.IssueTokens = append(local.R.IssueTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &issueTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBidderMarketplaceBids allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadBidderMarketplaceBids(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`marketplace_bids`),
+		qm.WhereIn(`marketplace_bids.bidder_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load marketplace_bids")
+	}
+
+	var resultSlice []*MarketplaceBid
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice marketplace_bids")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on marketplace_bids")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for marketplace_bids")
+	}
+
+	if len(marketplaceBidAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BidderMarketplaceBids = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &marketplaceBidR{}
+			}
+			foreign.R.Bidder = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BidderID {
+				local.R.BidderMarketplaceBids = append(local.R.BidderMarketplaceBids, foreign)
+				if foreign.R == nil {
+					foreign.R = &marketplaceBidR{}
+				}
+				foreign.R.Bidder = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBuyerMarketplaceListings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadBuyerMarketplaceListings(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`marketplace_listings`),
+		qm.WhereIn(`marketplace_listings.buyer_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load marketplace_listings")
+	}
+
+	var resultSlice []*MarketplaceListing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice marketplace_listings")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on marketplace_listings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for marketplace_listings")
+	}
+
+	if len(marketplaceListingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BuyerMarketplaceListings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &marketplaceListingR{}
+			}
+			foreign.R.Buyer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.BuyerID) {
+				local.R.BuyerMarketplaceListings = append(local.R.BuyerMarketplaceListings, foreign)
+				if foreign.R == nil {
+					foreign.R = &marketplaceListingR{}
+				}
+				foreign.R.Buyer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCurrentBidderMarketplaceListings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCurrentBidderMarketplaceListings(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`marketplace_listings`),
+		qm.WhereIn(`marketplace_listings.current_bidder_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load marketplace_listings")
+	}
+
+	var resultSlice []*MarketplaceListing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice marketplace_listings")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on marketplace_listings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for marketplace_listings")
+	}
+
+	if len(marketplaceListingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CurrentBidderMarketplaceListings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &marketplaceListingR{}
+			}
+			foreign.R.CurrentBidder = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CurrentBidderID) {
+				local.R.CurrentBidderMarketplaceListings = append(local.R.CurrentBidderMarketplaceListings, foreign)
+				if foreign.R == nil {
+					foreign.R = &marketplaceListingR{}
+				}
+				foreign.R.CurrentBidder = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSellerMarketplaceListings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSellerMarketplaceListings(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`marketplace_listings`),
+		qm.WhereIn(`marketplace_listings.seller_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load marketplace_listings")
+	}
+
+	var resultSlice []*MarketplaceListing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice marketplace_listings")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on marketplace_listings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for marketplace_listings")
+	}
+
+	if len(marketplaceListingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SellerMarketplaceListings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &marketplaceListingR{}
+			}
+			foreign.R.Seller = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SellerID {
+				local.R.SellerMarketplaceListings = append(local.R.SellerMarketplaceListings, foreign)
+				if foreign.R == nil {
+					foreign.R = &marketplaceListingR{}
+				}
+				foreign.R.Seller = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPending1155Rollbacks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPending1155Rollbacks(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`pending_1155_rollback`),
+		qm.WhereIn(`pending_1155_rollback.user_id in ?`, args...),
+		qmhelper.WhereIsNull(`pending_1155_rollback.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load pending_1155_rollback")
+	}
+
+	var resultSlice []*Pending1155Rollback
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice pending_1155_rollback")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on pending_1155_rollback")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pending_1155_rollback")
+	}
+
+	if len(pending1155RollbackAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Pending1155Rollbacks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pending1155RollbackR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Pending1155Rollbacks = append(local.R.Pending1155Rollbacks, foreign)
+				if foreign.R == nil {
+					foreign.R = &pending1155RollbackR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPendingRefunds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPendingRefunds(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`pending_refund`),
+		qm.WhereIn(`pending_refund.user_id in ?`, args...),
+		qmhelper.WhereIsNull(`pending_refund.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load pending_refund")
+	}
+
+	var resultSlice []*PendingRefund
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice pending_refund")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on pending_refund")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pending_refund")
+	}
+
+	if len(pendingRefundAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PendingRefunds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pendingRefundR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PendingRefunds = append(local.R.PendingRefunds, foreign)
+				if foreign.R == nil {
+					foreign.R = &pendingRefundR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOperatorUserPendingWithdrawActions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOperatorUserPendingWithdrawActions(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`pending_withdraw_actions`),
+		qm.WhereIn(`pending_withdraw_actions.operator_user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load pending_withdraw_actions")
+	}
+
+	var resultSlice []*PendingWithdrawAction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice pending_withdraw_actions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on pending_withdraw_actions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pending_withdraw_actions")
+	}
+
+	if len(pendingWithdrawActionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OperatorUserPendingWithdrawActions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pendingWithdrawActionR{}
+			}
+			foreign.R.OperatorUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.OperatorUserID) {
+				local.R.OperatorUserPendingWithdrawActions = append(local.R.OperatorUserPendingWithdrawActions, foreign)
+				if foreign.R == nil {
+					foreign.R = &pendingWithdrawActionR{}
+				}
+				foreign.R.OperatorUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerPurchasedItemsOlds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerPurchasedItemsOlds(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`purchased_items_old`),
+		qm.WhereIn(`purchased_items_old.owner_id in ?`, args...),
+		qmhelper.WhereIsNull(`purchased_items_old.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load purchased_items_old")
+	}
+
+	var resultSlice []*PurchasedItemsOld
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice purchased_items_old")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on purchased_items_old")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for purchased_items_old")
+	}
+
+	if len(purchasedItemsOldAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerPurchasedItemsOlds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &purchasedItemsOldR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerPurchasedItemsOlds = append(local.R.OwnerPurchasedItemsOlds, foreign)
+				if foreign.R == nil {
+					foreign.R = &purchasedItemsOldR{}
+				}
+				foreign.R.Owner = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadResolvedByReconciliationDiscrepancies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadResolvedByReconciliationDiscrepancies(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`reconciliation_discrepancies`),
+		qm.WhereIn(`reconciliation_discrepancies.resolved_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reconciliation_discrepancies")
+	}
+
+	var resultSlice []*ReconciliationDiscrepancy
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reconciliation_discrepancies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reconciliation_discrepancies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reconciliation_discrepancies")
+	}
+
+	if len(reconciliationDiscrepancyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ResolvedByReconciliationDiscrepancies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reconciliationDiscrepancyR{}
+			}
+			foreign.R.ResolvedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ResolvedByID) {
+				local.R.ResolvedByReconciliationDiscrepancies = append(local.R.ResolvedByReconciliationDiscrepancies, foreign)
+				if foreign.R == nil {
+					foreign.R = &reconciliationDiscrepancyR{}
+				}
+				foreign.R.ResolvedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByReconciliationRuns allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByReconciliationRuns(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`reconciliation_runs`),
+		qm.WhereIn(`reconciliation_runs.created_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reconciliation_runs")
+	}
+
+	var resultSlice []*ReconciliationRun
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reconciliation_runs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reconciliation_runs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reconciliation_runs")
+	}
+
+	if len(reconciliationRunAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByReconciliationRuns = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reconciliationRunR{}
+			}
+			foreign.R.CreatedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreatedByID {
+				local.R.CreatedByReconciliationRuns = append(local.R.CreatedByReconciliationRuns, foreign)
+				if foreign.R == nil {
+					foreign.R = &reconciliationRunR{}
+				}
+				foreign.R.CreatedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMemberSyndicateAssetTransfers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadMemberSyndicateAssetTransfers(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`syndicate_asset_transfers`),
+		qm.WhereIn(`syndicate_asset_transfers.member_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load syndicate_asset_transfers")
+	}
+
+	var resultSlice []*SyndicateAssetTransfer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice syndicate_asset_transfers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on syndicate_asset_transfers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for syndicate_asset_transfers")
+	}
+
+	if len(syndicateAssetTransferAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MemberSyndicateAssetTransfers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &syndicateAssetTransferR{}
+			}
+			foreign.R.Member = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.MemberID {
+				local.R.MemberSyndicateAssetTransfers = append(local.R.MemberSyndicateAssetTransfers, foreign)
+				if foreign.R == nil {
+					foreign.R = &syndicateAssetTransferR{}
+				}
+				foreign.R.Member = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSyndicateMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSyndicateMembers(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`syndicate_members`),
+		qm.WhereIn(`syndicate_members.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load syndicate_members")
+	}
+
+	var resultSlice []*SyndicateMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice syndicate_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on syndicate_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for syndicate_members")
+	}
+
+	if len(syndicateMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SyndicateMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &syndicateMemberR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.SyndicateMembers = append(local.R.SyndicateMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &syndicateMemberR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFoundedBySyndicates allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadFoundedBySyndicates(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`syndicates`),
+		qm.WhereIn(`syndicates.founded_by_id in ?`, args...),
+		qmhelper.WhereIsNull(`syndicates.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load syndicates")
+	}
+
+	var resultSlice []*Syndicate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice syndicates")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on syndicates")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for syndicates")
+	}
+
+	if len(syndicateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FoundedBySyndicates = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &syndicateR{}
+			}
+			foreign.R.FoundedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FoundedByID {
+				local.R.FoundedBySyndicates = append(local.R.FoundedBySyndicates, foreign)
+				if foreign.R == nil {
+					foreign.R = &syndicateR{}
+				}
+				foreign.R.FoundedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadServiceTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadServiceTransactions(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transactions`),
+		qm.WhereIn(`transactions.service_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transactions")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions")
+	}
+
+	if len(transactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ServiceTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionR{}
+			}
+			foreign.R.Service = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ServiceID) {
+				local.R.ServiceTransactions = append(local.R.ServiceTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.Service = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreditTransactionsOlds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreditTransactionsOlds(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transactions_old`),
+		qm.WhereIn(`transactions_old.credit in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transactions_old")
+	}
+
+	var resultSlice []*TransactionsOld
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transactions_old")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transactions_old")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions_old")
+	}
+
+	if len(transactionsOldAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreditTransactionsOlds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionsOldR{}
+			}
+			foreign.R.CreditUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Credit {
+				local.R.CreditTransactionsOlds = append(local.R.CreditTransactionsOlds, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionsOldR{}
+				}
+				foreign.R.CreditUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDebitTransactionsOlds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDebitTransactionsOlds(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transactions_old`),
+		qm.WhereIn(`transactions_old.debit in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transactions_old")
+	}
+
+	var resultSlice []*TransactionsOld
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transactions_old")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transactions_old")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions_old")
+	}
+
+	if len(transactionsOldAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DebitTransactionsOlds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionsOldR{}
+			}
+			foreign.R.DebitUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Debit {
+				local.R.DebitTransactionsOlds = append(local.R.DebitTransactionsOlds, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionsOldR{}
+				}
+				foreign.R.DebitUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadServiceTransactionsOlds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadServiceTransactionsOlds(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transactions_old`),
+		qm.WhereIn(`transactions_old.service_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transactions_old")
+	}
+
+	var resultSlice []*TransactionsOld
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transactions_old")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transactions_old")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions_old")
+	}
+
+	if len(transactionsOldAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ServiceTransactionsOlds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionsOldR{}
+			}
+			foreign.R.Service = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ServiceID) {
+				local.R.ServiceTransactionsOlds = append(local.R.ServiceTransactionsOlds, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionsOldR{}
+				}
+				foreign.R.Service = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserActivities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserActivities(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_activities`),
+		qm.WhereIn(`user_activities.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_activities")
+	}
+
+	var resultSlice []*UserActivity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_activities")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_activities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_activities")
+	}
+
+	if len(userActivityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserActivities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userActivityR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserActivities = append(local.R.UserActivities, foreign)
+				if foreign.R == nil {
+					foreign.R = &userActivityR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChangedByUserAssetOnChainStatusHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadChangedByUserAssetOnChainStatusHistories(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_asset_on_chain_status_history`),
+		qm.WhereIn(`user_asset_on_chain_status_history.changed_by_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_asset_on_chain_status_history")
+	}
+
+	var resultSlice []*UserAssetOnChainStatusHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_asset_on_chain_status_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_asset_on_chain_status_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_asset_on_chain_status_history")
+	}
+
+	if len(userAssetOnChainStatusHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChangedByUserAssetOnChainStatusHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userAssetOnChainStatusHistoryR{}
+			}
+			foreign.R.ChangedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ChangedByID) {
+				local.R.ChangedByUserAssetOnChainStatusHistories = append(local.R.ChangedByUserAssetOnChainStatusHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &userAssetOnChainStatusHistoryR{}
+				}
+				foreign.R.ChangedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLockedToServiceUserAssets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadLockedToServiceUserAssets(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets`),
+		qm.WhereIn(`user_assets.locked_to_service in ?`, args...),
+		qmhelper.WhereIsNull(`user_assets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_assets")
+	}
+
+	var resultSlice []*UserAsset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_assets")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_assets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
+	}
+
+	if len(userAssetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.LockedToServiceUserAssets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userAssetR{}
+			}
+			foreign.R.LockedToServiceUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.LockedToService) {
+				local.R.LockedToServiceUserAssets = append(local.R.LockedToServiceUserAssets, foreign)
+				if foreign.R == nil {
+					foreign.R = &userAssetR{}
+				}
+				foreign.R.LockedToServiceUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerUserAssets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerUserAssets(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets`),
+		qm.WhereIn(`user_assets.owner_id in ?`, args...),
+		qmhelper.WhereIsNull(`user_assets.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_assets")
+	}
+
+	var resultSlice []*UserAsset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_assets")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_assets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets")
+	}
+
+	if len(userAssetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerUserAssets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userAssetR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerUserAssets = append(local.R.OwnerUserAssets, foreign)
+				if foreign.R == nil {
+					foreign.R = &userAssetR{}
+				}
+				foreign.R.Owner = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerUserAssets1155S allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerUserAssets1155S(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_assets_1155`),
+		qm.WhereIn(`user_assets_1155.owner_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_assets_1155")
+	}
+
+	var resultSlice []*UserAssets1155
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_assets_1155")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_assets_1155")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_assets_1155")
+	}
+
+	if len(userAssets1155AfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerUserAssets1155S = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userAssets1155R{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerUserAssets1155S = append(local.R.OwnerUserAssets1155S, foreign)
+				if foreign.R == nil {
+					foreign.R = &userAssets1155R{}
+				}
+				foreign.R.Owner = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserFingerprints allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserFingerprints(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_fingerprints`),
+		qm.WhereIn(`user_fingerprints.user_id in ?`, args...),
+		qmhelper.WhereIsNull(`user_fingerprints.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_fingerprints")
+	}
+
+	var resultSlice []*UserFingerprint
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_fingerprints")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_fingerprints")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_fingerprints")
+	}
+
+	if len(userFingerprintAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserFingerprints = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userFingerprintR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserFingerprints = append(local.R.UserFingerprints, foreign)
+				if foreign.R == nil {
+					foreign.R = &userFingerprintR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserRecoveryCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserRecoveryCodes(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_recovery_codes`),
+		qm.WhereIn(`user_recovery_codes.user_id in ?`, args...),
+		qmhelper.WhereIsNull(`user_recovery_codes.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_recovery_codes")
+	}
+
+	var resultSlice []*UserRecoveryCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_recovery_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_recovery_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_recovery_codes")
+	}
+
+	if len(userRecoveryCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserRecoveryCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userRecoveryCodeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserRecoveryCodes = append(local.R.UserRecoveryCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &userRecoveryCodeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUsernameHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUsernameHistories(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`username_history`),
+		qm.WhereIn(`username_history.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load username_history")
+	}
+
+	var resultSlice []*UsernameHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice username_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on username_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for username_history")
+	}
+
+	if len(usernameHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UsernameHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &usernameHistoryR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UsernameHistories = append(local.R.UsernameHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &usernameHistoryR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAccount of the user to the related item.
+// Sets o.R.Account to related.
+// Adds o to related.R.Users.
+func (o *User) SetAccount(exec boil.Executor, insert bool, related *Account) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AccountID = related.ID
+	if o.R == nil {
+		o.R = &userR{
+			Account: related,
+		}
+	} else {
+		o.R.Account = related
+	}
+
+	if related.R == nil {
+		related.R = &accountR{
+			Users: UserSlice{o},
+		}
+	} else {
+		related.R.Users = append(related.R.Users, o)
+	}
+
+	return nil
+}
+
+// SetAvatar of the user to the related item.
+// Sets o.R.Avatar to related.
+// Adds o to related.R.AvatarUsers.
+func (o *User) SetAvatar(exec boil.Executor, insert bool, related *Blob) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"avatar_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AvatarID, related.ID)
+	if o.R == nil {
+		o.R = &userR{
+			Avatar: related,
+		}
+	} else {
+		o.R.Avatar = related
+	}
+
+	if related.R == nil {
+		related.R = &blobR{
+			AvatarUsers: UserSlice{o},
+		}
+	} else {
+		related.R.AvatarUsers = append(related.R.AvatarUsers, o)
+	}
+
+	return nil
+}
+
+// RemoveAvatar relationship.
+// Sets o.R.Avatar to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *User) RemoveAvatar(exec boil.Executor, related *Blob) error {
+	var err error
+
+	queries.SetScanner(&o.AvatarID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("avatar_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Avatar = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AvatarUsers {
+		if queries.Equal(o.AvatarID, ri.AvatarID) {
+			continue
+		}
+
+		ln := len(related.R.AvatarUsers)
+		if ln > 1 && i < ln-1 {
+			related.R.AvatarUsers[i] = related.R.AvatarUsers[ln-1]
+		}
+		related.R.AvatarUsers = related.R.AvatarUsers[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetAvatarUserAsset of the user to the related item.
+// Sets o.R.AvatarUserAsset to related.
+// Adds o to related.R.AvatarUserAssetUsers.
+func (o *User) SetAvatarUserAsset(exec boil.Executor, insert bool, related *UserAsset) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"avatar_user_asset_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AvatarUserAssetID, related.ID)
+	if o.R == nil {
+		o.R = &userR{
+			AvatarUserAsset: related,
+		}
+	} else {
+		o.R.AvatarUserAsset = related
+	}
+
+	if related.R == nil {
+		related.R = &userAssetR{
+			AvatarUserAssetUsers: UserSlice{o},
+		}
+	} else {
+		related.R.AvatarUserAssetUsers = append(related.R.AvatarUserAssetUsers, o)
+	}
+
+	return nil
+}
+
+// RemoveAvatarUserAsset relationship.
+// Sets o.R.AvatarUserAsset to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *User) RemoveAvatarUserAsset(exec boil.Executor, related *UserAsset) error {
+	var err error
+
+	queries.SetScanner(&o.AvatarUserAssetID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("avatar_user_asset_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.AvatarUserAsset = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AvatarUserAssetUsers {
+		if queries.Equal(o.AvatarUserAssetID, ri.AvatarUserAssetID) {
+			continue
+		}
+
+		ln := len(related.R.AvatarUserAssetUsers)
+		if ln > 1 && i < ln-1 {
+			related.R.AvatarUserAssetUsers[i] = related.R.AvatarUserAssetUsers[ln-1]
+		}
+		related.R.AvatarUserAssetUsers = related.R.AvatarUserAssetUsers[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetFaction of the user to the related item.
+// Sets o.R.Faction to related.
+// Adds o to related.R.Users.
+func (o *User) SetFaction(exec boil.Executor, insert bool, related *Faction) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"faction_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.FactionID, related.ID)
+	if o.R == nil {
+		o.R = &userR{
+			Faction: related,
+		}
+	} else {
+		o.R.Faction = related
+	}
+
+	if related.R == nil {
+		related.R = &factionR{
+			Users: UserSlice{o},
+		}
+	} else {
+		related.R.Users = append(related.R.Users, o)
+	}
+
+	return nil
+}
+
+// RemoveFaction relationship.
+// Sets o.R.Faction to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *User) RemoveFaction(exec boil.Executor, related *Faction) error {
+	var err error
+
+	queries.SetScanner(&o.FactionID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("faction_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Faction = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Users {
+		if queries.Equal(o.FactionID, ri.FactionID) {
+			continue
+		}
+
+		ln := len(related.R.Users)
+		if ln > 1 && i < ln-1 {
+			related.R.Users[i] = related.R.Users[ln-1]
+		}
+		related.R.Users = related.R.Users[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetLegacyAccount of the user to the related item.
+// Sets o.R.LegacyAccount to related.
+// Adds o to related.R.LegacyAccountUsers.
+func (o *User) SetLegacyAccount(exec boil.Executor, insert bool, related *Account) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"legacy_account_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.LegacyAccountID, related.ID)
+	if o.R == nil {
+		o.R = &userR{
+			LegacyAccount: related,
+		}
+	} else {
+		o.R.LegacyAccount = related
+	}
+
+	if related.R == nil {
+		related.R = &accountR{
+			LegacyAccountUsers: UserSlice{o},
+		}
+	} else {
+		related.R.LegacyAccountUsers = append(related.R.LegacyAccountUsers, o)
+	}
+
+	return nil
+}
+
+// RemoveLegacyAccount relationship.
+// Sets o.R.LegacyAccount to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *User) RemoveLegacyAccount(exec boil.Executor, related *Account) error {
+	var err error
+
+	queries.SetScanner(&o.LegacyAccountID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("legacy_account_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.LegacyAccount = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.LegacyAccountUsers {
+		if queries.Equal(o.LegacyAccountID, ri.LegacyAccountID) {
+			continue
+		}
+
+		ln := len(related.R.LegacyAccountUsers)
+		if ln > 1 && i < ln-1 {
+			related.R.LegacyAccountUsers[i] = related.R.LegacyAccountUsers[ln-1]
+		}
+		related.R.LegacyAccountUsers = related.R.LegacyAccountUsers[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetRole of the user to the related item.
+// Sets o.R.Role to related.
+// Adds o to related.R.Users.
+func (o *User) SetRole(exec boil.Executor, insert bool, related *Role) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.RoleID, related.ID)
+	if o.R == nil {
+		o.R = &userR{
+			Role: related,
+		}
+	} else {
+		o.R.Role = related
+	}
+
+	if related.R == nil {
+		related.R = &roleR{
+			Users: UserSlice{o},
+		}
+	} else {
+		related.R.Users = append(related.R.Users, o)
+	}
+
+	return nil
+}
+
+// RemoveRole relationship.
+// Sets o.R.Role to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *User) RemoveRole(exec boil.Executor, related *Role) error {
+	var err error
+
+	queries.SetScanner(&o.RoleID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("role_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Role = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Users {
+		if queries.Equal(o.RoleID, ri.RoleID) {
+			continue
+		}
+
+		ln := len(related.R.Users)
+		if ln > 1 && i < ln-1 {
+			related.R.Users[i] = related.R.Users[ln-1]
+		}
+		related.R.Users = related.R.Users[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetPasswordHash of the user to the related item.
+// Sets o.R.PasswordHash to related.
+// Adds o to related.R.User.
+func (o *User) SetPasswordHash(exec boil.Executor, insert bool, related *PasswordHash) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"password_hashes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, passwordHashPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PasswordHash: related,
+		}
+	} else {
+		o.R.PasswordHash = related
+	}
+
+	if related.R == nil {
+		related.R = &passwordHashR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// AddAPIKeys adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.APIKeys.
+// Sets related.R.User appropriately.
+func (o *User) AddAPIKeys(exec boil.Executor, insert bool, related ...*APIKey) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"api_keys\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, apiKeyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			APIKeys: related,
+		}
+	} else {
+		o.R.APIKeys = append(o.R.APIKeys, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &apiKeyR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddFromServiceAsset1155ServiceTransferEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.FromServiceAsset1155ServiceTransferEvents.
+// Sets related.R.FromServiceUser appropriately.
+func (o *User) AddFromServiceAsset1155ServiceTransferEvents(exec boil.Executor, insert bool, related ...*Asset1155ServiceTransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.FromService, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset1155_service_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"from_service"}),
+				strmangle.WhereClause("\"", "\"", 2, asset1155ServiceTransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.FromService, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			FromServiceAsset1155ServiceTransferEvents: related,
+		}
+	} else {
+		o.R.FromServiceAsset1155ServiceTransferEvents = append(o.R.FromServiceAsset1155ServiceTransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &asset1155ServiceTransferEventR{
+				FromServiceUser: o,
+			}
+		} else {
+			rel.R.FromServiceUser = o
+		}
+	}
+	return nil
+}
+
+// SetFromServiceAsset1155ServiceTransferEvents removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.FromServiceUser's FromServiceAsset1155ServiceTransferEvents accordingly.
+// Replaces o.R.FromServiceAsset1155ServiceTransferEvents with related.
+// Sets related.R.FromServiceUser's FromServiceAsset1155ServiceTransferEvents accordingly.
+func (o *User) SetFromServiceAsset1155ServiceTransferEvents(exec boil.Executor, insert bool, related ...*Asset1155ServiceTransferEvent) error {
+	query := "update \"asset1155_service_transfer_events\" set \"from_service\" = null where \"from_service\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.FromServiceAsset1155ServiceTransferEvents {
+			queries.SetScanner(&rel.FromService, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.FromServiceUser = nil
+		}
+
+		o.R.FromServiceAsset1155ServiceTransferEvents = nil
+	}
+	return o.AddFromServiceAsset1155ServiceTransferEvents(exec, insert, related...)
+}
+
+// RemoveFromServiceAsset1155ServiceTransferEvents relationships from objects passed in.
+// Removes related items from R.FromServiceAsset1155ServiceTransferEvents (uses pointer comparison, removal does not keep order)
+// Sets related.R.FromServiceUser.
+func (o *User) RemoveFromServiceAsset1155ServiceTransferEvents(exec boil.Executor, related ...*Asset1155ServiceTransferEvent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.FromService, nil)
+		if rel.R != nil {
+			rel.R.FromServiceUser = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("from_service")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.FromServiceAsset1155ServiceTransferEvents {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.FromServiceAsset1155ServiceTransferEvents)
+			if ln > 1 && i < ln-1 {
+				o.R.FromServiceAsset1155ServiceTransferEvents[i] = o.R.FromServiceAsset1155ServiceTransferEvents[ln-1]
+			}
+			o.R.FromServiceAsset1155ServiceTransferEvents = o.R.FromServiceAsset1155ServiceTransferEvents[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddToServiceAsset1155ServiceTransferEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ToServiceAsset1155ServiceTransferEvents.
+// Sets related.R.ToServiceUser appropriately.
+func (o *User) AddToServiceAsset1155ServiceTransferEvents(exec boil.Executor, insert bool, related ...*Asset1155ServiceTransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ToService, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset1155_service_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"to_service"}),
+				strmangle.WhereClause("\"", "\"", 2, asset1155ServiceTransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ToService, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ToServiceAsset1155ServiceTransferEvents: related,
+		}
+	} else {
+		o.R.ToServiceAsset1155ServiceTransferEvents = append(o.R.ToServiceAsset1155ServiceTransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &asset1155ServiceTransferEventR{
+				ToServiceUser: o,
+			}
+		} else {
+			rel.R.ToServiceUser = o
+		}
+	}
+	return nil
+}
+
+// SetToServiceAsset1155ServiceTransferEvents removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ToServiceUser's ToServiceAsset1155ServiceTransferEvents accordingly.
+// Replaces o.R.ToServiceAsset1155ServiceTransferEvents with related.
+// Sets related.R.ToServiceUser's ToServiceAsset1155ServiceTransferEvents accordingly.
+func (o *User) SetToServiceAsset1155ServiceTransferEvents(exec boil.Executor, insert bool, related ...*Asset1155ServiceTransferEvent) error {
+	query := "update \"asset1155_service_transfer_events\" set \"to_service\" = null where \"to_service\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ToServiceAsset1155ServiceTransferEvents {
+			queries.SetScanner(&rel.ToService, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ToServiceUser = nil
+		}
+
+		o.R.ToServiceAsset1155ServiceTransferEvents = nil
+	}
+	return o.AddToServiceAsset1155ServiceTransferEvents(exec, insert, related...)
+}
+
+// RemoveToServiceAsset1155ServiceTransferEvents relationships from objects passed in.
+// Removes related items from R.ToServiceAsset1155ServiceTransferEvents (uses pointer comparison, removal does not keep order)
+// Sets related.R.ToServiceUser.
+func (o *User) RemoveToServiceAsset1155ServiceTransferEvents(exec boil.Executor, related ...*Asset1155ServiceTransferEvent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ToService, nil)
+		if rel.R != nil {
+			rel.R.ToServiceUser = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("to_service")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ToServiceAsset1155ServiceTransferEvents {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ToServiceAsset1155ServiceTransferEvents)
+			if ln > 1 && i < ln-1 {
+				o.R.ToServiceAsset1155ServiceTransferEvents[i] = o.R.ToServiceAsset1155ServiceTransferEvents[ln-1]
+			}
+			o.R.ToServiceAsset1155ServiceTransferEvents = o.R.ToServiceAsset1155ServiceTransferEvents[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddAsset1155ServiceTransferEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Asset1155ServiceTransferEvents.
+// Sets related.R.User appropriately.
+func (o *User) AddAsset1155ServiceTransferEvents(exec boil.Executor, insert bool, related ...*Asset1155ServiceTransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset1155_service_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, asset1155ServiceTransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Asset1155ServiceTransferEvents: related,
+		}
+	} else {
+		o.R.Asset1155ServiceTransferEvents = append(o.R.Asset1155ServiceTransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &asset1155ServiceTransferEventR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddFromUserAsset1155TransferEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.FromUserAsset1155TransferEvents.
+// Sets related.R.FromUser appropriately.
+func (o *User) AddFromUserAsset1155TransferEvents(exec boil.Executor, insert bool, related ...*Asset1155TransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FromUserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset1155_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"from_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, asset1155TransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FromUserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			FromUserAsset1155TransferEvents: related,
+		}
+	} else {
+		o.R.FromUserAsset1155TransferEvents = append(o.R.FromUserAsset1155TransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &asset1155TransferEventR{
+				FromUser: o,
+			}
+		} else {
+			rel.R.FromUser = o
+		}
+	}
+	return nil
+}
+
+// AddServiceAsset1155TransferEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ServiceAsset1155TransferEvents.
+// Sets related.R.Service appropriately.
+func (o *User) AddServiceAsset1155TransferEvents(exec boil.Executor, insert bool, related ...*Asset1155TransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ServiceID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset1155_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"service_id"}),
+				strmangle.WhereClause("\"", "\"", 2, asset1155TransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ServiceID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ServiceAsset1155TransferEvents: related,
+		}
+	} else {
+		o.R.ServiceAsset1155TransferEvents = append(o.R.ServiceAsset1155TransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &asset1155TransferEventR{
+				Service: o,
+			}
+		} else {
+			rel.R.Service = o
+		}
+	}
+	return nil
+}
+
+// SetServiceAsset1155TransferEvents removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Service's ServiceAsset1155TransferEvents accordingly.
+// Replaces o.R.ServiceAsset1155TransferEvents with related.
+// Sets related.R.Service's ServiceAsset1155TransferEvents accordingly.
+func (o *User) SetServiceAsset1155TransferEvents(exec boil.Executor, insert bool, related ...*Asset1155TransferEvent) error {
+	query := "update \"asset1155_transfer_events\" set \"service_id\" = null where \"service_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ServiceAsset1155TransferEvents {
+			queries.SetScanner(&rel.ServiceID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Service = nil
+		}
+
+		o.R.ServiceAsset1155TransferEvents = nil
+	}
+	return o.AddServiceAsset1155TransferEvents(exec, insert, related...)
+}
+
+// RemoveServiceAsset1155TransferEvents relationships from objects passed in.
+// Removes related items from R.ServiceAsset1155TransferEvents (uses pointer comparison, removal does not keep order)
+// Sets related.R.Service.
+func (o *User) RemoveServiceAsset1155TransferEvents(exec boil.Executor, related ...*Asset1155TransferEvent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ServiceID, nil)
+		if rel.R != nil {
+			rel.R.Service = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("service_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ServiceAsset1155TransferEvents {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ServiceAsset1155TransferEvents)
+			if ln > 1 && i < ln-1 {
+				o.R.ServiceAsset1155TransferEvents[i] = o.R.ServiceAsset1155TransferEvents[ln-1]
+			}
+			o.R.ServiceAsset1155TransferEvents = o.R.ServiceAsset1155TransferEvents[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddToUserAsset1155TransferEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ToUserAsset1155TransferEvents.
+// Sets related.R.ToUser appropriately.
+func (o *User) AddToUserAsset1155TransferEvents(exec boil.Executor, insert bool, related ...*Asset1155TransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ToUserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset1155_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"to_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, asset1155TransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ToUserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ToUserAsset1155TransferEvents: related,
+		}
+	} else {
+		o.R.ToUserAsset1155TransferEvents = append(o.R.ToUserAsset1155TransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &asset1155TransferEventR{
+				ToUser: o,
+			}
+		} else {
+			rel.R.ToUser = o
+		}
+	}
+	return nil
+}
+
+// AddAcceptedByAssetRentals adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AcceptedByAssetRentals.
+// Sets related.R.AcceptedBy appropriately.
+func (o *User) AddAcceptedByAssetRentals(exec boil.Executor, insert bool, related ...*AssetRental) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AcceptedByID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_rentals\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"accepted_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetRentalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AcceptedByID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AcceptedByAssetRentals: related,
+		}
+	} else {
+		o.R.AcceptedByAssetRentals = append(o.R.AcceptedByAssetRentals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetRentalR{
+				AcceptedBy: o,
+			}
+		} else {
+			rel.R.AcceptedBy = o
+		}
+	}
+	return nil
+}
+
+// SetAcceptedByAssetRentals removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.AcceptedBy's AcceptedByAssetRentals accordingly.
+// Replaces o.R.AcceptedByAssetRentals with related.
+// Sets related.R.AcceptedBy's AcceptedByAssetRentals accordingly.
+func (o *User) SetAcceptedByAssetRentals(exec boil.Executor, insert bool, related ...*AssetRental) error {
+	query := "update \"asset_rentals\" set \"accepted_by_id\" = null where \"accepted_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AcceptedByAssetRentals {
+			queries.SetScanner(&rel.AcceptedByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.AcceptedBy = nil
+		}
+
+		o.R.AcceptedByAssetRentals = nil
+	}
+	return o.AddAcceptedByAssetRentals(exec, insert, related...)
+}
+
+// RemoveAcceptedByAssetRentals relationships from objects passed in.
+// Removes related items from R.AcceptedByAssetRentals (uses pointer comparison, removal does not keep order)
+// Sets related.R.AcceptedBy.
+func (o *User) RemoveAcceptedByAssetRentals(exec boil.Executor, related ...*AssetRental) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AcceptedByID, nil)
+		if rel.R != nil {
+			rel.R.AcceptedBy = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("accepted_by_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AcceptedByAssetRentals {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AcceptedByAssetRentals)
+			if ln > 1 && i < ln-1 {
+				o.R.AcceptedByAssetRentals[i] = o.R.AcceptedByAssetRentals[ln-1]
+			}
+			o.R.AcceptedByAssetRentals = o.R.AcceptedByAssetRentals[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddOwnerAssetRentals adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerAssetRentals.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerAssetRentals(exec boil.Executor, insert bool, related ...*AssetRental) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OwnerID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_rentals\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetRentalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OwnerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OwnerAssetRentals: related,
+		}
+	} else {
+		o.R.OwnerAssetRentals = append(o.R.OwnerAssetRentals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetRentalR{
+				Owner: o,
+			}
+		} else {
+			rel.R.Owner = o
+		}
+	}
+	return nil
+}
+
+// AddRenterUserAssetRentals adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RenterUserAssetRentals.
+// Sets related.R.RenterUser appropriately.
+func (o *User) AddRenterUserAssetRentals(exec boil.Executor, insert bool, related ...*AssetRental) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.RenterUserID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_rentals\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"renter_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetRentalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.RenterUserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			RenterUserAssetRentals: related,
+		}
+	} else {
+		o.R.RenterUserAssetRentals = append(o.R.RenterUserAssetRentals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetRentalR{
+				RenterUser: o,
+			}
+		} else {
+			rel.R.RenterUser = o
+		}
+	}
+	return nil
+}
+
+// SetRenterUserAssetRentals removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.RenterUser's RenterUserAssetRentals accordingly.
+// Replaces o.R.RenterUserAssetRentals with related.
+// Sets related.R.RenterUser's RenterUserAssetRentals accordingly.
+func (o *User) SetRenterUserAssetRentals(exec boil.Executor, insert bool, related ...*AssetRental) error {
+	query := "update \"asset_rentals\" set \"renter_user_id\" = null where \"renter_user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.RenterUserAssetRentals {
+			queries.SetScanner(&rel.RenterUserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.RenterUser = nil
+		}
+
+		o.R.RenterUserAssetRentals = nil
+	}
+	return o.AddRenterUserAssetRentals(exec, insert, related...)
+}
+
+// RemoveRenterUserAssetRentals relationships from objects passed in.
+// Removes related items from R.RenterUserAssetRentals (uses pointer comparison, removal does not keep order)
+// Sets related.R.RenterUser.
+func (o *User) RemoveRenterUserAssetRentals(exec boil.Executor, related ...*AssetRental) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.RenterUserID, nil)
+		if rel.R != nil {
+			rel.R.RenterUser = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("renter_user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.RenterUserAssetRentals {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.RenterUserAssetRentals)
+			if ln > 1 && i < ln-1 {
+				o.R.RenterUserAssetRentals[i] = o.R.RenterUserAssetRentals[ln-1]
+			}
+			o.R.RenterUserAssetRentals = o.R.RenterUserAssetRentals[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddReleasedByAssetServiceLocks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ReleasedByAssetServiceLocks.
+// Sets related.R.ReleasedBy appropriately.
+func (o *User) AddReleasedByAssetServiceLocks(exec boil.Executor, insert bool, related ...*AssetServiceLock) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ReleasedByID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_service_locks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"released_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetServiceLockPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ReleasedByID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ReleasedByAssetServiceLocks: related,
+		}
+	} else {
+		o.R.ReleasedByAssetServiceLocks = append(o.R.ReleasedByAssetServiceLocks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetServiceLockR{
+				ReleasedBy: o,
+			}
+		} else {
+			rel.R.ReleasedBy = o
+		}
+	}
+	return nil
+}
+
+// SetReleasedByAssetServiceLocks removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ReleasedBy's ReleasedByAssetServiceLocks accordingly.
+// Replaces o.R.ReleasedByAssetServiceLocks with related.
+// Sets related.R.ReleasedBy's ReleasedByAssetServiceLocks accordingly.
+func (o *User) SetReleasedByAssetServiceLocks(exec boil.Executor, insert bool, related ...*AssetServiceLock) error {
+	query := "update \"asset_service_locks\" set \"released_by_id\" = null where \"released_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ReleasedByAssetServiceLocks {
+			queries.SetScanner(&rel.ReleasedByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ReleasedBy = nil
+		}
+
+		o.R.ReleasedByAssetServiceLocks = nil
+	}
+	return o.AddReleasedByAssetServiceLocks(exec, insert, related...)
+}
+
+// RemoveReleasedByAssetServiceLocks relationships from objects passed in.
+// Removes related items from R.ReleasedByAssetServiceLocks (uses pointer comparison, removal does not keep order)
+// Sets related.R.ReleasedBy.
+func (o *User) RemoveReleasedByAssetServiceLocks(exec boil.Executor, related ...*AssetServiceLock) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ReleasedByID, nil)
+		if rel.R != nil {
+			rel.R.ReleasedBy = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("released_by_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ReleasedByAssetServiceLocks {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ReleasedByAssetServiceLocks)
+			if ln > 1 && i < ln-1 {
+				o.R.ReleasedByAssetServiceLocks[i] = o.R.ReleasedByAssetServiceLocks[ln-1]
+			}
+			o.R.ReleasedByAssetServiceLocks = o.R.ReleasedByAssetServiceLocks[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddServiceAssetServiceLocks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ServiceAssetServiceLocks.
+// Sets related.R.Service appropriately.
+func (o *User) AddServiceAssetServiceLocks(exec boil.Executor, insert bool, related ...*AssetServiceLock) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ServiceID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_service_locks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"service_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetServiceLockPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ServiceID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ServiceAssetServiceLocks: related,
+		}
+	} else {
+		o.R.ServiceAssetServiceLocks = append(o.R.ServiceAssetServiceLocks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetServiceLockR{
+				Service: o,
+			}
+		} else {
+			rel.R.Service = o
+		}
+	}
+	return nil
+}
+
+// AddFromServiceAssetServiceTransferEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.FromServiceAssetServiceTransferEvents.
+// Sets related.R.FromServiceUser appropriately.
+func (o *User) AddFromServiceAssetServiceTransferEvents(exec boil.Executor, insert bool, related ...*AssetServiceTransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.FromService, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_service_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"from_service"}),
+				strmangle.WhereClause("\"", "\"", 2, assetServiceTransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.FromService, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			FromServiceAssetServiceTransferEvents: related,
+		}
+	} else {
+		o.R.FromServiceAssetServiceTransferEvents = append(o.R.FromServiceAssetServiceTransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetServiceTransferEventR{
+				FromServiceUser: o,
+			}
+		} else {
+			rel.R.FromServiceUser = o
+		}
+	}
+	return nil
+}
+
+// SetFromServiceAssetServiceTransferEvents removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.FromServiceUser's FromServiceAssetServiceTransferEvents accordingly.
+// Replaces o.R.FromServiceAssetServiceTransferEvents with related.
+// Sets related.R.FromServiceUser's FromServiceAssetServiceTransferEvents accordingly.
+func (o *User) SetFromServiceAssetServiceTransferEvents(exec boil.Executor, insert bool, related ...*AssetServiceTransferEvent) error {
+	query := "update \"asset_service_transfer_events\" set \"from_service\" = null where \"from_service\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.FromServiceAssetServiceTransferEvents {
+			queries.SetScanner(&rel.FromService, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.FromServiceUser = nil
+		}
+
+		o.R.FromServiceAssetServiceTransferEvents = nil
+	}
+	return o.AddFromServiceAssetServiceTransferEvents(exec, insert, related...)
+}
+
+// RemoveFromServiceAssetServiceTransferEvents relationships from objects passed in.
+// Removes related items from R.FromServiceAssetServiceTransferEvents (uses pointer comparison, removal does not keep order)
+// Sets related.R.FromServiceUser.
+func (o *User) RemoveFromServiceAssetServiceTransferEvents(exec boil.Executor, related ...*AssetServiceTransferEvent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.FromService, nil)
+		if rel.R != nil {
+			rel.R.FromServiceUser = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("from_service")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.FromServiceAssetServiceTransferEvents {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.FromServiceAssetServiceTransferEvents)
+			if ln > 1 && i < ln-1 {
+				o.R.FromServiceAssetServiceTransferEvents[i] = o.R.FromServiceAssetServiceTransferEvents[ln-1]
+			}
+			o.R.FromServiceAssetServiceTransferEvents = o.R.FromServiceAssetServiceTransferEvents[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddToServiceAssetServiceTransferEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ToServiceAssetServiceTransferEvents.
+// Sets related.R.ToServiceUser appropriately.
+func (o *User) AddToServiceAssetServiceTransferEvents(exec boil.Executor, insert bool, related ...*AssetServiceTransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ToService, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_service_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"to_service"}),
+				strmangle.WhereClause("\"", "\"", 2, assetServiceTransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ToService, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ToServiceAssetServiceTransferEvents: related,
+		}
+	} else {
+		o.R.ToServiceAssetServiceTransferEvents = append(o.R.ToServiceAssetServiceTransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetServiceTransferEventR{
+				ToServiceUser: o,
+			}
+		} else {
+			rel.R.ToServiceUser = o
+		}
+	}
+	return nil
+}
+
+// SetToServiceAssetServiceTransferEvents removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ToServiceUser's ToServiceAssetServiceTransferEvents accordingly.
+// Replaces o.R.ToServiceAssetServiceTransferEvents with related.
+// Sets related.R.ToServiceUser's ToServiceAssetServiceTransferEvents accordingly.
+func (o *User) SetToServiceAssetServiceTransferEvents(exec boil.Executor, insert bool, related ...*AssetServiceTransferEvent) error {
+	query := "update \"asset_service_transfer_events\" set \"to_service\" = null where \"to_service\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ToServiceAssetServiceTransferEvents {
+			queries.SetScanner(&rel.ToService, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ToServiceUser = nil
+		}
+
+		o.R.ToServiceAssetServiceTransferEvents = nil
+	}
+	return o.AddToServiceAssetServiceTransferEvents(exec, insert, related...)
+}
+
+// RemoveToServiceAssetServiceTransferEvents relationships from objects passed in.
+// Removes related items from R.ToServiceAssetServiceTransferEvents (uses pointer comparison, removal does not keep order)
+// Sets related.R.ToServiceUser.
+func (o *User) RemoveToServiceAssetServiceTransferEvents(exec boil.Executor, related ...*AssetServiceTransferEvent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ToService, nil)
+		if rel.R != nil {
+			rel.R.ToServiceUser = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("to_service")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ToServiceAssetServiceTransferEvents {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ToServiceAssetServiceTransferEvents)
+			if ln > 1 && i < ln-1 {
+				o.R.ToServiceAssetServiceTransferEvents[i] = o.R.ToServiceAssetServiceTransferEvents[ln-1]
+			}
+			o.R.ToServiceAssetServiceTransferEvents = o.R.ToServiceAssetServiceTransferEvents[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddAssetServiceTransferEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AssetServiceTransferEvents.
+// Sets related.R.User appropriately.
+func (o *User) AddAssetServiceTransferEvents(exec boil.Executor, insert bool, related ...*AssetServiceTransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_service_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetServiceTransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AssetServiceTransferEvents: related,
+		}
+	} else {
+		o.R.AssetServiceTransferEvents = append(o.R.AssetServiceTransferEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetServiceTransferEventR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddOffererAssetTrades adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OffererAssetTrades.
+// Sets related.R.Offerer appropriately.
+func (o *User) AddOffererAssetTrades(exec boil.Executor, insert bool, related ...*AssetTrade) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OffererID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_trades\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"offerer_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetTradePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OffererID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OffererAssetTrades: related,
+		}
+	} else {
+		o.R.OffererAssetTrades = append(o.R.OffererAssetTrades, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetTradeR{
+				Offerer: o,
+			}
+		} else {
+			rel.R.Offerer = o
+		}
+	}
+	return nil
+}
+
+// AddRecipientAssetTrades adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RecipientAssetTrades.
+// Sets related.R.Recipient appropriately.
+func (o *User) AddRecipientAssetTrades(exec boil.Executor, insert bool, related ...*AssetTrade) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RecipientID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_trades\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"recipient_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetTradePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RecipientID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			RecipientAssetTrades: related,
+		}
+	} else {
+		o.R.RecipientAssetTrades = append(o.R.RecipientAssetTrades, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetTradeR{
+				Recipient: o,
+			}
+		} else {
+			rel.R.Recipient = o
+		}
+	}
+	return nil
+}
+
+// AddFromUserAssetTransferEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.FromUserAssetTransferEvents.
+// Sets related.R.FromUser appropriately.
+func (o *User) AddFromUserAssetTransferEvents(exec boil.Executor, insert bool, related ...*AssetTransferEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FromUserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"from_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetTransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
+
+			rel.FromUserID = o.ID
 		}
 	}
-	if singular {
-		object.R.UsernameHistories = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &usernameHistoryR{}
-			}
-			foreign.R.User = object
+
+	if o.R == nil {
+		o.R = &userR{
+			FromUserAssetTransferEvents: related,
 		}
-		return nil
+	} else {
+		o.R.FromUserAssetTransferEvents = append(o.R.FromUserAssetTransferEvents, related...)
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.UsernameHistories = append(local.R.UsernameHistories, foreign)
-				if foreign.R == nil {
-					foreign.R = &usernameHistoryR{}
-				}
-				foreign.R.User = local
-				break
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetTransferEventR{
+				FromUser: o,
 			}
+		} else {
+			rel.R.FromUser = o
 		}
 	}
-
 	return nil
 }
 
-// SetAccount of the user to the related item.
-// Sets o.R.Account to related.
-// Adds o to related.R.Users.
-func (o *User) SetAccount(exec boil.Executor, insert bool, related *Account) error {
+// AddToUserAssetTransferEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ToUserAssetTransferEvents.
+// Sets related.R.ToUser appropriately.
+func (o *User) AddToUserAssetTransferEvents(exec boil.Executor, insert bool, related ...*AssetTransferEvent) error {
 	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
+	for _, rel := range related {
+		if insert {
+			rel.ToUserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"asset_transfer_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"to_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetTransferEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"users\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
-		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			rel.ToUserID = o.ID
+		}
 	}
 
-	o.AccountID = related.ID
 	if o.R == nil {
 		o.R = &userR{
-			Account: related,
+			ToUserAssetTransferEvents: related,
 		}
 	} else {
-		o.R.Account = related
+		o.R.ToUserAssetTransferEvents = append(o.R.ToUserAssetTransferEvents, related...)
 	}
 
-	if related.R == nil {
-		related.R = &accountR{
-			Users: UserSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetTransferEventR{
+				ToUser: o,
+			}
+		} else {
+			rel.R.ToUser = o
 		}
-	} else {
-		related.R.Users = append(related.R.Users, o)
 	}
-
 	return nil
 }
 
-// SetAvatar of the user to the related item.
-// Sets o.R.Avatar to related.
-// Adds o to related.R.AvatarUsers.
-func (o *User) SetAvatar(exec boil.Executor, insert bool, related *Blob) error {
+// AddServiceCraftingEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ServiceCraftingEvents.
+// Sets related.R.Service appropriately.
+func (o *User) AddServiceCraftingEvents(exec boil.Executor, insert bool, related ...*CraftingEvent) error {
 	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ServiceID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"crafting_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"service_id"}),
+				strmangle.WhereClause("\"", "\"", 2, craftingEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"users\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"avatar_id"}),
-		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			queries.Assign(&rel.ServiceID, o.ID)
+		}
 	}
 
-	queries.Assign(&o.AvatarID, related.ID)
 	if o.R == nil {
 		o.R = &userR{
-			Avatar: related,
+			ServiceCraftingEvents: related,
 		}
 	} else {
-		o.R.Avatar = related
+		o.R.ServiceCraftingEvents = append(o.R.ServiceCraftingEvents, related...)
 	}
 
-	if related.R == nil {
-		related.R = &blobR{
-			AvatarUsers: UserSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &craftingEventR{
+				Service: o,
+			}
+		} else {
+			rel.R.Service = o
 		}
-	} else {
-		related.R.AvatarUsers = append(related.R.AvatarUsers, o)
 	}
-
 	return nil
 }
 
-// RemoveAvatar relationship.
-// Sets o.R.Avatar to nil.
-// Removes o from all passed in related items' relationships struct (Optional).
-func (o *User) RemoveAvatar(exec boil.Executor, related *Blob) error {
-	var err error
-
-	queries.SetScanner(&o.AvatarID, nil)
-	if _, err = o.Update(exec, boil.Whitelist("avatar_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+// SetServiceCraftingEvents removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Service's ServiceCraftingEvents accordingly.
+// Replaces o.R.ServiceCraftingEvents with related.
+// Sets related.R.Service's ServiceCraftingEvents accordingly.
+func (o *User) SetServiceCraftingEvents(exec boil.Executor, insert bool, related ...*CraftingEvent) error {
+	query := "update \"crafting_events\" set \"service_id\" = null where \"service_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
 	if o.R != nil {
-		o.R.Avatar = nil
+		for _, rel := range o.R.ServiceCraftingEvents {
+			queries.SetScanner(&rel.ServiceID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Service = nil
+		}
+
+		o.R.ServiceCraftingEvents = nil
 	}
-	if related == nil || related.R == nil {
+	return o.AddServiceCraftingEvents(exec, insert, related...)
+}
+
+// RemoveServiceCraftingEvents relationships from objects passed in.
+// Removes related items from R.ServiceCraftingEvents (uses pointer comparison, removal does not keep order)
+// Sets related.R.Service.
+func (o *User) RemoveServiceCraftingEvents(exec boil.Executor, related ...*CraftingEvent) error {
+	if len(related) == 0 {
 		return nil
 	}
 
-	for i, ri := range related.R.AvatarUsers {
-		if queries.Equal(o.AvatarID, ri.AvatarID) {
-			continue
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ServiceID, nil)
+		if rel.R != nil {
+			rel.R.Service = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("service_id")); err != nil {
+			return err
 		}
+	}
+	if o.R == nil {
+		return nil
+	}
 
-		ln := len(related.R.AvatarUsers)
-		if ln > 1 && i < ln-1 {
-			related.R.AvatarUsers[i] = related.R.AvatarUsers[ln-1]
+	for _, rel := range related {
+		for i, ri := range o.R.ServiceCraftingEvents {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ServiceCraftingEvents)
+			if ln > 1 && i < ln-1 {
+				o.R.ServiceCraftingEvents[i] = o.R.ServiceCraftingEvents[ln-1]
+			}
+			o.R.ServiceCraftingEvents = o.R.ServiceCraftingEvents[:ln-1]
+			break
 		}
-		related.R.AvatarUsers = related.R.AvatarUsers[:ln-1]
-		break
 	}
+
 	return nil
 }
 
-// SetFaction of the user to the related item.
-// Sets o.R.Faction to related.
-// Adds o to related.R.Users.
-func (o *User) SetFaction(exec boil.Executor, insert bool, related *Faction) error {
+// AddCraftingEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CraftingEvents.
+// Sets related.R.User appropriately.
+func (o *User) AddCraftingEvents(exec boil.Executor, insert bool, related ...*CraftingEvent) error {
 	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"users\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"faction_id"}),
-		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"crafting_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, craftingEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
 	}
 
-	queries.Assign(&o.FactionID, related.ID)
 	if o.R == nil {
 		o.R = &userR{
-			Faction: related,
+			CraftingEvents: related,
 		}
 	} else {
-		o.R.Faction = related
+		o.R.CraftingEvents = append(o.R.CraftingEvents, related...)
 	}
 
-	if related.R == nil {
-		related.R = &factionR{
-			Users: UserSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &craftingEventR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
 		}
-	} else {
-		related.R.Users = append(related.R.Users, o)
 	}
-
 	return nil
 }
 
-// RemoveFaction relationship.
-// Sets o.R.Faction to nil.
-// Removes o from all passed in related items' relationships struct (Optional).
-func (o *User) RemoveFaction(exec boil.Executor, related *Faction) error {
+// AddCreatedByCraftingRecipes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByCraftingRecipes.
+// Sets related.R.CreatedBy appropriately.
+func (o *User) AddCreatedByCraftingRecipes(exec boil.Executor, insert bool, related ...*CraftingRecipe) error {
 	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreatedByID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"crafting_recipes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, craftingRecipePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	queries.SetScanner(&o.FactionID, nil)
-	if _, err = o.Update(exec, boil.Whitelist("faction_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if o.R != nil {
-		o.R.Faction = nil
-	}
-	if related == nil || related.R == nil {
-		return nil
+			rel.CreatedByID = o.ID
+		}
 	}
 
-	for i, ri := range related.R.Users {
-		if queries.Equal(o.FactionID, ri.FactionID) {
-			continue
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByCraftingRecipes: related,
 		}
+	} else {
+		o.R.CreatedByCraftingRecipes = append(o.R.CreatedByCraftingRecipes, related...)
+	}
 
-		ln := len(related.R.Users)
-		if ln > 1 && i < ln-1 {
-			related.R.Users[i] = related.R.Users[ln-1]
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &craftingRecipeR{
+				CreatedBy: o,
+			}
+		} else {
+			rel.R.CreatedBy = o
 		}
-		related.R.Users = related.R.Users[:ln-1]
-		break
 	}
 	return nil
 }
 
-// SetLegacyAccount of the user to the related item.
-// Sets o.R.LegacyAccount to related.
-// Adds o to related.R.LegacyAccountUsers.
-func (o *User) SetLegacyAccount(exec boil.Executor, insert bool, related *Account) error {
+// AddDepositAsset1155Transactions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DepositAsset1155Transactions.
+// Sets related.R.User appropriately.
+func (o *User) AddDepositAsset1155Transactions(exec boil.Executor, insert bool, related ...*DepositAsset1155Transaction) error {
 	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"deposit_asset1155_transactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, depositAsset1155TransactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"users\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"legacy_account_id"}),
-		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			rel.UserID = o.ID
+		}
 	}
 
-	queries.Assign(&o.LegacyAccountID, related.ID)
 	if o.R == nil {
 		o.R = &userR{
-			LegacyAccount: related,
+			DepositAsset1155Transactions: related,
 		}
 	} else {
-		o.R.LegacyAccount = related
+		o.R.DepositAsset1155Transactions = append(o.R.DepositAsset1155Transactions, related...)
 	}
 
-	if related.R == nil {
-		related.R = &accountR{
-			LegacyAccountUsers: UserSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &depositAsset1155TransactionR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
 		}
-	} else {
-		related.R.LegacyAccountUsers = append(related.R.LegacyAccountUsers, o)
 	}
-
 	return nil
 }
 
-// RemoveLegacyAccount relationship.
-// Sets o.R.LegacyAccount to nil.
-// Removes o from all passed in related items' relationships struct (Optional).
-func (o *User) RemoveLegacyAccount(exec boil.Executor, related *Account) error {
+// AddDepositTransactions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DepositTransactions.
+// Sets related.R.User appropriately.
+func (o *User) AddDepositTransactions(exec boil.Executor, insert bool, related ...*DepositTransaction) error {
 	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"deposit_transactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, depositTransactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	queries.SetScanner(&o.LegacyAccountID, nil)
-	if _, err = o.Update(exec, boil.Whitelist("legacy_account_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if o.R != nil {
-		o.R.LegacyAccount = nil
-	}
-	if related == nil || related.R == nil {
-		return nil
+			rel.UserID = o.ID
+		}
 	}
 
-	for i, ri := range related.R.LegacyAccountUsers {
-		if queries.Equal(o.LegacyAccountID, ri.LegacyAccountID) {
-			continue
+	if o.R == nil {
+		o.R = &userR{
+			DepositTransactions: related,
 		}
+	} else {
+		o.R.DepositTransactions = append(o.R.DepositTransactions, related...)
+	}
 
-		ln := len(related.R.LegacyAccountUsers)
-		if ln > 1 && i < ln-1 {
-			related.R.LegacyAccountUsers[i] = related.R.LegacyAccountUsers[ln-1]
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &depositTransactionR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
 		}
-		related.R.LegacyAccountUsers = related.R.LegacyAccountUsers[:ln-1]
-		break
 	}
 	return nil
 }
 
-// SetRole of the user to the related item.
-// Sets o.R.Role to related.
-// Adds o to related.R.Users.
-func (o *User) SetRole(exec boil.Executor, insert bool, related *Role) error {
+// AddCreditFailedTransactions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreditFailedTransactions.
+// Sets related.R.CreditUser appropriately.
+func (o *User) AddCreditFailedTransactions(exec boil.Executor, insert bool, related ...*FailedTransaction) error {
 	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
+	for _, rel := range related {
+		if insert {
+			rel.Credit = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"failed_transactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"credit"}),
+				strmangle.WhereClause("\"", "\"", 2, failedTransactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"users\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
-		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			rel.Credit = o.ID
+		}
 	}
 
-	queries.Assign(&o.RoleID, related.ID)
 	if o.R == nil {
 		o.R = &userR{
-			Role: related,
-		}
-	} else {
-		o.R.Role = related
-	}
-
-	if related.R == nil {
-		related.R = &roleR{
-			Users: UserSlice{o},
+			CreditFailedTransactions: related,
 		}
 	} else {
-		related.R.Users = append(related.R.Users, o)
-	}
-
-	return nil
-}
-
-// RemoveRole relationship.
-// Sets o.R.Role to nil.
-// Removes o from all passed in related items' relationships struct (Optional).
-func (o *User) RemoveRole(exec boil.Executor, related *Role) error {
-	var err error
-
-	queries.SetScanner(&o.RoleID, nil)
-	if _, err = o.Update(exec, boil.Whitelist("role_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
-
-	if o.R != nil {
-		o.R.Role = nil
-	}
-	if related == nil || related.R == nil {
-		return nil
+		o.R.CreditFailedTransactions = append(o.R.CreditFailedTransactions, related...)
 	}
 
-	for i, ri := range related.R.Users {
-		if queries.Equal(o.RoleID, ri.RoleID) {
-			continue
-		}
-
-		ln := len(related.R.Users)
-		if ln > 1 && i < ln-1 {
-			related.R.Users[i] = related.R.Users[ln-1]
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &failedTransactionR{
+				CreditUser: o,
+			}
+		} else {
+			rel.R.CreditUser = o
 		}
-		related.R.Users = related.R.Users[:ln-1]
-		break
 	}
 	return nil
 }
 
-// SetPasswordHash of the user to the related item.
-// Sets o.R.PasswordHash to related.
-// Adds o to related.R.User.
-func (o *User) SetPasswordHash(exec boil.Executor, insert bool, related *PasswordHash) error {
+// AddDebitFailedTransactions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DebitFailedTransactions.
+// Sets related.R.DebitUser appropriately.
+func (o *User) AddDebitFailedTransactions(exec boil.Executor, insert bool, related ...*FailedTransaction) error {
 	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Debit = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"failed_transactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"debit"}),
+				strmangle.WhereClause("\"", "\"", 2, failedTransactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	if insert {
-		related.UserID = o.ID
-
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	} else {
-		updateQuery := fmt.Sprintf(
-			"UPDATE \"password_hashes\" SET %s WHERE %s",
-			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-			strmangle.WhereClause("\"", "\"", 2, passwordHashPrimaryKeyColumns),
-		)
-		values := []interface{}{o.ID, related.UserID}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-		if boil.DebugMode {
-			fmt.Fprintln(boil.DebugWriter, updateQuery)
-			fmt.Fprintln(boil.DebugWriter, values)
-		}
-		if _, err = exec.Exec(updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+			rel.Debit = o.ID
 		}
-
-		related.UserID = o.ID
-
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			PasswordHash: related,
+			DebitFailedTransactions: related,
 		}
 	} else {
-		o.R.PasswordHash = related
+		o.R.DebitFailedTransactions = append(o.R.DebitFailedTransactions, related...)
 	}
 
-	if related.R == nil {
-		related.R = &passwordHashR{
-			User: o,
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &failedTransactionR{
+				DebitUser: o,
+			}
+		} else {
+			rel.R.DebitUser = o
 		}
-	} else {
-		related.R.User = o
 	}
 	return nil
 }
 
-// AddAPIKeys adds the given related objects to the existing relationships
+// AddHeldPurchases adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.APIKeys.
+// Appends related to o.R.HeldPurchases.
 // Sets related.R.User appropriately.
-func (o *User) AddAPIKeys(exec boil.Executor, insert bool, related ...*APIKey) error {
+func (o *User) AddHeldPurchases(exec boil.Executor, insert bool, related ...*HeldPurchase) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -5296,9 +10690,9 @@ func (o *User) AddAPIKeys(exec boil.Executor, insert bool, related ...*APIKey) e
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"api_keys\" SET %s WHERE %s",
+				"UPDATE \"held_purchases\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, apiKeyPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, heldPurchasePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -5316,15 +10710,15 @@ func (o *User) AddAPIKeys(exec boil.Executor, insert bool, related ...*APIKey) e
 
 	if o.R == nil {
 		o.R = &userR{
-			APIKeys: related,
+			HeldPurchases: related,
 		}
 	} else {
-		o.R.APIKeys = append(o.R.APIKeys, related...)
+		o.R.HeldPurchases = append(o.R.HeldPurchases, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &apiKeyR{
+			rel.R = &heldPurchaseR{
 				User: o,
 			}
 		} else {
@@ -5334,23 +10728,23 @@ func (o *User) AddAPIKeys(exec boil.Executor, insert bool, related ...*APIKey) e
 	return nil
 }
 
-// AddFromServiceAsset1155ServiceTransferEvents adds the given related objects to the existing relationships
+// AddHolderSnapshotEntries adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.FromServiceAsset1155ServiceTransferEvents.
-// Sets related.R.FromServiceUser appropriately.
-func (o *User) AddFromServiceAsset1155ServiceTransferEvents(exec boil.Executor, insert bool, related ...*Asset1155ServiceTransferEvent) error {
+// Appends related to o.R.HolderSnapshotEntries.
+// Sets related.R.User appropriately.
+func (o *User) AddHolderSnapshotEntries(exec boil.Executor, insert bool, related ...*HolderSnapshotEntry) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.FromService, o.ID)
+			queries.Assign(&rel.UserID, o.ID)
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"asset1155_service_transfer_events\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"from_service"}),
-				strmangle.WhereClause("\"", "\"", 2, asset1155ServiceTransferEventPrimaryKeyColumns),
+				"UPDATE \"holder_snapshot_entries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, holderSnapshotEntryPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -5362,38 +10756,38 @@ func (o *User) AddFromServiceAsset1155ServiceTransferEvents(exec boil.Executor,
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.FromService, o.ID)
+			queries.Assign(&rel.UserID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			FromServiceAsset1155ServiceTransferEvents: related,
+			HolderSnapshotEntries: related,
 		}
 	} else {
-		o.R.FromServiceAsset1155ServiceTransferEvents = append(o.R.FromServiceAsset1155ServiceTransferEvents, related...)
+		o.R.HolderSnapshotEntries = append(o.R.HolderSnapshotEntries, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &asset1155ServiceTransferEventR{
-				FromServiceUser: o,
+			rel.R = &holderSnapshotEntryR{
+				User: o,
 			}
 		} else {
-			rel.R.FromServiceUser = o
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// SetFromServiceAsset1155ServiceTransferEvents removes all previously related items of the
+// SetHolderSnapshotEntries removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.FromServiceUser's FromServiceAsset1155ServiceTransferEvents accordingly.
-// Replaces o.R.FromServiceAsset1155ServiceTransferEvents with related.
-// Sets related.R.FromServiceUser's FromServiceAsset1155ServiceTransferEvents accordingly.
-func (o *User) SetFromServiceAsset1155ServiceTransferEvents(exec boil.Executor, insert bool, related ...*Asset1155ServiceTransferEvent) error {
-	query := "update \"asset1155_service_transfer_events\" set \"from_service\" = null where \"from_service\" = $1"
+// Sets o.R.User's HolderSnapshotEntries accordingly.
+// Replaces o.R.HolderSnapshotEntries with related.
+// Sets related.R.User's HolderSnapshotEntries accordingly.
+func (o *User) SetHolderSnapshotEntries(exec boil.Executor, insert bool, related ...*HolderSnapshotEntry) error {
+	query := "update \"holder_snapshot_entries\" set \"user_id\" = null where \"user_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, query)
@@ -5405,35 +10799,35 @@ func (o *User) SetFromServiceAsset1155ServiceTransferEvents(exec boil.Executor,
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.FromServiceAsset1155ServiceTransferEvents {
-			queries.SetScanner(&rel.FromService, nil)
+		for _, rel := range o.R.HolderSnapshotEntries {
+			queries.SetScanner(&rel.UserID, nil)
 			if rel.R == nil {
 				continue
 			}
 
-			rel.R.FromServiceUser = nil
+			rel.R.User = nil
 		}
 
-		o.R.FromServiceAsset1155ServiceTransferEvents = nil
+		o.R.HolderSnapshotEntries = nil
 	}
-	return o.AddFromServiceAsset1155ServiceTransferEvents(exec, insert, related...)
+	return o.AddHolderSnapshotEntries(exec, insert, related...)
 }
 
-// RemoveFromServiceAsset1155ServiceTransferEvents relationships from objects passed in.
-// Removes related items from R.FromServiceAsset1155ServiceTransferEvents (uses pointer comparison, removal does not keep order)
-// Sets related.R.FromServiceUser.
-func (o *User) RemoveFromServiceAsset1155ServiceTransferEvents(exec boil.Executor, related ...*Asset1155ServiceTransferEvent) error {
+// RemoveHolderSnapshotEntries relationships from objects passed in.
+// Removes related items from R.HolderSnapshotEntries (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveHolderSnapshotEntries(exec boil.Executor, related ...*HolderSnapshotEntry) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.FromService, nil)
+		queries.SetScanner(&rel.UserID, nil)
 		if rel.R != nil {
-			rel.R.FromServiceUser = nil
+			rel.R.User = nil
 		}
-		if _, err = rel.Update(exec, boil.Whitelist("from_service")); err != nil {
+		if _, err = rel.Update(exec, boil.Whitelist("user_id")); err != nil {
 			return err
 		}
 	}
@@ -5442,16 +10836,16 @@ func (o *User) RemoveFromServiceAsset1155ServiceTransferEvents(exec boil.Executo
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.FromServiceAsset1155ServiceTransferEvents {
+		for i, ri := range o.R.HolderSnapshotEntries {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.FromServiceAsset1155ServiceTransferEvents)
+			ln := len(o.R.HolderSnapshotEntries)
 			if ln > 1 && i < ln-1 {
-				o.R.FromServiceAsset1155ServiceTransferEvents[i] = o.R.FromServiceAsset1155ServiceTransferEvents[ln-1]
+				o.R.HolderSnapshotEntries[i] = o.R.HolderSnapshotEntries[ln-1]
 			}
-			o.R.FromServiceAsset1155ServiceTransferEvents = o.R.FromServiceAsset1155ServiceTransferEvents[:ln-1]
+			o.R.HolderSnapshotEntries = o.R.HolderSnapshotEntries[:ln-1]
 			break
 		}
 	}
@@ -5459,23 +10853,23 @@ func (o *User) RemoveFromServiceAsset1155ServiceTransferEvents(exec boil.Executo
 	return nil
 }
 
-// AddToServiceAsset1155ServiceTransferEvents adds the given related objects to the existing relationships
+// AddCreatedByHolderSnapshots adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ToServiceAsset1155ServiceTransferEvents.
-// Sets related.R.ToServiceUser appropriately.
-func (o *User) AddToServiceAsset1155ServiceTransferEvents(exec boil.Executor, insert bool, related ...*Asset1155ServiceTransferEvent) error {
+// Appends related to o.R.CreatedByHolderSnapshots.
+// Sets related.R.CreatedBy appropriately.
+func (o *User) AddCreatedByHolderSnapshots(exec boil.Executor, insert bool, related ...*HolderSnapshot) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.ToService, o.ID)
+			rel.CreatedByID = o.ID
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"asset1155_service_transfer_events\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"to_service"}),
-				strmangle.WhereClause("\"", "\"", 2, asset1155ServiceTransferEventPrimaryKeyColumns),
+				"UPDATE \"holder_snapshots\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, holderSnapshotPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -5487,120 +10881,99 @@ func (o *User) AddToServiceAsset1155ServiceTransferEvents(exec boil.Executor, in
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.ToService, o.ID)
+			rel.CreatedByID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			ToServiceAsset1155ServiceTransferEvents: related,
+			CreatedByHolderSnapshots: related,
 		}
 	} else {
-		o.R.ToServiceAsset1155ServiceTransferEvents = append(o.R.ToServiceAsset1155ServiceTransferEvents, related...)
+		o.R.CreatedByHolderSnapshots = append(o.R.CreatedByHolderSnapshots, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &asset1155ServiceTransferEventR{
-				ToServiceUser: o,
+			rel.R = &holderSnapshotR{
+				CreatedBy: o,
 			}
 		} else {
-			rel.R.ToServiceUser = o
+			rel.R.CreatedBy = o
 		}
 	}
 	return nil
 }
 
-// SetToServiceAsset1155ServiceTransferEvents removes all previously related items of the
-// user replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.ToServiceUser's ToServiceAsset1155ServiceTransferEvents accordingly.
-// Replaces o.R.ToServiceAsset1155ServiceTransferEvents with related.
-// Sets related.R.ToServiceUser's ToServiceAsset1155ServiceTransferEvents accordingly.
-func (o *User) SetToServiceAsset1155ServiceTransferEvents(exec boil.Executor, insert bool, related ...*Asset1155ServiceTransferEvent) error {
-	query := "update \"asset1155_service_transfer_events\" set \"to_service\" = null where \"to_service\" = $1"
-	values := []interface{}{o.ID}
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, query)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-	_, err := exec.Exec(query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
+// AddIssueTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.IssueTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddIssueTokens(exec boil.Executor, insert bool, related ...*IssueToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"issue_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, issueTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	if o.R != nil {
-		for _, rel := range o.R.ToServiceAsset1155ServiceTransferEvents {
-			queries.SetScanner(&rel.ToService, nil)
-			if rel.R == nil {
-				continue
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.R.ToServiceUser = nil
+			rel.UserID = o.ID
 		}
-
-		o.R.ToServiceAsset1155ServiceTransferEvents = nil
-	}
-	return o.AddToServiceAsset1155ServiceTransferEvents(exec, insert, related...)
-}
-
-// RemoveToServiceAsset1155ServiceTransferEvents relationships from objects passed in.
-// Removes related items from R.ToServiceAsset1155ServiceTransferEvents (uses pointer comparison, removal does not keep order)
-// Sets related.R.ToServiceUser.
-func (o *User) RemoveToServiceAsset1155ServiceTransferEvents(exec boil.Executor, related ...*Asset1155ServiceTransferEvent) error {
-	if len(related) == 0 {
-		return nil
 	}
 
-	var err error
-	for _, rel := range related {
-		queries.SetScanner(&rel.ToService, nil)
-		if rel.R != nil {
-			rel.R.ToServiceUser = nil
-		}
-		if _, err = rel.Update(exec, boil.Whitelist("to_service")); err != nil {
-			return err
-		}
-	}
 	if o.R == nil {
-		return nil
+		o.R = &userR{
+			IssueTokens: related,
+		}
+	} else {
+		o.R.IssueTokens = append(o.R.IssueTokens, related...)
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.ToServiceAsset1155ServiceTransferEvents {
-			if rel != ri {
-				continue
-			}
-
-			ln := len(o.R.ToServiceAsset1155ServiceTransferEvents)
-			if ln > 1 && i < ln-1 {
-				o.R.ToServiceAsset1155ServiceTransferEvents[i] = o.R.ToServiceAsset1155ServiceTransferEvents[ln-1]
+		if rel.R == nil {
+			rel.R = &issueTokenR{
+				User: o,
 			}
-			o.R.ToServiceAsset1155ServiceTransferEvents = o.R.ToServiceAsset1155ServiceTransferEvents[:ln-1]
-			break
+		} else {
+			rel.R.User = o
 		}
 	}
-
 	return nil
 }
 
-// AddAsset1155ServiceTransferEvents adds the given related objects to the existing relationships
+// AddBidderMarketplaceBids adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.Asset1155ServiceTransferEvents.
-// Sets related.R.User appropriately.
-func (o *User) AddAsset1155ServiceTransferEvents(exec boil.Executor, insert bool, related ...*Asset1155ServiceTransferEvent) error {
+// Appends related to o.R.BidderMarketplaceBids.
+// Sets related.R.Bidder appropriately.
+func (o *User) AddBidderMarketplaceBids(exec boil.Executor, insert bool, related ...*MarketplaceBid) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserID = o.ID
+			rel.BidderID = o.ID
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"asset1155_service_transfer_events\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, asset1155ServiceTransferEventPrimaryKeyColumns),
+				"UPDATE \"marketplace_bids\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"bidder_id"}),
+				strmangle.WhereClause("\"", "\"", 2, marketplaceBidPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -5612,47 +10985,47 @@ func (o *User) AddAsset1155ServiceTransferEvents(exec boil.Executor, insert bool
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserID = o.ID
+			rel.BidderID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			Asset1155ServiceTransferEvents: related,
+			BidderMarketplaceBids: related,
 		}
 	} else {
-		o.R.Asset1155ServiceTransferEvents = append(o.R.Asset1155ServiceTransferEvents, related...)
+		o.R.BidderMarketplaceBids = append(o.R.BidderMarketplaceBids, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &asset1155ServiceTransferEventR{
-				User: o,
+			rel.R = &marketplaceBidR{
+				Bidder: o,
 			}
 		} else {
-			rel.R.User = o
+			rel.R.Bidder = o
 		}
 	}
 	return nil
 }
 
-// AddFromServiceAssetServiceTransferEvents adds the given related objects to the existing relationships
+// AddBuyerMarketplaceListings adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.FromServiceAssetServiceTransferEvents.
-// Sets related.R.FromServiceUser appropriately.
-func (o *User) AddFromServiceAssetServiceTransferEvents(exec boil.Executor, insert bool, related ...*AssetServiceTransferEvent) error {
+// Appends related to o.R.BuyerMarketplaceListings.
+// Sets related.R.Buyer appropriately.
+func (o *User) AddBuyerMarketplaceListings(exec boil.Executor, insert bool, related ...*MarketplaceListing) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.FromService, o.ID)
+			queries.Assign(&rel.BuyerID, o.ID)
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"asset_service_transfer_events\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"from_service"}),
-				strmangle.WhereClause("\"", "\"", 2, assetServiceTransferEventPrimaryKeyColumns),
+				"UPDATE \"marketplace_listings\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"buyer_id"}),
+				strmangle.WhereClause("\"", "\"", 2, marketplaceListingPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -5664,38 +11037,38 @@ func (o *User) AddFromServiceAssetServiceTransferEvents(exec boil.Executor, inse
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.FromService, o.ID)
+			queries.Assign(&rel.BuyerID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			FromServiceAssetServiceTransferEvents: related,
+			BuyerMarketplaceListings: related,
 		}
 	} else {
-		o.R.FromServiceAssetServiceTransferEvents = append(o.R.FromServiceAssetServiceTransferEvents, related...)
+		o.R.BuyerMarketplaceListings = append(o.R.BuyerMarketplaceListings, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &assetServiceTransferEventR{
-				FromServiceUser: o,
+			rel.R = &marketplaceListingR{
+				Buyer: o,
 			}
 		} else {
-			rel.R.FromServiceUser = o
+			rel.R.Buyer = o
 		}
 	}
 	return nil
 }
 
-// SetFromServiceAssetServiceTransferEvents removes all previously related items of the
+// SetBuyerMarketplaceListings removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.FromServiceUser's FromServiceAssetServiceTransferEvents accordingly.
-// Replaces o.R.FromServiceAssetServiceTransferEvents with related.
-// Sets related.R.FromServiceUser's FromServiceAssetServiceTransferEvents accordingly.
-func (o *User) SetFromServiceAssetServiceTransferEvents(exec boil.Executor, insert bool, related ...*AssetServiceTransferEvent) error {
-	query := "update \"asset_service_transfer_events\" set \"from_service\" = null where \"from_service\" = $1"
+// Sets o.R.Buyer's BuyerMarketplaceListings accordingly.
+// Replaces o.R.BuyerMarketplaceListings with related.
+// Sets related.R.Buyer's BuyerMarketplaceListings accordingly.
+func (o *User) SetBuyerMarketplaceListings(exec boil.Executor, insert bool, related ...*MarketplaceListing) error {
+	query := "update \"marketplace_listings\" set \"buyer_id\" = null where \"buyer_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, query)
@@ -5707,35 +11080,35 @@ func (o *User) SetFromServiceAssetServiceTransferEvents(exec boil.Executor, inse
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.FromServiceAssetServiceTransferEvents {
-			queries.SetScanner(&rel.FromService, nil)
+		for _, rel := range o.R.BuyerMarketplaceListings {
+			queries.SetScanner(&rel.BuyerID, nil)
 			if rel.R == nil {
 				continue
 			}
 
-			rel.R.FromServiceUser = nil
+			rel.R.Buyer = nil
 		}
 
-		o.R.FromServiceAssetServiceTransferEvents = nil
+		o.R.BuyerMarketplaceListings = nil
 	}
-	return o.AddFromServiceAssetServiceTransferEvents(exec, insert, related...)
+	return o.AddBuyerMarketplaceListings(exec, insert, related...)
 }
 
-// RemoveFromServiceAssetServiceTransferEvents relationships from objects passed in.
-// Removes related items from R.FromServiceAssetServiceTransferEvents (uses pointer comparison, removal does not keep order)
-// Sets related.R.FromServiceUser.
-func (o *User) RemoveFromServiceAssetServiceTransferEvents(exec boil.Executor, related ...*AssetServiceTransferEvent) error {
+// RemoveBuyerMarketplaceListings relationships from objects passed in.
+// Removes related items from R.BuyerMarketplaceListings (uses pointer comparison, removal does not keep order)
+// Sets related.R.Buyer.
+func (o *User) RemoveBuyerMarketplaceListings(exec boil.Executor, related ...*MarketplaceListing) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.FromService, nil)
+		queries.SetScanner(&rel.BuyerID, nil)
 		if rel.R != nil {
-			rel.R.FromServiceUser = nil
+			rel.R.Buyer = nil
 		}
-		if _, err = rel.Update(exec, boil.Whitelist("from_service")); err != nil {
+		if _, err = rel.Update(exec, boil.Whitelist("buyer_id")); err != nil {
 			return err
 		}
 	}
@@ -5744,16 +11117,16 @@ func (o *User) RemoveFromServiceAssetServiceTransferEvents(exec boil.Executor, r
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.FromServiceAssetServiceTransferEvents {
+		for i, ri := range o.R.BuyerMarketplaceListings {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.FromServiceAssetServiceTransferEvents)
+			ln := len(o.R.BuyerMarketplaceListings)
 			if ln > 1 && i < ln-1 {
-				o.R.FromServiceAssetServiceTransferEvents[i] = o.R.FromServiceAssetServiceTransferEvents[ln-1]
+				o.R.BuyerMarketplaceListings[i] = o.R.BuyerMarketplaceListings[ln-1]
 			}
-			o.R.FromServiceAssetServiceTransferEvents = o.R.FromServiceAssetServiceTransferEvents[:ln-1]
+			o.R.BuyerMarketplaceListings = o.R.BuyerMarketplaceListings[:ln-1]
 			break
 		}
 	}
@@ -5761,23 +11134,23 @@ func (o *User) RemoveFromServiceAssetServiceTransferEvents(exec boil.Executor, r
 	return nil
 }
 
-// AddToServiceAssetServiceTransferEvents adds the given related objects to the existing relationships
+// AddCurrentBidderMarketplaceListings adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ToServiceAssetServiceTransferEvents.
-// Sets related.R.ToServiceUser appropriately.
-func (o *User) AddToServiceAssetServiceTransferEvents(exec boil.Executor, insert bool, related ...*AssetServiceTransferEvent) error {
+// Appends related to o.R.CurrentBidderMarketplaceListings.
+// Sets related.R.CurrentBidder appropriately.
+func (o *User) AddCurrentBidderMarketplaceListings(exec boil.Executor, insert bool, related ...*MarketplaceListing) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.ToService, o.ID)
+			queries.Assign(&rel.CurrentBidderID, o.ID)
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"asset_service_transfer_events\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"to_service"}),
-				strmangle.WhereClause("\"", "\"", 2, assetServiceTransferEventPrimaryKeyColumns),
+				"UPDATE \"marketplace_listings\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"current_bidder_id"}),
+				strmangle.WhereClause("\"", "\"", 2, marketplaceListingPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -5789,38 +11162,38 @@ func (o *User) AddToServiceAssetServiceTransferEvents(exec boil.Executor, insert
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.ToService, o.ID)
+			queries.Assign(&rel.CurrentBidderID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			ToServiceAssetServiceTransferEvents: related,
+			CurrentBidderMarketplaceListings: related,
 		}
 	} else {
-		o.R.ToServiceAssetServiceTransferEvents = append(o.R.ToServiceAssetServiceTransferEvents, related...)
+		o.R.CurrentBidderMarketplaceListings = append(o.R.CurrentBidderMarketplaceListings, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &assetServiceTransferEventR{
-				ToServiceUser: o,
+			rel.R = &marketplaceListingR{
+				CurrentBidder: o,
 			}
 		} else {
-			rel.R.ToServiceUser = o
+			rel.R.CurrentBidder = o
 		}
 	}
 	return nil
 }
 
-// SetToServiceAssetServiceTransferEvents removes all previously related items of the
+// SetCurrentBidderMarketplaceListings removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.ToServiceUser's ToServiceAssetServiceTransferEvents accordingly.
-// Replaces o.R.ToServiceAssetServiceTransferEvents with related.
-// Sets related.R.ToServiceUser's ToServiceAssetServiceTransferEvents accordingly.
-func (o *User) SetToServiceAssetServiceTransferEvents(exec boil.Executor, insert bool, related ...*AssetServiceTransferEvent) error {
-	query := "update \"asset_service_transfer_events\" set \"to_service\" = null where \"to_service\" = $1"
+// Sets o.R.CurrentBidder's CurrentBidderMarketplaceListings accordingly.
+// Replaces o.R.CurrentBidderMarketplaceListings with related.
+// Sets related.R.CurrentBidder's CurrentBidderMarketplaceListings accordingly.
+func (o *User) SetCurrentBidderMarketplaceListings(exec boil.Executor, insert bool, related ...*MarketplaceListing) error {
+	query := "update \"marketplace_listings\" set \"current_bidder_id\" = null where \"current_bidder_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, query)
@@ -5832,35 +11205,35 @@ func (o *User) SetToServiceAssetServiceTransferEvents(exec boil.Executor, insert
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.ToServiceAssetServiceTransferEvents {
-			queries.SetScanner(&rel.ToService, nil)
+		for _, rel := range o.R.CurrentBidderMarketplaceListings {
+			queries.SetScanner(&rel.CurrentBidderID, nil)
 			if rel.R == nil {
 				continue
 			}
 
-			rel.R.ToServiceUser = nil
+			rel.R.CurrentBidder = nil
 		}
 
-		o.R.ToServiceAssetServiceTransferEvents = nil
+		o.R.CurrentBidderMarketplaceListings = nil
 	}
-	return o.AddToServiceAssetServiceTransferEvents(exec, insert, related...)
+	return o.AddCurrentBidderMarketplaceListings(exec, insert, related...)
 }
 
-// RemoveToServiceAssetServiceTransferEvents relationships from objects passed in.
-// Removes related items from R.ToServiceAssetServiceTransferEvents (uses pointer comparison, removal does not keep order)
-// Sets related.R.ToServiceUser.
-func (o *User) RemoveToServiceAssetServiceTransferEvents(exec boil.Executor, related ...*AssetServiceTransferEvent) error {
+// RemoveCurrentBidderMarketplaceListings relationships from objects passed in.
+// Removes related items from R.CurrentBidderMarketplaceListings (uses pointer comparison, removal does not keep order)
+// Sets related.R.CurrentBidder.
+func (o *User) RemoveCurrentBidderMarketplaceListings(exec boil.Executor, related ...*MarketplaceListing) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.ToService, nil)
+		queries.SetScanner(&rel.CurrentBidderID, nil)
 		if rel.R != nil {
-			rel.R.ToServiceUser = nil
+			rel.R.CurrentBidder = nil
 		}
-		if _, err = rel.Update(exec, boil.Whitelist("to_service")); err != nil {
+		if _, err = rel.Update(exec, boil.Whitelist("current_bidder_id")); err != nil {
 			return err
 		}
 	}
@@ -5869,16 +11242,16 @@ func (o *User) RemoveToServiceAssetServiceTransferEvents(exec boil.Executor, rel
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.ToServiceAssetServiceTransferEvents {
+		for i, ri := range o.R.CurrentBidderMarketplaceListings {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.ToServiceAssetServiceTransferEvents)
+			ln := len(o.R.CurrentBidderMarketplaceListings)
 			if ln > 1 && i < ln-1 {
-				o.R.ToServiceAssetServiceTransferEvents[i] = o.R.ToServiceAssetServiceTransferEvents[ln-1]
+				o.R.CurrentBidderMarketplaceListings[i] = o.R.CurrentBidderMarketplaceListings[ln-1]
 			}
-			o.R.ToServiceAssetServiceTransferEvents = o.R.ToServiceAssetServiceTransferEvents[:ln-1]
+			o.R.CurrentBidderMarketplaceListings = o.R.CurrentBidderMarketplaceListings[:ln-1]
 			break
 		}
 	}
@@ -5886,23 +11259,23 @@ func (o *User) RemoveToServiceAssetServiceTransferEvents(exec boil.Executor, rel
 	return nil
 }
 
-// AddAssetServiceTransferEvents adds the given related objects to the existing relationships
+// AddSellerMarketplaceListings adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.AssetServiceTransferEvents.
-// Sets related.R.User appropriately.
-func (o *User) AddAssetServiceTransferEvents(exec boil.Executor, insert bool, related ...*AssetServiceTransferEvent) error {
+// Appends related to o.R.SellerMarketplaceListings.
+// Sets related.R.Seller appropriately.
+func (o *User) AddSellerMarketplaceListings(exec boil.Executor, insert bool, related ...*MarketplaceListing) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserID = o.ID
+			rel.SellerID = o.ID
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"asset_service_transfer_events\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, assetServiceTransferEventPrimaryKeyColumns),
+				"UPDATE \"marketplace_listings\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"seller_id"}),
+				strmangle.WhereClause("\"", "\"", 2, marketplaceListingPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -5914,47 +11287,47 @@ func (o *User) AddAssetServiceTransferEvents(exec boil.Executor, insert bool, re
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserID = o.ID
+			rel.SellerID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			AssetServiceTransferEvents: related,
+			SellerMarketplaceListings: related,
 		}
 	} else {
-		o.R.AssetServiceTransferEvents = append(o.R.AssetServiceTransferEvents, related...)
+		o.R.SellerMarketplaceListings = append(o.R.SellerMarketplaceListings, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &assetServiceTransferEventR{
-				User: o,
+			rel.R = &marketplaceListingR{
+				Seller: o,
 			}
 		} else {
-			rel.R.User = o
+			rel.R.Seller = o
 		}
 	}
 	return nil
 }
 
-// AddFromUserAssetTransferEvents adds the given related objects to the existing relationships
+// AddPending1155Rollbacks adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.FromUserAssetTransferEvents.
-// Sets related.R.FromUser appropriately.
-func (o *User) AddFromUserAssetTransferEvents(exec boil.Executor, insert bool, related ...*AssetTransferEvent) error {
+// Appends related to o.R.Pending1155Rollbacks.
+// Sets related.R.User appropriately.
+func (o *User) AddPending1155Rollbacks(exec boil.Executor, insert bool, related ...*Pending1155Rollback) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.FromUserID = o.ID
+			rel.UserID = o.ID
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"asset_transfer_events\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"from_user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, assetTransferEventPrimaryKeyColumns),
+				"UPDATE \"pending_1155_rollback\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pending1155RollbackPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -5966,47 +11339,47 @@ func (o *User) AddFromUserAssetTransferEvents(exec boil.Executor, insert bool, r
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.FromUserID = o.ID
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			FromUserAssetTransferEvents: related,
+			Pending1155Rollbacks: related,
 		}
 	} else {
-		o.R.FromUserAssetTransferEvents = append(o.R.FromUserAssetTransferEvents, related...)
+		o.R.Pending1155Rollbacks = append(o.R.Pending1155Rollbacks, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &assetTransferEventR{
-				FromUser: o,
+			rel.R = &pending1155RollbackR{
+				User: o,
 			}
 		} else {
-			rel.R.FromUser = o
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// AddToUserAssetTransferEvents adds the given related objects to the existing relationships
+// AddPendingRefunds adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ToUserAssetTransferEvents.
-// Sets related.R.ToUser appropriately.
-func (o *User) AddToUserAssetTransferEvents(exec boil.Executor, insert bool, related ...*AssetTransferEvent) error {
+// Appends related to o.R.PendingRefunds.
+// Sets related.R.User appropriately.
+func (o *User) AddPendingRefunds(exec boil.Executor, insert bool, related ...*PendingRefund) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.ToUserID = o.ID
+			rel.UserID = o.ID
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"asset_transfer_events\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"to_user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, assetTransferEventPrimaryKeyColumns),
+				"UPDATE \"pending_refund\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pendingRefundPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -6018,47 +11391,47 @@ func (o *User) AddToUserAssetTransferEvents(exec boil.Executor, insert bool, rel
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.ToUserID = o.ID
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			ToUserAssetTransferEvents: related,
+			PendingRefunds: related,
 		}
 	} else {
-		o.R.ToUserAssetTransferEvents = append(o.R.ToUserAssetTransferEvents, related...)
+		o.R.PendingRefunds = append(o.R.PendingRefunds, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &assetTransferEventR{
-				ToUser: o,
+			rel.R = &pendingRefundR{
+				User: o,
 			}
 		} else {
-			rel.R.ToUser = o
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// AddDepositAsset1155Transactions adds the given related objects to the existing relationships
+// AddOperatorUserPendingWithdrawActions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.DepositAsset1155Transactions.
-// Sets related.R.User appropriately.
-func (o *User) AddDepositAsset1155Transactions(exec boil.Executor, insert bool, related ...*DepositAsset1155Transaction) error {
+// Appends related to o.R.OperatorUserPendingWithdrawActions.
+// Sets related.R.OperatorUser appropriately.
+func (o *User) AddOperatorUserPendingWithdrawActions(exec boil.Executor, insert bool, related ...*PendingWithdrawAction) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserID = o.ID
+			queries.Assign(&rel.OperatorUserID, o.ID)
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"deposit_asset1155_transactions\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, depositAsset1155TransactionPrimaryKeyColumns),
+				"UPDATE \"pending_withdraw_actions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"operator_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pendingWithdrawActionPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -6070,99 +11443,120 @@ func (o *User) AddDepositAsset1155Transactions(exec boil.Executor, insert bool,
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserID = o.ID
+			queries.Assign(&rel.OperatorUserID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			DepositAsset1155Transactions: related,
+			OperatorUserPendingWithdrawActions: related,
 		}
 	} else {
-		o.R.DepositAsset1155Transactions = append(o.R.DepositAsset1155Transactions, related...)
+		o.R.OperatorUserPendingWithdrawActions = append(o.R.OperatorUserPendingWithdrawActions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &depositAsset1155TransactionR{
-				User: o,
+			rel.R = &pendingWithdrawActionR{
+				OperatorUser: o,
 			}
 		} else {
-			rel.R.User = o
+			rel.R.OperatorUser = o
 		}
 	}
 	return nil
 }
 
-// AddDepositTransactions adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.DepositTransactions.
-// Sets related.R.User appropriately.
-func (o *User) AddDepositTransactions(exec boil.Executor, insert bool, related ...*DepositTransaction) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.UserID = o.ID
-			if err = rel.Insert(exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"deposit_transactions\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, depositTransactionPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+// SetOperatorUserPendingWithdrawActions removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.OperatorUser's OperatorUserPendingWithdrawActions accordingly.
+// Replaces o.R.OperatorUserPendingWithdrawActions with related.
+// Sets related.R.OperatorUser's OperatorUserPendingWithdrawActions accordingly.
+func (o *User) SetOperatorUserPendingWithdrawActions(exec boil.Executor, insert bool, related ...*PendingWithdrawAction) error {
+	query := "update \"pending_withdraw_actions\" set \"operator_user_id\" = null where \"operator_user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
 
-			if boil.DebugMode {
-				fmt.Fprintln(boil.DebugWriter, updateQuery)
-				fmt.Fprintln(boil.DebugWriter, values)
-			}
-			if _, err = exec.Exec(updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+	if o.R != nil {
+		for _, rel := range o.R.OperatorUserPendingWithdrawActions {
+			queries.SetScanner(&rel.OperatorUserID, nil)
+			if rel.R == nil {
+				continue
 			}
 
-			rel.UserID = o.ID
+			rel.R.OperatorUser = nil
 		}
+
+		o.R.OperatorUserPendingWithdrawActions = nil
 	}
+	return o.AddOperatorUserPendingWithdrawActions(exec, insert, related...)
+}
 
-	if o.R == nil {
-		o.R = &userR{
-			DepositTransactions: related,
+// RemoveOperatorUserPendingWithdrawActions relationships from objects passed in.
+// Removes related items from R.OperatorUserPendingWithdrawActions (uses pointer comparison, removal does not keep order)
+// Sets related.R.OperatorUser.
+func (o *User) RemoveOperatorUserPendingWithdrawActions(exec boil.Executor, related ...*PendingWithdrawAction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.OperatorUserID, nil)
+		if rel.R != nil {
+			rel.R.OperatorUser = nil
 		}
-	} else {
-		o.R.DepositTransactions = append(o.R.DepositTransactions, related...)
+		if _, err = rel.Update(exec, boil.Whitelist("operator_user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
 	}
 
 	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &depositTransactionR{
-				User: o,
+		for i, ri := range o.R.OperatorUserPendingWithdrawActions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.OperatorUserPendingWithdrawActions)
+			if ln > 1 && i < ln-1 {
+				o.R.OperatorUserPendingWithdrawActions[i] = o.R.OperatorUserPendingWithdrawActions[ln-1]
 			}
-		} else {
-			rel.R.User = o
+			o.R.OperatorUserPendingWithdrawActions = o.R.OperatorUserPendingWithdrawActions[:ln-1]
+			break
 		}
 	}
+
 	return nil
 }
 
-// AddCreditFailedTransactions adds the given related objects to the existing relationships
+// AddOwnerPurchasedItemsOlds adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.CreditFailedTransactions.
-// Sets related.R.CreditUser appropriately.
-func (o *User) AddCreditFailedTransactions(exec boil.Executor, insert bool, related ...*FailedTransaction) error {
+// Appends related to o.R.OwnerPurchasedItemsOlds.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerPurchasedItemsOlds(exec boil.Executor, insert bool, related ...*PurchasedItemsOld) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.Credit = o.ID
+			rel.OwnerID = o.ID
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"failed_transactions\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"credit"}),
-				strmangle.WhereClause("\"", "\"", 2, failedTransactionPrimaryKeyColumns),
+				"UPDATE \"purchased_items_old\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, purchasedItemsOldPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -6174,47 +11568,47 @@ func (o *User) AddCreditFailedTransactions(exec boil.Executor, insert bool, rela
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.Credit = o.ID
+			rel.OwnerID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			CreditFailedTransactions: related,
+			OwnerPurchasedItemsOlds: related,
 		}
 	} else {
-		o.R.CreditFailedTransactions = append(o.R.CreditFailedTransactions, related...)
+		o.R.OwnerPurchasedItemsOlds = append(o.R.OwnerPurchasedItemsOlds, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &failedTransactionR{
-				CreditUser: o,
+			rel.R = &purchasedItemsOldR{
+				Owner: o,
 			}
 		} else {
-			rel.R.CreditUser = o
+			rel.R.Owner = o
 		}
 	}
 	return nil
 }
 
-// AddDebitFailedTransactions adds the given related objects to the existing relationships
+// AddResolvedByReconciliationDiscrepancies adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.DebitFailedTransactions.
-// Sets related.R.DebitUser appropriately.
-func (o *User) AddDebitFailedTransactions(exec boil.Executor, insert bool, related ...*FailedTransaction) error {
+// Appends related to o.R.ResolvedByReconciliationDiscrepancies.
+// Sets related.R.ResolvedBy appropriately.
+func (o *User) AddResolvedByReconciliationDiscrepancies(exec boil.Executor, insert bool, related ...*ReconciliationDiscrepancy) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.Debit = o.ID
+			queries.Assign(&rel.ResolvedByID, o.ID)
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"failed_transactions\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"debit"}),
-				strmangle.WhereClause("\"", "\"", 2, failedTransactionPrimaryKeyColumns),
+				"UPDATE \"reconciliation_discrepancies\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"resolved_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reconciliationDiscrepancyPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -6226,99 +11620,120 @@ func (o *User) AddDebitFailedTransactions(exec boil.Executor, insert bool, relat
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.Debit = o.ID
+			queries.Assign(&rel.ResolvedByID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			DebitFailedTransactions: related,
+			ResolvedByReconciliationDiscrepancies: related,
 		}
 	} else {
-		o.R.DebitFailedTransactions = append(o.R.DebitFailedTransactions, related...)
+		o.R.ResolvedByReconciliationDiscrepancies = append(o.R.ResolvedByReconciliationDiscrepancies, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &failedTransactionR{
-				DebitUser: o,
+			rel.R = &reconciliationDiscrepancyR{
+				ResolvedBy: o,
 			}
 		} else {
-			rel.R.DebitUser = o
+			rel.R.ResolvedBy = o
 		}
 	}
 	return nil
 }
 
-// AddIssueTokens adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.IssueTokens.
-// Sets related.R.User appropriately.
-func (o *User) AddIssueTokens(exec boil.Executor, insert bool, related ...*IssueToken) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.UserID = o.ID
-			if err = rel.Insert(exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"issue_tokens\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, issueTokenPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+// SetResolvedByReconciliationDiscrepancies removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ResolvedBy's ResolvedByReconciliationDiscrepancies accordingly.
+// Replaces o.R.ResolvedByReconciliationDiscrepancies with related.
+// Sets related.R.ResolvedBy's ResolvedByReconciliationDiscrepancies accordingly.
+func (o *User) SetResolvedByReconciliationDiscrepancies(exec boil.Executor, insert bool, related ...*ReconciliationDiscrepancy) error {
+	query := "update \"reconciliation_discrepancies\" set \"resolved_by_id\" = null where \"resolved_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
 
-			if boil.DebugMode {
-				fmt.Fprintln(boil.DebugWriter, updateQuery)
-				fmt.Fprintln(boil.DebugWriter, values)
-			}
-			if _, err = exec.Exec(updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+	if o.R != nil {
+		for _, rel := range o.R.ResolvedByReconciliationDiscrepancies {
+			queries.SetScanner(&rel.ResolvedByID, nil)
+			if rel.R == nil {
+				continue
 			}
 
-			rel.UserID = o.ID
+			rel.R.ResolvedBy = nil
 		}
+
+		o.R.ResolvedByReconciliationDiscrepancies = nil
+	}
+	return o.AddResolvedByReconciliationDiscrepancies(exec, insert, related...)
+}
+
+// RemoveResolvedByReconciliationDiscrepancies relationships from objects passed in.
+// Removes related items from R.ResolvedByReconciliationDiscrepancies (uses pointer comparison, removal does not keep order)
+// Sets related.R.ResolvedBy.
+func (o *User) RemoveResolvedByReconciliationDiscrepancies(exec boil.Executor, related ...*ReconciliationDiscrepancy) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			IssueTokens: related,
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ResolvedByID, nil)
+		if rel.R != nil {
+			rel.R.ResolvedBy = nil
 		}
-	} else {
-		o.R.IssueTokens = append(o.R.IssueTokens, related...)
+		if _, err = rel.Update(exec, boil.Whitelist("resolved_by_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
 	}
 
 	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &issueTokenR{
-				User: o,
+		for i, ri := range o.R.ResolvedByReconciliationDiscrepancies {
+			if rel != ri {
+				continue
 			}
-		} else {
-			rel.R.User = o
+
+			ln := len(o.R.ResolvedByReconciliationDiscrepancies)
+			if ln > 1 && i < ln-1 {
+				o.R.ResolvedByReconciliationDiscrepancies[i] = o.R.ResolvedByReconciliationDiscrepancies[ln-1]
+			}
+			o.R.ResolvedByReconciliationDiscrepancies = o.R.ResolvedByReconciliationDiscrepancies[:ln-1]
+			break
 		}
 	}
+
 	return nil
 }
 
-// AddPending1155Rollbacks adds the given related objects to the existing relationships
+// AddCreatedByReconciliationRuns adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.Pending1155Rollbacks.
-// Sets related.R.User appropriately.
-func (o *User) AddPending1155Rollbacks(exec boil.Executor, insert bool, related ...*Pending1155Rollback) error {
+// Appends related to o.R.CreatedByReconciliationRuns.
+// Sets related.R.CreatedBy appropriately.
+func (o *User) AddCreatedByReconciliationRuns(exec boil.Executor, insert bool, related ...*ReconciliationRun) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserID = o.ID
+			rel.CreatedByID = o.ID
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"pending_1155_rollback\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, pending1155RollbackPrimaryKeyColumns),
+				"UPDATE \"reconciliation_runs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reconciliationRunPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -6330,49 +11745,49 @@ func (o *User) AddPending1155Rollbacks(exec boil.Executor, insert bool, related
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserID = o.ID
+			rel.CreatedByID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			Pending1155Rollbacks: related,
+			CreatedByReconciliationRuns: related,
 		}
 	} else {
-		o.R.Pending1155Rollbacks = append(o.R.Pending1155Rollbacks, related...)
+		o.R.CreatedByReconciliationRuns = append(o.R.CreatedByReconciliationRuns, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &pending1155RollbackR{
-				User: o,
+			rel.R = &reconciliationRunR{
+				CreatedBy: o,
 			}
 		} else {
-			rel.R.User = o
+			rel.R.CreatedBy = o
 		}
 	}
 	return nil
 }
 
-// AddPendingRefunds adds the given related objects to the existing relationships
+// AddMemberSyndicateAssetTransfers adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.PendingRefunds.
-// Sets related.R.User appropriately.
-func (o *User) AddPendingRefunds(exec boil.Executor, insert bool, related ...*PendingRefund) error {
+// Appends related to o.R.MemberSyndicateAssetTransfers.
+// Sets related.R.Member appropriately.
+func (o *User) AddMemberSyndicateAssetTransfers(exec boil.Executor, insert bool, related ...*SyndicateAssetTransfer) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserID = o.ID
+			rel.MemberID = o.ID
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"pending_refund\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, pendingRefundPrimaryKeyColumns),
+				"UPDATE \"syndicate_asset_transfers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"member_id"}),
+				strmangle.WhereClause("\"", "\"", 2, syndicateAssetTransferPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.ID}
+			values := []interface{}{o.ID, rel.TransferEventID}
 
 			if boil.DebugMode {
 				fmt.Fprintln(boil.DebugWriter, updateQuery)
@@ -6382,49 +11797,49 @@ func (o *User) AddPendingRefunds(exec boil.Executor, insert bool, related ...*Pe
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserID = o.ID
+			rel.MemberID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			PendingRefunds: related,
+			MemberSyndicateAssetTransfers: related,
 		}
 	} else {
-		o.R.PendingRefunds = append(o.R.PendingRefunds, related...)
+		o.R.MemberSyndicateAssetTransfers = append(o.R.MemberSyndicateAssetTransfers, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &pendingRefundR{
-				User: o,
+			rel.R = &syndicateAssetTransferR{
+				Member: o,
 			}
 		} else {
-			rel.R.User = o
+			rel.R.Member = o
 		}
 	}
 	return nil
 }
 
-// AddOwnerPurchasedItemsOlds adds the given related objects to the existing relationships
+// AddSyndicateMembers adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.OwnerPurchasedItemsOlds.
-// Sets related.R.Owner appropriately.
-func (o *User) AddOwnerPurchasedItemsOlds(exec boil.Executor, insert bool, related ...*PurchasedItemsOld) error {
+// Appends related to o.R.SyndicateMembers.
+// Sets related.R.User appropriately.
+func (o *User) AddSyndicateMembers(exec boil.Executor, insert bool, related ...*SyndicateMember) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.OwnerID = o.ID
+			rel.UserID = o.ID
 			if err = rel.Insert(exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"purchased_items_old\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
-				strmangle.WhereClause("\"", "\"", 2, purchasedItemsOldPrimaryKeyColumns),
+				"UPDATE \"syndicate_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, syndicateMemberPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.ID}
+			values := []interface{}{o.ID, rel.SyndicateID, rel.UserID}
 
 			if boil.DebugMode {
 				fmt.Fprintln(boil.DebugWriter, updateQuery)
@@ -6434,25 +11849,25 @@ func (o *User) AddOwnerPurchasedItemsOlds(exec boil.Executor, insert bool, relat
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.OwnerID = o.ID
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			OwnerPurchasedItemsOlds: related,
+			SyndicateMembers: related,
 		}
 	} else {
-		o.R.OwnerPurchasedItemsOlds = append(o.R.OwnerPurchasedItemsOlds, related...)
+		o.R.SyndicateMembers = append(o.R.SyndicateMembers, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &purchasedItemsOldR{
-				Owner: o,
+			rel.R = &syndicateMemberR{
+				User: o,
 			}
 		} else {
-			rel.R.Owner = o
+			rel.R.User = o
 		}
 	}
 	return nil
@@ -6916,6 +12331,131 @@ func (o *User) AddUserActivities(exec boil.Executor, insert bool, related ...*Us
 	return nil
 }
 
+// AddChangedByUserAssetOnChainStatusHistories adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ChangedByUserAssetOnChainStatusHistories.
+// Sets related.R.ChangedBy appropriately.
+func (o *User) AddChangedByUserAssetOnChainStatusHistories(exec boil.Executor, insert bool, related ...*UserAssetOnChainStatusHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ChangedByID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_asset_on_chain_status_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"changed_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userAssetOnChainStatusHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ChangedByID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ChangedByUserAssetOnChainStatusHistories: related,
+		}
+	} else {
+		o.R.ChangedByUserAssetOnChainStatusHistories = append(o.R.ChangedByUserAssetOnChainStatusHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userAssetOnChainStatusHistoryR{
+				ChangedBy: o,
+			}
+		} else {
+			rel.R.ChangedBy = o
+		}
+	}
+	return nil
+}
+
+// SetChangedByUserAssetOnChainStatusHistories removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ChangedBy's ChangedByUserAssetOnChainStatusHistories accordingly.
+// Replaces o.R.ChangedByUserAssetOnChainStatusHistories with related.
+// Sets related.R.ChangedBy's ChangedByUserAssetOnChainStatusHistories accordingly.
+func (o *User) SetChangedByUserAssetOnChainStatusHistories(exec boil.Executor, insert bool, related ...*UserAssetOnChainStatusHistory) error {
+	query := "update \"user_asset_on_chain_status_history\" set \"changed_by_id\" = null where \"changed_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ChangedByUserAssetOnChainStatusHistories {
+			queries.SetScanner(&rel.ChangedByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ChangedBy = nil
+		}
+
+		o.R.ChangedByUserAssetOnChainStatusHistories = nil
+	}
+	return o.AddChangedByUserAssetOnChainStatusHistories(exec, insert, related...)
+}
+
+// RemoveChangedByUserAssetOnChainStatusHistories relationships from objects passed in.
+// Removes related items from R.ChangedByUserAssetOnChainStatusHistories (uses pointer comparison, removal does not keep order)
+// Sets related.R.ChangedBy.
+func (o *User) RemoveChangedByUserAssetOnChainStatusHistories(exec boil.Executor, related ...*UserAssetOnChainStatusHistory) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ChangedByID, nil)
+		if rel.R != nil {
+			rel.R.ChangedBy = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("changed_by_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ChangedByUserAssetOnChainStatusHistories {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ChangedByUserAssetOnChainStatusHistories)
+			if ln > 1 && i < ln-1 {
+				o.R.ChangedByUserAssetOnChainStatusHistories[i] = o.R.ChangedByUserAssetOnChainStatusHistories[ln-1]
+			}
+			o.R.ChangedByUserAssetOnChainStatusHistories = o.R.ChangedByUserAssetOnChainStatusHistories[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddLockedToServiceUserAssets adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.LockedToServiceUserAssets.
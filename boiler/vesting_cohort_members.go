@@ -0,0 +1,858 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// VestingCohortMember is an object representing the database table.
+type VestingCohortMember struct {
+	PublicAddress string    `boiler:"public_address" boil:"public_address" json:"public_address" toml:"public_address" yaml:"public_address"`
+	Cohort        string    `boiler:"cohort" boil:"cohort" json:"cohort" toml:"cohort" yaml:"cohort"`
+	CreatedAt     time.Time `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *vestingCohortMemberR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L vestingCohortMemberL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var VestingCohortMemberColumns = struct {
+	PublicAddress string
+	Cohort        string
+	CreatedAt     string
+}{
+	PublicAddress: "public_address",
+	Cohort:        "cohort",
+	CreatedAt:     "created_at",
+}
+
+var VestingCohortMemberTableColumns = struct {
+	PublicAddress string
+	Cohort        string
+	CreatedAt     string
+}{
+	PublicAddress: "vesting_cohort_members.public_address",
+	Cohort:        "vesting_cohort_members.cohort",
+	CreatedAt:     "vesting_cohort_members.created_at",
+}
+
+// Generated where
+
+var VestingCohortMemberWhere = struct {
+	PublicAddress whereHelperstring
+	Cohort        whereHelperstring
+	CreatedAt     whereHelpertime_Time
+}{
+	PublicAddress: whereHelperstring{field: "\"vesting_cohort_members\".\"public_address\""},
+	Cohort:        whereHelperstring{field: "\"vesting_cohort_members\".\"cohort\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"vesting_cohort_members\".\"created_at\""},
+}
+
+// VestingCohortMemberRels is where relationship names are stored.
+var VestingCohortMemberRels = struct {
+}{}
+
+// vestingCohortMemberR is where relationships are stored.
+type vestingCohortMemberR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*vestingCohortMemberR) NewStruct() *vestingCohortMemberR {
+	return &vestingCohortMemberR{}
+}
+
+// vestingCohortMemberL is where Load methods for each relationship are stored.
+type vestingCohortMemberL struct{}
+
+var (
+	vestingCohortMemberAllColumns            = []string{"public_address", "cohort", "created_at"}
+	vestingCohortMemberColumnsWithoutDefault = []string{"public_address", "cohort"}
+	vestingCohortMemberColumnsWithDefault    = []string{"created_at"}
+	vestingCohortMemberPrimaryKeyColumns     = []string{"public_address"}
+	vestingCohortMemberGeneratedColumns      = []string{}
+)
+
+type (
+	// VestingCohortMemberSlice is an alias for a slice of pointers to VestingCohortMember.
+	// This should almost always be used instead of []VestingCohortMember.
+	VestingCohortMemberSlice []*VestingCohortMember
+	// VestingCohortMemberHook is the signature for custom VestingCohortMember hook methods
+	VestingCohortMemberHook func(boil.Executor, *VestingCohortMember) error
+
+	vestingCohortMemberQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	vestingCohortMemberType                 = reflect.TypeOf(&VestingCohortMember{})
+	vestingCohortMemberMapping              = queries.MakeStructMapping(vestingCohortMemberType)
+	vestingCohortMemberPrimaryKeyMapping, _ = queries.BindMapping(vestingCohortMemberType, vestingCohortMemberMapping, vestingCohortMemberPrimaryKeyColumns)
+	vestingCohortMemberInsertCacheMut       sync.RWMutex
+	vestingCohortMemberInsertCache          = make(map[string]insertCache)
+	vestingCohortMemberUpdateCacheMut       sync.RWMutex
+	vestingCohortMemberUpdateCache          = make(map[string]updateCache)
+	vestingCohortMemberUpsertCacheMut       sync.RWMutex
+	vestingCohortMemberUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var vestingCohortMemberAfterSelectHooks []VestingCohortMemberHook
+
+var vestingCohortMemberBeforeInsertHooks []VestingCohortMemberHook
+var vestingCohortMemberAfterInsertHooks []VestingCohortMemberHook
+
+var vestingCohortMemberBeforeUpdateHooks []VestingCohortMemberHook
+var vestingCohortMemberAfterUpdateHooks []VestingCohortMemberHook
+
+var vestingCohortMemberBeforeDeleteHooks []VestingCohortMemberHook
+var vestingCohortMemberAfterDeleteHooks []VestingCohortMemberHook
+
+var vestingCohortMemberBeforeUpsertHooks []VestingCohortMemberHook
+var vestingCohortMemberAfterUpsertHooks []VestingCohortMemberHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *VestingCohortMember) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingCohortMemberAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *VestingCohortMember) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingCohortMemberBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *VestingCohortMember) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingCohortMemberAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *VestingCohortMember) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingCohortMemberBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *VestingCohortMember) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingCohortMemberAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *VestingCohortMember) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingCohortMemberBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *VestingCohortMember) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingCohortMemberAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *VestingCohortMember) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingCohortMemberBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *VestingCohortMember) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingCohortMemberAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddVestingCohortMemberHook registers your hook function for all future operations.
+func AddVestingCohortMemberHook(hookPoint boil.HookPoint, vestingCohortMemberHook VestingCohortMemberHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		vestingCohortMemberAfterSelectHooks = append(vestingCohortMemberAfterSelectHooks, vestingCohortMemberHook)
+	case boil.BeforeInsertHook:
+		vestingCohortMemberBeforeInsertHooks = append(vestingCohortMemberBeforeInsertHooks, vestingCohortMemberHook)
+	case boil.AfterInsertHook:
+		vestingCohortMemberAfterInsertHooks = append(vestingCohortMemberAfterInsertHooks, vestingCohortMemberHook)
+	case boil.BeforeUpdateHook:
+		vestingCohortMemberBeforeUpdateHooks = append(vestingCohortMemberBeforeUpdateHooks, vestingCohortMemberHook)
+	case boil.AfterUpdateHook:
+		vestingCohortMemberAfterUpdateHooks = append(vestingCohortMemberAfterUpdateHooks, vestingCohortMemberHook)
+	case boil.BeforeDeleteHook:
+		vestingCohortMemberBeforeDeleteHooks = append(vestingCohortMemberBeforeDeleteHooks, vestingCohortMemberHook)
+	case boil.AfterDeleteHook:
+		vestingCohortMemberAfterDeleteHooks = append(vestingCohortMemberAfterDeleteHooks, vestingCohortMemberHook)
+	case boil.BeforeUpsertHook:
+		vestingCohortMemberBeforeUpsertHooks = append(vestingCohortMemberBeforeUpsertHooks, vestingCohortMemberHook)
+	case boil.AfterUpsertHook:
+		vestingCohortMemberAfterUpsertHooks = append(vestingCohortMemberAfterUpsertHooks, vestingCohortMemberHook)
+	}
+}
+
+// One returns a single vestingCohortMember record from the query.
+func (q vestingCohortMemberQuery) One(exec boil.Executor) (*VestingCohortMember, error) {
+	o := &VestingCohortMember{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for vesting_cohort_members")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all VestingCohortMember records from the query.
+func (q vestingCohortMemberQuery) All(exec boil.Executor) (VestingCohortMemberSlice, error) {
+	var o []*VestingCohortMember
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to VestingCohortMember slice")
+	}
+
+	if len(vestingCohortMemberAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all VestingCohortMember records in the query.
+func (q vestingCohortMemberQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count vesting_cohort_members rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q vestingCohortMemberQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if vesting_cohort_members exists")
+	}
+
+	return count > 0, nil
+}
+
+// VestingCohortMembers retrieves all the records using an executor.
+func VestingCohortMembers(mods ...qm.QueryMod) vestingCohortMemberQuery {
+	mods = append(mods, qm.From("\"vesting_cohort_members\""))
+	return vestingCohortMemberQuery{NewQuery(mods...)}
+}
+
+// FindVestingCohortMember retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindVestingCohortMember(exec boil.Executor, publicAddress string, selectCols ...string) (*VestingCohortMember, error) {
+	vestingCohortMemberObj := &VestingCohortMember{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"vesting_cohort_members\" where \"public_address\"=$1", sel,
+	)
+
+	q := queries.Raw(query, publicAddress)
+
+	err := q.Bind(nil, exec, vestingCohortMemberObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from vesting_cohort_members")
+	}
+
+	if err = vestingCohortMemberObj.doAfterSelectHooks(exec); err != nil {
+		return vestingCohortMemberObj, err
+	}
+
+	return vestingCohortMemberObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *VestingCohortMember) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no vesting_cohort_members provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(vestingCohortMemberColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	vestingCohortMemberInsertCacheMut.RLock()
+	cache, cached := vestingCohortMemberInsertCache[key]
+	vestingCohortMemberInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			vestingCohortMemberAllColumns,
+			vestingCohortMemberColumnsWithDefault,
+			vestingCohortMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(vestingCohortMemberType, vestingCohortMemberMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(vestingCohortMemberType, vestingCohortMemberMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"vesting_cohort_members\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"vesting_cohort_members\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into vesting_cohort_members")
+	}
+
+	if !cached {
+		vestingCohortMemberInsertCacheMut.Lock()
+		vestingCohortMemberInsertCache[key] = cache
+		vestingCohortMemberInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the VestingCohortMember.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *VestingCohortMember) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	vestingCohortMemberUpdateCacheMut.RLock()
+	cache, cached := vestingCohortMemberUpdateCache[key]
+	vestingCohortMemberUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			vestingCohortMemberAllColumns,
+			vestingCohortMemberPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update vesting_cohort_members, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"vesting_cohort_members\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, vestingCohortMemberPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(vestingCohortMemberType, vestingCohortMemberMapping, append(wl, vestingCohortMemberPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update vesting_cohort_members row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for vesting_cohort_members")
+	}
+
+	if !cached {
+		vestingCohortMemberUpdateCacheMut.Lock()
+		vestingCohortMemberUpdateCache[key] = cache
+		vestingCohortMemberUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q vestingCohortMemberQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for vesting_cohort_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for vesting_cohort_members")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o VestingCohortMemberSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vestingCohortMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"vesting_cohort_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, vestingCohortMemberPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in vestingCohortMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all vestingCohortMember")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *VestingCohortMember) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no vesting_cohort_members provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(vestingCohortMemberColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	vestingCohortMemberUpsertCacheMut.RLock()
+	cache, cached := vestingCohortMemberUpsertCache[key]
+	vestingCohortMemberUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			vestingCohortMemberAllColumns,
+			vestingCohortMemberColumnsWithDefault,
+			vestingCohortMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			vestingCohortMemberAllColumns,
+			vestingCohortMemberPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert vesting_cohort_members, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(vestingCohortMemberPrimaryKeyColumns))
+			copy(conflict, vestingCohortMemberPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"vesting_cohort_members\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(vestingCohortMemberType, vestingCohortMemberMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(vestingCohortMemberType, vestingCohortMemberMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert vesting_cohort_members")
+	}
+
+	if !cached {
+		vestingCohortMemberUpsertCacheMut.Lock()
+		vestingCohortMemberUpsertCache[key] = cache
+		vestingCohortMemberUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single VestingCohortMember record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *VestingCohortMember) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no VestingCohortMember provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), vestingCohortMemberPrimaryKeyMapping)
+	sql := "DELETE FROM \"vesting_cohort_members\" WHERE \"public_address\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from vesting_cohort_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for vesting_cohort_members")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q vestingCohortMemberQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no vestingCohortMemberQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from vesting_cohort_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for vesting_cohort_members")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o VestingCohortMemberSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(vestingCohortMemberBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vestingCohortMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"vesting_cohort_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, vestingCohortMemberPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from vestingCohortMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for vesting_cohort_members")
+	}
+
+	if len(vestingCohortMemberAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *VestingCohortMember) Reload(exec boil.Executor) error {
+	ret, err := FindVestingCohortMember(exec, o.PublicAddress)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *VestingCohortMemberSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := VestingCohortMemberSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vestingCohortMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"vesting_cohort_members\".* FROM \"vesting_cohort_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, vestingCohortMemberPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in VestingCohortMemberSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// VestingCohortMemberExists checks if the VestingCohortMember row exists.
+func VestingCohortMemberExists(exec boil.Executor, publicAddress string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"vesting_cohort_members\" where \"public_address\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, publicAddress)
+	}
+	row := exec.QueryRow(sql, publicAddress)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if vesting_cohort_members exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1156 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// VestingSchedule is an object representing the database table.
+type VestingSchedule struct {
+	ID            string          `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Label         string          `boiler:"label" boil:"label" json:"label" toml:"label" yaml:"label"`
+	PublicAddress null.String     `boiler:"public_address" boil:"public_address" json:"public_address,omitempty" toml:"public_address" yaml:"public_address,omitempty"`
+	Cohort        null.String     `boiler:"cohort" boil:"cohort" json:"cohort,omitempty" toml:"cohort" yaml:"cohort,omitempty"`
+	Unlimited     bool            `boiler:"unlimited" boil:"unlimited" json:"unlimited" toml:"unlimited" yaml:"unlimited"`
+	CliffAt       null.Time       `boiler:"cliff_at" boil:"cliff_at" json:"cliff_at,omitempty" toml:"cliff_at" yaml:"cliff_at,omitempty"`
+	CliffAmount   decimal.Decimal `boiler:"cliff_amount" boil:"cliff_amount" json:"cliff_amount" toml:"cliff_amount" yaml:"cliff_amount"`
+	DripStartAt   null.Time       `boiler:"drip_start_at" boil:"drip_start_at" json:"drip_start_at,omitempty" toml:"drip_start_at" yaml:"drip_start_at,omitempty"`
+	DripEndAt     null.Time       `boiler:"drip_end_at" boil:"drip_end_at" json:"drip_end_at,omitempty" toml:"drip_end_at" yaml:"drip_end_at,omitempty"`
+	DripAmount    decimal.Decimal `boiler:"drip_amount" boil:"drip_amount" json:"drip_amount" toml:"drip_amount" yaml:"drip_amount"`
+	CreatedAt     time.Time       `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time       `boiler:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt     null.Time       `boiler:"deleted_at" boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *vestingScheduleR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L vestingScheduleL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var VestingScheduleColumns = struct {
+	ID            string
+	Label         string
+	PublicAddress string
+	Cohort        string
+	Unlimited     string
+	CliffAt       string
+	CliffAmount   string
+	DripStartAt   string
+	DripEndAt     string
+	DripAmount    string
+	CreatedAt     string
+	UpdatedAt     string
+	DeletedAt     string
+}{
+	ID:            "id",
+	Label:         "label",
+	PublicAddress: "public_address",
+	Cohort:        "cohort",
+	Unlimited:     "unlimited",
+	CliffAt:       "cliff_at",
+	CliffAmount:   "cliff_amount",
+	DripStartAt:   "drip_start_at",
+	DripEndAt:     "drip_end_at",
+	DripAmount:    "drip_amount",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+	DeletedAt:     "deleted_at",
+}
+
+var VestingScheduleTableColumns = struct {
+	ID            string
+	Label         string
+	PublicAddress string
+	Cohort        string
+	Unlimited     string
+	CliffAt       string
+	CliffAmount   string
+	DripStartAt   string
+	DripEndAt     string
+	DripAmount    string
+	CreatedAt     string
+	UpdatedAt     string
+	DeletedAt     string
+}{
+	ID:            "vesting_schedules.id",
+	Label:         "vesting_schedules.label",
+	PublicAddress: "vesting_schedules.public_address",
+	Cohort:        "vesting_schedules.cohort",
+	Unlimited:     "vesting_schedules.unlimited",
+	CliffAt:       "vesting_schedules.cliff_at",
+	CliffAmount:   "vesting_schedules.cliff_amount",
+	DripStartAt:   "vesting_schedules.drip_start_at",
+	DripEndAt:     "vesting_schedules.drip_end_at",
+	DripAmount:    "vesting_schedules.drip_amount",
+	CreatedAt:     "vesting_schedules.created_at",
+	UpdatedAt:     "vesting_schedules.updated_at",
+	DeletedAt:     "vesting_schedules.deleted_at",
+}
+
+// Generated where
+
+var VestingScheduleWhere = struct {
+	ID            whereHelperstring
+	Label         whereHelperstring
+	PublicAddress whereHelpernull_String
+	Cohort        whereHelpernull_String
+	Unlimited     whereHelperbool
+	CliffAt       whereHelpernull_Time
+	CliffAmount   whereHelperdecimal_Decimal
+	DripStartAt   whereHelpernull_Time
+	DripEndAt     whereHelpernull_Time
+	DripAmount    whereHelperdecimal_Decimal
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+	DeletedAt     whereHelpernull_Time
+}{
+	ID:            whereHelperstring{field: "\"vesting_schedules\".\"id\""},
+	Label:         whereHelperstring{field: "\"vesting_schedules\".\"label\""},
+	PublicAddress: whereHelpernull_String{field: "\"vesting_schedules\".\"public_address\""},
+	Cohort:        whereHelpernull_String{field: "\"vesting_schedules\".\"cohort\""},
+	Unlimited:     whereHelperbool{field: "\"vesting_schedules\".\"unlimited\""},
+	CliffAt:       whereHelpernull_Time{field: "\"vesting_schedules\".\"cliff_at\""},
+	CliffAmount:   whereHelperdecimal_Decimal{field: "\"vesting_schedules\".\"cliff_amount\""},
+	DripStartAt:   whereHelpernull_Time{field: "\"vesting_schedules\".\"drip_start_at\""},
+	DripEndAt:     whereHelpernull_Time{field: "\"vesting_schedules\".\"drip_end_at\""},
+	DripAmount:    whereHelperdecimal_Decimal{field: "\"vesting_schedules\".\"drip_amount\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"vesting_schedules\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"vesting_schedules\".\"updated_at\""},
+	DeletedAt:     whereHelpernull_Time{field: "\"vesting_schedules\".\"deleted_at\""},
+}
+
+// VestingScheduleRels is where relationship names are stored.
+var VestingScheduleRels = struct {
+	ScheduleVestingUnlockEvents string
+}{
+	ScheduleVestingUnlockEvents: "ScheduleVestingUnlockEvents",
+}
+
+// vestingScheduleR is where relationships are stored.
+type vestingScheduleR struct {
+	ScheduleVestingUnlockEvents VestingUnlockEventSlice `boiler:"ScheduleVestingUnlockEvents" boil:"ScheduleVestingUnlockEvents" json:"ScheduleVestingUnlockEvents" toml:"ScheduleVestingUnlockEvents" yaml:"ScheduleVestingUnlockEvents"`
+}
+
+// NewStruct creates a new relationship struct
+func (*vestingScheduleR) NewStruct() *vestingScheduleR {
+	return &vestingScheduleR{}
+}
+
+// vestingScheduleL is where Load methods for each relationship are stored.
+type vestingScheduleL struct{}
+
+var (
+	vestingScheduleAllColumns            = []string{"id", "label", "public_address", "cohort", "unlimited", "cliff_at", "cliff_amount", "drip_start_at", "drip_end_at", "drip_amount", "created_at", "updated_at", "deleted_at"}
+	vestingScheduleColumnsWithoutDefault = []string{"label"}
+	vestingScheduleColumnsWithDefault    = []string{"id", "public_address", "cohort", "unlimited", "cliff_at", "cliff_amount", "drip_start_at", "drip_end_at", "drip_amount", "created_at", "updated_at", "deleted_at"}
+	vestingSchedulePrimaryKeyColumns     = []string{"id"}
+	vestingScheduleGeneratedColumns      = []string{}
+)
+
+type (
+	// VestingScheduleSlice is an alias for a slice of pointers to VestingSchedule.
+	// This should almost always be used instead of []VestingSchedule.
+	VestingScheduleSlice []*VestingSchedule
+	// VestingScheduleHook is the signature for custom VestingSchedule hook methods
+	VestingScheduleHook func(boil.Executor, *VestingSchedule) error
+
+	vestingScheduleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	vestingScheduleType                 = reflect.TypeOf(&VestingSchedule{})
+	vestingScheduleMapping              = queries.MakeStructMapping(vestingScheduleType)
+	vestingSchedulePrimaryKeyMapping, _ = queries.BindMapping(vestingScheduleType, vestingScheduleMapping, vestingSchedulePrimaryKeyColumns)
+	vestingScheduleInsertCacheMut       sync.RWMutex
+	vestingScheduleInsertCache          = make(map[string]insertCache)
+	vestingScheduleUpdateCacheMut       sync.RWMutex
+	vestingScheduleUpdateCache          = make(map[string]updateCache)
+	vestingScheduleUpsertCacheMut       sync.RWMutex
+	vestingScheduleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var vestingScheduleAfterSelectHooks []VestingScheduleHook
+
+var vestingScheduleBeforeInsertHooks []VestingScheduleHook
+var vestingScheduleAfterInsertHooks []VestingScheduleHook
+
+var vestingScheduleBeforeUpdateHooks []VestingScheduleHook
+var vestingScheduleAfterUpdateHooks []VestingScheduleHook
+
+var vestingScheduleBeforeDeleteHooks []VestingScheduleHook
+var vestingScheduleAfterDeleteHooks []VestingScheduleHook
+
+var vestingScheduleBeforeUpsertHooks []VestingScheduleHook
+var vestingScheduleAfterUpsertHooks []VestingScheduleHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *VestingSchedule) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingScheduleAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *VestingSchedule) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingScheduleBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *VestingSchedule) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingScheduleAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *VestingSchedule) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingScheduleBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *VestingSchedule) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingScheduleAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *VestingSchedule) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingScheduleBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *VestingSchedule) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingScheduleAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *VestingSchedule) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingScheduleBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *VestingSchedule) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingScheduleAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddVestingScheduleHook registers your hook function for all future operations.
+func AddVestingScheduleHook(hookPoint boil.HookPoint, vestingScheduleHook VestingScheduleHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		vestingScheduleAfterSelectHooks = append(vestingScheduleAfterSelectHooks, vestingScheduleHook)
+	case boil.BeforeInsertHook:
+		vestingScheduleBeforeInsertHooks = append(vestingScheduleBeforeInsertHooks, vestingScheduleHook)
+	case boil.AfterInsertHook:
+		vestingScheduleAfterInsertHooks = append(vestingScheduleAfterInsertHooks, vestingScheduleHook)
+	case boil.BeforeUpdateHook:
+		vestingScheduleBeforeUpdateHooks = append(vestingScheduleBeforeUpdateHooks, vestingScheduleHook)
+	case boil.AfterUpdateHook:
+		vestingScheduleAfterUpdateHooks = append(vestingScheduleAfterUpdateHooks, vestingScheduleHook)
+	case boil.BeforeDeleteHook:
+		vestingScheduleBeforeDeleteHooks = append(vestingScheduleBeforeDeleteHooks, vestingScheduleHook)
+	case boil.AfterDeleteHook:
+		vestingScheduleAfterDeleteHooks = append(vestingScheduleAfterDeleteHooks, vestingScheduleHook)
+	case boil.BeforeUpsertHook:
+		vestingScheduleBeforeUpsertHooks = append(vestingScheduleBeforeUpsertHooks, vestingScheduleHook)
+	case boil.AfterUpsertHook:
+		vestingScheduleAfterUpsertHooks = append(vestingScheduleAfterUpsertHooks, vestingScheduleHook)
+	}
+}
+
+// One returns a single vestingSchedule record from the query.
+func (q vestingScheduleQuery) One(exec boil.Executor) (*VestingSchedule, error) {
+	o := &VestingSchedule{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for vesting_schedules")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all VestingSchedule records from the query.
+func (q vestingScheduleQuery) All(exec boil.Executor) (VestingScheduleSlice, error) {
+	var o []*VestingSchedule
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to VestingSchedule slice")
+	}
+
+	if len(vestingScheduleAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all VestingSchedule records in the query.
+func (q vestingScheduleQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count vesting_schedules rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q vestingScheduleQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if vesting_schedules exists")
+	}
+
+	return count > 0, nil
+}
+
+// ScheduleVestingUnlockEvents retrieves all the vesting_unlock_event's VestingUnlockEvents with an executor via schedule_id column.
+func (o *VestingSchedule) ScheduleVestingUnlockEvents(mods ...qm.QueryMod) vestingUnlockEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"vesting_unlock_events\".\"schedule_id\"=?", o.ID),
+		qmhelper.WhereIsNull("\"vesting_unlock_events\".\"deleted_at\""),
+	)
+
+	query := VestingUnlockEvents(queryMods...)
+	queries.SetFrom(query.Query, "\"vesting_unlock_events\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"vesting_unlock_events\".*"})
+	}
+
+	return query
+}
+
+// LoadScheduleVestingUnlockEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (vestingScheduleL) LoadScheduleVestingUnlockEvents(e boil.Executor, singular bool, maybeVestingSchedule interface{}, mods queries.Applicator) error {
+	var slice []*VestingSchedule
+	var object *VestingSchedule
+
+	if singular {
+		object = maybeVestingSchedule.(*VestingSchedule)
+	} else {
+		slice = *maybeVestingSchedule.(*[]*VestingSchedule)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &vestingScheduleR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &vestingScheduleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`vesting_unlock_events`),
+		qm.WhereIn(`vesting_unlock_events.schedule_id in ?`, args...),
+		qmhelper.WhereIsNull(`vesting_unlock_events.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load vesting_unlock_events")
+	}
+
+	var resultSlice []*VestingUnlockEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice vesting_unlock_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on vesting_unlock_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vesting_unlock_events")
+	}
+
+	if len(vestingUnlockEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ScheduleVestingUnlockEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &vestingUnlockEventR{}
+			}
+			foreign.R.Schedule = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ScheduleID {
+				local.R.ScheduleVestingUnlockEvents = append(local.R.ScheduleVestingUnlockEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &vestingUnlockEventR{}
+				}
+				foreign.R.Schedule = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddScheduleVestingUnlockEvents adds the given related objects to the existing relationships
+// of the vesting_schedule, optionally inserting them as new records.
+// Appends related to o.R.ScheduleVestingUnlockEvents.
+// Sets related.R.Schedule appropriately.
+func (o *VestingSchedule) AddScheduleVestingUnlockEvents(exec boil.Executor, insert bool, related ...*VestingUnlockEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ScheduleID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"vesting_unlock_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"schedule_id"}),
+				strmangle.WhereClause("\"", "\"", 2, vestingUnlockEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ScheduleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &vestingScheduleR{
+			ScheduleVestingUnlockEvents: related,
+		}
+	} else {
+		o.R.ScheduleVestingUnlockEvents = append(o.R.ScheduleVestingUnlockEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &vestingUnlockEventR{
+				Schedule: o,
+			}
+		} else {
+			rel.R.Schedule = o
+		}
+	}
+	return nil
+}
+
+// VestingSchedules retrieves all the records using an executor.
+func VestingSchedules(mods ...qm.QueryMod) vestingScheduleQuery {
+	mods = append(mods, qm.From("\"vesting_schedules\""), qmhelper.WhereIsNull("\"vesting_schedules\".\"deleted_at\""))
+	return vestingScheduleQuery{NewQuery(mods...)}
+}
+
+// FindVestingSchedule retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindVestingSchedule(exec boil.Executor, iD string, selectCols ...string) (*VestingSchedule, error) {
+	vestingScheduleObj := &VestingSchedule{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"vesting_schedules\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, vestingScheduleObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from vesting_schedules")
+	}
+
+	if err = vestingScheduleObj.doAfterSelectHooks(exec); err != nil {
+		return vestingScheduleObj, err
+	}
+
+	return vestingScheduleObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *VestingSchedule) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no vesting_schedules provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(vestingScheduleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	vestingScheduleInsertCacheMut.RLock()
+	cache, cached := vestingScheduleInsertCache[key]
+	vestingScheduleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			vestingScheduleAllColumns,
+			vestingScheduleColumnsWithDefault,
+			vestingScheduleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(vestingScheduleType, vestingScheduleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(vestingScheduleType, vestingScheduleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"vesting_schedules\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"vesting_schedules\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into vesting_schedules")
+	}
+
+	if !cached {
+		vestingScheduleInsertCacheMut.Lock()
+		vestingScheduleInsertCache[key] = cache
+		vestingScheduleInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the VestingSchedule.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *VestingSchedule) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	vestingScheduleUpdateCacheMut.RLock()
+	cache, cached := vestingScheduleUpdateCache[key]
+	vestingScheduleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			vestingScheduleAllColumns,
+			vestingSchedulePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update vesting_schedules, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"vesting_schedules\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, vestingSchedulePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(vestingScheduleType, vestingScheduleMapping, append(wl, vestingSchedulePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update vesting_schedules row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for vesting_schedules")
+	}
+
+	if !cached {
+		vestingScheduleUpdateCacheMut.Lock()
+		vestingScheduleUpdateCache[key] = cache
+		vestingScheduleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q vestingScheduleQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for vesting_schedules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for vesting_schedules")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o VestingScheduleSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vestingSchedulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"vesting_schedules\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, vestingSchedulePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in vestingSchedule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all vestingSchedule")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *VestingSchedule) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no vesting_schedules provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+	o.UpdatedAt = currTime
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(vestingScheduleColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	vestingScheduleUpsertCacheMut.RLock()
+	cache, cached := vestingScheduleUpsertCache[key]
+	vestingScheduleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			vestingScheduleAllColumns,
+			vestingScheduleColumnsWithDefault,
+			vestingScheduleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			vestingScheduleAllColumns,
+			vestingSchedulePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert vesting_schedules, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(vestingSchedulePrimaryKeyColumns))
+			copy(conflict, vestingSchedulePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"vesting_schedules\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(vestingScheduleType, vestingScheduleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(vestingScheduleType, vestingScheduleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert vesting_schedules")
+	}
+
+	if !cached {
+		vestingScheduleUpsertCacheMut.Lock()
+		vestingScheduleUpsertCache[key] = cache
+		vestingScheduleUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single VestingSchedule record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *VestingSchedule) Delete(exec boil.Executor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no VestingSchedule provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), vestingSchedulePrimaryKeyMapping)
+		sql = "DELETE FROM \"vesting_schedules\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"vesting_schedules\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(vestingScheduleType, vestingScheduleMapping, append(wl, vestingSchedulePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from vesting_schedules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for vesting_schedules")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q vestingScheduleQuery) DeleteAll(exec boil.Executor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no vestingScheduleQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from vesting_schedules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for vesting_schedules")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o VestingScheduleSlice) DeleteAll(exec boil.Executor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(vestingScheduleBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vestingSchedulePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"vesting_schedules\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, vestingSchedulePrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vestingSchedulePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"vesting_schedules\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, vestingSchedulePrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from vestingSchedule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for vesting_schedules")
+	}
+
+	if len(vestingScheduleAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *VestingSchedule) Reload(exec boil.Executor) error {
+	ret, err := FindVestingSchedule(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *VestingScheduleSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := VestingScheduleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vestingSchedulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"vesting_schedules\".* FROM \"vesting_schedules\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, vestingSchedulePrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in VestingScheduleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// VestingScheduleExists checks if the VestingSchedule row exists.
+func VestingScheduleExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"vesting_schedules\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if vesting_schedules exists")
+	}
+
+	return exists, nil
+}
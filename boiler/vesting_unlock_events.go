@@ -0,0 +1,1092 @@
+// Code generated by SQLBoiler 4.8.6 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// VestingUnlockEvent is an object representing the database table.
+type VestingUnlockEvent struct {
+	ID         string          `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	ScheduleID string          `boiler:"schedule_id" boil:"schedule_id" json:"schedule_id" toml:"schedule_id" yaml:"schedule_id"`
+	UnlockAt   time.Time       `boiler:"unlock_at" boil:"unlock_at" json:"unlock_at" toml:"unlock_at" yaml:"unlock_at"`
+	Amount     decimal.Decimal `boiler:"amount" boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	CreatedAt  time.Time       `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	DeletedAt  null.Time       `boiler:"deleted_at" boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *vestingUnlockEventR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L vestingUnlockEventL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var VestingUnlockEventColumns = struct {
+	ID         string
+	ScheduleID string
+	UnlockAt   string
+	Amount     string
+	CreatedAt  string
+	DeletedAt  string
+}{
+	ID:         "id",
+	ScheduleID: "schedule_id",
+	UnlockAt:   "unlock_at",
+	Amount:     "amount",
+	CreatedAt:  "created_at",
+	DeletedAt:  "deleted_at",
+}
+
+var VestingUnlockEventTableColumns = struct {
+	ID         string
+	ScheduleID string
+	UnlockAt   string
+	Amount     string
+	CreatedAt  string
+	DeletedAt  string
+}{
+	ID:         "vesting_unlock_events.id",
+	ScheduleID: "vesting_unlock_events.schedule_id",
+	UnlockAt:   "vesting_unlock_events.unlock_at",
+	Amount:     "vesting_unlock_events.amount",
+	CreatedAt:  "vesting_unlock_events.created_at",
+	DeletedAt:  "vesting_unlock_events.deleted_at",
+}
+
+// Generated where
+
+var VestingUnlockEventWhere = struct {
+	ID         whereHelperstring
+	ScheduleID whereHelperstring
+	UnlockAt   whereHelpertime_Time
+	Amount     whereHelperdecimal_Decimal
+	CreatedAt  whereHelpertime_Time
+	DeletedAt  whereHelpernull_Time
+}{
+	ID:         whereHelperstring{field: "\"vesting_unlock_events\".\"id\""},
+	ScheduleID: whereHelperstring{field: "\"vesting_unlock_events\".\"schedule_id\""},
+	UnlockAt:   whereHelpertime_Time{field: "\"vesting_unlock_events\".\"unlock_at\""},
+	Amount:     whereHelperdecimal_Decimal{field: "\"vesting_unlock_events\".\"amount\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"vesting_unlock_events\".\"created_at\""},
+	DeletedAt:  whereHelpernull_Time{field: "\"vesting_unlock_events\".\"deleted_at\""},
+}
+
+// VestingUnlockEventRels is where relationship names are stored.
+var VestingUnlockEventRels = struct {
+	Schedule string
+}{
+	Schedule: "Schedule",
+}
+
+// vestingUnlockEventR is where relationships are stored.
+type vestingUnlockEventR struct {
+	Schedule *VestingSchedule `boiler:"Schedule" boil:"Schedule" json:"Schedule" toml:"Schedule" yaml:"Schedule"`
+}
+
+// NewStruct creates a new relationship struct
+func (*vestingUnlockEventR) NewStruct() *vestingUnlockEventR {
+	return &vestingUnlockEventR{}
+}
+
+// vestingUnlockEventL is where Load methods for each relationship are stored.
+type vestingUnlockEventL struct{}
+
+var (
+	vestingUnlockEventAllColumns            = []string{"id", "schedule_id", "unlock_at", "amount", "created_at", "deleted_at"}
+	vestingUnlockEventColumnsWithoutDefault = []string{"schedule_id", "unlock_at", "amount"}
+	vestingUnlockEventColumnsWithDefault    = []string{"id", "created_at", "deleted_at"}
+	vestingUnlockEventPrimaryKeyColumns     = []string{"id"}
+	vestingUnlockEventGeneratedColumns      = []string{}
+)
+
+type (
+	// VestingUnlockEventSlice is an alias for a slice of pointers to VestingUnlockEvent.
+	// This should almost always be used instead of []VestingUnlockEvent.
+	VestingUnlockEventSlice []*VestingUnlockEvent
+	// VestingUnlockEventHook is the signature for custom VestingUnlockEvent hook methods
+	VestingUnlockEventHook func(boil.Executor, *VestingUnlockEvent) error
+
+	vestingUnlockEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	vestingUnlockEventType                 = reflect.TypeOf(&VestingUnlockEvent{})
+	vestingUnlockEventMapping              = queries.MakeStructMapping(vestingUnlockEventType)
+	vestingUnlockEventPrimaryKeyMapping, _ = queries.BindMapping(vestingUnlockEventType, vestingUnlockEventMapping, vestingUnlockEventPrimaryKeyColumns)
+	vestingUnlockEventInsertCacheMut       sync.RWMutex
+	vestingUnlockEventInsertCache          = make(map[string]insertCache)
+	vestingUnlockEventUpdateCacheMut       sync.RWMutex
+	vestingUnlockEventUpdateCache          = make(map[string]updateCache)
+	vestingUnlockEventUpsertCacheMut       sync.RWMutex
+	vestingUnlockEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var vestingUnlockEventAfterSelectHooks []VestingUnlockEventHook
+
+var vestingUnlockEventBeforeInsertHooks []VestingUnlockEventHook
+var vestingUnlockEventAfterInsertHooks []VestingUnlockEventHook
+
+var vestingUnlockEventBeforeUpdateHooks []VestingUnlockEventHook
+var vestingUnlockEventAfterUpdateHooks []VestingUnlockEventHook
+
+var vestingUnlockEventBeforeDeleteHooks []VestingUnlockEventHook
+var vestingUnlockEventAfterDeleteHooks []VestingUnlockEventHook
+
+var vestingUnlockEventBeforeUpsertHooks []VestingUnlockEventHook
+var vestingUnlockEventAfterUpsertHooks []VestingUnlockEventHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *VestingUnlockEvent) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingUnlockEventAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *VestingUnlockEvent) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingUnlockEventBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *VestingUnlockEvent) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingUnlockEventAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *VestingUnlockEvent) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingUnlockEventBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *VestingUnlockEvent) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingUnlockEventAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *VestingUnlockEvent) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingUnlockEventBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *VestingUnlockEvent) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingUnlockEventAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *VestingUnlockEvent) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingUnlockEventBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *VestingUnlockEvent) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range vestingUnlockEventAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddVestingUnlockEventHook registers your hook function for all future operations.
+func AddVestingUnlockEventHook(hookPoint boil.HookPoint, vestingUnlockEventHook VestingUnlockEventHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		vestingUnlockEventAfterSelectHooks = append(vestingUnlockEventAfterSelectHooks, vestingUnlockEventHook)
+	case boil.BeforeInsertHook:
+		vestingUnlockEventBeforeInsertHooks = append(vestingUnlockEventBeforeInsertHooks, vestingUnlockEventHook)
+	case boil.AfterInsertHook:
+		vestingUnlockEventAfterInsertHooks = append(vestingUnlockEventAfterInsertHooks, vestingUnlockEventHook)
+	case boil.BeforeUpdateHook:
+		vestingUnlockEventBeforeUpdateHooks = append(vestingUnlockEventBeforeUpdateHooks, vestingUnlockEventHook)
+	case boil.AfterUpdateHook:
+		vestingUnlockEventAfterUpdateHooks = append(vestingUnlockEventAfterUpdateHooks, vestingUnlockEventHook)
+	case boil.BeforeDeleteHook:
+		vestingUnlockEventBeforeDeleteHooks = append(vestingUnlockEventBeforeDeleteHooks, vestingUnlockEventHook)
+	case boil.AfterDeleteHook:
+		vestingUnlockEventAfterDeleteHooks = append(vestingUnlockEventAfterDeleteHooks, vestingUnlockEventHook)
+	case boil.BeforeUpsertHook:
+		vestingUnlockEventBeforeUpsertHooks = append(vestingUnlockEventBeforeUpsertHooks, vestingUnlockEventHook)
+	case boil.AfterUpsertHook:
+		vestingUnlockEventAfterUpsertHooks = append(vestingUnlockEventAfterUpsertHooks, vestingUnlockEventHook)
+	}
+}
+
+// One returns a single vestingUnlockEvent record from the query.
+func (q vestingUnlockEventQuery) One(exec boil.Executor) (*VestingUnlockEvent, error) {
+	o := &VestingUnlockEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for vesting_unlock_events")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all VestingUnlockEvent records from the query.
+func (q vestingUnlockEventQuery) All(exec boil.Executor) (VestingUnlockEventSlice, error) {
+	var o []*VestingUnlockEvent
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to VestingUnlockEvent slice")
+	}
+
+	if len(vestingUnlockEventAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all VestingUnlockEvent records in the query.
+func (q vestingUnlockEventQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count vesting_unlock_events rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q vestingUnlockEventQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if vesting_unlock_events exists")
+	}
+
+	return count > 0, nil
+}
+
+// Schedule pointed to by the foreign key.
+func (o *VestingUnlockEvent) Schedule(mods ...qm.QueryMod) vestingScheduleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ScheduleID),
+		qmhelper.WhereIsNull("deleted_at"),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := VestingSchedules(queryMods...)
+	queries.SetFrom(query.Query, "\"vesting_schedules\"")
+
+	return query
+}
+
+// LoadSchedule allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (vestingUnlockEventL) LoadSchedule(e boil.Executor, singular bool, maybeVestingUnlockEvent interface{}, mods queries.Applicator) error {
+	var slice []*VestingUnlockEvent
+	var object *VestingUnlockEvent
+
+	if singular {
+		object = maybeVestingUnlockEvent.(*VestingUnlockEvent)
+	} else {
+		slice = *maybeVestingUnlockEvent.(*[]*VestingUnlockEvent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &vestingUnlockEventR{}
+		}
+		args = append(args, object.ScheduleID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &vestingUnlockEventR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ScheduleID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ScheduleID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`vesting_schedules`),
+		qm.WhereIn(`vesting_schedules.id in ?`, args...),
+		qmhelper.WhereIsNull(`vesting_schedules.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load VestingSchedule")
+	}
+
+	var resultSlice []*VestingSchedule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice VestingSchedule")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for vesting_schedules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for vesting_schedules")
+	}
+
+	if len(vestingUnlockEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Schedule = foreign
+		if foreign.R == nil {
+			foreign.R = &vestingScheduleR{}
+		}
+		foreign.R.ScheduleVestingUnlockEvents = append(foreign.R.ScheduleVestingUnlockEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ScheduleID == foreign.ID {
+				local.R.Schedule = foreign
+				if foreign.R == nil {
+					foreign.R = &vestingScheduleR{}
+				}
+				foreign.R.ScheduleVestingUnlockEvents = append(foreign.R.ScheduleVestingUnlockEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSchedule of the vestingUnlockEvent to the related item.
+// Sets o.R.Schedule to related.
+// Adds o to related.R.ScheduleVestingUnlockEvents.
+func (o *VestingUnlockEvent) SetSchedule(exec boil.Executor, insert bool, related *VestingSchedule) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"vesting_unlock_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"schedule_id"}),
+		strmangle.WhereClause("\"", "\"", 2, vestingUnlockEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ScheduleID = related.ID
+	if o.R == nil {
+		o.R = &vestingUnlockEventR{
+			Schedule: related,
+		}
+	} else {
+		o.R.Schedule = related
+	}
+
+	if related.R == nil {
+		related.R = &vestingScheduleR{
+			ScheduleVestingUnlockEvents: VestingUnlockEventSlice{o},
+		}
+	} else {
+		related.R.ScheduleVestingUnlockEvents = append(related.R.ScheduleVestingUnlockEvents, o)
+	}
+
+	return nil
+}
+
+// VestingUnlockEvents retrieves all the records using an executor.
+func VestingUnlockEvents(mods ...qm.QueryMod) vestingUnlockEventQuery {
+	mods = append(mods, qm.From("\"vesting_unlock_events\""), qmhelper.WhereIsNull("\"vesting_unlock_events\".\"deleted_at\""))
+	return vestingUnlockEventQuery{NewQuery(mods...)}
+}
+
+// FindVestingUnlockEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindVestingUnlockEvent(exec boil.Executor, iD string, selectCols ...string) (*VestingUnlockEvent, error) {
+	vestingUnlockEventObj := &VestingUnlockEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"vesting_unlock_events\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, vestingUnlockEventObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from vesting_unlock_events")
+	}
+
+	if err = vestingUnlockEventObj.doAfterSelectHooks(exec); err != nil {
+		return vestingUnlockEventObj, err
+	}
+
+	return vestingUnlockEventObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *VestingUnlockEvent) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no vesting_unlock_events provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(vestingUnlockEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	vestingUnlockEventInsertCacheMut.RLock()
+	cache, cached := vestingUnlockEventInsertCache[key]
+	vestingUnlockEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			vestingUnlockEventAllColumns,
+			vestingUnlockEventColumnsWithDefault,
+			vestingUnlockEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(vestingUnlockEventType, vestingUnlockEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(vestingUnlockEventType, vestingUnlockEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"vesting_unlock_events\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"vesting_unlock_events\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into vesting_unlock_events")
+	}
+
+	if !cached {
+		vestingUnlockEventInsertCacheMut.Lock()
+		vestingUnlockEventInsertCache[key] = cache
+		vestingUnlockEventInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the VestingUnlockEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *VestingUnlockEvent) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	vestingUnlockEventUpdateCacheMut.RLock()
+	cache, cached := vestingUnlockEventUpdateCache[key]
+	vestingUnlockEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			vestingUnlockEventAllColumns,
+			vestingUnlockEventPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update vesting_unlock_events, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"vesting_unlock_events\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, vestingUnlockEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(vestingUnlockEventType, vestingUnlockEventMapping, append(wl, vestingUnlockEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update vesting_unlock_events row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for vesting_unlock_events")
+	}
+
+	if !cached {
+		vestingUnlockEventUpdateCacheMut.Lock()
+		vestingUnlockEventUpdateCache[key] = cache
+		vestingUnlockEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q vestingUnlockEventQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for vesting_unlock_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for vesting_unlock_events")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o VestingUnlockEventSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vestingUnlockEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"vesting_unlock_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, vestingUnlockEventPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in vestingUnlockEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all vestingUnlockEvent")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *VestingUnlockEvent) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no vesting_unlock_events provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(vestingUnlockEventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	vestingUnlockEventUpsertCacheMut.RLock()
+	cache, cached := vestingUnlockEventUpsertCache[key]
+	vestingUnlockEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			vestingUnlockEventAllColumns,
+			vestingUnlockEventColumnsWithDefault,
+			vestingUnlockEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			vestingUnlockEventAllColumns,
+			vestingUnlockEventPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert vesting_unlock_events, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(vestingUnlockEventPrimaryKeyColumns))
+			copy(conflict, vestingUnlockEventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"vesting_unlock_events\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(vestingUnlockEventType, vestingUnlockEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(vestingUnlockEventType, vestingUnlockEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert vesting_unlock_events")
+	}
+
+	if !cached {
+		vestingUnlockEventUpsertCacheMut.Lock()
+		vestingUnlockEventUpsertCache[key] = cache
+		vestingUnlockEventUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single VestingUnlockEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *VestingUnlockEvent) Delete(exec boil.Executor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no VestingUnlockEvent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), vestingUnlockEventPrimaryKeyMapping)
+		sql = "DELETE FROM \"vesting_unlock_events\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"vesting_unlock_events\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(vestingUnlockEventType, vestingUnlockEventMapping, append(wl, vestingUnlockEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from vesting_unlock_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for vesting_unlock_events")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q vestingUnlockEventQuery) DeleteAll(exec boil.Executor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no vestingUnlockEventQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from vesting_unlock_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for vesting_unlock_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o VestingUnlockEventSlice) DeleteAll(exec boil.Executor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(vestingUnlockEventBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vestingUnlockEventPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"vesting_unlock_events\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, vestingUnlockEventPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vestingUnlockEventPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"vesting_unlock_events\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, vestingUnlockEventPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from vestingUnlockEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for vesting_unlock_events")
+	}
+
+	if len(vestingUnlockEventAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *VestingUnlockEvent) Reload(exec boil.Executor) error {
+	ret, err := FindVestingUnlockEvent(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *VestingUnlockEventSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := VestingUnlockEventSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), vestingUnlockEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"vesting_unlock_events\".* FROM \"vesting_unlock_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, vestingUnlockEventPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in VestingUnlockEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// VestingUnlockEventExists checks if the VestingUnlockEvent row exists.
+func VestingUnlockEventExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"vesting_unlock_events\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if vesting_unlock_events exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS vesting_cohort_members;
+DROP TABLE IF EXISTS vesting_unlock_events;
+DROP TABLE IF EXISTS vesting_schedules;
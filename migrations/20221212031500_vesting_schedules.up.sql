@@ -0,0 +1,44 @@
+-- Vesting schedules replace the hard coded dispersion tables used to limit SUPS withdrawals.
+-- A schedule either targets a single public address or a named cohort, addresses join cohorts via vesting_cohort_members.
+CREATE TABLE vesting_schedules
+(
+    id             UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    label          TEXT        NOT NULL,
+    public_address TEXT,
+    cohort         TEXT,
+    unlimited      BOOL        NOT NULL DEFAULT FALSE,
+    cliff_at       TIMESTAMPTZ,
+    cliff_amount   NUMERIC(28) NOT NULL DEFAULT 0,
+    drip_start_at  TIMESTAMPTZ,
+    drip_end_at    TIMESTAMPTZ,
+    drip_amount    NUMERIC(28) NOT NULL DEFAULT 0,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at     TIMESTAMPTZ,
+    CHECK ((public_address IS NULL) <> (cohort IS NULL)),
+    CHECK (drip_end_at IS NULL OR drip_start_at < drip_end_at)
+);
+
+CREATE INDEX idx_vesting_schedules_public_address ON vesting_schedules (public_address);
+CREATE INDEX idx_vesting_schedules_cohort ON vesting_schedules (cohort);
+
+CREATE TABLE vesting_unlock_events
+(
+    id          UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    schedule_id UUID        NOT NULL REFERENCES vesting_schedules (id),
+    unlock_at   TIMESTAMPTZ NOT NULL,
+    amount      NUMERIC(28) NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at  TIMESTAMPTZ
+);
+
+CREATE INDEX idx_vesting_unlock_events_schedule_id ON vesting_unlock_events (schedule_id);
+
+CREATE TABLE vesting_cohort_members
+(
+    public_address TEXT PRIMARY KEY,
+    cohort         TEXT        NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_vesting_cohort_members_cohort ON vesting_cohort_members (cohort);
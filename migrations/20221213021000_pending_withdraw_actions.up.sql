@@ -0,0 +1,18 @@
+-- Audit log of manual actions taken on stuck pending refunds and 1155 rollbacks
+CREATE TABLE pending_withdraw_actions
+(
+    id                       UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    pending_refund_id        UUID REFERENCES pending_refund (id),
+    pending_1155_rollback_id UUID REFERENCES pending_1155_rollback (id),
+    action                   TEXT        NOT NULL CHECK (action IN ('FORCE_CONFIRM', 'FORCE_ROLLBACK', 'CANCEL')),
+    tx_hash                  TEXT,
+    reversal_transaction_id  TEXT,
+    reason                   TEXT        NOT NULL,
+    operator                 TEXT        NOT NULL,
+    operator_user_id         UUID REFERENCES users (id),
+    created_at               TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((pending_refund_id IS NULL) <> (pending_1155_rollback_id IS NULL))
+);
+
+CREATE INDEX idx_pending_withdraw_actions_pending_refund_id ON pending_withdraw_actions (pending_refund_id);
+CREATE INDEX idx_pending_withdraw_actions_pending_1155_rollback_id ON pending_withdraw_actions (pending_1155_rollback_id);
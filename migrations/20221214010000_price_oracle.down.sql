@@ -0,0 +1 @@
+DELETE FROM kv WHERE key IN ('price_oracle_max_age_seconds', 'price_oracle_max_deviation', 'price_oracle_min_sources', 'price_oracle_deviation_confirmations', 'price_oracle_pause_untrusted');
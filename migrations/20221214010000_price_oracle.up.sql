@@ -0,0 +1,5 @@
+INSERT INTO kv (key, value) VALUES ('price_oracle_max_age_seconds', '300') ON CONFLICT DO NOTHING;
+INSERT INTO kv (key, value) VALUES ('price_oracle_max_deviation', '0.1') ON CONFLICT DO NOTHING;
+INSERT INTO kv (key, value) VALUES ('price_oracle_min_sources', '1') ON CONFLICT DO NOTHING;
+INSERT INTO kv (key, value) VALUES ('price_oracle_deviation_confirmations', '3') ON CONFLICT DO NOTHING;
+INSERT INTO kv (key, value) VALUES ('price_oracle_pause_untrusted', 'true') ON CONFLICT DO NOTHING;
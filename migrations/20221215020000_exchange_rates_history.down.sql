@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS purchase_exchange_rates;
+DROP TABLE IF EXISTS exchange_rates;
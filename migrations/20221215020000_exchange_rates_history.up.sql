@@ -0,0 +1,33 @@
+CREATE TABLE exchange_rates
+(
+    id           UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    symbol       TEXT        NOT NULL,
+    usd          NUMERIC     NOT NULL,
+    min_usd      NUMERIC     NOT NULL,
+    max_usd      NUMERIC     NOT NULL,
+    sources      TEXT        NOT NULL DEFAULT '',
+    trusted      BOOLEAN     NOT NULL DEFAULT FALSE,
+    resolution   TEXT        NOT NULL DEFAULT 'RAW' CHECK (resolution IN ('RAW', 'HOUR', 'DAY')),
+    sample_count INT         NOT NULL DEFAULT 1,
+    recorded_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_exchange_rates_symbol_recorded_at ON exchange_rates (symbol, recorded_at);
+CREATE UNIQUE INDEX idx_exchange_rates_bucket ON exchange_rates (symbol, resolution, recorded_at) WHERE resolution != 'RAW';
+
+CREATE TABLE purchase_exchange_rates
+(
+    id               UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    transaction_id   TEXT        NOT NULL,
+    tx_hash          TEXT        NOT NULL,
+    symbol           TEXT        NOT NULL,
+    token_usd_rate   NUMERIC,
+    sups_usd_rate    NUMERIC     NOT NULL,
+    exchange_rate_id UUID REFERENCES exchange_rates (id),
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_purchase_exchange_rates_transaction_id ON purchase_exchange_rates (transaction_id);
+CREATE INDEX idx_purchase_exchange_rates_tx_hash ON purchase_exchange_rates (tx_hash);
+CREATE INDEX idx_purchase_exchange_rates_exchange_rate_id ON purchase_exchange_rates (exchange_rate_id);
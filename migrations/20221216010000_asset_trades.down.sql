@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS asset_trade_items;
+DROP TABLE IF EXISTS asset_trades;
@@ -0,0 +1,36 @@
+CREATE TABLE asset_trades
+(
+    id            UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    offerer_id    UUID        NOT NULL REFERENCES users (id),
+    recipient_id  UUID        NOT NULL REFERENCES users (id),
+    offer_sups    NUMERIC(28) NOT NULL DEFAULT 0,
+    request_sups  NUMERIC(28) NOT NULL DEFAULT 0,
+    status        TEXT        NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'ACCEPTED', 'REJECTED', 'CANCELED', 'EXPIRED', 'FAILED')),
+    offer_tx_id   TEXT,
+    request_tx_id TEXT,
+    failed_reason TEXT,
+    expires_at    TIMESTAMPTZ NOT NULL,
+    completed_at  TIMESTAMPTZ,
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_asset_trades_offerer_id ON asset_trades (offerer_id, status);
+CREATE INDEX idx_asset_trades_recipient_id ON asset_trades (recipient_id, status);
+CREATE INDEX idx_asset_trades_pending_expires_at ON asset_trades (expires_at) WHERE status = 'PENDING';
+
+CREATE TABLE asset_trade_items
+(
+    id                 UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    trade_id           UUID        NOT NULL REFERENCES asset_trades (id),
+    side               TEXT        NOT NULL CHECK (side IN ('OFFER', 'REQUEST')),
+    user_asset_id      UUID REFERENCES user_assets (id),
+    user_asset_1155_id UUID REFERENCES user_assets_1155 (id),
+    amount             INT         NOT NULL DEFAULT 1 CHECK (amount > 0),
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((user_asset_id IS NULL) != (user_asset_1155_id IS NULL))
+);
+
+CREATE INDEX idx_asset_trade_items_trade_id ON asset_trade_items (trade_id);
+CREATE INDEX idx_asset_trade_items_user_asset_id ON asset_trade_items (user_asset_id);
+CREATE INDEX idx_asset_trade_items_user_asset_1155_id ON asset_trade_items (user_asset_1155_id);
@@ -0,0 +1,8 @@
+DELETE FROM kv WHERE key IN ('marketplace_fee_percentage', 'marketplace_min_bid_increment');
+
+DROP TABLE IF EXISTS marketplace_bids;
+DROP TABLE IF EXISTS marketplace_listings;
+
+DELETE FROM users WHERE id = '7c4e5a8f-3d2b-4f6a-b1c9-8e0d2a5f6b31';
+DELETE FROM roles WHERE id = 'b0a6a1e9-5c8d-4d2f-9a41-2f0f6c3d7e52';
+DELETE FROM accounts WHERE id = '6f1a2cd4-0a57-4b2f-93b0-3d8a7c35e104';
@@ -0,0 +1,53 @@
+INSERT INTO accounts (id, type, sups)
+VALUES ('6f1a2cd4-0a57-4b2f-93b0-3d8a7c35e104', 'USER', 0);
+
+INSERT INTO roles (id, name, permissions)
+VALUES ('b0a6a1e9-5c8d-4d2f-9a41-2f0f6c3d7e52', 'Marketplace', '{}');
+
+INSERT INTO users (id, username, role_id, verified, account_id)
+VALUES ('7c4e5a8f-3d2b-4f6a-b1c9-8e0d2a5f6b31', 'Xsyn-Marketplace', 'b0a6a1e9-5c8d-4d2f-9a41-2f0f6c3d7e52', true, '6f1a2cd4-0a57-4b2f-93b0-3d8a7c35e104');
+
+CREATE TABLE marketplace_listings
+(
+    id                 UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    seller_id          UUID        NOT NULL REFERENCES users (id),
+    listing_type       TEXT        NOT NULL CHECK (listing_type IN ('FIXED_PRICE', 'AUCTION')),
+    user_asset_id      UUID REFERENCES user_assets (id),
+    user_asset_1155_id UUID REFERENCES user_assets_1155 (id),
+    amount             INT         NOT NULL DEFAULT 1 CHECK (amount > 0),
+    price              NUMERIC(28) NOT NULL CHECK (price > 0),
+    current_bid        NUMERIC(28),
+    current_bidder_id  UUID REFERENCES users (id),
+    current_bid_tx_id  TEXT,
+    status             TEXT        NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'SOLD', 'CANCELED', 'EXPIRED')),
+    buyer_id           UUID REFERENCES users (id),
+    sale_price         NUMERIC(28),
+    fee                NUMERIC(28),
+    sale_tx_id         TEXT,
+    fee_tx_id          TEXT,
+    expires_at         TIMESTAMPTZ NOT NULL,
+    completed_at       TIMESTAMPTZ,
+    updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((user_asset_id IS NULL) != (user_asset_1155_id IS NULL))
+);
+
+CREATE INDEX idx_marketplace_listings_seller_id ON marketplace_listings (seller_id, status);
+CREATE INDEX idx_marketplace_listings_active_expires_at ON marketplace_listings (expires_at) WHERE status = 'ACTIVE';
+CREATE UNIQUE INDEX idx_marketplace_listings_active_user_asset_id ON marketplace_listings (user_asset_id) WHERE status = 'ACTIVE';
+
+CREATE TABLE marketplace_bids
+(
+    id           UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    listing_id   UUID        NOT NULL REFERENCES marketplace_listings (id),
+    bidder_id    UUID        NOT NULL REFERENCES users (id),
+    amount       NUMERIC(28) NOT NULL,
+    tx_id        TEXT        NOT NULL,
+    refund_tx_id TEXT,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_marketplace_bids_listing_id ON marketplace_bids (listing_id);
+
+INSERT INTO kv (key, value) VALUES ('marketplace_fee_percentage', '0.025') ON CONFLICT DO NOTHING;
+INSERT INTO kv (key, value) VALUES ('marketplace_min_bid_increment', '0.05') ON CONFLICT DO NOTHING;
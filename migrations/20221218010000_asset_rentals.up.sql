@@ -0,0 +1,25 @@
+CREATE TABLE asset_rentals
+(
+    id                  UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    user_asset_id       UUID        NOT NULL REFERENCES user_assets (id),
+    owner_id            UUID        NOT NULL REFERENCES users (id),
+    renter_user_id      UUID REFERENCES users (id),
+    renter_syndicate_id UUID REFERENCES syndicates (id),
+    fee                 NUMERIC(28) NOT NULL DEFAULT 0 CHECK (fee >= 0),
+    fee_tx_id           TEXT,
+    duration_hours      INT         NOT NULL CHECK (duration_hours > 0),
+    status              TEXT        NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'ACTIVE', 'ENDED', 'CANCELED')),
+    starts_at           TIMESTAMPTZ,
+    ends_at             TIMESTAMPTZ,
+    ended_at            TIMESTAMPTZ,
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((renter_user_id IS NULL) != (renter_syndicate_id IS NULL))
+);
+
+-- an asset can only have one open offer or rental at a time
+CREATE UNIQUE INDEX idx_asset_rentals_open_user_asset_id ON asset_rentals (user_asset_id) WHERE status IN ('PENDING', 'ACTIVE');
+CREATE INDEX idx_asset_rentals_owner_id ON asset_rentals (owner_id, status);
+CREATE INDEX idx_asset_rentals_renter_user_id ON asset_rentals (renter_user_id, status);
+CREATE INDEX idx_asset_rentals_renter_syndicate_id ON asset_rentals (renter_syndicate_id, status);
+CREATE INDEX idx_asset_rentals_active_ends_at ON asset_rentals (ends_at) WHERE status = 'ACTIVE';
@@ -0,0 +1,8 @@
+DELETE
+FROM asset_service_transfer_events
+WHERE transfer_tx_id IS NULL;
+
+ALTER TABLE asset_service_transfer_events
+    ALTER COLUMN transfer_tx_id SET NOT NULL;
+
+DROP TABLE IF EXISTS asset_service_locks;
@@ -0,0 +1,22 @@
+CREATE TABLE asset_service_locks
+(
+    id             UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    user_asset_id  UUID        NOT NULL REFERENCES user_assets (id),
+    service_id     UUID        NOT NULL REFERENCES users (id),
+    reason         TEXT        NOT NULL DEFAULT '',
+    expires_at     TIMESTAMPTZ NOT NULL,
+    renewed_at     TIMESTAMPTZ,
+    released_at    TIMESTAMPTZ,
+    release_reason TEXT CHECK (release_reason IN ('SERVICE', 'EXPIRED', 'ADMIN')),
+    released_by_id UUID REFERENCES users (id),
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- an asset can only be leased to one service at a time
+CREATE UNIQUE INDEX idx_asset_service_locks_held_user_asset_id ON asset_service_locks (user_asset_id) WHERE released_at IS NULL;
+CREATE INDEX idx_asset_service_locks_held_expires_at ON asset_service_locks (expires_at) WHERE released_at IS NULL;
+CREATE INDEX idx_asset_service_locks_service_id ON asset_service_locks (service_id);
+
+-- lease changes are recorded as service transfers without a sups transaction
+ALTER TABLE asset_service_transfer_events
+    ALTER COLUMN transfer_tx_id DROP NOT NULL;
@@ -0,0 +1,3 @@
+DELETE FROM kv WHERE key IN ('metadata_refresh_batch_size', 'metadata_refresh_per_minute');
+
+DROP TABLE IF EXISTS asset_metadata_refreshes;
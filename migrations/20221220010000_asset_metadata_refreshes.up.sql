@@ -0,0 +1,16 @@
+CREATE TABLE asset_metadata_refreshes
+(
+    user_asset_id   UUID PRIMARY KEY REFERENCES user_assets (id),
+    priority        INT         NOT NULL DEFAULT 0,
+    attempts        INT         NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    last_attempt_at TIMESTAMPTZ,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    requested_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_asset_metadata_refreshes_queue ON asset_metadata_refreshes (priority DESC, requested_at);
+CREATE INDEX idx_asset_metadata_refreshes_failed ON asset_metadata_refreshes (last_attempt_at) WHERE last_error IS NOT NULL;
+
+INSERT INTO kv (key, value) VALUES ('metadata_refresh_batch_size', '50') ON CONFLICT DO NOTHING;
+INSERT INTO kv (key, value) VALUES ('metadata_refresh_per_minute', '300') ON CONFLICT DO NOTHING;
@@ -0,0 +1,4 @@
+ALTER TABLE collections
+    DROP COLUMN external_link,
+    DROP COLUMN royalty_recipient,
+    DROP COLUMN royalty_basis_points;
@@ -0,0 +1,5 @@
+-- contractURI metadata and EIP-2981 royalties, name, description and logo_url are reused
+ALTER TABLE collections
+    ADD COLUMN external_link        TEXT,
+    ADD COLUMN royalty_recipient    TEXT,
+    ADD COLUMN royalty_basis_points INT DEFAULT 0 NOT NULL CHECK (royalty_basis_points >= 0 AND royalty_basis_points <= 10000);
@@ -0,0 +1,14 @@
+CREATE OR REPLACE FUNCTION set_slug_from_name() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+  NEW.slug := slugify(NEW.name);
+  RETURN NEW;
+END
+$$;
+
+UPDATE roles
+SET permissions = ARRAY_REMOVE(ARRAY_REMOVE(ARRAY_REMOVE(ARRAY_REMOVE(permissions, 'CollectionCreate'), 'CollectionUpdate'), 'CollectionArchive'), 'CollectionUnarchive');
+
+ALTER TABLE collections
+    DROP COLUMN chain;
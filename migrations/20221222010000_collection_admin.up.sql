@@ -0,0 +1,24 @@
+-- chain the mint and stake contracts are deployed on, collections without contracts have none
+ALTER TABLE collections
+    ADD COLUMN chain TEXT CHECK (chain IN ('ETH', 'BSC'));
+
+UPDATE collections
+SET chain = 'ETH'
+WHERE mint_contract IS NOT NULL
+  AND mint_contract != '';
+
+UPDATE roles
+SET permissions = ARRAY_CAT(permissions, '{CollectionCreate,CollectionUpdate,CollectionArchive,CollectionUnarchive}')
+WHERE tier = 1;
+
+-- slugs can be set by admins now, only fill them from the name when left empty
+CREATE OR REPLACE FUNCTION set_slug_from_name() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+  IF NEW.slug IS NULL OR NEW.slug = '' THEN
+    NEW.slug := slugify(NEW.name);
+  END IF;
+  RETURN NEW;
+END
+$$;
@@ -0,0 +1,2 @@
+DROP TABLE holder_snapshot_entries;
+DROP TABLE holder_snapshots;
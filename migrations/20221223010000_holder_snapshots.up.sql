@@ -0,0 +1,37 @@
+CREATE TABLE holder_snapshots
+(
+    id             UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    name           TEXT        NOT NULL,
+    collection_ids UUID[]      NOT NULL,
+    trait_filters  JSONB       NOT NULL DEFAULT '[]',
+    snapshot_at    TIMESTAMPTZ,
+    block_number   BIGINT,
+    status         TEXT        NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'COMPLETE', 'FAILED')),
+    error          TEXT,
+    holder_count   INT         NOT NULL DEFAULT 0,
+    asset_count    INT         NOT NULL DEFAULT 0,
+    created_by_id  UUID        NOT NULL REFERENCES users (id),
+    completed_at   TIMESTAMPTZ,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (snapshot_at IS NULL OR block_number IS NULL)
+);
+
+CREATE INDEX idx_holder_snapshots_pending ON holder_snapshots (snapshot_at) WHERE status = 'PENDING';
+
+-- holders off xsyn only have an address
+CREATE TABLE holder_snapshot_entries
+(
+    id              UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    snapshot_id     UUID        NOT NULL REFERENCES holder_snapshots (id),
+    collection_id   UUID        NOT NULL REFERENCES collections (id),
+    user_id         UUID REFERENCES users (id),
+    public_address  TEXT,
+    custodial_count INT         NOT NULL DEFAULT 0,
+    minted_count    INT         NOT NULL DEFAULT 0,
+    staked_count    INT         NOT NULL DEFAULT 0,
+    total_count     INT         NOT NULL DEFAULT 0,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (user_id IS NOT NULL OR public_address IS NOT NULL)
+);
+
+CREATE INDEX idx_holder_snapshot_entries_snapshot ON holder_snapshot_entries (snapshot_id, collection_id);
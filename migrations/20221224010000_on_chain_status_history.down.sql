@@ -0,0 +1 @@
+DROP TABLE IF EXISTS user_asset_on_chain_status_history;
@@ -0,0 +1,20 @@
+CREATE TABLE user_asset_on_chain_status_history
+(
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    asset_hash      TEXT        NOT NULL REFERENCES user_assets (hash),
+    collection_id   UUID        NOT NULL REFERENCES collections (id),
+    from_status     TEXT CHECK (from_status IN ('MINTABLE', 'STAKABLE', 'UNSTAKABLE', 'UNSTAKABLE_OLD')),
+    to_status       TEXT        NOT NULL CHECK (to_status IN ('MINTABLE', 'STAKABLE', 'UNSTAKABLE', 'UNSTAKABLE_OLD')),
+    source          TEXT        NOT NULL CHECK (source IN ('REGISTER', 'SYNC', 'SIGNATURE', 'ADMIN')),
+    tx_hash         TEXT,
+    changed_by_id   UUID REFERENCES users (id),
+    note            TEXT,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_on_chain_status_history_asset ON user_asset_on_chain_status_history (asset_hash, created_at DESC);
+
+-- the current status of every asset is where its history starts
+INSERT INTO user_asset_on_chain_status_history (asset_hash, collection_id, to_status, source, created_at)
+SELECT asset_hash, collection_id, on_chain_status, 'REGISTER', updated_at
+FROM user_asset_on_chain_status;
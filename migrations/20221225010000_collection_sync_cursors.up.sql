@@ -0,0 +1,19 @@
+CREATE TABLE collection_sync_cursors
+(
+    collection_id UUID        NOT NULL REFERENCES collections (id),
+    cursor_type   TEXT        NOT NULL CHECK (cursor_type IN ('1155_DEPOSIT', '1155_WITHDRAW')),
+    block_number  BIGINT      NOT NULL DEFAULT 0,
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (collection_id, cursor_type)
+);
+
+-- the achievements collection was the only 1155 collection synced, carry its cursors over from kv
+INSERT INTO collection_sync_cursors (collection_id, cursor_type, block_number)
+SELECT c.id, '1155_DEPOSIT', COALESCE((SELECT NULLIF(value, '')::BIGINT FROM kv WHERE key = 'latest_1155_deposit_block'), 0)
+FROM collections c
+WHERE c.slug = 'supremacy-achievements';
+
+INSERT INTO collection_sync_cursors (collection_id, cursor_type, block_number)
+SELECT c.id, '1155_WITHDRAW', COALESCE((SELECT NULLIF(value, '')::BIGINT FROM kv WHERE key = 'latest_1155_withdraw_block'), 0)
+FROM collections c
+WHERE c.slug = 'supremacy-achievements';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS asset1155_transfer_events;
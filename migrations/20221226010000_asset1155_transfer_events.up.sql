@@ -0,0 +1,17 @@
+CREATE TABLE asset1155_transfer_events
+(
+    id                 BIGSERIAL PRIMARY KEY,
+    collection_id      UUID        NOT NULL REFERENCES collections (id),
+    external_token_id  INT         NOT NULL,
+    amount             INT         NOT NULL CHECK (amount > 0),
+    from_asset_id      UUID        NOT NULL REFERENCES user_assets_1155 (id),
+    to_asset_id        UUID        NOT NULL REFERENCES user_assets_1155 (id),
+    from_user_id       UUID        NOT NULL REFERENCES users (id),
+    to_user_id         UUID        NOT NULL REFERENCES users (id),
+    service_id         UUID REFERENCES users (id),
+    initiated_from     TEXT        NOT NULL DEFAULT 'XSYN',
+    transferred_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_asset1155_transfer_events_from_user_id ON asset1155_transfer_events (from_user_id, transferred_at DESC);
+CREATE INDEX idx_asset1155_transfer_events_to_user_id ON asset1155_transfer_events (to_user_id, transferred_at DESC);
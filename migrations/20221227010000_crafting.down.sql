@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS crafting_event_items;
+DROP TABLE IF EXISTS crafting_events;
+DROP TABLE IF EXISTS crafting_recipe_items;
+DROP TABLE IF EXISTS crafting_recipes;
@@ -0,0 +1,53 @@
+CREATE TABLE crafting_recipes
+(
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name          TEXT        NOT NULL,
+    description   TEXT        NOT NULL DEFAULT '',
+    sups_cost     NUMERIC(28) NOT NULL DEFAULT 0 CHECK (sups_cost >= 0),
+    is_active     BOOLEAN     NOT NULL DEFAULT TRUE,
+    created_by_id UUID        NOT NULL REFERENCES users (id),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- an input is a number of 721s from a collection, optionally of one tier, or a number of one 1155 token
+-- an output is a number of 721s the crafting service supplies, or a number of one 1155 token
+CREATE TABLE crafting_recipe_items
+(
+    id                UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    recipe_id         UUID        NOT NULL REFERENCES crafting_recipes (id),
+    side              TEXT        NOT NULL CHECK (side IN ('INPUT', 'OUTPUT')),
+    collection_id     UUID        NOT NULL REFERENCES collections (id),
+    external_token_id INT,
+    tier              TEXT,
+    amount            INT         NOT NULL CHECK (amount > 0),
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_crafting_recipe_items_recipe_id ON crafting_recipe_items (recipe_id);
+
+CREATE TABLE crafting_events
+(
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    recipe_id  UUID        NOT NULL REFERENCES crafting_recipes (id),
+    user_id    UUID        NOT NULL REFERENCES users (id),
+    service_id UUID REFERENCES users (id),
+    sups_cost  NUMERIC(28) NOT NULL,
+    tx_id      TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_crafting_events_user_id ON crafting_events (user_id, created_at DESC);
+
+CREATE TABLE crafting_event_items
+(
+    id                 UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    crafting_event_id  UUID NOT NULL REFERENCES crafting_events (id),
+    side               TEXT NOT NULL CHECK (side IN ('INPUT', 'OUTPUT')),
+    user_asset_id      UUID REFERENCES user_assets (id),
+    user_asset_1155_id UUID REFERENCES user_assets_1155 (id),
+    amount             INT  NOT NULL CHECK (amount > 0),
+    CHECK ((user_asset_id IS NULL) != (user_asset_1155_id IS NULL))
+);
+
+CREATE INDEX idx_crafting_event_items_crafting_event_id ON crafting_event_items (crafting_event_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_users_avatar_user_asset_id;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS avatar_user_asset_id;
@@ -0,0 +1,4 @@
+ALTER TABLE users
+    ADD COLUMN avatar_user_asset_id UUID REFERENCES user_assets (id);
+
+CREATE INDEX idx_users_avatar_user_asset_id ON users (avatar_user_asset_id) WHERE avatar_user_asset_id IS NOT NULL;
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS nft_ownership_changes;
+DROP TABLE IF EXISTS nft_owners;
+
+DELETE
+FROM collection_sync_cursors
+WHERE cursor_type = '721_TRANSFER';
+
+ALTER TABLE collection_sync_cursors
+    DROP CONSTRAINT collection_sync_cursors_cursor_type_check,
+    ADD CONSTRAINT collection_sync_cursors_cursor_type_check CHECK (cursor_type IN ('1155_DEPOSIT', '1155_WITHDRAW'));
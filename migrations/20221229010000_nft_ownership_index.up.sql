@@ -0,0 +1,74 @@
+ALTER TABLE collection_sync_cursors
+    DROP CONSTRAINT collection_sync_cursors_cursor_type_check,
+    ADD CONSTRAINT collection_sync_cursors_cursor_type_check CHECK (cursor_type IN ('1155_DEPOSIT', '1155_WITHDRAW', '721_TRANSFER'));
+
+-- nft_owners is the on chain ownership of every 721 token, derived from the transfers the indexer has seen.
+-- holder_address is who holds the token on chain, owner_address is who it belongs to, the staker when it is on a stake contract.
+CREATE TABLE nft_owners
+(
+    collection_id   UUID        NOT NULL REFERENCES collections (id),
+    token_id        BIGINT      NOT NULL,
+    owner_address   TEXT        NOT NULL,
+    holder_address  TEXT        NOT NULL,
+    on_chain_status TEXT        NOT NULL,
+    tx_hash         TEXT        NOT NULL,
+    block_number    BIGINT      NOT NULL,
+    log_index       INTEGER     NOT NULL,
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (collection_id, token_id)
+);
+
+CREATE INDEX idx_nft_owners_owner_address ON nft_owners (owner_address);
+
+-- nft_ownership_changes are the transfers the indexer has seen, in chain order, for passport to apply to user_assets
+CREATE TABLE nft_ownership_changes
+(
+    id              BIGSERIAL PRIMARY KEY,
+    collection_id   UUID        NOT NULL REFERENCES collections (id),
+    token_id        BIGINT      NOT NULL,
+    from_address    TEXT        NOT NULL,
+    to_address      TEXT        NOT NULL,
+    owner_address   TEXT        NOT NULL,
+    on_chain_status TEXT        NOT NULL,
+    tx_hash         TEXT        NOT NULL,
+    log_index       INTEGER     NOT NULL,
+    block_number    BIGINT      NOT NULL,
+    block_timestamp TIMESTAMPTZ NOT NULL,
+    applied_at      TIMESTAMPTZ,
+    apply_error     TEXT,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (collection_id, tx_hash, log_index)
+);
+
+CREATE INDEX idx_nft_ownership_changes_unapplied ON nft_ownership_changes (collection_id, block_number, log_index) WHERE applied_at IS NULL;
+
+-- start the index from what the snapshot sync last saw, so the indexer only picks up transfers after it
+INSERT INTO nft_owners (collection_id, token_id, owner_address, holder_address, on_chain_status, tx_hash, block_number, log_index)
+SELECT DISTINCT ON (iot.collection_id, iot.external_token_id) iot.collection_id,
+                                                             iot.external_token_id,
+                                                             iot.to_addr,
+                                                             CASE COALESCE(s.on_chain_status, 'STAKABLE')
+                                                                 WHEN 'UNSTAKABLE' THEN COALESCE(c.stake_contract, iot.to_addr)
+                                                                 WHEN 'UNSTAKABLE_OLD' THEN COALESCE(c.staking_contract_old, iot.to_addr)
+                                                                 ELSE iot.to_addr
+                                                                 END,
+                                                             COALESCE(s.on_chain_status, 'STAKABLE'),
+                                                             iot.tx_id,
+                                                             iot.block_number,
+                                                             0
+FROM item_onchain_transactions iot
+         INNER JOIN collections c ON c.id = iot.collection_id AND c.contract_type = 'ERC-721'
+         LEFT JOIN user_assets ua ON ua.collection_id = iot.collection_id AND ua.token_id = iot.external_token_id
+         LEFT JOIN user_asset_on_chain_status s ON s.collection_id = ua.collection_id AND s.asset_hash = ua.hash
+WHERE iot.deleted_at IS NULL
+ORDER BY iot.collection_id, iot.external_token_id, iot.block_number DESC, iot.block_timestamp DESC;
+
+INSERT INTO collection_sync_cursors (collection_id, cursor_type, block_number)
+SELECT collection_id, '721_TRANSFER', MAX(block_number)
+FROM nft_owners
+GROUP BY collection_id;
+
+-- the indexer no longer turns the sync off when avant has a bad response, turn it back on if that happened
+UPDATE kv
+SET value = 'true'
+WHERE key = 'enable_sync_nft_owners';
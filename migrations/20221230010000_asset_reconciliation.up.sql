@@ -0,0 +1,37 @@
+CREATE TABLE reconciliation_runs
+(
+    id                 UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    collection_ids     UUID[]      NOT NULL,
+    status             TEXT        NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'RUNNING', 'COMPLETE', 'FAILED')),
+    last_hash          TEXT        NOT NULL DEFAULT '',
+    checked_count      INTEGER     NOT NULL DEFAULT 0,
+    discrepancy_count  INTEGER     NOT NULL DEFAULT 0,
+    error              TEXT,
+    created_by_id      UUID        NOT NULL REFERENCES users (id),
+    started_at         TIMESTAMPTZ,
+    completed_at       TIMESTAMPTZ,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- a discrepancy is one way passport and the gameserver disagree about an asset.
+-- suggested_fix is what the job thinks will fix it, an admin approves it or picks another fix before it is applied.
+CREATE TABLE reconciliation_discrepancies
+(
+    id               UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    run_id           UUID        NOT NULL REFERENCES reconciliation_runs (id),
+    user_asset_id    UUID        NOT NULL REFERENCES user_assets (id),
+    asset_hash       TEXT        NOT NULL,
+    kind             TEXT        NOT NULL CHECK (kind IN ('OWNER', 'LOCK', 'METADATA', 'MISSING')),
+    passport_value   TEXT        NOT NULL DEFAULT '',
+    gameserver_value TEXT        NOT NULL DEFAULT '',
+    suggested_fix    TEXT        NOT NULL CHECK (suggested_fix IN ('PUSH_OWNER', 'ADOPT_GAMESERVER_OWNER', 'PUSH_LOCK', 'RELEASE_GAMESERVER_LOCK', 'REFRESH_METADATA', 'NONE')),
+    status           TEXT        NOT NULL DEFAULT 'OPEN' CHECK (status IN ('OPEN', 'REPAIRED', 'DISMISSED', 'FAILED')),
+    applied_fix      TEXT,
+    repair_error     TEXT,
+    resolved_by_id   UUID REFERENCES users (id),
+    resolved_at      TIMESTAMPTZ,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_reconciliation_discrepancies_run ON reconciliation_discrepancies (run_id, status);
+CREATE INDEX idx_reconciliation_discrepancies_asset ON reconciliation_discrepancies (user_asset_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS user_asset_metadata_versions;
@@ -0,0 +1,35 @@
+-- every change to an asset's metadata is kept as a version, metadata is the asset as it was after the change.
+-- diff lists the fields and traits that changed from the version before, the first version has none.
+CREATE TABLE user_asset_metadata_versions
+(
+    id            UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    user_asset_id UUID        NOT NULL REFERENCES user_assets (id),
+    version       INTEGER     NOT NULL CHECK (version > 0),
+    source        TEXT        NOT NULL CHECK (source IN ('BACKFILL', 'REGISTER', 'GAMESERVER_PUSH', 'REFRESH', 'USER_REQUEST', 'TRANSFER')),
+    metadata      JSONB       NOT NULL,
+    diff          JSONB       NOT NULL DEFAULT '[]',
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_asset_id, version)
+);
+
+INSERT INTO user_asset_metadata_versions (user_asset_id, version, source, metadata, created_at)
+SELECT id,
+       1,
+       'BACKFILL',
+       jsonb_build_object(
+               'name', name,
+               'tier', tier,
+               'asset_type', asset_type,
+               'attributes', COALESCE(attributes, '[]'),
+               'image_url', image_url,
+               'external_url', external_url,
+               'card_animation_url', card_animation_url,
+               'avatar_url', avatar_url,
+               'large_image_url', large_image_url,
+               'description', description,
+               'background_color', background_color,
+               'animation_url', animation_url,
+               'youtube_url', youtube_url
+           ),
+       data_refreshed_at
+FROM user_assets;
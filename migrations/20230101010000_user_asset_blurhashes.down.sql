@@ -0,0 +1,3 @@
+ALTER TABLE user_assets
+    DROP COLUMN IF EXISTS image_blurhash,
+    DROP COLUMN IF EXISTS image_blurhash_url;
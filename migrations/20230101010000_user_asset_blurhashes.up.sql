@@ -0,0 +1,4 @@
+-- a blurhash placeholder of the asset's image, image_blurhash_url is the image it was made from so a new image gets a new one
+ALTER TABLE user_assets
+    ADD COLUMN image_blurhash     TEXT,
+    ADD COLUMN image_blurhash_url TEXT;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS syndicate_asset_transfers;
+
+DELETE FROM users WHERE role_id = '4d6e2b1a-93c7-4f58-a0e2-7b1c5d9f3a68';
+DELETE FROM roles WHERE id = '4d6e2b1a-93c7-4f58-a0e2-7b1c5d9f3a68';
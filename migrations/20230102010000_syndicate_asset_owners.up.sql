@@ -0,0 +1,19 @@
+INSERT INTO roles (id, name, permissions)
+VALUES ('4d6e2b1a-93c7-4f58-a0e2-7b1c5d9f3a68', 'Syndicate', '{}');
+
+-- a syndicate owns assets through a user sharing its id and account, so everything referencing asset owners keeps working
+INSERT INTO users (id, username, role_id, verified, faction_id, account_id)
+SELECT s.id, 'Syndicate-' || s.id, '4d6e2b1a-93c7-4f58-a0e2-7b1c5d9f3a68', true, s.faction_id, s.account_id
+FROM syndicates s;
+
+-- the member behind each move of an asset into or out of a syndicate, liquidations record who received the asset
+CREATE TABLE syndicate_asset_transfers
+(
+    transfer_event_id BIGINT PRIMARY KEY REFERENCES asset_transfer_events (id),
+    syndicate_id      UUID        NOT NULL REFERENCES syndicates (id),
+    member_id         UUID        NOT NULL REFERENCES users (id),
+    kind              TEXT        NOT NULL CHECK (kind IN ('DEPOSIT', 'WITHDRAW', 'LIQUIDATION')),
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_syndicate_asset_transfers_syndicate_id ON syndicate_asset_transfers (syndicate_id, created_at DESC);
@@ -0,0 +1,2 @@
+ALTER TABLE pending_refund
+    DROP COLUMN rollback_claimed_at;
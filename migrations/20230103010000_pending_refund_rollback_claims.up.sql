@@ -0,0 +1,3 @@
+-- Set when a rollback is claimed, before the SUPS are moved, so only one process can reverse a withdraw
+ALTER TABLE pending_refund
+    ADD COLUMN rollback_claimed_at TIMESTAMPTZ;
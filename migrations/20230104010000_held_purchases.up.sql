@@ -0,0 +1,19 @@
+-- Purchases made while the price oracle was paused, with no trusted SUPS price from when they were made.
+-- They are credited once an admin releases them with a SUPS price.
+CREATE TABLE held_purchases
+(
+    id             UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    tx_hash        TEXT        NOT NULL UNIQUE,
+    symbol         TEXT        NOT NULL,
+    user_id        UUID        NOT NULL REFERENCES users (id),
+    record         JSONB       NOT NULL,
+    reason         TEXT        NOT NULL,
+    purchased_at   TIMESTAMPTZ,
+    released_at    TIMESTAMPTZ,
+    released_by    TEXT,
+    sups_usd_rate  NUMERIC,
+    transaction_id TEXT,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_held_purchases_unreleased ON held_purchases (created_at) WHERE released_at IS NULL;
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_asset_trades_unsettled;
+
+ALTER TABLE asset_trades
+    DROP COLUMN IF EXISTS offer_escrow_tx_id,
+    DROP COLUMN IF EXISTS request_escrow_tx_id,
+    DROP COLUMN IF EXISTS sups_settled_at;
+
+DELETE FROM users WHERE id = 'c3a9f2d6-1e84-4b7a-9f05-6b2d8e4a1c73';
+DELETE FROM roles WHERE id = '5e2c8a17-b4d9-4c60-a3f1-7d9e0b6c2a48';
+DELETE FROM accounts WHERE id = '0d7b5e4c-9a61-4f38-8c2e-51a3f6e9b704';
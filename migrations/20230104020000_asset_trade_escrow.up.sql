@@ -0,0 +1,28 @@
+-- Offered assets and SUPS are held by the escrow user from when the offer is made until the trade is settled
+INSERT INTO accounts (id, type, sups)
+VALUES ('0d7b5e4c-9a61-4f38-8c2e-51a3f6e9b704', 'USER', 0);
+
+INSERT INTO roles (id, name, permissions)
+VALUES ('5e2c8a17-b4d9-4c60-a3f1-7d9e0b6c2a48', 'Trade Escrow', '{}');
+
+INSERT INTO users (id, username, role_id, verified, account_id)
+VALUES ('c3a9f2d6-1e84-4b7a-9f05-6b2d8e4a1c73', 'Xsyn-Trade-Escrow', '5e2c8a17-b4d9-4c60-a3f1-7d9e0b6c2a48', true, '0d7b5e4c-9a61-4f38-8c2e-51a3f6e9b704');
+
+-- the escrow transactions, offer_tx_id and request_tx_id are the payouts once a trade is accepted
+ALTER TABLE asset_trades
+    ADD COLUMN offer_escrow_tx_id   TEXT,
+    ADD COLUMN request_escrow_tx_id TEXT,
+    ADD COLUMN sups_settled_at      TIMESTAMPTZ;
+
+-- offers made before escrow have nothing held, close them so they can't be accepted with assets that moved since
+UPDATE asset_trades
+SET status        = 'CANCELED',
+    failed_reason = 'offers now hold their assets, please make the offer again',
+    completed_at  = NOW(),
+    updated_at    = NOW()
+WHERE status = 'PENDING';
+
+UPDATE asset_trades
+SET sups_settled_at = COALESCE(completed_at, NOW());
+
+CREATE INDEX idx_asset_trades_unsettled ON asset_trades (updated_at) WHERE status != 'PENDING' AND sups_settled_at IS NULL;
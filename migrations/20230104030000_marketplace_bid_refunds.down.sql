@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_marketplace_bids_refund_due;
+
+ALTER TABLE marketplace_bids
+    DROP COLUMN IF EXISTS refund_due_at,
+    DROP COLUMN IF EXISTS refund_reason;
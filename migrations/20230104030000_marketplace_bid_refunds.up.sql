@@ -0,0 +1,13 @@
+-- an outbid bid is marked refund due in the same transaction as the bid that beat it, the refund is made from that record
+ALTER TABLE marketplace_bids
+    ADD COLUMN refund_due_at TIMESTAMPTZ,
+    ADD COLUMN refund_reason TEXT;
+
+-- bids that were beaten but never refunded
+UPDATE marketplace_bids mb
+SET refund_due_at = NOW(),
+    refund_reason = 'outbid'
+WHERE mb.refund_tx_id IS NULL
+  AND NOT EXISTS(SELECT 1 FROM marketplace_listings ml WHERE ml.current_bid_tx_id = mb.tx_id);
+
+CREATE INDEX idx_marketplace_bids_refund_due ON marketplace_bids (refund_due_at) WHERE refund_due_at IS NOT NULL AND refund_tx_id IS NULL;
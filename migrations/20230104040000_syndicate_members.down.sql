@@ -0,0 +1,4 @@
+ALTER TABLE asset_rentals
+    DROP COLUMN IF EXISTS accepted_by_id;
+
+DROP TABLE IF EXISTS syndicate_members;
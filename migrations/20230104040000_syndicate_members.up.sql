@@ -0,0 +1,23 @@
+-- syndicate members and what they may do, kept by supremacy and synced here so xsyn can check who spends syndicate funds or moves its assets
+CREATE TABLE syndicate_members
+(
+    syndicate_id      UUID        NOT NULL REFERENCES syndicates (id),
+    user_id           UUID        NOT NULL REFERENCES users (id),
+    role              TEXT        NOT NULL DEFAULT 'MEMBER',
+    can_spend_funds   BOOLEAN     NOT NULL DEFAULT FALSE,
+    can_manage_assets BOOLEAN     NOT NULL DEFAULT FALSE,
+    synced_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (syndicate_id, user_id)
+);
+
+CREATE INDEX idx_syndicate_members_user_id ON syndicate_members (user_id);
+
+-- founders can act for their syndicate until supremacy syncs the members
+INSERT INTO syndicate_members (syndicate_id, user_id, role, can_spend_funds, can_manage_assets)
+SELECT s.id, s.founded_by_id, 'FOUNDER', TRUE, TRUE
+FROM syndicates s
+WHERE s.deleted_at IS NULL;
+
+-- the member who accepted a rental, for syndicate rentals this is who spent the syndicate's SUPS on the fee
+ALTER TABLE asset_rentals
+    ADD COLUMN accepted_by_id UUID REFERENCES users (id);
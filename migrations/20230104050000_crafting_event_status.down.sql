@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_crafting_events_unsettled;
+
+ALTER TABLE crafting_events
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS failed_reason,
+    DROP COLUMN IF EXISTS refund_tx_id,
+    DROP COLUMN IF EXISTS settled_at;
@@ -0,0 +1,14 @@
+-- a crafting event is recorded before its SUPS are charged, so a charge whose crafting never committed can be found and refunded
+ALTER TABLE crafting_events
+    ADD COLUMN status        TEXT NOT NULL DEFAULT 'COMPLETE' CHECK (status IN ('PENDING', 'COMPLETE', 'FAILED')),
+    ADD COLUMN failed_reason TEXT,
+    ADD COLUMN refund_tx_id  TEXT,
+    ADD COLUMN settled_at    TIMESTAMPTZ;
+
+UPDATE crafting_events
+SET settled_at = created_at;
+
+ALTER TABLE crafting_events
+    ALTER COLUMN status SET DEFAULT 'PENDING';
+
+CREATE INDEX idx_crafting_events_unsettled ON crafting_events (created_at) WHERE settled_at IS NULL;
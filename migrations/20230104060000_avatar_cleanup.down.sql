@@ -0,0 +1,5 @@
+ALTER TABLE crafting_events
+    DROP COLUMN IF EXISTS avatar_cleared;
+
+ALTER TABLE asset_transfer_events
+    DROP COLUMN IF EXISTS avatar_cleared;
@@ -0,0 +1,6 @@
+-- whether the event cleared the avatar of the user giving up the asset, so the user is only told when it was
+ALTER TABLE asset_transfer_events
+    ADD COLUMN avatar_cleared BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE crafting_events
+    ADD COLUMN avatar_cleared BOOLEAN NOT NULL DEFAULT FALSE;
@@ -6,13 +6,13 @@ import (
 	"sync"
 	"xsyn-services/passport/db"
 	"xsyn-services/passport/email"
+	"xsyn-services/passport/media"
 	"xsyn-services/passport/passlog"
 	"xsyn-services/passport/payments"
 	"xsyn-services/types"
 
 	"github.com/meehow/securebytes"
 	"github.com/ninja-software/log_helpers"
-	"github.com/ninja-software/terror/v2"
 	"go.uber.org/atomic"
 
 	"github.com/shopspring/decimal"
@@ -57,6 +57,7 @@ type API struct {
 	SupremacyWorldWebhookToken string
 	SupremacyWorldHostUrl      string
 	Commander                  *ws.Commander
+	PublicCommander            *ws.Commander
 	Web3Params                 *types.Web3Params
 	botSecretKey               string
 
@@ -82,6 +83,12 @@ type API struct {
 	JWTKey []byte
 
 	Environment types.Environment
+
+	// image proxy for asset and faction media
+	Media *media.Proxy
+
+	// checks avant for a SUPS withdraw before it is force rolled back
+	withdrawLookup *payments.WithdrawLookup
 }
 
 // NewAPI registers routes
@@ -101,11 +108,16 @@ func NewAPI(
 	environment types.Environment,
 	ignoreRateLimitIPs []string,
 	pxr *PassportExchangeRate,
-
+	mediaProxy *media.Proxy,
 ) (*API, chi.Router) {
 
 	api := &API{
-		Web3Params:  config.Web3Params,
+		Web3Params: config.Web3Params,
+		withdrawLookup: &payments.WithdrawLookup{
+			Testnet:     isTestnetBlockchain,
+			ContractBSC: config.Web3Params.SupWithdrawalAddrBSC,
+			ContractETH: config.Web3Params.SupWithdrawalAddrETH,
+		},
 		ClientToken: config.AuthParams.GameserverToken,
 		// webhook setup
 		GameserverWebhookToken:     config.WebhookParams.GameserverWebhookToken,
@@ -151,11 +163,29 @@ func NewAPI(
 			verifyUrl: "https://hcaptcha.com/siteverify",
 		},
 		Environment: environment,
+		Media:       mediaProxy,
 	}
 
 	api.Commander = ws.NewCommander(func(c *ws.Commander) {
 		c.RestBridge("/rest")
 	})
+	// commands that don't need a user
+	api.PublicCommander = ws.NewCommander(func(c *ws.Commander) {
+		c.Command(HubKeySUPSExchangeRateHistory, ExchangeRateHistoryWSHandler)
+		c.Command(HubKeyAssetHistory, AssetHistoryHandler)
+	})
+
+	// push the exchange rates whenever the oracle prices a symbol, the price listeners update it every 10 seconds
+	go pxr.Run()
+	payments.OracleOnUpdate(func(price *payments.OraclePrice) {
+		pxr.Notify()
+	})
+
+	// hand back assets whose service stopped renewing its lock
+	go ReapServiceLocks()
+
+	// refund crafting that was charged but never completed
+	go ReconcileCrafting(ucm)
 
 	cc := NewChainClients(log, api, config.Web3Params, isTestnetBlockchain, runBlockchainBridge, enablePurchaseSubscription)
 	r := chi.NewRouter()
@@ -184,7 +214,7 @@ func NewAPI(
 		_ = NewSupController(log, api, cc)
 	}
 
-	_ = NewAssetController(log, api)
+	ac := NewAssetController(log, api)
 	_ = NewCollectionController(log, api, isTestnetBlockchain)
 
 	_ = NewCheckController(log, api)
@@ -199,6 +229,9 @@ func NewAPI(
 		ClientSecret: config.AuthParams.DiscordClientSecret,
 	})
 	_ = NewTransactionController(log, api)
+	tc := NewTradeController(log, api)
+	mc := NewMarketplaceController(log, api)
+	rc := NewRentalController(log, api)
 	_ = NewFactionController(log, api)
 	_ = NewRoleController(log, api)
 	sc := NewSupremacyController(log, api)
@@ -218,6 +251,7 @@ func NewAPI(
 			r.Use(sentryHandler.Handle)
 			r.Mount("/check", CheckRouter(log_helpers.NamedLogger(log, "check router")))
 			r.Mount("/files", FileRouter(api))
+			r.Mount("/media", MediaRouter(api))
 			r.Mount("/nfts", api.NFTRoutes())
 			r.Mount("/moderator", ModeratorRoutes())
 			if environment == types.Development {
@@ -235,15 +269,24 @@ func NewAPI(
 				r.Get("/withdraw/{address}/{nonce}/{amount}/{chain}", WithError(api.WithdrawSups))
 
 				r.Get("/1155/{address}/{token_id}/{nonce}/{amount}", WithError(api.Withdraw1155))
+				r.Get("/1155/{collection_slug}/{address}/{token_id}/{nonce}/{amount}", WithError(api.Withdraw1155))
 			}
 			r.Get("/1155/contracts", WithError(api.Get1155Contracts))
+			r.Get("/exchange_rates/history", WithError(ExchangeRateHistoryHandler))
+			r.Get("/marketplace/listings", WithError(MarketplaceListingsHandler))
+			r.Get("/marketplace/listings/{listing_id}", WithError(MarketplaceListingHandler))
 
 			r.Get("/asset/{hash}", WithError(api.AssetGet))
 			r.Get("/asset/{collection_address}/{token_id}", WithError(api.AssetGetByCollectionAndTokenID))
+			r.Get("/asset/{hash}/history", WithError(api.AssetHistoryByHash))
+			r.Get("/asset/{collection_address}/{token_id}/history", WithError(api.AssetHistoryByCollectionAndTokenID))
+			r.Get("/asset/{collection_address}/{token_id}/royalty", WithError(api.AssetRoyaltyInfo))
 			r.Get("/whitelist/check", WithError(api.WhitelistOnlyWalletCheck))
 
 			r.Get("/collection/1155/all", WithError(api.Get1155Collections))
 			r.Get("/collection/{collection_slug}", WithError(api.Get1155Collection))
+			r.Get("/collection/contract/{collection_address}", WithError(api.CollectionContractMetadata))
+			r.Post("/collection/{collection_slug}/search", WithError(api.CollectionAssetSearch))
 
 			r.Route("/early", func(r chi.Router) {
 				r.Get("/check", WithError(api.CheckUserEarlyContributor))
@@ -278,13 +321,19 @@ func NewAPI(
 		r.Route("/ws", func(r chi.Router) {
 			r.Use(ws.TrimPrefix("/api/ws"))
 			r.Mount("/public", ws.NewServer(func(s *ws.Server) {
-				s.WS("/exchange_rates", HubKeySUPSExchangeRates, WithPassportExchangeRate(pxr, ExchangeRatesHandler))
+				s.WS("/exchange_rates", HubKeySUPSExchangeRates, pxr.SubscribeHandler)
 				s.WS("/sups_remaining", HubKeySUPSRemainingSubscribe, uc.TotalSupRemainingHandler)
+				s.WS("/marketplace/{listing_id}", HubKeyMarketplaceListingUpdate, mc.ListingSubscribeHandler)
+				s.Mount("/commander", api.PublicCommander)
 			}))
 			r.Mount("/store", ws.NewServer(func(s *ws.Server) {
 			}))
 			r.Mount("/user/{userId}", ws.NewServer(func(s *ws.Server) {
 				s.Use(api.AuthWS(true, true, false))
+				s.WS("/trades", HubKeyTradeSubscribe, api.MustSecure(tc.TradeSubscribeHandler))
+				s.WS("/marketplace", HubKeyMarketplaceListingUpdate, api.MustSecure(mc.SellerListingsSubscribeHandler))
+				s.WS("/rentals", HubKeyRentalSubscribe, api.MustSecure(rc.RentalSubscribeHandler))
+				s.WS("/1155_transfers", HubKeyAsset1155TransferSubscribe, api.MustSecure(ac.Asset1155TransferSubscribeHandler))
 				s.WS("/*", HubKeyUserGet, api.MustSecure(uc.GetHandler))
 				s.Mount("/commander", api.Commander)
 			}))
@@ -344,6 +393,25 @@ func (api *API) RecordUserActivity(
 type PassportExchangeRate struct {
 	isEnabled           atomic.Bool
 	isCurrentBlockAfter atomic.Bool
+
+	// latest rates sent to the exchange rate subscribers
+	updated  chan struct{}
+	latestMu sync.RWMutex
+	latest   *payments.PriceExchangeRates
+
+	// swapped out by tests
+	fetch   func(passportExchangeRatesEnabled bool) (*payments.PriceExchangeRates, error)
+	publish func(exchangeRates *payments.PriceExchangeRates)
+}
+
+func NewPassportExchangeRate() *PassportExchangeRate {
+	return &PassportExchangeRate{
+		updated: make(chan struct{}, 1),
+		fetch:   payments.FetchExchangeRates,
+		publish: func(exchangeRates *payments.PriceExchangeRates) {
+			ws.PublishMessage("/public/exchange_rates", HubKeySUPSExchangeRates, exchangeRates)
+		},
+	}
 }
 
 func (pxr *PassportExchangeRate) GetIsEnable() bool {
@@ -362,19 +430,4 @@ func (pxr *PassportExchangeRate) SetIsCurrentBlockAfterTrue() {
 	pxr.isCurrentBlockAfter.Store(true)
 }
 
-func WithPassportExchangeRate(pxr *PassportExchangeRate, fn func(ctx context.Context, pxr *PassportExchangeRate, key string, payload []byte, reply ws.ReplyFunc) error) ws.CommandFunc {
-	return func(ctx context.Context, key string, payload []byte, reply ws.ReplyFunc) error {
-		return fn(ctx, pxr, key, payload, reply)
-	}
-}
-
 const HubKeySUPSExchangeRates = "SUPS:EXCHANGE"
-
-func ExchangeRatesHandler(ctx context.Context, pxr *PassportExchangeRate, key string, payload []byte, reply ws.ReplyFunc) error {
-	exchangeRates, err := payments.FetchExchangeRates(pxr.GetIsEnable() && pxr.GetIsCurrentBlockAfter())
-	if err != nil {
-		return terror.Error(err, "Unable to fetch exchange rates.")
-	}
-	reply(exchangeRates)
-	return nil
-}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/helpers"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ninja-software/terror/v2"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Craft burns a recipe's inputs and SUPS cost and gives the user its outputs, all or nothing.
+// The crafting event is recorded before the SUPS are charged, so a charge whose crafting can't be committed is refunded from it.
+func Craft(ucm *Transactor, req *asset.CraftRequest) (*boiler.CraftingEvent, error) {
+	recipe, err := asset.ActiveCraftingRecipe(req.RecipeID)
+	if err != nil {
+		return nil, fmt.Errorf("recipe %s: %w", req.RecipeID, err)
+	}
+
+	err = asset.PrepareCraftingOutputs1155(recipe, req)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := asset.StartCraftingEvent(recipe, req)
+	if err != nil {
+		return nil, err
+	}
+	fail := func(reason string) {
+		_, err := asset.FailCraftingEvent(ucm, event.ID, reason)
+		if err != nil {
+			passlog.L.Error().Err(err).Str("crafting_event_id", event.ID).Msg("failed to fail crafting event, it will be retried")
+		}
+	}
+
+	txID := null.String{}
+	if recipe.SupsCost.GreaterThan(decimal.Zero) {
+		id, err := asset.ChargeCrafting(ucm, event, recipe)
+		if err != nil {
+			fail("crafting payment failed")
+			return nil, fmt.Errorf("crafting payment failed: %w", err)
+		}
+		txID = null.StringFrom(id)
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		fail("failed to craft")
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	event, err = asset.CraftTx(tx, recipe, req, event.ID, txID)
+	if err != nil {
+		tx.Rollback()
+		fail("failed to craft")
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		fail("failed to craft")
+		return nil, err
+	}
+
+	if event.AvatarCleared {
+		asset.PublishUserAvatar(req.UserID)
+	}
+	return event, nil
+}
+
+// ReconcileCrafting fails crafting events that never completed and refunds their SUPS
+func ReconcileCrafting(ucm *Transactor) {
+	ticker := time.NewTicker(time.Minute)
+	for range ticker.C {
+		events, err := asset.UnsettledCraftingEvents()
+		if err != nil {
+			passlog.L.Error().Err(err).Msg("failed to get unsettled crafting events")
+			continue
+		}
+		for _, event := range events {
+			_, err := asset.FailCraftingEvent(ucm, event.ID, "crafting timed out")
+			if err != nil {
+				passlog.L.Error().Err(err).Str("crafting_event_id", event.ID).Msg("failed to settle crafting event")
+			}
+		}
+	}
+}
+
+// AdminCraftingRecipeList lists every recipe with its inputs and outputs
+func AdminCraftingRecipeList(w http.ResponseWriter, r *http.Request) (int, error) {
+	recipes, err := boiler.CraftingRecipes(
+		qm.Load(boiler.CraftingRecipeRels.RecipeCraftingRecipeItems),
+		qm.OrderBy(fmt.Sprintf("%s DESC", boiler.CraftingRecipeColumns.CreatedAt)),
+	).All(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get recipes.")
+	}
+
+	return helpers.EncodeJSON(w, recipes)
+}
+
+// AdminCraftingRecipeCreate creates a recipe, it is active straight away
+func AdminCraftingRecipeCreate(w http.ResponseWriter, r *http.Request) (int, error) {
+	apiKey, err := AdminAPIKey(r)
+	if err != nil {
+		return http.StatusUnauthorized, terror.Error(err, "Failed to get admin user.")
+	}
+
+	req := &asset.CraftingRecipeRequest{}
+	err = json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Could not decode json")
+	}
+
+	recipe, err := asset.CreateCraftingRecipe(req, apiKey.UserID)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, fmt.Sprintf("Failed to create recipe: %s.", err.Error()))
+	}
+
+	return helpers.EncodeJSON(w, recipe)
+}
+
+// AdminCraftingRecipeSetActive turns a recipe on or off
+func AdminCraftingRecipeSetActive(active bool) func(w http.ResponseWriter, r *http.Request) (int, error) {
+	return func(w http.ResponseWriter, r *http.Request) (int, error) {
+		recipe, err := asset.SetCraftingRecipeActive(chi.URLParam(r, "recipe_id"), active)
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusNotFound, terror.Error(err, "Recipe not found.")
+		}
+		if err != nil {
+			return http.StatusInternalServerError, terror.Error(err, "Failed to update recipe.")
+		}
+
+		return helpers.EncodeJSON(w, recipe)
+	}
+}
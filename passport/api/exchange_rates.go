@@ -0,0 +1,249 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/helpers"
+	"xsyn-services/passport/passlog"
+	"xsyn-services/passport/payments"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"github.com/shopspring/decimal"
+)
+
+const HubKeySUPSExchangeRateHistory = "SUPS:EXCHANGE:HISTORY"
+
+// Notify tells the publisher that a rate may have changed, notifications are merged while a fetch is running
+func (pxr *PassportExchangeRate) Notify() {
+	select {
+	case pxr.updated <- struct{}{}:
+	default:
+	}
+}
+
+// Run fetches the exchange rates once per notification and pushes them to the subscribers when they change
+func (pxr *PassportExchangeRate) Run() {
+	for range pxr.updated {
+		_, err := pxr.refresh()
+		if err != nil {
+			passlog.L.Error().Err(err).Msg("failed to refresh exchange rates")
+		}
+	}
+}
+
+func (pxr *PassportExchangeRate) refresh() (*payments.PriceExchangeRates, error) {
+	exchangeRates, err := pxr.fetch(pxr.GetIsEnable() && pxr.GetIsCurrentBlockAfter())
+	if err != nil {
+		return nil, err
+	}
+
+	pxr.latestMu.Lock()
+	changed := pxr.latest == nil ||
+		!pxr.latest.SUPtoUSD.Equal(exchangeRates.SUPtoUSD) ||
+		!pxr.latest.ETHtoUSD.Equal(exchangeRates.ETHtoUSD) ||
+		!pxr.latest.BNBtoUSD.Equal(exchangeRates.BNBtoUSD) ||
+		pxr.latest.EnableSale != exchangeRates.EnableSale
+	pxr.latest = exchangeRates
+	pxr.latestMu.Unlock()
+
+	if changed {
+		pxr.publish(exchangeRates)
+	}
+	return exchangeRates, nil
+}
+
+// Latest returns the last published exchange rates, they are only fetched here before the first update
+func (pxr *PassportExchangeRate) Latest() (*payments.PriceExchangeRates, error) {
+	pxr.latestMu.RLock()
+	latest := pxr.latest
+	pxr.latestMu.RUnlock()
+	if latest != nil {
+		return latest, nil
+	}
+	return pxr.refresh()
+}
+
+// SubscribeHandler sends a snapshot of the exchange rates on join, updates are pushed by Run
+func (pxr *PassportExchangeRate) SubscribeHandler(ctx context.Context, key string, payload []byte, reply ws.ReplyFunc) error {
+	exchangeRates, err := pxr.Latest()
+	if err != nil {
+		return terror.Error(err, "Unable to fetch exchange rates.")
+	}
+	reply(exchangeRates)
+	return nil
+}
+
+type ExchangeRateHistoryRequest struct {
+	Symbol string `json:"symbol"`
+	// unix seconds, defaults to the last day
+	From       int64  `json:"from"`
+	To         int64  `json:"to"`
+	Resolution string `json:"resolution"`
+}
+
+type ExchangeRateHistoryItem struct {
+	ID          string          `json:"id"`
+	USD         decimal.Decimal `json:"usd"`
+	MinUSD      decimal.Decimal `json:"min_usd"`
+	MaxUSD      decimal.Decimal `json:"max_usd"`
+	Sources     string          `json:"sources"`
+	Trusted     bool            `json:"trusted"`
+	Resolution  string          `json:"resolution"`
+	SampleCount int             `json:"sample_count"`
+	RecordedAt  time.Time       `json:"recorded_at"`
+}
+
+type ExchangeRateHistoryResponse struct {
+	Symbol string                     `json:"symbol"`
+	From   time.Time                  `json:"from"`
+	To     time.Time                  `json:"to"`
+	Rates  []*ExchangeRateHistoryItem `json:"rates"`
+}
+
+func exchangeRateHistory(req *ExchangeRateHistoryRequest) (*ExchangeRateHistoryResponse, error) {
+	valid := false
+	for _, symbol := range payments.OracleSymbols {
+		if symbol == req.Symbol {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return nil, fmt.Errorf("invalid symbol %s", req.Symbol)
+	}
+
+	switch req.Resolution {
+	case "", db.ExchangeRateResolutionRaw, db.ExchangeRateResolutionHour, db.ExchangeRateResolutionDay:
+	default:
+		return nil, fmt.Errorf("invalid resolution %s", req.Resolution)
+	}
+
+	to := time.Now()
+	if req.To > 0 {
+		to = time.Unix(req.To, 0)
+	}
+	from := to.Add(-24 * time.Hour)
+	if req.From > 0 {
+		from = time.Unix(req.From, 0)
+	}
+	if from.After(to) {
+		return nil, fmt.Errorf("from is after to")
+	}
+
+	rates, err := db.ExchangeRateHistory(req.Symbol, from, to, req.Resolution)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ExchangeRateHistoryResponse{
+		Symbol: req.Symbol,
+		From:   from,
+		To:     to,
+		Rates:  []*ExchangeRateHistoryItem{},
+	}
+	for _, rate := range rates {
+		resp.Rates = append(resp.Rates, exchangeRateHistoryItem(rate))
+	}
+	return resp, nil
+}
+
+func exchangeRateHistoryItem(rate *boiler.ExchangeRate) *ExchangeRateHistoryItem {
+	return &ExchangeRateHistoryItem{
+		ID:          rate.ID,
+		USD:         rate.Usd,
+		MinUSD:      rate.MinUsd,
+		MaxUSD:      rate.MaxUsd,
+		Sources:     rate.Sources,
+		Trusted:     rate.Trusted,
+		Resolution:  rate.Resolution,
+		SampleCount: rate.SampleCount,
+		RecordedAt:  rate.RecordedAt,
+	}
+}
+
+// ExchangeRateHistoryHandler returns the recorded rates of a symbol, from and to are unix seconds
+func ExchangeRateHistoryHandler(w http.ResponseWriter, r *http.Request) (int, error) {
+	req := &ExchangeRateHistoryRequest{
+		Symbol:     r.URL.Query().Get("symbol"),
+		Resolution: r.URL.Query().Get("resolution"),
+	}
+	var err error
+	if from := r.URL.Query().Get("from"); from != "" {
+		req.From, err = strconv.ParseInt(from, 10, 64)
+		if err != nil {
+			return http.StatusBadRequest, terror.Error(err, "Invalid from timestamp.")
+		}
+	}
+	if to := r.URL.Query().Get("to"); to != "" {
+		req.To, err = strconv.ParseInt(to, 10, 64)
+		if err != nil {
+			return http.StatusBadRequest, terror.Error(err, "Invalid to timestamp.")
+		}
+	}
+
+	resp, err := exchangeRateHistory(req)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Failed to get exchange rate history.")
+	}
+	return helpers.EncodeJSON(w, resp)
+}
+
+func ExchangeRateHistoryWSHandler(ctx context.Context, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &struct {
+		Payload ExchangeRateHistoryRequest `json:"payload"`
+	}{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	resp, err := exchangeRateHistory(&req.Payload)
+	if err != nil {
+		return terror.Error(err, "Failed to get exchange rate history.")
+	}
+	reply(resp)
+	return nil
+}
+
+type PurchaseExchangeRateItem struct {
+	TransactionID string                   `json:"transaction_id"`
+	TxHash        string                   `json:"tx_hash"`
+	Symbol        string                   `json:"symbol"`
+	TokenUSDRate  decimal.NullDecimal      `json:"token_usd_rate"`
+	SupsUSDRate   decimal.Decimal          `json:"sups_usd_rate"`
+	ExchangeRate  *ExchangeRateHistoryItem `json:"exchange_rate,omitempty"`
+	CreatedAt     time.Time                `json:"created_at"`
+}
+
+// AdminPurchaseExchangeRates returns the rates a SUPS purchase was priced with
+func AdminPurchaseExchangeRates(w http.ResponseWriter, r *http.Request) (int, error) {
+	links, err := db.PurchaseExchangeRatesByTxHash(chi.URLParam(r, "tx_hash"))
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get purchase exchange rates.")
+	}
+
+	resp := []*PurchaseExchangeRateItem{}
+	for _, link := range links {
+		item := &PurchaseExchangeRateItem{
+			TransactionID: link.TransactionID,
+			TxHash:        link.TXHash,
+			Symbol:        link.Symbol,
+			TokenUSDRate:  link.TokenUsdRate,
+			SupsUSDRate:   link.SupsUsdRate,
+			CreatedAt:     link.CreatedAt,
+		}
+		if link.R != nil && link.R.ExchangeRate != nil {
+			item.ExchangeRate = exchangeRateHistoryItem(link.R.ExchangeRate)
+		}
+		resp = append(resp, item)
+	}
+	return helpers.EncodeJSON(w, resp)
+}
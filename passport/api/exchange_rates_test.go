@@ -0,0 +1,100 @@
+package api
+
+import (
+	"errors"
+	"testing"
+	"xsyn-services/passport/payments"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestPassportExchangeRatePush(t *testing.T) {
+	rates := &payments.PriceExchangeRates{
+		SUPtoUSD:   decimal.NewFromFloat(0.05),
+		ETHtoUSD:   decimal.NewFromInt(1200),
+		BNBtoUSD:   decimal.NewFromInt(250),
+		EnableSale: true,
+	}
+	var fetchErr error
+	fetches := 0
+	published := []*payments.PriceExchangeRates{}
+
+	pxr := NewPassportExchangeRate()
+	pxr.fetch = func(bool) (*payments.PriceExchangeRates, error) {
+		fetches++
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+		copied := *rates
+		return &copied, nil
+	}
+	pxr.publish = func(exchangeRates *payments.PriceExchangeRates) {
+		published = append(published, exchangeRates)
+	}
+
+	t.Run("the first subscriber fetches the rates, later ones get the snapshot", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			latest, err := pxr.Latest()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !latest.SUPtoUSD.Equal(rates.SUPtoUSD) {
+				t.Errorf("SUPtoUSD = %s, want %s", latest.SUPtoUSD, rates.SUPtoUSD)
+			}
+		}
+		if fetches != 1 {
+			t.Errorf("fetched %d times for 3 subscribers, want once", fetches)
+		}
+	})
+
+	t.Run("only changes are pushed", func(t *testing.T) {
+		published = published[:0]
+		_, err := pxr.refresh()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(published) != 0 {
+			t.Errorf("pushed %d unchanged updates", len(published))
+		}
+
+		rates.SUPtoUSD = decimal.NewFromFloat(0.06)
+		_, err = pxr.refresh()
+		if err != nil {
+			t.Fatal(err)
+		}
+		rates.EnableSale = false
+		_, err = pxr.refresh()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(published) != 2 || !published[0].SUPtoUSD.Equal(decimal.NewFromFloat(0.06)) || published[1].EnableSale {
+			t.Errorf("pushed %d updates, want the price change then the sale pause", len(published))
+		}
+	})
+
+	t.Run("a failed fetch keeps the last rates", func(t *testing.T) {
+		published = published[:0]
+		fetchErr = errors.New("no price")
+		_, err := pxr.refresh()
+		if err == nil {
+			t.Fatalf("refresh succeeded without rates")
+		}
+		fetchErr = nil
+		latest, err := pxr.Latest()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !latest.SUPtoUSD.Equal(decimal.NewFromFloat(0.06)) || len(published) != 0 {
+			t.Errorf("latest SUPtoUSD = %s after %d pushes, want the last rates and nothing pushed", latest.SUPtoUSD, len(published))
+		}
+	})
+
+	t.Run("notifications merge while a fetch is pending", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			pxr.Notify()
+		}
+		if len(pxr.updated) != 1 {
+			t.Errorf("%d fetches queued for 5 notifications, want 1", len(pxr.updated))
+		}
+	})
+}
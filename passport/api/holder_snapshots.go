@@ -0,0 +1,187 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/helpers"
+	"xsyn-services/passport/passdb"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type HolderSnapshotCreateRequest struct {
+	Name            string                     `json:"name"`
+	CollectionSlugs []string                   `json:"collection_slugs"`
+	Filters         []*db.AttributeFacetFilter `json:"filters"`
+	At              null.Time                  `json:"at"`
+	BlockNumber     null.Int64                 `json:"block_number"`
+}
+
+// AdminHolderSnapshotCreate queues a holder snapshot, it is taken by the snapshot worker within a minute of its time
+func AdminHolderSnapshotCreate(w http.ResponseWriter, r *http.Request) (int, error) {
+	apiKey, err := AdminAPIKey(r)
+	if err != nil {
+		return http.StatusUnauthorized, terror.Error(err, "Failed to get admin user.")
+	}
+
+	req := &HolderSnapshotCreateRequest{}
+	err = json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Could not decode json")
+	}
+
+	collections, err := boiler.Collections(boiler.CollectionWhere.Slug.IN(req.CollectionSlugs)).All(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get collections.")
+	}
+	if len(collections) != len(req.CollectionSlugs) {
+		return http.StatusBadRequest, terror.Error(fmt.Errorf("collection not found"), "Collection not found.")
+	}
+	collectionIDs := []string{}
+	for _, collection := range collections {
+		collectionIDs = append(collectionIDs, collection.ID)
+	}
+
+	snapshot, err := asset.CreateHolderSnapshot(&asset.HolderSnapshotRequest{
+		Name:          req.Name,
+		CollectionIDs: collectionIDs,
+		Filters:       req.Filters,
+		At:            req.At,
+		BlockNumber:   req.BlockNumber,
+	}, apiKey.UserID)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, fmt.Sprintf("Failed to create snapshot: %s.", err.Error()))
+	}
+
+	return helpers.EncodeJSON(w, snapshot)
+}
+
+// AdminHolderSnapshotList lists holder snapshots, most recent first
+func AdminHolderSnapshotList(w http.ResponseWriter, r *http.Request) (int, error) {
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if pageSize <= 0 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	snapshots, err := boiler.HolderSnapshots(
+		qm.OrderBy(fmt.Sprintf("%s DESC", boiler.HolderSnapshotColumns.CreatedAt)),
+		qm.Limit(pageSize),
+		qm.Offset(page*pageSize),
+	).All(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get snapshots.")
+	}
+
+	return helpers.EncodeJSON(w, snapshots)
+}
+
+// AdminHolderSnapshotGet gets a holder snapshot and its status
+func AdminHolderSnapshotGet(w http.ResponseWriter, r *http.Request) (int, error) {
+	snapshot, err := boiler.FindHolderSnapshot(passdb.StdConn, chi.URLParam(r, "snapshot_id"))
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Error(err, "Snapshot not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get snapshot.")
+	}
+
+	return helpers.EncodeJSON(w, snapshot)
+}
+
+type HolderSnapshotHolder struct {
+	CollectionSlug string      `json:"collection_slug"`
+	UserID         null.String `json:"user_id"`
+	Username       null.String `json:"username"`
+	PublicAddress  null.String `json:"public_address"`
+	Custodial      int         `json:"custodial"`
+	Minted         int         `json:"minted"`
+	Staked         int         `json:"staked"`
+	Total          int         `json:"total"`
+}
+
+// AdminHolderSnapshotHolders exports the holders of a completed snapshot as json, or as csv with ?format=csv
+func AdminHolderSnapshotHolders(w http.ResponseWriter, r *http.Request) (int, error) {
+	snapshot, err := boiler.FindHolderSnapshot(passdb.StdConn, chi.URLParam(r, "snapshot_id"))
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Error(err, "Snapshot not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get snapshot.")
+	}
+	if snapshot.Status != asset.HolderSnapshotComplete {
+		return http.StatusBadRequest, terror.Error(fmt.Errorf("snapshot is %s", snapshot.Status), "Snapshot has not been taken yet.")
+	}
+
+	entries, err := boiler.HolderSnapshotEntries(
+		boiler.HolderSnapshotEntryWhere.SnapshotID.EQ(snapshot.ID),
+		qm.Load(boiler.HolderSnapshotEntryRels.Collection, qm.Select(boiler.CollectionColumns.ID, boiler.CollectionColumns.Slug)),
+		qm.Load(boiler.HolderSnapshotEntryRels.User, qm.Select(boiler.UserColumns.ID, boiler.UserColumns.Username, boiler.UserColumns.PublicAddress)),
+		qm.OrderBy(fmt.Sprintf("%s, %s DESC", boiler.HolderSnapshotEntryColumns.CollectionID, boiler.HolderSnapshotEntryColumns.TotalCount)),
+	).All(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get snapshot holders.")
+	}
+
+	holders := []*HolderSnapshotHolder{}
+	for _, entry := range entries {
+		holder := &HolderSnapshotHolder{
+			CollectionSlug: entry.R.Collection.Slug,
+			UserID:         entry.UserID,
+			PublicAddress:  entry.PublicAddress,
+			Custodial:      entry.CustodialCount,
+			Minted:         entry.MintedCount,
+			Staked:         entry.StakedCount,
+			Total:          entry.TotalCount,
+		}
+		if entry.R.User != nil {
+			holder.Username = null.StringFrom(entry.R.User.Username)
+			holder.PublicAddress = entry.R.User.PublicAddress
+		}
+		holders = append(holders, holder)
+	}
+
+	if r.URL.Query().Get("format") != "csv" {
+		return helpers.EncodeJSON(w, holders)
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="snapshot-%s.csv"`, snapshot.ID))
+	cw := csv.NewWriter(w)
+	err = cw.Write([]string{"collection_slug", "user_id", "username", "public_address", "custodial", "minted", "staked", "total"})
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to write csv.")
+	}
+	for _, holder := range holders {
+		err = cw.Write([]string{
+			holder.CollectionSlug,
+			holder.UserID.String,
+			holder.Username.String,
+			holder.PublicAddress.String,
+			strconv.Itoa(holder.Custodial),
+			strconv.Itoa(holder.Minted),
+			strconv.Itoa(holder.Staked),
+			strconv.Itoa(holder.Total),
+		})
+		if err != nil {
+			return http.StatusInternalServerError, terror.Error(err, "Failed to write csv.")
+		}
+	}
+	cw.Flush()
+	if err = cw.Error(); err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to write csv.")
+	}
+
+	return http.StatusOK, nil
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/helpers"
+	"xsyn-services/passport/passdb"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type MetadataRefreshFailure struct {
+	*boiler.AssetMetadataRefresh
+	Hash string `json:"hash"`
+	Name string `json:"name"`
+}
+
+// AdminMetadataRefreshFailures lists queued metadata refreshes that failed their last attempt, most recent first
+func AdminMetadataRefreshFailures(w http.ResponseWriter, r *http.Request) (int, error) {
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if pageSize <= 0 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	items, err := boiler.AssetMetadataRefreshes(
+		boiler.AssetMetadataRefreshWhere.LastError.IsNotNull(),
+		qm.Load(boiler.AssetMetadataRefreshRels.UserAsset, qm.Select(boiler.UserAssetColumns.ID, boiler.UserAssetColumns.Hash, boiler.UserAssetColumns.Name)),
+		qm.OrderBy(fmt.Sprintf("%s DESC", boiler.AssetMetadataRefreshColumns.LastAttemptAt)),
+		qm.Limit(pageSize),
+		qm.Offset(page*pageSize),
+	).All(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get metadata refresh failures.")
+	}
+
+	resp := []*MetadataRefreshFailure{}
+	for _, item := range items {
+		failure := &MetadataRefreshFailure{AssetMetadataRefresh: item}
+		// burned and deleted assets aren't loaded, the worker drops them from the queue
+		if item.R != nil && item.R.UserAsset != nil {
+			failure.Hash = item.R.UserAsset.Hash
+			failure.Name = item.R.UserAsset.Name
+		}
+		resp = append(resp, failure)
+	}
+
+	return helpers.EncodeJSON(w, resp)
+}
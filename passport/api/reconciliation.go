@@ -0,0 +1,324 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/helpers"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+	"xsyn-services/passport/supremacy_rpcclient"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type AdminReconciliationRunCreateRequest struct {
+	CollectionSlugs []string `json:"collection_slugs"`
+}
+
+// AdminReconciliationRunCreate queues a reconciliation of the collections' assets with the gameserver
+func AdminReconciliationRunCreate(w http.ResponseWriter, r *http.Request) (int, error) {
+	apiKey, err := AdminAPIKey(r)
+	if err != nil {
+		return http.StatusUnauthorized, terror.Error(err, "Failed to get admin user.")
+	}
+
+	req := &AdminReconciliationRunCreateRequest{}
+	err = json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Could not decode json")
+	}
+
+	collections, err := boiler.Collections(boiler.CollectionWhere.Slug.IN(req.CollectionSlugs)).All(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get collections.")
+	}
+	if len(collections) != len(req.CollectionSlugs) {
+		return http.StatusBadRequest, terror.Error(fmt.Errorf("collection not found"), "Collection not found.")
+	}
+	collectionIDs := []string{}
+	for _, collection := range collections {
+		collectionIDs = append(collectionIDs, collection.ID)
+	}
+
+	run, err := asset.CreateReconciliationRun(collectionIDs, apiKey.UserID)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, fmt.Sprintf("Failed to create reconciliation run: %s.", err.Error()))
+	}
+
+	return helpers.EncodeJSON(w, run)
+}
+
+// AdminReconciliationRunList lists reconciliation runs, most recent first
+func AdminReconciliationRunList(w http.ResponseWriter, r *http.Request) (int, error) {
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if pageSize <= 0 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	runs, err := boiler.ReconciliationRuns(
+		qm.OrderBy(fmt.Sprintf("%s DESC", boiler.ReconciliationRunColumns.CreatedAt)),
+		qm.Limit(pageSize),
+		qm.Offset(page*pageSize),
+	).All(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get reconciliation runs.")
+	}
+
+	return helpers.EncodeJSON(w, runs)
+}
+
+// AdminReconciliationRunGet gets a reconciliation run and its progress
+func AdminReconciliationRunGet(w http.ResponseWriter, r *http.Request) (int, error) {
+	run, err := boiler.FindReconciliationRun(passdb.StdConn, chi.URLParam(r, "run_id"))
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Error(err, "Reconciliation run not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get reconciliation run.")
+	}
+
+	return helpers.EncodeJSON(w, run)
+}
+
+// AdminReconciliationDiscrepancyList lists a run's discrepancies, filtered by status when one is given
+func AdminReconciliationDiscrepancyList(w http.ResponseWriter, r *http.Request) (int, error) {
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if pageSize <= 0 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	queries := []qm.QueryMod{
+		boiler.ReconciliationDiscrepancyWhere.RunID.EQ(chi.URLParam(r, "run_id")),
+		qm.OrderBy(fmt.Sprintf("%s, %s", boiler.ReconciliationDiscrepancyColumns.AssetHash, boiler.ReconciliationDiscrepancyColumns.Kind)),
+		qm.Limit(pageSize),
+		qm.Offset(page * pageSize),
+	}
+	if status := r.URL.Query().Get("status"); status != "" {
+		queries = append(queries, boiler.ReconciliationDiscrepancyWhere.Status.EQ(status))
+	}
+
+	discrepancies, err := boiler.ReconciliationDiscrepancies(queries...).All(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get discrepancies.")
+	}
+
+	return helpers.EncodeJSON(w, discrepancies)
+}
+
+type AdminReconciliationRepairRequest struct {
+	// Fix overrides the discrepancy's suggested fix
+	Fix string `json:"fix"`
+}
+
+// AdminReconciliationDiscrepancyRepair applies a fix to a discrepancy once the gameserver is checked again and still disagrees.
+// A fix that fails is recorded on the discrepancy, it can be repaired again or dismissed.
+func AdminReconciliationDiscrepancyRepair(w http.ResponseWriter, r *http.Request) (int, error) {
+	apiKey, err := AdminAPIKey(r)
+	if err != nil {
+		return http.StatusUnauthorized, terror.Error(err, "Failed to get admin user.")
+	}
+
+	req := &AdminReconciliationRepairRequest{}
+	if r.ContentLength != 0 {
+		err = json.NewDecoder(r.Body).Decode(req)
+		if err != nil {
+			return http.StatusBadRequest, terror.Error(err, "Could not decode json")
+		}
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to repair discrepancy.")
+	}
+	defer tx.Rollback()
+
+	discrepancy, err := boiler.ReconciliationDiscrepancies(
+		boiler.ReconciliationDiscrepancyWhere.ID.EQ(chi.URLParam(r, "discrepancy_id")),
+		qm.For("UPDATE"),
+	).One(tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Error(err, "Discrepancy not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get discrepancy.")
+	}
+	if discrepancy.Status != asset.DiscrepancyOpen && discrepancy.Status != asset.DiscrepancyFailed {
+		return http.StatusBadRequest, terror.Error(fmt.Errorf("discrepancy is %s", discrepancy.Status), "Discrepancy has already been resolved.")
+	}
+
+	fix := discrepancy.SuggestedFix
+	if req.Fix != "" {
+		fix = req.Fix
+	}
+	if fix == asset.FixNone {
+		return http.StatusBadRequest, terror.Error(fmt.Errorf("no fix for discrepancy"), "This discrepancy has no fix, it has to be looked into and dismissed.")
+	}
+	if !asset.FixFitsDiscrepancy(discrepancy.Kind, fix) {
+		return http.StatusBadRequest, terror.Error(fmt.Errorf("fix %s does not repair a %s discrepancy", fix, discrepancy.Kind), "This fix doesn't repair this kind of discrepancy.")
+	}
+
+	userAsset, err := boiler.UserAssets(
+		boiler.UserAssetWhere.ID.EQ(discrepancy.UserAssetID),
+		qm.Load(boiler.UserAssetRels.Collection),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get asset.")
+	}
+
+	// the run may be old, only repair what still differs
+	gsAsset, err := asset.GameserverAsset(userAsset.Hash)
+	if err != nil {
+		return http.StatusBadGateway, terror.Error(err, "Failed to get asset from gameserver.")
+	}
+	current, err := asset.CompareWithGameserver(userAsset, gsAsset)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to compare asset with gameserver.")
+	}
+	stillDiffers := false
+	for _, d := range current {
+		if d.Kind == discrepancy.Kind {
+			stillDiffers = true
+		}
+	}
+	if !stillDiffers {
+		return http.StatusConflict, terror.Error(fmt.Errorf("discrepancy no longer present"), "Passport and the gameserver agree now, dismiss the discrepancy.")
+	}
+
+	_, err = tx.Exec("SAVEPOINT repair")
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to repair discrepancy.")
+	}
+	transferEvent, repairErr := repairDiscrepancy(tx, fix, userAsset, gsAsset)
+
+	discrepancy.AppliedFix = null.StringFrom(fix)
+	discrepancy.ResolvedByID = null.StringFrom(apiKey.UserID)
+	discrepancy.ResolvedAt = null.TimeFrom(time.Now())
+	discrepancy.Status = asset.DiscrepancyRepaired
+	discrepancy.RepairError = null.String{}
+	if repairErr != nil {
+		passlog.L.Error().Err(repairErr).Str("discrepancy_id", discrepancy.ID).Str("fix", fix).Msg("failed to repair discrepancy")
+		discrepancy.Status = asset.DiscrepancyFailed
+		discrepancy.RepairError = null.StringFrom(repairErr.Error())
+		_, err = tx.Exec("ROLLBACK TO SAVEPOINT repair")
+		if err != nil {
+			return http.StatusInternalServerError, terror.Error(err, "Failed to repair discrepancy.")
+		}
+	}
+	_, err = discrepancy.Update(tx, boil.Infer())
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to update discrepancy.")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to update discrepancy.")
+	}
+
+	if repairErr == nil && transferEvent != nil && transferEvent.AvatarCleared {
+		asset.PublishUserAvatar(transferEvent.FromUserID)
+	}
+
+	return helpers.EncodeJSON(w, discrepancy)
+}
+
+// repairDiscrepancy applies a fix through the same calls passport makes when an asset is moved or locked normally.
+// Everything passport changes is done on the given tx, so a failed repair is undone with it.
+// The transfer event is returned when the fix moved the asset on passport, for the caller to publish a cleared avatar once committed.
+func repairDiscrepancy(tx boil.Executor, fix string, userAsset *boiler.UserAsset, gsAsset *supremacy_rpcclient.ReconcileAsset) (*boiler.AssetTransferEvent, error) {
+	if gsAsset == nil {
+		return nil, fmt.Errorf("gameserver doesn't have the asset")
+	}
+
+	switch fix {
+	case asset.FixPushOwner:
+		te := &boiler.AssetTransferEvent{
+			UserAssetID:   userAsset.ID,
+			UserAssetHash: userAsset.Hash,
+			FromUserID:    gsAsset.OwnerID,
+			ToUserID:      userAsset.OwnerID,
+			InitiatedFrom: "XSYN",
+		}
+		err := te.Insert(tx, boil.Infer())
+		if err != nil {
+			return nil, err
+		}
+		return nil, sendAssetTransfer(te)
+	case asset.FixAdoptGameserverOwner:
+		err := asset.CheckNotRented(tx, userAsset.ID)
+		if err != nil {
+			return nil, err
+		}
+		return asset.TransferAssetTx(tx, userAsset, userAsset.OwnerID, gsAsset.OwnerID, xsynTypes.SupremacyGameUserID.String(), null.String{})
+	case asset.FixPushLock:
+		transferLog := &boiler.AssetServiceTransferEvent{
+			UserAssetID:   userAsset.ID,
+			UserID:        userAsset.OwnerID,
+			InitiatedFrom: "XSYN",
+			ToService:     null.StringFrom(xsynTypes.SupremacyGameUserID.String()),
+		}
+		err := transferLog.Insert(tx, boil.Infer())
+		if err != nil {
+			return nil, err
+		}
+		return nil, supremacy_rpcclient.AssetLockToSupremacy(xsynTypes.UserAssetFromBoiler(userAsset), transferLog.ID, false)
+	case asset.FixReleaseGameserverLock:
+		transferLog := &boiler.AssetServiceTransferEvent{
+			UserAssetID:   userAsset.ID,
+			UserID:        userAsset.OwnerID,
+			InitiatedFrom: "XSYN",
+			FromService:   null.StringFrom(xsynTypes.SupremacyGameUserID.String()),
+		}
+		err := transferLog.Insert(tx, boil.Infer())
+		if err != nil {
+			return nil, err
+		}
+		return nil, supremacy_rpcclient.AssetUnlockFromSupremacy(xsynTypes.UserAssetFromBoiler(userAsset), transferLog.ID)
+	case asset.FixRefreshMetadata:
+		return nil, asset.EnqueueMetadataRefresh(tx, userAsset.ID, asset.MetadataRefreshPriorityRequested)
+	}
+	return nil, fmt.Errorf("unknown fix %s", fix)
+}
+
+// AdminReconciliationDiscrepancyDismiss closes a discrepancy without changing anything
+func AdminReconciliationDiscrepancyDismiss(w http.ResponseWriter, r *http.Request) (int, error) {
+	apiKey, err := AdminAPIKey(r)
+	if err != nil {
+		return http.StatusUnauthorized, terror.Error(err, "Failed to get admin user.")
+	}
+
+	discrepancy, err := boiler.FindReconciliationDiscrepancy(passdb.StdConn, chi.URLParam(r, "discrepancy_id"))
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Error(err, "Discrepancy not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get discrepancy.")
+	}
+	if discrepancy.Status != asset.DiscrepancyOpen && discrepancy.Status != asset.DiscrepancyFailed {
+		return http.StatusBadRequest, terror.Error(fmt.Errorf("discrepancy is %s", discrepancy.Status), "Discrepancy has already been resolved.")
+	}
+
+	discrepancy.Status = asset.DiscrepancyDismissed
+	discrepancy.ResolvedByID = null.StringFrom(apiKey.UserID)
+	discrepancy.ResolvedAt = null.TimeFrom(time.Now())
+	_, err = discrepancy.Update(passdb.StdConn, boil.Infer())
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to update discrepancy.")
+	}
+
+	return helpers.EncodeJSON(w, discrepancy)
+}
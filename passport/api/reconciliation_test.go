@@ -0,0 +1,152 @@
+package api
+
+import (
+	"errors"
+	"testing"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+	"xsyn-services/passport/supremacy_rpcclient"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestRepairDiscrepancy(t *testing.T) {
+	passdbtest.Require(t)
+
+	collection := passdbtest.Collection(t)
+
+	repair := func(t *testing.T, fix string, userAsset *boiler.UserAsset, gsAsset *supremacy_rpcclient.ReconcileAsset) error {
+		t.Helper()
+		tx, err := passdb.StdConn.Begin()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer tx.Rollback()
+		_, err = repairDiscrepancy(tx, fix, userAsset, gsAsset)
+		if err != nil {
+			return err
+		}
+		return tx.Commit()
+	}
+
+	t.Run("adopting the gameserver's owner transfers the asset", func(t *testing.T) {
+		owner := passdbtest.User(t)
+		gsOwner := passdbtest.User(t)
+		userAsset := passdbtest.Asset(t, collection, owner)
+
+		err := repair(t, asset.FixAdoptGameserverOwner, userAsset, &supremacy_rpcclient.ReconcileAsset{Hash: userAsset.Hash, OwnerID: gsOwner.ID})
+		if err != nil {
+			t.Fatalf("failed to repair: %s", err)
+		}
+		err = userAsset.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if userAsset.OwnerID != gsOwner.ID {
+			t.Errorf("asset owned by %s, want the gameserver's owner %s", userAsset.OwnerID, gsOwner.ID)
+		}
+		transferred, err := boiler.AssetTransferEvents(
+			boiler.AssetTransferEventWhere.UserAssetID.EQ(userAsset.ID),
+			boiler.AssetTransferEventWhere.FromUserID.EQ(owner.ID),
+			boiler.AssetTransferEventWhere.ToUserID.EQ(gsOwner.ID),
+		).Exists(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !transferred {
+			t.Errorf("no transfer event recorded for the repair")
+		}
+	})
+
+	t.Run("refreshing metadata queues the asset", func(t *testing.T) {
+		userAsset := passdbtest.Asset(t, collection, passdbtest.User(t))
+
+		err := repair(t, asset.FixRefreshMetadata, userAsset, &supremacy_rpcclient.ReconcileAsset{Hash: userAsset.Hash, OwnerID: userAsset.OwnerID})
+		if err != nil {
+			t.Fatalf("failed to repair: %s", err)
+		}
+		queued, err := boiler.AssetMetadataRefreshExists(passdb.StdConn, userAsset.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !queued {
+			t.Errorf("asset not queued for a metadata refresh")
+		}
+	})
+
+	t.Run("nothing is repaired for an asset the gameserver doesn't have", func(t *testing.T) {
+		owner := passdbtest.User(t)
+		userAsset := passdbtest.Asset(t, collection, owner)
+
+		err := repair(t, asset.FixAdoptGameserverOwner, userAsset, nil)
+		if err == nil {
+			t.Fatalf("repaired an asset the gameserver doesn't have")
+		}
+		err = userAsset.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if userAsset.OwnerID != owner.ID {
+			t.Errorf("asset moved to %s", userAsset.OwnerID)
+		}
+	})
+
+	t.Run("adopting the gameserver's owner is undone with the repair's tx", func(t *testing.T) {
+		owner := passdbtest.User(t)
+		userAsset := passdbtest.Asset(t, collection, owner)
+
+		tx, err := passdb.StdConn.Begin()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = repairDiscrepancy(tx, asset.FixAdoptGameserverOwner, userAsset, &supremacy_rpcclient.ReconcileAsset{Hash: userAsset.Hash, OwnerID: passdbtest.User(t).ID})
+		if err != nil {
+			t.Fatalf("failed to repair: %s", err)
+		}
+		err = tx.Rollback()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = userAsset.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if userAsset.OwnerID != owner.ID {
+			t.Errorf("asset moved to %s by a rolled back repair", userAsset.OwnerID)
+		}
+	})
+
+	t.Run("rented assets aren't moved to the gameserver's owner", func(t *testing.T) {
+		owner := passdbtest.User(t)
+		userAsset := passdbtest.Asset(t, collection, owner)
+		err := (&boiler.AssetRental{
+			UserAssetID:   userAsset.ID,
+			OwnerID:       owner.ID,
+			RenterUserID:  null.StringFrom(passdbtest.User(t).ID),
+			DurationHours: 1,
+			Status:        asset.RentalStatusActive,
+			StartsAt:      null.TimeFrom(time.Now()),
+			EndsAt:        null.TimeFrom(time.Now().Add(time.Hour)),
+		}).Insert(passdb.StdConn, boil.Infer())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = repair(t, asset.FixAdoptGameserverOwner, userAsset, &supremacy_rpcclient.ReconcileAsset{Hash: userAsset.Hash, OwnerID: passdbtest.User(t).ID})
+		if !errors.Is(err, asset.ErrAssetRented) {
+			t.Errorf("repair err = %v, want %v", err, asset.ErrAssetRented)
+		}
+		err = userAsset.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if userAsset.OwnerID != owner.ID {
+			t.Errorf("rented asset moved to %s", userAsset.OwnerID)
+		}
+	})
+}
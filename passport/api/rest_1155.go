@@ -17,9 +17,55 @@ import (
 	"xsyn-services/passport/api/users"
 	"xsyn-services/passport/db"
 	"xsyn-services/passport/passdb"
+	"xsyn-services/types"
 )
 
+// achievementsCollectionSlug is the 1155 collection the achievements key signed for before collections had their own keys
+const achievementsCollectionSlug = "supremacy-achievements"
+
+// withdraw1155SignerKey gets the key that signs withdrawals from a collection.
+// The achievements collection falls back to the achievements key so existing deployments keep signing without new config.
+func withdraw1155SignerKey(params *types.Web3Params, collectionSlug string) (string, bool) {
+	if key, ok := params.CollectionSignerKeys[collectionSlug]; ok {
+		return key, true
+	}
+	if collectionSlug == achievementsCollectionSlug && params.AchievementsSignerKey != "" {
+		return params.AchievementsSignerKey, true
+	}
+	return "", false
+}
+
+// withdraw1155Collection gets the collection a 1155 withdrawal is for.
+// The route without a collection slug predates multiple 1155 collections, it only works while there is one to pick.
+func withdraw1155Collection(collectionSlug string) (*boiler.Collection, error) {
+	collections, err := db.Bridged1155Collections()
+	if err != nil {
+		return nil, err
+	}
+	for _, collection := range collections {
+		if (collectionSlug == "" && len(collections) == 1) || collection.Slug == collectionSlug {
+			return collection, nil
+		}
+	}
+	if collectionSlug == "" {
+		return nil, fmt.Errorf("collection slug is required when there are %d 1155 collections", len(collections))
+	}
+	return nil, fmt.Errorf("%s is not a 1155 collection", collectionSlug)
+}
+
+// Withdraw1155 signs a withdrawal of 1155 tokens to the user's wallet with the collection's signer and holds the amount back until the sync sees it on chain
 func (api *API) Withdraw1155(w http.ResponseWriter, r *http.Request) (int, error) {
+	collection, err := withdraw1155Collection(chi.URLParam(r, "collection_slug"))
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Invalid collection.")
+	}
+
+	// each collection is signed for with its own key, a shared key would sign withdrawals from any collection
+	signerKey, ok := withdraw1155SignerKey(api.Web3Params, collection.Slug)
+	if !ok {
+		return http.StatusBadRequest, terror.Error(fmt.Errorf("no signer configured for collection %s", collection.Slug), "Withdrawals are not available for this collection.")
+	}
+
 	address := chi.URLParam(r, "address")
 	if address == "" {
 		return http.StatusBadRequest, terror.Error(fmt.Errorf("missing address"), "Missing address.")
@@ -59,6 +105,7 @@ func (api *API) Withdraw1155(w http.ResponseWriter, r *http.Request) (int, error
 
 	userAsset, err := boiler.UserAssets1155S(
 		boiler.UserAssets1155Where.OwnerID.EQ(user.ID),
+		boiler.UserAssets1155Where.CollectionID.EQ(collection.ID),
 		boiler.UserAssets1155Where.ExternalTokenID.EQ(tokenInt),
 		boiler.UserAssets1155Where.ServiceID.IsNull(),
 	).One(passdb.StdConn)
@@ -82,13 +129,13 @@ func (api *API) Withdraw1155(w http.ResponseWriter, r *http.Request) (int, error
 		return http.StatusBadRequest, terror.Error(fmt.Errorf("amount total after withdraw is below 0"), "Amount total after withdraw is less than 0")
 	}
 
-	signer := bridge.NewSigner(api.Web3Params.AchievementsSignerKey)
+	signer := bridge.NewSigner(signerKey)
 	_, messageSig, err := signer.GenerateSignature(toAddress, big.NewInt(int64(tokenInt)), big.NewInt(int64(nonceInt)))
 	if err != nil {
 		return http.StatusInternalServerError, terror.Error(err, "Failed to create withdraw signature, please try again or contact support.")
 	}
 
-	err = db.Withdraw1155AssetWithPendingRollback(amountInt, tokenInt, user.ID)
+	err = db.Withdraw1155AssetWithPendingRollback(amountInt, collection.ID, tokenInt, user.ID)
 	if err != nil {
 		return http.StatusInternalServerError, terror.Error(err, "Failed to process withdrawal. Please contract support or try again")
 	}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"testing"
+	"xsyn-services/types"
+)
+
+func TestWithdraw1155SignerKey(t *testing.T) {
+	params := &types.Web3Params{
+		AchievementsSignerKey: "achievements key",
+		CollectionSignerKeys:  map[string]string{"keycards": "keycards key"},
+	}
+
+	tests := []struct {
+		name   string
+		params *types.Web3Params
+		slug   string
+		want   string
+		wantOK bool
+	}{
+		{"collection key", params, "keycards", "keycards key", true},
+		{"achievements fall back to the achievements key", params, achievementsCollectionSlug, "achievements key", true},
+		{
+			name: "achievements collection key wins over the fallback",
+			params: &types.Web3Params{
+				AchievementsSignerKey: "achievements key",
+				CollectionSignerKeys:  map[string]string{achievementsCollectionSlug: "collection key"},
+			},
+			slug:   achievementsCollectionSlug,
+			want:   "collection key",
+			wantOK: true,
+		},
+		{"other collections don't use the achievements key", params, "other-1155", "", false},
+		{"no keys configured", &types.Web3Params{}, achievementsCollectionSlug, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := withdraw1155SignerKey(tt.params, tt.slug)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("withdraw1155SignerKey() = %q, %t, want %q, %t", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
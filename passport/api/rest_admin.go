@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -55,6 +56,61 @@ func (api *API) AdminRoutes(ucm *Transactor) chi.Router {
 	r.Post("/users/set_admin/{public_address}", WithError(WithAdmin(GiveUserAdminPermission)))
 	r.Post("/users/set_moderator/{public_address}", WithError(WithAdmin(GiveUserModeratorPermission)))
 
+	r.Get("/pending/refunds", WithError(WithAdmin(AdminPendingRefundList)))
+	r.Post("/pending/refunds/{pending_refund_id}/confirm", WithError(WithAdmin(AdminPendingRefundForceConfirm)))
+	r.Post("/pending/refunds/{pending_refund_id}/rollback", WithError(WithAdmin(AdminPendingRefundForceRollback(ucm, api.withdrawLookup))))
+	r.Post("/pending/refunds/{pending_refund_id}/cancel", WithError(WithAdmin(AdminPendingRefundCancel)))
+	r.Get("/pending/1155_rollbacks", WithError(WithAdmin(AdminPending1155RollbackList)))
+	r.Post("/pending/1155_rollbacks/{pending_1155_rollback_id}/confirm", WithError(WithAdmin(AdminPending1155RollbackForceConfirm)))
+	r.Post("/pending/1155_rollbacks/{pending_1155_rollback_id}/rollback", WithError(WithAdmin(AdminPending1155RollbackForceRollback(api.withdrawLookup))))
+	r.Post("/pending/1155_rollbacks/{pending_1155_rollback_id}/cancel", WithError(WithAdmin(AdminPending1155RollbackCancel)))
+
+	r.Get("/vesting/schedules", WithError(WithAdmin(AdminVestingScheduleList)))
+	r.Post("/vesting/schedules", WithError(WithAdmin(AdminVestingScheduleCreate)))
+	r.Put("/vesting/schedules/{schedule_id}", WithError(WithAdmin(AdminVestingScheduleUpdate)))
+	r.Delete("/vesting/schedules/{schedule_id}", WithError(WithAdmin(AdminVestingScheduleDelete)))
+	r.Post("/vesting/schedules/{schedule_id}/unlocks", WithError(WithAdmin(AdminVestingUnlockCreate)))
+	r.Delete("/vesting/unlocks/{unlock_id}", WithError(WithAdmin(AdminVestingUnlockDelete)))
+	r.Post("/vesting/cohorts/{cohort}/members", WithError(WithAdmin(AdminVestingCohortMembersSet)))
+	r.Delete("/vesting/cohorts/members/{public_address}", WithError(WithAdmin(AdminVestingCohortMemberRemove)))
+	r.Get("/vesting/max_withdraw/{public_address}", WithError(WithAdmin(AdminVestingMaxWithdraw)))
+	r.Post("/vesting/import_dispersions", WithError(WithAdmin(AdminVestingImportDispersions)))
+
+	r.Get("/service_locks", WithError(WithAdmin(AdminServiceLockList)))
+	r.Post("/service_locks/{user_asset_id}/release", WithError(WithAdmin(AdminServiceLockRelease)))
+	r.Get("/assets/{asset_hash}/on_chain_status/history", WithError(WithAdmin(AdminOnChainStatusHistory)))
+	r.Put("/assets/{asset_hash}/on_chain_status", WithError(WithAdmin(AdminOnChainStatusSet)))
+	r.Get("/metadata_refreshes/failures", WithError(WithAdmin(AdminMetadataRefreshFailures)))
+
+	r.Get("/collections", WithError(WithAdmin(AdminCollectionList)))
+	r.Post("/collections", WithError(WithAdmin(AdminCollectionCreate)))
+	r.Put("/collections/{collection_slug}", WithError(WithAdmin(AdminCollectionUpdate)))
+	r.Post("/collections/{collection_slug}/archive", WithError(WithAdmin(AdminCollectionArchive)))
+	r.Post("/collections/{collection_slug}/unarchive", WithError(WithAdmin(AdminCollectionUnarchive)))
+	r.Put("/collections/{collection_slug}/contract_metadata", WithError(WithAdmin(AdminCollectionContractMetadataUpdate)))
+
+	r.Get("/snapshots", WithError(WithAdmin(AdminHolderSnapshotList)))
+	r.Post("/snapshots", WithError(WithAdmin(AdminHolderSnapshotCreate)))
+	r.Get("/snapshots/{snapshot_id}", WithError(WithAdmin(AdminHolderSnapshotGet)))
+	r.Get("/snapshots/{snapshot_id}/holders", WithError(WithAdmin(AdminHolderSnapshotHolders)))
+
+	r.Get("/crafting/recipes", WithError(WithAdmin(AdminCraftingRecipeList)))
+	r.Post("/crafting/recipes", WithError(WithAdmin(AdminCraftingRecipeCreate)))
+	r.Post("/crafting/recipes/{recipe_id}/activate", WithError(WithAdmin(AdminCraftingRecipeSetActive(true))))
+	r.Post("/crafting/recipes/{recipe_id}/deactivate", WithError(WithAdmin(AdminCraftingRecipeSetActive(false))))
+	r.Get("/reconciliation/runs", WithError(WithAdmin(AdminReconciliationRunList)))
+	r.Post("/reconciliation/runs", WithError(WithAdmin(AdminReconciliationRunCreate)))
+	r.Get("/reconciliation/runs/{run_id}", WithError(WithAdmin(AdminReconciliationRunGet)))
+	r.Get("/reconciliation/runs/{run_id}/discrepancies", WithError(WithAdmin(AdminReconciliationDiscrepancyList)))
+	r.Post("/reconciliation/discrepancies/{discrepancy_id}/repair", WithError(WithAdmin(AdminReconciliationDiscrepancyRepair)))
+	r.Post("/reconciliation/discrepancies/{discrepancy_id}/dismiss", WithError(WithAdmin(AdminReconciliationDiscrepancyDismiss)))
+
+	r.Get("/price_oracle", WithError(WithAdmin(AdminPriceOracleStatus)))
+	r.Post("/price_oracle/refresh", WithError(WithAdmin(AdminPriceOracleRefresh)))
+	r.Get("/exchange_rates/purchases/{tx_hash}", WithError(WithAdmin(AdminPurchaseExchangeRates)))
+	r.Get("/held_purchases", WithError(WithAdmin(AdminHeldPurchaseList)))
+	r.Post("/held_purchases/{held_purchase_id}/release", WithError(WithAdmin(AdminHeldPurchaseRelease(ucm))))
+
 	return r
 }
 
@@ -75,11 +131,24 @@ func WithAdmin(next func(w http.ResponseWriter, r *http.Request) (int, error)) f
 		if apiKey.Type != "ADMIN" {
 			return http.StatusUnauthorized, terror.Error(fmt.Errorf("not admin key: %s", apiKey.Type), "Unauthorized.")
 		}
-		return next(w, r)
+		return next(w, r.WithContext(context.WithValue(r.Context(), adminAPIKeyContextKey, apiKey)))
 	}
 	return fn
 }
 
+type adminContextKey string
+
+const adminAPIKeyContextKey adminContextKey = "admin_api_key"
+
+// AdminAPIKey returns the api key the request was authorised with by WithAdmin
+func AdminAPIKey(r *http.Request) (*boiler.APIKey, error) {
+	apiKey, ok := r.Context().Value(adminAPIKeyContextKey).(*boiler.APIKey)
+	if !ok || apiKey == nil {
+		return nil, fmt.Errorf("no admin api key in request context")
+	}
+	return apiKey, nil
+}
+
 type TransferAssetRequest struct {
 	From           uuid.UUID      `json:"from"`
 	To             uuid.UUID      `json:"to"`
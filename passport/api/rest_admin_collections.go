@@ -0,0 +1,433 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/helpers"
+	"xsyn-services/passport/passdb"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-chi/chi/v5"
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type CollectionContractMetadataRequest struct {
+	Description        null.String `json:"description"`
+	LogoURL            null.String `json:"logo_url"`
+	ExternalLink       null.String `json:"external_link"`
+	RoyaltyRecipient   null.String `json:"royalty_recipient"`
+	RoyaltyBasisPoints int         `json:"royalty_basis_points"`
+}
+
+// toCollection validates the request and copies it onto the collection
+func (req *CollectionContractMetadataRequest) toCollection(collection *boiler.Collection) error {
+	if req.RoyaltyBasisPoints < 0 || req.RoyaltyBasisPoints > 10000 {
+		return fmt.Errorf("royalty basis points must be between 0 and 10000")
+	}
+	if req.RoyaltyRecipient.Valid {
+		if !common.IsHexAddress(req.RoyaltyRecipient.String) {
+			return fmt.Errorf("royalty recipient is not a valid address")
+		}
+		req.RoyaltyRecipient.String = common.HexToAddress(req.RoyaltyRecipient.String).Hex()
+	}
+	if req.RoyaltyBasisPoints > 0 && !req.RoyaltyRecipient.Valid {
+		return fmt.Errorf("royalty recipient is required when royalty basis points are set")
+	}
+	for _, link := range []null.String{req.LogoURL, req.ExternalLink} {
+		if link.Valid && !strings.HasPrefix(link.String, "https://") {
+			return fmt.Errorf("links must start with https://")
+		}
+	}
+
+	collection.Description = req.Description
+	collection.LogoURL = req.LogoURL
+	collection.ExternalLink = req.ExternalLink
+	collection.RoyaltyRecipient = req.RoyaltyRecipient
+	collection.RoyaltyBasisPoints = req.RoyaltyBasisPoints
+	return nil
+}
+
+func collectionContractMetadataRequestFrom(collection *boiler.Collection) *CollectionContractMetadataRequest {
+	return &CollectionContractMetadataRequest{
+		Description:        collection.Description,
+		LogoURL:            collection.LogoURL,
+		ExternalLink:       collection.ExternalLink,
+		RoyaltyRecipient:   collection.RoyaltyRecipient,
+		RoyaltyBasisPoints: collection.RoyaltyBasisPoints,
+	}
+}
+
+// updateCollectionContractMetadata decodes the request over the collection's current metadata and saves it, so fields left out keep their value and null clears them
+func updateCollectionContractMetadata(slug string, decode func(req *CollectionContractMetadataRequest) error) (*boiler.Collection, error) {
+	collection, err := boiler.Collections(boiler.CollectionWhere.Slug.EQ(slug)).One(passdb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Collection not found.")
+	}
+
+	req := collectionContractMetadataRequestFrom(collection)
+	err = decode(req)
+	if err != nil {
+		return nil, terror.Warn(err, "Invalid request received.")
+	}
+
+	err = req.toCollection(collection)
+	if err != nil {
+		return nil, terror.Warn(err, err.Error())
+	}
+	collection.UpdatedAt = time.Now()
+
+	_, err = collection.Update(passdb.StdConn, boil.Whitelist(
+		boiler.CollectionColumns.Description,
+		boiler.CollectionColumns.LogoURL,
+		boiler.CollectionColumns.ExternalLink,
+		boiler.CollectionColumns.RoyaltyRecipient,
+		boiler.CollectionColumns.RoyaltyBasisPoints,
+		boiler.CollectionColumns.UpdatedAt,
+	))
+	if err != nil {
+		return nil, terror.Error(err, "Failed to update collection.")
+	}
+	return collection, nil
+}
+
+// AdminCollectionContractMetadataUpdate sets the contractURI metadata and royalties of a collection
+func AdminCollectionContractMetadataUpdate(w http.ResponseWriter, r *http.Request) (int, error) {
+	collection, err := updateCollectionContractMetadata(chi.URLParam(r, "collection_slug"), func(req *CollectionContractMetadataRequest) error {
+		return json.NewDecoder(r.Body).Decode(req)
+	})
+	if err != nil {
+		return collectionStatus(err), err
+	}
+	return helpers.EncodeJSON(w, collection)
+}
+
+var collectionSlugRegexp = regexp.MustCompile(`^[a-z0-9]+([-_][a-z0-9]+)*$`)
+
+type CollectionRequest struct {
+	Name             string      `json:"name"`
+	Slug             string      `json:"slug"`
+	MintContract     null.String `json:"mint_contract"`
+	StakeContract    null.String `json:"stake_contract"`
+	TransferContract null.String `json:"transfer_contract"`
+	Chain            null.String `json:"chain"`
+	ContractType     null.String `json:"contract_type"`
+	IsVisible        bool        `json:"is_visible"`
+	Description      null.String `json:"description"`
+	LogoURL          null.String `json:"logo_url"`
+	BackgroundURL    null.String `json:"background_url"`
+}
+
+// checksumAddress validates a contract address and stores it in the checksummed form the sync jobs look it up by
+func checksumAddress(addr *null.String, field string) error {
+	if !addr.Valid || addr.String == "" {
+		*addr = null.String{}
+		return nil
+	}
+	if !common.IsHexAddress(addr.String) {
+		return fmt.Errorf("%s is not a valid address", field)
+	}
+	if common.HexToAddress(addr.String) == (common.Address{}) {
+		return fmt.Errorf("%s can not be the zero address", field)
+	}
+	addr.String = common.HexToAddress(addr.String).Hex()
+	return nil
+}
+
+// toCollection validates the request and copies it onto the collection, archived collections are checked for clashes too
+func (req *CollectionRequest) toCollection(collection *boiler.Collection) error {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if req.Slug != "" && !collectionSlugRegexp.MatchString(req.Slug) {
+		return fmt.Errorf("slug can only contain lowercase letters, numbers, hyphens and underscores")
+	}
+
+	for field, addr := range map[string]*null.String{
+		"mint contract":     &req.MintContract,
+		"stake contract":    &req.StakeContract,
+		"transfer contract": &req.TransferContract,
+	} {
+		err := checksumAddress(addr, field)
+		if err != nil {
+			return err
+		}
+	}
+	if req.StakeContract.Valid && req.StakeContract.String == req.MintContract.String {
+		return fmt.Errorf("stake contract can not be the mint contract")
+	}
+
+	if req.Chain.Valid && req.Chain.String != db.CollectionChainETH && req.Chain.String != db.CollectionChainBSC {
+		return fmt.Errorf("chain must be %s or %s", db.CollectionChainETH, db.CollectionChainBSC)
+	}
+	if req.ContractType.Valid && req.ContractType.String != db.ContractTypeERC721 && req.ContractType.String != db.ContractTypeEIP1155 {
+		return fmt.Errorf("contract type must be %s or %s", db.ContractTypeERC721, db.ContractTypeEIP1155)
+	}
+	if req.MintContract.Valid && (!req.Chain.Valid || !req.ContractType.Valid) {
+		return fmt.Errorf("chain and contract type are required with a mint contract")
+	}
+	if !req.MintContract.Valid && (req.StakeContract.Valid || req.TransferContract.Valid) {
+		return fmt.Errorf("a mint contract is required with a stake or transfer contract")
+	}
+
+	clashes := []qm.QueryMod{qm.WithDeleted(), boiler.CollectionWhere.ID.NEQ(collection.ID)}
+	nameTaken, err := boiler.Collections(append(clashes, boiler.CollectionWhere.Name.EQ(req.Name))...).Exists(passdb.StdConn)
+	if err != nil {
+		return err
+	}
+	if nameTaken {
+		return fmt.Errorf("name is already taken by another collection")
+	}
+	if req.Slug != "" {
+		slugTaken, err := boiler.Collections(append(clashes, boiler.CollectionWhere.Slug.EQ(req.Slug))...).Exists(passdb.StdConn)
+		if err != nil {
+			return err
+		}
+		if slugTaken {
+			return fmt.Errorf("slug is already taken by another collection")
+		}
+	}
+	if req.MintContract.Valid {
+		mintTaken, err := boiler.Collections(append(clashes, boiler.CollectionWhere.MintContract.EQ(req.MintContract))...).Exists(passdb.StdConn)
+		if err != nil {
+			return err
+		}
+		if mintTaken {
+			return fmt.Errorf("mint contract is already used by another collection")
+		}
+	}
+
+	collection.Name = req.Name
+	collection.Slug = req.Slug
+	collection.MintContract = req.MintContract
+	collection.StakeContract = req.StakeContract
+	collection.TransferContract = req.TransferContract
+	collection.Chain = req.Chain
+	collection.ContractType = req.ContractType
+	collection.IsVisible = null.BoolFrom(req.IsVisible)
+	collection.Description = req.Description
+	collection.LogoURL = req.LogoURL
+	collection.BackgroundURL = req.BackgroundURL
+	return nil
+}
+
+var collectionRequestColumns = []string{
+	boiler.CollectionColumns.Name,
+	boiler.CollectionColumns.Slug,
+	boiler.CollectionColumns.MintContract,
+	boiler.CollectionColumns.StakeContract,
+	boiler.CollectionColumns.TransferContract,
+	boiler.CollectionColumns.Chain,
+	boiler.CollectionColumns.ContractType,
+	boiler.CollectionColumns.IsVisible,
+	boiler.CollectionColumns.Description,
+	boiler.CollectionColumns.LogoURL,
+	boiler.CollectionColumns.BackgroundURL,
+	boiler.CollectionColumns.UpdatedAt,
+}
+
+// createCollection inserts the collection, an empty slug is filled from the name by the db
+func createCollection(req *CollectionRequest) (*boiler.Collection, error) {
+	collection := &boiler.Collection{}
+	err := req.toCollection(collection)
+	if err != nil {
+		return nil, terror.Warn(err, err.Error())
+	}
+
+	err = collection.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		return nil, terror.Error(err, "Failed to create collection.")
+	}
+	err = collection.Reload(passdb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to get collection.")
+	}
+	return collection, nil
+}
+
+// collectionHasAssets reports whether any 721 or 1155 asset belongs to the collection
+func collectionHasAssets(collectionID string) (bool, error) {
+	hasAssets, err := boiler.UserAssets(qm.WithDeleted(), boiler.UserAssetWhere.CollectionID.EQ(collectionID)).Exists(passdb.StdConn)
+	if err != nil || hasAssets {
+		return hasAssets, err
+	}
+	return boiler.UserAssets1155S(boiler.UserAssets1155Where.CollectionID.EQ(collectionID)).Exists(passdb.StdConn)
+}
+
+// collectionRequestFrom fills a request with the collection's current values
+func collectionRequestFrom(collection *boiler.Collection) *CollectionRequest {
+	return &CollectionRequest{
+		Name:             collection.Name,
+		Slug:             collection.Slug,
+		MintContract:     collection.MintContract,
+		StakeContract:    collection.StakeContract,
+		TransferContract: collection.TransferContract,
+		Chain:            collection.Chain,
+		ContractType:     collection.ContractType,
+		IsVisible:        collection.IsVisible.Bool,
+		Description:      collection.Description,
+		LogoURL:          collection.LogoURL,
+		BackgroundURL:    collection.BackgroundURL,
+	}
+}
+
+// updateCollection decodes the request over the collection's current values and saves it, so fields left out keep their value and null clears them.
+// The name, mint contract, chain and contract type identify a collection's assets, so they can't change once it has any.
+// Archived collections have to be unarchived first.
+func updateCollection(slug string, decode func(req *CollectionRequest) error) (*boiler.Collection, error) {
+	collection, err := boiler.Collections(boiler.CollectionWhere.Slug.EQ(slug)).One(passdb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Collection not found.")
+	}
+
+	req := collectionRequestFrom(collection)
+	err = decode(req)
+	if err != nil {
+		return nil, terror.Warn(err, "Invalid request received.")
+	}
+
+	before := *collection
+	err = req.toCollection(collection)
+	if err != nil {
+		return nil, terror.Warn(err, err.Error())
+	}
+
+	if collection.Name != before.Name ||
+		collection.MintContract != before.MintContract ||
+		collection.Chain != before.Chain ||
+		collection.ContractType != before.ContractType {
+		hasAssets, err := collectionHasAssets(collection.ID)
+		if err != nil {
+			return nil, terror.Error(err, "Failed to get collection assets.")
+		}
+		if hasAssets {
+			return nil, terror.Warn(fmt.Errorf("collection has assets"), "The name, mint contract, chain and contract type of a collection with assets can not be changed.")
+		}
+	}
+	collection.UpdatedAt = time.Now()
+
+	_, err = collection.Update(passdb.StdConn, boil.Whitelist(collectionRequestColumns...))
+	if err != nil {
+		return nil, terror.Error(err, "Failed to update collection.")
+	}
+	err = collection.Reload(passdb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to get collection.")
+	}
+	return collection, nil
+}
+
+// setCollectionArchived archives or unarchives a collection.
+// Archived collections drop out of listings, sync jobs and mints, so only collections without assets can be archived.
+func setCollectionArchived(slug string, archived bool) (*boiler.Collection, error) {
+	collection, err := boiler.Collections(
+		qm.WithDeleted(),
+		boiler.CollectionWhere.Slug.EQ(slug),
+	).One(passdb.StdConn)
+	if err != nil {
+		return nil, terror.Error(err, "Collection not found.")
+	}
+
+	if !archived {
+		collection.DeletedAt = null.Time{}
+		collection.UpdatedAt = time.Now()
+		_, err = collection.Update(passdb.StdConn, boil.Whitelist(boiler.CollectionColumns.DeletedAt, boiler.CollectionColumns.UpdatedAt))
+		if err != nil {
+			return nil, terror.Error(err, "Failed to unarchive collection.")
+		}
+		return collection, nil
+	}
+
+	hasAssets, err := collectionHasAssets(collection.ID)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to get collection assets.")
+	}
+	if hasAssets {
+		return nil, terror.Warn(fmt.Errorf("collection has assets"), "Collections with assets can not be archived, hide them instead.")
+	}
+
+	_, err = collection.Delete(passdb.StdConn, false)
+	if err != nil {
+		return nil, terror.Error(err, "Failed to archive collection.")
+	}
+	return collection, nil
+}
+
+// collectionStatus picks the http status for errors from the collection admin helpers
+func collectionStatus(err error) int {
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound
+	}
+	var tErr *terror.TError
+	if errors.As(err, &tErr) && tErr.Level == terror.ErrLevelWarn {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// AdminCollectionList lists every collection including archived ones
+func AdminCollectionList(w http.ResponseWriter, r *http.Request) (int, error) {
+	collections, err := boiler.Collections(
+		qm.WithDeleted(),
+		qm.OrderBy(boiler.CollectionColumns.CreatedAt),
+	).All(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get collections.")
+	}
+	return helpers.EncodeJSON(w, collections)
+}
+
+// AdminCollectionCreate creates a collection
+func AdminCollectionCreate(w http.ResponseWriter, r *http.Request) (int, error) {
+	req := &CollectionRequest{}
+	err := json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Could not decode json")
+	}
+
+	collection, err := createCollection(req)
+	if err != nil {
+		return collectionStatus(err), err
+	}
+	return helpers.EncodeJSON(w, collection)
+}
+
+// AdminCollectionUpdate updates the fields of a collection that are in the request
+func AdminCollectionUpdate(w http.ResponseWriter, r *http.Request) (int, error) {
+	collection, err := updateCollection(chi.URLParam(r, "collection_slug"), func(req *CollectionRequest) error {
+		return json.NewDecoder(r.Body).Decode(req)
+	})
+	if err != nil {
+		return collectionStatus(err), err
+	}
+	return helpers.EncodeJSON(w, collection)
+}
+
+// AdminCollectionArchive archives a collection
+func AdminCollectionArchive(w http.ResponseWriter, r *http.Request) (int, error) {
+	collection, err := setCollectionArchived(chi.URLParam(r, "collection_slug"), true)
+	if err != nil {
+		return collectionStatus(err), err
+	}
+	return helpers.EncodeJSON(w, collection)
+}
+
+// AdminCollectionUnarchive unarchives a collection
+func AdminCollectionUnarchive(w http.ResponseWriter, r *http.Request) (int, error) {
+	collection, err := setCollectionArchived(chi.URLParam(r, "collection_slug"), false)
+	if err != nil {
+		return collectionStatus(err), err
+	}
+	return helpers.EncodeJSON(w, collection)
+}
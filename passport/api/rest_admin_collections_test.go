@@ -0,0 +1,125 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb/passdbtest"
+)
+
+func TestMain(m *testing.M) {
+	passdbtest.Main(m)
+}
+
+func TestUpdateCollection(t *testing.T) {
+	passdbtest.Require(t)
+
+	update := func(slug string, body string) error {
+		_, err := updateCollection(slug, func(req *CollectionRequest) error {
+			return json.NewDecoder(strings.NewReader(body)).Decode(req)
+		})
+		return err
+	}
+
+	t.Run("fields left out keep their value", func(t *testing.T) {
+		collection := passdbtest.Collection(t)
+		updated, err := updateCollection(collection.Slug, func(req *CollectionRequest) error {
+			return json.NewDecoder(strings.NewReader(`{"description": "new description", "logo_url": null}`)).Decode(req)
+		})
+		if err != nil {
+			t.Fatalf("failed to update collection: %s", err)
+		}
+		if updated.Description.String != "new description" {
+			t.Errorf("description = %q, want the new description", updated.Description.String)
+		}
+		if updated.MintContract != collection.MintContract || updated.ContractType != collection.ContractType || updated.Name != collection.Name {
+			t.Errorf("fields left out of the request changed: %+v", updated)
+		}
+		if updated.LogoURL.Valid {
+			t.Errorf("logo url = %q, want it cleared", updated.LogoURL.String)
+		}
+	})
+
+	t.Run("identity can change without assets", func(t *testing.T) {
+		collection := passdbtest.Collection(t)
+		err := update(collection.Slug, `{"name": "`+collection.Name+` renamed"}`)
+		if err != nil {
+			t.Errorf("failed to rename collection without assets: %s", err)
+		}
+	})
+
+	t.Run("identity can't change with assets", func(t *testing.T) {
+		collection := passdbtest.Collection(t)
+		passdbtest.Asset(t, collection, passdbtest.User(t))
+
+		for _, body := range []string{
+			`{"name": "` + collection.Name + ` renamed"}`,
+			`{"mint_contract": "0x000000000000000000000000000000000000dEaD"}`,
+			`{"chain": "BSC"}`,
+			`{"contract_type": "EIP-1155"}`,
+		} {
+			err := update(collection.Slug, body)
+			if err == nil {
+				t.Errorf("updated a collection with assets with %s", body)
+			}
+		}
+
+		err := update(collection.Slug, `{"description": "still editable"}`)
+		if err != nil {
+			t.Errorf("failed to update description of a collection with assets: %s", err)
+		}
+	})
+}
+
+func TestUpdateCollectionContractMetadata(t *testing.T) {
+	passdbtest.Require(t)
+
+	update := func(slug string, body string) (*boiler.Collection, error) {
+		return updateCollectionContractMetadata(slug, func(req *CollectionContractMetadataRequest) error {
+			return json.NewDecoder(strings.NewReader(body)).Decode(req)
+		})
+	}
+	recipient := "0x000000000000000000000000000000000000dEaD"
+
+	t.Run("fields left out keep their value", func(t *testing.T) {
+		collection := passdbtest.Collection(t)
+		_, err := update(collection.Slug, `{"description": "a collection", "logo_url": "https://example.com/logo.png", "royalty_recipient": "`+recipient+`", "royalty_basis_points": 250}`)
+		if err != nil {
+			t.Fatalf("failed to set metadata: %s", err)
+		}
+
+		updated, err := update(collection.Slug, `{"description": "new description", "logo_url": null}`)
+		if err != nil {
+			t.Fatalf("failed to update metadata: %s", err)
+		}
+		if updated.Description.String != "new description" {
+			t.Errorf("description = %q, want the new description", updated.Description.String)
+		}
+		if updated.LogoURL.Valid {
+			t.Errorf("logo url = %q, want it cleared", updated.LogoURL.String)
+		}
+		if updated.RoyaltyRecipient.String != recipient || updated.RoyaltyBasisPoints != 250 {
+			t.Errorf("royalty = %d to %q, want 250 to %s kept", updated.RoyaltyBasisPoints, updated.RoyaltyRecipient.String, recipient)
+		}
+	})
+
+	t.Run("invalid metadata is refused", func(t *testing.T) {
+		collection := passdbtest.Collection(t)
+		for _, body := range []string{
+			`{"royalty_basis_points": 10001, "royalty_recipient": "` + recipient + `"}`,
+			`{"royalty_basis_points": 250}`,
+			`{"royalty_recipient": "not an address"}`,
+			`{"external_link": "http://example.com"}`,
+		} {
+			_, err := update(collection.Slug, body)
+			if err == nil {
+				t.Errorf("updated metadata with %s", body)
+			}
+			if collectionStatus(err) != http.StatusBadRequest {
+				t.Errorf("status for %s = %d, want %d", body, collectionStatus(err), http.StatusBadRequest)
+			}
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"xsyn-services/passport/helpers"
+	"xsyn-services/passport/payments"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ninja-software/terror/v2"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+)
+
+type HeldPurchaseReleaseRequest struct {
+	SupsUSDRate decimal.Decimal `json:"sups_usd_rate"`
+}
+
+// AdminHeldPurchaseList lists purchases made while the price oracle was paused that are waiting to be priced
+func AdminHeldPurchaseList(w http.ResponseWriter, r *http.Request) (int, error) {
+	held, err := payments.HeldPurchasesList(r.URL.Query().Get("include_released") == "true")
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get held purchases.")
+	}
+	return helpers.EncodeJSON(w, held)
+}
+
+// AdminHeldPurchaseRelease credits a held purchase at the given SUPS price
+func AdminHeldPurchaseRelease(ucm *Transactor) func(w http.ResponseWriter, r *http.Request) (int, error) {
+	fn := func(w http.ResponseWriter, r *http.Request) (int, error) {
+		apiKey, err := AdminAPIKey(r)
+		if err != nil {
+			return http.StatusUnauthorized, terror.Error(err, "Unauthorized.")
+		}
+
+		req := &HeldPurchaseReleaseRequest{}
+		err = json.NewDecoder(r.Body).Decode(req)
+		if err != nil {
+			return http.StatusBadRequest, terror.Error(err, "Invalid request.")
+		}
+
+		held, err := payments.ReleaseHeldPurchase(ucm, chi.URLParam(r, "held_purchase_id"), req.SupsUSDRate, &payments.PendingOperator{
+			Name:   fmt.Sprintf("api_key:%s", apiKey.ID),
+			UserID: null.StringFrom(apiKey.UserID),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusNotFound, terror.Error(err, "Held purchase not found.")
+		}
+		if err != nil {
+			return http.StatusBadRequest, terror.Error(err, "Failed to release held purchase.")
+		}
+
+		return helpers.EncodeJSON(w, held)
+	}
+	return fn
+}
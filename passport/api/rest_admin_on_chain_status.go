@@ -0,0 +1,90 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/helpers"
+	"xsyn-services/passport/passdb"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+)
+
+type OnChainStatusHistoryResp struct {
+	AssetHash     string                                    `json:"asset_hash"`
+	OnChainStatus string                                    `json:"on_chain_status"`
+	History       boiler.UserAssetOnChainStatusHistorySlice `json:"history"`
+}
+
+// AdminOnChainStatusHistory gets an asset's current on chain status and every change that led to it
+func AdminOnChainStatusHistory(w http.ResponseWriter, r *http.Request) (int, error) {
+	status, err := boiler.UserAssetOnChainStatuses(
+		boiler.UserAssetOnChainStatusWhere.AssetHash.EQ(chi.URLParam(r, "asset_hash")),
+	).One(passdb.StdConn)
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Error(err, "Asset not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get on chain status.")
+	}
+
+	history, err := db.OnChainStatusHistory(status.AssetHash)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get on chain status history.")
+	}
+
+	return helpers.EncodeJSON(w, &OnChainStatusHistoryResp{
+		AssetHash:     status.AssetHash,
+		OnChainStatus: status.OnChainStatus,
+		History:       history,
+	})
+}
+
+type OnChainStatusSetRequest struct {
+	OnChainStatus db.OnChainStatus `json:"on_chain_status"`
+	Note          string           `json:"note"`
+}
+
+// AdminOnChainStatusSet corrects an asset's on chain status, the change still has to be a legal one for an admin
+func AdminOnChainStatusSet(w http.ResponseWriter, r *http.Request) (int, error) {
+	apiKey, err := AdminAPIKey(r)
+	if err != nil {
+		return http.StatusUnauthorized, terror.Error(err, "Failed to get admin user.")
+	}
+
+	req := &OnChainStatusSetRequest{}
+	err = json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Could not decode json")
+	}
+	if req.Note == "" {
+		return http.StatusBadRequest, terror.Error(fmt.Errorf("note is required"), "A note on why the status is being changed is required.")
+	}
+
+	status, err := boiler.UserAssetOnChainStatuses(
+		boiler.UserAssetOnChainStatusWhere.AssetHash.EQ(chi.URLParam(r, "asset_hash")),
+	).One(passdb.StdConn)
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Error(err, "Asset not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get on chain status.")
+	}
+
+	err = db.SetOnChainStatus(passdb.StdConn, status, req.OnChainStatus, &db.OnChainStatusChange{
+		Source:      db.OnChainStatusSourceAdmin,
+		ChangedByID: null.StringFrom(apiKey.UserID),
+		Note:        null.StringFrom(req.Note),
+	})
+	if err != nil {
+		return collectionStatus(err), err
+	}
+
+	return helpers.EncodeJSON(w, status)
+}
@@ -0,0 +1,31 @@
+package api
+
+import (
+	"net/http"
+	"xsyn-services/passport/helpers"
+	"xsyn-services/passport/payments"
+)
+
+type PriceOracleStatusResponse struct {
+	PurchasesPaused bool                    `json:"purchases_paused"`
+	Reason          string                  `json:"reason,omitempty"`
+	Prices          []*payments.OraclePrice `json:"prices"`
+}
+
+// AdminPriceOracleStatus returns the aggregated prices along with the quote of every source
+func AdminPriceOracleStatus(w http.ResponseWriter, r *http.Request) (int, error) {
+	paused, reason := payments.PurchasesPaused()
+	return helpers.EncodeJSON(w, &PriceOracleStatusResponse{
+		PurchasesPaused: paused,
+		Reason:          reason,
+		Prices:          payments.OraclePrices(),
+	})
+}
+
+// AdminPriceOracleRefresh queries every source again, use it to resume purchases after a source has been fixed
+func AdminPriceOracleRefresh(w http.ResponseWriter, r *http.Request) (int, error) {
+	for _, symbol := range payments.OracleSymbols {
+		payments.OracleUpdate(symbol)
+	}
+	return AdminPriceOracleStatus(w, r)
+}
@@ -0,0 +1,180 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/helpers"
+	"xsyn-services/passport/payments"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+)
+
+type PendingActionRequest struct {
+	TxHash string `json:"tx_hash"`
+	Reason string `json:"reason"`
+}
+
+type PendingRefundListResponse struct {
+	RollbackEnabled   bool                          `json:"rollback_enabled"`
+	AvantFailureCount int                           `json:"avant_failure_count"`
+	PendingRefunds    []*payments.PendingRefundItem `json:"pending_refunds"`
+}
+
+type Pending1155RollbackListResponse struct {
+	RollbackEnabled      bool                                `json:"rollback_enabled"`
+	AvantFailureCount    int                                 `json:"avant_failure_count"`
+	Pending1155Rollbacks []*payments.Pending1155RollbackItem `json:"pending_1155_rollbacks"`
+}
+
+// pendingActionRequest decodes the request body and works out the operator from the admin api key
+func pendingActionRequest(r *http.Request) (*PendingActionRequest, *payments.PendingOperator, error) {
+	apiKey, err := AdminAPIKey(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req := &PendingActionRequest{}
+	err = json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if req.Reason == "" {
+		return nil, nil, fmt.Errorf("reason is required")
+	}
+
+	return req, &payments.PendingOperator{
+		Name:   fmt.Sprintf("api_key:%s", apiKey.ID),
+		UserID: null.StringFrom(apiKey.UserID),
+	}, nil
+}
+
+func pendingActionError(err error, msg string) (int, error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Error(err, "Pending withdraw not found.")
+	}
+	return http.StatusBadRequest, terror.Error(err, msg)
+}
+
+// AdminPendingRefundList lists SUPS withdraws that have not been confirmed on chain or rolled back yet
+func AdminPendingRefundList(w http.ResponseWriter, r *http.Request) (int, error) {
+	items, err := payments.PendingRefundsList(r.URL.Query().Get("include_resolved") == "true")
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get pending refunds.")
+	}
+
+	return helpers.EncodeJSON(w, &PendingRefundListResponse{
+		RollbackEnabled:   db.GetBoolWithDefault(db.KeyEnableWithdrawRollback, false),
+		AvantFailureCount: db.GetIntWithDefault(db.KeyAvantFailureCount, 0),
+		PendingRefunds:    items,
+	})
+}
+
+func AdminPendingRefundForceConfirm(w http.ResponseWriter, r *http.Request) (int, error) {
+	req, op, err := pendingActionRequest(r)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Invalid request.")
+	}
+
+	refund, err := payments.PendingRefundForceConfirm(chi.URLParam(r, "pending_refund_id"), req.TxHash, op, req.Reason)
+	if err != nil {
+		return pendingActionError(err, "Failed to confirm pending refund.")
+	}
+
+	return helpers.EncodeJSON(w, refund)
+}
+
+func AdminPendingRefundForceRollback(ucm *Transactor, lookup *payments.WithdrawLookup) func(w http.ResponseWriter, r *http.Request) (int, error) {
+	fn := func(w http.ResponseWriter, r *http.Request) (int, error) {
+		req, op, err := pendingActionRequest(r)
+		if err != nil {
+			return http.StatusBadRequest, terror.Error(err, "Invalid request.")
+		}
+
+		refund, err := payments.PendingRefundForceRollback(ucm, lookup, chi.URLParam(r, "pending_refund_id"), op, req.Reason)
+		if err != nil {
+			return pendingActionError(err, "Failed to roll back pending refund.")
+		}
+
+		return helpers.EncodeJSON(w, refund)
+	}
+	return fn
+}
+
+func AdminPendingRefundCancel(w http.ResponseWriter, r *http.Request) (int, error) {
+	req, op, err := pendingActionRequest(r)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Invalid request.")
+	}
+
+	refund, err := payments.PendingRefundCancel(chi.URLParam(r, "pending_refund_id"), op, req.Reason)
+	if err != nil {
+		return pendingActionError(err, "Failed to cancel pending refund.")
+	}
+
+	return helpers.EncodeJSON(w, refund)
+}
+
+// AdminPending1155RollbackList lists 1155 withdraws that have not been confirmed on chain or rolled back yet
+func AdminPending1155RollbackList(w http.ResponseWriter, r *http.Request) (int, error) {
+	items, err := payments.Pending1155RollbacksList(r.URL.Query().Get("include_resolved") == "true")
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get pending 1155 rollbacks.")
+	}
+
+	return helpers.EncodeJSON(w, &Pending1155RollbackListResponse{
+		RollbackEnabled:      db.GetBoolWithDefault(db.KeyEnableWithdrawRollback, false),
+		AvantFailureCount:    db.GetIntWithDefault(db.KeyAvantFailureCount, 0),
+		Pending1155Rollbacks: items,
+	})
+}
+
+func AdminPending1155RollbackForceConfirm(w http.ResponseWriter, r *http.Request) (int, error) {
+	req, op, err := pendingActionRequest(r)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Invalid request.")
+	}
+
+	rollback, err := payments.Pending1155RollbackForceConfirm(chi.URLParam(r, "pending_1155_rollback_id"), req.TxHash, op, req.Reason)
+	if err != nil {
+		return pendingActionError(err, "Failed to confirm pending 1155 rollback.")
+	}
+
+	return helpers.EncodeJSON(w, rollback)
+}
+
+func AdminPending1155RollbackForceRollback(lookup *payments.WithdrawLookup) func(w http.ResponseWriter, r *http.Request) (int, error) {
+	fn := func(w http.ResponseWriter, r *http.Request) (int, error) {
+		req, op, err := pendingActionRequest(r)
+		if err != nil {
+			return http.StatusBadRequest, terror.Error(err, "Invalid request.")
+		}
+
+		rollback, err := payments.Pending1155RollbackForceRollback(lookup, chi.URLParam(r, "pending_1155_rollback_id"), op, req.Reason)
+		if err != nil {
+			return pendingActionError(err, "Failed to roll back pending 1155 withdraw.")
+		}
+
+		return helpers.EncodeJSON(w, rollback)
+	}
+	return fn
+}
+
+func AdminPending1155RollbackCancel(w http.ResponseWriter, r *http.Request) (int, error) {
+	req, op, err := pendingActionRequest(r)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Invalid request.")
+	}
+
+	rollback, err := payments.Pending1155RollbackCancel(chi.URLParam(r, "pending_1155_rollback_id"), op, req.Reason)
+	if err != nil {
+		return pendingActionError(err, "Failed to cancel pending 1155 rollback.")
+	}
+
+	return helpers.EncodeJSON(w, rollback)
+}
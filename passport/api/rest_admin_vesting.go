@@ -0,0 +1,363 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/helpers"
+	"xsyn-services/passport/passdb"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-chi/chi/v5"
+	"github.com/ninja-software/terror/v2"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type VestingUnlockRequest struct {
+	UnlockAt time.Time       `json:"unlock_at"`
+	Amount   decimal.Decimal `json:"amount"`
+}
+
+type VestingScheduleRequest struct {
+	Label         string                  `json:"label"`
+	PublicAddress null.String             `json:"public_address"`
+	Cohort        null.String             `json:"cohort"`
+	Unlimited     bool                    `json:"unlimited"`
+	CliffAt       null.Time               `json:"cliff_at"`
+	CliffAmount   decimal.Decimal         `json:"cliff_amount"`
+	DripStartAt   null.Time               `json:"drip_start_at"`
+	DripEndAt     null.Time               `json:"drip_end_at"`
+	DripAmount    decimal.Decimal         `json:"drip_amount"`
+	Unlocks       []*VestingUnlockRequest `json:"unlocks"`
+}
+
+type VestingScheduleResponse struct {
+	*boiler.VestingSchedule
+	Unlocks []*boiler.VestingUnlockEvent `json:"unlocks"`
+}
+
+func vestingScheduleResponse(s *boiler.VestingSchedule) *VestingScheduleResponse {
+	resp := &VestingScheduleResponse{
+		VestingSchedule: s,
+		Unlocks:         []*boiler.VestingUnlockEvent{},
+	}
+	if s.R != nil && s.R.ScheduleVestingUnlockEvents != nil {
+		resp.Unlocks = s.R.ScheduleVestingUnlockEvents
+	}
+	return resp
+}
+
+// toSchedule validates the request and copies it onto the given schedule
+func (req *VestingScheduleRequest) toSchedule(s *boiler.VestingSchedule) error {
+	if req.Label == "" {
+		return fmt.Errorf("label is required")
+	}
+	if req.PublicAddress.Valid == req.Cohort.Valid {
+		return fmt.Errorf("schedule needs either a public address or a cohort")
+	}
+	if req.PublicAddress.Valid && !common.IsHexAddress(req.PublicAddress.String) {
+		return fmt.Errorf("invalid public address: %s", req.PublicAddress.String)
+	}
+	if req.CliffAmount.IsNegative() || req.DripAmount.IsNegative() {
+		return fmt.Errorf("amounts can not be negative")
+	}
+	if req.CliffAmount.GreaterThan(decimal.Zero) && !req.CliffAt.Valid {
+		return fmt.Errorf("cliff amount requires a cliff date")
+	}
+	if req.DripAmount.GreaterThan(decimal.Zero) && (!req.DripStartAt.Valid || !req.DripEndAt.Valid) {
+		return fmt.Errorf("drip amount requires a drip start and end date")
+	}
+	if req.DripStartAt.Valid && req.DripEndAt.Valid && !req.DripStartAt.Time.Before(req.DripEndAt.Time) {
+		return fmt.Errorf("drip end must be after drip start")
+	}
+	for _, u := range req.Unlocks {
+		if !u.Amount.GreaterThan(decimal.Zero) {
+			return fmt.Errorf("unlock amount must be greater than zero")
+		}
+	}
+
+	s.Label = req.Label
+	s.PublicAddress = null.String{}
+	if req.PublicAddress.Valid {
+		s.PublicAddress = null.StringFrom(common.HexToAddress(req.PublicAddress.String).Hex())
+	}
+	s.Cohort = req.Cohort
+	s.Unlimited = req.Unlimited
+	s.CliffAt = req.CliffAt
+	s.CliffAmount = req.CliffAmount
+	s.DripStartAt = req.DripStartAt
+	s.DripEndAt = req.DripEndAt
+	s.DripAmount = req.DripAmount
+
+	return nil
+}
+
+// AdminVestingScheduleList lists the vesting schedules, optionally filtered by public_address or cohort
+func AdminVestingScheduleList(w http.ResponseWriter, r *http.Request) (int, error) {
+	queryMods := []qm.QueryMod{
+		qm.Load(boiler.VestingScheduleRels.ScheduleVestingUnlockEvents),
+		qm.OrderBy(boiler.VestingScheduleColumns.CreatedAt + " DESC"),
+	}
+	if publicAddress := r.URL.Query().Get("public_address"); publicAddress != "" {
+		queryMods = append(queryMods, boiler.VestingScheduleWhere.PublicAddress.EQ(null.StringFrom(common.HexToAddress(publicAddress).Hex())))
+	}
+	if cohort := r.URL.Query().Get("cohort"); cohort != "" {
+		queryMods = append(queryMods, boiler.VestingScheduleWhere.Cohort.EQ(null.StringFrom(cohort)))
+	}
+
+	schedules, err := boiler.VestingSchedules(queryMods...).All(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get vesting schedules.")
+	}
+
+	resp := []*VestingScheduleResponse{}
+	for _, s := range schedules {
+		resp = append(resp, vestingScheduleResponse(s))
+	}
+
+	return helpers.EncodeJSON(w, resp)
+}
+
+// AdminVestingScheduleCreate creates a vesting schedule with its unlock events
+func AdminVestingScheduleCreate(w http.ResponseWriter, r *http.Request) (int, error) {
+	req := &VestingScheduleRequest{}
+	err := json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Could not decode json")
+	}
+
+	schedule := &boiler.VestingSchedule{}
+	err = req.toSchedule(schedule)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, err.Error())
+	}
+
+	unlocks := []*boiler.VestingUnlockEvent{}
+	for _, u := range req.Unlocks {
+		unlocks = append(unlocks, &boiler.VestingUnlockEvent{
+			UnlockAt: u.UnlockAt,
+			Amount:   u.Amount,
+		})
+	}
+
+	err = db.VestingScheduleCreate(schedule, unlocks)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to create vesting schedule.")
+	}
+
+	resp := vestingScheduleResponse(schedule)
+	resp.Unlocks = unlocks
+	return helpers.EncodeJSON(w, resp)
+}
+
+// AdminVestingScheduleUpdate updates the cliff and drip of a vesting schedule, unlock events are managed separately
+func AdminVestingScheduleUpdate(w http.ResponseWriter, r *http.Request) (int, error) {
+	schedule, err := boiler.FindVestingSchedule(passdb.StdConn, chi.URLParam(r, "schedule_id"))
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Error(err, "Vesting schedule not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get vesting schedule.")
+	}
+
+	req := &VestingScheduleRequest{}
+	err = json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Could not decode json")
+	}
+	req.Unlocks = nil
+
+	err = req.toSchedule(schedule)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, err.Error())
+	}
+	schedule.UpdatedAt = time.Now()
+
+	_, err = schedule.Update(passdb.StdConn, boil.Infer())
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to update vesting schedule.")
+	}
+
+	err = schedule.L.LoadScheduleVestingUnlockEvents(passdb.StdConn, true, schedule, nil)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get vesting unlock events.")
+	}
+
+	return helpers.EncodeJSON(w, vestingScheduleResponse(schedule))
+}
+
+// AdminVestingScheduleDelete archives a vesting schedule
+func AdminVestingScheduleDelete(w http.ResponseWriter, r *http.Request) (int, error) {
+	schedule, err := boiler.FindVestingSchedule(passdb.StdConn, chi.URLParam(r, "schedule_id"))
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Error(err, "Vesting schedule not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get vesting schedule.")
+	}
+
+	_, err = schedule.Delete(passdb.StdConn, false)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to delete vesting schedule.")
+	}
+
+	return http.StatusOK, nil
+}
+
+// AdminVestingUnlockCreate adds a one-off unlock event to a vesting schedule
+func AdminVestingUnlockCreate(w http.ResponseWriter, r *http.Request) (int, error) {
+	schedule, err := boiler.FindVestingSchedule(passdb.StdConn, chi.URLParam(r, "schedule_id"))
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Error(err, "Vesting schedule not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get vesting schedule.")
+	}
+
+	req := &VestingUnlockRequest{}
+	err = json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Could not decode json")
+	}
+	if !req.Amount.GreaterThan(decimal.Zero) {
+		return http.StatusBadRequest, terror.Error(fmt.Errorf("invalid unlock amount: %s", req.Amount), "Unlock amount must be greater than zero.")
+	}
+
+	ev := &boiler.VestingUnlockEvent{
+		ScheduleID: schedule.ID,
+		UnlockAt:   req.UnlockAt,
+		Amount:     req.Amount,
+	}
+	err = ev.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to create vesting unlock event.")
+	}
+
+	return helpers.EncodeJSON(w, ev)
+}
+
+// AdminVestingUnlockDelete removes a one-off unlock event
+func AdminVestingUnlockDelete(w http.ResponseWriter, r *http.Request) (int, error) {
+	ev, err := boiler.FindVestingUnlockEvent(passdb.StdConn, chi.URLParam(r, "unlock_id"))
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Error(err, "Vesting unlock event not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get vesting unlock event.")
+	}
+
+	_, err = ev.Delete(passdb.StdConn, false)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to delete vesting unlock event.")
+	}
+
+	return http.StatusOK, nil
+}
+
+type VestingCohortMembersRequest struct {
+	PublicAddresses []string `json:"public_addresses"`
+}
+
+// AdminVestingCohortMembersSet moves the given addresses into the cohort
+func AdminVestingCohortMembersSet(w http.ResponseWriter, r *http.Request) (int, error) {
+	cohort := chi.URLParam(r, "cohort")
+	if cohort == "" {
+		return http.StatusBadRequest, terror.Error(fmt.Errorf("missing cohort"), "Missing cohort.")
+	}
+
+	req := &VestingCohortMembersRequest{}
+	err := json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Could not decode json")
+	}
+
+	for _, addr := range req.PublicAddresses {
+		if !common.IsHexAddress(addr) {
+			return http.StatusBadRequest, terror.Error(fmt.Errorf("invalid public address: %s", addr), fmt.Sprintf("Invalid public address: %s.", addr))
+		}
+	}
+
+	for _, addr := range req.PublicAddresses {
+		err = db.VestingCohortMemberSet(common.HexToAddress(addr), cohort)
+		if err != nil {
+			return http.StatusInternalServerError, terror.Error(err, "Failed to set cohort member.")
+		}
+	}
+
+	return http.StatusOK, nil
+}
+
+// AdminVestingCohortMemberRemove removes the address from its cohort
+func AdminVestingCohortMemberRemove(w http.ResponseWriter, r *http.Request) (int, error) {
+	publicAddress := common.HexToAddress(chi.URLParam(r, "public_address"))
+	_, err := boiler.VestingCohortMembers(
+		boiler.VestingCohortMemberWhere.PublicAddress.EQ(publicAddress.Hex()),
+	).DeleteAll(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to remove cohort member.")
+	}
+	return http.StatusOK, nil
+}
+
+// AdminVestingMaxWithdraw previews the vested amount of an address, optionally at a given unix timestamp
+func AdminVestingMaxWithdraw(w http.ResponseWriter, r *http.Request) (int, error) {
+	publicAddress := common.HexToAddress(chi.URLParam(r, "public_address"))
+
+	at := time.Now()
+	if atStr := r.URL.Query().Get("at"); atStr != "" {
+		atUnix, err := strconv.ParseInt(atStr, 10, 64)
+		if err != nil {
+			return http.StatusBadRequest, terror.Error(err, "Invalid timestamp.")
+		}
+		at = time.Unix(atUnix, 0)
+	}
+
+	amount, infinite, err := db.VestingMaxWithdrawBefore(publicAddress, at)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get max withdraw amount")
+	}
+
+	return helpers.EncodeJSON(w, &MaxWithdrawResponse{
+		MaxWithdraw: amount.BigInt().String(),
+		Unlimited:   infinite,
+	})
+}
+
+// AdminVestingImportDispersions imports the legacy dispersion allowances of every user wallet as vesting schedules
+func AdminVestingImportDispersions(w http.ResponseWriter, r *http.Request) (int, error) {
+	state, err := boiler.States().One(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get state")
+	}
+
+	users, err := boiler.Users(
+		qm.Select(boiler.UserColumns.PublicAddress),
+		boiler.UserWhere.PublicAddress.IsNotNull(),
+	).All(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get users.")
+	}
+
+	addresses := []common.Address{}
+	for _, u := range users {
+		addresses = append(addresses, common.HexToAddress(u.PublicAddress.String))
+	}
+
+	imported, err := db.VestingImportFromDispersions(addresses, state.WithdrawStartAt, state.CliffEndAt, state.DripStartAt)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, fmt.Sprintf("Failed to import dispersions, %d imported before failing.", imported))
+	}
+
+	return helpers.EncodeJSON(w, struct {
+		Imported int `json:"imported"`
+	}{imported})
+}
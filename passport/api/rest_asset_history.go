@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/helpers"
+	"xsyn-services/passport/passdb"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-chi/chi/v5"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"github.com/volatiletech/null/v8"
+)
+
+const HubKeyAssetHistory = "ASSET:HISTORY"
+
+type AssetHistoryResponse struct {
+	Hash           string                `json:"hash"`
+	CollectionSlug string                `json:"collection_slug"`
+	CollectionAddr string                `json:"collection_address"`
+	TokenID        int64                 `json:"token_id"`
+	Events         []*asset.HistoryEvent `json:"events"`
+}
+
+// userAssetByCollectionAndTokenID finds an asset by the mint contract of its collection
+func userAssetByCollectionAndTokenID(collectionAddress string, tokenID int64) (*boiler.UserAsset, error) {
+	collection, err := boiler.Collections(
+		boiler.CollectionWhere.MintContract.EQ(null.StringFrom(common.HexToAddress(collectionAddress).Hex())),
+	).One(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	return boiler.UserAssets(
+		boiler.UserAssetWhere.CollectionID.EQ(collection.ID),
+		boiler.UserAssetWhere.TokenID.EQ(tokenID),
+	).One(passdb.StdConn)
+}
+
+func assetHistory(userAsset *boiler.UserAsset) (*AssetHistoryResponse, error) {
+	collection, err := boiler.FindCollection(passdb.StdConn, userAsset.CollectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := asset.History(userAsset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AssetHistoryResponse{
+		Hash:           userAsset.Hash,
+		CollectionSlug: collection.Slug,
+		CollectionAddr: collection.MintContract.String,
+		TokenID:        userAsset.TokenID,
+		Events:         events,
+	}, nil
+}
+
+func assetLookupError(err error) (int, error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Warn(err, "Asset not found.")
+	}
+	return http.StatusInternalServerError, terror.Error(err, "Failed to get asset.")
+}
+
+// AssetHistoryByHash returns the provenance timeline of an asset
+func (api *API) AssetHistoryByHash(w http.ResponseWriter, r *http.Request) (int, error) {
+	userAsset, err := boiler.UserAssets(
+		boiler.UserAssetWhere.Hash.EQ(chi.URLParam(r, "hash")),
+	).One(passdb.StdConn)
+	if err != nil {
+		return assetLookupError(err)
+	}
+
+	resp, err := assetHistory(userAsset)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get asset history.")
+	}
+	return helpers.EncodeJSON(w, resp)
+}
+
+// AssetHistoryByCollectionAndTokenID returns the provenance timeline of an asset via its collection address and token id
+func (api *API) AssetHistoryByCollectionAndTokenID(w http.ResponseWriter, r *http.Request) (int, error) {
+	tokenID, err := strconv.ParseInt(chi.URLParam(r, "token_id"), 10, 64)
+	if err != nil {
+		return http.StatusBadRequest, terror.Warn(err, "Invalid token_id")
+	}
+
+	userAsset, err := userAssetByCollectionAndTokenID(chi.URLParam(r, "collection_address"), tokenID)
+	if err != nil {
+		return assetLookupError(err)
+	}
+
+	resp, err := assetHistory(userAsset)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get asset history.")
+	}
+	return helpers.EncodeJSON(w, resp)
+}
+
+type AssetHistoryRequest struct {
+	Payload struct {
+		Hash              string `json:"hash"`
+		CollectionAddress string `json:"collection_address"`
+		TokenID           int64  `json:"token_id"`
+	} `json:"payload"`
+}
+
+// AssetHistoryHandler returns the provenance timeline of an asset by hash, or by collection address and token id
+func AssetHistoryHandler(ctx context.Context, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &AssetHistoryRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	var userAsset *boiler.UserAsset
+	switch {
+	case req.Payload.Hash != "":
+		userAsset, err = boiler.UserAssets(
+			boiler.UserAssetWhere.Hash.EQ(req.Payload.Hash),
+		).One(passdb.StdConn)
+	case req.Payload.CollectionAddress != "":
+		userAsset, err = userAssetByCollectionAndTokenID(req.Payload.CollectionAddress, req.Payload.TokenID)
+	default:
+		err = fmt.Errorf("hash or collection address required")
+	}
+	if err != nil {
+		return terror.Warn(err, "Failed to get asset.")
+	}
+
+	resp, err := assetHistory(userAsset)
+	if err != nil {
+		return terror.Error(err, "Failed to get asset history.")
+	}
+	reply(resp)
+	return nil
+}
@@ -1,15 +1,21 @@
 package api
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-chi/chi/v5"
 	"github.com/ninja-software/terror/v2"
+	"github.com/shopspring/decimal"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/types"
 	"net/http"
+	"strconv"
 	"xsyn-services/boiler"
 	"xsyn-services/passport/db"
+	"xsyn-services/passport/helpers"
 	"xsyn-services/passport/passdb"
 )
 
@@ -97,3 +103,108 @@ func (api *API) Get1155Collection(w http.ResponseWriter, r *http.Request) (int,
 
 	return http.StatusOK, nil
 }
+
+// ContractMetaData is the collection level metadata marketplaces read from the contractURI
+type ContractMetaData struct {
+	Name                 string `json:"name"`
+	Description          string `json:"description,omitempty"`
+	Image                string `json:"image,omitempty"`
+	ExternalLink         string `json:"external_link,omitempty"`
+	SellerFeeBasisPoints int    `json:"seller_fee_basis_points"`
+	FeeRecipient         string `json:"fee_recipient,omitempty"`
+}
+
+// CollectionContractMetadata serves the contractURI json of a mint contract
+func (api *API) CollectionContractMetadata(w http.ResponseWriter, r *http.Request) (int, error) {
+	collectionAddress := chi.URLParam(r, "collection_address")
+	if !common.IsHexAddress(collectionAddress) {
+		return http.StatusBadRequest, terror.Warn(fmt.Errorf("invalid collection_address"), "Invalid collection_address.")
+	}
+
+	collection, err := db.CollectionByMintAddress(common.HexToAddress(collectionAddress))
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Warn(err, "Collection not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get collection from db")
+	}
+
+	return helpers.EncodeJSON(w, &ContractMetaData{
+		Name:                 collection.Name,
+		Description:          collection.Description.String,
+		Image:                collection.LogoURL.String,
+		ExternalLink:         collection.ExternalLink.String,
+		SellerFeeBasisPoints: collection.RoyaltyBasisPoints,
+		FeeRecipient:         collection.RoyaltyRecipient.String,
+	})
+}
+
+type RoyaltyInfoResp struct {
+	Receiver      string `json:"receiver"`
+	RoyaltyAmount string `json:"royalty_amount"`
+	BasisPoints   int    `json:"basis_points"`
+}
+
+// AssetRoyaltyInfo answers EIP-2981 royaltyInfo(tokenId, salePrice) for a token, sale_price is in the smallest unit of the sale currency
+func (api *API) AssetRoyaltyInfo(w http.ResponseWriter, r *http.Request) (int, error) {
+	collectionAddress := chi.URLParam(r, "collection_address")
+	if !common.IsHexAddress(collectionAddress) {
+		return http.StatusBadRequest, terror.Warn(fmt.Errorf("invalid collection_address"), "Invalid collection_address.")
+	}
+	tokenID, err := strconv.ParseInt(chi.URLParam(r, "token_id"), 10, 64)
+	if err != nil {
+		return http.StatusBadRequest, terror.Warn(err, "Invalid token_id")
+	}
+	salePrice, err := decimal.NewFromString(r.URL.Query().Get("sale_price"))
+	if err != nil || salePrice.IsNegative() || !salePrice.Equal(salePrice.Floor()) {
+		return http.StatusBadRequest, terror.Warn(fmt.Errorf("invalid sale_price"), "Invalid sale_price, it must be a whole amount.")
+	}
+
+	collection, err := db.CollectionByMintAddress(common.HexToAddress(collectionAddress))
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Warn(err, "Collection not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get collection from db")
+	}
+
+	exists, err := boiler.UserAssets(
+		boiler.UserAssetWhere.CollectionID.EQ(collection.ID),
+		boiler.UserAssetWhere.TokenID.EQ(tokenID),
+	).Exists(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed find asset")
+	}
+	if !exists {
+		return http.StatusNotFound, terror.Warn(fmt.Errorf("asset not found"), "Asset not found.")
+	}
+
+	// no recipient means no royalty, the same as royaltyInfo returning the zero address
+	resp := &RoyaltyInfoResp{
+		Receiver:      common.Address{}.Hex(),
+		RoyaltyAmount: "0",
+	}
+	if collection.RoyaltyRecipient.Valid && collection.RoyaltyBasisPoints > 0 {
+		resp.Receiver = collection.RoyaltyRecipient.String
+		resp.BasisPoints = collection.RoyaltyBasisPoints
+		resp.RoyaltyAmount = salePrice.Mul(decimal.NewFromInt(int64(collection.RoyaltyBasisPoints))).Div(decimal.NewFromInt(10000)).Floor().String()
+	}
+
+	return helpers.EncodeJSON(w, resp)
+}
+
+// CollectionAssetSearch is the public faceted search over a collection's assets
+func (api *API) CollectionAssetSearch(w http.ResponseWriter, r *http.Request) (int, error) {
+	req := &AssetSearchPayload{}
+	err := json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Could not decode json")
+	}
+	req.CollectionSlug = chi.URLParam(r, "collection_slug")
+
+	result, err := assetSearch(req)
+	if err != nil {
+		return collectionStatus(err), err
+	}
+	return helpers.EncodeJSON(w, result)
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-chi/chi/v5"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// serveCollection calls the handler with the chi url params set the way the router would
+func serveCollection(t *testing.T, handler func(w http.ResponseWriter, r *http.Request) (int, error), target string, params map[string]string, out interface{}) int {
+	t.Helper()
+	rctx := chi.NewRouteContext()
+	for k, v := range params {
+		rctx.URLParams.Add(k, v)
+	}
+	r := httptest.NewRequest(http.MethodGet, target, nil)
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	w := httptest.NewRecorder()
+
+	code, err := handler(w, r)
+	if err != nil {
+		return code
+	}
+	err = json.NewDecoder(w.Body).Decode(out)
+	if err != nil {
+		t.Fatalf("failed to decode response: %s", err)
+	}
+	return http.StatusOK
+}
+
+func TestCollectionContractMetadata(t *testing.T) {
+	passdbtest.Require(t)
+
+	api := &API{}
+	collection := passdbtest.Collection(t)
+	collection.Description = null.StringFrom("a collection")
+	collection.LogoURL = null.StringFrom("https://example.com/logo.png")
+	collection.RoyaltyRecipient = null.StringFrom("0x000000000000000000000000000000000000dEaD")
+	collection.RoyaltyBasisPoints = 250
+	_, err := collection.Update(passdb.StdConn, boil.Infer())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("serves the collection's metadata", func(t *testing.T) {
+		// marketplaces ask with whatever case the contract address was given in
+		resp := &ContractMetaData{}
+		code := serveCollection(t, api.CollectionContractMetadata, "/", map[string]string{"collection_address": strings.ToLower(collection.MintContract.String)}, resp)
+		if code != http.StatusOK {
+			t.Fatalf("status = %d, want %d", code, http.StatusOK)
+		}
+		want := &ContractMetaData{
+			Name:                 collection.Name,
+			Description:          "a collection",
+			Image:                "https://example.com/logo.png",
+			SellerFeeBasisPoints: 250,
+			FeeRecipient:         collection.RoyaltyRecipient.String,
+		}
+		if *resp != *want {
+			t.Errorf("metadata = %+v, want %+v", resp, want)
+		}
+	})
+
+	t.Run("unknown contract", func(t *testing.T) {
+		code := serveCollection(t, api.CollectionContractMetadata, "/", map[string]string{"collection_address": "0x000000000000000000000000000000000000bEEF"}, nil)
+		if code != http.StatusNotFound {
+			t.Errorf("status = %d, want %d", code, http.StatusNotFound)
+		}
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		code := serveCollection(t, api.CollectionContractMetadata, "/", map[string]string{"collection_address": "nope"}, nil)
+		if code != http.StatusBadRequest {
+			t.Errorf("status = %d, want %d", code, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestAssetRoyaltyInfo(t *testing.T) {
+	passdbtest.Require(t)
+
+	api := &API{}
+	royalty := func(t *testing.T, collection *boiler.Collection, userAsset *boiler.UserAsset, salePrice string) (int, *RoyaltyInfoResp) {
+		t.Helper()
+		resp := &RoyaltyInfoResp{}
+		code := serveCollection(t, api.AssetRoyaltyInfo, "/?sale_price="+salePrice, map[string]string{
+			"collection_address": collection.MintContract.String,
+			"token_id":           fmt.Sprint(userAsset.TokenID),
+		}, resp)
+		return code, resp
+	}
+
+	collection := passdbtest.Collection(t)
+	collection.RoyaltyRecipient = null.StringFrom("0x000000000000000000000000000000000000dEaD")
+	collection.RoyaltyBasisPoints = 250
+	_, err := collection.Update(passdb.StdConn, boil.Whitelist(boiler.CollectionColumns.RoyaltyRecipient, boiler.CollectionColumns.RoyaltyBasisPoints))
+	if err != nil {
+		t.Fatal(err)
+	}
+	userAsset := passdbtest.Asset(t, collection, passdbtest.User(t))
+
+	t.Run("royalty rounds down", func(t *testing.T) {
+		code, resp := royalty(t, collection, userAsset, "1000000000000000001")
+		if code != http.StatusOK {
+			t.Fatalf("status = %d, want %d", code, http.StatusOK)
+		}
+		want := RoyaltyInfoResp{Receiver: collection.RoyaltyRecipient.String, RoyaltyAmount: "25000000000000000", BasisPoints: 250}
+		if *resp != want {
+			t.Errorf("royalty = %+v, want %+v", resp, want)
+		}
+	})
+
+	t.Run("no recipient pays no royalty", func(t *testing.T) {
+		free := passdbtest.Collection(t)
+		freeAsset := passdbtest.Asset(t, free, passdbtest.User(t))
+		code, resp := royalty(t, free, freeAsset, "1000")
+		if code != http.StatusOK {
+			t.Fatalf("status = %d, want %d", code, http.StatusOK)
+		}
+		want := RoyaltyInfoResp{Receiver: common.Address{}.Hex(), RoyaltyAmount: "0"}
+		if *resp != want {
+			t.Errorf("royalty = %+v, want %+v", resp, want)
+		}
+	})
+
+	t.Run("bad requests", func(t *testing.T) {
+		for _, salePrice := range []string{"", "-1", "1.5", "lots"} {
+			code, _ := royalty(t, collection, userAsset, salePrice)
+			if code != http.StatusBadRequest {
+				t.Errorf("status for sale price %q = %d, want %d", salePrice, code, http.StatusBadRequest)
+			}
+		}
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		code, _ := royalty(t, collection, &boiler.UserAsset{TokenID: 999999}, "1000")
+		if code != http.StatusNotFound {
+			t.Errorf("status = %d, want %d", code, http.StatusNotFound)
+		}
+	})
+}
@@ -1,7 +1,9 @@
 package api
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/volatiletech/null/v8"
@@ -9,10 +11,10 @@ import (
 	"strconv"
 	"time"
 	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
 	"xsyn-services/passport/db"
 	"xsyn-services/passport/passdb"
 	"xsyn-services/passport/passlog"
-	"xsyn-services/passport/supremacy_rpcclient"
 	"xsyn-services/types"
 
 	"github.com/go-chi/chi/v5"
@@ -77,7 +79,7 @@ func (api *API) AssetGetByCollectionAndTokenID(w http.ResponseWriter, r *http.Re
 
 	var openseaAsset *openSeaMetaData
 
-	asset, err := boiler.UserAssets(
+	userAsset, err := boiler.UserAssets(
 		boiler.UserAssetWhere.CollectionID.EQ(collection.ID),
 		boiler.UserAssetWhere.TokenID.EQ(int64(tokenID)),
 	).One(passdb.StdConn)
@@ -85,54 +87,57 @@ func (api *API) AssetGetByCollectionAndTokenID(w http.ResponseWriter, r *http.Re
 		return http.StatusInternalServerError, terror.Error(err, "Failed find asset")
 	}
 
-	// if asset refreshed over 24 hours ago, pull it
-	if asset.DataRefreshedAt.Before(time.Now().Add(-24 * time.Hour)) {
-		xsynAsset, err := supremacy_rpcclient.AssetGet(asset.Hash)
+	// serve what we have, stale metadata is refreshed by the metadata refresh worker
+	if userAsset.DataRefreshedAt.Before(time.Now().Add(-asset.MetadataStaleAfter)) {
+		err = asset.EnqueueMetadataRefresh(passdb.StdConn, userAsset.ID, asset.MetadataRefreshPriorityRequested)
 		if err != nil {
-			passlog.L.Error().Err(err).Str("asset.Hash", asset.Hash).Msg("failed to refresh metadata")
-		} else {
-			userAssetNew, err := db.UpdateUserAsset(xsynAsset, false)
-			if err != nil {
-				passlog.L.Error().Err(err).Str("asset.Hash", asset.Hash).Msg("failed to update metadata")
-			} else {
-				asset = userAssetNew
-			}
+			passlog.L.Error().Err(err).Str("asset.Hash", userAsset.Hash).Msg("failed to queue metadata refresh")
 		}
 	}
 
-	attribes := []*types.OpenSeaAttribute{}
-	if asset.Attributes != nil {
-		err := asset.Attributes.Unmarshal(&attribes)
+	metadata, err := db.AssetMetadataFromBoiler(userAsset)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed find asset")
+	}
+
+	// an earlier version of the metadata can be asked for, e.g. to show the asset as it was when it was sold
+	if versionStr := r.URL.Query().Get("version"); versionStr != "" {
+		version, err := strconv.Atoi(versionStr)
 		if err != nil {
-			return http.StatusInternalServerError, terror.Error(err, "Failed find asset")
+			return http.StatusBadRequest, terror.Warn(err, "Invalid version")
+		}
+		metadata, err = db.AssetMetadataVersion(userAsset.ID, version)
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusNotFound, terror.Warn(err, "Metadata version not found")
+		}
+		if err != nil {
+			return http.StatusInternalServerError, terror.Error(err, "Failed find metadata version")
 		}
 	}
 
 	newAttributes := []*types.OpenSeaAttribute{}
-	if len(attribes) > 0 {
-		for _, attribute := range attribes {
-			if attribute.TraitType == "Name" || attribute.TraitType == "name" {
-				continue
-			}
-			newAttribute := &types.OpenSeaAttribute{
-				DisplayType: attribute.DisplayType,
-				TraitType:   attribute.TraitType,
-				Value:       attribute.Value,
-			}
-
-			newAttributes = append(newAttributes, newAttribute)
+	for _, attribute := range metadata.Attributes {
+		if attribute.TraitType == "Name" || attribute.TraitType == "name" {
+			continue
 		}
+		newAttribute := &types.OpenSeaAttribute{
+			DisplayType: attribute.DisplayType,
+			TraitType:   attribute.TraitType,
+			Value:       attribute.Value,
+		}
+
+		newAttributes = append(newAttributes, newAttribute)
 	}
 
 	openseaAsset = &openSeaMetaData{
-		Image:           asset.ImageURL.String,
-		ExternalURL:     asset.ExternalURL.String,
-		Description:     asset.Description.String,
-		Name:            asset.Name,
+		Image:           metadata.ImageURL.String,
+		ExternalURL:     metadata.ExternalURL.String,
+		Description:     metadata.Description.String,
+		Name:            metadata.Name,
 		Attributes:      newAttributes,
-		BackgroundColor: asset.BackgroundColor.String,
-		AnimationURL:    asset.AnimationURL.String,
-		YoutubeURL:      asset.YoutubeURL.String,
+		BackgroundColor: metadata.BackgroundColor.String,
+		AnimationURL:    metadata.AnimationURL.String,
+		YoutubeURL:      metadata.YoutubeURL.String,
 	}
 
 	jsonObject, err := json.Marshal(openseaAsset)
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/media"
+	"xsyn-services/passport/passdb"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ninja-software/terror/v2"
+)
+
+// MediaRouter serves asset and faction images resized to a width as webp, ?w= picks the width
+func MediaRouter(api *API) chi.Router {
+	r := chi.NewRouter()
+	r.Get("/blob/{blob_id}", WithError(api.MediaBlob))
+	r.Get("/faction/{faction_id}/{kind}", WithError(api.MediaFaction))
+	r.Get("/asset/{hash}/{kind}", WithError(api.MediaAsset))
+	r.Get("/proxy", WithError(api.MediaProxy))
+	return r
+}
+
+// MediaBlob serves a public blob
+func (api *API) MediaBlob(w http.ResponseWriter, r *http.Request) (int, error) {
+	return api.serveMedia(w, r, media.Source{BlobID: chi.URLParam(r, "blob_id")}, true)
+}
+
+// MediaFaction redirects to a faction's logo or background blob.
+// A faction can change its images, so only the redirect is served from here, briefly cached, and the blob is cached for good.
+func (api *API) MediaFaction(w http.ResponseWriter, r *http.Request) (int, error) {
+	faction, err := boiler.FindFaction(passdb.StdConn, chi.URLParam(r, "faction_id"))
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Warn(err, "Faction not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get faction.")
+	}
+
+	blobID := ""
+	switch chi.URLParam(r, "kind") {
+	case "logo":
+		blobID = faction.LogoBlobID
+	case "background":
+		blobID = faction.BackgroundBlobID
+	default:
+		return http.StatusNotFound, terror.Warn(fmt.Errorf("unknown faction media"), "Media not found.")
+	}
+
+	// relative to /faction/{faction_id}/{kind}, so it works wherever the router is mounted
+	blobURL := &url.URL{Path: "../../blob/" + url.PathEscape(blobID), RawQuery: r.URL.RawQuery}
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	http.Redirect(w, r, blobURL.String(), http.StatusFound)
+	return http.StatusFound, nil
+}
+
+// MediaAsset serves an asset's image, avatar or large image.
+// The url is looked up at request time, so the response is only cached for a day in case the asset changes.
+func (api *API) MediaAsset(w http.ResponseWriter, r *http.Request) (int, error) {
+	userAsset, err := boiler.UserAssets(
+		boiler.UserAssetWhere.Hash.EQ(chi.URLParam(r, "hash")),
+	).One(passdb.StdConn)
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Warn(err, "Asset not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get asset.")
+	}
+
+	src := media.Source{}
+	switch chi.URLParam(r, "kind") {
+	case "image":
+		src.URL = userAsset.ImageURL.String
+	case "avatar":
+		src.URL = userAsset.AvatarURL.String
+	case "large_image":
+		src.URL = userAsset.LargeImageURL.String
+	default:
+		return http.StatusNotFound, terror.Warn(fmt.Errorf("unknown asset media"), "Media not found.")
+	}
+	if src.URL == "" {
+		return http.StatusNotFound, terror.Warn(fmt.Errorf("asset has no %s", chi.URLParam(r, "kind")), "Media not found.")
+	}
+	return api.serveMedia(w, r, src, false)
+}
+
+// MediaProxy serves an image from any of the allowed hosts, ?url= is the image
+func (api *API) MediaProxy(w http.ResponseWriter, r *http.Request) (int, error) {
+	src := media.Source{URL: r.URL.Query().Get("url")}
+	if src.URL == "" {
+		return http.StatusBadRequest, terror.Warn(fmt.Errorf("missing url"), "Missing url.")
+	}
+	return api.serveMedia(w, r, src, false)
+}
+
+func (api *API) serveMedia(w http.ResponseWriter, r *http.Request, src media.Source, immutable bool) (int, error) {
+	width := 0
+	if widthStr := r.URL.Query().Get("w"); widthStr != "" {
+		var err error
+		width, err = strconv.Atoi(widthStr)
+		if err != nil {
+			return http.StatusBadRequest, terror.Warn(err, "Invalid width.")
+		}
+	}
+
+	data, err := api.Media.Variant(src, width)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound, terror.Warn(err, "Media not found.")
+	case errors.Is(err, media.ErrHostNotAllowed):
+		return http.StatusForbidden, terror.Warn(err, "Media host is not allowed.")
+	case errors.Is(err, media.ErrNotImage):
+		return http.StatusUnsupportedMediaType, terror.Warn(err, "Media is not an image.")
+	case errors.Is(err, media.ErrTooLarge):
+		return http.StatusRequestEntityTooLarge, terror.Warn(err, "Media is too large.")
+	case err != nil:
+		return http.StatusBadGateway, terror.Error(err, "Failed to get media.")
+	}
+
+	cacheControl := "public, max-age=86400"
+	if immutable {
+		cacheControl = "public, max-age=31536000, immutable"
+	}
+	w.Header().Set("Content-Type", "image/webp")
+	w.Header().Set("Cache-Control", cacheControl)
+	_, err = w.Write(data)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
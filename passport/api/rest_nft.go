@@ -11,6 +11,7 @@ import (
 	"time"
 	"xsyn-services/boiler"
 	"xsyn-services/passport/api/users"
+	"xsyn-services/passport/asset"
 	"xsyn-services/passport/db"
 	"xsyn-services/passport/passdb"
 	"xsyn-services/types"
@@ -131,14 +132,20 @@ func (api *API) MintAsset(w http.ResponseWriter, r *http.Request) (int, error) {
 		return http.StatusInternalServerError, terror.Error(err, "Unable to validate status of asset.")
 	}
 
-	if onChainStatusObject.OnChainStatus != string(db.MINTABLE) {
-		return http.StatusInternalServerError, terror.Error(fmt.Errorf("unable to mint asset with status %s", onChainStatusObject.OnChainStatus), "Failed to mint asset.")
+	err = db.ValidateOnChainTransition(db.OnChainStatus(onChainStatusObject.OnChainStatus), db.STAKABLE, db.OnChainStatusSourceSignature)
+	if err != nil || onChainStatusObject.OnChainStatus != string(db.MINTABLE) {
+		return http.StatusBadRequest, terror.Error(fmt.Errorf("unable to mint asset with status %s", onChainStatusObject.OnChainStatus), "Failed to mint asset.")
 	}
 
 	if item.UnlockedAt.After(time.Now()) {
 		return http.StatusBadRequest, terror.Error(fmt.Errorf("asset is locked"), "Asset is locked.")
 	}
 
+	err = asset.CheckNotRented(passdb.StdConn, item.ID)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Asset is rented out, it can be minted once the rental ends.")
+	}
+
 	if item.LockedToService.Valid {
 		service, err := boiler.FindUser(passdb.StdConn, item.LockedToService.String)
 		if err != nil {
@@ -356,8 +363,9 @@ func (api *API) UnstakeNFT(w http.ResponseWriter, r *http.Request) (int, error)
 		return http.StatusInternalServerError, terror.Error(err, "Unable to validate status of asset.")
 	}
 
-	if onChainStatusObject.OnChainStatus != string(db.UNSTAKABLE) {
-		return http.StatusInternalServerError, terror.Error(fmt.Errorf("unable to unstake asset with status %s", onChainStatusObject.OnChainStatus), "Failed to mint asset.")
+	err = db.ValidateOnChainTransition(db.OnChainStatus(onChainStatusObject.OnChainStatus), db.STAKABLE, db.OnChainStatusSourceSignature)
+	if err != nil || onChainStatusObject.OnChainStatus != string(db.UNSTAKABLE) {
+		return http.StatusBadRequest, terror.Error(fmt.Errorf("unable to unstake asset with status %s", onChainStatusObject.OnChainStatus), "Failed to unstake asset.")
 	}
 
 	if item.UnlockedAt.After(time.Now()) {
@@ -26,7 +26,6 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/go-chi/chi/v5"
-	"github.com/ninja-software/sale/dispersions"
 	"github.com/ninja-software/terror/v2"
 	"github.com/ninja-syndicate/supremacy-bridge/bridge"
 )
@@ -56,13 +55,8 @@ func (api *API) GetMaxWithdrawAmount(w http.ResponseWriter, r *http.Request) (in
 		return http.StatusInternalServerError, terror.Error(err, "Failed to find users info")
 	}
 
-	state, err := boiler.States().One(passdb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to get state")
-	}
-
 	toAddress := common.HexToAddress(address)
-	amountCanRefund, infinite, err := dispersions.MaxWithdrawBefore(toAddress, time.Now(), state.WithdrawStartAt, state.CliffEndAt, state.DripStartAt)
+	amountCanRefund, infinite, err := db.VestingMaxWithdrawBefore(toAddress, time.Now())
 	if err != nil {
 		return http.StatusInternalServerError, terror.Error(err, "Failed to get max withdraw amount")
 	}
@@ -264,12 +258,7 @@ func (api *API) WithdrawSups(w http.ResponseWriter, r *http.Request) (int, error
 	if err != nil {
 		return http.StatusInternalServerError, terror.Error(err, "Failed to create withdraw signature, please try again or contact support.")
 	}
-	state, err := boiler.States().One(passdb.StdConn)
-	if err != nil {
-		return http.StatusInternalServerError, terror.Error(err, "Failed to get state")
-	}
-
-	amountCanRefund, infinite, err := dispersions.MaxWithdrawBefore(toAddress, time.Now(), state.WithdrawStartAt, state.CliffEndAt, state.DripStartAt)
+	amountCanRefund, infinite, err := db.VestingMaxWithdrawBefore(toAddress, time.Now())
 	if err != nil {
 		return http.StatusInternalServerError, terror.Error(err, "Failed to get max withdraw amount")
 	}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/helpers"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+	"xsyn-services/passport/supremacy_rpcclient"
+	"xsyn-services/types"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// notifyServiceLockRelease tells the service an asset was taken back from it.
+// Only supremacy can be reached from here, other services find out when their next renew fails.
+func notifyServiceLockRelease(userAsset *boiler.UserAsset, serviceID string, transferEvent *boiler.AssetServiceTransferEvent) bool {
+	if serviceID != types.SupremacyGameUserID.String() {
+		return false
+	}
+	err := supremacy_rpcclient.AssetUnlockFromSupremacy(types.UserAssetFromBoiler(userAsset), transferEvent.ID)
+	if err != nil {
+		passlog.L.Error().Err(err).Str("user_asset_id", userAsset.ID).Msg("failed to tell supremacy about released asset lock")
+		return false
+	}
+	return true
+}
+
+// ReapServiceLocks hands assets back to xsyn every minute once the service holding them stops renewing its lease
+func ReapServiceLocks() {
+	ticker := time.NewTicker(time.Minute)
+	for range ticker.C {
+		leases, err := asset.ExpiredServiceLocks()
+		if err != nil {
+			passlog.L.Error().Err(err).Msg("failed to get expired service locks")
+			continue
+		}
+		for _, lease := range leases {
+			l := passlog.L.With().Str("lease_id", lease.ID).Str("user_asset_id", lease.UserAssetID).Str("service_id", lease.ServiceID).Logger()
+			transferEvent, err := asset.ReleaseServiceLock(lease.UserAssetID, lease.ServiceID, asset.LockReleaseExpired, null.String{})
+			if err != nil {
+				l.Warn().Err(err).Msg("failed to release expired service lock")
+				continue
+			}
+			l.Info().Msg("released expired service lock")
+			if transferEvent == nil {
+				continue
+			}
+			userAsset, err := boiler.FindUserAsset(passdb.StdConn, lease.UserAssetID)
+			if err != nil {
+				l.Error().Err(err).Msg("failed to get released asset")
+				continue
+			}
+			notifyServiceLockRelease(userAsset, lease.ServiceID, transferEvent)
+		}
+	}
+}
+
+type ServiceLockResponse struct {
+	UserAssetID string                   `json:"user_asset_id"`
+	Hash        string                   `json:"hash"`
+	Name        string                   `json:"name"`
+	OwnerID     string                   `json:"owner_id"`
+	ServiceID   string                   `json:"service_id"`
+	ServiceName string                   `json:"service_name"`
+	Lease       *boiler.AssetServiceLock `json:"lease,omitempty"`
+}
+
+// AdminServiceLockList lists locked assets with their lease, assets without a lease stay locked until the owner moves them back
+func AdminServiceLockList(w http.ResponseWriter, r *http.Request) (int, error) {
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if pageSize <= 0 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	queryMods := []qm.QueryMod{
+		boiler.UserAssetWhere.LockedToService.IsNotNull(),
+		qm.Load(boiler.UserAssetRels.LockedToServiceUser, qm.Select(boiler.UserColumns.ID, boiler.UserColumns.Username)),
+		qm.Load(boiler.UserAssetRels.AssetServiceLocks, boiler.AssetServiceLockWhere.ReleasedAt.IsNull()),
+		qm.OrderBy(boiler.UserAssetColumns.Hash),
+		qm.Limit(pageSize),
+		qm.Offset(page * pageSize),
+	}
+	if serviceID := r.URL.Query().Get("service_id"); serviceID != "" {
+		queryMods = append(queryMods, boiler.UserAssetWhere.LockedToService.EQ(null.StringFrom(serviceID)))
+	}
+	if r.URL.Query().Get("leased") == "true" {
+		queryMods = append(queryMods, qm.Where(fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %s WHERE %s = %s AND %s IS NULL)",
+			boiler.TableNames.AssetServiceLocks,
+			boiler.AssetServiceLockTableColumns.UserAssetID,
+			boiler.UserAssetTableColumns.ID,
+			boiler.AssetServiceLockTableColumns.ReleasedAt,
+		)))
+	}
+
+	userAssets, err := boiler.UserAssets(queryMods...).All(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get service locks.")
+	}
+
+	resp := []*ServiceLockResponse{}
+	for _, ua := range userAssets {
+		sl := &ServiceLockResponse{
+			UserAssetID: ua.ID,
+			Hash:        ua.Hash,
+			Name:        ua.Name,
+			OwnerID:     ua.OwnerID,
+			ServiceID:   ua.LockedToService.String,
+		}
+		if ua.R.LockedToServiceUser != nil {
+			sl.ServiceName = ua.R.LockedToServiceUser.Username
+		}
+		if len(ua.R.AssetServiceLocks) > 0 {
+			sl.Lease = ua.R.AssetServiceLocks[0]
+		}
+		resp = append(resp, sl)
+	}
+
+	return helpers.EncodeJSON(w, resp)
+}
+
+type ServiceLockReleaseResponse struct {
+	TransferEvent   *boiler.AssetServiceTransferEvent `json:"transfer_event"`
+	ServiceNotified bool                              `json:"service_notified"`
+}
+
+// AdminServiceLockRelease takes an asset back from the service it is locked to, with or without a lease
+func AdminServiceLockRelease(w http.ResponseWriter, r *http.Request) (int, error) {
+	apiKey, err := AdminAPIKey(r)
+	if err != nil {
+		return http.StatusUnauthorized, terror.Error(err, "Invalid admin api key.")
+	}
+
+	userAsset, err := boiler.FindUserAsset(passdb.StdConn, chi.URLParam(r, "user_asset_id"))
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, terror.Error(err, "Asset not found.")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get asset.")
+	}
+	if !userAsset.LockedToService.Valid {
+		return http.StatusBadRequest, terror.Error(fmt.Errorf("asset is not locked"), "Asset is not locked to a service.")
+	}
+	if asset.IsEscrowService(userAsset.LockedToService.String) {
+		return http.StatusBadRequest, terror.Error(asset.ErrEscrowServiceLock, "Asset is held by a trade or marketplace listing, cancel it instead.")
+	}
+
+	serviceID := userAsset.LockedToService.String
+	transferEvent, err := asset.ReleaseServiceLock(userAsset.ID, serviceID, asset.LockReleaseAdmin, null.StringFrom(apiKey.UserID))
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, fmt.Sprintf("Failed to release asset lock: %s.", err.Error()))
+	}
+
+	err = userAsset.Reload(passdb.StdConn)
+	if err != nil {
+		return http.StatusInternalServerError, terror.Error(err, "Failed to get asset.")
+	}
+
+	return helpers.EncodeJSON(w, &ServiceLockReleaseResponse{
+		TransferEvent:   transferEvent,
+		ServiceNotified: notifyServiceLockRelease(userAsset, serviceID, transferEvent),
+	})
+}
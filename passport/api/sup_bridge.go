@@ -2,12 +2,10 @@ package api
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
 	"time"
 	"xsyn-services/passport/db"
 	"xsyn-services/passport/passlog"
+	"xsyn-services/passport/payments"
 	"xsyn-services/types"
 
 	"github.com/jpillora/backoff"
@@ -50,54 +48,14 @@ type ETHPriceResp struct {
 		Usd float64 `json:"usd"`
 	} `json:"ethereum"`
 }
-type CoinbaseResp struct {
-	Data struct {
-		Currency string `json:"currency"`
-		Rates    struct {
-			Usd string `json:"USD"`
-		} `json:"rates"`
-	} `json:"data"`
-}
-
-func fetchPrice(symbol string) (decimal.Decimal, error) {
-	// use ETH or BNB for symbol
-	req, err := http.NewRequest("GET", fmt.Sprintf(`https://api.coinbase.com/v2/exchange-rates?currency=%s`, symbol), nil)
-
-	if err != nil {
-		return decimal.Zero, err
-	}
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return decimal.Zero, err
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
-		return decimal.Zero, fmt.Errorf("non 200 status code: %d", resp.StatusCode)
-	}
-	result := &CoinbaseResp{}
-	err = json.NewDecoder(resp.Body).Decode(result)
-	if err != nil {
-		return decimal.Zero, err
-	}
-
-	dec, err := decimal.NewFromString(result.Data.Rates.Usd)
-	if err != nil {
-		return decimal.Zero, err
-	}
-	if dec.Equal(decimal.Zero) {
-		return decimal.Zero, fmt.Errorf("0 price returned")
-	}
-	return dec, nil
-}
 
+// FetchETHPrice fetches the ETH price from coinbase only, the listeners use the price oracle instead
 func FetchETHPrice() (decimal.Decimal, error) {
-	return fetchPrice("ETH")
+	return payments.CoinbasePrice("ETH")
 }
 
 func FetchBNBPrice() (decimal.Decimal, error) {
-	return fetchPrice("BNB")
+	return payments.CoinbasePrice("BNB")
 }
 
 func NewChainClients(log *zerolog.Logger, api *API, p *types.Web3Params, isTestnetBlockchain bool, runBlockchainBridge bool, enablePurchaseSubscription bool) *ChainClients {
@@ -163,16 +121,19 @@ func (cc *ChainClients) runGoETHPriceListener(ctx context.Context) {
 			return
 		default:
 			for {
-				result, err := FetchETHPrice()
-				if err != nil {
-					cc.Log.Err(err).Msg("failed to get ETH price")
+				price := payments.OracleUpdate(types.ETHSymbol)
+				if price.USD.LessThanOrEqual(decimal.Zero) {
+					cc.Log.Error().Str("reason", price.Reason).Msg("failed to get ETH price")
 					time.Sleep(exchangeRateBackoff.Duration())
 					continue
 				}
 				exchangeRateBackoff.Reset()
+				if !price.Trusted {
+					cc.Log.Warn().Str("price", price.USD.String()).Str("reason", price.Reason).Msg("ETH price can't be trusted")
+				}
 
 				cc.updatePriceFuncMu.Lock()
-				cc.updatePriceFunc(types.ETHSymbol, result)
+				cc.updatePriceFunc(types.ETHSymbol, price.USD)
 				cc.updatePriceFuncMu.Unlock()
 
 				time.Sleep(10 * time.Second)
@@ -196,16 +157,19 @@ func (cc *ChainClients) runGoBNBPriceListener(ctx context.Context) {
 
 			for {
 
-				result, err := FetchBNBPrice()
-				if err != nil {
-					cc.Log.Err(err).Msg("failed to get BNB price")
+				price := payments.OracleUpdate(types.BNBSymbol)
+				if price.USD.LessThanOrEqual(decimal.Zero) {
+					cc.Log.Error().Str("reason", price.Reason).Msg("failed to get BNB price")
 					time.Sleep(exchangeRateBackoff.Duration())
 					continue
 				}
 				exchangeRateBackoff.Reset()
+				if !price.Trusted {
+					cc.Log.Warn().Str("price", price.USD.String()).Str("reason", price.Reason).Msg("BNB price can't be trusted")
+				}
 
 				cc.updatePriceFuncMu.Lock()
-				cc.updatePriceFunc(types.BNBSymbol, result)
+				cc.updatePriceFunc(types.BNBSymbol, price.USD)
 				cc.updatePriceFuncMu.Unlock()
 
 				time.Sleep(10 * time.Second)
@@ -0,0 +1,166 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	HubKeyAsset1155Transfer          = "ASSET:1155:TRANSFER"
+	HubKeyAsset1155TransferSubscribe = "ASSET:1155:TRANSFER:SUBSCRIBE"
+)
+
+type Asset1155TransferRequest struct {
+	Payload struct {
+		CollectionSlug string `json:"collection_slug"`
+		TokenID        int    `json:"token_id"`
+		Amount         int    `json:"amount"`
+		ToUserID       string `json:"to_user_id"`
+	} `json:"payload"`
+}
+
+type Asset1155TransferResponse struct {
+	ID             int64       `json:"id"`
+	CollectionSlug string      `json:"collection_slug"`
+	TokenID        int         `json:"token_id"`
+	Label          string      `json:"label"`
+	ImageURL       string      `json:"image_url"`
+	Amount         int         `json:"amount"`
+	From           *User       `json:"from"`
+	To             *User       `json:"to"`
+	ServiceID      null.String `json:"service_id,omitempty"`
+	TransferredAt  time.Time   `json:"transferred_at"`
+}
+
+func asset1155TransferResponse(eventID int64) (*Asset1155TransferResponse, error) {
+	event, err := boiler.Asset1155TransferEvents(
+		boiler.Asset1155TransferEventWhere.ID.EQ(eventID),
+		qm.Load(boiler.Asset1155TransferEventRels.Collection, qm.Select(boiler.CollectionColumns.ID, boiler.CollectionColumns.Slug)),
+		qm.Load(boiler.Asset1155TransferEventRels.ToAsset),
+		qm.Load(boiler.Asset1155TransferEventRels.FromUser, qm.Select(boiler.UserColumns.ID, boiler.UserColumns.Username)),
+		qm.Load(boiler.Asset1155TransferEventRels.ToUser, qm.Select(boiler.UserColumns.ID, boiler.UserColumns.Username)),
+	).One(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Asset1155TransferResponse{
+		ID:             event.ID,
+		CollectionSlug: event.R.Collection.Slug,
+		TokenID:        event.ExternalTokenID,
+		Label:          event.R.ToAsset.Label,
+		ImageURL:       event.R.ToAsset.ImageURL,
+		Amount:         event.Amount,
+		From:           &User{ID: event.R.FromUser.ID, Username: event.R.FromUser.Username},
+		To:             &User{ID: event.R.ToUser.ID, Username: event.R.ToUser.Username},
+		ServiceID:      event.ServiceID,
+		TransferredAt:  event.TransferredAt,
+	}, nil
+}
+
+// Publish1155Transfer tells the sender and the recipient about a 1155 transfer between them
+func Publish1155Transfer(eventID int64) {
+	resp, err := asset1155TransferResponse(eventID)
+	if err != nil {
+		passlog.L.Error().Err(err).Int64("event_id", eventID).Msg("failed to load 1155 transfer for notification")
+		return
+	}
+	publish1155Transfer(resp)
+}
+
+func publish1155Transfer(resp *Asset1155TransferResponse) {
+	ws.PublishMessage(fmt.Sprintf("/user/%s/1155_transfers", resp.From.ID), HubKeyAsset1155TransferSubscribe, resp)
+	ws.PublishMessage(fmt.Sprintf("/user/%s/1155_transfers", resp.To.ID), HubKeyAsset1155TransferSubscribe, resp)
+}
+
+// Asset1155TransferHandler gives an amount of a 1155 token held on xsyn to another user
+func (ac *AssetController) Asset1155TransferHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &Asset1155TransferRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	b := TransferBucket.Add(fmt.Sprintf("%s_%s_%d", user.ID, req.Payload.CollectionSlug, req.Payload.TokenID), 1)
+	if b == 0 {
+		return terror.Error(fmt.Errorf("too many requests"), "Too many request made for transfer")
+	}
+
+	if req.Payload.Amount <= 0 {
+		return terror.Error(fmt.Errorf("amount must be positive"), "Amount must be more than 0.")
+	}
+	if req.Payload.ToUserID == user.ID {
+		return terror.Error(fmt.Errorf("cannot transfer to yourself"), "You can't transfer to yourself.")
+	}
+
+	collection, err := db.CollectionBySlug(req.Payload.CollectionSlug)
+	if err != nil {
+		return terror.Error(err, "Failed to get collection.")
+	}
+
+	from, err := boiler.UserAssets1155S(
+		boiler.UserAssets1155Where.OwnerID.EQ(user.ID),
+		boiler.UserAssets1155Where.CollectionID.EQ(collection.ID),
+		boiler.UserAssets1155Where.ExternalTokenID.EQ(req.Payload.TokenID),
+		boiler.UserAssets1155Where.ServiceID.IsNull(),
+	).One(passdb.StdConn)
+	if err != nil {
+		return terror.Error(err, "Failed to get user 1155 asset")
+	}
+	if from.Count < req.Payload.Amount {
+		return terror.Error(fmt.Errorf("asset count below 0 after transfer"), "Cannot process transfer. Amount after transfer is below 0")
+	}
+
+	event, err := asset.Transfer1155(user.ID, req.Payload.ToUserID, collection.ID, req.Payload.TokenID, req.Payload.Amount, null.String{}, "XSYN")
+	if err != nil {
+		return terror.Error(err, "Failed to transfer asset.")
+	}
+
+	resp, err := asset1155TransferResponse(event.ID)
+	if err != nil {
+		return terror.Error(err, "Failed to get transfer.")
+	}
+	publish1155Transfer(resp)
+
+	reply(resp)
+	return nil
+}
+
+// Asset1155TransferSubscribeHandler gets the user's most recent 1155 transfers, sent and received
+func (ac *AssetController) Asset1155TransferSubscribeHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	events, err := boiler.Asset1155TransferEvents(
+		qm.Expr(
+			boiler.Asset1155TransferEventWhere.FromUserID.EQ(user.ID),
+			qm.Or2(boiler.Asset1155TransferEventWhere.ToUserID.EQ(user.ID)),
+		),
+		qm.OrderBy(fmt.Sprintf("%s DESC", boiler.Asset1155TransferEventColumns.TransferredAt)),
+		qm.Limit(20),
+	).All(passdb.StdConn)
+	if err != nil {
+		return terror.Error(err, "Failed to get transfers.")
+	}
+
+	resp := []*Asset1155TransferResponse{}
+	for _, event := range events {
+		tr, err := asset1155TransferResponse(event.ID)
+		if err != nil {
+			return terror.Error(err, "Failed to get transfers.")
+		}
+		resp = append(resp, tr)
+	}
+	reply(resp)
+	return nil
+}
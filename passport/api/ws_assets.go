@@ -8,9 +8,11 @@ import (
 	"strings"
 	"time"
 	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
 	"xsyn-services/passport/db"
 	"xsyn-services/passport/passdb"
 	"xsyn-services/passport/passlog"
+	"xsyn-services/passport/payments"
 	"xsyn-services/passport/supremacy_rpcclient"
 	xsynTypes "xsyn-services/types"
 
@@ -46,10 +48,12 @@ func NewAssetController(log *zerolog.Logger, api *API) *AssetController {
 	// assets list
 	api.SecureCommand(HubKeyAssetList, assetHub.AssetList721Handler)
 	api.SecureCommand(HubKey1155AssetList, assetHub.AssetList1155Handler)
+	api.Command(HubKeyAssetSearch, assetHub.AssetSearch721Handler)
 	api.SecureCommand(HubKeyAssetTransferToSupremacy, assetHub.AssetTransferToSupremacyHandler)
 	api.SecureCommand(HubKeyAssetTransferFromSupremacy, assetHub.AssetTransferFromSupremacyHandler)
 	api.SecureCommand(HubKeyAsset1155TransferToSupremacy, assetHub.Asset1155TransferToSupremacyHandler)
 	api.SecureCommand(HubKeyAsset1155TransferFromSupremacy, assetHub.Asset1155TransferFromSupremacyHandler)
+	api.SecureCommand(HubKeyAsset1155Transfer, assetHub.Asset1155TransferHandler)
 	api.SecureCommand(HubKeyDeposit1155Asset, assetHub.DepositAsset1155Handler)
 	api.SecureCommand(HubKeyDepositAsset1155List, assetHub.DepositAsset1155ListHandler)
 	api.Command(HubKeyAssetGet, assetHub.AssetUpdatedGetHandler)
@@ -110,6 +114,65 @@ func (ac *AssetController) AssetList721Handler(ctx context.Context, user *xsynTy
 	return nil
 }
 
+// AssetSearchPayload is a faceted search over the attributes of a collection, with user_id set it only covers their inventory
+type AssetSearchPayload struct {
+	CollectionSlug string                     `json:"collection_slug"`
+	UserID         string                     `json:"user_id"`
+	Filters        []*db.AttributeFacetFilter `json:"filters"`
+	Sort           *db.AttributeFacetSort     `json:"sort,omitempty"`
+	Cursor         string                     `json:"cursor"`
+	PageSize       int                        `json:"page_size"`
+}
+
+func assetSearch(req *AssetSearchPayload) (*db.AssetFacetSearchResult, error) {
+	collection, err := boiler.Collections(boiler.CollectionWhere.Slug.EQ(req.CollectionSlug)).One(passdb.StdConn)
+	if err != nil {
+		return nil, terror.Warn(err, "Collection not found.")
+	}
+
+	opts := &db.AssetFacetSearchOpts{
+		CollectionID: collection.ID,
+		Filters:      req.Filters,
+		Sort:         req.Sort,
+		Cursor:       req.Cursor,
+		PageSize:     req.PageSize,
+	}
+	if req.UserID != "" {
+		opts.OwnerID = null.StringFrom(req.UserID)
+	}
+
+	result, err := db.AssetFacetSearch(opts)
+	var tErr *terror.TError
+	if errors.As(err, &tErr) && tErr.Level == terror.ErrLevelWarn {
+		return nil, err
+	}
+	if err != nil {
+		return nil, terror.Error(err, "Unable to search assets at this time, please check the filters or contact support.")
+	}
+	return result, nil
+}
+
+const HubKeyAssetSearch = "ASSET:SEARCH:721"
+
+// AssetSearch721Handler searches a collection by attributes and returns the matching assets with trait facets
+func (ac *AssetController) AssetSearch721Handler(ctx context.Context, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &struct {
+		Payload AssetSearchPayload `json:"payload"`
+	}{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	result, err := assetSearch(&req.Payload)
+	if err != nil {
+		return err
+	}
+
+	reply(result)
+	return nil
+}
+
 // Asset1155ListResponse is the response from get asset list
 type Asset1155ListResponse struct {
 	Total  int64                      `json:"total"`
@@ -258,7 +321,7 @@ func (ac *AssetController) AssetUpdatedGetHandler(ctx context.Context, key strin
 		if err != nil {
 			passlog.L.Error().Err(err).Str("userAsset.Hash", userAsset.Hash).Msg("failed to refresh metadata")
 		} else {
-			userAssetNew, err := db.UpdateUserAsset(xsynAsset, false)
+			userAssetNew, err := db.UpdateUserAsset(xsynAsset, false, db.MetadataSourceRefresh)
 			if err != nil {
 				passlog.L.Error().Err(err).Str("userAsset.Hash", userAsset.Hash).Msg("failed to update metadata")
 			} else {
@@ -345,7 +408,7 @@ func (ac *AssetController) AssetRefreshMetadataHandler(ctx context.Context, key
 		return terror.Error(err, "Failed to update asset metadata.")
 	}
 
-	_, err = db.UpdateUserAsset(asset, false)
+	_, err = db.UpdateUserAsset(asset, false, db.MetadataSourceUserRequest)
 	if err != nil {
 		return terror.Error(err, "Failed to update asset metadata.")
 	}
@@ -503,6 +566,10 @@ func (ac *AssetController) AssetTransferToSupremacyHandler(ctx context.Context,
 		return terror.Error(fmt.Errorf("trying to transfer locked asset to supremacy"), "Asset is currently locked.")
 	}
 
+	if userAsset.LockedToService.Valid {
+		return terror.Error(fmt.Errorf("trying to transfer asset locked to %s to supremacy", userAsset.LockedToService.String), "Asset is currently locked to another service.")
+	}
+
 	onChainStatusObject, err := boiler.UserAssetOnChainStatuses(
 		boiler.UserAssetOnChainStatusWhere.CollectionID.EQ(userAsset.CollectionID),
 		boiler.UserAssetOnChainStatusWhere.AssetHash.EQ(userAsset.Hash),
@@ -515,6 +582,17 @@ func (ac *AssetController) AssetTransferToSupremacyHandler(ctx context.Context,
 		return terror.Error(fmt.Errorf("trying to transfer unstaked asset to supremacy"), "Asset needs to be On-World before being able to transfer to Supremacy.")
 	}
 
+	// a staked asset can be unstaked at any time, so its collection's ownership index has to be up to date
+	if onChainStatusObject.OnChainStatus != string(db.MINTABLE) {
+		fresh, err := db.CollectionSyncCursorFresh(userAsset.CollectionID, db.CollectionSyncCursor721Transfer, payments.NFTIndexerMaxLag)
+		if err != nil {
+			return terror.Error(err, "Unable to validate status of asset.")
+		}
+		if !fresh {
+			return terror.Error(fmt.Errorf("nft ownership index of collection %s is stale", userAsset.R.Collection.Slug), "Unable to transfer asset, please try again or contact support.")
+		}
+	}
+
 	if userAsset.OwnerID != user.ID {
 		return terror.Error(terror.ErrUnauthorised, "You don't own this asset.")
 	}
@@ -544,7 +622,7 @@ func (ac *AssetController) AssetTransferToSupremacyHandler(ctx context.Context,
 		UserID:        userAsset.OwnerID,
 		InitiatedFrom: "XSYN",
 		ToService:     null.StringFrom(xsynTypes.SupremacyGameUserID.String()),
-		TransferTXID:  txID,
+		TransferTXID:  null.StringFrom(txID),
 	}
 	err = transferLog.Insert(passdb.StdConn, boil.Infer())
 	if err != nil {
@@ -657,12 +735,12 @@ func (ac *AssetController) AssetTransferFromSupremacyHandler(ctx context.Context
 	}
 
 	// Refresh metadata first, otherwise we could transfer an asset that is no longer a genesis when really it is.
-	asset, err := supremacy_rpcclient.AssetGet(req.Payload.AssetHash)
+	supAsset, err := supremacy_rpcclient.AssetGet(req.Payload.AssetHash)
 	if err != nil {
 		return terror.Error(err, "Failed to update asset metadata, try again or contact support.")
 	}
 
-	_, err = db.UpdateUserAsset(asset, false)
+	_, err = db.UpdateUserAsset(supAsset, false, db.MetadataSourceTransfer)
 	if err != nil {
 		return terror.Error(err, "Failed to update asset metadata, try again or contact support.")
 	}
@@ -687,6 +765,11 @@ func (ac *AssetController) AssetTransferFromSupremacyHandler(ctx context.Context
 		return terror.Error(terror.ErrUnauthorised, "You don't own this asset, try again or contact support.")
 	}
 
+	err = asset.CheckNotRented(passdb.StdConn, userAsset.ID)
+	if err != nil {
+		return terror.Error(err, "Asset is rented out, it can be moved once the rental ends.")
+	}
+
 	onChainStatusObject, err := boiler.UserAssetOnChainStatuses(
 		boiler.UserAssetOnChainStatusWhere.CollectionID.EQ(userAsset.CollectionID),
 		boiler.UserAssetOnChainStatusWhere.AssetHash.EQ(userAsset.Hash),
@@ -720,7 +803,7 @@ func (ac *AssetController) AssetTransferFromSupremacyHandler(ctx context.Context
 		UserID:        userAsset.OwnerID,
 		InitiatedFrom: "XSYN",
 		FromService:   null.StringFrom(xsynTypes.SupremacyGameUserID.String()),
-		TransferTXID:  txID,
+		TransferTXID:  null.StringFrom(txID),
 	}
 	err = transferLog.Insert(passdb.StdConn, boil.Infer())
 	if err != nil {
@@ -1319,7 +1402,7 @@ func reverseAssetServiceTransaction(
 		InitiatedFrom: transferToReverse.InitiatedFrom,
 		FromService:   transferToReverse.ToService,
 		ToService:     transferToReverse.FromService,
-		TransferTXID:  reverseID,
+		TransferTXID:  null.StringFrom(reverseID),
 	}
 	err = returnTransferLog.Insert(passdb.StdConn, boil.Infer())
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"xsyn-services/boiler"
 	"xsyn-services/passport/db"
+	"xsyn-services/passport/helpers"
 	"xsyn-services/passport/passdb"
 	"xsyn-services/types"
 
@@ -40,6 +41,12 @@ func NewCollectionController(log *zerolog.Logger, api *API, isTestnetwork bool)
 	api.SecureCommand(HubKeyCollectionList, collectionHub.CollectionsList)
 	api.Command(HubKeyWalletCollectionList, collectionHub.WalletCollectionsList)
 
+	// collection admin
+	api.SecureCommandWithPerm(HubKeyCollectionCreate, collectionHub.CreateHandler, types.PermCollectionCreate)
+	api.SecureCommandWithPerm(HubKeyCollectionUpdate, collectionHub.UpdateHandler, types.PermCollectionUpdate)
+	api.SecureCommandWithPerm(HubKeyCollectionArchive, collectionHub.ArchiveHandler, types.PermCollectionArchive)
+	api.SecureCommandWithPerm(HubKeyCollectionUnarchive, collectionHub.UnarchiveHandler, types.PermCollectionUnarchive)
+
 	// collection subscribe
 	api.Command(HubKeyCollectionSubscribe, collectionHub.Collection)
 
@@ -234,3 +241,154 @@ func (ctrlr *CollectionController) Collection(ctx context.Context, key string, p
 	reply(collection)
 	return nil
 }
+
+const HubKeyCollectionCreate = "COLLECTION:CREATE"
+
+type CollectionCreateRequest struct {
+	Payload CollectionRequest `json:"payload"`
+}
+
+// CreateHandler creates a collection
+func (ctrlr *CollectionController) CreateHandler(ctx context.Context, user *types.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &CollectionCreateRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	collection, err := createCollection(&req.Payload)
+	if err != nil {
+		return err
+	}
+
+	reply(collection)
+
+	ctrlr.API.RecordUserActivity(ctx,
+		user.ID,
+		"Created Collection",
+		types.ObjectTypeCollection,
+		helpers.StringPointer(collection.ID),
+		helpers.StringPointer(collection.Slug),
+		helpers.StringPointer(collection.Name),
+		&types.UserActivityChangeData{
+			Name: boiler.TableNames.Collections,
+			From: nil,
+			To:   collection,
+		},
+	)
+
+	return nil
+}
+
+const HubKeyCollectionUpdate = "COLLECTION:UPDATE"
+
+type CollectionUpdateRequest struct {
+	Payload struct {
+		CollectionSlug string `json:"collection_slug"`
+		CollectionRequest
+	} `json:"payload"`
+}
+
+// UpdateHandler updates a collection
+func (ctrlr *CollectionController) UpdateHandler(ctx context.Context, user *types.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &CollectionUpdateRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	oldCollection, err := db.CollectionBySlug(req.Payload.CollectionSlug)
+	if err != nil {
+		return terror.Error(err, "Collection not found.")
+	}
+
+	collection, err := updateCollection(req.Payload.CollectionSlug, func(cr *CollectionRequest) error {
+		return json.Unmarshal(payload, &struct {
+			Payload *CollectionRequest `json:"payload"`
+		}{cr})
+	})
+	if err != nil {
+		return err
+	}
+
+	reply(collection)
+
+	ctrlr.API.RecordUserActivity(ctx,
+		user.ID,
+		"Updated Collection",
+		types.ObjectTypeCollection,
+		helpers.StringPointer(collection.ID),
+		helpers.StringPointer(collection.Slug),
+		helpers.StringPointer(collection.Name),
+		&types.UserActivityChangeData{
+			Name: boiler.TableNames.Collections,
+			From: oldCollection,
+			To:   collection,
+		},
+	)
+
+	return nil
+}
+
+const HubKeyCollectionArchive = "COLLECTION:ARCHIVE"
+const HubKeyCollectionUnarchive = "COLLECTION:UNARCHIVE"
+
+type CollectionToggleArchiveRequest struct {
+	Payload struct {
+		CollectionSlug string `json:"collection_slug"`
+	} `json:"payload"`
+}
+
+// ArchiveHandler archives a collection
+func (ctrlr *CollectionController) ArchiveHandler(ctx context.Context, user *types.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &CollectionToggleArchiveRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	collection, err := setCollectionArchived(req.Payload.CollectionSlug, true)
+	if err != nil {
+		return err
+	}
+
+	reply(collection)
+
+	ctrlr.API.RecordUserActivity(ctx,
+		user.ID,
+		"Archived Collection",
+		types.ObjectTypeCollection,
+		helpers.StringPointer(collection.ID),
+		helpers.StringPointer(collection.Slug),
+		helpers.StringPointer(collection.Name),
+	)
+
+	return nil
+}
+
+// UnarchiveHandler unarchives a collection
+func (ctrlr *CollectionController) UnarchiveHandler(ctx context.Context, user *types.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &CollectionToggleArchiveRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	collection, err := setCollectionArchived(req.Payload.CollectionSlug, false)
+	if err != nil {
+		return err
+	}
+
+	reply(collection)
+
+	ctrlr.API.RecordUserActivity(ctx,
+		user.ID,
+		"Unarchived Collection",
+		types.ObjectTypeCollection,
+		helpers.StringPointer(collection.ID),
+		helpers.StringPointer(collection.Slug),
+		helpers.StringPointer(collection.Name),
+	)
+
+	return nil
+}
@@ -0,0 +1,692 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/helpers"
+	"xsyn-services/passport/passdb"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/friendsofgo/errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/kevinms/leakybucket-go"
+	"github.com/ninja-software/log_helpers"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// MarketplaceController holds handlers for marketplace listings
+type MarketplaceController struct {
+	Log *zerolog.Logger
+	API *API
+}
+
+// NewMarketplaceController creates the marketplace hub and starts closing expired listings
+func NewMarketplaceController(log *zerolog.Logger, api *API) *MarketplaceController {
+	marketplaceHub := &MarketplaceController{
+		Log: log_helpers.NamedLogger(log, "marketplace_hub"),
+		API: api,
+	}
+
+	api.SecureCommand(HubKeyMarketplaceListingCreate, marketplaceHub.ListingCreateHandler)
+	api.SecureCommand(HubKeyMarketplaceListingCancel, marketplaceHub.ListingCancelHandler)
+	api.SecureCommand(HubKeyMarketplaceBuy, marketplaceHub.BuyHandler)
+	api.SecureCommand(HubKeyMarketplaceBid, marketplaceHub.BidHandler)
+	api.PublicCommander.Command(HubKeyMarketplaceListings, marketplaceHub.ListingsWSHandler)
+
+	go marketplaceHub.CloseExpiredListings()
+
+	return marketplaceHub
+}
+
+const (
+	HubKeyMarketplaceListingCreate = "MARKETPLACE:LISTING:CREATE"
+	HubKeyMarketplaceListingCancel = "MARKETPLACE:LISTING:CANCEL"
+	HubKeyMarketplaceBuy           = "MARKETPLACE:BUY"
+	HubKeyMarketplaceBid           = "MARKETPLACE:BID"
+	HubKeyMarketplaceListings      = "MARKETPLACE:LISTINGS"
+	HubKeyMarketplaceListingUpdate = "MARKETPLACE:LISTING:UPDATE"
+)
+
+var MarketplaceBucket = leakybucket.NewCollector(1, 2, true)
+
+type MarketplaceListing struct {
+	ID              string              `json:"id"`
+	Seller          *User               `json:"seller"`
+	ListingType     string              `json:"listing_type"`
+	Hash            string              `json:"hash,omitempty"`
+	UserAsset1155ID string              `json:"user_asset_1155_id,omitempty"`
+	ExternalTokenID int                 `json:"external_token_id,omitempty"`
+	Name            string              `json:"name"`
+	ImageURL        null.String         `json:"image_url,omitempty"`
+	Amount          int                 `json:"amount"`
+	Price           decimal.Decimal     `json:"price"`
+	CurrentBid      decimal.NullDecimal `json:"current_bid"`
+	MinBid          decimal.Decimal     `json:"min_bid,omitempty"`
+	Fee             decimal.Decimal     `json:"fee"`
+	Status          string              `json:"status"`
+	BuyerID         null.String         `json:"buyer_id,omitempty"`
+	SalePrice       decimal.NullDecimal `json:"sale_price,omitempty"`
+	ExpiresAt       time.Time           `json:"expires_at"`
+	CompletedAt     null.Time           `json:"completed_at,omitempty"`
+	CreatedAt       time.Time           `json:"created_at"`
+}
+
+func marketplaceListing(listing *boiler.MarketplaceListing) *MarketplaceListing {
+	ml := &MarketplaceListing{
+		ID:          listing.ID,
+		ListingType: listing.ListingType,
+		Amount:      listing.Amount,
+		Price:       listing.Price,
+		CurrentBid:  listing.CurrentBid,
+		Fee:         asset.MarketplaceFee(listing.Price),
+		Status:      listing.Status,
+		BuyerID:     listing.BuyerID,
+		SalePrice:   listing.SalePrice,
+		ExpiresAt:   listing.ExpiresAt,
+		CompletedAt: listing.CompletedAt,
+		CreatedAt:   listing.CreatedAt,
+	}
+	if listing.ListingType == asset.ListingTypeAuction {
+		ml.MinBid = asset.MarketplaceMinBid(listing)
+	}
+	if listing.R == nil {
+		return ml
+	}
+	if listing.R.Seller != nil {
+		ml.Seller = &User{ID: listing.R.Seller.ID, Username: listing.R.Seller.Username}
+	}
+	if listing.R.UserAsset != nil {
+		ml.Hash = listing.R.UserAsset.Hash
+		ml.Name = listing.R.UserAsset.Name
+		ml.ImageURL = listing.R.UserAsset.ImageURL
+	}
+	if listing.R.UserAsset1155 != nil {
+		ml.UserAsset1155ID = listing.R.UserAsset1155.ID
+		ml.ExternalTokenID = listing.R.UserAsset1155.ExternalTokenID
+		ml.Name = listing.R.UserAsset1155.Label
+		ml.ImageURL = null.StringFrom(listing.R.UserAsset1155.ImageURL)
+	}
+	return ml
+}
+
+func marketplaceListingByID(listingID string) (*MarketplaceListing, error) {
+	listing, err := boiler.MarketplaceListings(
+		boiler.MarketplaceListingWhere.ID.EQ(listingID),
+		qm.Load(boiler.MarketplaceListingRels.Seller, qm.Select(boiler.UserColumns.ID, boiler.UserColumns.Username)),
+		qm.Load(boiler.MarketplaceListingRels.UserAsset),
+		qm.Load(boiler.MarketplaceListingRels.UserAsset1155),
+	).One(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+	return marketplaceListing(listing), nil
+}
+
+// publishListing sends the latest state of a listing to anyone watching it
+func publishListing(listingID string) {
+	ml, err := marketplaceListingByID(listingID)
+	if err != nil {
+		return
+	}
+	ws.PublishMessage(fmt.Sprintf("/public/marketplace/%s", listingID), HubKeyMarketplaceListingUpdate, ml)
+	ws.PublishMessage(fmt.Sprintf("/user/%s/marketplace", ml.Seller.ID), HubKeyMarketplaceListingUpdate, ml)
+}
+
+type ListingCreateRequest struct {
+	Payload struct {
+		ListingType string                  `json:"listing_type"`
+		Item        *asset.TradeItemRequest `json:"item"`
+		Price       decimal.Decimal         `json:"price"`
+		ExpiresAt   time.Time               `json:"expires_at"`
+	} `json:"payload"`
+}
+
+// ListingCreateHandler lists an asset at a fixed price or as an auction starting at the price
+func (mc *MarketplaceController) ListingCreateHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &ListingCreateRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+	if req.Payload.Item == nil {
+		return terror.Error(fmt.Errorf("missing item"), "Invalid request received.")
+	}
+
+	b := MarketplaceBucket.Add(user.ID, 1)
+	if b == 0 {
+		return terror.Warn(fmt.Errorf("too many requests"), "Too many requests.")
+	}
+
+	listing, err := asset.CreateListing(user.ID, req.Payload.ListingType, req.Payload.Item, req.Payload.Price, req.Payload.ExpiresAt)
+	if err != nil {
+		return terror.Error(err, fmt.Sprintf("Failed to create listing: %s.", err.Error()))
+	}
+
+	ml, err := marketplaceListingByID(listing.ID)
+	if err != nil {
+		return terror.Error(err, "Failed to get listing.")
+	}
+	reply(ml)
+	return nil
+}
+
+type ListingRequest struct {
+	Payload struct {
+		ListingID string `json:"listing_id"`
+	} `json:"payload"`
+}
+
+// ListingCancelHandler unlists an asset, auctions can't be canceled once they have a bid
+func (mc *MarketplaceController) ListingCancelHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &ListingRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	listing, err := boiler.FindMarketplaceListing(passdb.StdConn, req.Payload.ListingID)
+	if err != nil {
+		return terror.Error(err, "Failed to get listing.")
+	}
+	if listing.SellerID != user.ID {
+		return terror.Error(terror.ErrUnauthorised, "You don't own this listing.")
+	}
+
+	_, err = asset.CloseListing(listing.ID, asset.ListingStatusCanceled)
+	if errors.Is(err, asset.ErrListingNotActive) {
+		return terror.Warn(err, "This listing is no longer active.")
+	}
+	if err != nil {
+		return terror.Error(err, fmt.Sprintf("Failed to cancel listing: %s.", err.Error()))
+	}
+
+	publishListing(listing.ID)
+	reply(true)
+	return nil
+}
+
+// settleListing pays the seller and treasury from the payer, delivers the asset to the buyer and marks the listing sold.
+// The listing must be locked by tx. Every payment is refunded when a later step fails, except for auctions,
+// which are paid out of the held bid with one ledger reference per listing so the next settle picks up the same payments.
+func (mc *MarketplaceController) settleListing(tx boil.Executor, listing *boiler.MarketplaceListing, payerAccountID string, buyerID string, price decimal.Decimal) ([]*xsynTypes.NewTransaction, *boiler.AssetTransferEvent, error) {
+	auction := listing.ListingType == asset.ListingTypeAuction
+	reference := func(kind string) xsynTypes.TransactionReference {
+		if auction {
+			return xsynTypes.TransactionReference(fmt.Sprintf("%s|%s", kind, listing.ID))
+		}
+		return xsynTypes.TransactionReference(fmt.Sprintf("%s|%s|%d", kind, listing.ID, time.Now().UnixNano()))
+	}
+	transact := func(nt *xsynTypes.NewTransaction) (string, error) {
+		if auction {
+			return asset.TransactOnce(mc.API.userCacheMap, nt)
+		}
+		return mc.API.userCacheMap.Transact(nt)
+	}
+
+	seller, err := boiler.FindUser(passdb.StdConn, listing.SellerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	treasury, err := boiler.FindUser(passdb.StdConn, xsynTypes.XsynTreasuryUserID.String())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fee := asset.MarketplaceFee(price)
+	transactions := []*xsynTypes.NewTransaction{}
+	fail := func(err error) ([]*xsynTypes.NewTransaction, *boiler.AssetTransferEvent, error) {
+		if auction {
+			return nil, nil, err
+		}
+		for _, t := range transactions {
+			refundTransaction(mc.API.userCacheMap, t, "marketplace sale failed")
+		}
+		return nil, nil, err
+	}
+
+	sale := &xsynTypes.NewTransaction{
+		DebitAccountID:       payerAccountID,
+		CreditAccountID:      seller.AccountID,
+		TransactionReference: reference("marketplace_sale"),
+		Description:          fmt.Sprintf("Marketplace sale of listing %s", listing.ID),
+		Amount:               price.Sub(fee),
+		Group:                xsynTypes.TransactionGroupMarketplace,
+		SubGroup:             xsynTypes.TransactionSubGroupPurchase,
+	}
+	sale.ID, err = transact(sale)
+	if err != nil {
+		return fail(err)
+	}
+	transactions = append(transactions, sale)
+	listing.SaleTXID = null.StringFrom(sale.ID)
+
+	if fee.GreaterThan(decimal.Zero) {
+		feeTx := &xsynTypes.NewTransaction{
+			DebitAccountID:       payerAccountID,
+			CreditAccountID:      treasury.AccountID,
+			TransactionReference: reference("marketplace_fee"),
+			Description:          fmt.Sprintf("Marketplace fee of listing %s", listing.ID),
+			Amount:               fee,
+			Group:                xsynTypes.TransactionGroupMarketplace,
+			SubGroup:             xsynTypes.TransactionSubGroupFee,
+		}
+		feeTx.ID, err = transact(feeTx)
+		if err != nil {
+			return fail(err)
+		}
+		transactions = append(transactions, feeTx)
+		listing.FeeTXID = null.StringFrom(feeTx.ID)
+	}
+
+	transferEvent, err := asset.DeliverListingAsset(tx, listing, buyerID, listing.SaleTXID)
+	if err != nil {
+		return fail(err)
+	}
+
+	listing.Status = asset.ListingStatusSold
+	listing.BuyerID = null.StringFrom(buyerID)
+	listing.SalePrice = decimal.NewNullDecimal(price)
+	listing.Fee = decimal.NewNullDecimal(fee)
+	listing.CompletedAt = null.TimeFrom(time.Now())
+	_, err = listing.Update(tx, boil.Infer())
+	if err != nil {
+		return fail(err)
+	}
+
+	return transactions, transferEvent, nil
+}
+
+// BuyHandler buys a fixed price listing, the price is paid by the buyer and the fee is taken from it
+func (mc *MarketplaceController) BuyHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &ListingRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	b := MarketplaceBucket.Add(user.ID, 1)
+	if b == 0 {
+		return terror.Warn(fmt.Errorf("too many requests"), "Too many requests.")
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return terror.Error(err, "Failed to buy listing.")
+	}
+	defer tx.Rollback()
+
+	listing, err := asset.ActiveListingForUpdate(tx, req.Payload.ListingID)
+	if errors.Is(err, asset.ErrListingNotActive) {
+		return terror.Warn(err, "This listing is no longer available.")
+	}
+	if err != nil {
+		return terror.Error(err, "Failed to get listing.")
+	}
+	if listing.ListingType != asset.ListingTypeFixedPrice {
+		return terror.Error(fmt.Errorf("listing is an auction"), "This listing can only be bid on.")
+	}
+	if listing.ExpiresAt.Before(time.Now()) {
+		return terror.Warn(asset.ErrListingNotActive, "This listing is no longer available.")
+	}
+	if listing.SellerID == user.ID {
+		return terror.Error(fmt.Errorf("buyer is the seller"), "You can't buy your own listing.")
+	}
+
+	transactions, transferEvent, err := mc.settleListing(tx, listing, user.AccountID, user.ID, listing.Price)
+	if err != nil {
+		return terror.Error(err, "Purchase failed, please check your balance and try again.")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		for _, t := range transactions {
+			refundTransaction(mc.API.userCacheMap, t, "marketplace sale failed")
+		}
+		return terror.Error(err, "Failed to buy listing.")
+	}
+
+	if transferEvent != nil {
+		notifyAssetTransfer(transferEvent)
+	}
+	publishListing(listing.ID)
+
+	ml, err := marketplaceListingByID(listing.ID)
+	if err != nil {
+		return terror.Error(err, "Failed to get listing.")
+	}
+	reply(ml)
+	return nil
+}
+
+type BidRequest struct {
+	Payload struct {
+		ListingID string          `json:"listing_id"`
+		Amount    decimal.Decimal `json:"amount"`
+	} `json:"payload"`
+}
+
+// BidHandler places a bid on an auction.
+// The bid is held by the marketplace account until the auction ends, the previous bid is refunded once it's beaten.
+func (mc *MarketplaceController) BidHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &BidRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	b := MarketplaceBucket.Add(user.ID, 1)
+	if b == 0 {
+		return terror.Warn(fmt.Errorf("too many requests"), "Too many requests.")
+	}
+
+	marketplace, err := boiler.FindUser(passdb.StdConn, xsynTypes.XsynMarketplaceUserID.String())
+	if err != nil {
+		return terror.Error(err, "Failed to load marketplace account.")
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return terror.Error(err, "Failed to place bid.")
+	}
+	defer tx.Rollback()
+
+	listing, err := asset.ActiveListingForUpdate(tx, req.Payload.ListingID)
+	if errors.Is(err, asset.ErrListingNotActive) {
+		return terror.Warn(err, "This auction has ended.")
+	}
+	if err != nil {
+		return terror.Error(err, "Failed to get listing.")
+	}
+	if listing.ListingType != asset.ListingTypeAuction {
+		return terror.Error(fmt.Errorf("listing is not an auction"), "This listing can't be bid on.")
+	}
+	if listing.ExpiresAt.Before(time.Now()) {
+		return terror.Warn(asset.ErrListingNotActive, "This auction has ended.")
+	}
+	if listing.SellerID == user.ID {
+		return terror.Error(fmt.Errorf("bidder is the seller"), "You can't bid on your own listing.")
+	}
+	minBid := asset.MarketplaceMinBid(listing)
+	if req.Payload.Amount.LessThan(minBid) {
+		return terror.Warn(fmt.Errorf("bid below minimum"), fmt.Sprintf("Bid must be at least %s.", minBid.Shift(-18).String()))
+	}
+
+	previousBid := &boiler.MarketplaceBid{}
+	hasPreviousBid := listing.CurrentBidTXID.Valid
+	if hasPreviousBid {
+		previousBid, err = boiler.MarketplaceBids(
+			boiler.MarketplaceBidWhere.TXID.EQ(listing.CurrentBidTXID.String),
+		).One(tx)
+		if err != nil {
+			return terror.Error(err, "Failed to get current bid.")
+		}
+	}
+
+	bidTx := &xsynTypes.NewTransaction{
+		DebitAccountID:       user.AccountID,
+		CreditAccountID:      marketplace.AccountID,
+		TransactionReference: xsynTypes.TransactionReference(fmt.Sprintf("marketplace_bid|%s|%d", listing.ID, time.Now().UnixNano())),
+		Description:          fmt.Sprintf("Bid on marketplace listing %s", listing.ID),
+		Amount:               req.Payload.Amount,
+		Group:                xsynTypes.TransactionGroupMarketplace,
+		SubGroup:             xsynTypes.TransactionSubGroupBid,
+	}
+	bidTx.ID, err = mc.API.userCacheMap.Transact(bidTx)
+	if err != nil {
+		return terror.Error(err, "Bid failed, please check your balance and try again.")
+	}
+
+	bid := &boiler.MarketplaceBid{
+		ListingID: listing.ID,
+		BidderID:  user.ID,
+		Amount:    req.Payload.Amount,
+		TXID:      bidTx.ID,
+	}
+	err = bid.Insert(tx, boil.Infer())
+	if err != nil {
+		refundTransaction(mc.API.userCacheMap, bidTx, "failed to place bid")
+		return terror.Error(err, "Failed to place bid.")
+	}
+
+	if hasPreviousBid {
+		err = asset.MarkBidRefundDue(tx, previousBid, "outbid")
+		if err != nil {
+			refundTransaction(mc.API.userCacheMap, bidTx, "failed to place bid")
+			return terror.Error(err, "Failed to place bid.")
+		}
+	}
+
+	listing.CurrentBid = decimal.NewNullDecimal(req.Payload.Amount)
+	listing.CurrentBidderID = null.StringFrom(user.ID)
+	listing.CurrentBidTXID = null.StringFrom(bidTx.ID)
+	_, err = listing.Update(tx, boil.Infer())
+	if err != nil {
+		refundTransaction(mc.API.userCacheMap, bidTx, "failed to place bid")
+		return terror.Error(err, "Failed to place bid.")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		refundTransaction(mc.API.userCacheMap, bidTx, "failed to place bid")
+		return terror.Error(err, "Failed to place bid.")
+	}
+
+	if hasPreviousBid {
+		mc.refundBid(previousBid.ID)
+	}
+
+	publishListing(listing.ID)
+	reply(true)
+	return nil
+}
+
+// refundBid returns a bid marked refund due to the bidder, CloseExpiredListings retries it when it fails
+func (mc *MarketplaceController) refundBid(bidID string) {
+	_, err := asset.RefundBid(mc.API.userCacheMap, bidID)
+	if err != nil {
+		mc.Log.Error().Err(err).Str("bid_id", bidID).Msg("failed to refund bid")
+	}
+}
+
+// settleAuction sells an ended auction to the highest bidder, paying the seller and the fee out of the held bid
+func (mc *MarketplaceController) settleAuction(listingID string, marketplaceAccountID string) error {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	listing, err := asset.ActiveListingForUpdate(tx, listingID)
+	if err != nil {
+		return err
+	}
+
+	// the payments are kept when this fails, the next run finds them by reference
+	_, transferEvent, err := mc.settleListing(tx, listing, marketplaceAccountID, listing.CurrentBidderID.String, listing.CurrentBid.Decimal)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	if transferEvent != nil {
+		notifyAssetTransfer(transferEvent)
+	}
+	return nil
+}
+
+// CloseExpiredListings returns unsold assets to their sellers, settles ended auctions and makes any bid refunds still due every minute
+func (mc *MarketplaceController) CloseExpiredListings() {
+	ticker := time.NewTicker(time.Minute)
+	for range ticker.C {
+		bids, err := asset.BidsRefundDue()
+		if err != nil {
+			mc.Log.Error().Err(err).Msg("failed to get bids due a refund")
+		}
+		for _, bid := range bids {
+			mc.refundBid(bid.ID)
+		}
+
+		listings, err := boiler.MarketplaceListings(
+			boiler.MarketplaceListingWhere.Status.EQ(asset.ListingStatusActive),
+			boiler.MarketplaceListingWhere.ExpiresAt.LT(time.Now()),
+		).All(passdb.StdConn)
+		if err != nil {
+			mc.Log.Error().Err(err).Msg("failed to get expired listings")
+			continue
+		}
+
+		marketplace, err := boiler.FindUser(passdb.StdConn, xsynTypes.XsynMarketplaceUserID.String())
+		if err != nil {
+			mc.Log.Error().Err(err).Msg("failed to load marketplace account")
+			continue
+		}
+
+		for _, listing := range listings {
+			if listing.CurrentBidderID.Valid {
+				err = mc.settleAuction(listing.ID, marketplace.AccountID)
+			} else {
+				_, err = asset.CloseListing(listing.ID, asset.ListingStatusExpired)
+			}
+			if err != nil && !errors.Is(err, asset.ErrListingNotActive) {
+				mc.Log.Error().Err(err).Str("listing_id", listing.ID).Msg("failed to close expired listing")
+				continue
+			}
+			publishListing(listing.ID)
+		}
+	}
+}
+
+type MarketplaceListingsResponse struct {
+	Total    int64                 `json:"total"`
+	Listings []*MarketplaceListing `json:"listings"`
+}
+
+func marketplaceListings(search *asset.ListingSearch) (*MarketplaceListingsResponse, error) {
+	listings, total, err := asset.SearchListings(search)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &MarketplaceListingsResponse{
+		Total:    total,
+		Listings: []*MarketplaceListing{},
+	}
+	for _, listing := range listings {
+		resp.Listings = append(resp.Listings, marketplaceListing(listing))
+	}
+	return resp, nil
+}
+
+// ListingsWSHandler searches the active listings
+func (mc *MarketplaceController) ListingsWSHandler(ctx context.Context, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &struct {
+		Payload asset.ListingSearch `json:"payload"`
+	}{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	resp, err := marketplaceListings(&req.Payload)
+	if err != nil {
+		return terror.Error(err, "Failed to search listings.")
+	}
+	reply(resp)
+	return nil
+}
+
+// ListingSubscribeHandler sends the listing on join, changes are pushed by publishListing
+func (mc *MarketplaceController) ListingSubscribeHandler(ctx context.Context, key string, payload []byte, reply ws.ReplyFunc) error {
+	cctx := chi.RouteContext(ctx)
+	listingID := cctx.URLParam("listing_id")
+
+	ml, err := marketplaceListingByID(listingID)
+	if err != nil {
+		return terror.Error(err, "Failed to get listing.")
+	}
+	reply(ml)
+	return nil
+}
+
+// SellerListingsSubscribeHandler sends the user's active listings on join, changes are pushed by publishListing
+func (mc *MarketplaceController) SellerListingsSubscribeHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	resp, err := marketplaceListings(&asset.ListingSearch{
+		SellerID: user.ID,
+		PageSize: 100,
+	})
+	if err != nil {
+		return terror.Error(err, "Failed to get listings.")
+	}
+	reply(resp.Listings)
+	return nil
+}
+
+// MarketplaceListingsHandler searches the active listings, prices are in wei
+func MarketplaceListingsHandler(w http.ResponseWriter, r *http.Request) (int, error) {
+	query := r.URL.Query()
+	search := &asset.ListingSearch{
+		ListingType:    query.Get("listing_type"),
+		CollectionSlug: query.Get("collection_slug"),
+		SellerID:       query.Get("seller_id"),
+		Search:         query.Get("search"),
+		SortBy:         query.Get("sort_by"),
+		SortDir:        query.Get("sort_dir"),
+	}
+
+	var err error
+	if minPrice := query.Get("min_price"); minPrice != "" {
+		search.MinPrice, err = decimal.NewFromString(minPrice)
+		if err != nil {
+			return http.StatusBadRequest, terror.Error(err, "Invalid min price.")
+		}
+	}
+	if maxPrice := query.Get("max_price"); maxPrice != "" {
+		search.MaxPrice, err = decimal.NewFromString(maxPrice)
+		if err != nil {
+			return http.StatusBadRequest, terror.Error(err, "Invalid max price.")
+		}
+	}
+	if pageSize := query.Get("page_size"); pageSize != "" {
+		search.PageSize, err = strconv.Atoi(pageSize)
+		if err != nil {
+			return http.StatusBadRequest, terror.Error(err, "Invalid page size.")
+		}
+	}
+	if page := query.Get("page"); page != "" {
+		search.Page, err = strconv.Atoi(page)
+		if err != nil {
+			return http.StatusBadRequest, terror.Error(err, "Invalid page.")
+		}
+	}
+
+	resp, err := marketplaceListings(search)
+	if err != nil {
+		return http.StatusBadRequest, terror.Error(err, "Failed to search listings.")
+	}
+	return helpers.EncodeJSON(w, resp)
+}
+
+// MarketplaceListingHandler returns a single listing
+func MarketplaceListingHandler(w http.ResponseWriter, r *http.Request) (int, error) {
+	ml, err := marketplaceListingByID(chi.URLParam(r, "listing_id"))
+	if err != nil {
+		return http.StatusBadRequest, terror.Warn(err, "Failed to get listing.")
+	}
+	return helpers.EncodeJSON(w, ml)
+}
@@ -0,0 +1,524 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+	"xsyn-services/passport/supremacy_rpcclient"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/friendsofgo/errors"
+	"github.com/kevinms/leakybucket-go"
+	"github.com/ninja-software/log_helpers"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// RentalController holds handlers for renting assets out to users and syndicates
+type RentalController struct {
+	Log *zerolog.Logger
+	API *API
+}
+
+// NewRentalController creates the rental hub and starts ending rentals that have run out
+func NewRentalController(log *zerolog.Logger, api *API) *RentalController {
+	rentalHub := &RentalController{
+		Log: log_helpers.NamedLogger(log, "rental_hub"),
+		API: api,
+	}
+
+	api.SecureCommand(HubKeyRentalCreate, rentalHub.RentalCreateHandler)
+	api.SecureCommand(HubKeyRentalAccept, rentalHub.RentalAcceptHandler)
+	api.SecureCommand(HubKeyRentalCancel, rentalHub.RentalCancelHandler)
+	api.SecureCommand(HubKeyRentalEnd, rentalHub.RentalEndHandler)
+	api.SecureCommand(HubKeyRentalList, rentalHub.RentalListHandler)
+
+	go rentalHub.EndRentals()
+
+	return rentalHub
+}
+
+const (
+	HubKeyRentalCreate    = "ASSET:RENTAL:CREATE"
+	HubKeyRentalAccept    = "ASSET:RENTAL:ACCEPT"
+	HubKeyRentalCancel    = "ASSET:RENTAL:CANCEL"
+	HubKeyRentalEnd       = "ASSET:RENTAL:END"
+	HubKeyRentalList      = "ASSET:RENTAL:LIST"
+	HubKeyRentalSubscribe = "ASSET:RENTAL:SUBSCRIBE"
+)
+
+var RentalBucket = leakybucket.NewCollector(1, 2, true)
+
+type RentalSyndicate struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type RentalResponse struct {
+	ID              string           `json:"id"`
+	Hash            string           `json:"hash"`
+	Name            string           `json:"name"`
+	ImageURL        null.String      `json:"image_url,omitempty"`
+	Owner           *User            `json:"owner"`
+	RenterUser      *User            `json:"renter_user,omitempty"`
+	RenterSyndicate *RentalSyndicate `json:"renter_syndicate,omitempty"`
+	Fee             decimal.Decimal  `json:"fee"`
+	DurationHours   int              `json:"duration_hours"`
+	Status          string           `json:"status"`
+	StartsAt        null.Time        `json:"starts_at,omitempty"`
+	EndsAt          null.Time        `json:"ends_at,omitempty"`
+	EndedAt         null.Time        `json:"ended_at,omitempty"`
+	CreatedAt       time.Time        `json:"created_at"`
+}
+
+func rentalResponse(rentalID string) (*RentalResponse, error) {
+	rental, err := boiler.AssetRentals(
+		boiler.AssetRentalWhere.ID.EQ(rentalID),
+		qm.Load(boiler.AssetRentalRels.UserAsset),
+		qm.Load(boiler.AssetRentalRels.Owner, qm.Select(boiler.UserColumns.ID, boiler.UserColumns.Username)),
+		qm.Load(boiler.AssetRentalRels.RenterUser, qm.Select(boiler.UserColumns.ID, boiler.UserColumns.Username)),
+		qm.Load(boiler.AssetRentalRels.RenterSyndicate),
+	).One(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &RentalResponse{
+		ID:            rental.ID,
+		Hash:          rental.R.UserAsset.Hash,
+		Name:          rental.R.UserAsset.Name,
+		ImageURL:      rental.R.UserAsset.ImageURL,
+		Owner:         &User{ID: rental.R.Owner.ID, Username: rental.R.Owner.Username},
+		Fee:           rental.Fee,
+		DurationHours: rental.DurationHours,
+		Status:        rental.Status,
+		StartsAt:      rental.StartsAt,
+		EndsAt:        rental.EndsAt,
+		EndedAt:       rental.EndedAt,
+		CreatedAt:     rental.CreatedAt,
+	}
+	if rental.R.RenterUser != nil {
+		resp.RenterUser = &User{ID: rental.R.RenterUser.ID, Username: rental.R.RenterUser.Username}
+	}
+	if rental.R.RenterSyndicate != nil {
+		resp.RenterSyndicate = &RentalSyndicate{ID: rental.R.RenterSyndicate.ID, Name: rental.R.RenterSyndicate.Name}
+	}
+	return resp, nil
+}
+
+// publishRental sends the latest state of the rental to the owner and the renting user.
+// Syndicate renters are kept up to date by supremacy.
+func publishRental(rentalID string) {
+	resp, err := rentalResponse(rentalID)
+	if err != nil {
+		passlog.L.Error().Err(err).Str("rental_id", rentalID).Msg("failed to load rental for notification")
+		return
+	}
+	ws.PublishMessage(fmt.Sprintf("/user/%s/rentals", resp.Owner.ID), HubKeyRentalSubscribe, resp)
+	if resp.RenterUser != nil {
+		ws.PublishMessage(fmt.Sprintf("/user/%s/rentals", resp.RenterUser.ID), HubKeyRentalSubscribe, resp)
+	}
+}
+
+// StartRental accepts a rental offer for the renting user or syndicate.
+// The fee is paid while the rental row is locked and supremacy is told before commit,
+// if either fails the fee is refunded and the offer stays open.
+// acceptedByID is the user accepting, a syndicate's fee can only be paid by a member allowed to spend its funds.
+func StartRental(ucm *Transactor, rentalID string, renterID string, acceptedByID string) (*boiler.AssetRental, error) {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rental, err := asset.ClaimRental(tx, rentalID, renterID)
+	if err != nil {
+		return nil, err
+	}
+	if rental.RenterSyndicateID.Valid && rental.Fee.GreaterThan(decimal.Zero) {
+		err = asset.CheckSyndicatePermission(tx, rental.RenterSyndicateID.String, acceptedByID, asset.SyndicatePermissionSpendFunds)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rental.AcceptedByID = null.StringFrom(acceptedByID)
+
+	var feeTransaction *xsynTypes.NewTransaction
+	if rental.Fee.GreaterThan(decimal.Zero) {
+		payerAccountID := ""
+		if rental.RenterUserID.Valid {
+			renter, err := boiler.FindUser(tx, rental.RenterUserID.String)
+			if err != nil {
+				return nil, err
+			}
+			payerAccountID = renter.AccountID
+		} else {
+			syndicate, err := boiler.FindSyndicate(tx, rental.RenterSyndicateID.String)
+			if err != nil {
+				return nil, err
+			}
+			payerAccountID = syndicate.AccountID
+		}
+		owner, err := boiler.FindUser(tx, rental.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+
+		feeTransaction = &xsynTypes.NewTransaction{
+			DebitAccountID:       payerAccountID,
+			CreditAccountID:      owner.AccountID,
+			TransactionReference: xsynTypes.TransactionReference(fmt.Sprintf("asset_rental|%s|%d", rental.ID, time.Now().UnixNano())),
+			Description:          fmt.Sprintf("Rental of asset %s for %d hours, accepted by %s", rental.R.UserAsset.Hash, rental.DurationHours, acceptedByID),
+			Amount:               rental.Fee,
+			Group:                xsynTypes.TransactionGroupAssetManagement,
+			SubGroup:             xsynTypes.TransactionSubGroupRental,
+		}
+		feeTransaction.ID, err = ucm.Transact(feeTransaction)
+		if err != nil {
+			return nil, fmt.Errorf("rental fee payment failed: %w", err)
+		}
+		rental.FeeTXID = null.StringFrom(feeTransaction.ID)
+	}
+	refund := func(reason string) {
+		if feeTransaction != nil {
+			refundTransaction(ucm, feeTransaction, reason)
+		}
+	}
+
+	_, err = rental.Update(tx, boil.Whitelist(boiler.AssetRentalColumns.FeeTXID, boiler.AssetRentalColumns.AcceptedByID))
+	if err != nil {
+		refund("failed to start rental")
+		return nil, err
+	}
+
+	err = supremacy_rpcclient.AssetRentalStart(&supremacy_rpcclient.AssetRentalStartReq{
+		RentalID:          rental.ID,
+		Hash:              rental.R.UserAsset.Hash,
+		OwnerID:           rental.OwnerID,
+		RenterUserID:      rental.RenterUserID,
+		RenterSyndicateID: rental.RenterSyndicateID,
+		EndsAt:            rental.EndsAt.Time,
+	})
+	if err != nil {
+		refund("supremacy did not accept the rental")
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		refund("failed to start rental")
+		if rerr := supremacy_rpcclient.AssetRentalEnd(rental.ID, rental.R.UserAsset.Hash, rental.OwnerID); rerr != nil {
+			passlog.L.Error().Err(rerr).Str("rental_id", rental.ID).Msg("failed to revert rental on supremacy")
+		}
+		return nil, err
+	}
+
+	publishRental(rental.ID)
+	return rental, nil
+}
+
+// EndRental hands usage rights back to the owner, supremacy is told first so the rental stays active if it can't be reached
+func EndRental(rental *boiler.AssetRental, hash string) (*boiler.AssetRental, error) {
+	err := supremacy_rpcclient.AssetRentalEnd(rental.ID, hash, rental.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	ended, err := asset.SetRentalStatus(rental.ID, []string{asset.RentalStatusActive}, asset.RentalStatusEnded)
+	if err != nil {
+		return nil, err
+	}
+
+	publishRental(rental.ID)
+	return ended, nil
+}
+
+type RentalCreateRequest struct {
+	Payload struct {
+		Hash              string          `json:"hash"`
+		RenterUserID      null.String     `json:"renter_user_id"`
+		RenterSyndicateID null.String     `json:"renter_syndicate_id"`
+		Fee               decimal.Decimal `json:"fee"`
+		DurationHours     int             `json:"duration_hours"`
+	} `json:"payload"`
+}
+
+// RentalCreateHandler offers the usage rights of an asset to a user or syndicate.
+// Free rentals start straight away, paid ones wait for the renter to accept.
+func (rc *RentalController) RentalCreateHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &RentalCreateRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	b := RentalBucket.Add(user.ID, 1)
+	if b == 0 {
+		return terror.Warn(fmt.Errorf("too many requests"), "Too many requests.")
+	}
+
+	rental, err := asset.CreateRental(
+		user.ID,
+		req.Payload.Hash,
+		req.Payload.RenterUserID,
+		req.Payload.RenterSyndicateID,
+		req.Payload.Fee,
+		req.Payload.DurationHours,
+	)
+	if err != nil {
+		return terror.Error(err, fmt.Sprintf("Failed to create rental: %s.", err.Error()))
+	}
+
+	if rental.Fee.IsZero() {
+		renterID := rental.RenterUserID.String
+		if rental.RenterSyndicateID.Valid {
+			renterID = rental.RenterSyndicateID.String
+		}
+		_, err = StartRental(rc.API.userCacheMap, rental.ID, renterID, user.ID)
+		if err != nil {
+			_, cerr := asset.SetRentalStatus(rental.ID, []string{asset.RentalStatusPending}, asset.RentalStatusCanceled)
+			if cerr != nil {
+				rc.Log.Error().Err(cerr).Str("rental_id", rental.ID).Msg("failed to cancel rental")
+			}
+			return terror.Error(err, "Failed to start rental, try again or contact support.")
+		}
+	} else {
+		publishRental(rental.ID)
+	}
+
+	resp, err := rentalResponse(rental.ID)
+	if err != nil {
+		return terror.Error(err, "Failed to get rental.")
+	}
+	reply(resp)
+	return nil
+}
+
+type RentalRequest struct {
+	Payload struct {
+		RentalID string `json:"rental_id"`
+	} `json:"payload"`
+}
+
+// RentalAcceptHandler pays the fee of a rental offered to the user and starts it
+func (rc *RentalController) RentalAcceptHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &RentalRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	b := RentalBucket.Add(user.ID, 1)
+	if b == 0 {
+		return terror.Warn(fmt.Errorf("too many requests"), "Too many requests.")
+	}
+
+	rental, err := StartRental(rc.API.userCacheMap, req.Payload.RentalID, user.ID, user.ID)
+	if errors.Is(err, asset.ErrRentalNotPending) {
+		return terror.Warn(err, "This rental offer is no longer available.")
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return terror.Error(err, "Rental offer not found.")
+	}
+	if err != nil {
+		return terror.Error(err, fmt.Sprintf("Failed to accept rental: %s.", err.Error()))
+	}
+
+	resp, err := rentalResponse(rental.ID)
+	if err != nil {
+		return terror.Error(err, "Failed to get rental.")
+	}
+	reply(resp)
+	return nil
+}
+
+// RentalCancelHandler lets the owner withdraw, or the renting user decline, a pending offer
+func (rc *RentalController) RentalCancelHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &RentalRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	rental, err := boiler.FindAssetRental(passdb.StdConn, req.Payload.RentalID)
+	if err != nil {
+		return terror.Error(err, "Failed to get rental.")
+	}
+	if rental.OwnerID != user.ID && rental.RenterUserID.String != user.ID {
+		return terror.Error(terror.ErrUnauthorised, "You are not part of this rental.")
+	}
+
+	_, err = asset.SetRentalStatus(rental.ID, []string{asset.RentalStatusPending}, asset.RentalStatusCanceled)
+	if errors.Is(err, asset.ErrRentalNotPending) {
+		return terror.Warn(err, "This rental offer is no longer available.")
+	}
+	if err != nil {
+		return terror.Error(err, "Failed to cancel rental.")
+	}
+
+	publishRental(rental.ID)
+	reply(true)
+	return nil
+}
+
+// RentalEndHandler ends an active rental early.
+// The renter can give the asset back at any time, the owner can only take back rentals they didn't charge for.
+func (rc *RentalController) RentalEndHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &RentalRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	rental, err := boiler.AssetRentals(
+		boiler.AssetRentalWhere.ID.EQ(req.Payload.RentalID),
+		qm.Load(boiler.AssetRentalRels.UserAsset),
+	).One(passdb.StdConn)
+	if err != nil {
+		return terror.Error(err, "Failed to get rental.")
+	}
+	if rental.RenterUserID.String != user.ID && (rental.OwnerID != user.ID || !rental.Fee.IsZero()) {
+		return terror.Error(terror.ErrUnauthorised, "You can't end this rental.")
+	}
+	if rental.Status != asset.RentalStatusActive {
+		return terror.Warn(asset.ErrRentalNotActive, "This rental is not active.")
+	}
+
+	_, err = EndRental(rental, rental.R.UserAsset.Hash)
+	if errors.Is(err, asset.ErrRentalNotActive) {
+		return terror.Warn(err, "This rental is not active.")
+	}
+	if err != nil {
+		return terror.Error(err, "Failed to end rental, try again or contact support.")
+	}
+
+	reply(true)
+	return nil
+}
+
+type RentalListRequest struct {
+	Payload struct {
+		Status   string `json:"status"`
+		PageSize int    `json:"page_size"`
+		Page     int    `json:"page"`
+	} `json:"payload"`
+}
+
+type RentalListResponse struct {
+	Total   int64             `json:"total"`
+	Rentals []*RentalResponse `json:"rentals"`
+}
+
+// RentalListHandler lists the rentals the user has given or received
+func (rc *RentalController) RentalListHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &RentalListRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+	if req.Payload.PageSize <= 0 || req.Payload.PageSize > 50 {
+		req.Payload.PageSize = 10
+	}
+
+	queries := []qm.QueryMod{
+		qm.Expr(
+			boiler.AssetRentalWhere.OwnerID.EQ(user.ID),
+			qm.Or2(boiler.AssetRentalWhere.RenterUserID.EQ(null.StringFrom(user.ID))),
+		),
+	}
+	if req.Payload.Status != "" {
+		queries = append(queries, boiler.AssetRentalWhere.Status.EQ(req.Payload.Status))
+	}
+
+	total, err := boiler.AssetRentals(queries...).Count(passdb.StdConn)
+	if err != nil {
+		return terror.Error(err, "Failed to list rentals.")
+	}
+
+	rentals, err := boiler.AssetRentals(append(queries,
+		qm.OrderBy(fmt.Sprintf("%s DESC", boiler.AssetRentalColumns.CreatedAt)),
+		qm.Limit(req.Payload.PageSize),
+		qm.Offset(req.Payload.Page*req.Payload.PageSize),
+	)...).All(passdb.StdConn)
+	if err != nil {
+		return terror.Error(err, "Failed to list rentals.")
+	}
+
+	resp := &RentalListResponse{
+		Total:   total,
+		Rentals: []*RentalResponse{},
+	}
+	for _, rental := range rentals {
+		rr, err := rentalResponse(rental.ID)
+		if err != nil {
+			return terror.Error(err, "Failed to list rentals.")
+		}
+		resp.Rentals = append(resp.Rentals, rr)
+	}
+
+	reply(resp)
+	return nil
+}
+
+// RentalSubscribeHandler sends the user's open rentals on join, changes are pushed by publishRental
+func (rc *RentalController) RentalSubscribeHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	rentals, err := boiler.AssetRentals(
+		boiler.AssetRentalWhere.Status.IN([]string{asset.RentalStatusPending, asset.RentalStatusActive}),
+		qm.Expr(
+			boiler.AssetRentalWhere.OwnerID.EQ(user.ID),
+			qm.Or2(boiler.AssetRentalWhere.RenterUserID.EQ(null.StringFrom(user.ID))),
+		),
+	).All(passdb.StdConn)
+	if err != nil {
+		return terror.Error(err, "Failed to get rentals.")
+	}
+
+	resp := []*RentalResponse{}
+	for _, rental := range rentals {
+		rr, err := rentalResponse(rental.ID)
+		if err != nil {
+			return terror.Error(err, "Failed to get rentals.")
+		}
+		resp = append(resp, rr)
+	}
+	reply(resp)
+	return nil
+}
+
+// EndRentals reverts rentals past their end time and cancels stale offers every minute.
+// Rentals supremacy couldn't be told about stay active and are retried on the next tick.
+func (rc *RentalController) EndRentals() {
+	ticker := time.NewTicker(time.Minute)
+	for range ticker.C {
+		expired, err := asset.ExpireRentalOffers()
+		if err != nil {
+			rc.Log.Error().Err(err).Msg("failed to expire rental offers")
+		}
+		for _, rental := range expired {
+			publishRental(rental.ID)
+		}
+
+		ended, err := asset.EndedRentals()
+		if err != nil {
+			rc.Log.Error().Err(err).Msg("failed to get ended rentals")
+			continue
+		}
+		for _, rental := range ended {
+			_, err = EndRental(rental, rental.R.UserAsset.Hash)
+			if err != nil && !errors.Is(err, asset.ErrRentalNotActive) {
+				rc.Log.Error().Err(err).Str("rental_id", rental.ID).Msg("failed to end rental")
+			}
+		}
+	}
+}
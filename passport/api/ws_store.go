@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"xsyn-services/boiler"
 	"xsyn-services/passport/db"
+	"xsyn-services/passport/payments"
 	"xsyn-services/types"
 
 	"github.com/gofrs/uuid"
@@ -70,6 +71,9 @@ func (sc *StoreControllerWS) PurchaseItemHandler(ctx context.Context, user *type
 
 	// reply(true)
 	// return nil
+	if paused, reason := payments.PurchasesPaused(); paused {
+		return terror.Warn(fmt.Errorf("store paused: %s", reason), "The XSYN Store is paused while prices are being verified.")
+	}
 	return terror.Warn(fmt.Errorf("store closed"), "The XSYN Store is currently closed.")
 }
 
@@ -82,6 +86,9 @@ type PurchaseLootboxRequest struct {
 const HubKeyLootbox = "STORE:LOOTBOX"
 
 func (sc *StoreControllerWS) PurchaseLootboxHandler(ctx context.Context, user *types.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	if paused, reason := payments.PurchasesPaused(); paused {
+		return terror.Warn(fmt.Errorf("store paused: %s", reason), "The XSYN Store is paused while prices are being verified.")
+	}
 	return terror.Warn(fmt.Errorf("store closed"), "The XSYN Store is currently closed.")
 
 	//req := &PurchaseLootboxRequest{}
@@ -0,0 +1,502 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+	"xsyn-services/passport/supremacy_rpcclient"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/friendsofgo/errors"
+	"github.com/kevinms/leakybucket-go"
+	"github.com/ninja-software/log_helpers"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ninja-syndicate/ws"
+	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// TradeController holds handlers for peer to peer trades
+type TradeController struct {
+	Log *zerolog.Logger
+	API *API
+}
+
+// NewTradeController creates the trade hub and starts expiring old offers
+func NewTradeController(log *zerolog.Logger, api *API) *TradeController {
+	tradeHub := &TradeController{
+		Log: log_helpers.NamedLogger(log, "trade_hub"),
+		API: api,
+	}
+
+	api.SecureCommand(HubKeyTradeCreate, tradeHub.TradeCreateHandler)
+	api.SecureCommand(HubKeyTradeAccept, tradeHub.TradeAcceptHandler)
+	api.SecureCommand(HubKeyTradeReject, tradeHub.TradeRejectHandler)
+	api.SecureCommand(HubKeyTradeCancel, tradeHub.TradeCancelHandler)
+	api.SecureCommand(HubKeyTradeList, tradeHub.TradeListHandler)
+
+	go tradeHub.ExpireTrades()
+
+	return tradeHub
+}
+
+const (
+	HubKeyTradeCreate    = "TRADE:CREATE"
+	HubKeyTradeAccept    = "TRADE:ACCEPT"
+	HubKeyTradeReject    = "TRADE:REJECT"
+	HubKeyTradeCancel    = "TRADE:CANCEL"
+	HubKeyTradeList      = "TRADE:LIST"
+	HubKeyTradeSubscribe = "TRADE:SUBSCRIBE"
+)
+
+var TradeBucket = leakybucket.NewCollector(1, 2, true)
+
+type TradeItem struct {
+	Side            string      `json:"side"`
+	Hash            string      `json:"hash,omitempty"`
+	UserAsset1155ID string      `json:"user_asset_1155_id,omitempty"`
+	ExternalTokenID int         `json:"external_token_id,omitempty"`
+	Name            string      `json:"name"`
+	ImageURL        null.String `json:"image_url,omitempty"`
+	Amount          int         `json:"amount"`
+}
+
+type TradeResponse struct {
+	ID           string          `json:"id"`
+	Offerer      *User           `json:"offerer"`
+	Recipient    *User           `json:"recipient"`
+	OfferSups    decimal.Decimal `json:"offer_sups"`
+	RequestSups  decimal.Decimal `json:"request_sups"`
+	Status       string          `json:"status"`
+	FailedReason null.String     `json:"failed_reason,omitempty"`
+	Items        []*TradeItem    `json:"items"`
+	ExpiresAt    time.Time       `json:"expires_at"`
+	CompletedAt  null.Time       `json:"completed_at,omitempty"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+func tradeResponse(tradeID string) (*TradeResponse, error) {
+	trade, err := boiler.AssetTrades(
+		boiler.AssetTradeWhere.ID.EQ(tradeID),
+		qm.Load(boiler.AssetTradeRels.Offerer, qm.Select(boiler.UserColumns.ID, boiler.UserColumns.Username)),
+		qm.Load(boiler.AssetTradeRels.Recipient, qm.Select(boiler.UserColumns.ID, boiler.UserColumns.Username)),
+		qm.Load(qm.Rels(boiler.AssetTradeRels.TradeAssetTradeItems, boiler.AssetTradeItemRels.UserAsset)),
+		qm.Load(qm.Rels(boiler.AssetTradeRels.TradeAssetTradeItems, boiler.AssetTradeItemRels.UserAsset1155)),
+	).One(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &TradeResponse{
+		ID:           trade.ID,
+		Offerer:      &User{ID: trade.R.Offerer.ID, Username: trade.R.Offerer.Username},
+		Recipient:    &User{ID: trade.R.Recipient.ID, Username: trade.R.Recipient.Username},
+		OfferSups:    trade.OfferSups,
+		RequestSups:  trade.RequestSups,
+		Status:       trade.Status,
+		FailedReason: trade.FailedReason,
+		Items:        []*TradeItem{},
+		ExpiresAt:    trade.ExpiresAt,
+		CompletedAt:  trade.CompletedAt,
+		CreatedAt:    trade.CreatedAt,
+	}
+	for _, item := range trade.R.TradeAssetTradeItems {
+		ti := &TradeItem{
+			Side:   item.Side,
+			Amount: item.Amount,
+		}
+		if item.R.UserAsset != nil {
+			ti.Hash = item.R.UserAsset.Hash
+			ti.Name = item.R.UserAsset.Name
+			ti.ImageURL = item.R.UserAsset.ImageURL
+		}
+		if item.R.UserAsset1155 != nil {
+			ti.UserAsset1155ID = item.R.UserAsset1155.ID
+			ti.ExternalTokenID = item.R.UserAsset1155.ExternalTokenID
+			ti.Name = item.R.UserAsset1155.Label
+			ti.ImageURL = null.StringFrom(item.R.UserAsset1155.ImageURL)
+		}
+		resp.Items = append(resp.Items, ti)
+	}
+	return resp, nil
+}
+
+// publishTrade sends the latest state of the trade to both sides
+func publishTrade(tradeID string) {
+	resp, err := tradeResponse(tradeID)
+	if err != nil {
+		passlog.L.Error().Err(err).Str("trade_id", tradeID).Msg("failed to load trade for notification")
+		return
+	}
+	ws.PublishMessage(fmt.Sprintf("/user/%s/trades", resp.Offerer.ID), HubKeyTradeSubscribe, resp)
+	ws.PublishMessage(fmt.Sprintf("/user/%s/trades", resp.Recipient.ID), HubKeyTradeSubscribe, resp)
+}
+
+type TradeCreateRequest struct {
+	Payload struct {
+		RecipientID string                    `json:"recipient_id"`
+		OfferSups   decimal.Decimal           `json:"offer_sups"`
+		RequestSups decimal.Decimal           `json:"request_sups"`
+		Offer       []*asset.TradeItemRequest `json:"offer"`
+		Request     []*asset.TradeItemRequest `json:"request"`
+		ExpiresAt   time.Time                 `json:"expires_at"`
+	} `json:"payload"`
+}
+
+// TradeCreateHandler offers assets and sups to another user in exchange for theirs
+func (tc *TradeController) TradeCreateHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &TradeCreateRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	b := TradeBucket.Add(user.ID, 1)
+	if b == 0 {
+		return terror.Warn(fmt.Errorf("too many requests"), "Too many requests.")
+	}
+
+	if req.Payload.OfferSups.GreaterThan(decimal.Zero) {
+		sups, _, err := tc.API.userCacheMap.Get(user.AccountID)
+		if err != nil {
+			return terror.Error(err, "Failed to get SUPS balance.")
+		}
+		if sups.LessThan(req.Payload.OfferSups) {
+			return terror.Warn(fmt.Errorf("insufficient sups"), "You don't have enough SUPS for this offer.")
+		}
+	}
+
+	trade, err := asset.CreateTrade(
+		tc.API.userCacheMap,
+		user.ID,
+		req.Payload.RecipientID,
+		req.Payload.OfferSups,
+		req.Payload.RequestSups,
+		req.Payload.Offer,
+		req.Payload.Request,
+		req.Payload.ExpiresAt,
+	)
+	if err != nil {
+		return terror.Error(err, fmt.Sprintf("Failed to create trade: %s.", err.Error()))
+	}
+
+	resp, err := tradeResponse(trade.ID)
+	if err != nil {
+		return terror.Error(err, "Failed to get trade.")
+	}
+	ws.PublishMessage(fmt.Sprintf("/user/%s/trades", resp.Recipient.ID), HubKeyTradeSubscribe, resp)
+
+	reply(resp)
+	return nil
+}
+
+type TradeRequest struct {
+	Payload struct {
+		TradeID string `json:"trade_id"`
+	} `json:"payload"`
+}
+
+// TradeAcceptHandler settles a trade.
+// The SUPS asked for are escrowed and the assets are moved while the trade row is locked.
+// Once committed the escrowed SUPS of both sides are paid out, if that fails it is retried by the settlement loop.
+func (tc *TradeController) TradeAcceptHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &TradeRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	b := TradeBucket.Add(user.ID, 1)
+	if b == 0 {
+		return terror.Warn(fmt.Errorf("too many requests"), "Too many requests.")
+	}
+
+	l := tc.Log.With().Str("func", "TradeAcceptHandler").Str("trade_id", req.Payload.TradeID).Str("user_id", user.ID).Logger()
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return terror.Error(err, "Failed to accept trade.")
+	}
+	defer tx.Rollback()
+
+	trade, err := asset.ClaimTrade(tx, tc.API.userCacheMap, req.Payload.TradeID, user.ID)
+	if errors.Is(err, asset.ErrTradeNotPending) {
+		return terror.Warn(err, "This trade is no longer available.")
+	}
+	if errors.Is(err, asset.ErrTradeNotEscrowed) {
+		return terror.Warn(err, "This trade isn't ready yet, please try again shortly.")
+	}
+	if errors.Is(err, asset.ErrTradeSupsNotEscrowed) {
+		return terror.Error(err, "Payment failed, please check your balance and try again.")
+	}
+	if err != nil {
+		return terror.Error(err, "Failed to get trade.")
+	}
+
+	relatedTransactionID := trade.OfferEscrowTXID
+	if !relatedTransactionID.Valid {
+		relatedTransactionID = trade.RequestEscrowTXID
+	}
+
+	transfers, err := asset.ExecuteTradeAssets(tx, trade, relatedTransactionID)
+	if err != nil {
+		l.Warn().Err(err).Msg("failed to move trade assets")
+		_ = tx.Rollback()
+		_, serr := asset.SetTradeStatus(trade.ID, asset.TradeStatusFailed, null.StringFrom(err.Error()))
+		if serr != nil {
+			l.Error().Err(serr).Msg("failed to mark trade as failed")
+		}
+		tc.settleTrade(trade.ID)
+		publishTrade(trade.ID)
+		return terror.Error(err, fmt.Sprintf("Failed to move trade assets: %s.", err.Error()))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return terror.Error(err, "Failed to accept trade.")
+	}
+
+	tc.settleTrade(trade.ID)
+	for _, te := range transfers.TransferEvents {
+		notifyAssetTransfer(te)
+	}
+	publishTrade(trade.ID)
+
+	resp, err := tradeResponse(trade.ID)
+	if err != nil {
+		return terror.Error(err, "Failed to get trade.")
+	}
+	reply(resp)
+	return nil
+}
+
+// settleTrade pays out or returns the escrowed SUPS of a closed trade, failures are left for the settlement loop
+func (tc *TradeController) settleTrade(tradeID string) {
+	_, err := asset.SettleTradeSups(tc.API.userCacheMap, tradeID)
+	if err != nil {
+		tc.Log.Error().Err(err).Str("trade_id", tradeID).Msg("failed to settle trade sups")
+	}
+}
+
+// notifyAssetTransfer tells the previous owner and the gameserver about the new owner of a traded or sold asset and moves any assets it says are attached
+func notifyAssetTransfer(te *boiler.AssetTransferEvent) {
+	if te.AvatarCleared {
+		asset.PublishUserAvatar(te.FromUserID)
+	}
+	err := sendAssetTransfer(te)
+	if err != nil {
+		passlog.L.Error().Err(err).Int64("transfer_event_id", te.ID).Msg("failed to notify supremacy of asset transfer")
+	}
+}
+
+// sendAssetTransfer sends supremacy a transfer event and moves the assets attached to it along with it
+func sendAssetTransfer(te *boiler.AssetTransferEvent) error {
+	attached, err := supremacy_rpcclient.SupremacyAssetTransferEvent(&xsynTypes.TransferEvent{
+		TransferEventID: te.ID,
+		AssetHash:       te.UserAssetHash,
+		FromUserID:      te.FromUserID,
+		ToUserID:        te.ToUserID,
+		TransferredAt:   te.TransferredAt,
+		TransferTXID:    te.TransferTXID,
+	})
+	if err != nil {
+		return err
+	}
+	for _, hash := range attached {
+		_, _, err = asset.TransferAsset(
+			hash,
+			te.FromUserID,
+			te.ToUserID,
+			xsynTypes.SupremacyGameUserID.String(),
+			false,
+			te.TransferTXID,
+			nil,
+		)
+		if err != nil {
+			passlog.L.Error().Err(err).Str("hash", hash).Msg("failed to transfer attached asset")
+		}
+	}
+	return nil
+}
+
+// refundTransaction refunds a transaction made before a later step failed
+func refundTransaction(ucm *Transactor, transactionToReverse *xsynTypes.NewTransaction, reason string) {
+	transaction := &xsynTypes.NewTransaction{
+		DebitAccountID:       transactionToReverse.CreditAccountID,
+		CreditAccountID:      transactionToReverse.DebitAccountID,
+		TransactionReference: xsynTypes.TransactionReference(fmt.Sprintf("REFUND - %s", transactionToReverse.TransactionReference)),
+		Description:          fmt.Sprintf("Reverse transaction - %s. Reason: %s", transactionToReverse.Description, reason),
+		Amount:               transactionToReverse.Amount,
+		Group:                transactionToReverse.Group,
+		SubGroup:             xsynTypes.TransactionSubGroupRefund,
+		RelatedTransactionID: null.StringFrom(transactionToReverse.ID),
+	}
+
+	_, err := ucm.Transact(transaction)
+	if err != nil {
+		passlog.L.Error().
+			Err(err).
+			Interface("transaction", transaction).
+			Msg("reverse failed")
+	}
+}
+
+// TradeRejectHandler lets the recipient turn down an offer
+func (tc *TradeController) TradeRejectHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	return tc.closeTrade(user, payload, reply, asset.TradeStatusRejected)
+}
+
+// TradeCancelHandler lets the offerer withdraw an offer
+func (tc *TradeController) TradeCancelHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	return tc.closeTrade(user, payload, reply, asset.TradeStatusCanceled)
+}
+
+func (tc *TradeController) closeTrade(user *xsynTypes.User, payload []byte, reply ws.ReplyFunc, status string) error {
+	req := &TradeRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+
+	trade, err := boiler.FindAssetTrade(passdb.StdConn, req.Payload.TradeID)
+	if err != nil {
+		return terror.Error(err, "Failed to get trade.")
+	}
+	if (status == asset.TradeStatusRejected && trade.RecipientID != user.ID) ||
+		(status == asset.TradeStatusCanceled && trade.OffererID != user.ID) {
+		return terror.Error(terror.ErrUnauthorised, "You are not part of this trade.")
+	}
+
+	_, err = asset.SetTradeStatus(trade.ID, status, null.String{})
+	if errors.Is(err, asset.ErrTradeNotPending) {
+		return terror.Warn(err, "This trade is no longer available.")
+	}
+	if err != nil {
+		return terror.Error(err, "Failed to update trade.")
+	}
+	tc.settleTrade(trade.ID)
+
+	publishTrade(trade.ID)
+	reply(true)
+	return nil
+}
+
+type TradeListRequest struct {
+	Payload struct {
+		Status   string `json:"status"`
+		PageSize int    `json:"page_size"`
+		Page     int    `json:"page"`
+	} `json:"payload"`
+}
+
+type TradeListResponse struct {
+	Total  int64            `json:"total"`
+	Trades []*TradeResponse `json:"trades"`
+}
+
+// TradeListHandler lists the trades the user has made or received
+func (tc *TradeController) TradeListHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	req := &TradeListRequest{}
+	err := json.Unmarshal(payload, req)
+	if err != nil {
+		return terror.Error(err, "Invalid request received.")
+	}
+	if req.Payload.PageSize <= 0 || req.Payload.PageSize > 50 {
+		req.Payload.PageSize = 10
+	}
+
+	queries := []qm.QueryMod{
+		qm.Expr(
+			boiler.AssetTradeWhere.OffererID.EQ(user.ID),
+			qm.Or2(boiler.AssetTradeWhere.RecipientID.EQ(user.ID)),
+		),
+	}
+	if req.Payload.Status != "" {
+		queries = append(queries, boiler.AssetTradeWhere.Status.EQ(req.Payload.Status))
+	}
+
+	total, err := boiler.AssetTrades(queries...).Count(passdb.StdConn)
+	if err != nil {
+		return terror.Error(err, "Failed to list trades.")
+	}
+
+	trades, err := boiler.AssetTrades(append(queries,
+		qm.OrderBy(fmt.Sprintf("%s DESC", boiler.AssetTradeColumns.CreatedAt)),
+		qm.Limit(req.Payload.PageSize),
+		qm.Offset(req.Payload.Page*req.Payload.PageSize),
+	)...).All(passdb.StdConn)
+	if err != nil {
+		return terror.Error(err, "Failed to list trades.")
+	}
+
+	resp := &TradeListResponse{
+		Total:  total,
+		Trades: []*TradeResponse{},
+	}
+	for _, trade := range trades {
+		tr, err := tradeResponse(trade.ID)
+		if err != nil {
+			return terror.Error(err, "Failed to list trades.")
+		}
+		resp.Trades = append(resp.Trades, tr)
+	}
+
+	reply(resp)
+	return nil
+}
+
+// TradeSubscribeHandler sends the user's pending trades on join, changes are pushed by publishTrade
+func (tc *TradeController) TradeSubscribeHandler(ctx context.Context, user *xsynTypes.User, key string, payload []byte, reply ws.ReplyFunc) error {
+	trades, err := boiler.AssetTrades(
+		boiler.AssetTradeWhere.Status.EQ(asset.TradeStatusPending),
+		qm.Expr(
+			boiler.AssetTradeWhere.OffererID.EQ(user.ID),
+			qm.Or2(boiler.AssetTradeWhere.RecipientID.EQ(user.ID)),
+		),
+	).All(passdb.StdConn)
+	if err != nil {
+		return terror.Error(err, "Failed to get trades.")
+	}
+
+	resp := []*TradeResponse{}
+	for _, trade := range trades {
+		tr, err := tradeResponse(trade.ID)
+		if err != nil {
+			return terror.Error(err, "Failed to get trades.")
+		}
+		resp = append(resp, tr)
+	}
+	reply(resp)
+	return nil
+}
+
+// ExpireTrades closes offers past their expiry every minute and lets both sides know,
+// then settles the SUPS of every closed trade that hasn't been settled yet
+func (tc *TradeController) ExpireTrades() {
+	ticker := time.NewTicker(time.Minute)
+	for range ticker.C {
+		expired, err := asset.ExpireTrades()
+		if err != nil {
+			tc.Log.Error().Err(err).Msg("failed to expire trades")
+		}
+		for _, trade := range expired {
+			publishTrade(trade.ID)
+		}
+
+		unsettled, err := asset.UnsettledTrades()
+		if err != nil {
+			tc.Log.Error().Err(err).Msg("failed to get unsettled trades")
+			continue
+		}
+		for _, trade := range unsettled {
+			tc.settleTrade(trade.ID)
+		}
+	}
+}
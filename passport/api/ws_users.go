@@ -15,6 +15,7 @@ import (
 	"time"
 	"xsyn-services/boiler"
 	"xsyn-services/passport/api/users"
+	"xsyn-services/passport/asset"
 	"xsyn-services/passport/crypto"
 	"xsyn-services/passport/db"
 	"xsyn-services/passport/helpers"
@@ -104,7 +105,7 @@ type GetUserRequest struct {
 	} `json:"payload"`
 }
 
-// 	rootHub.SecureCommand(HubKeyUserGet, UserController.GetHandler)
+// rootHub.SecureCommand(HubKeyUserGet, UserController.GetHandler)
 const HubKeyUserGet = "USER"
 
 // GetHandler gets the details for a user
@@ -133,11 +134,15 @@ func (uc *UserController) GetHandler(ctx context.Context, user *types.User, key
 		}
 
 		if buser.AvatarID.Valid {
-			*b.AvatarID = buser.AvatarID.String
+			b.AvatarID = &buser.AvatarID.String
+		}
+
+		if buser.AvatarUserAssetID.Valid {
+			b.AvatarUserAssetID = &buser.AvatarUserAssetID.String
 		}
 
 		if buser.FactionID.Valid {
-			*b.FactionID = buser.FactionID.String
+			b.FactionID = &buser.FactionID.String
 		}
 
 		b.Faction = buser.R.Faction
@@ -328,7 +333,7 @@ type UpdateUserRequest struct {
 		LastName                         string      `json:"last_name"`
 		MobileNumber                     string      `json:"mobile_number"`
 		Email                            null.String `json:"email"`
-		AvatarID                         *string     `json:"avatar_id"`
+		AvatarAssetHash                  *string     `json:"avatar_asset_hash"`
 		CurrentPassword                  *string     `json:"current_password"`
 		NewPassword                      *string     `json:"new_password"`
 		TwoFactorAuthenticationActivated bool        `json:"two_factor_authentication_activated"`
@@ -402,10 +407,6 @@ func (uc *UserController) UpdateHandler(ctx context.Context, user *types.User, k
 		user.MobileNumber = null.NewString("", false)
 	}
 
-	if req.Payload.AvatarID != nil {
-		user.AvatarID = null.StringFrom(*req.Payload.AvatarID)
-	}
-
 	// Start transaction
 	tx, err := passdb.StdConn.Begin()
 	if err != nil {
@@ -414,6 +415,13 @@ func (uc *UserController) UpdateHandler(ctx context.Context, user *types.User, k
 
 	defer tx.Rollback()
 
+	if req.Payload.AvatarAssetHash != nil {
+		err = asset.SetAvatarAsset(tx, &user.User, *req.Payload.AvatarAssetHash)
+		if err != nil {
+			return terror.Warn(err, "You can only use an asset you own as your avatar.")
+		}
+	}
+
 	// Update user
 	_, err = user.Update(tx, boil.Infer())
 	if err != nil {
@@ -490,7 +498,6 @@ type CreateUserRequest struct {
 		FirstName   string      `json:"first_name"`
 		LastName    string      `json:"last_name"`
 		Email       null.String `json:"email"`
-		AvatarID    string      `json:"avatar_id"`
 		NewPassword *string     `json:"new_password"`
 		RoleID      string      `json:"role_id"`
 	} `json:"payload"`
@@ -544,9 +551,6 @@ func (uc *UserController) CreateHandler(ctx context.Context, user *types.User, k
 		Email:     req.Payload.Email,
 		RoleID:    null.StringFrom(req.Payload.RoleID),
 	}
-	if req.Payload.AvatarID != "" {
-		newUser.AvatarID = null.StringFrom(req.Payload.AvatarID)
-	}
 
 	err = newUser.Insert(tx, boil.Infer())
 	if err != nil {
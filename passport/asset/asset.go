@@ -39,6 +39,18 @@ func TransferAsset(
 		return userAsset, 0, err
 	}
 
+	// syndicate assets go through TransferSyndicateAsset, which checks the member moving them
+	err = checkNotSyndicate(passdb.StdConn, fromID, toID)
+	if err != nil {
+		passlog.L.Error().Err(err).
+			Str("assetHash", assetHash).
+			Str("fromID", fromID).
+			Str("toID", toID).
+			Str("serviceID", serviceID).
+			Msg("failed to transfer asset ownership - TransferAsset")
+		return userAsset, 0, err
+	}
+
 	if updateServiceID && serviceID != "" && userAsset.LockedToService.Valid && userAsset.LockedToService.String != serviceID {
 		err := fmt.Errorf("cannot transfer asset the service doesn't control")
 		passlog.L.Error().Err(err).
@@ -61,7 +73,6 @@ func TransferAsset(
 		return userAsset, 0, err
 	}
 
-	userAsset.OwnerID = toID
 	if updateServiceID {
 		userAsset.LockedToService = null.String{}
 		if serviceID != "" {
@@ -69,7 +80,7 @@ func TransferAsset(
 		}
 	}
 
-	_, err = userAsset.Update(tx, boil.Infer())
+	transferEvent, err := TransferAssetTx(tx, userAsset, fromID, toID, serviceID, relatedTransactionID)
 	if err != nil {
 		passlog.L.Error().Err(err).
 			Str("assetHash", assetHash).
@@ -77,28 +88,7 @@ func TransferAsset(
 			Str("toID", toID).
 			Str("serviceID", serviceID).
 			Interface("userAsset", userAsset).
-			Msg("failed to update asset ownership - TransferAsset")
-		return userAsset, 0, err
-	}
-
-	transferEvent := &boiler.AssetTransferEvent{
-		UserAssetID:   userAsset.ID,
-		UserAssetHash: userAsset.Hash,
-		FromUserID:    fromID,
-		ToUserID:      toID,
-		InitiatedFrom: serviceID,
-		TransferTXID:  relatedTransactionID,
-	}
-
-	err = transferEvent.Insert(tx, boil.Infer())
-	if err != nil {
-		passlog.L.Error().Err(err).
-			Str("assetHash", assetHash).
-			Str("fromID", fromID).
-			Str("toID", toID).
-			Str("serviceID", serviceID).
-			Interface("transferEvent", transferEvent).
-			Msg("failed to insert transferEvent - TransferAsset")
+			Msg("failed to transfer asset ownership - TransferAsset")
 		return userAsset, 0, err
 	}
 
@@ -115,12 +105,53 @@ func TransferAsset(
 		return userAsset, 0, err
 	}
 
+	if transferEvent.AvatarCleared {
+		PublishUserAvatar(fromID)
+	}
 	if assetTransferNotify != nil {
 		assetTransferNotify(transferEvent)
 	}
 	return userAsset, transferEvent.ID, nil
 }
 
+// TransferAssetTx moves the asset to a new owner and records the transfer event inside the given db transaction,
+// it is used when the transfer has to commit together with other changes.
+// The previous owner's avatar is cleared if it was the asset, callers publish it with PublishUserAvatar once committed when the event says so.
+func TransferAssetTx(
+	tx boil.Executor,
+	userAsset *boiler.UserAsset,
+	fromID,
+	toID,
+	serviceID string,
+	relatedTransactionID null.String,
+) (*boiler.AssetTransferEvent, error) {
+	userAsset.OwnerID = toID
+	_, err := userAsset.Update(tx, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	avatarCleared, err := clearLostAvatarTx(tx, userAsset.ID, fromID)
+	if err != nil {
+		return nil, err
+	}
+
+	transferEvent := &boiler.AssetTransferEvent{
+		UserAssetID:   userAsset.ID,
+		UserAssetHash: userAsset.Hash,
+		FromUserID:    fromID,
+		ToUserID:      toID,
+		InitiatedFrom: serviceID,
+		TransferTXID:  relatedTransactionID,
+		AvatarCleared: avatarCleared,
+	}
+	err = transferEvent.Insert(tx, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+
+	return transferEvent, nil
+}
+
 // TransferAssetADMIN is used for admins to transfer assets, ignore service id and previous owner
 func TransferAssetADMIN(assetID, toID uuid.UUID) (int64, error) {
 	// get asset
@@ -141,34 +172,16 @@ func TransferAssetADMIN(assetID, toID uuid.UUID) (int64, error) {
 			Msg("failed to begin tx - TransferAssetADMIN")
 		return 0, err
 	}
-	oldOwner := userAsset.OwnerID
-	userAsset.OwnerID = toID.String()
-
-	_, err = userAsset.Update(tx, boil.Infer())
-	if err != nil {
-		passlog.L.Error().Err(err).
-			Str("assetHash", assetID.String()).
-			Str("toID", toID.String()).
-			Interface("userAsset", userAsset).
-			Msg("failed to update asset ownership - TransferAssetADMIN")
-		return 0, err
-	}
+	defer tx.Rollback()
 
-	transferEvent := &boiler.AssetTransferEvent{
-		UserAssetID:   userAsset.ID,
-		UserAssetHash: userAsset.Hash,
-		FromUserID:    oldOwner,
-		ToUserID:      toID.String(),
-	}
-
-	err = transferEvent.Insert(tx, boil.Infer())
+	oldOwner := userAsset.OwnerID
+	transferEvent, err := TransferAssetTx(tx, userAsset, oldOwner, toID.String(), "", null.String{})
 	if err != nil {
 		passlog.L.Error().Err(err).
 			Str("assetHash", assetID.String()).
 			Str("toID", toID.String()).
 			Interface("userAsset", userAsset).
-			Interface("transferEvent", transferEvent).
-			Msg("failed to insert transferEvent - TransferAssetADMIN")
+			Msg("failed to transfer asset ownership - TransferAssetADMIN")
 		return 0, err
 	}
 
@@ -183,5 +196,8 @@ func TransferAssetADMIN(assetID, toID uuid.UUID) (int64, error) {
 		return 0, err
 	}
 
+	if transferEvent.AvatarCleared {
+		PublishUserAvatar(oldOwner)
+	}
 	return transferEvent.ID, nil
 }
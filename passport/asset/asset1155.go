@@ -0,0 +1,143 @@
+package asset
+
+import (
+	"database/sql"
+	"fmt"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Move1155Tx moves an amount of a 1155 asset from one row to the matching row of the new owner and service,
+// creating it when needed. The caller is expected to have locked the from row.
+func Move1155Tx(tx boil.Executor, from *boiler.UserAssets1155, toOwnerID string, toServiceID null.String, amount int) (*boiler.UserAssets1155, error) {
+	if amount <= 0 || from.Count < amount {
+		return nil, fmt.Errorf("1155 asset %s has %d left, %d required", from.ID, from.Count, amount)
+	}
+
+	queries := []qm.QueryMod{
+		boiler.UserAssets1155Where.OwnerID.EQ(toOwnerID),
+		boiler.UserAssets1155Where.CollectionID.EQ(from.CollectionID),
+		boiler.UserAssets1155Where.ExternalTokenID.EQ(from.ExternalTokenID),
+		qm.For("UPDATE"),
+	}
+	if toServiceID.Valid {
+		queries = append(queries, boiler.UserAssets1155Where.ServiceID.EQ(toServiceID))
+	} else {
+		queries = append(queries, boiler.UserAssets1155Where.ServiceID.IsNull())
+	}
+
+	to, err := boiler.UserAssets1155S(queries...).One(tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		to = &boiler.UserAssets1155{
+			OwnerID:         toOwnerID,
+			CollectionID:    from.CollectionID,
+			ExternalTokenID: from.ExternalTokenID,
+			Label:           from.Label,
+			Description:     from.Description,
+			ImageURL:        from.ImageURL,
+			AnimationURL:    from.AnimationURL,
+			KeycardGroup:    from.KeycardGroup,
+			Attributes:      from.Attributes,
+			ServiceID:       toServiceID,
+		}
+		err = to.Insert(tx, boil.Infer())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	from.Count -= amount
+	_, err = from.Update(tx, boil.Whitelist(boiler.UserAssets1155Columns.Count))
+	if err != nil {
+		return nil, err
+	}
+	to.Count += amount
+	_, err = to.Update(tx, boil.Whitelist(boiler.UserAssets1155Columns.Count))
+	if err != nil {
+		return nil, err
+	}
+	return to, nil
+}
+
+// Transfer1155 moves an amount of a 1155 token from one user to another and records the transfer.
+// Only the balance held where serviceID says can move, on xsyn when it is null, and it stays locked to the same service for the new owner.
+func Transfer1155(fromID, toID, collectionID string, externalTokenID, amount int, serviceID null.String, initiatedFrom string) (*boiler.Asset1155TransferEvent, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+	if fromID == toID {
+		return nil, fmt.Errorf("cannot transfer to yourself")
+	}
+	exists, err := boiler.UserExists(passdb.StdConn, toID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("recipient %s not found", toID)
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// lock both balances in id order, so transfers going both ways between two users can't deadlock
+	queries := []qm.QueryMod{
+		boiler.UserAssets1155Where.OwnerID.IN([]string{fromID, toID}),
+		boiler.UserAssets1155Where.CollectionID.EQ(collectionID),
+		boiler.UserAssets1155Where.ExternalTokenID.EQ(externalTokenID),
+		qm.OrderBy(boiler.UserAssets1155Columns.ID),
+		qm.For("UPDATE"),
+	}
+	if serviceID.Valid {
+		queries = append(queries, boiler.UserAssets1155Where.ServiceID.EQ(serviceID))
+	} else {
+		queries = append(queries, boiler.UserAssets1155Where.ServiceID.IsNull())
+	}
+	balances, err := boiler.UserAssets1155S(queries...).All(tx)
+	if err != nil {
+		return nil, err
+	}
+	var from *boiler.UserAssets1155
+	for _, balance := range balances {
+		if balance.OwnerID == fromID {
+			from = balance
+		}
+	}
+	if from == nil {
+		return nil, fmt.Errorf("user %s holds none of token %d", fromID, externalTokenID)
+	}
+
+	to, err := Move1155Tx(tx, from, toID, from.ServiceID, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &boiler.Asset1155TransferEvent{
+		CollectionID:    collectionID,
+		ExternalTokenID: externalTokenID,
+		Amount:          amount,
+		FromAssetID:     from.ID,
+		ToAssetID:       to.ID,
+		FromUserID:      fromID,
+		ToUserID:        toID,
+		ServiceID:       serviceID,
+		InitiatedFrom:   initiatedFrom,
+	}
+	err = event.Insert(tx, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,142 @@
+package asset_test
+
+import (
+	"testing"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+
+	"github.com/gofrs/uuid"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestTransfer1155(t *testing.T) {
+	passdbtest.Require(t)
+
+	collection := passdbtest.Collection(t)
+
+	// balance is what the user holds of the token on xsyn
+	balance := func(t *testing.T, user *boiler.User, tokenID int) int {
+		t.Helper()
+		held, err := boiler.UserAssets1155S(
+			boiler.UserAssets1155Where.OwnerID.EQ(user.ID),
+			boiler.UserAssets1155Where.CollectionID.EQ(collection.ID),
+			boiler.UserAssets1155Where.ExternalTokenID.EQ(tokenID),
+			boiler.UserAssets1155Where.ServiceID.IsNull(),
+		).All(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		count := 0
+		for _, h := range held {
+			count += h.Count
+		}
+		return count
+	}
+
+	t.Run("moves the amount to a new balance", func(t *testing.T) {
+		from := passdbtest.User(t)
+		to := passdbtest.User(t)
+		passdbtest.Asset1155(t, collection, from, 1, 5)
+
+		event, err := asset.Transfer1155(from.ID, to.ID, collection.ID, 1, 3, null.String{}, "XSYN")
+		if err != nil {
+			t.Fatalf("failed to transfer: %s", err)
+		}
+		if got := balance(t, from, 1); got != 2 {
+			t.Errorf("sender holds %d, want 2", got)
+		}
+		if got := balance(t, to, 1); got != 3 {
+			t.Errorf("receiver holds %d, want 3", got)
+		}
+		if event.Amount != 3 || event.FromUserID != from.ID || event.ToUserID != to.ID {
+			t.Errorf("recorded %d from %s to %s, want 3 from %s to %s", event.Amount, event.FromUserID, event.ToUserID, from.ID, to.ID)
+		}
+	})
+
+	t.Run("adds to the receiver's balance", func(t *testing.T) {
+		from := passdbtest.User(t)
+		to := passdbtest.User(t)
+		passdbtest.Asset1155(t, collection, from, 2, 4)
+		passdbtest.Asset1155(t, collection, to, 2, 1)
+
+		_, err := asset.Transfer1155(from.ID, to.ID, collection.ID, 2, 4, null.String{}, "XSYN")
+		if err != nil {
+			t.Fatalf("failed to transfer: %s", err)
+		}
+		if got := balance(t, from, 2); got != 0 {
+			t.Errorf("sender holds %d, want 0", got)
+		}
+		if got := balance(t, to, 2); got != 5 {
+			t.Errorf("receiver holds %d, want 5", got)
+		}
+	})
+
+	t.Run("transfers both ways", func(t *testing.T) {
+		a := passdbtest.User(t)
+		b := passdbtest.User(t)
+		passdbtest.Asset1155(t, collection, a, 3, 2)
+		passdbtest.Asset1155(t, collection, b, 3, 2)
+
+		_, err := asset.Transfer1155(a.ID, b.ID, collection.ID, 3, 1, null.String{}, "XSYN")
+		if err != nil {
+			t.Fatalf("failed to transfer: %s", err)
+		}
+		_, err = asset.Transfer1155(b.ID, a.ID, collection.ID, 3, 3, null.String{}, "XSYN")
+		if err != nil {
+			t.Fatalf("failed to transfer back: %s", err)
+		}
+		if got := balance(t, a, 3); got != 4 {
+			t.Errorf("first user holds %d, want 4", got)
+		}
+		if got := balance(t, b, 3); got != 0 {
+			t.Errorf("second user holds %d, want 0", got)
+		}
+	})
+
+	t.Run("insufficient balance", func(t *testing.T) {
+		from := passdbtest.User(t)
+		to := passdbtest.User(t)
+		passdbtest.Asset1155(t, collection, from, 4, 2)
+
+		_, err := asset.Transfer1155(from.ID, to.ID, collection.ID, 4, 3, null.String{}, "XSYN")
+		if err == nil {
+			t.Fatalf("transferred more than the sender holds")
+		}
+		_, err = asset.Transfer1155(from.ID, to.ID, collection.ID, 5, 1, null.String{}, "XSYN")
+		if err == nil {
+			t.Fatalf("transferred a token the sender doesn't hold")
+		}
+		if got := balance(t, from, 4); got != 2 {
+			t.Errorf("sender holds %d, want 2", got)
+		}
+		if got := balance(t, to, 4); got != 0 {
+			t.Errorf("receiver holds %d, want 0", got)
+		}
+	})
+
+	t.Run("missing receiver", func(t *testing.T) {
+		from := passdbtest.User(t)
+		passdbtest.Asset1155(t, collection, from, 6, 2)
+
+		_, err := asset.Transfer1155(from.ID, uuid.Must(uuid.NewV4()).String(), collection.ID, 6, 1, null.String{}, "XSYN")
+		if err == nil {
+			t.Fatalf("transferred to a user that doesn't exist")
+		}
+		if got := balance(t, from, 6); got != 2 {
+			t.Errorf("sender holds %d, want 2", got)
+		}
+	})
+
+	t.Run("only the balance held by the service moves", func(t *testing.T) {
+		from := passdbtest.User(t)
+		to := passdbtest.User(t)
+		passdbtest.Asset1155(t, collection, from, 7, 2)
+
+		_, err := asset.Transfer1155(from.ID, to.ID, collection.ID, 7, 1, null.StringFrom(uuid.Must(uuid.NewV4()).String()), "service")
+		if err == nil {
+			t.Fatalf("transferred a balance the service doesn't hold")
+		}
+	})
+}
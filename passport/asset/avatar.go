@@ -0,0 +1,72 @@
+package asset
+
+import (
+	"fmt"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/ninja-syndicate/ws"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// hubKeyUser is the key user updates are published on, the same as api.HubKeyUser
+const hubKeyUser = "USER"
+
+// SetAvatarAsset sets the user's avatar to an asset they own, an empty hash clears it.
+// The asset is locked until the caller saves the user in the same db transaction, so it can't be transferred in between.
+func SetAvatarAsset(tx boil.Executor, user *boiler.User, hash string) error {
+	user.AvatarUserAssetID = null.String{}
+	if hash != "" {
+		userAsset, err := boiler.UserAssets(
+			boiler.UserAssetWhere.Hash.EQ(hash),
+			qm.Select(boiler.UserAssetColumns.ID, boiler.UserAssetColumns.OwnerID),
+			qm.For("SHARE"),
+		).One(tx)
+		if err != nil {
+			return fmt.Errorf("asset %s: %w", hash, err)
+		}
+		if userAsset.OwnerID != user.ID {
+			return fmt.Errorf("asset %s is not owned by the user", hash)
+		}
+		user.AvatarUserAssetID = null.StringFrom(userAsset.ID)
+	}
+	return nil
+}
+
+// clearLostAvatarTx clears the avatar of a user who no longer owns the asset it was set to, it returns whether there was one to clear
+func clearLostAvatarTx(exec boil.Executor, userAssetID string, userID string) (bool, error) {
+	n, err := boiler.Users(
+		boiler.UserWhere.ID.EQ(userID),
+		boiler.UserWhere.AvatarUserAssetID.EQ(null.StringFrom(userAssetID)),
+	).UpdateAll(exec, boiler.M{
+		boiler.UserColumns.AvatarUserAssetID: null.String{},
+		boiler.UserColumns.UpdatedAt:         time.Now(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// PublishUserAvatar sends a user update to a user whose avatar was cleared by giving up the asset, so it is shown straight away.
+// Callers only call it when the transfer or crafting event says the avatar was cleared.
+func PublishUserAvatar(userID string) {
+	u, err := boiler.FindUser(passdb.StdConn, userID)
+	if err != nil {
+		passlog.L.Error().Err(err).Str("user_id", userID).Msg("failed to get user for avatar update")
+		return
+	}
+	if u.AvatarUserAssetID.Valid {
+		return
+	}
+	user, err := xsynTypes.UserFromBoil(u)
+	if err != nil {
+		return
+	}
+	ws.PublishMessage("/user/"+user.ID, hubKeyUser, user)
+}
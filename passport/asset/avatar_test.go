@@ -0,0 +1,102 @@
+package asset_test
+
+import (
+	"testing"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+	"xsyn-services/types"
+
+	"github.com/gofrs/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestAvatarClearedOnTransfer(t *testing.T) {
+	passdbtest.Require(t)
+
+	collection := passdbtest.Collection(t)
+	owner := passdbtest.User(t)
+	buyer := passdbtest.User(t)
+	avatar := passdbtest.Asset(t, collection, owner)
+	other := passdbtest.Asset(t, collection, owner)
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+	err = asset.SetAvatarAsset(tx, owner, avatar.Hash)
+	if err != nil {
+		t.Fatalf("failed to set avatar: %s", err)
+	}
+	_, err = owner.Update(tx, boil.Whitelist(boiler.UserColumns.AvatarUserAssetID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = tx.Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = asset.SetAvatarAsset(passdb.StdConn, buyer, other.Hash)
+	if err == nil {
+		t.Errorf("set an avatar to an asset the user doesn't own")
+	}
+
+	transfer := func(t *testing.T, userAsset *boiler.UserAsset) *boiler.AssetTransferEvent {
+		t.Helper()
+		var te *boiler.AssetTransferEvent
+		_, _, err := asset.TransferAsset(userAsset.Hash, owner.ID, buyer.ID, types.XsynMarketplaceUserID.String(), false, null.String{}, func(e *boiler.AssetTransferEvent) {
+			te = e
+		})
+		if err != nil {
+			t.Fatalf("failed to transfer asset: %s", err)
+		}
+		return te
+	}
+
+	if te := transfer(t, other); te.AvatarCleared {
+		t.Errorf("transferring another asset cleared the avatar")
+	}
+	if te := transfer(t, avatar); !te.AvatarCleared {
+		t.Errorf("transferring the avatar didn't say it was cleared")
+	}
+	err = owner.Reload(passdb.StdConn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner.AvatarUserAssetID.Valid {
+		t.Errorf("avatar is still set to %s", owner.AvatarUserAssetID.String)
+	}
+}
+
+func TestAvatarClearedOnAdminTransfer(t *testing.T) {
+	passdbtest.Require(t)
+
+	collection := passdbtest.Collection(t)
+	owner := passdbtest.User(t)
+	avatar := passdbtest.Asset(t, collection, owner)
+
+	err := asset.SetAvatarAsset(passdb.StdConn, owner, avatar.Hash)
+	if err != nil {
+		t.Fatalf("failed to set avatar: %s", err)
+	}
+	_, err = owner.Update(passdb.StdConn, boil.Whitelist(boiler.UserColumns.AvatarUserAssetID))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = asset.TransferAssetADMIN(uuid.Must(uuid.FromString(avatar.ID)), uuid.Must(uuid.FromString(passdbtest.User(t).ID)))
+	if err != nil {
+		t.Fatalf("failed to transfer asset: %s", err)
+	}
+	err = owner.Reload(passdb.StdConn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner.AvatarUserAssetID.Valid {
+		t.Errorf("avatar is still set to %s after an admin moved the asset", owner.AvatarUserAssetID.String)
+	}
+}
@@ -0,0 +1,575 @@
+package asset
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/nft1155"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/supremacy_rpcclient"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	CraftingSideInput  = "INPUT"
+	CraftingSideOutput = "OUTPUT"
+)
+
+const CraftingMaxItems = 20
+
+const (
+	CraftingStatusPending  = "PENDING"
+	CraftingStatusComplete = "COMPLETE"
+	CraftingStatusFailed   = "FAILED"
+)
+
+// CraftingPendingTimeout is how long a crafting event can stay pending before it is failed and its SUPS refunded
+const CraftingPendingTimeout = 10 * time.Minute
+
+var ErrCraftingNotPending = fmt.Errorf("crafting is no longer pending")
+
+// CraftingRecipeItemRequest is an input or output of a recipe.
+// 721s are any assets of the collection, limited to a tier when one is given, 1155s are a single token of the collection.
+type CraftingRecipeItemRequest struct {
+	CollectionSlug string      `json:"collection_slug"`
+	TokenID        null.Int    `json:"token_id"`
+	Tier           null.String `json:"tier"`
+	Amount         int         `json:"amount"`
+}
+
+type CraftingRecipeRequest struct {
+	Name        string                       `json:"name"`
+	Description string                       `json:"description"`
+	SupsCost    decimal.Decimal              `json:"sups_cost"`
+	Inputs      []*CraftingRecipeItemRequest `json:"inputs"`
+	Outputs     []*CraftingRecipeItemRequest `json:"outputs"`
+}
+
+func craftingRecipeItem(req *CraftingRecipeItemRequest, side string) (*boiler.CraftingRecipeItem, error) {
+	if req.Amount <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+	collection, err := boiler.Collections(boiler.CollectionWhere.Slug.EQ(req.CollectionSlug)).One(passdb.StdConn)
+	if err != nil {
+		return nil, fmt.Errorf("collection %s: %w", req.CollectionSlug, err)
+	}
+
+	if collection.ContractType.String == db.ContractTypeEIP1155 {
+		if !req.TokenID.Valid {
+			return nil, fmt.Errorf("token id is required for %s", collection.Slug)
+		}
+		if req.Tier.Valid {
+			return nil, fmt.Errorf("tier only applies to %s collections", db.ContractTypeERC721)
+		}
+	} else if req.TokenID.Valid {
+		return nil, fmt.Errorf("token id only applies to %s collections", db.ContractTypeEIP1155)
+	}
+
+	return &boiler.CraftingRecipeItem{
+		Side:            side,
+		CollectionID:    collection.ID,
+		ExternalTokenID: req.TokenID,
+		Tier:            req.Tier,
+		Amount:          req.Amount,
+	}, nil
+}
+
+// CreateCraftingRecipe creates an active recipe with its inputs and outputs
+func CreateCraftingRecipe(req *CraftingRecipeRequest, createdByID string) (*boiler.CraftingRecipe, error) {
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if req.SupsCost.LessThan(decimal.Zero) {
+		return nil, fmt.Errorf("sups cost can't be negative")
+	}
+	if len(req.Inputs) == 0 || len(req.Outputs) == 0 {
+		return nil, fmt.Errorf("a recipe needs at least one input and one output")
+	}
+	if len(req.Inputs)+len(req.Outputs) > CraftingMaxItems {
+		return nil, fmt.Errorf("a recipe can have at most %d inputs and outputs", CraftingMaxItems)
+	}
+
+	items := boiler.CraftingRecipeItemSlice{}
+	for _, input := range req.Inputs {
+		item, err := craftingRecipeItem(input, CraftingSideInput)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	for _, output := range req.Outputs {
+		item, err := craftingRecipeItem(output, CraftingSideOutput)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	recipe := &boiler.CraftingRecipe{
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+		SupsCost:    req.SupsCost,
+		IsActive:    true,
+		CreatedByID: createdByID,
+	}
+	err = recipe.Insert(tx, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		item.RecipeID = recipe.ID
+		err = item.Insert(tx, boil.Infer())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return recipe, nil
+}
+
+// SetCraftingRecipeActive turns a recipe on or off, recipes are never deleted so past crafting events keep their recipe
+func SetCraftingRecipeActive(recipeID string, active bool) (*boiler.CraftingRecipe, error) {
+	recipe, err := boiler.FindCraftingRecipe(passdb.StdConn, recipeID)
+	if err != nil {
+		return nil, err
+	}
+	recipe.IsActive = active
+	recipe.UpdatedAt = time.Now()
+	_, err = recipe.Update(passdb.StdConn, boil.Whitelist(boiler.CraftingRecipeColumns.IsActive, boiler.CraftingRecipeColumns.UpdatedAt))
+	if err != nil {
+		return nil, err
+	}
+	return recipe, nil
+}
+
+// ActiveCraftingRecipe gets an active recipe with its items and their collections
+func ActiveCraftingRecipe(recipeID string) (*boiler.CraftingRecipe, error) {
+	recipe, err := boiler.CraftingRecipes(
+		boiler.CraftingRecipeWhere.ID.EQ(recipeID),
+		boiler.CraftingRecipeWhere.IsActive.EQ(true),
+		qm.Load(qm.Rels(boiler.CraftingRecipeRels.RecipeCraftingRecipeItems, boiler.CraftingRecipeItemRels.Collection)),
+	).One(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+	return recipe, nil
+}
+
+// CraftRequest is a user crafting a recipe.
+// Hashes are the 721s to burn for the recipe's 721 inputs, Outputs721 are the assets the service made for its 721 outputs.
+// Assets locked to the service crafting can be burned, and what's crafted is locked to it.
+type CraftRequest struct {
+	RecipeID   string                           `json:"recipe_id"`
+	UserID     string                           `json:"user_id"`
+	ServiceID  null.String                      `json:"service_id"`
+	Hashes     []string                         `json:"hashes"`
+	Outputs721 []*supremacy_rpcclient.XsynAsset `json:"outputs_721"`
+}
+
+// PrepareCraftingOutputs1155 makes sure the user has a row for every 1155 the recipe outputs.
+// Getting a new token's details calls supremacy so it is done before the crafting db transaction.
+func PrepareCraftingOutputs1155(recipe *boiler.CraftingRecipe, req *CraftRequest) error {
+	user, err := boiler.FindUser(passdb.StdConn, req.UserID)
+	if err != nil {
+		return err
+	}
+	for _, item := range recipe.R.RecipeCraftingRecipeItems {
+		if item.Side != CraftingSideOutput || !item.ExternalTokenID.Valid {
+			continue
+		}
+		if req.ServiceID.Valid {
+			_, err = nft1155.CreateOrGet1155AssetWithService(item.ExternalTokenID.Int, &xsynTypes.User{User: *user}, item.R.Collection.Slug, req.ServiceID.String)
+		} else {
+			_, err = nft1155.CreateOrGet1155Asset(item.ExternalTokenID.Int, &xsynTypes.User{User: *user}, item.R.Collection.Slug)
+		}
+		if err != nil {
+			return fmt.Errorf("1155 output %s %d: %w", item.R.Collection.Slug, item.ExternalTokenID.Int, err)
+		}
+	}
+	return nil
+}
+
+// checkCraftingInput721 returns an error when a 721 can't be burned, it has to be held off chain and not be busy
+func checkCraftingInput721(exec boil.Executor, userAsset *boiler.UserAsset, req *CraftRequest) error {
+	if userAsset.OwnerID != req.UserID {
+		return fmt.Errorf("asset %s is not owned by the user", userAsset.Hash)
+	}
+	if userAsset.LockedToService.Valid && userAsset.LockedToService != req.ServiceID {
+		return fmt.Errorf("asset %s is locked to another service", userAsset.Hash)
+	}
+	err := CheckNotRented(exec, userAsset.ID)
+	if err != nil {
+		return fmt.Errorf("asset %s: %w", userAsset.Hash, err)
+	}
+	if userAsset.UnlockedAt.After(time.Now()) {
+		return fmt.Errorf("asset %s is locked", userAsset.Hash)
+	}
+	onChainStatus, err := boiler.UserAssetOnChainStatuses(
+		boiler.UserAssetOnChainStatusWhere.CollectionID.EQ(userAsset.CollectionID),
+		boiler.UserAssetOnChainStatusWhere.AssetHash.EQ(userAsset.Hash),
+	).One(exec)
+	if err != nil {
+		return err
+	}
+	if onChainStatus.OnChainStatus != string(db.MINTABLE) {
+		return fmt.Errorf("asset %s is on chain", userAsset.Hash)
+	}
+	return nil
+}
+
+// burnCraftingInputs721 burns the given 721s, each one has to fill one of the recipe's 721 inputs and every input has to be filled
+func burnCraftingInputs721(tx boil.Executor, recipe *boiler.CraftingRecipe, req *CraftRequest, event *boiler.CraftingEvent) error {
+	required := map[*boiler.CraftingRecipeItem]int{}
+	for _, item := range recipe.R.RecipeCraftingRecipeItems {
+		if item.Side == CraftingSideInput && !item.ExternalTokenID.Valid {
+			required[item] = item.Amount
+		}
+	}
+
+	userAssets := boiler.UserAssetSlice{}
+	if len(req.Hashes) > 0 {
+		var err error
+		userAssets, err = boiler.UserAssets(
+			boiler.UserAssetWhere.Hash.IN(req.Hashes),
+			qm.For("UPDATE"),
+		).All(tx)
+		if err != nil {
+			return err
+		}
+	}
+	if len(userAssets) != len(req.Hashes) {
+		return fmt.Errorf("%d of %d assets found", len(userAssets), len(req.Hashes))
+	}
+
+	for _, userAsset := range userAssets {
+		err := checkCraftingInput721(tx, userAsset, req)
+		if err != nil {
+			return err
+		}
+
+		var filled *boiler.CraftingRecipeItem
+		for item, left := range required {
+			if left > 0 && item.CollectionID == userAsset.CollectionID && (!item.Tier.Valid || item.Tier.String == userAsset.Tier) {
+				filled = item
+				break
+			}
+		}
+		if filled == nil {
+			return fmt.Errorf("asset %s is not an input of the recipe", userAsset.Hash)
+		}
+		required[filled]--
+
+		avatarCleared, err := clearBurnedAssetTx(tx, userAsset)
+		if err != nil {
+			return err
+		}
+		event.AvatarCleared = event.AvatarCleared || avatarCleared
+		_, err = userAsset.Delete(tx, false)
+		if err != nil {
+			return err
+		}
+		eventItem := &boiler.CraftingEventItem{
+			CraftingEventID: event.ID,
+			Side:            CraftingSideInput,
+			UserAssetID:     null.StringFrom(userAsset.ID),
+			Amount:          1,
+		}
+		err = eventItem.Insert(tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	for item, left := range required {
+		if left > 0 {
+			return fmt.Errorf("%d more assets of %s are required", left, item.R.Collection.Name)
+		}
+	}
+	return nil
+}
+
+// clearBurnedAssetTx removes what still points at an asset that is about to be burned: the owner's avatar, its metadata refresh and any open service lease.
+// It returns whether the owner's avatar was cleared.
+func clearBurnedAssetTx(tx boil.Executor, userAsset *boiler.UserAsset) (bool, error) {
+	avatarCleared, err := clearLostAvatarTx(tx, userAsset.ID, userAsset.OwnerID)
+	if err != nil {
+		return false, err
+	}
+	_, err = boiler.AssetMetadataRefreshes(
+		boiler.AssetMetadataRefreshWhere.UserAssetID.EQ(userAsset.ID),
+	).DeleteAll(tx)
+	if err != nil {
+		return false, err
+	}
+	_, err = boiler.AssetServiceLocks(
+		boiler.AssetServiceLockWhere.UserAssetID.EQ(userAsset.ID),
+		boiler.AssetServiceLockWhere.ReleasedAt.IsNull(),
+	).UpdateAll(tx, boiler.M{
+		boiler.AssetServiceLockColumns.ReleasedAt:    null.TimeFrom(time.Now()),
+		boiler.AssetServiceLockColumns.ReleaseReason: null.StringFrom(LockReleaseService),
+	})
+	if err != nil {
+		return false, err
+	}
+	return avatarCleared, nil
+}
+
+// craftingAsset1155ForUpdate locks the user's row of a 1155 held on xsyn or by the crafting service
+func craftingAsset1155ForUpdate(tx boil.Executor, item *boiler.CraftingRecipeItem, req *CraftRequest) (*boiler.UserAssets1155, error) {
+	queries := []qm.QueryMod{
+		boiler.UserAssets1155Where.OwnerID.EQ(req.UserID),
+		boiler.UserAssets1155Where.CollectionID.EQ(item.CollectionID),
+		boiler.UserAssets1155Where.ExternalTokenID.EQ(item.ExternalTokenID.Int),
+		qm.For("UPDATE"),
+	}
+	if req.ServiceID.Valid {
+		queries = append(queries, boiler.UserAssets1155Where.ServiceID.EQ(req.ServiceID))
+	} else {
+		queries = append(queries, boiler.UserAssets1155Where.ServiceID.IsNull())
+	}
+	return boiler.UserAssets1155S(queries...).One(tx)
+}
+
+// StartCraftingEvent records a pending crafting event, it has to exist before the SUPS are charged so the charge can always be traced back to it
+func StartCraftingEvent(recipe *boiler.CraftingRecipe, req *CraftRequest) (*boiler.CraftingEvent, error) {
+	event := &boiler.CraftingEvent{
+		RecipeID:  recipe.ID,
+		UserID:    req.UserID,
+		ServiceID: req.ServiceID,
+		SupsCost:  recipe.SupsCost,
+		Status:    CraftingStatusPending,
+	}
+	err := event.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func craftingSupsReference(eventID string) string {
+	return fmt.Sprintf("crafting|%s", eventID)
+}
+
+// ChargeCrafting takes the SUPS cost of a pending crafting event from the user, charging again returns the same ledger transaction
+func ChargeCrafting(ucm Transactor, event *boiler.CraftingEvent, recipe *boiler.CraftingRecipe) (string, error) {
+	user, err := boiler.FindUser(passdb.StdConn, event.UserID)
+	if err != nil {
+		return "", err
+	}
+	treasury, err := boiler.FindUser(passdb.StdConn, xsynTypes.XsynTreasuryUserID.String())
+	if err != nil {
+		return "", err
+	}
+	return TransactOnce(ucm, &xsynTypes.NewTransaction{
+		DebitAccountID:       user.AccountID,
+		CreditAccountID:      treasury.AccountID,
+		TransactionReference: xsynTypes.TransactionReference(craftingSupsReference(event.ID)),
+		Description:          fmt.Sprintf("Crafting %s", recipe.Name),
+		Amount:               event.SupsCost,
+		Group:                xsynTypes.TransactionGroupAssetManagement,
+		SubGroup:             xsynTypes.TransactionSubGroupCrafting,
+	})
+}
+
+// CraftTx burns the inputs of a recipe, creates its outputs and completes the pending crafting event in the given db transaction.
+// txID is the ledger transaction that paid for it, if the recipe costs SUPS.
+func CraftTx(tx boil.Executor, recipe *boiler.CraftingRecipe, req *CraftRequest, eventID string, txID null.String) (*boiler.CraftingEvent, error) {
+	event, err := boiler.CraftingEvents(
+		boiler.CraftingEventWhere.ID.EQ(eventID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status != CraftingStatusPending {
+		return nil, ErrCraftingNotPending
+	}
+
+	err = burnCraftingInputs721(tx, recipe, req, event)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs721 := map[string][]*supremacy_rpcclient.XsynAsset{}
+	for _, output := range req.Outputs721 {
+		outputs721[output.CollectionSlug] = append(outputs721[output.CollectionSlug], output)
+	}
+
+	for _, item := range recipe.R.RecipeCraftingRecipeItems {
+		eventItem := &boiler.CraftingEventItem{
+			CraftingEventID: event.ID,
+			Side:            item.Side,
+			Amount:          item.Amount,
+		}
+
+		switch {
+		case item.ExternalTokenID.Valid:
+			asset1155, err := craftingAsset1155ForUpdate(tx, item, req)
+			if err != nil {
+				return nil, fmt.Errorf("%s %d: %w", item.R.Collection.Slug, item.ExternalTokenID.Int, err)
+			}
+			if item.Side == CraftingSideInput {
+				if asset1155.Count < item.Amount {
+					return nil, fmt.Errorf("%s has %d left, %d required", asset1155.Label, asset1155.Count, item.Amount)
+				}
+				asset1155.Count -= item.Amount
+			} else {
+				asset1155.Count += item.Amount
+			}
+			_, err = asset1155.Update(tx, boil.Whitelist(boiler.UserAssets1155Columns.Count))
+			if err != nil {
+				return nil, err
+			}
+			eventItem.UserAsset1155ID = null.StringFrom(asset1155.ID)
+			err = eventItem.Insert(tx, boil.Infer())
+			if err != nil {
+				return nil, err
+			}
+
+		case item.Side == CraftingSideOutput:
+			made := outputs721[item.R.Collection.Slug]
+			if len(made) < item.Amount {
+				return nil, fmt.Errorf("%d assets of %s are required as outputs, %d given", item.Amount, item.R.Collection.Slug, len(made))
+			}
+			outputs721[item.R.Collection.Slug] = made[item.Amount:]
+			for _, output := range made[:item.Amount] {
+				output.OwnerID = req.UserID
+				userAsset, err := db.RegisterUserAsset(output, req.ServiceID.String, tx)
+				if err != nil {
+					return nil, err
+				}
+				eventItem := &boiler.CraftingEventItem{
+					CraftingEventID: event.ID,
+					Side:            CraftingSideOutput,
+					UserAssetID:     null.StringFrom(userAsset.ID),
+					Amount:          1,
+				}
+				err = eventItem.Insert(tx, boil.Infer())
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	for slug, left := range outputs721 {
+		if len(left) > 0 {
+			return nil, fmt.Errorf("%d assets of %s are not outputs of the recipe", len(left), slug)
+		}
+	}
+
+	event.Status = CraftingStatusComplete
+	event.TXID = txID
+	event.SettledAt = null.TimeFrom(time.Now())
+	_, err = event.Update(tx, boil.Whitelist(
+		boiler.CraftingEventColumns.Status,
+		boiler.CraftingEventColumns.TXID,
+		boiler.CraftingEventColumns.SettledAt,
+		boiler.CraftingEventColumns.AvatarCleared,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FailCraftingEvent fails a pending crafting event and refunds its SUPS if they were charged.
+// It only goes by the event and the ledger, so it can be run again until the event is settled. Completed events are left alone.
+func FailCraftingEvent(ucm Transactor, eventID string, reason string) (*boiler.CraftingEvent, error) {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	event, err := boiler.CraftingEvents(
+		boiler.CraftingEventWhere.ID.EQ(eventID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status == CraftingStatusComplete || event.SettledAt.Valid {
+		return event, nil
+	}
+	if event.Status == CraftingStatusPending {
+		event.Status = CraftingStatusFailed
+		event.FailedReason = null.StringFrom(reason)
+	}
+
+	charge, err := db.TransactionGetByReference(craftingSupsReference(event.ID))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if err == nil {
+		user, err := boiler.FindUser(tx, event.UserID)
+		if err != nil {
+			return nil, err
+		}
+		refundID, err := TransactOnce(ucm, &xsynTypes.NewTransaction{
+			DebitAccountID:       charge.CreditAccountID,
+			CreditAccountID:      user.AccountID,
+			TransactionReference: xsynTypes.TransactionReference(fmt.Sprintf("REFUND %s", craftingSupsReference(event.ID))),
+			Description:          fmt.Sprintf("Refund of crafting %s. Reason: %s", event.ID, event.FailedReason.String),
+			Amount:               charge.Amount,
+			Group:                xsynTypes.TransactionGroupAssetManagement,
+			SubGroup:             xsynTypes.TransactionSubGroupRefund,
+			RelatedTransactionID: null.StringFrom(charge.ID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		event.RefundTXID = null.StringFrom(refundID)
+	}
+
+	event.SettledAt = null.TimeFrom(time.Now())
+	_, err = event.Update(tx, boil.Whitelist(
+		boiler.CraftingEventColumns.Status,
+		boiler.CraftingEventColumns.FailedReason,
+		boiler.CraftingEventColumns.RefundTXID,
+		boiler.CraftingEventColumns.SettledAt,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// UnsettledCraftingEvents returns the failed events still to be refunded and the events left pending past CraftingPendingTimeout
+func UnsettledCraftingEvents() (boiler.CraftingEventSlice, error) {
+	return boiler.CraftingEvents(
+		boiler.CraftingEventWhere.SettledAt.IsNull(),
+		qm.Expr(
+			boiler.CraftingEventWhere.Status.EQ(CraftingStatusFailed),
+			qm.Or2(qm.Expr(
+				boiler.CraftingEventWhere.Status.EQ(CraftingStatusPending),
+				boiler.CraftingEventWhere.CreatedAt.LT(time.Now().Add(-CraftingPendingTimeout)),
+			)),
+		),
+		qm.OrderBy(boiler.CraftingEventColumns.CreatedAt),
+	).All(passdb.StdConn)
+}
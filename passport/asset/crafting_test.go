@@ -0,0 +1,187 @@
+package asset_test
+
+import (
+	"testing"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+	"xsyn-services/types"
+
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestCrafting(t *testing.T) {
+	passdbtest.Require(t)
+
+	inputs := passdbtest.Collection(t)
+	outputs := passdbtest.Collection(t)
+	serviceID := types.SupremacyGameUserID.String()
+	sups := decimal.New(100, 18)
+
+	created, err := asset.CreateCraftingRecipe(&asset.CraftingRecipeRequest{
+		Name:     "test recipe",
+		SupsCost: decimal.New(10, 18),
+		Inputs:   []*asset.CraftingRecipeItemRequest{{CollectionSlug: inputs.Slug, Amount: 1}},
+		Outputs:  []*asset.CraftingRecipeItemRequest{{CollectionSlug: outputs.Slug, TokenID: null.IntFrom(7), Amount: 1}},
+	}, types.XsynTreasuryUserID.String())
+	if err != nil {
+		t.Fatalf("failed to create recipe: %s", err)
+	}
+	recipe, err := asset.ActiveCraftingRecipe(created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// setup gives a funded user an input leased to the service and an empty row for the output, so supremacy isn't asked for it
+	setup := func(t *testing.T) (*asset.CraftRequest, *boiler.UserAsset) {
+		t.Helper()
+		user := passdbtest.User(t)
+		passdbtest.Fund(t, user, sups)
+		input := passdbtest.Asset(t, inputs, user)
+		output := passdbtest.Asset1155(t, outputs, user, 7, 0)
+		output.ServiceID = null.StringFrom(serviceID)
+		_, err := output.Update(passdb.StdConn, boil.Whitelist(boiler.UserAssets1155Columns.ServiceID))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = asset.AcquireServiceLock(input.ID, serviceID, "test", time.Hour)
+		if err != nil {
+			t.Fatalf("failed to lock input: %s", err)
+		}
+		err = asset.EnqueueMetadataRefresh(passdb.StdConn, input.ID, asset.MetadataRefreshPriorityRequested)
+		if err != nil {
+			t.Fatalf("failed to queue refresh: %s", err)
+		}
+		return &asset.CraftRequest{
+			RecipeID:  recipe.ID,
+			UserID:    user.ID,
+			ServiceID: null.StringFrom(serviceID),
+			Hashes:    []string{input.Hash},
+		}, input
+	}
+	start := func(t *testing.T, ucm asset.Transactor, req *asset.CraftRequest) (*boiler.CraftingEvent, string) {
+		t.Helper()
+		event, err := asset.StartCraftingEvent(recipe, req)
+		if err != nil {
+			t.Fatalf("failed to start crafting: %s", err)
+		}
+		txID, err := asset.ChargeCrafting(ucm, event, recipe)
+		if err != nil {
+			t.Fatalf("failed to charge crafting: %s", err)
+		}
+		return event, txID
+	}
+	balance := func(t *testing.T, userID string) decimal.Decimal {
+		t.Helper()
+		user, err := boiler.FindUser(passdb.StdConn, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return passdbtest.Balance(t, user)
+	}
+
+	t.Run("failed crafting is refunded once", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		req, input := setup(t)
+		event, _ := start(t, ucm, req)
+
+		if got := balance(t, req.UserID); !got.Equal(decimal.New(90, 18)) {
+			t.Errorf("balance after charge = %s, want 90 SUPS", got)
+		}
+
+		for i := 0; i < 2; i++ {
+			failed, err := asset.FailCraftingEvent(ucm, event.ID, "test")
+			if err != nil {
+				t.Fatalf("failed to fail crafting: %s", err)
+			}
+			if failed.Status != asset.CraftingStatusFailed || !failed.RefundTXID.Valid || !failed.SettledAt.Valid {
+				t.Errorf("crafting not refunded: %+v", failed)
+			}
+		}
+		if got := balance(t, req.UserID); !got.Equal(sups) {
+			t.Errorf("balance after refund = %s, want %s", got, sups)
+		}
+
+		// the inputs weren't burned
+		err := input.Reload(passdb.StdConn)
+		if err != nil {
+			t.Errorf("input is gone: %s", err)
+		}
+	})
+
+	t.Run("stale pending crafting is found by the reconciler", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		req, _ := setup(t)
+		event, _ := start(t, ucm, req)
+
+		event.CreatedAt = time.Now().Add(-asset.CraftingPendingTimeout - time.Minute)
+		_, err := event.Update(passdb.StdConn, boil.Whitelist(boiler.CraftingEventColumns.CreatedAt))
+		if err != nil {
+			t.Fatal(err)
+		}
+		events, err := asset.UnsettledCraftingEvents()
+		if err != nil {
+			t.Fatal(err)
+		}
+		found := false
+		for _, e := range events {
+			found = found || e.ID == event.ID
+		}
+		if !found {
+			t.Errorf("stale pending crafting %s not returned", event.ID)
+		}
+	})
+
+	t.Run("completed crafting burns the inputs and is not refunded", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		req, input := setup(t)
+		event, txID := start(t, ucm, req)
+
+		tx, err := passdb.StdConn.Begin()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer tx.Rollback()
+		_, err = asset.CraftTx(tx, recipe, req, event.ID, null.StringFrom(txID))
+		if err != nil {
+			t.Fatalf("failed to craft: %s", err)
+		}
+		err = tx.Commit()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		settled, err := asset.FailCraftingEvent(ucm, event.ID, "test")
+		if err != nil {
+			t.Fatalf("failed to fail crafting: %s", err)
+		}
+		if settled.Status != asset.CraftingStatusComplete || settled.RefundTXID.Valid {
+			t.Errorf("completed crafting was failed: %+v", settled)
+		}
+		if got := balance(t, req.UserID); !got.Equal(decimal.New(90, 18)) {
+			t.Errorf("balance = %s, want 90 SUPS", got)
+		}
+
+		queued, err := boiler.AssetMetadataRefreshExists(passdb.StdConn, input.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if queued {
+			t.Errorf("burned input is still queued for a metadata refresh")
+		}
+		open, err := boiler.AssetServiceLocks(
+			boiler.AssetServiceLockWhere.UserAssetID.EQ(input.ID),
+			boiler.AssetServiceLockWhere.ReleasedAt.IsNull(),
+		).Exists(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if open {
+			t.Errorf("burned input still has an open service lease")
+		}
+	})
+}
@@ -0,0 +1,248 @@
+package asset
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passdb"
+
+	"github.com/gofrs/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type HistoryEventType string
+
+const (
+	HistoryEventRegistered      HistoryEventType = "REGISTERED"
+	HistoryEventTransfer        HistoryEventType = "TRANSFER"
+	HistoryEventServiceLock     HistoryEventType = "SERVICE_LOCK"
+	HistoryEventServiceUnlock   HistoryEventType = "SERVICE_UNLOCK"
+	HistoryEventMint            HistoryEventType = "MINT"
+	HistoryEventOnChainTransfer HistoryEventType = "ONCHAIN_TRANSFER"
+	HistoryEventStake           HistoryEventType = "STAKE"
+	HistoryEventUnstake         HistoryEventType = "UNSTAKE"
+	HistoryEventMetadataChange  HistoryEventType = "METADATA_CHANGE"
+)
+
+type HistoryUser struct {
+	ID            string      `json:"id"`
+	Username      string      `json:"username"`
+	PublicAddress null.String `json:"public_address,omitempty"`
+}
+
+// HistoryEvent is a single entry of an asset's provenance timeline
+type HistoryEvent struct {
+	Type HistoryEventType `json:"type"`
+	// off chain owners and services
+	From    *HistoryUser `json:"from,omitempty"`
+	To      *HistoryUser `json:"to,omitempty"`
+	Service *HistoryUser `json:"service,omitempty"`
+	// metadata changes
+	MetadataVersion int                  `json:"metadata_version,omitempty"`
+	MetadataSource  string               `json:"metadata_source,omitempty"`
+	Changes         []*db.MetadataChange `json:"changes,omitempty"`
+	// on chain addresses
+	FromAddress   string    `json:"from_address,omitempty"`
+	ToAddress     string    `json:"to_address,omitempty"`
+	TxHash        string    `json:"tx_hash,omitempty"`
+	BlockNumber   int       `json:"block_number,omitempty"`
+	InitiatedFrom string    `json:"initiated_from,omitempty"`
+	OccurredAt    time.Time `json:"occurred_at"`
+}
+
+// History merges the off chain transfers, service locks, mints, on chain transactions and metadata changes of the asset into one timeline, oldest first
+func History(userAsset *boiler.UserAsset) ([]*HistoryEvent, error) {
+	collection, err := boiler.FindCollection(passdb.StdConn, userAsset.CollectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers, err := boiler.AssetTransferEvents(
+		boiler.AssetTransferEventWhere.UserAssetID.EQ(userAsset.ID),
+	).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceTransfers, err := boiler.AssetServiceTransferEvents(
+		boiler.AssetServiceTransferEventWhere.UserAssetID.EQ(userAsset.ID),
+	).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	onChainTxs, err := boiler.ItemOnchainTransactions(
+		boiler.ItemOnchainTransactionWhere.CollectionID.EQ(userAsset.CollectionID),
+		boiler.ItemOnchainTransactionWhere.ExternalTokenID.EQ(int(userAsset.TokenID)),
+	).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	// the first version is the asset as registered, only later ones are changes
+	metadataVersions, err := boiler.UserAssetMetadataVersions(
+		boiler.UserAssetMetadataVersionWhere.UserAssetID.EQ(userAsset.ID),
+		boiler.UserAssetMetadataVersionWhere.Version.GT(1),
+		qm.OrderBy(boiler.UserAssetMetadataVersionColumns.Version),
+	).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	// load every user referenced by the events in one query
+	userIDs := []string{userAsset.OwnerID}
+	for _, te := range transfers {
+		userIDs = append(userIDs, te.FromUserID, te.ToUserID)
+	}
+	for _, ste := range serviceTransfers {
+		userIDs = append(userIDs, ste.UserID)
+		if ste.FromService.Valid {
+			userIDs = append(userIDs, ste.FromService.String)
+		}
+		if ste.ToService.Valid {
+			userIDs = append(userIDs, ste.ToService.String)
+		}
+	}
+	for _, tx := range onChainTxs {
+		userIDs = append(userIDs, tx.FromAddr)
+	}
+	users, err := historyUsers(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []*HistoryEvent{}
+
+	// the first owner is the from user of the first transfer, otherwise it is the current owner
+	firstOwner := userAsset.OwnerID
+	earliest := time.Time{}
+	for _, te := range transfers {
+		if earliest.IsZero() || te.TransferredAt.Before(earliest) {
+			earliest = te.TransferredAt
+			firstOwner = te.FromUserID
+		}
+	}
+	events = append(events, &HistoryEvent{
+		Type:       HistoryEventRegistered,
+		To:         users[firstOwner],
+		OccurredAt: userAsset.CreatedAt,
+	})
+
+	for _, te := range transfers {
+		events = append(events, &HistoryEvent{
+			Type:          HistoryEventTransfer,
+			From:          users[te.FromUserID],
+			To:            users[te.ToUserID],
+			TxHash:        te.TransferTXID.String,
+			InitiatedFrom: te.InitiatedFrom,
+			OccurredAt:    te.TransferredAt,
+		})
+	}
+
+	for _, ste := range serviceTransfers {
+		event := &HistoryEvent{
+			Type:          HistoryEventServiceLock,
+			From:          users[ste.UserID],
+			InitiatedFrom: ste.InitiatedFrom,
+			TxHash:        ste.TransferTXID.String,
+			OccurredAt:    ste.TransferredAt,
+		}
+		if ste.ToService.Valid {
+			event.Service = users[ste.ToService.String]
+		} else {
+			event.Type = HistoryEventServiceUnlock
+			event.Service = users[ste.FromService.String]
+		}
+		events = append(events, event)
+	}
+
+	if userAsset.MintedAt.Valid {
+		events = append(events, &HistoryEvent{
+			Type:       HistoryEventMint,
+			OccurredAt: userAsset.MintedAt.Time,
+		})
+	}
+
+	sort.Slice(onChainTxs, func(i, j int) bool { return onChainTxs[i].BlockNumber < onChainTxs[j].BlockNumber })
+	previousTo := ""
+	for _, tx := range onChainTxs {
+		event := &HistoryEvent{
+			Type:        HistoryEventOnChainTransfer,
+			FromAddress: previousTo,
+			ToAddress:   tx.ToAddr,
+			TxHash:      tx.TXID,
+			BlockNumber: tx.BlockNumber,
+			OccurredAt:  tx.BlockTimestamp,
+		}
+		// from_addr holds the off chain owner at the time of the sync
+		if from, ok := users[tx.FromAddr]; ok {
+			event.From = from
+			if event.FromAddress == "" {
+				event.FromAddress = from.PublicAddress.String
+			}
+		}
+		if isStakeContract(collection, tx.ToAddr) {
+			event.Type = HistoryEventStake
+		} else if isStakeContract(collection, previousTo) {
+			event.Type = HistoryEventUnstake
+		}
+		previousTo = tx.ToAddr
+		events = append(events, event)
+	}
+
+	for _, version := range metadataVersions {
+		changes := []*db.MetadataChange{}
+		err = version.Diff.Unmarshal(&changes)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &HistoryEvent{
+			Type:            HistoryEventMetadataChange,
+			MetadataVersion: version.Version,
+			MetadataSource:  version.Source,
+			Changes:         changes,
+			OccurredAt:      version.CreatedAt,
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].OccurredAt.Before(events[j].OccurredAt) })
+	return events, nil
+}
+
+func isStakeContract(collection *boiler.Collection, addr string) bool {
+	if addr == "" {
+		return false
+	}
+	return (collection.StakeContract.Valid && strings.EqualFold(collection.StakeContract.String, addr)) ||
+		(collection.StakingContractOld.Valid && strings.EqualFold(collection.StakingContractOld.String, addr))
+}
+
+func historyUsers(ids []string) (map[string]*HistoryUser, error) {
+	// skip anything that isn't a user id, ids is a uuid column
+	userIDs := []string{}
+	for _, id := range ids {
+		if _, err := uuid.FromString(id); err == nil {
+			userIDs = append(userIDs, id)
+		}
+	}
+
+	users, err := boiler.Users(
+		boiler.UserWhere.ID.IN(userIDs),
+	).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]*HistoryUser{}
+	for _, u := range users {
+		result[u.ID] = &HistoryUser{
+			ID:            u.ID,
+			Username:      u.Username,
+			PublicAddress: u.PublicAddress,
+		}
+	}
+	return result, nil
+}
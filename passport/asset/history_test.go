@@ -0,0 +1,140 @@
+package asset
+
+import (
+	"fmt"
+	"testing"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gofrs/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestHistory(t *testing.T) {
+	passdbtest.Require(t)
+
+	insert := func(t *testing.T, o interface {
+		Insert(boil.Executor, boil.Columns) error
+	}) {
+		t.Helper()
+		err := o.Insert(passdb.StdConn, boil.Infer())
+		if err != nil {
+			t.Fatalf("failed to insert %T: %s", o, err)
+		}
+	}
+
+	collection := passdbtest.Collection(t)
+	collection.StakeContract = null.StringFrom(common.BytesToAddress(uuid.Must(uuid.NewV4()).Bytes()).Hex())
+	_, err := collection.Update(passdb.StdConn, boil.Whitelist(boiler.CollectionColumns.StakeContract))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seller := passdbtest.User(t)
+	buyer := passdbtest.User(t)
+	service := passdbtest.User(t)
+	userAsset := passdbtest.Asset(t, collection, buyer)
+	at := func(minutes int) time.Time { return userAsset.CreatedAt.Add(time.Duration(minutes) * time.Minute) }
+
+	insert(t, &boiler.AssetTransferEvent{
+		UserAssetID:   userAsset.ID,
+		UserAssetHash: userAsset.Hash,
+		FromUserID:    seller.ID,
+		ToUserID:      buyer.ID,
+		InitiatedFrom: "XSYN",
+		TransferredAt: at(1),
+	})
+	insert(t, &boiler.AssetServiceTransferEvent{
+		UserAssetID:   userAsset.ID,
+		UserID:        buyer.ID,
+		InitiatedFrom: "SUPREMACY",
+		ToService:     null.StringFrom(service.ID),
+		TransferredAt: at(2),
+	})
+	insert(t, &boiler.AssetServiceTransferEvent{
+		UserAssetID:   userAsset.ID,
+		UserID:        buyer.ID,
+		InitiatedFrom: "SUPREMACY",
+		FromService:   null.StringFrom(service.ID),
+		TransferredAt: at(3),
+	})
+	userAsset.MintedAt = null.TimeFrom(at(4))
+	_, err = userAsset.Update(passdb.StdConn, boil.Whitelist(boiler.UserAssetColumns.MintedAt))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wallet := buyer.PublicAddress.String
+	// the sync stores the off chain owner at the time in from_addr, and is inserted out of block order here
+	onChain := []struct {
+		from  string
+		to    string
+		block int
+	}{
+		{buyer.ID, collection.StakeContract.String, 11},
+		{buyer.ID, wallet, 10},
+		{buyer.ID, wallet, 12},
+	}
+	for _, tx := range onChain {
+		insert(t, &boiler.ItemOnchainTransaction{
+			CollectionID:    collection.ID,
+			ExternalTokenID: int(userAsset.TokenID),
+			TXID:            fmt.Sprintf("0x%s", uuid.Must(uuid.NewV4())),
+			ContractAddr:    collection.MintContract.String,
+			FromAddr:        tx.from,
+			ToAddr:          tx.to,
+			BlockNumber:     tx.block,
+			BlockTimestamp:  at(tx.block - 5),
+		})
+	}
+
+	events, err := History(userAsset)
+	if err != nil {
+		t.Fatalf("failed to get history: %s", err)
+	}
+
+	want := []struct {
+		eventType HistoryEventType
+		from      string
+		to        string
+		service   string
+	}{
+		{HistoryEventRegistered, "", seller.ID, ""},
+		{HistoryEventTransfer, seller.ID, buyer.ID, ""},
+		{HistoryEventServiceLock, buyer.ID, "", service.ID},
+		{HistoryEventServiceUnlock, buyer.ID, "", service.ID},
+		{HistoryEventMint, "", "", ""},
+		{HistoryEventOnChainTransfer, buyer.ID, "", ""},
+		{HistoryEventStake, buyer.ID, "", ""},
+		{HistoryEventUnstake, buyer.ID, "", ""},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d", len(events), len(want))
+	}
+	id := func(u *HistoryUser) string {
+		if u == nil {
+			return ""
+		}
+		return u.ID
+	}
+	for i, w := range want {
+		e := events[i]
+		if e.Type != w.eventType || id(e.From) != w.from || id(e.To) != w.to || id(e.Service) != w.service {
+			t.Errorf("event %d = %s from %q to %q service %q, want %s from %q to %q service %q",
+				i, e.Type, id(e.From), id(e.To), id(e.Service), w.eventType, w.from, w.to, w.service)
+		}
+	}
+
+	mint := events[5]
+	if mint.FromAddress != wallet || mint.ToAddress != wallet || mint.BlockNumber != 10 {
+		t.Errorf("first on chain transfer from %s to %s at block %d, want from the owner's wallet %s to it at block 10", mint.FromAddress, mint.ToAddress, mint.BlockNumber, wallet)
+	}
+	unstake := events[7]
+	if unstake.FromAddress != collection.StakeContract.String || unstake.ToAddress != wallet {
+		t.Errorf("unstake from %s to %s, want from the stake contract to %s", unstake.FromAddress, unstake.ToAddress, wallet)
+	}
+}
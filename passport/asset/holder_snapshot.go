@@ -0,0 +1,507 @@
+package asset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	HolderSnapshotPending  = "PENDING"
+	HolderSnapshotComplete = "COMPLETE"
+	HolderSnapshotFailed   = "FAILED"
+)
+
+type HolderSnapshotRequest struct {
+	Name          string                     `json:"name"`
+	CollectionIDs []string                   `json:"collection_ids"`
+	Filters       []*db.AttributeFacetFilter `json:"filters"`
+	At            null.Time                  `json:"at"`
+	BlockNumber   null.Int64                 `json:"block_number"`
+}
+
+// CreateHolderSnapshot queues a snapshot of the holders of the collections.
+// A time in the future is taken live once it arrives, a time in the past or a block is rebuilt from the transfer history.
+// 1155 balances have no history so they can only be snapshotted live.
+func CreateHolderSnapshot(req *HolderSnapshotRequest, createdByID string) (*boiler.HolderSnapshot, error) {
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if len(req.CollectionIDs) == 0 {
+		return nil, fmt.Errorf("at least one collection is required")
+	}
+	if req.At.Valid && req.BlockNumber.Valid {
+		return nil, fmt.Errorf("snapshot either at a time or at a block, not both")
+	}
+	if req.BlockNumber.Valid && req.BlockNumber.Int64 <= 0 {
+		return nil, fmt.Errorf("block number must be positive")
+	}
+	err := db.ValidateAttributeFacetFilters(req.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	collections, err := boiler.Collections(boiler.CollectionWhere.ID.IN(req.CollectionIDs)).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+	if len(collections) != len(req.CollectionIDs) {
+		return nil, fmt.Errorf("collection not found")
+	}
+
+	// a block number only means something on one chain
+	if req.BlockNumber.Valid {
+		_, err = snapshotChain(collections)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	historic := req.BlockNumber.Valid || (req.At.Valid && req.At.Time.Before(time.Now()))
+	for _, collection := range collections {
+		if collection.ContractType.String != db.ContractTypeEIP1155 {
+			continue
+		}
+		if historic {
+			return nil, fmt.Errorf("%s balances have no history, snapshot it now or at a future time", collection.Name)
+		}
+		if len(req.Filters) > 0 {
+			return nil, fmt.Errorf("trait filters only apply to %s collections", db.ContractTypeERC721)
+		}
+	}
+
+	filters := req.Filters
+	if filters == nil {
+		filters = []*db.AttributeFacetFilter{}
+	}
+	traitFilters, err := json.Marshal(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &boiler.HolderSnapshot{
+		Name:          strings.TrimSpace(req.Name),
+		CollectionIds: req.CollectionIDs,
+		TraitFilters:  traitFilters,
+		SnapshotAt:    req.At,
+		BlockNumber:   req.BlockNumber,
+		Status:        HolderSnapshotPending,
+		CreatedByID:   createdByID,
+	}
+	err = snapshot.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// holding is what one holder has of one collection
+type holding struct {
+	CollectionID  string
+	UserID        null.String
+	PublicAddress null.String
+	Custodial     int
+	Minted        int
+	Staked        int
+}
+
+type holdings map[string]*holding
+
+func (h holdings) add(collectionID string, userID null.String, address null.String) *holding {
+	key := collectionID + ":" + userID.String
+	if !userID.Valid {
+		key = collectionID + ":" + strings.ToLower(address.String)
+	}
+	if _, ok := h[key]; !ok {
+		h[key] = &holding{CollectionID: collectionID, UserID: userID, PublicAddress: address}
+	}
+	return h[key]
+}
+
+func snapshotFilterMods(snapshot *boiler.HolderSnapshot) ([]qm.QueryMod, error) {
+	filters := []*db.AttributeFacetFilter{}
+	err := snapshot.TraitFilters.Unmarshal(&filters)
+	if err != nil {
+		return nil, err
+	}
+	queryMods := []qm.QueryMod{}
+	for _, filter := range filters {
+		queryMods = append(queryMods, db.AttributeFacetFilterMod(filter))
+	}
+	return queryMods, nil
+}
+
+// liveHoldings counts current owners, the on chain status says whether the owner holds the asset on xsyn, in their wallet or staked
+func liveHoldings(snapshot *boiler.HolderSnapshot) (holdings, error) {
+	result := holdings{}
+
+	queryMods := []qm.QueryMod{
+		qm.Select(
+			boiler.UserAssetTableColumns.CollectionID+" AS collection_id",
+			boiler.UserAssetTableColumns.OwnerID+" AS owner_id",
+			fmt.Sprintf("COALESCE(%s, '%s') AS on_chain_status", boiler.UserAssetOnChainStatusTableColumns.OnChainStatus, db.MINTABLE),
+		),
+		qm.From(boiler.TableNames.UserAssets),
+		qm.LeftOuterJoin(fmt.Sprintf(
+			"%s ON %s = %s",
+			boiler.TableNames.UserAssetOnChainStatus,
+			boiler.UserAssetOnChainStatusTableColumns.AssetHash,
+			boiler.UserAssetTableColumns.Hash,
+		)),
+		boiler.UserAssetWhere.CollectionID.IN(snapshot.CollectionIds),
+		boiler.UserAssetWhere.DeletedAt.IsNull(),
+	}
+	filterMods, err := snapshotFilterMods(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	queryMods = append(queryMods, filterMods...)
+
+	rows := []*struct {
+		CollectionID  string `boil:"collection_id"`
+		OwnerID       string `boil:"owner_id"`
+		OnChainStatus string `boil:"on_chain_status"`
+	}{}
+	err = boiler.NewQuery(queryMods...).Bind(nil, passdb.StdConn, &rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		h := result.add(row.CollectionID, null.StringFrom(row.OwnerID), null.String{})
+		switch db.OnChainStatus(row.OnChainStatus) {
+		case db.STAKABLE:
+			h.Minted++
+		case db.UNSTAKABLE, db.UNSTAKABLEOLD:
+			h.Staked++
+		default:
+			h.Custodial++
+		}
+	}
+
+	balances, err := boiler.UserAssets1155S(
+		boiler.UserAssets1155Where.CollectionID.IN(snapshot.CollectionIds),
+		boiler.UserAssets1155Where.Count.GT(0),
+	).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+	for _, balance := range balances {
+		result.add(balance.CollectionID, null.StringFrom(balance.OwnerID), null.String{}).Custodial += balance.Count
+	}
+
+	return result, nil
+}
+
+// BlockTimeFunc gets the time a block was mined on the chain
+type BlockTimeFunc func(chain string, blockNumber int64) (time.Time, error)
+
+// NodeBlockTime reads block times from each chain's node, keyed by collection chain
+func NodeBlockTime(nodeURLs map[string]string) BlockTimeFunc {
+	return func(chain string, blockNumber int64) (time.Time, error) {
+		url, ok := nodeURLs[chain]
+		if !ok || url == "" {
+			return time.Time{}, fmt.Errorf("no node configured for chain %q", chain)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		client, err := ethclient.DialContext(ctx, url)
+		if err != nil {
+			return time.Time{}, err
+		}
+		defer client.Close()
+		header, err := client.HeaderByNumber(ctx, big.NewInt(blockNumber))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to get block %d on %s: %w", blockNumber, chain, err)
+		}
+		return time.Unix(int64(header.Time), 0), nil
+	}
+}
+
+// snapshotChain is the chain all the collections are on
+func snapshotChain(collections boiler.CollectionSlice) (string, error) {
+	chain := ""
+	for _, collection := range collections {
+		if !collection.Chain.Valid {
+			return "", fmt.Errorf("%s has no chain", collection.Name)
+		}
+		if chain != "" && collection.Chain.String != chain {
+			return "", fmt.Errorf("a block snapshot needs collections on one chain")
+		}
+		chain = collection.Chain.String
+	}
+	return chain, nil
+}
+
+// snapshotTime is the time the snapshot covers, for a block it is when the block was mined on the collections' chain
+func snapshotTime(snapshot *boiler.HolderSnapshot, collections boiler.CollectionSlice, blockTime BlockTimeFunc) (time.Time, error) {
+	if !snapshot.BlockNumber.Valid {
+		return snapshot.SnapshotAt.Time, nil
+	}
+	chain, err := snapshotChain(collections)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if blockTime == nil {
+		return time.Time{}, fmt.Errorf("block times are not available")
+	}
+	return blockTime(chain, snapshot.BlockNumber.Int64)
+}
+
+// historicHoldings rebuilds who held each asset at the snapshot.
+// Assets last sent on chain before then are held by the receiving wallet, or by the wallet that staked them if sent to a stake contract.
+// The rest are held off chain by the owner before the first xsyn transfer after the snapshot.
+func historicHoldings(snapshot *boiler.HolderSnapshot, blockTime BlockTimeFunc) (holdings, error) {
+	collections, err := boiler.Collections(boiler.CollectionWhere.ID.IN(snapshot.CollectionIds)).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+	collectionsByID := map[string]*boiler.Collection{}
+	for _, collection := range collections {
+		collectionsByID[collection.ID] = collection
+	}
+
+	at, err := snapshotTime(snapshot, collections, blockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	queryMods := []qm.QueryMod{
+		qm.WithDeleted(),
+		boiler.UserAssetWhere.CollectionID.IN(snapshot.CollectionIds),
+		boiler.UserAssetWhere.CreatedAt.LTE(at),
+		qm.Expr(boiler.UserAssetWhere.DeletedAt.IsNull(), qm.Or2(boiler.UserAssetWhere.DeletedAt.GT(null.TimeFrom(at)))),
+	}
+	filterMods, err := snapshotFilterMods(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	queryMods = append(queryMods, filterMods...)
+
+	userAssets, err := boiler.UserAssets(queryMods...).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+	if len(userAssets) == 0 {
+		return holdings{}, nil
+	}
+
+	// on chain transactions up to the snapshot, oldest first
+	onChainMods := []qm.QueryMod{
+		boiler.ItemOnchainTransactionWhere.CollectionID.IN(snapshot.CollectionIds),
+		qm.OrderBy(fmt.Sprintf("%s, %s", boiler.ItemOnchainTransactionColumns.BlockNumber, boiler.ItemOnchainTransactionColumns.BlockTimestamp)),
+	}
+	if snapshot.BlockNumber.Valid {
+		onChainMods = append(onChainMods, boiler.ItemOnchainTransactionWhere.BlockNumber.LTE(int(snapshot.BlockNumber.Int64)))
+	} else {
+		onChainMods = append(onChainMods, boiler.ItemOnchainTransactionWhere.BlockTimestamp.LTE(at))
+	}
+	onChainTxs, err := boiler.ItemOnchainTransactions(onChainMods...).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+	type onChainState struct {
+		to       string
+		previous string
+	}
+	onChain := map[string]*onChainState{}
+	for _, tx := range onChainTxs {
+		key := fmt.Sprintf("%s:%d", tx.CollectionID, tx.ExternalTokenID)
+		state, ok := onChain[key]
+		if !ok {
+			state = &onChainState{}
+			onChain[key] = state
+		}
+		state.previous = state.to
+		state.to = tx.ToAddr
+	}
+
+	// the owner at the snapshot is the sender of the first transfer after it
+	assetIDs := []string{}
+	for _, userAsset := range userAssets {
+		assetIDs = append(assetIDs, userAsset.ID)
+	}
+	transfers, err := boiler.AssetTransferEvents(
+		boiler.AssetTransferEventWhere.UserAssetID.IN(assetIDs),
+		boiler.AssetTransferEventWhere.TransferredAt.GT(at),
+		qm.OrderBy(boiler.AssetTransferEventColumns.TransferredAt),
+	).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+	ownerAt := map[string]string{}
+	for _, transfer := range transfers {
+		if _, ok := ownerAt[transfer.UserAssetID]; !ok {
+			ownerAt[transfer.UserAssetID] = transfer.FromUserID
+		}
+	}
+
+	type walletHolding struct {
+		collectionID string
+		address      string
+		staked       bool
+	}
+	wallets := []*walletHolding{}
+	result := holdings{}
+	for _, userAsset := range userAssets {
+		state, ok := onChain[fmt.Sprintf("%s:%d", userAsset.CollectionID, userAsset.TokenID)]
+		if ok && common.HexToAddress(state.to) != (common.Address{}) {
+			if isStakeContract(collectionsByID[userAsset.CollectionID], state.to) {
+				// staked with no earlier transfer synced, the staker isn't known
+				if common.HexToAddress(state.previous) == (common.Address{}) {
+					continue
+				}
+				wallets = append(wallets, &walletHolding{userAsset.CollectionID, state.previous, true})
+				continue
+			}
+			wallets = append(wallets, &walletHolding{userAsset.CollectionID, state.to, false})
+			continue
+		}
+
+		owner, ok := ownerAt[userAsset.ID]
+		if !ok {
+			owner = userAsset.OwnerID
+		}
+		result.add(userAsset.CollectionID, null.StringFrom(owner), null.String{}).Custodial++
+	}
+
+	// wallets are credited to their xsyn user when there is one
+	addresses := []interface{}{}
+	for _, w := range wallets {
+		addresses = append(addresses, strings.ToLower(w.address))
+	}
+	usersByAddress := map[string]string{}
+	if len(addresses) > 0 {
+		users, err := boiler.Users(
+			qm.Select(boiler.UserColumns.ID, boiler.UserColumns.PublicAddress),
+			qm.WhereIn(fmt.Sprintf("LOWER(%s) IN ?", boiler.UserTableColumns.PublicAddress), addresses...),
+		).All(passdb.StdConn)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			usersByAddress[strings.ToLower(user.PublicAddress.String)] = user.ID
+		}
+	}
+	for _, w := range wallets {
+		var h *holding
+		if userID, ok := usersByAddress[strings.ToLower(w.address)]; ok {
+			h = result.add(w.collectionID, null.StringFrom(userID), null.String{})
+		} else {
+			h = result.add(w.collectionID, null.String{}, null.StringFrom(common.HexToAddress(w.address).Hex()))
+		}
+		if w.staked {
+			h.Staked++
+		} else {
+			h.Minted++
+		}
+	}
+
+	return result, nil
+}
+
+// TakeHolderSnapshot counts the holders and freezes them into the snapshot
+func TakeHolderSnapshot(snapshot *boiler.HolderSnapshot, blockTime BlockTimeFunc) error {
+	var result holdings
+	var err error
+	if snapshot.BlockNumber.Valid || (snapshot.SnapshotAt.Valid && snapshot.SnapshotAt.Time.Before(snapshot.CreatedAt)) {
+		result, err = historicHoldings(snapshot, blockTime)
+	} else {
+		result, err = liveHoldings(snapshot)
+	}
+	if err != nil {
+		return err
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	holders := map[string]bool{}
+	assetCount := 0
+	for key, h := range result {
+		total := h.Custodial + h.Minted + h.Staked
+		if total == 0 {
+			continue
+		}
+		entry := &boiler.HolderSnapshotEntry{
+			SnapshotID:     snapshot.ID,
+			CollectionID:   h.CollectionID,
+			UserID:         h.UserID,
+			PublicAddress:  h.PublicAddress,
+			CustodialCount: h.Custodial,
+			MintedCount:    h.Minted,
+			StakedCount:    h.Staked,
+			TotalCount:     total,
+		}
+		err = entry.Insert(tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+		holders[strings.TrimPrefix(key, h.CollectionID+":")] = true
+		assetCount += total
+	}
+
+	snapshot.Status = HolderSnapshotComplete
+	snapshot.HolderCount = len(holders)
+	snapshot.AssetCount = assetCount
+	snapshot.CompletedAt = null.TimeFrom(time.Now())
+	_, err = snapshot.Update(tx, boil.Whitelist(
+		boiler.HolderSnapshotColumns.Status,
+		boiler.HolderSnapshotColumns.HolderCount,
+		boiler.HolderSnapshotColumns.AssetCount,
+		boiler.HolderSnapshotColumns.CompletedAt,
+	))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RunHolderSnapshots takes pending snapshots once their time has come
+func RunHolderSnapshots(blockTime BlockTimeFunc) {
+	ticker := time.NewTicker(time.Minute)
+	for range ticker.C {
+		snapshots, err := boiler.HolderSnapshots(
+			boiler.HolderSnapshotWhere.Status.EQ(HolderSnapshotPending),
+			qm.Expr(boiler.HolderSnapshotWhere.SnapshotAt.IsNull(), qm.Or2(boiler.HolderSnapshotWhere.SnapshotAt.LTE(null.TimeFrom(time.Now())))),
+			qm.OrderBy(boiler.HolderSnapshotColumns.CreatedAt),
+		).All(passdb.StdConn)
+		if err != nil {
+			passlog.L.Error().Err(err).Msg("failed to get pending holder snapshots")
+			continue
+		}
+
+		for _, snapshot := range snapshots {
+			l := passlog.L.With().Str("snapshot_id", snapshot.ID).Logger()
+			err = TakeHolderSnapshot(snapshot, blockTime)
+			if err == nil {
+				l.Info().Msg("took holder snapshot")
+				continue
+			}
+
+			l.Error().Err(err).Msg("failed to take holder snapshot")
+			snapshot.Status = HolderSnapshotFailed
+			snapshot.Error = null.StringFrom(err.Error())
+			_, err = snapshot.Update(passdb.StdConn, boil.Whitelist(boiler.HolderSnapshotColumns.Status, boiler.HolderSnapshotColumns.Error))
+			if err != nil {
+				l.Error().Err(err).Msg("failed to mark holder snapshot as failed")
+			}
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package asset
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gofrs/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestSnapshotTime(t *testing.T) {
+	mined := time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+	var gotChain string
+	var gotBlock int64
+	blockTime := func(chain string, blockNumber int64) (time.Time, error) {
+		gotChain, gotBlock = chain, blockNumber
+		return mined, nil
+	}
+	eth := &boiler.Collection{Name: "eth", Chain: null.StringFrom(db.CollectionChainETH)}
+	bsc := &boiler.Collection{Name: "bsc", Chain: null.StringFrom(db.CollectionChainBSC)}
+
+	t.Run("a timed snapshot is at its time", func(t *testing.T) {
+		at := time.Now()
+		got, err := snapshotTime(&boiler.HolderSnapshot{SnapshotAt: null.TimeFrom(at)}, boiler.CollectionSlice{eth, bsc}, nil)
+		if err != nil || !got.Equal(at) {
+			t.Errorf("snapshotTime() = %s, %v, want %s", got, err, at)
+		}
+	})
+
+	t.Run("a block snapshot is when the block was mined", func(t *testing.T) {
+		got, err := snapshotTime(&boiler.HolderSnapshot{BlockNumber: null.Int64From(1234)}, boiler.CollectionSlice{eth, eth}, blockTime)
+		if err != nil || !got.Equal(mined) {
+			t.Errorf("snapshotTime() = %s, %v, want %s", got, err, mined)
+		}
+		if gotChain != db.CollectionChainETH || gotBlock != 1234 {
+			t.Errorf("looked up block %d on %s, want 1234 on %s", gotBlock, gotChain, db.CollectionChainETH)
+		}
+	})
+
+	errs := []struct {
+		name        string
+		collections boiler.CollectionSlice
+		blockTime   BlockTimeFunc
+	}{
+		{"collections on different chains", boiler.CollectionSlice{eth, bsc}, blockTime},
+		{"collection without a chain", boiler.CollectionSlice{eth, {Name: "none"}}, blockTime},
+		{"no block times", boiler.CollectionSlice{eth}, nil},
+		{"block lookup fails", boiler.CollectionSlice{eth}, func(string, int64) (time.Time, error) { return time.Time{}, errors.New("node down") }},
+	}
+	for _, tt := range errs {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := snapshotTime(&boiler.HolderSnapshot{BlockNumber: null.Int64From(1234)}, tt.collections, tt.blockTime)
+			if err == nil {
+				t.Errorf("snapshotTime() succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestHistoricHoldings(t *testing.T) {
+	passdbtest.Require(t)
+
+	address := func() string {
+		return common.BytesToAddress(uuid.Must(uuid.NewV4()).Bytes()).Hex()
+	}
+
+	collection := passdbtest.Collection(t)
+	collection.Chain = null.StringFrom(db.CollectionChainETH)
+	collection.StakeContract = null.StringFrom(address())
+	_, err := collection.Update(passdb.StdConn, boil.Whitelist(boiler.CollectionColumns.Chain, boiler.CollectionColumns.StakeContract))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// send records an on chain transfer of the asset
+	send := func(t *testing.T, userAsset *boiler.UserAsset, from string, to string, blockNumber int) {
+		t.Helper()
+		tx := &boiler.ItemOnchainTransaction{
+			CollectionID:    collection.ID,
+			ExternalTokenID: int(userAsset.TokenID),
+			TXID:            fmt.Sprintf("0x%s", uuid.Must(uuid.NewV4())),
+			ContractAddr:    collection.MintContract.String,
+			FromAddr:        from,
+			ToAddr:          to,
+			BlockNumber:     blockNumber,
+			BlockTimestamp:  time.Now(),
+		}
+		err := tx.Insert(passdb.StdConn, boil.Infer())
+		if err != nil {
+			t.Fatalf("failed to insert on chain transaction: %s", err)
+		}
+	}
+
+	owner := passdbtest.User(t)
+	zero := common.Address{}.Hex()
+	wallet := address()
+	staker := address()
+
+	minted := passdbtest.Asset(t, collection, owner)
+	send(t, minted, zero, wallet, 10)
+	staked := passdbtest.Asset(t, collection, owner)
+	send(t, staked, zero, staker, 10)
+	send(t, staked, staker, collection.StakeContract.String, 11)
+	// only the stake is synced, so who staked it isn't known
+	unknownStaker := passdbtest.Asset(t, collection, owner)
+	send(t, unknownStaker, address(), collection.StakeContract.String, 12)
+	// minted after the snapshot block, so still on xsyn at it
+	custodial := passdbtest.Asset(t, collection, owner)
+	send(t, custodial, zero, address(), 25)
+
+	var lookedUp int64
+	snapshot := &boiler.HolderSnapshot{
+		CollectionIds: []string{collection.ID},
+		TraitFilters:  []byte("[]"),
+		BlockNumber:   null.Int64From(20),
+	}
+	result, err := historicHoldings(snapshot, func(chain string, blockNumber int64) (time.Time, error) {
+		lookedUp = blockNumber
+		return time.Now(), nil
+	})
+	if err != nil {
+		t.Fatalf("failed to get holdings: %s", err)
+	}
+	if lookedUp != 20 {
+		t.Errorf("looked up the time of block %d, want 20", lookedUp)
+	}
+
+	want := map[string]holding{
+		owner.ID:                {Custodial: 1},
+		strings.ToLower(wallet): {Minted: 1},
+		strings.ToLower(staker): {Staked: 1},
+	}
+	if len(result) != len(want) {
+		t.Errorf("got %d holders, want %d", len(result), len(want))
+	}
+	for key, w := range want {
+		h, ok := result[collection.ID+":"+key]
+		if !ok {
+			t.Errorf("%s holds nothing", key)
+			continue
+		}
+		if h.Custodial != w.Custodial || h.Minted != w.Minted || h.Staked != w.Staked {
+			t.Errorf("%s holds %d custodial, %d minted and %d staked, want %d, %d and %d", key, h.Custodial, h.Minted, h.Staked, w.Custodial, w.Minted, w.Staked)
+		}
+	}
+}
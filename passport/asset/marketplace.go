@@ -0,0 +1,426 @@
+package asset
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passdb"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	ListingTypeFixedPrice = "FIXED_PRICE"
+	ListingTypeAuction    = "AUCTION"
+)
+
+const (
+	ListingStatusActive   = "ACTIVE"
+	ListingStatusSold     = "SOLD"
+	ListingStatusCanceled = "CANCELED"
+	ListingStatusExpired  = "EXPIRED"
+)
+
+const ListingMaxDuration = 30 * 24 * time.Hour
+
+var ErrListingNotActive = fmt.Errorf("listing is no longer active")
+
+// MarketplaceFee is the cut of a sale that goes to the treasury
+func MarketplaceFee(price decimal.Decimal) decimal.Decimal {
+	pct := db.GetDecimalWithDefault(db.KeyMarketplaceFeePercentage, decimal.NewFromFloat(0.025))
+	return price.Mul(pct).Floor()
+}
+
+// MarketplaceMinBid is the lowest bid an auction accepts
+func MarketplaceMinBid(listing *boiler.MarketplaceListing) decimal.Decimal {
+	if !listing.CurrentBid.Valid {
+		return listing.Price
+	}
+	increment := db.GetDecimalWithDefault(db.KeyMarketplaceMinBidIncrement, decimal.NewFromFloat(0.05))
+	return listing.CurrentBid.Decimal.Add(listing.CurrentBid.Decimal.Mul(increment)).Ceil()
+}
+
+// CreateListing locks the asset to the marketplace and lists it.
+// 721s are locked through LockedToService so they can't be equipped, transferred or minted,
+// 1155s are moved into a row held by the marketplace service.
+func CreateListing(sellerID string, listingType string, item *TradeItemRequest, price decimal.Decimal, expiresAt time.Time) (*boiler.MarketplaceListing, error) {
+	if listingType != ListingTypeFixedPrice && listingType != ListingTypeAuction {
+		return nil, fmt.Errorf("invalid listing type %s", listingType)
+	}
+	if price.LessThanOrEqual(decimal.Zero) {
+		return nil, fmt.Errorf("price must be above 0")
+	}
+	if !expiresAt.After(time.Now()) || expiresAt.After(time.Now().Add(ListingMaxDuration)) {
+		return nil, fmt.Errorf("expiry must be within %s", ListingMaxDuration)
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	listing := &boiler.MarketplaceListing{
+		SellerID:    sellerID,
+		ListingType: listingType,
+		Amount:      1,
+		Price:       price,
+		Status:      ListingStatusActive,
+		ExpiresAt:   expiresAt,
+	}
+
+	switch {
+	case item.Hash != "":
+		userAsset, err := boiler.UserAssets(
+			boiler.UserAssetWhere.Hash.EQ(item.Hash),
+			qm.For("UPDATE"),
+		).One(tx)
+		if err != nil {
+			return nil, fmt.Errorf("asset %s: %w", item.Hash, err)
+		}
+		err = CheckTradableAsset(tx, userAsset, sellerID)
+		if err != nil {
+			return nil, err
+		}
+
+		userAsset.LockedToService = null.StringFrom(xsynTypes.XsynMarketplaceUserID.String())
+		_, err = userAsset.Update(tx, boil.Whitelist(boiler.UserAssetColumns.LockedToService))
+		if err != nil {
+			return nil, err
+		}
+		listing.UserAssetID = null.StringFrom(userAsset.ID)
+	case item.UserAsset1155ID != "":
+		from, err := boiler.UserAssets1155S(
+			boiler.UserAssets1155Where.ID.EQ(item.UserAsset1155ID),
+			qm.For("UPDATE"),
+		).One(tx)
+		if err != nil {
+			return nil, fmt.Errorf("1155 asset %s: %w", item.UserAsset1155ID, err)
+		}
+		err = CheckTradableAsset1155(from, sellerID, item.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = Move1155Tx(tx, from, sellerID, null.StringFrom(xsynTypes.XsynMarketplaceUserID.String()), item.Amount)
+		if err != nil {
+			return nil, err
+		}
+		listing.UserAsset1155ID = null.StringFrom(from.ID)
+		listing.Amount = item.Amount
+	default:
+		return nil, fmt.Errorf("listing needs a hash or 1155 asset id")
+	}
+
+	err = listing.Insert(tx, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return listing, nil
+}
+
+// ActiveListingForUpdate locks an active listing for the rest of the db transaction
+func ActiveListingForUpdate(tx boil.Executor, listingID string) (*boiler.MarketplaceListing, error) {
+	listing, err := boiler.MarketplaceListings(
+		boiler.MarketplaceListingWhere.ID.EQ(listingID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return nil, err
+	}
+	if listing.Status != ListingStatusActive {
+		return nil, ErrListingNotActive
+	}
+	return listing, nil
+}
+
+// listed1155 returns the marketplace held row of a 1155 listing
+func listed1155(tx boil.Executor, listing *boiler.MarketplaceListing) (*boiler.UserAssets1155, error) {
+	source, err := boiler.FindUserAssets1155(tx, listing.UserAsset1155ID.String)
+	if err != nil {
+		return nil, err
+	}
+	return boiler.UserAssets1155S(
+		boiler.UserAssets1155Where.OwnerID.EQ(listing.SellerID),
+		boiler.UserAssets1155Where.CollectionID.EQ(source.CollectionID),
+		boiler.UserAssets1155Where.ExternalTokenID.EQ(source.ExternalTokenID),
+		boiler.UserAssets1155Where.ServiceID.EQ(null.StringFrom(xsynTypes.XsynMarketplaceUserID.String())),
+		qm.For("UPDATE"),
+	).One(tx)
+}
+
+// ReleaseListingAsset gives the asset of a listing back to the seller
+func ReleaseListingAsset(tx boil.Executor, listing *boiler.MarketplaceListing) error {
+	if listing.UserAssetID.Valid {
+		userAsset, err := boiler.UserAssets(
+			boiler.UserAssetWhere.ID.EQ(listing.UserAssetID.String),
+			qm.For("UPDATE"),
+		).One(tx)
+		if err != nil {
+			return err
+		}
+		if userAsset.LockedToService.String != xsynTypes.XsynMarketplaceUserID.String() {
+			return fmt.Errorf("asset %s is not locked to the marketplace", userAsset.Hash)
+		}
+		userAsset.LockedToService = null.String{}
+		_, err = userAsset.Update(tx, boil.Whitelist(boiler.UserAssetColumns.LockedToService))
+		return err
+	}
+
+	held, err := listed1155(tx, listing)
+	if err != nil {
+		return err
+	}
+	_, err = Move1155Tx(tx, held, listing.SellerID, null.String{}, listing.Amount)
+	return err
+}
+
+// DeliverListingAsset unlocks the asset of a listing and moves it to the buyer.
+// The transfer event is returned for 721s so the gameserver can be told about the new owner.
+func DeliverListingAsset(tx boil.Executor, listing *boiler.MarketplaceListing, buyerID string, relatedTransactionID null.String) (*boiler.AssetTransferEvent, error) {
+	if listing.UserAssetID.Valid {
+		userAsset, err := boiler.UserAssets(
+			boiler.UserAssetWhere.ID.EQ(listing.UserAssetID.String),
+			qm.For("UPDATE"),
+		).One(tx)
+		if err != nil {
+			return nil, err
+		}
+		if userAsset.OwnerID != listing.SellerID || userAsset.LockedToService.String != xsynTypes.XsynMarketplaceUserID.String() {
+			return nil, fmt.Errorf("asset %s is no longer held by the marketplace", userAsset.Hash)
+		}
+		userAsset.LockedToService = null.String{}
+		return TransferAssetTx(tx, userAsset, listing.SellerID, buyerID, xsynTypes.XsynMarketplaceUserID.String(), relatedTransactionID)
+	}
+
+	held, err := listed1155(tx, listing)
+	if err != nil {
+		return nil, err
+	}
+	_, err = Move1155Tx(tx, held, buyerID, null.String{}, listing.Amount)
+	return nil, err
+}
+
+// CloseListing releases the asset of an active listing back to the seller and sets its final status
+func CloseListing(listingID string, status string) (*boiler.MarketplaceListing, error) {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	listing, err := ActiveListingForUpdate(tx, listingID)
+	if err != nil {
+		return nil, err
+	}
+	if listing.CurrentBidderID.Valid {
+		return nil, fmt.Errorf("listing has bids")
+	}
+
+	err = ReleaseListingAsset(tx, listing)
+	if err != nil {
+		return nil, err
+	}
+
+	listing.Status = status
+	listing.CompletedAt = null.TimeFrom(time.Now())
+	_, err = listing.Update(tx, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return listing, nil
+}
+
+type ListingSearch struct {
+	ListingType    string          `json:"listing_type"`
+	CollectionSlug string          `json:"collection_slug"`
+	SellerID       string          `json:"seller_id"`
+	Search         string          `json:"search"`
+	MinPrice       decimal.Decimal `json:"min_price"`
+	MaxPrice       decimal.Decimal `json:"max_price"`
+	// price, created_at or expires_at
+	SortBy   string `json:"sort_by"`
+	SortDir  string `json:"sort_dir"`
+	PageSize int    `json:"page_size"`
+	Page     int    `json:"page"`
+}
+
+// SearchListings returns the active listings matching the search along with the total count
+func SearchListings(search *ListingSearch) (boiler.MarketplaceListingSlice, int64, error) {
+	queries := []qm.QueryMod{
+		boiler.MarketplaceListingWhere.Status.EQ(ListingStatusActive),
+		boiler.MarketplaceListingWhere.ExpiresAt.GT(time.Now()),
+		qm.LeftOuterJoin(fmt.Sprintf("%s ON %s = %s",
+			boiler.TableNames.UserAssets,
+			qm.Rels(boiler.TableNames.UserAssets, boiler.UserAssetColumns.ID),
+			qm.Rels(boiler.TableNames.MarketplaceListings, boiler.MarketplaceListingColumns.UserAssetID),
+		)),
+		qm.LeftOuterJoin(fmt.Sprintf("%s ON %s = %s",
+			boiler.TableNames.UserAssets1155,
+			qm.Rels(boiler.TableNames.UserAssets1155, boiler.UserAssets1155Columns.ID),
+			qm.Rels(boiler.TableNames.MarketplaceListings, boiler.MarketplaceListingColumns.UserAsset1155ID),
+		)),
+	}
+
+	if search.ListingType != "" {
+		queries = append(queries, boiler.MarketplaceListingWhere.ListingType.EQ(search.ListingType))
+	}
+	if search.SellerID != "" {
+		queries = append(queries, boiler.MarketplaceListingWhere.SellerID.EQ(search.SellerID))
+	}
+	if search.CollectionSlug != "" {
+		collection, err := db.CollectionBySlug(search.CollectionSlug)
+		if err != nil {
+			return nil, 0, err
+		}
+		queries = append(queries, qm.Where(
+			fmt.Sprintf("COALESCE(%s, %s) = ?",
+				qm.Rels(boiler.TableNames.UserAssets, boiler.UserAssetColumns.CollectionID),
+				qm.Rels(boiler.TableNames.UserAssets1155, boiler.UserAssets1155Columns.CollectionID),
+			),
+			collection.ID,
+		))
+	}
+	if search.Search != "" {
+		queries = append(queries, qm.Where(
+			fmt.Sprintf("COALESCE(%s, %s) ILIKE ?",
+				qm.Rels(boiler.TableNames.UserAssets, boiler.UserAssetColumns.Name),
+				qm.Rels(boiler.TableNames.UserAssets1155, boiler.UserAssets1155Columns.Label),
+			),
+			"%"+search.Search+"%",
+		))
+	}
+
+	// auctions are searched by their current bid
+	priceColumn := fmt.Sprintf("COALESCE(%s, %s)",
+		qm.Rels(boiler.TableNames.MarketplaceListings, boiler.MarketplaceListingColumns.CurrentBid),
+		qm.Rels(boiler.TableNames.MarketplaceListings, boiler.MarketplaceListingColumns.Price),
+	)
+	if search.MinPrice.GreaterThan(decimal.Zero) {
+		queries = append(queries, qm.Where(priceColumn+" >= ?", search.MinPrice))
+	}
+	if search.MaxPrice.GreaterThan(decimal.Zero) {
+		queries = append(queries, qm.Where(priceColumn+" <= ?", search.MaxPrice))
+	}
+
+	total, err := boiler.MarketplaceListings(queries...).Count(passdb.StdConn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sortColumn := qm.Rels(boiler.TableNames.MarketplaceListings, boiler.MarketplaceListingColumns.CreatedAt)
+	switch search.SortBy {
+	case "price":
+		sortColumn = priceColumn
+	case "expires_at":
+		sortColumn = qm.Rels(boiler.TableNames.MarketplaceListings, boiler.MarketplaceListingColumns.ExpiresAt)
+	}
+	sortDir := "DESC"
+	if strings.EqualFold(search.SortDir, "asc") {
+		sortDir = "ASC"
+	}
+
+	if search.PageSize <= 0 || search.PageSize > 100 {
+		search.PageSize = 20
+	}
+	if search.Page < 0 {
+		search.Page = 0
+	}
+
+	queries = append(queries,
+		qm.Select(qm.Rels(boiler.TableNames.MarketplaceListings, "*")),
+		qm.OrderBy(fmt.Sprintf("%s %s", sortColumn, sortDir)),
+		qm.Limit(search.PageSize),
+		qm.Offset(search.Page*search.PageSize),
+		qm.Load(boiler.MarketplaceListingRels.Seller, qm.Select(boiler.UserColumns.ID, boiler.UserColumns.Username)),
+		qm.Load(boiler.MarketplaceListingRels.UserAsset),
+		qm.Load(boiler.MarketplaceListingRels.UserAsset1155),
+	)
+
+	listings, err := boiler.MarketplaceListings(queries...).All(passdb.StdConn)
+	if err != nil {
+		return nil, 0, err
+	}
+	return listings, total, nil
+}
+
+// MarkBidRefundDue records that a held bid has to go back to the bidder, call it in the same db transaction as whatever beat or ended the bid
+func MarkBidRefundDue(tx boil.Executor, bid *boiler.MarketplaceBid, reason string) error {
+	bid.RefundDueAt = null.TimeFrom(time.Now())
+	bid.RefundReason = null.StringFrom(reason)
+	_, err := bid.Update(tx, boil.Whitelist(
+		boiler.MarketplaceBidColumns.RefundDueAt,
+		boiler.MarketplaceBidColumns.RefundReason,
+	))
+	return err
+}
+
+// RefundBid returns a bid that was marked refund due to the bidder.
+// The refund has a ledger reference per bid, so it can be run again after a failure or a restart.
+func RefundBid(ucm Transactor, bidID string) (*boiler.MarketplaceBid, error) {
+	bid, err := boiler.FindMarketplaceBid(passdb.StdConn, bidID)
+	if err != nil {
+		return nil, err
+	}
+	if bid.RefundTXID.Valid {
+		return bid, nil
+	}
+	if !bid.RefundDueAt.Valid {
+		return nil, fmt.Errorf("bid %s is not due a refund", bid.ID)
+	}
+
+	marketplace, err := boiler.FindUser(passdb.StdConn, xsynTypes.XsynMarketplaceUserID.String())
+	if err != nil {
+		return nil, err
+	}
+	bidder, err := boiler.FindUser(passdb.StdConn, bid.BidderID)
+	if err != nil {
+		return nil, err
+	}
+
+	refundID, err := TransactOnce(ucm, &xsynTypes.NewTransaction{
+		DebitAccountID:       marketplace.AccountID,
+		CreditAccountID:      bidder.AccountID,
+		TransactionReference: xsynTypes.TransactionReference(fmt.Sprintf("REFUND - marketplace_bid|%s|%s", bid.ListingID, bid.ID)),
+		Description:          fmt.Sprintf("Refund of bid on marketplace listing %s. Reason: %s", bid.ListingID, bid.RefundReason.String),
+		Amount:               bid.Amount,
+		Group:                xsynTypes.TransactionGroupMarketplace,
+		SubGroup:             xsynTypes.TransactionSubGroupRefund,
+		RelatedTransactionID: null.StringFrom(bid.TXID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bid.RefundTXID = null.StringFrom(refundID)
+	_, err = bid.Update(passdb.StdConn, boil.Whitelist(boiler.MarketplaceBidColumns.RefundTXID))
+	if err != nil {
+		return nil, err
+	}
+	return bid, nil
+}
+
+// BidsRefundDue returns the bids marked refund due that haven't been refunded yet, oldest first
+func BidsRefundDue() (boiler.MarketplaceBidSlice, error) {
+	return boiler.MarketplaceBids(
+		boiler.MarketplaceBidWhere.RefundDueAt.IsNotNull(),
+		boiler.MarketplaceBidWhere.RefundTXID.IsNull(),
+		qm.OrderBy(boiler.MarketplaceBidColumns.RefundDueAt),
+	).All(passdb.StdConn)
+}
@@ -0,0 +1,146 @@
+package asset_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+	"xsyn-services/types"
+
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestBidRefunds(t *testing.T) {
+	passdbtest.Require(t)
+
+	collection := passdbtest.Collection(t)
+	marketplace, err := boiler.FindUser(passdb.StdConn, types.XsynMarketplaceUserID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sups := decimal.New(100, 18)
+
+	// bid holds amount of the bidder's SUPS with the marketplace like BidHandler does
+	bid := func(t *testing.T, ucm asset.Transactor, listing *boiler.MarketplaceListing, bidder *boiler.User, amount decimal.Decimal) *boiler.MarketplaceBid {
+		t.Helper()
+		txID, err := ucm.Transact(&types.NewTransaction{
+			DebitAccountID:       bidder.AccountID,
+			CreditAccountID:      marketplace.AccountID,
+			TransactionReference: types.TransactionReference(fmt.Sprintf("marketplace_bid|%s|%d", listing.ID, time.Now().UnixNano())),
+			Description:          "test bid",
+			Amount:               amount,
+			Group:                types.TransactionGroupMarketplace,
+			SubGroup:             types.TransactionSubGroupBid,
+		})
+		if err != nil {
+			t.Fatalf("failed to hold bid: %s", err)
+		}
+		b := &boiler.MarketplaceBid{
+			ListingID: listing.ID,
+			BidderID:  bidder.ID,
+			Amount:    amount,
+			TXID:      txID,
+		}
+		err = b.Insert(passdb.StdConn, boil.Infer())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	auction := func(t *testing.T) *boiler.MarketplaceListing {
+		t.Helper()
+		seller := passdbtest.User(t)
+		userAsset := passdbtest.Asset(t, collection, seller)
+		listing, err := asset.CreateListing(seller.ID, asset.ListingTypeAuction, &asset.TradeItemRequest{Hash: userAsset.Hash}, decimal.New(10, 18), time.Now().Add(time.Hour))
+		if err != nil {
+			t.Fatalf("failed to list asset: %s", err)
+		}
+		return listing
+	}
+
+	t.Run("outbid refund is made once from the record", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		listing := auction(t)
+		bidder := passdbtest.User(t)
+		passdbtest.Fund(t, bidder, sups)
+		outbid := bid(t, ucm, listing, bidder, decimal.New(20, 18))
+
+		tx, err := passdb.StdConn.Begin()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = asset.MarkBidRefundDue(tx, outbid, "outbid")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = tx.Commit()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		due, err := asset.BidsRefundDue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		found := false
+		for _, b := range due {
+			found = found || b.ID == outbid.ID
+		}
+		if !found {
+			t.Fatalf("outbid bid is not due a refund")
+		}
+
+		// the first refund fails, the retry makes it
+		ucm.Fail = true
+		_, err = asset.RefundBid(ucm, outbid.ID)
+		if err == nil {
+			t.Fatalf("refund made by a failing transactor")
+		}
+		ucm.Fail = false
+		for i := 0; i < 2; i++ {
+			refunded, err := asset.RefundBid(ucm, outbid.ID)
+			if err != nil {
+				t.Fatalf("failed to refund bid: %s", err)
+			}
+			if !refunded.RefundTXID.Valid {
+				t.Errorf("refund not recorded on the bid")
+			}
+		}
+		if got := passdbtest.Balance(t, bidder); !got.Equal(sups) {
+			t.Errorf("bidder balance = %s, want %s", got, sups)
+		}
+	})
+
+	t.Run("rolled back bid leaves the previous bid held", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		listing := auction(t)
+		bidder := passdbtest.User(t)
+		passdbtest.Fund(t, bidder, sups)
+		held := bid(t, ucm, listing, bidder, decimal.New(20, 18))
+
+		tx, err := passdb.StdConn.Begin()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = asset.MarkBidRefundDue(tx, held, "outbid")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = tx.Rollback()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = asset.RefundBid(ucm, held.ID)
+		if err == nil {
+			t.Errorf("refunded a bid that was never marked refund due")
+		}
+		if got := passdbtest.Balance(t, bidder); !got.Equal(decimal.New(80, 18)) {
+			t.Errorf("bidder balance = %s, want 80 SUPS", got)
+		}
+	})
+}
@@ -0,0 +1,218 @@
+package asset
+
+import (
+	"fmt"
+	"math"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+	"xsyn-services/passport/supremacy_rpcclient"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	MetadataRefreshPriorityStale     = 0
+	MetadataRefreshPriorityRequested = 10
+)
+
+// MetadataStaleAfter is how old asset metadata can get before it is queued for a refresh
+const MetadataStaleAfter = 24 * time.Hour
+
+const metadataRefreshInterval = 10 * time.Second
+
+// metadataRefreshClaim keeps a claimed item from being picked up again while its batch is in flight
+const metadataRefreshClaim = 5 * time.Minute
+
+// EnqueueMetadataRefresh queues an asset for a refresh, asking again for a queued asset only raises its priority
+func EnqueueMetadataRefresh(exec boil.Executor, userAssetID string, priority int) error {
+	q := `
+		INSERT INTO asset_metadata_refreshes (user_asset_id, priority)
+		VALUES ($1, $2)
+		ON CONFLICT (user_asset_id)
+		DO UPDATE SET priority = GREATEST(asset_metadata_refreshes.priority, EXCLUDED.priority)`
+	_, err := exec.Exec(q, userAssetID, priority)
+	return err
+}
+
+// enqueueStaleAssets queues the assets that have gone the longest without a refresh
+func enqueueStaleAssets(limit int) error {
+	q := `
+		INSERT INTO asset_metadata_refreshes (user_asset_id, priority)
+		SELECT id, $1
+		FROM user_assets
+		WHERE data_refreshed_at < $2
+		  AND deleted_at IS NULL
+		  AND NOT EXISTS (SELECT 1 FROM asset_metadata_refreshes amr WHERE amr.user_asset_id = user_assets.id)
+		ORDER BY data_refreshed_at
+		LIMIT $3
+		ON CONFLICT (user_asset_id) DO NOTHING`
+	_, err := passdb.StdConn.Exec(q, MetadataRefreshPriorityStale, time.Now().Add(-MetadataStaleAfter), limit)
+	return err
+}
+
+// claimMetadataRefreshes takes the next batch off the queue, highest priority first
+func claimMetadataRefreshes(limit int) (boiler.AssetMetadataRefreshSlice, error) {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	items, err := boiler.AssetMetadataRefreshes(
+		boiler.AssetMetadataRefreshWhere.NextAttemptAt.LTE(time.Now()),
+		qm.OrderBy(fmt.Sprintf("%s DESC, %s", boiler.AssetMetadataRefreshColumns.Priority, boiler.AssetMetadataRefreshColumns.RequestedAt)),
+		qm.Limit(limit),
+		qm.Load(boiler.AssetMetadataRefreshRels.UserAsset),
+		qm.For("UPDATE SKIP LOCKED"),
+	).All(tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return items, nil
+	}
+
+	_, err = items.UpdateAll(tx, boiler.M{
+		boiler.AssetMetadataRefreshColumns.NextAttemptAt: time.Now().Add(metadataRefreshClaim),
+		boiler.AssetMetadataRefreshColumns.LastAttemptAt: null.TimeFrom(time.Now()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return items, tx.Commit()
+}
+
+// metadataRefreshFailed records why a refresh failed and backs the item off, doubling from a minute up to a day
+func metadataRefreshFailed(item *boiler.AssetMetadataRefresh, reason string) {
+	item.Attempts++
+	item.LastError = null.StringFrom(reason)
+	backoff := time.Duration(math.Min(math.Pow(2, float64(item.Attempts-1)), 24*60)) * time.Minute
+	item.NextAttemptAt = time.Now().Add(backoff)
+	_, err := item.Update(passdb.StdConn, boil.Whitelist(
+		boiler.AssetMetadataRefreshColumns.Attempts,
+		boiler.AssetMetadataRefreshColumns.LastError,
+		boiler.AssetMetadataRefreshColumns.NextAttemptAt,
+	))
+	if err != nil {
+		passlog.L.Error().Err(err).Str("user_asset_id", item.UserAssetID).Msg("failed to record metadata refresh failure")
+	}
+}
+
+// refreshMetadataBatch refreshes up to limit queued assets with one call to supremacy.
+// It returns how many items were taken off the queue and an error if supremacy couldn't be reached.
+func refreshMetadataBatch(limit int) (int, error) {
+	items, err := claimMetadataRefreshes(limit)
+	if err != nil {
+		return 0, err
+	}
+	if len(items) == 0 {
+		return 0, nil
+	}
+
+	// burned and deleted assets aren't loaded, they have nothing left to refresh
+	live := boiler.AssetMetadataRefreshSlice{}
+	hashes := []string{}
+	for _, item := range items {
+		if item.R == nil || item.R.UserAsset == nil {
+			_, err = item.Delete(passdb.StdConn)
+			if err != nil {
+				passlog.L.Error().Err(err).Str("user_asset_id", item.UserAssetID).Msg("failed to remove deleted asset from queue")
+			}
+			continue
+		}
+		live = append(live, item)
+		hashes = append(hashes, item.R.UserAsset.Hash)
+	}
+	if len(live) == 0 {
+		return len(items), nil
+	}
+
+	resp, err := supremacy_rpcclient.AssetsGet(hashes)
+	if err != nil {
+		for _, item := range live {
+			metadataRefreshFailed(item, err.Error())
+		}
+		return len(items), err
+	}
+
+	refreshed := map[string]*supremacy_rpcclient.XsynAsset{}
+	for _, xsynAsset := range resp.Assets {
+		refreshed[xsynAsset.Hash] = xsynAsset
+	}
+
+	for _, item := range live {
+		xsynAsset, ok := refreshed[item.R.UserAsset.Hash]
+		if !ok {
+			reason, ok := resp.Failed[item.R.UserAsset.Hash]
+			if !ok {
+				reason = "asset not returned by supremacy"
+			}
+			metadataRefreshFailed(item, reason)
+			continue
+		}
+
+		_, err = db.UpdateUserAsset(xsynAsset, false, db.MetadataSourceRefresh)
+		if err != nil {
+			metadataRefreshFailed(item, err.Error())
+			continue
+		}
+
+		_, err = item.Delete(passdb.StdConn)
+		if err != nil {
+			passlog.L.Error().Err(err).Str("user_asset_id", item.UserAssetID).Msg("failed to remove refreshed asset from queue")
+		}
+	}
+
+	return len(items), nil
+}
+
+// RunMetadataRefresh works through the metadata refresh queue in the background.
+// Calls to supremacy are kept under metadata_refresh_per_minute, and if it can't be reached the worker backs off for up to 5 minutes.
+func RunMetadataRefresh() {
+	l := passlog.L.With().Str("svc", "metadata_refresh").Logger()
+	ticker := time.NewTicker(metadataRefreshInterval)
+	skip, failures := 0, 0
+	for range ticker.C {
+		if skip > 0 {
+			skip--
+			continue
+		}
+
+		batchSize := db.GetIntWithDefault(db.KeyMetadataRefreshBatchSize, 50)
+		budget := db.GetIntWithDefault(db.KeyMetadataRefreshPerMinute, 300) * int(metadataRefreshInterval.Seconds()) / 60
+		if batchSize <= 0 || budget <= 0 {
+			continue
+		}
+
+		err := enqueueStaleAssets(budget)
+		if err != nil {
+			l.Error().Err(err).Msg("failed to queue stale assets")
+		}
+
+		for budget > 0 {
+			limit := batchSize
+			if limit > budget {
+				limit = budget
+			}
+
+			count, err := refreshMetadataBatch(limit)
+			if err != nil {
+				failures++
+				skip = int(math.Min(math.Pow(2, float64(failures)), 30))
+				l.Warn().Err(err).Int("failures", failures).Int("skip_ticks", skip).Msg("metadata refresh batch failed")
+				break
+			}
+			failures = 0
+			budget -= count
+			if count < limit {
+				break
+			}
+		}
+	}
+}
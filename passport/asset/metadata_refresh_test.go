@@ -0,0 +1,43 @@
+package asset
+
+import (
+	"testing"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestRefreshMetadataBatchDeletedAsset(t *testing.T) {
+	passdbtest.Require(t)
+
+	userAsset := passdbtest.Asset(t, passdbtest.Collection(t), passdbtest.User(t))
+	err := EnqueueMetadataRefresh(passdb.StdConn, userAsset.ID, MetadataRefreshPriorityRequested)
+	if err != nil {
+		t.Fatalf("failed to queue refresh: %s", err)
+	}
+	userAsset.DeletedAt = null.TimeFrom(time.Now())
+	_, err = userAsset.Update(passdb.StdConn, boil.Whitelist(boiler.UserAssetColumns.DeletedAt))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// nothing else is queued, so supremacy isn't asked for anything
+	n, err := refreshMetadataBatch(10)
+	if err != nil {
+		t.Fatalf("failed to refresh batch: %s", err)
+	}
+	if n != 1 {
+		t.Errorf("refreshMetadataBatch() took %d items, want 1", n)
+	}
+	queued, err := boiler.AssetMetadataRefreshExists(passdb.StdConn, userAsset.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if queued {
+		t.Errorf("deleted asset is still queued")
+	}
+}
@@ -0,0 +1,323 @@
+package asset
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+	"xsyn-services/passport/supremacy_rpcclient"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	ReconciliationRunPending  = "PENDING"
+	ReconciliationRunRunning  = "RUNNING"
+	ReconciliationRunComplete = "COMPLETE"
+	ReconciliationRunFailed   = "FAILED"
+)
+
+const (
+	DiscrepancyOwner    = "OWNER"
+	DiscrepancyLock     = "LOCK"
+	DiscrepancyMetadata = "METADATA"
+	DiscrepancyMissing  = "MISSING"
+)
+
+const (
+	DiscrepancyOpen      = "OPEN"
+	DiscrepancyRepaired  = "REPAIRED"
+	DiscrepancyDismissed = "DISMISSED"
+	DiscrepancyFailed    = "FAILED"
+)
+
+// The fixes for a discrepancy, each one goes through the path passport already uses to move or lock an asset
+const (
+	// FixPushOwner sends the gameserver a transfer to passport's owner
+	FixPushOwner = "PUSH_OWNER"
+	// FixAdoptGameserverOwner transfers the asset on passport to the gameserver's owner, for a transfer passport missed while the game held the asset
+	FixAdoptGameserverOwner = "ADOPT_GAMESERVER_OWNER"
+	// FixPushLock locks the asset to the gameserver, passport has it locked to supremacy
+	FixPushLock = "PUSH_LOCK"
+	// FixReleaseGameserverLock unlocks the asset from the gameserver, passport has it on xsyn
+	FixReleaseGameserverLock = "RELEASE_GAMESERVER_LOCK"
+	// FixRefreshMetadata queues the asset for a metadata refresh from the gameserver
+	FixRefreshMetadata = "REFRESH_METADATA"
+	// FixNone is a discrepancy an admin has to look into
+	FixNone = "NONE"
+)
+
+// discrepancyFixes are the fixes that repair each kind of discrepancy, a missing asset has none
+var discrepancyFixes = map[string][]string{
+	DiscrepancyOwner:    {FixPushOwner, FixAdoptGameserverOwner},
+	DiscrepancyLock:     {FixPushLock, FixReleaseGameserverLock},
+	DiscrepancyMetadata: {FixRefreshMetadata},
+}
+
+// FixFitsDiscrepancy says whether the fix repairs the kind of discrepancy
+func FixFitsDiscrepancy(kind string, fix string) bool {
+	for _, f := range discrepancyFixes[kind] {
+		if f == fix {
+			return true
+		}
+	}
+	return false
+}
+
+const reconciliationPageSize = 100
+
+// CreateReconciliationRun queues a run comparing the assets of the collections with the gameserver
+func CreateReconciliationRun(collectionIDs []string, createdByID string) (*boiler.ReconciliationRun, error) {
+	if len(collectionIDs) == 0 {
+		return nil, fmt.Errorf("at least one collection is required")
+	}
+	count, err := boiler.Collections(boiler.CollectionWhere.ID.IN(collectionIDs)).Count(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+	if int(count) != len(collectionIDs) {
+		return nil, fmt.Errorf("collection not found")
+	}
+
+	run := &boiler.ReconciliationRun{
+		CollectionIds: collectionIDs,
+		Status:        ReconciliationRunPending,
+		CreatedByID:   createdByID,
+	}
+	err = run.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// reconcileXsynAsset builds the asset passport would send the gameserver, to hash its metadata
+func reconcileXsynAsset(userAsset *boiler.UserAsset) (*supremacy_rpcclient.XsynAsset, error) {
+	attributes := []*xsynTypes.Attribute{}
+	err := json.Unmarshal(userAsset.Attributes, &attributes)
+	if err != nil {
+		return nil, err
+	}
+	return &supremacy_rpcclient.XsynAsset{
+		CollectionSlug:   userAsset.R.Collection.Slug,
+		TokenID:          userAsset.TokenID,
+		Tier:             userAsset.Tier,
+		Hash:             userAsset.Hash,
+		OwnerID:          userAsset.OwnerID,
+		Attributes:       attributes,
+		Name:             userAsset.Name,
+		AssetType:        userAsset.AssetType,
+		ImageURL:         userAsset.ImageURL,
+		ExternalURL:      userAsset.ExternalURL,
+		Description:      userAsset.Description,
+		BackgroundColor:  userAsset.BackgroundColor,
+		AnimationURL:     userAsset.AnimationURL,
+		YoutubeURL:       userAsset.YoutubeURL,
+		CardAnimationURL: userAsset.CardAnimationURL,
+		AvatarURL:        userAsset.AvatarURL,
+		LargeImageURL:    userAsset.LargeImageURL,
+	}, nil
+}
+
+// CompareWithGameserver lists how passport and the gameserver disagree about an asset, with a suggested fix for each.
+// gsAsset is nil when the gameserver has no asset for the hash.
+func CompareWithGameserver(userAsset *boiler.UserAsset, gsAsset *supremacy_rpcclient.ReconcileAsset) ([]*boiler.ReconciliationDiscrepancy, error) {
+	discrepancy := func(kind, passportValue, gameserverValue, fix string) *boiler.ReconciliationDiscrepancy {
+		return &boiler.ReconciliationDiscrepancy{
+			UserAssetID:     userAsset.ID,
+			AssetHash:       userAsset.Hash,
+			Kind:            kind,
+			PassportValue:   passportValue,
+			GameserverValue: gameserverValue,
+			SuggestedFix:    fix,
+		}
+	}
+
+	if gsAsset == nil {
+		return []*boiler.ReconciliationDiscrepancy{discrepancy(DiscrepancyMissing, userAsset.OwnerID, "", FixNone)}, nil
+	}
+
+	result := []*boiler.ReconciliationDiscrepancy{}
+	lockedToGame := userAsset.LockedToService.String == xsynTypes.SupremacyGameUserID.String()
+
+	if gsAsset.OwnerID != userAsset.OwnerID {
+		// the game can only move an asset it holds, anything else passport has the final say on
+		fix := FixPushOwner
+		if lockedToGame {
+			fix = FixAdoptGameserverOwner
+		}
+		result = append(result, discrepancy(DiscrepancyOwner, userAsset.OwnerID, gsAsset.OwnerID, fix))
+	}
+
+	if lockedToGame == gsAsset.XsynLocked {
+		passportValue, gameserverValue, fix := "SUPREMACY", "XSYN", FixPushLock
+		if !lockedToGame {
+			passportValue, gameserverValue, fix = userAsset.LockedToService.String, "SUPREMACY", FixReleaseGameserverLock
+			if gsAsset.EquippedOn.Valid {
+				gameserverValue = fmt.Sprintf("SUPREMACY, equipped on %s", gsAsset.EquippedOn.String)
+			}
+		}
+		result = append(result, discrepancy(DiscrepancyLock, passportValue, gameserverValue, fix))
+	}
+
+	xsynAsset, err := reconcileXsynAsset(userAsset)
+	if err != nil {
+		return nil, err
+	}
+	metadataHash, err := supremacy_rpcclient.AssetMetadataHash(xsynAsset)
+	if err != nil {
+		return nil, err
+	}
+	if metadataHash != gsAsset.MetadataHash {
+		result = append(result, discrepancy(DiscrepancyMetadata, metadataHash, gsAsset.MetadataHash, FixRefreshMetadata))
+	}
+
+	return result, nil
+}
+
+// GameserverAsset gets the gameserver's view of a single asset, nil when it doesn't have it
+func GameserverAsset(hash string) (*supremacy_rpcclient.ReconcileAsset, error) {
+	resp, err := supremacy_rpcclient.AssetsReconcile([]string{hash})
+	if err != nil {
+		return nil, err
+	}
+	for _, gsAsset := range resp.Assets {
+		if gsAsset.Hash == hash {
+			return gsAsset, nil
+		}
+	}
+	return nil, nil
+}
+
+// reconcilePage compares the next page of a run's assets with the gameserver and records what differs.
+// It returns false once the run has been through every asset.
+func reconcilePage(run *boiler.ReconciliationRun) (bool, error) {
+	userAssets, err := boiler.UserAssets(
+		boiler.UserAssetWhere.CollectionID.IN(run.CollectionIds),
+		boiler.UserAssetWhere.Hash.GT(run.LastHash),
+		qm.Load(boiler.UserAssetRels.Collection),
+		qm.OrderBy(boiler.UserAssetColumns.Hash),
+		qm.Limit(reconciliationPageSize),
+	).All(passdb.StdConn)
+	if err != nil {
+		return false, err
+	}
+	if len(userAssets) == 0 {
+		return false, nil
+	}
+
+	hashes := []string{}
+	for _, userAsset := range userAssets {
+		hashes = append(hashes, userAsset.Hash)
+	}
+	resp, err := supremacy_rpcclient.AssetsReconcile(hashes)
+	if err != nil {
+		return false, err
+	}
+	gsAssets := map[string]*supremacy_rpcclient.ReconcileAsset{}
+	for _, gsAsset := range resp.Assets {
+		gsAssets[gsAsset.Hash] = gsAsset
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	for _, userAsset := range userAssets {
+		discrepancies, err := CompareWithGameserver(userAsset, gsAssets[userAsset.Hash])
+		if err != nil {
+			return false, fmt.Errorf("compare %s: %w", userAsset.Hash, err)
+		}
+		for _, discrepancy := range discrepancies {
+			discrepancy.RunID = run.ID
+			err = discrepancy.Insert(tx, boil.Infer())
+			if err != nil {
+				return false, err
+			}
+		}
+		run.DiscrepancyCount += len(discrepancies)
+	}
+
+	run.CheckedCount += len(userAssets)
+	run.LastHash = userAssets[len(userAssets)-1].Hash
+	_, err = run.Update(tx, boil.Whitelist(
+		boiler.ReconciliationRunColumns.CheckedCount,
+		boiler.ReconciliationRunColumns.DiscrepancyCount,
+		boiler.ReconciliationRunColumns.LastHash,
+	))
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+	return len(userAssets) == reconciliationPageSize, nil
+}
+
+// Reconcile pages through a run's assets, a run that was interrupted carries on from the last page it recorded
+func Reconcile(run *boiler.ReconciliationRun) error {
+	if run.Status == ReconciliationRunPending {
+		run.Status = ReconciliationRunRunning
+		run.StartedAt = null.TimeFrom(time.Now())
+		_, err := run.Update(passdb.StdConn, boil.Whitelist(boiler.ReconciliationRunColumns.Status, boiler.ReconciliationRunColumns.StartedAt))
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		more, err := reconcilePage(run)
+		if err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+	}
+
+	run.Status = ReconciliationRunComplete
+	run.CompletedAt = null.TimeFrom(time.Now())
+	_, err := run.Update(passdb.StdConn, boil.Whitelist(boiler.ReconciliationRunColumns.Status, boiler.ReconciliationRunColumns.CompletedAt))
+	return err
+}
+
+// RunReconciliations works through queued reconciliation runs in the background
+func RunReconciliations() {
+	ticker := time.NewTicker(time.Minute)
+	for range ticker.C {
+		runs, err := boiler.ReconciliationRuns(
+			boiler.ReconciliationRunWhere.Status.IN([]string{ReconciliationRunPending, ReconciliationRunRunning}),
+			qm.OrderBy(boiler.ReconciliationRunColumns.CreatedAt),
+		).All(passdb.StdConn)
+		if err != nil {
+			passlog.L.Error().Err(err).Msg("failed to get pending reconciliation runs")
+			continue
+		}
+
+		for _, run := range runs {
+			l := passlog.L.With().Str("reconciliation_run_id", run.ID).Logger()
+			err = Reconcile(run)
+			if err == nil {
+				l.Info().Int("checked", run.CheckedCount).Int("discrepancies", run.DiscrepancyCount).Msg("reconciled assets with gameserver")
+				continue
+			}
+
+			l.Error().Err(err).Msg("failed to reconcile assets with gameserver")
+			run.Status = ReconciliationRunFailed
+			run.Error = null.StringFrom(err.Error())
+			_, err = run.Update(passdb.StdConn, boil.Whitelist(boiler.ReconciliationRunColumns.Status, boiler.ReconciliationRunColumns.Error))
+			if err != nil {
+				l.Error().Err(err).Msg("failed to mark reconciliation run as failed")
+			}
+		}
+	}
+}
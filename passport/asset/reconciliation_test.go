@@ -0,0 +1,288 @@
+package asset
+
+import (
+	"net"
+	"net/rpc"
+	"sort"
+	"testing"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+	"xsyn-services/passport/supremacy_rpcclient"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/volatiletech/null/v8"
+)
+
+func TestCompareWithGameserver(t *testing.T) {
+	owner := "owner"
+	userAsset := func(lockedToGame bool) *boiler.UserAsset {
+		ua := &boiler.UserAsset{
+			ID:         "asset",
+			Hash:       "hash",
+			OwnerID:    owner,
+			Name:       "mech",
+			Tier:       "MEGA",
+			Attributes: []byte(`[{"trait_type": "Speed", "value": 10}]`),
+			R:          (&boiler.UserAsset{}).R.NewStruct(),
+		}
+		ua.R.Collection = &boiler.Collection{Slug: "supremacy-genesis"}
+		if lockedToGame {
+			ua.LockedToService = null.StringFrom(xsynTypes.SupremacyGameUserID.String())
+		}
+		return ua
+	}
+	metadataHash := func(t *testing.T, ua *boiler.UserAsset) string {
+		t.Helper()
+		xsynAsset, err := reconcileXsynAsset(ua)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hash, err := supremacy_rpcclient.AssetMetadataHash(xsynAsset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hash
+	}
+
+	tests := []struct {
+		name         string
+		lockedToGame bool
+		gsAsset      func(hash string) *supremacy_rpcclient.ReconcileAsset
+		want         map[string]string
+	}{
+		{
+			name:         "agreeing asset has no discrepancies",
+			lockedToGame: true,
+			gsAsset: func(hash string) *supremacy_rpcclient.ReconcileAsset {
+				return &supremacy_rpcclient.ReconcileAsset{OwnerID: owner, MetadataHash: hash}
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "missing from the gameserver",
+			gsAsset: func(hash string) *supremacy_rpcclient.ReconcileAsset {
+				return nil
+			},
+			want: map[string]string{DiscrepancyMissing: FixNone},
+		},
+		{
+			name: "passport's owner is pushed for an asset on xsyn",
+			gsAsset: func(hash string) *supremacy_rpcclient.ReconcileAsset {
+				return &supremacy_rpcclient.ReconcileAsset{OwnerID: "someone else", XsynLocked: true, MetadataHash: hash}
+			},
+			want: map[string]string{DiscrepancyOwner: FixPushOwner},
+		},
+		{
+			name:         "gameserver's owner is adopted for an asset the game holds",
+			lockedToGame: true,
+			gsAsset: func(hash string) *supremacy_rpcclient.ReconcileAsset {
+				return &supremacy_rpcclient.ReconcileAsset{OwnerID: "someone else", MetadataHash: hash}
+			},
+			want: map[string]string{DiscrepancyOwner: FixAdoptGameserverOwner},
+		},
+		{
+			name:         "lock is pushed when the gameserver thinks the asset is on xsyn",
+			lockedToGame: true,
+			gsAsset: func(hash string) *supremacy_rpcclient.ReconcileAsset {
+				return &supremacy_rpcclient.ReconcileAsset{OwnerID: owner, XsynLocked: true, MetadataHash: hash}
+			},
+			want: map[string]string{DiscrepancyLock: FixPushLock},
+		},
+		{
+			name: "gameserver lock is released when passport has the asset on xsyn",
+			gsAsset: func(hash string) *supremacy_rpcclient.ReconcileAsset {
+				return &supremacy_rpcclient.ReconcileAsset{OwnerID: owner, EquippedOn: null.StringFrom("mech"), MetadataHash: hash}
+			},
+			want: map[string]string{DiscrepancyLock: FixReleaseGameserverLock},
+		},
+		{
+			name:         "different metadata is refreshed",
+			lockedToGame: true,
+			gsAsset: func(hash string) *supremacy_rpcclient.ReconcileAsset {
+				return &supremacy_rpcclient.ReconcileAsset{OwnerID: owner, MetadataHash: "stale"}
+			},
+			want: map[string]string{DiscrepancyMetadata: FixRefreshMetadata},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ua := userAsset(tt.lockedToGame)
+			discrepancies, err := CompareWithGameserver(ua, tt.gsAsset(metadataHash(t, ua)))
+			if err != nil {
+				t.Fatalf("CompareWithGameserver() error = %s", err)
+			}
+			got := map[string]string{}
+			for _, d := range discrepancies {
+				got[d.Kind] = d.SuggestedFix
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("CompareWithGameserver() = %v, want %v", got, tt.want)
+			}
+			for kind, fix := range tt.want {
+				if got[kind] != fix {
+					t.Errorf("%s fix = %q, want %q", kind, got[kind], fix)
+				}
+			}
+		})
+	}
+}
+
+func TestFixFitsDiscrepancy(t *testing.T) {
+	fixes := []string{FixPushOwner, FixAdoptGameserverOwner, FixPushLock, FixReleaseGameserverLock, FixRefreshMetadata, FixNone}
+	tests := []struct {
+		kind string
+		want []string
+	}{
+		{DiscrepancyOwner, []string{FixPushOwner, FixAdoptGameserverOwner}},
+		{DiscrepancyLock, []string{FixPushLock, FixReleaseGameserverLock}},
+		{DiscrepancyMetadata, []string{FixRefreshMetadata}},
+		{DiscrepancyMissing, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			for _, fix := range fixes {
+				want := false
+				for _, w := range tt.want {
+					want = want || w == fix
+				}
+				if got := FixFitsDiscrepancy(tt.kind, fix); got != want {
+					t.Errorf("FixFitsDiscrepancy(%s, %s) = %t, want %t", tt.kind, fix, got, want)
+				}
+			}
+		})
+	}
+}
+
+// fakeGameserver answers the reconcile call with the assets it has
+type fakeGameserver struct {
+	assets map[string]*supremacy_rpcclient.ReconcileAsset
+}
+
+func (g *fakeGameserver) AssetsReconcileHandler(req *supremacy_rpcclient.AssetsReconcileReq, resp *supremacy_rpcclient.AssetsReconcileResp) error {
+	for _, hash := range req.AssetHashes {
+		gsAsset, ok := g.assets[hash]
+		if !ok {
+			resp.Missing = append(resp.Missing, hash)
+			continue
+		}
+		resp.Assets = append(resp.Assets, gsAsset)
+	}
+	return nil
+}
+
+// serveGameserver points the supremacy rpc client at the fake gameserver until the test ends
+func serveGameserver(t *testing.T, g *fakeGameserver) {
+	t.Helper()
+	server := rpc.NewServer()
+	err := server.RegisterName("S", g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.Accept(l)
+
+	previous := supremacy_rpcclient.SupremacyClient
+	supremacy_rpcclient.SupremacyClient = &supremacy_rpcclient.SupremacyXrpcClient{Addrs: []string{l.Addr().String()}}
+	t.Cleanup(func() {
+		supremacy_rpcclient.SupremacyClient = previous
+		l.Close()
+	})
+}
+
+func TestReconcile(t *testing.T) {
+	passdbtest.Require(t)
+
+	collection := passdbtest.Collection(t)
+	owner := passdbtest.User(t)
+	userAssets := boiler.UserAssetSlice{
+		passdbtest.Asset(t, collection, owner),
+		passdbtest.Asset(t, collection, owner),
+		passdbtest.Asset(t, collection, owner),
+	}
+	sort.Slice(userAssets, func(i, j int) bool { return userAssets[i].Hash < userAssets[j].Hash })
+	for _, ua := range userAssets {
+		ua.R = ua.R.NewStruct()
+		ua.R.Collection = collection
+	}
+	agreeing, moved, missing := userAssets[0], userAssets[1], userAssets[2]
+
+	gs := &fakeGameserver{assets: map[string]*supremacy_rpcclient.ReconcileAsset{}}
+	for _, ua := range []*boiler.UserAsset{agreeing, moved} {
+		xsynAsset, err := reconcileXsynAsset(ua)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hash, err := supremacy_rpcclient.AssetMetadataHash(xsynAsset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gs.assets[ua.Hash] = &supremacy_rpcclient.ReconcileAsset{Hash: ua.Hash, OwnerID: owner.ID, XsynLocked: true, MetadataHash: hash}
+	}
+	gs.assets[moved.Hash].OwnerID = passdbtest.User(t).ID
+	serveGameserver(t, gs)
+
+	discrepancies := func(t *testing.T, run *boiler.ReconciliationRun) map[string]string {
+		t.Helper()
+		rows, err := boiler.ReconciliationDiscrepancies(boiler.ReconciliationDiscrepancyWhere.RunID.EQ(run.ID)).All(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := map[string]string{}
+		for _, d := range rows {
+			got[d.AssetHash] = d.Kind + " " + d.SuggestedFix
+		}
+		return got
+	}
+
+	t.Run("run records what differs", func(t *testing.T) {
+		run, err := CreateReconciliationRun([]string{collection.ID}, owner.ID)
+		if err != nil {
+			t.Fatalf("failed to create run: %s", err)
+		}
+		err = Reconcile(run)
+		if err != nil {
+			t.Fatalf("failed to reconcile: %s", err)
+		}
+		if run.Status != ReconciliationRunComplete || run.CheckedCount != 3 || run.DiscrepancyCount != 2 {
+			t.Errorf("run = %s checked %d with %d discrepancies, want COMPLETE checked 3 with 2", run.Status, run.CheckedCount, run.DiscrepancyCount)
+		}
+
+		got := discrepancies(t, run)
+		want := map[string]string{
+			moved.Hash:   DiscrepancyOwner + " " + FixPushOwner,
+			missing.Hash: DiscrepancyMissing + " " + FixNone,
+		}
+		if len(got) != len(want) {
+			t.Fatalf("discrepancies = %v, want %v", got, want)
+		}
+		for hash, w := range want {
+			if got[hash] != w {
+				t.Errorf("discrepancy for %s = %q, want %q", hash, got[hash], w)
+			}
+		}
+	})
+
+	t.Run("interrupted run carries on from the last asset", func(t *testing.T) {
+		run, err := CreateReconciliationRun([]string{collection.ID}, owner.ID)
+		if err != nil {
+			t.Fatalf("failed to create run: %s", err)
+		}
+		run.Status = ReconciliationRunRunning
+		run.LastHash = moved.Hash
+		err = Reconcile(run)
+		if err != nil {
+			t.Fatalf("failed to reconcile: %s", err)
+		}
+		if run.CheckedCount != 1 {
+			t.Errorf("checked %d assets, want 1", run.CheckedCount)
+		}
+		if got := discrepancies(t, run); len(got) != 1 || got[missing.Hash] == "" {
+			t.Errorf("discrepancies = %v, want only the missing asset", got)
+		}
+	})
+}
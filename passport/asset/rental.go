@@ -0,0 +1,238 @@
+package asset
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/types"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	RentalStatusPending  = "PENDING"
+	RentalStatusActive   = "ACTIVE"
+	RentalStatusEnded    = "ENDED"
+	RentalStatusCanceled = "CANCELED"
+)
+
+const RentalMaxDurationHours = 30 * 24
+
+// RentalOfferDuration is how long a renter has to accept a paid rental offer
+const RentalOfferDuration = 24 * time.Hour
+
+var ErrRentalNotPending = fmt.Errorf("rental offer is no longer available")
+var ErrRentalNotActive = fmt.Errorf("rental is not active")
+var ErrAssetRented = fmt.Errorf("asset is rented out")
+
+// CheckNotRented returns ErrAssetRented when the asset has an active rental
+func CheckNotRented(exec boil.Executor, userAssetID string) error {
+	rented, err := boiler.AssetRentals(
+		boiler.AssetRentalWhere.UserAssetID.EQ(userAssetID),
+		boiler.AssetRentalWhere.Status.EQ(RentalStatusActive),
+	).Exists(exec)
+	if err != nil {
+		return err
+	}
+	if rented {
+		return ErrAssetRented
+	}
+	return nil
+}
+
+// checkRentableAsset returns an error when the owner can't hand out usage rights of the asset.
+// Rentals only apply to assets in supremacy since that's where they are used.
+func checkRentableAsset(userAsset *boiler.UserAsset, ownerID string) error {
+	if userAsset.OwnerID != ownerID {
+		return fmt.Errorf("asset %s is not owned by the user", userAsset.Hash)
+	}
+	if userAsset.LockedToService.String != types.SupremacyGameUserID.String() {
+		return fmt.Errorf("asset %s needs to be in supremacy to be rented", userAsset.Hash)
+	}
+	if userAsset.UnlockedAt.After(time.Now()) {
+		return fmt.Errorf("asset %s is locked", userAsset.Hash)
+	}
+	return nil
+}
+
+// CreateRental stores a rental offer of the asset to a user or a syndicate
+func CreateRental(ownerID, hash string, renterUserID, renterSyndicateID null.String, fee decimal.Decimal, durationHours int) (*boiler.AssetRental, error) {
+	if renterUserID.Valid == renterSyndicateID.Valid {
+		return nil, fmt.Errorf("rental needs either a user or a syndicate")
+	}
+	if renterUserID.String == ownerID {
+		return nil, fmt.Errorf("cannot rent to yourself")
+	}
+	if fee.IsNegative() {
+		return nil, fmt.Errorf("fee cannot be negative")
+	}
+	if durationHours <= 0 || durationHours > RentalMaxDurationHours {
+		return nil, fmt.Errorf("duration must be between 1 and %d hours", RentalMaxDurationHours)
+	}
+
+	if renterUserID.Valid {
+		_, err := boiler.FindUser(passdb.StdConn, renterUserID.String)
+		if err != nil {
+			return nil, fmt.Errorf("renter %s: %w", renterUserID.String, err)
+		}
+	}
+	if renterSyndicateID.Valid {
+		exists, err := boiler.Syndicates(
+			boiler.SyndicateWhere.ID.EQ(renterSyndicateID.String),
+			boiler.SyndicateWhere.DeletedAt.IsNull(),
+		).Exists(passdb.StdConn)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, fmt.Errorf("syndicate %s does not exist", renterSyndicateID.String)
+		}
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	userAsset, err := boiler.UserAssets(
+		boiler.UserAssetWhere.Hash.EQ(hash),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return nil, fmt.Errorf("asset %s: %w", hash, err)
+	}
+	err = checkRentableAsset(userAsset, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	open, err := boiler.AssetRentals(
+		boiler.AssetRentalWhere.UserAssetID.EQ(userAsset.ID),
+		boiler.AssetRentalWhere.Status.IN([]string{RentalStatusPending, RentalStatusActive}),
+	).Exists(tx)
+	if err != nil {
+		return nil, err
+	}
+	if open {
+		return nil, fmt.Errorf("asset %s already has an open rental", hash)
+	}
+
+	rental := &boiler.AssetRental{
+		UserAssetID:       userAsset.ID,
+		OwnerID:           ownerID,
+		RenterUserID:      renterUserID,
+		RenterSyndicateID: renterSyndicateID,
+		Fee:               fee,
+		DurationHours:     durationHours,
+		Status:            RentalStatusPending,
+	}
+	err = rental.Insert(tx, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return rental, nil
+}
+
+// ClaimRental starts a pending rental inside the db transaction.
+// The row stays locked until the transaction ends, so an offer can only be accepted once.
+func ClaimRental(tx boil.Executor, rentalID string, renterID string) (*boiler.AssetRental, error) {
+	rental, err := boiler.AssetRentals(
+		boiler.AssetRentalWhere.ID.EQ(rentalID),
+		qm.Load(boiler.AssetRentalRels.UserAsset),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return nil, err
+	}
+	if rental.RenterUserID.String != renterID && rental.RenterSyndicateID.String != renterID {
+		return nil, sql.ErrNoRows
+	}
+	if rental.Status != RentalStatusPending || rental.CreatedAt.Add(RentalOfferDuration).Before(time.Now()) {
+		return nil, ErrRentalNotPending
+	}
+
+	// the owner may have moved the asset since the offer was made
+	err = checkRentableAsset(rental.R.UserAsset, rental.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	rental.Status = RentalStatusActive
+	rental.StartsAt = null.TimeFrom(now)
+	rental.EndsAt = null.TimeFrom(now.Add(time.Duration(rental.DurationHours) * time.Hour))
+	rental.UpdatedAt = now
+	_, err = rental.Update(tx, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return rental, nil
+}
+
+// SetRentalStatus moves a rental from one of the given statuses to a final status
+func SetRentalStatus(rentalID string, from []string, status string) (*boiler.AssetRental, error) {
+	count, err := boiler.AssetRentals(
+		boiler.AssetRentalWhere.ID.EQ(rentalID),
+		boiler.AssetRentalWhere.Status.IN(from),
+	).UpdateAll(passdb.StdConn, boiler.M{
+		boiler.AssetRentalColumns.Status:    status,
+		boiler.AssetRentalColumns.EndedAt:   null.TimeFrom(time.Now()),
+		boiler.AssetRentalColumns.UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		if status == RentalStatusEnded {
+			return nil, ErrRentalNotActive
+		}
+		return nil, ErrRentalNotPending
+	}
+
+	return boiler.FindAssetRental(passdb.StdConn, rentalID)
+}
+
+// ExpireRentalOffers cancels every pending offer that wasn't accepted in time and returns them
+func ExpireRentalOffers() (boiler.AssetRentalSlice, error) {
+	rentals, err := boiler.AssetRentals(
+		boiler.AssetRentalWhere.Status.EQ(RentalStatusPending),
+		boiler.AssetRentalWhere.CreatedAt.LT(time.Now().Add(-RentalOfferDuration)),
+	).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	expired := boiler.AssetRentalSlice{}
+	for _, rental := range rentals {
+		r, err := SetRentalStatus(rental.ID, []string{RentalStatusPending}, RentalStatusCanceled)
+		if errors.Is(err, ErrRentalNotPending) {
+			continue
+		}
+		if err != nil {
+			return expired, err
+		}
+		expired = append(expired, r)
+	}
+	return expired, nil
+}
+
+// EndedRentals returns the active rentals that have run past their end time
+func EndedRentals() (boiler.AssetRentalSlice, error) {
+	return boiler.AssetRentals(
+		boiler.AssetRentalWhere.Status.EQ(RentalStatusActive),
+		boiler.AssetRentalWhere.EndsAt.LT(null.TimeFrom(time.Now())),
+		qm.Load(boiler.AssetRentalRels.UserAsset),
+	).All(passdb.StdConn)
+}
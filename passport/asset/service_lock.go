@@ -0,0 +1,247 @@
+package asset
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	LockReleaseService = "SERVICE"
+	LockReleaseExpired = "EXPIRED"
+	LockReleaseAdmin   = "ADMIN"
+)
+
+const DefaultServiceLockTTL = 5 * time.Minute
+const MaxServiceLockTTL = 24 * time.Hour
+
+var ErrServiceLockNotHeld = fmt.Errorf("asset is not locked to the service")
+var ErrEscrowServiceLock = fmt.Errorf("asset is held by a trade or marketplace listing")
+
+// IsEscrowService says whether the service is a pseudo service assets are locked to while a trade or listing holds them.
+// Those locks are only released by settling or cancelling the trade or listing, so the SUPS it holds are settled with them.
+func IsEscrowService(serviceID string) bool {
+	switch serviceID {
+	case xsynTypes.XsynMarketplaceUserID.String(),
+		xsynTypes.XsynTradeEscrowUserID.String():
+		return true
+	}
+	return false
+}
+
+// ServiceLockTTL turns the ttl a service asked for into a lease duration, zero means the default
+func ServiceLockTTL(seconds int) (time.Duration, error) {
+	if seconds == 0 {
+		return DefaultServiceLockTTL, nil
+	}
+	ttl := time.Duration(seconds) * time.Second
+	if ttl < 0 || ttl > MaxServiceLockTTL {
+		return 0, fmt.Errorf("lock ttl must be between 1 second and %s", MaxServiceLockTTL)
+	}
+	return ttl, nil
+}
+
+// AcquireServiceLock leases the asset to the service until the ttl runs out, the service has to renew it to keep it.
+// Asking again for an asset the service already leases renews the lease.
+// Assets moved to a service by the owner (eg. transfers to supremacy) are locked without a lease, for those nil is returned.
+func AcquireServiceLock(userAssetID, serviceID, reason string, ttl time.Duration) (*boiler.AssetServiceLock, error) {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	userAsset, err := boiler.UserAssets(
+		boiler.UserAssetWhere.ID.EQ(userAssetID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if userAsset.LockedToService.Valid {
+		if userAsset.LockedToService.String != serviceID {
+			return nil, fmt.Errorf("asset %s is locked to a different service", userAsset.Hash)
+		}
+
+		lease, err := boiler.AssetServiceLocks(
+			boiler.AssetServiceLockWhere.UserAssetID.EQ(userAsset.ID),
+			boiler.AssetServiceLockWhere.ReleasedAt.IsNull(),
+			qm.For("UPDATE"),
+		).One(tx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		lease.ExpiresAt = time.Now().Add(ttl)
+		lease.RenewedAt = null.TimeFrom(time.Now())
+		_, err = lease.Update(tx, boil.Whitelist(boiler.AssetServiceLockColumns.ExpiresAt, boiler.AssetServiceLockColumns.RenewedAt))
+		if err != nil {
+			return nil, err
+		}
+		return lease, tx.Commit()
+	}
+
+	// unlocked at is pushed forward while a mint or unstake signature is valid
+	if userAsset.UnlockedAt.After(time.Now()) {
+		return nil, fmt.Errorf("asset %s is locked", userAsset.Hash)
+	}
+
+	userAsset.LockedToService = null.StringFrom(serviceID)
+	_, err = userAsset.Update(tx, boil.Whitelist(boiler.UserAssetColumns.LockedToService))
+	if err != nil {
+		return nil, err
+	}
+
+	lease := &boiler.AssetServiceLock{
+		UserAssetID: userAsset.ID,
+		ServiceID:   serviceID,
+		Reason:      reason,
+		ExpiresAt:   time.Now().Add(ttl),
+	}
+	err = lease.Insert(tx, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+
+	transferEvent := &boiler.AssetServiceTransferEvent{
+		UserAssetID: userAsset.ID,
+		UserID:      userAsset.OwnerID,
+		ToService:   null.StringFrom(serviceID),
+	}
+	err = transferEvent.Insert(tx, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+
+	return lease, tx.Commit()
+}
+
+// RenewServiceLock pushes the expiry of a lease the service still holds
+func RenewServiceLock(userAssetID, serviceID string, ttl time.Duration) (*boiler.AssetServiceLock, error) {
+	count, err := boiler.AssetServiceLocks(
+		boiler.AssetServiceLockWhere.UserAssetID.EQ(userAssetID),
+		boiler.AssetServiceLockWhere.ServiceID.EQ(serviceID),
+		boiler.AssetServiceLockWhere.ReleasedAt.IsNull(),
+		boiler.AssetServiceLockWhere.ExpiresAt.GT(time.Now()),
+	).UpdateAll(passdb.StdConn, boiler.M{
+		boiler.AssetServiceLockColumns.ExpiresAt: time.Now().Add(ttl),
+		boiler.AssetServiceLockColumns.RenewedAt: null.TimeFrom(time.Now()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, ErrServiceLockNotHeld
+	}
+
+	return boiler.AssetServiceLocks(
+		boiler.AssetServiceLockWhere.UserAssetID.EQ(userAssetID),
+		boiler.AssetServiceLockWhere.ReleasedAt.IsNull(),
+	).One(passdb.StdConn)
+}
+
+// ReleaseServiceLock hands the asset back from the service to xsyn and closes its lease if it has one.
+// A rented asset has to stay in the service for the renter, so an expired lease on one is closed and the asset kept locked.
+// The returned transfer event is nil when only the lease was closed.
+func ReleaseServiceLock(userAssetID, serviceID, releaseReason string, releasedByID null.String) (*boiler.AssetServiceTransferEvent, error) {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	userAsset, err := boiler.UserAssets(
+		boiler.UserAssetWhere.ID.EQ(userAssetID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	lease, err := boiler.AssetServiceLocks(
+		boiler.AssetServiceLockWhere.UserAssetID.EQ(userAsset.ID),
+		boiler.AssetServiceLockWhere.ServiceID.EQ(serviceID),
+		boiler.AssetServiceLockWhere.ReleasedAt.IsNull(),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	held := userAsset.LockedToService.String == serviceID
+	if !held && lease == nil {
+		return nil, ErrServiceLockNotHeld
+	}
+
+	if lease != nil {
+		lease.ReleasedAt = null.TimeFrom(time.Now())
+		lease.ReleaseReason = null.StringFrom(releaseReason)
+		lease.ReleasedByID = releasedByID
+		_, err = lease.Update(tx, boil.Whitelist(
+			boiler.AssetServiceLockColumns.ReleasedAt,
+			boiler.AssetServiceLockColumns.ReleaseReason,
+			boiler.AssetServiceLockColumns.ReleasedByID,
+		))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if held && IsEscrowService(serviceID) {
+		return nil, ErrEscrowServiceLock
+	}
+
+	if held {
+		err = CheckNotRented(tx, userAsset.ID)
+		if errors.Is(err, ErrAssetRented) && releaseReason == LockReleaseExpired {
+			held = false
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	var transferEvent *boiler.AssetServiceTransferEvent
+	if held {
+
+		userAsset.LockedToService = null.String{}
+		_, err = userAsset.Update(tx, boil.Whitelist(boiler.UserAssetColumns.LockedToService))
+		if err != nil {
+			return nil, err
+		}
+
+		transferEvent = &boiler.AssetServiceTransferEvent{
+			UserAssetID: userAsset.ID,
+			UserID:      userAsset.OwnerID,
+			FromService: null.StringFrom(serviceID),
+		}
+		err = transferEvent.Insert(tx, boil.Infer())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return transferEvent, nil
+}
+
+// ExpiredServiceLocks returns the leases whose service stopped renewing them
+func ExpiredServiceLocks() (boiler.AssetServiceLockSlice, error) {
+	return boiler.AssetServiceLocks(
+		boiler.AssetServiceLockWhere.ReleasedAt.IsNull(),
+		boiler.AssetServiceLockWhere.ExpiresAt.LT(time.Now()),
+	).All(passdb.StdConn)
+}
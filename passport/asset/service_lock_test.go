@@ -0,0 +1,139 @@
+package asset_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+	"xsyn-services/types"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestReleaseExpiredServiceLock(t *testing.T) {
+	passdbtest.Require(t)
+
+	collection := passdbtest.Collection(t)
+	serviceID := types.SupremacyGameUserID.String()
+
+	// lease leases the asset to the service with a lease that has already run out
+	lease := func(t *testing.T, userAsset *boiler.UserAsset) *boiler.AssetServiceLock {
+		t.Helper()
+		lease, err := asset.AcquireServiceLock(userAsset.ID, serviceID, "test", time.Minute)
+		if err != nil {
+			t.Fatalf("failed to lock asset: %s", err)
+		}
+		lease.ExpiresAt = time.Now().Add(-time.Minute)
+		_, err = lease.Update(passdb.StdConn, boil.Whitelist(boiler.AssetServiceLockColumns.ExpiresAt))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return lease
+	}
+	expired := func(t *testing.T, leaseID string) bool {
+		t.Helper()
+		leases, err := asset.ExpiredServiceLocks()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, l := range leases {
+			if l.ID == leaseID {
+				return true
+			}
+		}
+		return false
+	}
+
+	t.Run("expired lease hands the asset back", func(t *testing.T) {
+		owner := passdbtest.User(t)
+		userAsset := passdbtest.Asset(t, collection, owner)
+		l := lease(t, userAsset)
+
+		transferEvent, err := asset.ReleaseServiceLock(userAsset.ID, serviceID, asset.LockReleaseExpired, null.String{})
+		if err != nil {
+			t.Fatalf("failed to release lock: %s", err)
+		}
+		if transferEvent == nil {
+			t.Errorf("no transfer event for a released asset")
+		}
+		err = userAsset.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if userAsset.LockedToService.Valid {
+			t.Errorf("asset still locked to %s", userAsset.LockedToService.String)
+		}
+		if expired(t, l.ID) {
+			t.Errorf("released lease still expired")
+		}
+	})
+
+	t.Run("expired lease on a rented asset is closed and the asset kept", func(t *testing.T) {
+		owner := passdbtest.User(t)
+		renter := passdbtest.User(t)
+		userAsset := passdbtest.Asset(t, collection, owner)
+		l := lease(t, userAsset)
+		err := (&boiler.AssetRental{
+			UserAssetID:   userAsset.ID,
+			OwnerID:       owner.ID,
+			RenterUserID:  null.StringFrom(renter.ID),
+			DurationHours: 1,
+			Status:        asset.RentalStatusActive,
+			StartsAt:      null.TimeFrom(time.Now()),
+			EndsAt:        null.TimeFrom(time.Now().Add(time.Hour)),
+		}).Insert(passdb.StdConn, boil.Infer())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = asset.ReleaseServiceLock(userAsset.ID, serviceID, asset.LockReleaseAdmin, null.String{})
+		if !errors.Is(err, asset.ErrAssetRented) {
+			t.Errorf("admin release err = %v, want %v", err, asset.ErrAssetRented)
+		}
+
+		transferEvent, err := asset.ReleaseServiceLock(userAsset.ID, serviceID, asset.LockReleaseExpired, null.String{})
+		if err != nil {
+			t.Fatalf("failed to close expired lease: %s", err)
+		}
+		if transferEvent != nil {
+			t.Errorf("rented asset was handed back")
+		}
+		err = userAsset.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if userAsset.LockedToService.String != serviceID {
+			t.Errorf("rented asset locked to %v, want the service", userAsset.LockedToService)
+		}
+		if expired(t, l.ID) {
+			t.Errorf("lease on the rented asset is still picked up as expired")
+		}
+	})
+
+	t.Run("assets held by a trade or listing aren't released", func(t *testing.T) {
+		for _, escrowID := range []types.UserID{types.XsynTradeEscrowUserID, types.XsynMarketplaceUserID} {
+			userAsset := passdbtest.Asset(t, collection, passdbtest.User(t))
+			userAsset.LockedToService = null.StringFrom(escrowID.String())
+			_, err := userAsset.Update(passdb.StdConn, boil.Whitelist(boiler.UserAssetColumns.LockedToService))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = asset.ReleaseServiceLock(userAsset.ID, escrowID.String(), asset.LockReleaseAdmin, null.String{})
+			if !errors.Is(err, asset.ErrEscrowServiceLock) {
+				t.Errorf("admin release of an asset held by %s err = %v, want %v", escrowID, err, asset.ErrEscrowServiceLock)
+			}
+			err = userAsset.Reload(passdb.StdConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if userAsset.LockedToService.String != escrowID.String() {
+				t.Errorf("escrowed asset locked to %v, want %s", userAsset.LockedToService, escrowID)
+			}
+		}
+	})
+}
@@ -0,0 +1,413 @@
+package asset
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/types"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	SyndicateTransferDeposit     = "DEPOSIT"
+	SyndicateTransferWithdraw    = "WITHDRAW"
+	SyndicateTransferLiquidation = "LIQUIDATION"
+)
+
+var ErrSyndicateAsset = fmt.Errorf("syndicate assets can only be moved in and out by the syndicate")
+var ErrSyndicateLiquidated = fmt.Errorf("syndicate is liquidated")
+
+// CreateSyndicateHolder creates the user a syndicate owns assets through.
+// It shares the syndicate's id and account, so an asset owned by the syndicate has its id as the owner id.
+func CreateSyndicateHolder(exec boil.Executor, syndicate *boiler.Syndicate) error {
+	holder := &boiler.User{
+		ID:        syndicate.ID,
+		Username:  fmt.Sprintf("Syndicate-%s", syndicate.ID),
+		RoleID:    null.StringFrom(types.UserRoleSyndicate.String()),
+		Verified:  true,
+		FactionID: null.StringFrom(syndicate.FactionID),
+		AccountID: syndicate.AccountID,
+	}
+	return holder.Insert(exec, boil.Infer())
+}
+
+// checkNotSyndicate returns ErrSyndicateAsset when any of the users is a syndicate
+func checkNotSyndicate(exec boil.Executor, userIDs ...string) error {
+	isSyndicate, err := boiler.Syndicates(boiler.SyndicateWhere.ID.IN(userIDs)).Exists(exec)
+	if err != nil {
+		return err
+	}
+	if isSyndicate {
+		return ErrSyndicateAsset
+	}
+	return nil
+}
+
+// checkSyndicateMember returns an error unless the user is an active member of the syndicate, in its faction and with the permission.
+// An empty permission only checks they are a member.
+func checkSyndicateMember(exec boil.Executor, syndicate *boiler.Syndicate, userID string, permission string) error {
+	user, err := boiler.FindUser(exec, userID)
+	if err != nil {
+		return err
+	}
+	if user.DeletedAt.Valid || user.TotalLock {
+		return fmt.Errorf("user %s is locked", userID)
+	}
+	if user.FactionID.String != syndicate.FactionID {
+		return fmt.Errorf("user %s is not in the syndicate's faction", userID)
+	}
+	err = checkNotSyndicate(exec, userID)
+	if err != nil {
+		return err
+	}
+	return CheckSyndicatePermission(exec, syndicate.ID, userID, permission)
+}
+
+const (
+	SyndicatePermissionSpendFunds   = "SPEND_FUNDS"
+	SyndicatePermissionManageAssets = "MANAGE_ASSETS"
+)
+
+var ErrNotSyndicateMember = fmt.Errorf("user is not a member of the syndicate")
+
+// CheckSyndicatePermission returns an error unless the user is an active member of the syndicate with the permission, as last synced from supremacy.
+// An empty permission only checks they are a member.
+func CheckSyndicatePermission(exec boil.Executor, syndicateID, userID, permission string) error {
+	member, err := boiler.SyndicateMembers(
+		boiler.SyndicateMemberWhere.SyndicateID.EQ(syndicateID),
+		boiler.SyndicateMemberWhere.UserID.EQ(userID),
+		qm.Load(boiler.SyndicateMemberRels.User),
+	).One(exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotSyndicateMember
+	}
+	if err != nil {
+		return err
+	}
+	if member.R.User.DeletedAt.Valid || member.R.User.TotalLock {
+		return fmt.Errorf("user %s is locked", userID)
+	}
+
+	allowed := false
+	switch permission {
+	case "":
+		allowed = true
+	case SyndicatePermissionSpendFunds:
+		allowed = member.CanSpendFunds
+	case SyndicatePermissionManageAssets:
+		allowed = member.CanManageAssets
+	}
+	if !allowed {
+		return fmt.Errorf("user %s is not allowed to %s for the syndicate", userID, strings.ToLower(strings.ReplaceAll(permission, "_", " ")))
+	}
+	return nil
+}
+
+type SyndicateMember struct {
+	UserID          string `json:"user_id"`
+	Role            string `json:"role"`
+	CanSpendFunds   bool   `json:"can_spend_funds"`
+	CanManageAssets bool   `json:"can_manage_assets"`
+}
+
+// SyncSyndicateMembers replaces the members of a syndicate with the list supremacy sent
+func SyncSyndicateMembers(syndicateID string, members []*SyndicateMember) error {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	syndicate, err := boiler.Syndicates(
+		boiler.SyndicateWhere.ID.EQ(syndicateID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return err
+	}
+	if syndicate.DeletedAt.Valid {
+		return ErrSyndicateLiquidated
+	}
+
+	_, err = boiler.SyndicateMembers(boiler.SyndicateMemberWhere.SyndicateID.EQ(syndicate.ID)).DeleteAll(tx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, m := range members {
+		user, err := boiler.FindUser(tx, m.UserID)
+		if err != nil {
+			return fmt.Errorf("member %s: %w", m.UserID, err)
+		}
+		if user.FactionID.String != syndicate.FactionID {
+			return fmt.Errorf("member %s is not in the syndicate's faction", m.UserID)
+		}
+
+		member := &boiler.SyndicateMember{
+			SyndicateID:     syndicate.ID,
+			UserID:          user.ID,
+			Role:            m.Role,
+			CanSpendFunds:   m.CanSpendFunds,
+			CanManageAssets: m.CanManageAssets,
+			SyncedAt:        now,
+		}
+		if member.Role == "" {
+			member.Role = "MEMBER"
+		}
+		err = member.Insert(tx, boil.Infer())
+		if err != nil {
+			return fmt.Errorf("member %s: %w", m.UserID, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// checkSyndicateMovableAsset returns an error when the asset can't move in or out of a syndicate.
+// Unlike trades, assets in supremacy can be moved since that's where syndicates use them.
+func checkSyndicateMovableAsset(exec boil.Executor, userAsset *boiler.UserAsset, ownerID string) error {
+	if userAsset.OwnerID != ownerID {
+		return fmt.Errorf("asset %s is not owned by %s", userAsset.Hash, ownerID)
+	}
+	if userAsset.LockedToService.Valid && userAsset.LockedToService.String != types.SupremacyGameUserID.String() {
+		return fmt.Errorf("asset %s is locked to a service", userAsset.Hash)
+	}
+	err := CheckNotRented(exec, userAsset.ID)
+	if err != nil {
+		return fmt.Errorf("asset %s: %w", userAsset.Hash, err)
+	}
+	if userAsset.UnlockedAt.After(time.Now()) {
+		return fmt.Errorf("asset %s is locked", userAsset.Hash)
+	}
+	return checkHeldOffChain(exec, userAsset)
+}
+
+// transferSyndicateAssetTx moves the asset and records the member behind it
+func transferSyndicateAssetTx(tx boil.Executor, userAsset *boiler.UserAsset, syndicateID, fromID, toID, memberID, kind, serviceID string) (*boiler.AssetTransferEvent, error) {
+	transferEvent, err := TransferAssetTx(tx, userAsset, fromID, toID, serviceID, null.String{})
+	if err != nil {
+		return nil, err
+	}
+	syndicateTransfer := &boiler.SyndicateAssetTransfer{
+		TransferEventID: transferEvent.ID,
+		SyndicateID:     syndicateID,
+		MemberID:        memberID,
+		Kind:            kind,
+	}
+	err = syndicateTransfer.Insert(tx, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return transferEvent, nil
+}
+
+// TransferSyndicateAsset moves an asset into or out of a syndicate on behalf of a member.
+// The member needs to be allowed to manage the syndicate's assets, as last synced from supremacy.
+// A member can only deposit their own assets, withdrawals can go to any member.
+func TransferSyndicateAsset(syndicateID, memberID, hash, fromID, toID, serviceID string) (*boiler.AssetTransferEvent, error) {
+	kind := SyndicateTransferDeposit
+	userID := fromID
+	if fromID == syndicateID {
+		kind = SyndicateTransferWithdraw
+		userID = toID
+	} else if toID != syndicateID {
+		return nil, fmt.Errorf("transfer is not in or out of the syndicate")
+	}
+	if kind == SyndicateTransferDeposit && fromID != memberID {
+		return nil, fmt.Errorf("members can only deposit their own assets")
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	syndicate, err := boiler.Syndicates(
+		boiler.SyndicateWhere.ID.EQ(syndicateID),
+		qm.For("SHARE"),
+	).One(tx)
+	if err != nil {
+		return nil, err
+	}
+	if syndicate.DeletedAt.Valid {
+		return nil, ErrSyndicateLiquidated
+	}
+	err = checkSyndicateMember(tx, syndicate, memberID, SyndicatePermissionManageAssets)
+	if err != nil {
+		return nil, err
+	}
+	if userID != memberID {
+		err = checkSyndicateMember(tx, syndicate, userID, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	userAsset, err := boiler.UserAssets(
+		boiler.UserAssetWhere.Hash.EQ(hash),
+		qm.For("UPDATE"),
+	).One(tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("asset not exist")
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = checkSyndicateMovableAsset(tx, userAsset, fromID)
+	if err != nil {
+		return nil, err
+	}
+
+	transferEvent, err := transferSyndicateAssetTx(tx, userAsset, syndicateID, fromID, toID, memberID, kind, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	if transferEvent.AvatarCleared {
+		PublishUserAvatar(fromID)
+	}
+	return transferEvent, nil
+}
+
+// SyndicateDepositors gets the member who last deposited each of the assets into the syndicate, by asset id
+func SyndicateDepositors(exec boil.Executor, syndicateID string, userAssetIDs []string) (map[string]string, error) {
+	depositors := map[string]string{}
+	if len(userAssetIDs) == 0 {
+		return depositors, nil
+	}
+
+	ids := []interface{}{}
+	for _, id := range userAssetIDs {
+		ids = append(ids, id)
+	}
+	rows := []struct {
+		UserAssetID string `boil:"user_asset_id"`
+		MemberID    string `boil:"member_id"`
+	}{}
+	err := boiler.NewQuery(
+		qm.Select(
+			fmt.Sprintf("DISTINCT ON (ate.%s) ate.%s", boiler.AssetTransferEventColumns.UserAssetID, boiler.AssetTransferEventColumns.UserAssetID),
+			fmt.Sprintf("sat.%s", boiler.SyndicateAssetTransferColumns.MemberID),
+		),
+		qm.From(fmt.Sprintf("%s sat", boiler.TableNames.SyndicateAssetTransfers)),
+		qm.InnerJoin(fmt.Sprintf("%s ate ON ate.%s = sat.%s", boiler.TableNames.AssetTransferEvents, boiler.AssetTransferEventColumns.ID, boiler.SyndicateAssetTransferColumns.TransferEventID)),
+		qm.Where(fmt.Sprintf("sat.%s = ?", boiler.SyndicateAssetTransferColumns.SyndicateID), syndicateID),
+		qm.Where(fmt.Sprintf("sat.%s = ?", boiler.SyndicateAssetTransferColumns.Kind), SyndicateTransferDeposit),
+		qm.WhereIn(fmt.Sprintf("ate.%s IN ?", boiler.AssetTransferEventColumns.UserAssetID), ids...),
+		qm.OrderBy(fmt.Sprintf("ate.%s, ate.%s DESC", boiler.AssetTransferEventColumns.UserAssetID, boiler.AssetTransferEventColumns.ID)),
+	).Bind(nil, exec, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		depositors[row.UserAssetID] = row.MemberID
+	}
+	return depositors, nil
+}
+
+// LiquidateSyndicateAssets hands out everything the syndicate holds as it is liquidated.
+// An asset goes to the recipient picked for its hash, otherwise back to the member who deposited it if they are still a member.
+// Other assets are shared between the remaining members in turn, or go to the founder if none remain.
+// Every recipient has to be a member, and it fails while any asset is rented, locked to a service other than supremacy or on chain.
+func LiquidateSyndicateAssets(syndicateID string, recipients map[string]string, remainUserIDs []string, serviceID string) ([]*boiler.AssetTransferEvent, error) {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	syndicate, err := boiler.Syndicates(
+		boiler.SyndicateWhere.ID.EQ(syndicateID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	userAssets, err := boiler.UserAssets(
+		boiler.UserAssetWhere.OwnerID.EQ(syndicate.ID),
+		qm.OrderBy(boiler.UserAssetColumns.Hash),
+		qm.For("UPDATE"),
+	).All(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range remainUserIDs {
+		err = checkSyndicateMember(tx, syndicate, id, "")
+		if err != nil {
+			return nil, fmt.Errorf("remaining member: %w", err)
+		}
+	}
+
+	userAssetIDs := []string{}
+	for _, userAsset := range userAssets {
+		err = checkSyndicateMovableAsset(tx, userAsset, syndicate.ID)
+		if err != nil {
+			return nil, err
+		}
+		userAssetIDs = append(userAssetIDs, userAsset.ID)
+	}
+	depositors, err := SyndicateDepositors(tx, syndicate.ID, userAssetIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	transferEvents := []*boiler.AssetTransferEvent{}
+	nextMember := 0
+	for _, userAsset := range userAssets {
+		toID, ok := recipients[userAsset.Hash]
+		if ok {
+			err = checkSyndicateMember(tx, syndicate, toID, "")
+			if err != nil {
+				return nil, fmt.Errorf("recipient of asset %s: %w", userAsset.Hash, err)
+			}
+		} else if depositor, ok := depositors[userAsset.ID]; ok && checkSyndicateMember(tx, syndicate, depositor, "") == nil {
+			toID = depositor
+		} else if len(remainUserIDs) > 0 {
+			toID = remainUserIDs[nextMember%len(remainUserIDs)]
+			nextMember++
+		} else {
+			toID = syndicate.FoundedByID
+			err = checkSyndicateMember(tx, syndicate, toID, "")
+			if err != nil {
+				return nil, fmt.Errorf("founder can't be given asset %s: %w", userAsset.Hash, err)
+			}
+		}
+
+		transferEvent, err := transferSyndicateAssetTx(tx, userAsset, syndicate.ID, syndicate.ID, toID, toID, SyndicateTransferLiquidation, serviceID)
+		if err != nil {
+			return nil, fmt.Errorf("asset %s: %w", userAsset.Hash, err)
+		}
+		transferEvents = append(transferEvents, transferEvent)
+	}
+
+	if !syndicate.DeletedAt.Valid {
+		syndicate.DeletedAt = null.TimeFrom(time.Now())
+		_, err = syndicate.Update(tx, boil.Whitelist(boiler.SyndicateColumns.DeletedAt))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return transferEvents, nil
+}
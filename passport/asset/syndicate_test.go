@@ -0,0 +1,209 @@
+package asset_test
+
+import (
+	"errors"
+	"testing"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+	"xsyn-services/types"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestSyndicateMembers(t *testing.T) {
+	passdbtest.Require(t)
+
+	founder := passdbtest.User(t)
+	syndicate := passdbtest.Syndicate(t, founder)
+	spender := passdbtest.User(t)
+	passdbtest.JoinFaction(t, spender, syndicate.FactionID)
+	member := passdbtest.User(t)
+	passdbtest.JoinFaction(t, member, syndicate.FactionID)
+	outsider := passdbtest.User(t)
+
+	err := asset.CheckSyndicatePermission(passdb.StdConn, syndicate.ID, founder.ID, asset.SyndicatePermissionSpendFunds)
+	if err != nil {
+		t.Errorf("founder can't spend syndicate funds: %s", err)
+	}
+	err = asset.CheckSyndicatePermission(passdb.StdConn, syndicate.ID, spender.ID, asset.SyndicatePermissionSpendFunds)
+	if !errors.Is(err, asset.ErrNotSyndicateMember) {
+		t.Errorf("unsynced user err = %v, want %v", err, asset.ErrNotSyndicateMember)
+	}
+
+	err = asset.SyncSyndicateMembers(syndicate.ID, []*asset.SyndicateMember{
+		{UserID: founder.ID, Role: "FOUNDER", CanSpendFunds: true, CanManageAssets: true},
+		{UserID: spender.ID, Role: "DIRECTOR", CanSpendFunds: true},
+		{UserID: member.ID},
+	})
+	if err != nil {
+		t.Fatalf("failed to sync members: %s", err)
+	}
+
+	tests := []struct {
+		name       string
+		userID     string
+		permission string
+		wantErr    bool
+	}{
+		{"founder manages assets", founder.ID, asset.SyndicatePermissionManageAssets, false},
+		{"director spends funds", spender.ID, asset.SyndicatePermissionSpendFunds, false},
+		{"director can't manage assets", spender.ID, asset.SyndicatePermissionManageAssets, true},
+		{"member can't spend funds", member.ID, asset.SyndicatePermissionSpendFunds, true},
+		{"outsider can't spend funds", outsider.ID, asset.SyndicatePermissionSpendFunds, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := asset.CheckSyndicatePermission(passdb.StdConn, syndicate.ID, tt.userID, tt.permission)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckSyndicatePermission() err = %v, want err %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// a member outside the faction fails the whole sync
+	err = asset.SyncSyndicateMembers(syndicate.ID, []*asset.SyndicateMember{
+		{UserID: member.ID, CanSpendFunds: true},
+		{UserID: outsider.ID, CanSpendFunds: true},
+	})
+	if err == nil {
+		t.Fatalf("synced a member outside the syndicate's faction")
+	}
+	err = asset.CheckSyndicatePermission(passdb.StdConn, syndicate.ID, spender.ID, asset.SyndicatePermissionSpendFunds)
+	if err != nil {
+		t.Errorf("failed sync changed the members: %s", err)
+	}
+}
+
+func TestSyndicateAssets(t *testing.T) {
+	passdbtest.Require(t)
+
+	collection := passdbtest.Collection(t)
+	serviceID := types.SupremacyGameUserID.String()
+
+	type party struct {
+		syndicate                       *boiler.Syndicate
+		founder, manager, member, other *boiler.User
+	}
+	setup := func(t *testing.T) *party {
+		t.Helper()
+		p := &party{founder: passdbtest.User(t)}
+		p.syndicate = passdbtest.Syndicate(t, p.founder)
+		p.manager = passdbtest.User(t)
+		p.member = passdbtest.User(t)
+		p.other = passdbtest.User(t)
+		for _, u := range []*boiler.User{p.manager, p.member, p.other} {
+			passdbtest.JoinFaction(t, u, p.syndicate.FactionID)
+		}
+		err := asset.SyncSyndicateMembers(p.syndicate.ID, []*asset.SyndicateMember{
+			{UserID: p.founder.ID, Role: "FOUNDER", CanSpendFunds: true, CanManageAssets: true},
+			{UserID: p.manager.ID, Role: "DIRECTOR", CanManageAssets: true},
+			{UserID: p.member.ID},
+		})
+		if err != nil {
+			t.Fatalf("failed to sync members: %s", err)
+		}
+		return p
+	}
+	holding := func(t *testing.T, p *party) *boiler.UserAsset {
+		t.Helper()
+		return passdbtest.Asset(t, collection, &boiler.User{ID: p.syndicate.ID})
+	}
+	owner := func(t *testing.T, userAsset *boiler.UserAsset) string {
+		t.Helper()
+		err := userAsset.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return userAsset.OwnerID
+	}
+
+	t.Run("only members who manage assets deposit", func(t *testing.T) {
+		p := setup(t)
+		for _, u := range []*boiler.User{p.member, p.other} {
+			userAsset := passdbtest.Asset(t, collection, u)
+			_, err := asset.TransferSyndicateAsset(p.syndicate.ID, u.ID, userAsset.Hash, u.ID, p.syndicate.ID, serviceID)
+			if err == nil {
+				t.Errorf("user %s deposited without being allowed to manage assets", u.ID)
+			}
+			if got := owner(t, userAsset); got != u.ID {
+				t.Errorf("asset owned by %s, want %s", got, u.ID)
+			}
+		}
+
+		userAsset := passdbtest.Asset(t, collection, p.manager)
+		_, err := asset.TransferSyndicateAsset(p.syndicate.ID, p.manager.ID, userAsset.Hash, p.manager.ID, p.syndicate.ID, serviceID)
+		if err != nil {
+			t.Fatalf("manager failed to deposit: %s", err)
+		}
+		if got := owner(t, userAsset); got != p.syndicate.ID {
+			t.Errorf("asset owned by %s, want the syndicate", got)
+		}
+	})
+
+	t.Run("withdrawals only go to members", func(t *testing.T) {
+		p := setup(t)
+		userAsset := holding(t, p)
+
+		_, err := asset.TransferSyndicateAsset(p.syndicate.ID, p.member.ID, userAsset.Hash, p.syndicate.ID, p.member.ID, serviceID)
+		if err == nil {
+			t.Errorf("member withdrew without being allowed to manage assets")
+		}
+		_, err = asset.TransferSyndicateAsset(p.syndicate.ID, p.manager.ID, userAsset.Hash, p.syndicate.ID, p.other.ID, serviceID)
+		if err == nil {
+			t.Errorf("withdrew to a user who isn't a member")
+		}
+		_, err = asset.TransferSyndicateAsset(p.syndicate.ID, p.manager.ID, userAsset.Hash, p.syndicate.ID, p.member.ID, serviceID)
+		if err != nil {
+			t.Fatalf("manager failed to withdraw to a member: %s", err)
+		}
+		if got := owner(t, userAsset); got != p.member.ID {
+			t.Errorf("asset owned by %s, want the member", got)
+		}
+	})
+
+	t.Run("liquidation checks every recipient", func(t *testing.T) {
+		p := setup(t)
+		userAsset := holding(t, p)
+
+		_, err := asset.LiquidateSyndicateAssets(p.syndicate.ID, map[string]string{userAsset.Hash: p.other.ID}, nil, serviceID)
+		if err == nil {
+			t.Errorf("liquidated an asset to a user who isn't a member")
+		}
+		_, err = asset.LiquidateSyndicateAssets(p.syndicate.ID, nil, []string{p.member.ID, p.other.ID}, serviceID)
+		if err == nil {
+			t.Errorf("liquidated with a remaining user who isn't a member")
+		}
+		if got := owner(t, userAsset); got != p.syndicate.ID {
+			t.Errorf("failed liquidation moved the asset to %s", got)
+		}
+
+		_, err = asset.LiquidateSyndicateAssets(p.syndicate.ID, nil, []string{p.member.ID}, serviceID)
+		if err != nil {
+			t.Fatalf("failed to liquidate: %s", err)
+		}
+		if got := owner(t, userAsset); got != p.member.ID {
+			t.Errorf("asset owned by %s, want the remaining member", got)
+		}
+	})
+
+	t.Run("liquidation fails on assets that can't move", func(t *testing.T) {
+		p := setup(t)
+		userAsset := holding(t, p)
+		userAsset.LockedToService = null.StringFrom(types.XsynTreasuryUserID.String())
+		_, err := userAsset.Update(passdb.StdConn, boil.Whitelist(boiler.UserAssetColumns.LockedToService))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = asset.LiquidateSyndicateAssets(p.syndicate.ID, nil, []string{p.member.ID}, serviceID)
+		if err == nil {
+			t.Fatalf("liquidated an asset locked to another service")
+		}
+		if got := owner(t, userAsset); got != p.syndicate.ID {
+			t.Errorf("failed liquidation moved the asset to %s", got)
+		}
+	})
+}
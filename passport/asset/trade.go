@@ -0,0 +1,542 @@
+package asset
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	TradeStatusPending  = "PENDING"
+	TradeStatusAccepted = "ACCEPTED"
+	TradeStatusRejected = "REJECTED"
+	TradeStatusCanceled = "CANCELED"
+	TradeStatusExpired  = "EXPIRED"
+	TradeStatusFailed   = "FAILED"
+)
+
+const (
+	TradeSideOffer   = "OFFER"
+	TradeSideRequest = "REQUEST"
+)
+
+const TradeMaxItems = 20
+const TradeMaxDuration = 7 * 24 * time.Hour
+
+var ErrTradeNotPending = fmt.Errorf("trade is no longer pending")
+var ErrTradeNotEscrowed = fmt.Errorf("the offered sups are not in escrow yet")
+var ErrTradeSupsNotEscrowed = fmt.Errorf("the requested sups could not be escrowed")
+
+// TradeItemRequest is an asset put into a trade, 721s are picked by hash and 1155s by their user asset id
+type TradeItemRequest struct {
+	Hash            string `json:"hash,omitempty"`
+	UserAsset1155ID string `json:"user_asset_1155_id,omitempty"`
+	Amount          int    `json:"amount,omitempty"`
+}
+
+// TradeTransfers are the ownership changes made when a trade is accepted
+type TradeTransfers struct {
+	TransferEvents []*boiler.AssetTransferEvent
+	Assets1155     []*boiler.UserAssets1155
+}
+
+// CheckTradableAsset returns an error when a 721 can't change owner off chain:
+// it is locked to a service, rented out, mid mint, in a wallet or staked on the old contract
+func CheckTradableAsset(exec boil.Executor, userAsset *boiler.UserAsset, ownerID string) error {
+	if userAsset.OwnerID != ownerID {
+		return fmt.Errorf("asset %s is not owned by the user", userAsset.Hash)
+	}
+	if userAsset.LockedToService.Valid {
+		return fmt.Errorf("asset %s is locked to a service", userAsset.Hash)
+	}
+	err := CheckNotRented(exec, userAsset.ID)
+	if err != nil {
+		return fmt.Errorf("asset %s: %w", userAsset.Hash, err)
+	}
+	// unlocked at is pushed forward while a mint or unstake signature is valid
+	if userAsset.UnlockedAt.After(time.Now()) {
+		return fmt.Errorf("asset %s is locked", userAsset.Hash)
+	}
+	return checkHeldOffChain(exec, userAsset)
+}
+
+// checkHeldOffChain returns an error unless the asset is held on xsyn rather than in a wallet or the old staking contract
+func checkHeldOffChain(exec boil.Executor, userAsset *boiler.UserAsset) error {
+	onChainStatus, err := boiler.UserAssetOnChainStatuses(
+		boiler.UserAssetOnChainStatusWhere.CollectionID.EQ(userAsset.CollectionID),
+		boiler.UserAssetOnChainStatusWhere.AssetHash.EQ(userAsset.Hash),
+	).One(exec)
+	if err != nil {
+		return err
+	}
+	if onChainStatus.OnChainStatus != string(db.MINTABLE) && onChainStatus.OnChainStatus != string(db.UNSTAKABLE) {
+		return fmt.Errorf("asset %s has on chain status %s", userAsset.Hash, onChainStatus.OnChainStatus)
+	}
+	return nil
+}
+
+// CheckTradableAsset1155 returns an error when the user doesn't hold enough of the 1155 on xsyn
+func CheckTradableAsset1155(asset *boiler.UserAssets1155, ownerID string, amount int) error {
+	if asset.OwnerID != ownerID {
+		return fmt.Errorf("1155 asset %s is not owned by the user", asset.ID)
+	}
+	if asset.ServiceID.Valid {
+		return fmt.Errorf("1155 asset %s is locked to a service", asset.ID)
+	}
+	if amount <= 0 || asset.Count < amount {
+		return fmt.Errorf("1155 asset %s has %d left, %d required", asset.ID, asset.Count, amount)
+	}
+	return nil
+}
+
+// Transactor moves SUPS between accounts, the api's transactor also keeps the cached balances up to date
+type Transactor interface {
+	Transact(nt *xsynTypes.NewTransaction) (string, error)
+}
+
+// tradeEscrowID holds offered assets, offered 721s are locked to it and offered 1155s are moved into a row with it as the service
+var tradeEscrowID = null.StringFrom(xsynTypes.XsynTradeEscrowUserID.String())
+
+// tradeItems checks the assets put into a trade. Offered assets are put in escrow so they can't be used elsewhere while the offer is open,
+// requested assets are only checked, they are checked again when the trade is accepted.
+func tradeItems(exec boil.Executor, tradeID string, side string, ownerID string, reqs []*TradeItemRequest) (boiler.AssetTradeItemSlice, error) {
+	items := boiler.AssetTradeItemSlice{}
+	seen := map[string]bool{}
+	for _, req := range reqs {
+		item := &boiler.AssetTradeItem{
+			TradeID: tradeID,
+			Side:    side,
+			Amount:  1,
+		}
+
+		switch {
+		case req.Hash != "":
+			userAsset, err := boiler.UserAssets(
+				boiler.UserAssetWhere.Hash.EQ(req.Hash),
+				qm.For("UPDATE"),
+			).One(exec)
+			if err != nil {
+				return nil, fmt.Errorf("asset %s: %w", req.Hash, err)
+			}
+			err = CheckTradableAsset(exec, userAsset, ownerID)
+			if err != nil {
+				return nil, err
+			}
+			if side == TradeSideOffer {
+				userAsset.LockedToService = tradeEscrowID
+				_, err = userAsset.Update(exec, boil.Whitelist(boiler.UserAssetColumns.LockedToService))
+				if err != nil {
+					return nil, err
+				}
+			}
+			item.UserAssetID = null.StringFrom(userAsset.ID)
+		case req.UserAsset1155ID != "":
+			asset1155, err := boiler.UserAssets1155S(
+				boiler.UserAssets1155Where.ID.EQ(req.UserAsset1155ID),
+				qm.For("UPDATE"),
+			).One(exec)
+			if err != nil {
+				return nil, fmt.Errorf("1155 asset %s: %w", req.UserAsset1155ID, err)
+			}
+			err = CheckTradableAsset1155(asset1155, ownerID, req.Amount)
+			if err != nil {
+				return nil, err
+			}
+			if side == TradeSideOffer {
+				_, err = Move1155Tx(exec, asset1155, ownerID, tradeEscrowID, req.Amount)
+				if err != nil {
+					return nil, err
+				}
+			}
+			item.UserAsset1155ID = null.StringFrom(asset1155.ID)
+			item.Amount = req.Amount
+		default:
+			return nil, fmt.Errorf("trade item needs a hash or 1155 asset id")
+		}
+
+		key := item.UserAssetID.String + item.UserAsset1155ID.String
+		if seen[key] {
+			return nil, fmt.Errorf("asset %s is in the trade twice", key)
+		}
+		seen[key] = true
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// escrowed1155 returns the escrow held row of an offered 1155
+func escrowed1155(tx boil.Executor, item *boiler.AssetTradeItem, ownerID string) (*boiler.UserAssets1155, error) {
+	source, err := boiler.FindUserAssets1155(tx, item.UserAsset1155ID.String)
+	if err != nil {
+		return nil, err
+	}
+	return boiler.UserAssets1155S(
+		boiler.UserAssets1155Where.OwnerID.EQ(ownerID),
+		boiler.UserAssets1155Where.CollectionID.EQ(source.CollectionID),
+		boiler.UserAssets1155Where.ExternalTokenID.EQ(source.ExternalTokenID),
+		boiler.UserAssets1155Where.ServiceID.EQ(tradeEscrowID),
+		qm.For("UPDATE"),
+	).One(tx)
+}
+
+// escrowed721 returns an offered 721 after checking it is still held in escrow for the offerer
+func escrowed721(tx boil.Executor, item *boiler.AssetTradeItem, ownerID string) (*boiler.UserAsset, error) {
+	userAsset, err := boiler.UserAssets(
+		boiler.UserAssetWhere.ID.EQ(item.UserAssetID.String),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return nil, err
+	}
+	if userAsset.OwnerID != ownerID || userAsset.LockedToService != tradeEscrowID {
+		return nil, fmt.Errorf("asset %s is no longer held for the trade", userAsset.Hash)
+	}
+	return userAsset, nil
+}
+
+// releaseTradeAssets gives the offered assets of a trade back to the offerer
+func releaseTradeAssets(tx boil.Executor, trade *boiler.AssetTrade) error {
+	items, err := boiler.AssetTradeItems(
+		boiler.AssetTradeItemWhere.TradeID.EQ(trade.ID),
+		boiler.AssetTradeItemWhere.Side.EQ(TradeSideOffer),
+	).All(tx)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if item.UserAssetID.Valid {
+			userAsset, err := escrowed721(tx, item, trade.OffererID)
+			if err != nil {
+				return err
+			}
+			userAsset.LockedToService = null.String{}
+			_, err = userAsset.Update(tx, boil.Whitelist(boiler.UserAssetColumns.LockedToService))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		held, err := escrowed1155(tx, item, trade.OffererID)
+		if err != nil {
+			return err
+		}
+		_, err = Move1155Tx(tx, held, trade.OffererID, null.String{}, item.Amount)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateTrade stores a pending offer from the offerer to the recipient after checking both sides own what they put in.
+// The offered assets are put in escrow with the trade, the offered SUPS are escrowed once it's stored.
+// If they can't be the trade is failed and the assets are released.
+func CreateTrade(ucm Transactor, offererID, recipientID string, offerSups, requestSups decimal.Decimal, offer, request []*TradeItemRequest, expiresAt time.Time) (*boiler.AssetTrade, error) {
+	if offererID == recipientID {
+		return nil, fmt.Errorf("cannot trade with yourself")
+	}
+	if offerSups.IsNegative() || requestSups.IsNegative() {
+		return nil, fmt.Errorf("sups amounts cannot be negative")
+	}
+	if len(offer)+len(request) == 0 {
+		return nil, fmt.Errorf("trade has no assets")
+	}
+	if len(offer)+len(request) > TradeMaxItems {
+		return nil, fmt.Errorf("trade has more than %d assets", TradeMaxItems)
+	}
+	if !expiresAt.After(time.Now()) || expiresAt.After(time.Now().Add(TradeMaxDuration)) {
+		return nil, fmt.Errorf("expiry must be within %s", TradeMaxDuration)
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	trade := &boiler.AssetTrade{
+		OffererID:   offererID,
+		RecipientID: recipientID,
+		OfferSups:   offerSups,
+		RequestSups: requestSups,
+		Status:      TradeStatusPending,
+		ExpiresAt:   expiresAt,
+	}
+	err = trade.Insert(tx, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+
+	offerItems, err := tradeItems(tx, trade.ID, TradeSideOffer, offererID, offer)
+	if err != nil {
+		return nil, err
+	}
+	requestItems, err := tradeItems(tx, trade.ID, TradeSideRequest, recipientID, request)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range append(offerItems, requestItems...) {
+		err = item.Insert(tx, boil.Infer())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	if offerSups.GreaterThan(decimal.Zero) {
+		err = escrowOfferSups(ucm, trade.ID)
+		if err != nil {
+			_, serr := SetTradeStatus(trade.ID, TradeStatusFailed, null.StringFrom(err.Error()))
+			if serr != nil {
+				passlog.L.Error().Err(serr).Str("trade_id", trade.ID).Msg("failed to fail trade without escrowed sups")
+			}
+			return nil, fmt.Errorf("escrow offered sups: %w", err)
+		}
+	}
+
+	err = trade.Reload(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
+
+// escrowOfferSups moves the offered SUPS into escrow while the trade is locked, so it can't be closed and settled in between
+func escrowOfferSups(ucm Transactor, tradeID string) error {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	trade, err := boiler.AssetTrades(
+		boiler.AssetTradeWhere.ID.EQ(tradeID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return err
+	}
+	if trade.Status != TradeStatusPending {
+		return ErrTradeNotPending
+	}
+
+	txID, err := EscrowTradeSups(ucm, trade, TradeSideOffer)
+	if err != nil {
+		return err
+	}
+	trade.OfferEscrowTXID = null.StringFrom(txID)
+	_, err = trade.Update(tx, boil.Whitelist(boiler.AssetTradeColumns.OfferEscrowTXID))
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ClaimTrade marks a pending, unexpired trade as accepted inside the db transaction.
+// The row stays locked until the transaction ends, so a trade can only be claimed once.
+// The SUPS asked for are escrowed from the recipient while the row is locked, the caller settles them after commit.
+func ClaimTrade(tx boil.Executor, ucm Transactor, tradeID string, recipientID string) (*boiler.AssetTrade, error) {
+	trade, err := boiler.AssetTrades(
+		boiler.AssetTradeWhere.ID.EQ(tradeID),
+		boiler.AssetTradeWhere.RecipientID.EQ(recipientID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return nil, err
+	}
+	if trade.Status != TradeStatusPending || trade.ExpiresAt.Before(time.Now()) {
+		return nil, ErrTradeNotPending
+	}
+
+	if trade.OfferSups.GreaterThan(decimal.Zero) {
+		escrow, err := db.TransactionGetByReference(tradeSupsReference(trade.ID, tradeSupsOfferEscrow))
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTradeNotEscrowed
+		}
+		if err != nil {
+			return nil, err
+		}
+		trade.OfferEscrowTXID = null.StringFrom(escrow.ID)
+	}
+	if trade.RequestSups.GreaterThan(decimal.Zero) {
+		txID, err := EscrowTradeSups(ucm, trade, TradeSideRequest)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrTradeSupsNotEscrowed, err.Error())
+		}
+		trade.RequestEscrowTXID = null.StringFrom(txID)
+	}
+
+	trade.Status = TradeStatusAccepted
+	trade.CompletedAt = null.TimeFrom(time.Now())
+	_, err = trade.Update(tx, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
+
+// ExecuteTradeAssets moves every asset of the trade to the other side inside the db transaction.
+// Offered assets come out of escrow, requested assets are locked and checked again since they may have changed since the offer was made.
+func ExecuteTradeAssets(tx boil.Executor, trade *boiler.AssetTrade, relatedTransactionID null.String) (*TradeTransfers, error) {
+	items, err := boiler.AssetTradeItems(
+		boiler.AssetTradeItemWhere.TradeID.EQ(trade.ID),
+	).All(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TradeTransfers{}
+	for _, item := range items {
+		fromID, toID := trade.OffererID, trade.RecipientID
+		if item.Side == TradeSideRequest {
+			fromID, toID = trade.RecipientID, trade.OffererID
+		}
+
+		if item.UserAssetID.Valid {
+			var userAsset *boiler.UserAsset
+			serviceID := ""
+			if item.Side == TradeSideOffer {
+				userAsset, err = escrowed721(tx, item, fromID)
+				if err != nil {
+					return nil, err
+				}
+				userAsset.LockedToService = null.String{}
+				serviceID = tradeEscrowID.String
+			} else {
+				userAsset, err = boiler.UserAssets(
+					boiler.UserAssetWhere.ID.EQ(item.UserAssetID.String),
+					qm.For("UPDATE"),
+				).One(tx)
+				if err != nil {
+					return nil, err
+				}
+				err = CheckTradableAsset(tx, userAsset, fromID)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			transferEvent, err := TransferAssetTx(tx, userAsset, fromID, toID, serviceID, relatedTransactionID)
+			if err != nil {
+				return nil, err
+			}
+			result.TransferEvents = append(result.TransferEvents, transferEvent)
+			continue
+		}
+
+		var from *boiler.UserAssets1155
+		if item.Side == TradeSideOffer {
+			from, err = escrowed1155(tx, item, fromID)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			from, err = boiler.UserAssets1155S(
+				boiler.UserAssets1155Where.ID.EQ(item.UserAsset1155ID.String),
+				qm.For("UPDATE"),
+			).One(tx)
+			if err != nil {
+				return nil, err
+			}
+			err = CheckTradableAsset1155(from, fromID, item.Amount)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		to, err := Move1155Tx(tx, from, toID, null.String{}, item.Amount)
+		if err != nil {
+			return nil, err
+		}
+		result.Assets1155 = append(result.Assets1155, from, to)
+	}
+
+	return result, nil
+}
+
+// SetTradeStatus moves a pending trade to a final status and releases its offered assets, it fails if the trade has already been settled.
+// The escrowed SUPS are returned by SettleTradeSups.
+func SetTradeStatus(tradeID string, status string, reason null.String) (*boiler.AssetTrade, error) {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	trade, err := boiler.AssetTrades(
+		boiler.AssetTradeWhere.ID.EQ(tradeID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return nil, err
+	}
+	if trade.Status != TradeStatusPending {
+		return nil, ErrTradeNotPending
+	}
+
+	err = releaseTradeAssets(tx, trade)
+	if err != nil {
+		return nil, err
+	}
+
+	trade.Status = status
+	trade.FailedReason = reason
+	trade.CompletedAt = null.TimeFrom(time.Now())
+	trade.UpdatedAt = time.Now()
+	_, err = trade.Update(tx, boil.Whitelist(
+		boiler.AssetTradeColumns.Status,
+		boiler.AssetTradeColumns.FailedReason,
+		boiler.AssetTradeColumns.CompletedAt,
+		boiler.AssetTradeColumns.UpdatedAt,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
+
+// ExpireTrades marks every pending trade past its expiry as expired and returns them
+func ExpireTrades() (boiler.AssetTradeSlice, error) {
+	trades, err := boiler.AssetTrades(
+		boiler.AssetTradeWhere.Status.EQ(TradeStatusPending),
+		boiler.AssetTradeWhere.ExpiresAt.LT(time.Now()),
+	).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	expired := boiler.AssetTradeSlice{}
+	for _, trade := range trades {
+		t, err := SetTradeStatus(trade.ID, TradeStatusExpired, null.String{})
+		if errors.Is(err, ErrTradeNotPending) {
+			continue
+		}
+		if err != nil {
+			return expired, err
+		}
+		expired = append(expired, t)
+	}
+	return expired, nil
+}
@@ -0,0 +1,182 @@
+package asset
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passdb"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// the SUPS movements of a trade, each has its own ledger reference so it happens at most once
+const (
+	tradeSupsOfferEscrow   = "offer_escrow"
+	tradeSupsRequestEscrow = "request_escrow"
+	tradeSupsOffer         = "offer"
+	tradeSupsRequest       = "request"
+)
+
+func tradeSupsReference(tradeID string, movement string) string {
+	return fmt.Sprintf("asset_trade|%s|%s", tradeID, movement)
+}
+
+// TransactOnce makes the transaction unless its reference is already in the ledger, either way the ledger transaction id is returned
+func TransactOnce(ucm Transactor, nt *xsynTypes.NewTransaction) (string, error) {
+	existing, err := db.TransactionGetByReference(string(nt.TransactionReference))
+	if err == nil {
+		return existing.ID, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return "", err
+	}
+
+	id, err := ucm.Transact(nt)
+	if err != nil {
+		// made by a settlement running at the same time
+		existing, lookupErr := db.TransactionGetByReference(string(nt.TransactionReference))
+		if lookupErr == nil {
+			return existing.ID, nil
+		}
+		return "", err
+	}
+	return id, nil
+}
+
+// EscrowTradeSups moves the SUPS one side put into the trade to the escrow account and returns the ledger transaction.
+// It fails when the user doesn't have enough SUPS.
+func EscrowTradeSups(ucm Transactor, trade *boiler.AssetTrade, side string) (string, error) {
+	userID, amount, movement := trade.OffererID, trade.OfferSups, tradeSupsOfferEscrow
+	if side == TradeSideRequest {
+		userID, amount, movement = trade.RecipientID, trade.RequestSups, tradeSupsRequestEscrow
+	}
+
+	user, err := boiler.FindUser(passdb.StdConn, userID)
+	if err != nil {
+		return "", err
+	}
+	escrow, err := boiler.FindUser(passdb.StdConn, xsynTypes.XsynTradeEscrowUserID.String())
+	if err != nil {
+		return "", err
+	}
+
+	return TransactOnce(ucm, &xsynTypes.NewTransaction{
+		DebitAccountID:       user.AccountID,
+		CreditAccountID:      escrow.AccountID,
+		TransactionReference: xsynTypes.TransactionReference(tradeSupsReference(trade.ID, movement)),
+		Description:          fmt.Sprintf("Trade %s: SUPS held in escrow", trade.ID),
+		Amount:               amount,
+		Group:                xsynTypes.TransactionGroupAssetManagement,
+		SubGroup:             xsynTypes.TransactionSubGroupTransfer,
+	})
+}
+
+// SettleTradeSups pays out the escrowed SUPS of an accepted trade, or returns them for any other final status, and marks the trade settled.
+// It only goes by what is in the ledger, so it can be run again after a failure or a restart.
+func SettleTradeSups(ucm Transactor, tradeID string) (*boiler.AssetTrade, error) {
+	trade, err := boiler.FindAssetTrade(passdb.StdConn, tradeID)
+	if err != nil {
+		return nil, err
+	}
+	if trade.Status == TradeStatusPending {
+		return nil, fmt.Errorf("trade %s is still pending", trade.ID)
+	}
+	if trade.SupsSettledAt.Valid {
+		return trade, nil
+	}
+
+	escrow, err := boiler.FindUser(passdb.StdConn, xsynTypes.XsynTradeEscrowUserID.String())
+	if err != nil {
+		return nil, err
+	}
+	offerer, err := boiler.FindUser(passdb.StdConn, trade.OffererID)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := boiler.FindUser(passdb.StdConn, trade.RecipientID)
+	if err != nil {
+		return nil, err
+	}
+
+	sides := []struct {
+		escrowMovement string
+		payoutMovement string
+		owner          *boiler.User
+		counterparty   *boiler.User
+		payoutTXID     *null.String
+	}{
+		{tradeSupsOfferEscrow, tradeSupsOffer, offerer, recipient, &trade.OfferTXID},
+		{tradeSupsRequestEscrow, tradeSupsRequest, recipient, offerer, &trade.RequestTXID},
+	}
+	for _, side := range sides {
+		escrowRef := tradeSupsReference(trade.ID, side.escrowMovement)
+		escrowed, err := db.TransactionGetByReference(escrowRef)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		nt := &xsynTypes.NewTransaction{
+			DebitAccountID:       escrow.AccountID,
+			CreditAccountID:      side.counterparty.AccountID,
+			TransactionReference: xsynTypes.TransactionReference(tradeSupsReference(trade.ID, side.payoutMovement)),
+			Description:          fmt.Sprintf("Trade %s: SUPS from %s", trade.ID, side.owner.Username),
+			Amount:               escrowed.Amount,
+			Group:                xsynTypes.TransactionGroupAssetManagement,
+			SubGroup:             xsynTypes.TransactionSubGroupTransfer,
+			RelatedTransactionID: null.StringFrom(escrowed.ID),
+		}
+		if trade.Status != TradeStatusAccepted {
+			nt.CreditAccountID = side.owner.AccountID
+			nt.TransactionReference = xsynTypes.TransactionReference(fmt.Sprintf("REFUND %s", escrowRef))
+			nt.Description = fmt.Sprintf("Trade %s: escrowed SUPS returned, trade %s", trade.ID, trade.Status)
+			nt.SubGroup = xsynTypes.TransactionSubGroupRefund
+		}
+
+		txID, err := TransactOnce(ucm, nt)
+		if err != nil {
+			return nil, fmt.Errorf("settle %s: %w", side.escrowMovement, err)
+		}
+		if trade.Status == TradeStatusAccepted {
+			*side.payoutTXID = null.StringFrom(txID)
+		}
+	}
+
+	count, err := boiler.AssetTrades(
+		boiler.AssetTradeWhere.ID.EQ(trade.ID),
+		boiler.AssetTradeWhere.SupsSettledAt.IsNull(),
+	).UpdateAll(passdb.StdConn, boiler.M{
+		boiler.AssetTradeColumns.OfferTXID:     trade.OfferTXID,
+		boiler.AssetTradeColumns.RequestTXID:   trade.RequestTXID,
+		boiler.AssetTradeColumns.SupsSettledAt: null.TimeFrom(time.Now()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		// settled by another run
+		return boiler.FindAssetTrade(passdb.StdConn, trade.ID)
+	}
+
+	err = trade.Reload(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
+
+// UnsettledTrades returns the trades that are no longer pending but still have SUPS to pay out or return
+func UnsettledTrades() (boiler.AssetTradeSlice, error) {
+	return boiler.AssetTrades(
+		boiler.AssetTradeWhere.Status.NEQ(TradeStatusPending),
+		boiler.AssetTradeWhere.SupsSettledAt.IsNull(),
+		qm.OrderBy(boiler.AssetTradeColumns.UpdatedAt),
+	).All(passdb.StdConn)
+}
@@ -0,0 +1,228 @@
+package asset_test
+
+import (
+	"testing"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+	"xsyn-services/types"
+
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestMain(m *testing.M) {
+	passdbtest.Main(m)
+}
+
+func TestTrades(t *testing.T) {
+	passdbtest.Require(t)
+
+	collection := passdbtest.Collection(t)
+	sups := decimal.New(100, 18)
+
+	type party struct {
+		offerer, recipient     *boiler.User
+		offered, requested     *boiler.UserAsset
+		offered1155            *boiler.UserAssets1155
+		offererSups, recipSups decimal.Decimal
+	}
+	setup := func(t *testing.T) *party {
+		p := &party{
+			offerer:     passdbtest.User(t),
+			recipient:   passdbtest.User(t),
+			offererSups: sups,
+			recipSups:   sups,
+		}
+		passdbtest.Fund(t, p.offerer, sups)
+		passdbtest.Fund(t, p.recipient, sups)
+		p.offered = passdbtest.Asset(t, collection, p.offerer)
+		p.requested = passdbtest.Asset(t, collection, p.recipient)
+		p.offered1155 = passdbtest.Asset1155(t, collection, p.offerer, 1, 5)
+		return p
+	}
+	create := func(t *testing.T, ucm asset.Transactor, p *party, offerSups, requestSups decimal.Decimal) *boiler.AssetTrade {
+		t.Helper()
+		trade, err := asset.CreateTrade(ucm, p.offerer.ID, p.recipient.ID, offerSups, requestSups,
+			[]*asset.TradeItemRequest{{Hash: p.offered.Hash}, {UserAsset1155ID: p.offered1155.ID, Amount: 2}},
+			[]*asset.TradeItemRequest{{Hash: p.requested.Hash}},
+			time.Now().Add(time.Hour),
+		)
+		if err != nil {
+			t.Fatalf("failed to create trade: %s", err)
+		}
+		return trade
+	}
+	accept := func(t *testing.T, ucm asset.Transactor, trade *boiler.AssetTrade, recipientID string) error {
+		t.Helper()
+		tx, err := passdb.StdConn.Begin()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer tx.Rollback()
+		claimed, err := asset.ClaimTrade(tx, ucm, trade.ID, recipientID)
+		if err != nil {
+			return err
+		}
+		_, err = asset.ExecuteTradeAssets(tx, claimed, claimed.OfferEscrowTXID)
+		if err != nil {
+			return err
+		}
+		return tx.Commit()
+	}
+	owner := func(t *testing.T, userAsset *boiler.UserAsset) (string, null.String) {
+		t.Helper()
+		err := userAsset.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return userAsset.OwnerID, userAsset.LockedToService
+	}
+	count1155 := func(t *testing.T, ownerID string, serviceID null.String) int {
+		t.Helper()
+		rows, err := boiler.UserAssets1155S(
+			boiler.UserAssets1155Where.OwnerID.EQ(ownerID),
+			boiler.UserAssets1155Where.CollectionID.EQ(collection.ID),
+		).All(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		total := 0
+		for _, row := range rows {
+			if row.ServiceID == serviceID {
+				total += row.Count
+			}
+		}
+		return total
+	}
+	escrowID := null.StringFrom(types.XsynTradeEscrowUserID.String())
+
+	t.Run("offer holds the offered assets and sups", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		p := setup(t)
+		create(t, ucm, p, decimal.New(30, 18), decimal.Zero)
+
+		if _, locked := owner(t, p.offered); locked != escrowID {
+			t.Errorf("offered asset locked to %v, want escrow", locked)
+		}
+		if got := count1155(t, p.offerer.ID, escrowID); got != 2 {
+			t.Errorf("escrowed 1155s = %d, want 2", got)
+		}
+		if got := passdbtest.Balance(t, p.offerer); !got.Equal(decimal.New(70, 18)) {
+			t.Errorf("offerer balance = %s, want 70 SUPS", got)
+		}
+
+		_, err := asset.CreateTrade(ucm, p.offerer.ID, p.recipient.ID, decimal.Zero, decimal.Zero,
+			[]*asset.TradeItemRequest{{Hash: p.offered.Hash}}, nil, time.Now().Add(time.Hour))
+		if err == nil {
+			t.Errorf("offered an asset that is already in an offer")
+		}
+	})
+
+	t.Run("accepting pays out both sides", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		p := setup(t)
+		trade := create(t, ucm, p, decimal.New(30, 18), decimal.New(10, 18))
+
+		err := accept(t, ucm, trade, p.recipient.ID)
+		if err != nil {
+			t.Fatalf("failed to accept trade: %s", err)
+		}
+		settled, err := asset.SettleTradeSups(ucm, trade.ID)
+		if err != nil {
+			t.Fatalf("failed to settle trade: %s", err)
+		}
+		if !settled.SupsSettledAt.Valid || !settled.OfferTXID.Valid || !settled.RequestTXID.Valid {
+			t.Errorf("trade not settled: %+v", settled)
+		}
+
+		if got := passdbtest.Balance(t, p.offerer); !got.Equal(decimal.New(80, 18)) {
+			t.Errorf("offerer balance = %s, want 80 SUPS", got)
+		}
+		if got := passdbtest.Balance(t, p.recipient); !got.Equal(decimal.New(120, 18)) {
+			t.Errorf("recipient balance = %s, want 120 SUPS", got)
+		}
+		if ownerID, locked := owner(t, p.offered); ownerID != p.recipient.ID || locked.Valid {
+			t.Errorf("offered asset owned by %s locked to %v, want the recipient unlocked", ownerID, locked)
+		}
+		if ownerID, _ := owner(t, p.requested); ownerID != p.offerer.ID {
+			t.Errorf("requested asset owned by %s, want the offerer", ownerID)
+		}
+		if got := count1155(t, p.recipient.ID, null.String{}); got != 2 {
+			t.Errorf("recipient 1155s = %d, want 2", got)
+		}
+
+		// settling again moves nothing
+		_, err = asset.SettleTradeSups(ucm, trade.ID)
+		if err != nil {
+			t.Fatalf("failed to settle trade again: %s", err)
+		}
+		if got := passdbtest.Balance(t, p.recipient); !got.Equal(decimal.New(120, 18)) {
+			t.Errorf("recipient balance after second settle = %s, want 120 SUPS", got)
+		}
+	})
+
+	t.Run("recipient without the sups can't accept", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		p := setup(t)
+		trade := create(t, ucm, p, decimal.Zero, decimal.New(10, 18))
+
+		ucm.Fail = true
+		err := accept(t, ucm, trade, p.recipient.ID)
+		if err == nil {
+			t.Fatalf("accepted a trade without paying")
+		}
+		err = trade.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if trade.Status != asset.TradeStatusPending {
+			t.Errorf("trade status = %s, want %s", trade.Status, asset.TradeStatusPending)
+		}
+	})
+
+	t.Run("canceling returns the escrow", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		p := setup(t)
+		trade := create(t, ucm, p, decimal.New(30, 18), decimal.Zero)
+
+		_, err := asset.SetTradeStatus(trade.ID, asset.TradeStatusCanceled, null.String{})
+		if err != nil {
+			t.Fatalf("failed to cancel trade: %s", err)
+		}
+		_, err = asset.SettleTradeSups(ucm, trade.ID)
+		if err != nil {
+			t.Fatalf("failed to settle trade: %s", err)
+		}
+
+		if got := passdbtest.Balance(t, p.offerer); !got.Equal(sups) {
+			t.Errorf("offerer balance = %s, want %s", got, sups)
+		}
+		if ownerID, locked := owner(t, p.offered); ownerID != p.offerer.ID || locked.Valid {
+			t.Errorf("offered asset owned by %s locked to %v, want the offerer unlocked", ownerID, locked)
+		}
+		if got := count1155(t, p.offerer.ID, null.String{}); got != 5 {
+			t.Errorf("offerer 1155s = %d, want 5", got)
+		}
+
+		err = accept(t, ucm, trade, p.recipient.ID)
+		if err == nil {
+			t.Errorf("accepted a canceled trade")
+		}
+	})
+
+	t.Run("offer without the sups is failed and released", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{Fail: true}
+		p := setup(t)
+		_, err := asset.CreateTrade(ucm, p.offerer.ID, p.recipient.ID, decimal.New(30, 18), decimal.Zero,
+			[]*asset.TradeItemRequest{{Hash: p.offered.Hash}}, nil, time.Now().Add(time.Hour))
+		if err == nil {
+			t.Fatalf("created an offer without escrowing the sups")
+		}
+		if _, locked := owner(t, p.offered); locked.Valid {
+			t.Errorf("offered asset still locked to %v", locked)
+		}
+	})
+}
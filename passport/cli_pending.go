@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"xsyn-services/passport/payments"
+
+	"github.com/urfave/cli/v2"
+)
+
+// pendingCommand lets support manage stuck withdraws from the terminal.
+// It talks to the admin routes of a running server instead of the database, so the server's balance cache stays correct after a rollback.
+func pendingCommand() *cli.Command {
+	flags := []cli.Flag{
+		&cli.StringFlag{Name: "api_host", Value: "http://localhost:8086", EnvVars: []string{envPrefix + "_ADMIN_API_HOST"}, Usage: "Host of the passport API"},
+		&cli.StringFlag{Name: "admin_api_key", Value: "", EnvVars: []string{envPrefix + "_ADMIN_API_KEY"}, Usage: "Admin api key, recorded as the operator of any action", Required: true},
+		&cli.BoolFlag{Name: "nft_1155", Value: false, Usage: "Manage pending 1155 rollbacks instead of pending SUPS refunds"},
+	}
+	actionFlags := append([]cli.Flag{
+		&cli.StringFlag{Name: "reason", Usage: "Why the action is being taken", Required: true},
+	}, flags...)
+
+	return &cli.Command{
+		Name:  "pending",
+		Usage: "List and resolve pending withdraw refunds and 1155 rollbacks",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "list",
+				Usage: "list pending withdraws with their on chain status",
+				Flags: append([]cli.Flag{
+					&cli.BoolFlag{Name: "all", Value: false, Usage: "Include resolved withdraws"},
+				}, flags...),
+				Action: pendingList,
+			},
+			{
+				Name:      "confirm",
+				Usage:     "force confirm a pending withdraw with the tx hash it was claimed with",
+				ArgsUsage: "<id> <tx_hash>",
+				Flags:     actionFlags,
+				Action:    pendingAction("confirm", 2),
+			},
+			{
+				Name:      "rollback",
+				Usage:     "force roll back an expired pending withdraw",
+				ArgsUsage: "<id>",
+				Flags:     actionFlags,
+				Action:    pendingAction("rollback", 1),
+			},
+			{
+				Name:      "cancel",
+				Usage:     "cancel the automatic rollback of a pending withdraw",
+				ArgsUsage: "<id>",
+				Flags:     actionFlags,
+				Action:    pendingAction("cancel", 1),
+			},
+		},
+	}
+}
+
+func pendingPath(c *cli.Context) string {
+	if c.Bool("nft_1155") {
+		return "/api/admin/pending/1155_rollbacks"
+	}
+	return "/api/admin/pending/refunds"
+}
+
+func pendingRequest(c *cli.Context, method string, path string, body interface{}, result interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, strings.TrimRight(c.String("api_host"), "/")+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Authorization", c.String("admin_api_key"))
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s: %d %s", method, path, resp.StatusCode, string(respBody))
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(respBody, result)
+}
+
+func pendingList(c *cli.Context) error {
+	path := pendingPath(c)
+	if c.Bool("all") {
+		path += "?include_resolved=true"
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	if c.Bool("nft_1155") {
+		resp := struct {
+			RollbackEnabled      bool                                `json:"rollback_enabled"`
+			AvantFailureCount    int                                 `json:"avant_failure_count"`
+			Pending1155Rollbacks []*payments.Pending1155RollbackItem `json:"pending_1155_rollbacks"`
+		}{}
+		err := pendingRequest(c, http.MethodGet, path, nil, &resp)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("automatic rollback enabled: %t (avant failures: %d)\n\n", resp.RollbackEnabled, resp.AvantFailureCount)
+		fmt.Fprintln(w, "ID\tUSER\tASSET\tCOUNT\tSTATUS\tEXPIRES\tTX HASH\tACTIONS")
+		for _, item := range resp.Pending1155Rollbacks {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\t%d\n",
+				item.ID, item.UserID, item.AssetID, item.Count, item.Status, item.RefundedAt.Format(time.RFC3339), item.TXHash, len(item.Actions))
+		}
+		return nil
+	}
+
+	resp := struct {
+		RollbackEnabled   bool                          `json:"rollback_enabled"`
+		AvantFailureCount int                           `json:"avant_failure_count"`
+		PendingRefunds    []*payments.PendingRefundItem `json:"pending_refunds"`
+	}{}
+	err := pendingRequest(c, http.MethodGet, path, nil, &resp)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("automatic rollback enabled: %t (avant failures: %d)\n\n", resp.RollbackEnabled, resp.AvantFailureCount)
+	fmt.Fprintln(w, "ID\tUSER\tSUPS\tSTATUS\tEXPIRES\tTX HASH\tACTIONS")
+	for _, item := range resp.PendingRefunds {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d\n",
+			item.ID, item.UserID, item.AmountSups.Shift(-18).StringFixed(4), item.Status, item.RefundedAt.Format(time.RFC3339), item.TXHash, len(item.Actions))
+	}
+	return nil
+}
+
+func pendingAction(action string, args int) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		if c.NArg() != args {
+			return fmt.Errorf("expected %d arguments, got %d", args, c.NArg())
+		}
+
+		body := struct {
+			TxHash string `json:"tx_hash,omitempty"`
+			Reason string `json:"reason"`
+		}{
+			Reason: c.String("reason"),
+		}
+		if args > 1 {
+			body.TxHash = c.Args().Get(1)
+		}
+
+		result := struct {
+			ID string `json:"id"`
+		}{}
+		err := pendingRequest(c, http.MethodPost, fmt.Sprintf("%s/%s/%s", pendingPath(c), c.Args().Get(0), action), body, &result)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s: %s done\n", result.ID, action)
+		return nil
+	}
+}
@@ -0,0 +1,95 @@
+package comms
+
+import (
+	"fmt"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/api"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type AssetRentalAcceptReq struct {
+	ApiKey      string `json:"api_key"`
+	RentalID    string `json:"rental_id"`
+	SyndicateID string `json:"syndicate_id"`
+	MemberID    string `json:"member_id"`
+}
+
+type AssetRentalAcceptResp struct {
+	EndsAt null.Time `json:"ends_at"`
+}
+
+// AssetRentalAcceptHandler accepts a rental offered to a syndicate on behalf of a member, the fee is paid from the syndicate account.
+// The member has to be allowed to spend syndicate funds and is recorded on the rental.
+func (s *S) AssetRentalAcceptHandler(req AssetRentalAcceptReq, resp *AssetRentalAcceptResp) error {
+	_, err := IsServerClient(req.ApiKey)
+	if err != nil {
+		passlog.L.Error().Err(err).Msg("failed to get service id - AssetRentalAcceptHandler")
+		return err
+	}
+
+	if req.MemberID == "" {
+		return fmt.Errorf("member id is required")
+	}
+
+	rental, err := api.StartRental(s.UserCacheMap, req.RentalID, req.SyndicateID, req.MemberID)
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to start rental - AssetRentalAcceptHandler")
+		return err
+	}
+
+	resp.EndsAt = rental.EndsAt
+	return nil
+}
+
+type ActiveAssetRental struct {
+	RentalID          string      `json:"rental_id"`
+	Hash              string      `json:"hash"`
+	OwnerID           string      `json:"owner_id"`
+	RenterUserID      null.String `json:"renter_user_id"`
+	RenterSyndicateID null.String `json:"renter_syndicate_id"`
+	EndsAt            null.Time   `json:"ends_at"`
+}
+
+type AssetRentalListActiveReq struct {
+	ApiKey string `json:"api_key"`
+}
+
+type AssetRentalListActiveResp struct {
+	Rentals []*ActiveAssetRental `json:"rentals"`
+}
+
+// AssetRentalListActiveHandler returns every active rental so supremacy can resync usage rights after a restart
+func (s *S) AssetRentalListActiveHandler(req AssetRentalListActiveReq, resp *AssetRentalListActiveResp) error {
+	_, err := IsServerClient(req.ApiKey)
+	if err != nil {
+		passlog.L.Error().Err(err).Msg("failed to get service id - AssetRentalListActiveHandler")
+		return err
+	}
+
+	rentals, err := boiler.AssetRentals(
+		boiler.AssetRentalWhere.Status.EQ(asset.RentalStatusActive),
+		qm.Load(boiler.AssetRentalRels.UserAsset),
+	).All(passdb.StdConn)
+	if err != nil {
+		passlog.L.Error().Err(err).Msg("failed to get active rentals - AssetRentalListActiveHandler")
+		return fmt.Errorf("failed to get active rentals")
+	}
+
+	resp.Rentals = []*ActiveAssetRental{}
+	for _, rental := range rentals {
+		resp.Rentals = append(resp.Rentals, &ActiveAssetRental{
+			RentalID:          rental.ID,
+			Hash:              rental.R.UserAsset.Hash,
+			OwnerID:           rental.OwnerID,
+			RenterUserID:      rental.RenterUserID,
+			RenterSyndicateID: rental.RenterSyndicateID,
+			EndsAt:            rental.EndsAt,
+		})
+	}
+	return nil
+}
@@ -2,7 +2,9 @@ package comms
 
 import (
 	"xsyn-services/boiler"
+	"xsyn-services/passport/api"
 	"xsyn-services/passport/asset"
+	"xsyn-services/passport/db"
 	"xsyn-services/passport/passdb"
 	"xsyn-services/passport/passlog"
 	xsynTypes "xsyn-services/types"
@@ -31,6 +33,17 @@ func (s *S) AssetTransferOwnershipHandler(req AssetTransferOwnershipReq, resp *A
 		return err
 	}
 
+	userAsset, err := boiler.UserAssets(boiler.UserAssetWhere.Hash.EQ(req.Hash)).One(passdb.StdConn)
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to get user asset - AssetTransferOwnershipHandler")
+		return err
+	}
+	err = asset.CheckNotRented(passdb.StdConn, userAsset.ID)
+	if err != nil {
+		passlog.L.Warn().Err(err).Interface("req", req).Msg("cannot transfer rented asset - AssetTransferOwnershipHandler")
+		return err
+	}
+
 	_, transferID, err := asset.TransferAsset(req.Hash, req.FromOwnerID, req.ToOwnerID, serviceID, true, req.RelatedTransactionID, nil)
 	if err != nil {
 		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to transfer asset - AssetTransferOwnershipHandler")
@@ -41,6 +54,64 @@ func (s *S) AssetTransferOwnershipHandler(req AssetTransferOwnershipReq, resp *A
 	return nil
 }
 
+type Asset1155TransferReq struct {
+	ApiKey         string `json:"api_key,omitempty"`
+	FromUserID     string `json:"from_user_id"`
+	ToUserID       string `json:"to_user_id"`
+	CollectionSlug string `json:"collection_slug"`
+	TokenID        int    `json:"token_id"`
+	Amount         int    `json:"amount"`
+}
+
+type Asset1155TransferResp struct {
+	TransferEventID int64 `json:"transfer_event_id"`
+	FromCount       int   `json:"from_count"`
+	ToCount         int   `json:"to_count"`
+}
+
+// Asset1155TransferHandler moves an amount of a 1155 token locked to the service from one user to another, it stays locked to the service
+func (s *S) Asset1155TransferHandler(req Asset1155TransferReq, resp *Asset1155TransferResp) error {
+	serviceID, err := IsServerClient(req.ApiKey)
+	if err != nil {
+		passlog.L.Error().Err(err).Msg("failed to get service id - Asset1155TransferHandler")
+		return err
+	}
+
+	collection, err := db.CollectionBySlug(req.CollectionSlug)
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to get collection - Asset1155TransferHandler")
+		return err
+	}
+
+	event, err := asset.Transfer1155(req.FromUserID, req.ToUserID, collection.ID, req.TokenID, req.Amount, null.StringFrom(serviceID), serviceID)
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to transfer 1155 asset - Asset1155TransferHandler")
+		return err
+	}
+
+	assets, err := boiler.UserAssets1155S(
+		boiler.UserAssets1155Where.ID.IN([]string{event.FromAssetID, event.ToAssetID}),
+		qm.Select(boiler.UserAssets1155Columns.ID, boiler.UserAssets1155Columns.Count),
+	).All(passdb.StdConn)
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to get 1155 counts - Asset1155TransferHandler")
+		return err
+	}
+	for _, a := range assets {
+		if a.ID == event.FromAssetID {
+			resp.FromCount = a.Count
+		}
+		if a.ID == event.ToAssetID {
+			resp.ToCount = a.Count
+		}
+	}
+
+	go api.Publish1155Transfer(event.ID)
+
+	resp.TransferEventID = event.ID
+	return nil
+}
+
 type GetAssetTransferEventsResp struct {
 	TransferEvents []*xsynTypes.TransferEvent `json:"transfer_events"`
 }
@@ -3,13 +3,31 @@ package comms
 import (
 	"fmt"
 	"github.com/volatiletech/null/v8"
-	"github.com/volatiletech/sqlboiler/v4/boil"
 	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
 	"xsyn-services/passport/passdb"
 	"xsyn-services/passport/passlog"
 )
 
+// serviceLockAsset finds the asset a service wants to lock, renew or unlock
+func serviceLockAsset(collectionSlug string, tokenID int64, ownerID string, hash string) (*boiler.UserAsset, error) {
+	// get collection
+	collection, err := boiler.Collections(boiler.CollectionWhere.Slug.EQ(collectionSlug)).One(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	// get asset
+	return boiler.UserAssets(
+		boiler.UserAssetWhere.OwnerID.EQ(ownerID),
+		boiler.UserAssetWhere.TokenID.EQ(tokenID),
+		boiler.UserAssetWhere.Hash.EQ(hash),
+		boiler.UserAssetWhere.CollectionID.EQ(collection.ID),
+	).One(passdb.StdConn)
+}
+
 type AssetLockToServiceResp struct {
+	ExpiresAt null.Time `json:"expires_at"`
 }
 
 type AssetLockToServiceReq struct {
@@ -18,9 +36,12 @@ type AssetLockToServiceReq struct {
 	TokenID        int64  `json:"token_id,omitempty"`
 	OwnerID        string `json:"owner_id,omitempty"`
 	Hash           string `json:"hash,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+	TTLSeconds     int    `json:"ttl_seconds,omitempty"`
 }
 
-// AssetLockToServiceHandler request a service lock of an asset
+// AssetLockToServiceHandler leases an asset to the calling service.
+// The lease has to be renewed with AssetLockRenewHandler before it expires or the asset is handed back to xsyn.
 func (s *S) AssetLockToServiceHandler(req AssetLockToServiceReq, resp *AssetLockToServiceResp) error {
 	serviceID, err := IsServerClient(req.ApiKey)
 	if err != nil {
@@ -28,38 +49,68 @@ func (s *S) AssetLockToServiceHandler(req AssetLockToServiceReq, resp *AssetLock
 		return err
 	}
 
-	// get collection
-	collection, err := boiler.Collections(boiler.CollectionWhere.Slug.EQ(req.CollectionSlug)).One(passdb.StdConn)
+	ttl, err := asset.ServiceLockTTL(req.TTLSeconds)
 	if err != nil {
-		passlog.L.Error().Err(err).Str("req.CollectionSlug", req.CollectionSlug).Msg("failed to get collection - AssetLockToServiceHandler")
 		return err
 	}
 
-	// get asset
-	userAsset, err := boiler.UserAssets(
-		boiler.UserAssetWhere.OwnerID.EQ(req.OwnerID),
-		boiler.UserAssetWhere.TokenID.EQ(req.TokenID),
-		boiler.UserAssetWhere.Hash.EQ(req.Hash),
-		boiler.UserAssetWhere.CollectionID.EQ(collection.ID),
-	).One(passdb.StdConn)
+	userAsset, err := serviceLockAsset(req.CollectionSlug, req.TokenID, req.OwnerID, req.Hash)
 	if err != nil {
 		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to get user asset - AssetLockToServiceHandler")
 		return err
 	}
 
-	// check if asset is lockable
-	// already locked by this service
-	if userAsset.LockedToService.Valid && userAsset.LockedToService.String == serviceID {
-		passlog.L.Warn().Msg("asset is already locked by this service - AssetUnlockFromServiceHandler")
-		return nil
+	lease, err := asset.AcquireServiceLock(userAsset.ID, serviceID, req.Reason, ttl)
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("req", req).Str("serviceID", serviceID).Msg("failed to lock asset - AssetLockToServiceHandler")
+		return err
+	}
+
+	if lease != nil {
+		resp.ExpiresAt = null.TimeFrom(lease.ExpiresAt)
+	}
+	return nil
+}
+
+type AssetLockRenewReq struct {
+	ApiKey         string `json:"api_key,omitempty"`
+	CollectionSlug string `json:"collection_slug,omitempty"`
+	TokenID        int64  `json:"token_id,omitempty"`
+	OwnerID        string `json:"owner_id,omitempty"`
+	Hash           string `json:"hash,omitempty"`
+	TTLSeconds     int    `json:"ttl_seconds,omitempty"`
+}
+
+type AssetLockRenewResp struct {
+	ExpiresAt null.Time `json:"expires_at"`
+}
+
+// AssetLockRenewHandler is the heartbeat of a service lock, it fails once the lease has expired or been released
+func (s *S) AssetLockRenewHandler(req AssetLockRenewReq, resp *AssetLockRenewResp) error {
+	serviceID, err := IsServerClient(req.ApiKey)
+	if err != nil {
+		passlog.L.Error().Err(err).Msg("failed to get service id - AssetLockRenewHandler")
+		return err
+	}
+
+	ttl, err := asset.ServiceLockTTL(req.TTLSeconds)
+	if err != nil {
+		return err
 	}
-	// already locked by different service
-	if userAsset.LockedToService.Valid && userAsset.LockedToService.String != serviceID {
-		err := fmt.Errorf("attempted to lock user asset that is locked to a different service")
-		passlog.L.Error().Err(err).Str("userAsset.LockedToService.String", userAsset.LockedToService.String).Str("serviceID", serviceID).Msg("asset is locked by another service - AssetLockToServiceHandler")
+
+	userAsset, err := serviceLockAsset(req.CollectionSlug, req.TokenID, req.OwnerID, req.Hash)
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to get user asset - AssetLockRenewHandler")
 		return err
 	}
 
+	lease, err := asset.RenewServiceLock(userAsset.ID, serviceID, ttl)
+	if err != nil {
+		passlog.L.Warn().Err(err).Interface("req", req).Str("serviceID", serviceID).Msg("failed to renew asset lock - AssetLockRenewHandler")
+		return err
+	}
+
+	resp.ExpiresAt = null.TimeFrom(lease.ExpiresAt)
 	return nil
 }
 
@@ -82,20 +133,7 @@ func (s *S) AssetUnlockFromServiceHandler(req AssetUnlockToServiceReq, resp *Ass
 		return err
 	}
 
-	// get collection
-	collection, err := boiler.Collections(boiler.CollectionWhere.Slug.EQ(req.CollectionSlug)).One(passdb.StdConn)
-	if err != nil {
-		passlog.L.Error().Err(err).Str("req.CollectionSlug", req.CollectionSlug).Msg("failed to get collection - AssetUnlockFromServiceHandler")
-		return err
-	}
-
-	// get asset
-	userAsset, err := boiler.UserAssets(
-		boiler.UserAssetWhere.OwnerID.EQ(req.OwnerID),
-		boiler.UserAssetWhere.TokenID.EQ(req.TokenID),
-		boiler.UserAssetWhere.Hash.EQ(req.Hash),
-		boiler.UserAssetWhere.CollectionID.EQ(collection.ID),
-	).One(passdb.StdConn)
+	userAsset, err := serviceLockAsset(req.CollectionSlug, req.TokenID, req.OwnerID, req.Hash)
 	if err != nil {
 		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to get user asset - AssetUnlockFromServiceHandler")
 		return err
@@ -107,17 +145,10 @@ func (s *S) AssetUnlockFromServiceHandler(req AssetUnlockToServiceReq, resp *Ass
 		return err
 	}
 
-	if userAsset.LockedToService.String != serviceID {
-		err := fmt.Errorf("attempted to unlock user asset that is locked to a different service")
-		passlog.L.Error().Err(err).Str("userAsset.LockedToService.String", userAsset.LockedToService.String).Str("serviceID", serviceID).Msg("asset is locked by another service - AssetUnlockFromServiceHandler")
-		return err
-	}
-
 	// unlock!
-	userAsset.LockedToService = null.NewString("", false)
-	_, err = userAsset.Update(passdb.StdConn, boil.Infer())
+	_, err = asset.ReleaseServiceLock(userAsset.ID, serviceID, asset.LockReleaseService, null.String{})
 	if err != nil {
-		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to update user asset lock - AssetUnlockFromServiceHandler")
+		passlog.L.Error().Err(err).Interface("req", req).Str("serviceID", serviceID).Msg("failed to release asset lock - AssetUnlockFromServiceHandler")
 		return err
 	}
 
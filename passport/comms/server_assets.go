@@ -368,7 +368,7 @@ func (s *S) AssetUpdateHandler(req AssetUpdateReq, resp *AssetUpdateResp) error
 		return err
 	}
 
-	_, err = db.UpdateUserAsset(req.Asset, true)
+	_, err = db.UpdateUserAsset(req.Asset, true, db.MetadataSourceGameserverPush)
 	if err != nil {
 		passlog.L.Error().Err(err).Msg("failed to UpdateUserAsset")
 	}
@@ -0,0 +1,86 @@
+package comms
+
+import (
+	"fmt"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/api"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+	"xsyn-services/passport/supremacy_rpcclient"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type CraftingRecipesReq struct {
+	ApiKey string `json:"api_key"`
+}
+
+type CraftingRecipesResp struct {
+	Recipes boiler.CraftingRecipeSlice `json:"recipes"`
+}
+
+// CraftingRecipesHandler lists the active recipes with their inputs and outputs
+func (s *S) CraftingRecipesHandler(req CraftingRecipesReq, resp *CraftingRecipesResp) error {
+	_, err := IsServerClient(req.ApiKey)
+	if err != nil {
+		passlog.L.Error().Err(err).Msg("failed to get service id - CraftingRecipesHandler")
+		return err
+	}
+
+	recipes, err := boiler.CraftingRecipes(
+		boiler.CraftingRecipeWhere.IsActive.EQ(true),
+		qm.Load(boiler.CraftingRecipeRels.RecipeCraftingRecipeItems),
+	).All(passdb.StdConn)
+	if err != nil {
+		passlog.L.Error().Err(err).Msg("failed to get recipes - CraftingRecipesHandler")
+		return err
+	}
+
+	resp.Recipes = recipes
+	return nil
+}
+
+type CraftReq struct {
+	ApiKey   string                           `json:"api_key"`
+	RecipeID string                           `json:"recipe_id"`
+	UserID   string                           `json:"user_id"`
+	Hashes   []string                         `json:"hashes"`
+	Outputs  []*supremacy_rpcclient.XsynAsset `json:"outputs"`
+}
+
+type CraftResp struct {
+	CraftingEventID string      `json:"crafting_event_id"`
+	TXID            null.String `json:"tx_id"`
+}
+
+// CraftHandler crafts a recipe for a user.
+// Hashes are the 721s to burn, they can be held on xsyn or locked to the service. Outputs are the 721s the service made for the recipe,
+// they are registered to the user and locked to the service along with any 1155s crafted.
+func (s *S) CraftHandler(req CraftReq, resp *CraftResp) error {
+	serviceID, err := IsServerClient(req.ApiKey)
+	if err != nil {
+		passlog.L.Error().Err(err).Msg("failed to get service id - CraftHandler")
+		return err
+	}
+	if req.UserID == "" {
+		return fmt.Errorf("missing user id")
+	}
+
+	event, err := api.Craft(s.UserCacheMap, &asset.CraftRequest{
+		RecipeID:   req.RecipeID,
+		UserID:     req.UserID,
+		ServiceID:  null.StringFrom(serviceID),
+		Hashes:     req.Hashes,
+		Outputs721: req.Outputs,
+	})
+	if err != nil {
+		passlog.L.Warn().Err(err).Interface("req", req).Msg("failed to craft - CraftHandler")
+		return err
+	}
+
+	resp.CraftingEventID = event.ID
+	resp.TXID = event.TXID
+	return nil
+}
@@ -0,0 +1,136 @@
+package comms
+
+import (
+	"fmt"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/asset"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+	"xsyn-services/types"
+
+	"github.com/gofrs/uuid"
+	"github.com/volatiletech/null/v8"
+)
+
+type SyndicateAssetDepositReq struct {
+	ApiKey      string `json:"api_key"`
+	SyndicateID string `json:"syndicate_id"`
+	MemberID    string `json:"member_id"`
+	Hash        string `json:"hash"`
+}
+
+type SyndicateAssetWithdrawReq struct {
+	ApiKey      string `json:"api_key"`
+	SyndicateID string `json:"syndicate_id"`
+	MemberID    string `json:"member_id"`
+	ToUserID    string `json:"to_user_id"`
+	Hash        string `json:"hash"`
+}
+
+type SyndicateAssetTransferResp struct {
+	TransferEventID int64 `json:"transfer_event_id"`
+}
+
+// SyndicateAssetDepositHandler moves a member's asset into the syndicate, the member has to be allowed to manage its assets
+func (s *S) SyndicateAssetDepositHandler(req SyndicateAssetDepositReq, resp *SyndicateAssetTransferResp) error {
+	serviceID, err := IsServerClient(req.ApiKey)
+	if err != nil {
+		passlog.L.Error().Err(err).Msg("failed to get service id - SyndicateAssetDepositHandler")
+		return err
+	}
+
+	transferEvent, err := asset.TransferSyndicateAsset(req.SyndicateID, req.MemberID, req.Hash, req.MemberID, req.SyndicateID, serviceID)
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to deposit asset - SyndicateAssetDepositHandler")
+		return err
+	}
+
+	resp.TransferEventID = transferEvent.ID
+	return nil
+}
+
+// SyndicateAssetWithdrawHandler moves an asset out of the syndicate to a member, the requesting member has to be allowed to manage its assets
+func (s *S) SyndicateAssetWithdrawHandler(req SyndicateAssetWithdrawReq, resp *SyndicateAssetTransferResp) error {
+	serviceID, err := IsServerClient(req.ApiKey)
+	if err != nil {
+		passlog.L.Error().Err(err).Msg("failed to get service id - SyndicateAssetWithdrawHandler")
+		return err
+	}
+
+	transferEvent, err := asset.TransferSyndicateAsset(req.SyndicateID, req.MemberID, req.Hash, req.SyndicateID, req.ToUserID, serviceID)
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to withdraw asset - SyndicateAssetWithdrawHandler")
+		return err
+	}
+
+	resp.TransferEventID = transferEvent.ID
+	return nil
+}
+
+type SyndicateAssetListReq struct {
+	ApiKey      string `json:"api_key"`
+	SyndicateID string `json:"syndicate_id"`
+	Search      string `json:"search"`
+	AssetType   string `json:"asset_type"`
+	PageSize    int    `json:"page_size"`
+	Page        int    `json:"page"`
+}
+
+type SyndicateAsset struct {
+	*types.UserAsset
+	DepositedByID null.String `json:"deposited_by_id"`
+}
+
+type SyndicateAssetListResp struct {
+	Total  int64             `json:"total"`
+	Assets []*SyndicateAsset `json:"assets"`
+}
+
+// SyndicateAssetListHandler lists the assets a syndicate holds for supremacy to show its members
+func (s *S) SyndicateAssetListHandler(req SyndicateAssetListReq, resp *SyndicateAssetListResp) error {
+	_, err := IsServerClient(req.ApiKey)
+	if err != nil {
+		passlog.L.Error().Err(err).Msg("failed to get service id - SyndicateAssetListHandler")
+		return err
+	}
+
+	syndicate, err := boiler.FindSyndicate(passdb.StdConn, req.SyndicateID)
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to get syndicate - SyndicateAssetListHandler")
+		return fmt.Errorf("syndicate does not exist")
+	}
+
+	total, userAssets, err := db.AssetList721(&db.AssetListOpts{
+		UserID:    types.UserID(uuid.FromStringOrNil(syndicate.ID)),
+		Search:    req.Search,
+		AssetType: req.AssetType,
+		PageSize:  req.PageSize,
+		Page:      req.Page,
+	})
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to list syndicate assets - SyndicateAssetListHandler")
+		return fmt.Errorf("failed to list syndicate assets")
+	}
+
+	userAssetIDs := []string{}
+	for _, userAsset := range userAssets {
+		userAssetIDs = append(userAssetIDs, userAsset.ID)
+	}
+	depositors, err := asset.SyndicateDepositors(passdb.StdConn, syndicate.ID, userAssetIDs)
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to get syndicate depositors - SyndicateAssetListHandler")
+		return fmt.Errorf("failed to list syndicate assets")
+	}
+
+	resp.Total = total
+	resp.Assets = []*SyndicateAsset{}
+	for _, userAsset := range userAssets {
+		depositor, ok := depositors[userAsset.ID]
+		resp.Assets = append(resp.Assets, &SyndicateAsset{
+			UserAsset:     userAsset,
+			DepositedByID: null.NewString(depositor, ok),
+		})
+	}
+	return nil
+}
@@ -5,11 +5,11 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/ninja-software/terror/v2"
 	"github.com/shopspring/decimal"
-	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"time"
 	"xsyn-services/boiler"
 	"xsyn-services/passport/api/users"
+	"xsyn-services/passport/asset"
 	"xsyn-services/passport/db"
 	"xsyn-services/passport/passdb"
 	"xsyn-services/passport/passlog"
@@ -80,6 +80,26 @@ func (s *S) SyndicateRegisterHandler(req SyndicateCreateReq, resp *SyndicateCrea
 		return terror.Error(err, "Failed to register syndicate in Xsyn")
 	}
 
+	err = asset.CreateSyndicateHolder(tx, &syndicate)
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("syndicate", syndicate).Msg("Failed to create syndicate asset holder")
+		return terror.Error(err, "Failed to register syndicate in Xsyn")
+	}
+
+	// the founder can act for the syndicate until supremacy syncs its members
+	founderMember := boiler.SyndicateMember{
+		SyndicateID:     syndicate.ID,
+		UserID:          founder.ID,
+		Role:            "FOUNDER",
+		CanSpendFunds:   true,
+		CanManageAssets: true,
+	}
+	err = founderMember.Insert(tx, boil.Infer())
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("syndicate", syndicate).Msg("Failed to add syndicate founder")
+		return terror.Error(err, "Failed to register syndicate in Xsyn")
+	}
+
 	supremacyGameUse, err := boiler.FindUser(passdb.StdConn, types.SupremacyGameUserID.String())
 	if err != nil {
 		return terror.Error(err, "Failed to load debitor account")
@@ -154,8 +174,26 @@ func (s *S) SyndicateNameChangeHandler(req SyndicateNameCreateReq, resp *Syndica
 	return nil
 }
 
-func (s *S) SyndicateLiquidateHandler(req SyndicateLiquidateReq, resp *SyndicateLiquidateResp) error {
+// SyndicateMembersSyncHandler replaces the members of a syndicate and what they're allowed to do, supremacy sends it whenever they change
+func (s *S) SyndicateMembersSyncHandler(req SyndicateMembersSyncReq, resp *SyndicateMembersSyncResp) error {
 	_, err := IsServerClient(req.ApiKey)
+	if err != nil {
+		passlog.L.Error().Err(err).Msg("failed to get service id - SyndicateMembersSyncHandler")
+		return err
+	}
+
+	err = asset.SyncSyndicateMembers(req.SyndicateID, req.Members)
+	if err != nil {
+		passlog.L.Error().Err(err).Str("syndicate id", req.SyndicateID).Msg("Failed to sync syndicate members")
+		return terror.Error(err, "Failed to sync syndicate members")
+	}
+
+	return nil
+}
+
+// SyndicateLiquidateHandler archives the syndicate and hands out the assets it holds, the transfers are returned for supremacy to apply
+func (s *S) SyndicateLiquidateHandler(req SyndicateLiquidateReq, resp *SyndicateLiquidateResp) error {
+	serviceID, err := IsServerClient(req.ApiKey)
 	if err != nil {
 		passlog.L.Error().Err(err).Msg("failed to get service id - AssetTransferOwnershipHandler")
 		return err
@@ -167,12 +205,23 @@ func (s *S) SyndicateLiquidateHandler(req SyndicateLiquidateReq, resp *Syndicate
 		return terror.Error(err, "Syndicate does not exist or it is liquidated.")
 	}
 
-	syndicate.DeletedAt = null.TimeFrom(time.Now())
-	_, err = syndicate.Update(passdb.StdConn, boil.Whitelist(boiler.SyndicateColumns.DeletedAt))
+	transferEvents, err := asset.LiquidateSyndicateAssets(syndicate.ID, req.AssetRecipients, req.RemainUserIDs, serviceID)
 	if err != nil {
-		passlog.L.Error().Err(err).Str("syndicate id", syndicate.ID).Msg("Failed to archive syndicate")
+		passlog.L.Error().Err(err).Str("syndicate id", syndicate.ID).Msg("Failed to liquidate syndicate")
 		return terror.Error(err, "Failed to liquidate syndicate")
 	}
 
+	resp.Transfers = []*types.TransferEvent{}
+	for _, te := range transferEvents {
+		resp.Transfers = append(resp.Transfers, &types.TransferEvent{
+			TransferEventID: te.ID,
+			AssetHash:       te.UserAssetHash,
+			FromUserID:      te.FromUserID,
+			ToUserID:        te.ToUserID,
+			TransferredAt:   te.TransferredAt,
+			TransferTXID:    te.TransferTXID,
+		})
+	}
+
 	return nil
 }
@@ -1,6 +1,7 @@
 package comms
 
 import (
+	"xsyn-services/passport/asset"
 	types2 "xsyn-services/types"
 
 	"github.com/gofrs/uuid"
@@ -89,12 +90,23 @@ type SyndicateNameCreateReq struct {
 }
 type SyndicateNameChangeResp struct{}
 
+type SyndicateMembersSyncReq struct {
+	ApiKey      string                   `json:"api_key"`
+	SyndicateID string                   `json:"syndicate_id"`
+	Members     []*asset.SyndicateMember `json:"members"`
+}
+type SyndicateMembersSyncResp struct{}
+
 type SyndicateLiquidateReq struct {
 	ApiKey        string   `json:"api_key"`
 	SyndicateID   string   `json:"syndicate_id"`
 	RemainUserIDs []string `json:"remain_user_ids"`
+	// AssetRecipients picks who gets a held asset by its hash, the rest go back to whoever deposited them
+	AssetRecipients map[string]string `json:"asset_recipients"`
+}
+type SyndicateLiquidateResp struct {
+	Transfers []*types2.TransferEvent `json:"transfers"`
 }
-type SyndicateLiquidateResp struct{}
 
 type GetCurrentSupPriceReq struct{}
 type GetExchangeRatesReq struct{}
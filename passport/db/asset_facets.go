@@ -0,0 +1,347 @@
+package db
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	facetSearchMaxPageSize   = 100
+	facetSearchMaxFilters    = 20
+	facetMaxValuesPerTrait   = 100
+	facetNumericValuePattern = `^-?[0-9]+(\.[0-9]+)?$`
+)
+
+// facetAttributes is the attributes of an asset as an array, whatever else was stored there
+var facetAttributes = fmt.Sprintf(
+	"CASE WHEN JSONB_TYPEOF(%[1]s) = 'array' THEN %[1]s ELSE '[]'::JSONB END",
+	boiler.UserAssetTableColumns.Attributes,
+)
+
+// AttributeFacetFilter narrows a search to assets with one of the values of a trait, or with a numeric trait inside a range
+type AttributeFacetFilter struct {
+	Trait  string       `json:"trait"`
+	Values []string     `json:"values"`
+	Min    null.Float64 `json:"min"`
+	Max    null.Float64 `json:"max"`
+}
+
+type AttributeFacetSort struct {
+	Trait     string    `json:"trait"`
+	Numeric   bool      `json:"numeric"`
+	Direction SortByDir `json:"direction"`
+}
+
+type AssetFacetSearchOpts struct {
+	CollectionID string
+	OwnerID      null.String
+	Filters      []*AttributeFacetFilter
+	Sort         *AttributeFacetSort
+	Cursor       string
+	PageSize     int
+}
+
+type AttributeFacetValue struct {
+	Value string `json:"value" boil:"value"`
+	Count int64  `json:"count" boil:"count"`
+}
+
+// AttributeFacet is the values of a trait with how many assets match each one.
+// Counts of a filtered trait ignore its own filter so the other values can still be picked.
+type AttributeFacet struct {
+	Trait   string                 `json:"trait"`
+	Values  []*AttributeFacetValue `json:"values"`
+	Numeric bool                   `json:"numeric"`
+	Min     null.Float64           `json:"min"`
+	Max     null.Float64           `json:"max"`
+}
+
+type AssetFacetSearchResult struct {
+	Assets     []*xsynTypes.UserAsset `json:"assets"`
+	Facets     []*AttributeFacet      `json:"facets"`
+	Total      int64                  `json:"total"`
+	NextCursor string                 `json:"next_cursor,omitempty"`
+}
+
+type facetCursor struct {
+	Key string `json:"k"`
+	ID  string `json:"id"`
+}
+
+func encodeFacetCursor(key, id string) string {
+	b, _ := json.Marshal(&facetCursor{Key: key, ID: id})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeFacetCursor(cursor string) (*facetCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, terror.Warn(fmt.Errorf("invalid cursor"), "Invalid cursor.")
+	}
+	c := &facetCursor{}
+	err = json.Unmarshal(b, c)
+	if err != nil || c.ID == "" {
+		return nil, terror.Warn(fmt.Errorf("invalid cursor"), "Invalid cursor.")
+	}
+	return c, nil
+}
+
+// AttributeFacetFilterMod matches user assets passing the filter, values within a trait are OR'd
+func AttributeFacetFilterMod(filter *AttributeFacetFilter) qm.QueryMod {
+	conditions := []string{"f->>'trait_type' = ?"}
+	args := []interface{}{filter.Trait}
+	if len(filter.Values) > 0 {
+		placeholders := strings.TrimSuffix(strings.Repeat("?,", len(filter.Values)), ",")
+		conditions = append(conditions, fmt.Sprintf("f->>'value' IN (%s)", placeholders))
+		for _, v := range filter.Values {
+			args = append(args, v)
+		}
+	}
+	// the value is only cast once it is known to be a number
+	numeric := fmt.Sprintf("CASE WHEN f->>'value' ~ '%s' THEN (f->>'value')::NUMERIC END", facetNumericValuePattern)
+	if filter.Min.Valid {
+		conditions = append(conditions, numeric+" >= ?")
+		args = append(args, filter.Min.Float64)
+	}
+	if filter.Max.Valid {
+		conditions = append(conditions, numeric+" <= ?")
+		args = append(args, filter.Max.Float64)
+	}
+
+	return qm.Where(fmt.Sprintf(
+		"EXISTS (SELECT 1 FROM JSONB_ARRAY_ELEMENTS(%s) f WHERE %s)",
+		facetAttributes,
+		strings.Join(conditions, " AND "),
+	), args...)
+}
+
+// facetScopeMods limits a search to the collection and owner, with every filter except the one for skipTrait
+func facetScopeMods(opts *AssetFacetSearchOpts, skipTrait string) []qm.QueryMod {
+	queryMods := []qm.QueryMod{
+		boiler.UserAssetWhere.CollectionID.EQ(opts.CollectionID),
+		boiler.UserAssetWhere.DeletedAt.IsNull(),
+	}
+	if opts.OwnerID.Valid {
+		queryMods = append(queryMods, boiler.UserAssetWhere.OwnerID.EQ(opts.OwnerID.String))
+	}
+	for _, filter := range opts.Filters {
+		if filter.Trait == skipTrait {
+			continue
+		}
+		queryMods = append(queryMods, AttributeFacetFilterMod(filter))
+	}
+	return queryMods
+}
+
+// facetCounts counts assets per trait value, most common first, for one trait or every trait when trait is empty.
+// An asset listing the same value twice is only counted once.
+func facetCounts(opts *AssetFacetSearchOpts, skipTrait string, trait string) (map[string][]*AttributeFacetValue, error) {
+	queryMods := []qm.QueryMod{
+		qm.Select("COALESCE(a->>'trait_type', '') AS trait", "COALESCE(a->>'value', '') AS value", fmt.Sprintf("COUNT(DISTINCT %s) AS count", boiler.UserAssetTableColumns.ID)),
+		qm.From(boiler.TableNames.UserAssets),
+		qm.InnerJoin(fmt.Sprintf("JSONB_ARRAY_ELEMENTS(%s) a ON TRUE", facetAttributes)),
+	}
+	queryMods = append(queryMods, facetScopeMods(opts, skipTrait)...)
+	if trait != "" {
+		queryMods = append(queryMods, qm.Where("a->>'trait_type' = ?", trait))
+	}
+	queryMods = append(queryMods,
+		qm.GroupBy("a->>'trait_type', a->>'value'"),
+		qm.OrderBy("trait, count DESC, value"),
+	)
+
+	rows := []*struct {
+		Trait               string `boil:"trait"`
+		AttributeFacetValue `boil:",bind"`
+	}{}
+	err := boiler.NewQuery(queryMods...).Bind(nil, passdb.StdConn, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string][]*AttributeFacetValue{}
+	for _, row := range rows {
+		counts[row.Trait] = append(counts[row.Trait], &AttributeFacetValue{Value: row.Value, Count: row.Count})
+	}
+	return counts, nil
+}
+
+// AssetFacets returns the trait facets of the assets matching the search
+func AssetFacets(opts *AssetFacetSearchOpts) ([]*AttributeFacet, error) {
+	counts, err := facetCounts(opts, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	// filtered traits are counted without their own filter
+	for _, filter := range opts.Filters {
+		traitCounts, err := facetCounts(opts, filter.Trait, filter.Trait)
+		if err != nil {
+			return nil, err
+		}
+		counts[filter.Trait] = traitCounts[filter.Trait]
+	}
+
+	facets := []*AttributeFacet{}
+	for trait, values := range counts {
+		if trait == "" || strings.EqualFold(trait, "name") {
+			continue
+		}
+		facet := &AttributeFacet{Trait: trait, Values: values, Numeric: len(values) > 0}
+		for _, v := range values {
+			n, err := strconv.ParseFloat(v.Value, 64)
+			if err != nil {
+				facet.Numeric = false
+				facet.Min, facet.Max = null.Float64{}, null.Float64{}
+				break
+			}
+			if !facet.Min.Valid || n < facet.Min.Float64 {
+				facet.Min = null.Float64From(n)
+			}
+			if !facet.Max.Valid || n > facet.Max.Float64 {
+				facet.Max = null.Float64From(n)
+			}
+		}
+		// ranges are worked out from every value, only the most common ones are listed
+		if len(facet.Values) > facetMaxValuesPerTrait {
+			facet.Values = facet.Values[:facetMaxValuesPerTrait]
+		}
+		facets = append(facets, facet)
+	}
+	sort.Slice(facets, func(i, j int) bool { return facets[i].Trait < facets[j].Trait })
+
+	return facets, nil
+}
+
+// ValidateAttributeFacetFilters checks every filter has a trait and something to match
+func ValidateAttributeFacetFilters(filters []*AttributeFacetFilter) error {
+	if len(filters) > facetSearchMaxFilters {
+		return terror.Warn(fmt.Errorf("too many filters"), fmt.Sprintf("Only %d filters can be used at once.", facetSearchMaxFilters))
+	}
+	for _, filter := range filters {
+		if filter.Trait == "" {
+			return terror.Warn(fmt.Errorf("filter trait is required"), "Filter trait is required.")
+		}
+		if len(filter.Values) == 0 && !filter.Min.Valid && !filter.Max.Valid {
+			return terror.Warn(fmt.Errorf("filter has no values"), fmt.Sprintf("Filter on %s needs values or a range.", filter.Trait))
+		}
+	}
+	return nil
+}
+
+// AssetFacetSearch lists the assets of a collection matching the attribute filters, a page at a time, along with the facets.
+// Pages follow each other with the returned cursor, so assets changing between pages don't get skipped or repeated.
+func AssetFacetSearch(opts *AssetFacetSearchOpts) (*AssetFacetSearchResult, error) {
+	err := ValidateAttributeFacetFilters(opts.Filters)
+	if err != nil {
+		return nil, err
+	}
+	if opts.PageSize <= 0 || opts.PageSize > facetSearchMaxPageSize {
+		opts.PageSize = 50
+	}
+
+	queryMods := facetScopeMods(opts, "")
+
+	total, err := boiler.UserAssets(queryMods...).Count(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	// sort key is the trait value, missing numbers go last either way and missing text sorts as an empty value.
+	// NaN sorts above every number, so descending numbers sort ascending on the negated value to keep it last.
+	direction := SortByDirAsc
+	sortKey := boiler.UserAssetTableColumns.Name
+	numeric := false
+	if opts.Sort != nil {
+		if opts.Sort.Direction.IsValid() {
+			direction = opts.Sort.Direction
+		}
+		if opts.Sort.Trait != "" {
+			numeric = opts.Sort.Numeric
+			keyExpr := "COALESCE(f->>'value', '')"
+			if numeric {
+				value := "(f->>'value')::NUMERIC"
+				if direction == SortByDirDesc {
+					value = "-" + value
+					direction = SortByDirAsc
+				}
+				keyExpr = fmt.Sprintf("COALESCE(CASE WHEN f->>'value' ~ '%s' THEN %s END, 'NaN')", facetNumericValuePattern, value)
+			}
+			queryMods = append(queryMods, qm.LeftOuterJoin(fmt.Sprintf(
+				"LATERAL (SELECT %s AS sort_key FROM JSONB_ARRAY_ELEMENTS(%s) f WHERE f->>'trait_type' = ? LIMIT 1) sk ON TRUE",
+				keyExpr,
+				facetAttributes,
+			), opts.Sort.Trait))
+			sortKey = "COALESCE(sk.sort_key, '')"
+			if numeric {
+				sortKey = "COALESCE(sk.sort_key, 'NaN')"
+			}
+		}
+	}
+
+	cast := "TEXT"
+	if numeric {
+		cast = "NUMERIC"
+	}
+	if opts.Cursor != "" {
+		cursor, err := decodeFacetCursor(opts.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		comparison := ">"
+		if direction == SortByDirDesc {
+			comparison = "<"
+		}
+		queryMods = append(queryMods, qm.Where(fmt.Sprintf(
+			"(%s, %s) %s (?::%s, ?::UUID)",
+			sortKey,
+			boiler.UserAssetTableColumns.ID,
+			comparison,
+			cast,
+		), cursor.Key, cursor.ID))
+	}
+
+	queryMods = append(queryMods,
+		qm.Select(fmt.Sprintf("%s.*", boiler.TableNames.UserAssets), fmt.Sprintf("(%s)::TEXT AS sort_key_text", sortKey)),
+		qm.OrderBy(fmt.Sprintf("%s %s, %s %s", sortKey, direction, boiler.UserAssetTableColumns.ID, direction)),
+		qm.Limit(opts.PageSize+1),
+	)
+
+	rows := []*struct {
+		boiler.UserAsset `boil:",bind"`
+		SortKey          string `boil:"sort_key_text"`
+	}{}
+	err = boiler.NewQuery(append(queryMods, qm.From(boiler.TableNames.UserAssets))...).Bind(nil, passdb.StdConn, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AssetFacetSearchResult{Assets: []*xsynTypes.UserAsset{}, Total: total}
+	if len(rows) > opts.PageSize {
+		last := rows[opts.PageSize-1]
+		result.NextCursor = encodeFacetCursor(last.SortKey, last.ID)
+		rows = rows[:opts.PageSize]
+	}
+	for _, row := range rows {
+		userAsset := row.UserAsset
+		result.Assets = append(result.Assets, xsynTypes.UserAssetFromBoiler(&userAsset))
+	}
+
+	result.Facets, err = AssetFacets(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
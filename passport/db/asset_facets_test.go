@@ -0,0 +1,142 @@
+package db_test
+
+import (
+	"testing"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestAssetFacetSearch(t *testing.T) {
+	passdbtest.Require(t)
+
+	collection := passdbtest.Collection(t)
+	owner := passdbtest.User(t)
+
+	// asset inserts an asset with the attributes json
+	asset := func(t *testing.T, name string, attributes string) *boiler.UserAsset {
+		t.Helper()
+		userAsset := passdbtest.Asset(t, collection, owner)
+		userAsset.Name = name
+		userAsset.Attributes = []byte(attributes)
+		_, err := userAsset.Update(passdb.StdConn, boil.Whitelist(boiler.UserAssetColumns.Name, boiler.UserAssetColumns.Attributes))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return userAsset
+	}
+
+	asset(t, "one", `[{"trait_type": "Color", "value": "Red"}, {"trait_type": "Level", "value": 1}]`)
+	asset(t, "ten", `[{"trait_type": "Color", "value": "Blue"}, {"trait_type": "Level", "value": 10}]`)
+	asset(t, "two", `[{"trait_type": "Color", "value": "Red"}, {"trait_type": "Color", "value": "Red"}, {"trait_type": "Level", "value": 2}]`)
+	asset(t, "unleveled", `[{"trait_type": "Color", "value": "Green"}, {"trait_type": "Level", "value": "max"}]`)
+	asset(t, "bare", `{"not": "an array"}`)
+
+	// names lists every page of the search in order
+	names := func(t *testing.T, opts *db.AssetFacetSearchOpts) []string {
+		t.Helper()
+		got := []string{}
+		for {
+			result, err := db.AssetFacetSearch(opts)
+			if err != nil {
+				t.Fatalf("failed to search: %s", err)
+			}
+			for _, a := range result.Assets {
+				got = append(got, a.Name)
+			}
+			if result.NextCursor == "" {
+				return got
+			}
+			opts.Cursor = result.NextCursor
+		}
+	}
+	equal := func(a, b []string) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	sorts := []struct {
+		name    string
+		sort    *db.AttributeFacetSort
+		want    []string
+		missing []string
+	}{
+		{"numbers ascending", &db.AttributeFacetSort{Trait: "Level", Numeric: true, Direction: db.SortByDirAsc}, []string{"one", "two", "ten"}, []string{"bare", "unleveled"}},
+		{"numbers descending", &db.AttributeFacetSort{Trait: "Level", Numeric: true, Direction: db.SortByDirDesc}, []string{"ten", "two", "one"}, []string{"bare", "unleveled"}},
+		{"text ascending", &db.AttributeFacetSort{Trait: "Level", Direction: db.SortByDirAsc}, []string{"bare", "one", "ten", "two", "unleveled"}, nil},
+		{"text descending", &db.AttributeFacetSort{Trait: "Level", Direction: db.SortByDirDesc}, []string{"unleveled", "two", "ten", "one", "bare"}, nil},
+		{"name", nil, []string{"bare", "one", "ten", "two", "unleveled"}, nil},
+	}
+	for _, tt := range sorts {
+		t.Run(tt.name, func(t *testing.T) {
+			got := names(t, &db.AssetFacetSearchOpts{CollectionID: collection.ID, Sort: tt.sort, PageSize: 2})
+			if len(got) != len(tt.want)+len(tt.missing) || !equal(got[:len(tt.want)], tt.want) {
+				t.Fatalf("got %v, want %v then %v", got, tt.want, tt.missing)
+			}
+			// assets without a number tie at the end, in id order
+			rest := got[len(tt.want):]
+			if len(rest) > 0 && !equal(rest, tt.missing) && !equal(rest, []string{tt.missing[1], tt.missing[0]}) {
+				t.Errorf("got %v last, want %v", rest, tt.missing)
+			}
+		})
+	}
+
+	t.Run("duplicate values count an asset once", func(t *testing.T) {
+		facets, err := db.AssetFacets(&db.AssetFacetSearchOpts{CollectionID: collection.ID})
+		if err != nil {
+			t.Fatalf("failed to get facets: %s", err)
+		}
+		counts := map[string]map[string]int64{}
+		for _, f := range facets {
+			counts[f.Trait] = map[string]int64{}
+			for _, v := range f.Values {
+				counts[f.Trait][v.Value] = v.Count
+			}
+		}
+		if counts["Color"]["Red"] != 2 || counts["Color"]["Blue"] != 1 || counts["Color"]["Green"] != 1 {
+			t.Errorf("color counts = %v, want Red 2, Blue 1 and Green 1", counts["Color"])
+		}
+	})
+
+	t.Run("a filtered trait counts without its own filter", func(t *testing.T) {
+		result, err := db.AssetFacetSearch(&db.AssetFacetSearchOpts{
+			CollectionID: collection.ID,
+			Filters:      []*db.AttributeFacetFilter{{Trait: "Color", Values: []string{"Red"}}},
+		})
+		if err != nil {
+			t.Fatalf("failed to search: %s", err)
+		}
+		if result.Total != 2 {
+			t.Errorf("total = %d, want 2", result.Total)
+		}
+		for _, f := range result.Facets {
+			if f.Trait == "Color" && len(f.Values) != 3 {
+				t.Errorf("color facet has %d values, want all 3", len(f.Values))
+			}
+			if f.Trait == "Level" && (!f.Numeric || len(f.Values) != 2) {
+				t.Errorf("level facet is numeric %t with %d values, want 2 numeric values", f.Numeric, len(f.Values))
+			}
+		}
+	})
+
+	t.Run("numeric range", func(t *testing.T) {
+		got := names(t, &db.AssetFacetSearchOpts{
+			CollectionID: collection.ID,
+			Filters:      []*db.AttributeFacetFilter{{Trait: "Level", Min: null.Float64From(2), Max: null.Float64From(10)}},
+		})
+		if !equal(got, []string{"ten", "two"}) {
+			t.Errorf("got %v, want [ten two]", got)
+		}
+	})
+}
@@ -0,0 +1,217 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// MetadataSource is what caused an asset's metadata to change
+type MetadataSource string
+
+const (
+	MetadataSourceRegister       MetadataSource = "REGISTER"
+	MetadataSourceGameserverPush MetadataSource = "GAMESERVER_PUSH"
+	MetadataSourceRefresh        MetadataSource = "REFRESH"
+	MetadataSourceUserRequest    MetadataSource = "USER_REQUEST"
+	MetadataSourceTransfer       MetadataSource = "TRANSFER"
+)
+
+// AssetMetadata is the metadata of an asset kept in each version
+type AssetMetadata struct {
+	Name             string                 `json:"name"`
+	Tier             string                 `json:"tier"`
+	AssetType        null.String            `json:"asset_type"`
+	Attributes       []*xsynTypes.Attribute `json:"attributes"`
+	ImageURL         null.String            `json:"image_url"`
+	ExternalURL      null.String            `json:"external_url"`
+	CardAnimationURL null.String            `json:"card_animation_url"`
+	AvatarURL        null.String            `json:"avatar_url"`
+	LargeImageURL    null.String            `json:"large_image_url"`
+	Description      null.String            `json:"description"`
+	BackgroundColor  null.String            `json:"background_color"`
+	AnimationURL     null.String            `json:"animation_url"`
+	YoutubeURL       null.String            `json:"youtube_url"`
+}
+
+// AssetMetadataFromBoiler gets the current metadata of an asset
+func AssetMetadataFromBoiler(userAsset *boiler.UserAsset) (*AssetMetadata, error) {
+	attributes := []*xsynTypes.Attribute{}
+	if userAsset.Attributes != nil {
+		err := userAsset.Attributes.Unmarshal(&attributes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &AssetMetadata{
+		Name:             userAsset.Name,
+		Tier:             userAsset.Tier,
+		AssetType:        userAsset.AssetType,
+		Attributes:       attributes,
+		ImageURL:         userAsset.ImageURL,
+		ExternalURL:      userAsset.ExternalURL,
+		CardAnimationURL: userAsset.CardAnimationURL,
+		AvatarURL:        userAsset.AvatarURL,
+		LargeImageURL:    userAsset.LargeImageURL,
+		Description:      userAsset.Description,
+		BackgroundColor:  userAsset.BackgroundColor,
+		AnimationURL:     userAsset.AnimationURL,
+		YoutubeURL:       userAsset.YoutubeURL,
+	}, nil
+}
+
+// fields lists the metadata other than attributes in the order a diff shows them
+func (m *AssetMetadata) fields() [][2]interface{} {
+	return [][2]interface{}{
+		{"name", m.Name},
+		{"tier", m.Tier},
+		{"asset_type", m.AssetType},
+		{"image_url", m.ImageURL},
+		{"external_url", m.ExternalURL},
+		{"card_animation_url", m.CardAnimationURL},
+		{"avatar_url", m.AvatarURL},
+		{"large_image_url", m.LargeImageURL},
+		{"description", m.Description},
+		{"background_color", m.BackgroundColor},
+		{"animation_url", m.AnimationURL},
+		{"youtube_url", m.YoutubeURL},
+	}
+}
+
+// MetadataChange is a field that changed between two versions, a changed trait has the field "attributes" and its trait type
+type MetadataChange struct {
+	Field     string      `json:"field"`
+	TraitType string      `json:"trait_type,omitempty"`
+	From      interface{} `json:"from"`
+	To        interface{} `json:"to"`
+}
+
+// DiffAssetMetadata lists what changed from one version of an asset's metadata to the next.
+// Traits are matched by trait type, one that was added or removed has no from or to value.
+// nil is returned when nothing changed.
+func DiffAssetMetadata(from *AssetMetadata, to *AssetMetadata) ([]*MetadataChange, error) {
+	var changes []*MetadataChange
+
+	fromFields := from.fields()
+	for i, field := range to.fields() {
+		if fromFields[i][1] != field[1] {
+			changes = append(changes, &MetadataChange{Field: field[0].(string), From: fromFields[i][1], To: field[1]})
+		}
+	}
+
+	fromTraits := map[string]*xsynTypes.Attribute{}
+	for _, attribute := range from.Attributes {
+		fromTraits[attribute.TraitType] = attribute
+	}
+	toTraits := map[string]bool{}
+	for _, attribute := range to.Attributes {
+		toTraits[attribute.TraitType] = true
+		change := &MetadataChange{Field: "attributes", TraitType: attribute.TraitType, To: attribute.Value}
+
+		previous, ok := fromTraits[attribute.TraitType]
+		if ok {
+			// compare as json, numbers may have been decoded as different types
+			previousJSON, err := json.Marshal(previous)
+			if err != nil {
+				return nil, err
+			}
+			currentJSON, err := json.Marshal(attribute)
+			if err != nil {
+				return nil, err
+			}
+			if string(previousJSON) == string(currentJSON) {
+				continue
+			}
+			change.From = previous.Value
+		}
+		changes = append(changes, change)
+	}
+	for _, attribute := range from.Attributes {
+		if !toTraits[attribute.TraitType] {
+			changes = append(changes, &MetadataChange{Field: "attributes", TraitType: attribute.TraitType, From: attribute.Value})
+		}
+	}
+
+	return changes, nil
+}
+
+// RecordMetadataVersion stores the asset's metadata as a new version if it differs from the latest one.
+// It is called after the asset is inserted or updated in the same db transaction, so the row lock keeps versions in order.
+// nil is returned when nothing changed.
+func RecordMetadataVersion(exec boil.Executor, userAsset *boiler.UserAsset, source MetadataSource) (*boiler.UserAssetMetadataVersion, error) {
+	metadata, err := AssetMetadataFromBoiler(userAsset)
+	if err != nil {
+		return nil, err
+	}
+
+	version := &boiler.UserAssetMetadataVersion{
+		UserAssetID: userAsset.ID,
+		Version:     1,
+		Source:      string(source),
+		Diff:        types.JSON("[]"),
+	}
+
+	latest, err := boiler.UserAssetMetadataVersions(
+		boiler.UserAssetMetadataVersionWhere.UserAssetID.EQ(userAsset.ID),
+		qm.OrderBy(fmt.Sprintf("%s DESC", boiler.UserAssetMetadataVersionColumns.Version)),
+	).One(exec)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if latest != nil {
+		previous := &AssetMetadata{}
+		err = latest.Metadata.Unmarshal(previous)
+		if err != nil {
+			return nil, err
+		}
+		changes, err := DiffAssetMetadata(previous, metadata)
+		if err != nil {
+			return nil, err
+		}
+		if len(changes) == 0 {
+			return nil, nil
+		}
+		err = version.Diff.Marshal(changes)
+		if err != nil {
+			return nil, err
+		}
+		version.Version = latest.Version + 1
+	}
+
+	err = version.Metadata.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+	err = version.Insert(exec, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+// AssetMetadataVersion gets an asset's metadata as it was at a version
+func AssetMetadataVersion(userAssetID string, version int) (*AssetMetadata, error) {
+	metadataVersion, err := boiler.UserAssetMetadataVersions(
+		boiler.UserAssetMetadataVersionWhere.UserAssetID.EQ(userAssetID),
+		boiler.UserAssetMetadataVersionWhere.Version.EQ(version),
+	).One(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &AssetMetadata{}
+	err = metadataVersion.Metadata.Unmarshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
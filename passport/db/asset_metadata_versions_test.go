@@ -0,0 +1,104 @@
+package db_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"xsyn-services/passport/db"
+	xsynTypes "xsyn-services/types"
+
+	"github.com/volatiletech/null/v8"
+)
+
+func TestDiffAssetMetadata(t *testing.T) {
+	trait := func(traitType string, value interface{}) *xsynTypes.Attribute {
+		return &xsynTypes.Attribute{TraitType: traitType, Value: value}
+	}
+	metadata := func(attributes ...*xsynTypes.Attribute) *db.AssetMetadata {
+		return &db.AssetMetadata{
+			Name:       "Olympus Mons LY07",
+			Tier:       "MEGA",
+			ImageURL:   null.StringFrom("https://example.com/mech.png"),
+			Attributes: attributes,
+		}
+	}
+
+	tests := []struct {
+		name string
+		from *db.AssetMetadata
+		to   *db.AssetMetadata
+		want []*db.MetadataChange
+	}{
+		{
+			name: "no change",
+			from: metadata(trait("Speed", 10), trait("Rarity", "Mega")),
+			to:   metadata(trait("Speed", 10), trait("Rarity", "Mega")),
+			want: nil,
+		},
+		{
+			name: "trait order doesn't matter",
+			from: metadata(trait("Speed", 10), trait("Rarity", "Mega")),
+			to:   metadata(trait("Rarity", "Mega"), trait("Speed", 10)),
+			want: nil,
+		},
+		{
+			name: "number decoded as another type is the same value",
+			from: metadata(trait("Speed", 10)),
+			to:   metadata(trait("Speed", float64(10))),
+			want: nil,
+		},
+		{
+			name: "number that became a string changed",
+			from: metadata(trait("Speed", 10)),
+			to:   metadata(trait("Speed", "10")),
+			want: []*db.MetadataChange{{Field: "attributes", TraitType: "Speed", From: 10, To: "10"}},
+		},
+		{
+			name: "added trait",
+			from: metadata(trait("Speed", 10)),
+			to:   metadata(trait("Speed", 10), trait("Weapon", "Plasma Rifle")),
+			want: []*db.MetadataChange{{Field: "attributes", TraitType: "Weapon", To: "Plasma Rifle"}},
+		},
+		{
+			name: "removed trait",
+			from: metadata(trait("Speed", 10), trait("Weapon", "Plasma Rifle")),
+			to:   metadata(trait("Speed", 10)),
+			want: []*db.MetadataChange{{Field: "attributes", TraitType: "Weapon", From: "Plasma Rifle"}},
+		},
+		{
+			name: "changed trait",
+			from: metadata(trait("Speed", 10)),
+			to:   metadata(trait("Speed", 12.5)),
+			want: []*db.MetadataChange{{Field: "attributes", TraitType: "Speed", From: 10, To: 12.5}},
+		},
+		{
+			name: "changed fields come before traits",
+			from: metadata(trait("Speed", 10)),
+			to: func() *db.AssetMetadata {
+				m := metadata(trait("Speed", 11))
+				m.Tier = "LEGENDARY"
+				m.ImageURL = null.String{}
+				return m
+			}(),
+			want: []*db.MetadataChange{
+				{Field: "tier", From: "MEGA", To: "LEGENDARY"},
+				{Field: "image_url", From: null.StringFrom("https://example.com/mech.png"), To: null.String{}},
+				{Field: "attributes", TraitType: "Speed", From: 10, To: 11},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := db.DiffAssetMetadata(tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("DiffAssetMetadata() error = %s", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				gotJSON, _ := json.Marshal(got)
+				wantJSON, _ := json.Marshal(tt.want)
+				t.Errorf("DiffAssetMetadata() = %s, want %s", gotJSON, wantJSON)
+			}
+		})
+	}
+}
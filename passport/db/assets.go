@@ -395,7 +395,13 @@ func RegisterUserAsset(itm *supremacy_rpcclient.XsynAsset, serviceID string, tx
 		return nil, err
 	}
 
-	err = onChainStatus.Insert(tx, boil.Infer())
+	_, err = RecordMetadataVersion(tx, boilerAsset, MetadataSourceRegister)
+	if err != nil {
+		passlog.L.Error().Interface("itm", itm).Err(err).Msg("failed to register new asset - can't record metadata version")
+		return nil, err
+	}
+
+	err = InsertOnChainStatus(tx, onChainStatus)
 	if err != nil {
 		passlog.L.Error().Interface("itm", itm).Interface("onChainStatus", onChainStatus).Err(err).Msg("failed to register new asset - can't insert asset on chain status")
 		return nil, err
@@ -404,7 +410,8 @@ func RegisterUserAsset(itm *supremacy_rpcclient.XsynAsset, serviceID string, tx
 	return boilerAsset, nil
 }
 
-func UpdateUserAsset(itm *supremacy_rpcclient.XsynAsset, registerIfNotExists bool) (*boiler.UserAsset, error) {
+// UpdateUserAsset updates an asset with the metadata from a service, a change to the metadata is kept as a new version with its source
+func UpdateUserAsset(itm *supremacy_rpcclient.XsynAsset, registerIfNotExists bool, source MetadataSource) (*boiler.UserAsset, error) {
 	asset, err := boiler.UserAssets(
 		boiler.UserAssetWhere.Hash.EQ(itm.Hash),
 		qm.Load(boiler.UserAssetRels.Collection),
@@ -454,9 +461,9 @@ func UpdateUserAsset(itm *supremacy_rpcclient.XsynAsset, registerIfNotExists boo
 		onChainStatusObject = &boiler.UserAssetOnChainStatus{
 			AssetHash:     asset.Hash,
 			CollectionID:  collection.ID,
-			OnChainStatus: "MINTABLE",
+			OnChainStatus: string(MINTABLE),
 		}
-		err := onChainStatusObject.Insert(passdb.StdConn, boil.Infer())
+		err := InsertOnChainStatus(passdb.StdConn, onChainStatusObject)
 		if err != nil {
 			return nil, terror.Error(err)
 		}
@@ -482,7 +489,23 @@ func UpdateUserAsset(itm *supremacy_rpcclient.XsynAsset, registerIfNotExists boo
 	asset.LockedToService = null.NewString(itm.Service, itm.Service != "")
 	asset.DataRefreshedAt = time.Now()
 
-	_, err = asset.Update(passdb.StdConn, boil.Infer())
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+	defer tx.Rollback()
+
+	_, err = asset.Update(tx, boil.Infer())
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+
+	_, err = RecordMetadataVersion(tx, asset, source)
+	if err != nil {
+		return nil, terror.Error(err)
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return nil, terror.Error(err)
 	}
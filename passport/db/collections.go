@@ -1,6 +1,9 @@
 package db
 
 import (
+	"database/sql"
+	"errors"
+	"time"
 	"xsyn-services/boiler"
 	"xsyn-services/passport/passdb"
 
@@ -8,9 +11,20 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/ninja-software/terror/v2"
 	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
+const (
+	ContractTypeERC721  = "ERC-721"
+	ContractTypeEIP1155 = "EIP-1155"
+)
+
+const (
+	CollectionChainETH = "ETH"
+	CollectionChainBSC = "BSC"
+)
+
 func RogueCollection() (*boiler.Collection, error) {
 	collection, err := boiler.Collections(
 		boiler.CollectionWhere.Name.EQ("Supremacy"),
@@ -115,3 +129,58 @@ func CollectionsVisibleList() ([]*boiler.Collection, error) {
 	}
 	return collections, nil
 }
+
+// Bridged1155Collections gets the 1155 collections with a contract to sync deposits and withdrawals for
+func Bridged1155Collections() (boiler.CollectionSlice, error) {
+	return boiler.Collections(
+		boiler.CollectionWhere.ContractType.EQ(null.StringFrom(ContractTypeEIP1155)),
+		boiler.CollectionWhere.MintContract.IsNotNull(),
+		boiler.CollectionWhere.MintContract.NEQ(null.StringFrom("")),
+	).All(passdb.StdConn)
+}
+
+type CollectionSyncCursorType string
+
+const (
+	CollectionSyncCursor1155Deposit  CollectionSyncCursorType = "1155_DEPOSIT"
+	CollectionSyncCursor1155Withdraw CollectionSyncCursorType = "1155_WITHDRAW"
+	CollectionSyncCursor721Transfer  CollectionSyncCursorType = "721_TRANSFER"
+)
+
+// CollectionSyncCursor gets the last block a collection's sync has seen, a new collection starts from 0
+func CollectionSyncCursor(collectionID string, cursorType CollectionSyncCursorType) (int, error) {
+	cursor, err := boiler.FindCollectionSyncCursor(passdb.StdConn, collectionID, string(cursorType))
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int(cursor.BlockNumber), nil
+}
+
+// PutCollectionSyncCursor stores the last block a collection's sync has seen
+func PutCollectionSyncCursor(collectionID string, cursorType CollectionSyncCursorType, blockNumber int) error {
+	cursor := &boiler.CollectionSyncCursor{
+		CollectionID: collectionID,
+		CursorType:   string(cursorType),
+		BlockNumber:  int64(blockNumber),
+		UpdatedAt:    time.Now(),
+	}
+	return cursor.Upsert(
+		passdb.StdConn,
+		true,
+		[]string{boiler.CollectionSyncCursorColumns.CollectionID, boiler.CollectionSyncCursorColumns.CursorType},
+		boil.Whitelist(boiler.CollectionSyncCursorColumns.BlockNumber, boiler.CollectionSyncCursorColumns.UpdatedAt),
+		boil.Infer(),
+	)
+}
+
+// CollectionSyncCursorFresh checks a collection's sync has run within maxAge, the cursor is stored on every successful run
+func CollectionSyncCursorFresh(collectionID string, cursorType CollectionSyncCursorType, maxAge time.Duration) (bool, error) {
+	return boiler.CollectionSyncCursors(
+		boiler.CollectionSyncCursorWhere.CollectionID.EQ(collectionID),
+		boiler.CollectionSyncCursorWhere.CursorType.EQ(string(cursorType)),
+		boiler.CollectionSyncCursorWhere.UpdatedAt.GT(time.Now().Add(-maxAge)),
+	).Exists(passdb.StdConn)
+}
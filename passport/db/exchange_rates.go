@@ -0,0 +1,158 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	ExchangeRateResolutionRaw  = "RAW"
+	ExchangeRateResolutionHour = "HOUR"
+	ExchangeRateResolutionDay  = "DAY"
+)
+
+// raw rates are kept for a day before being averaged per hour, hourly rates are kept for 90 days before being averaged per day
+const exchangeRateRawRetention = 24 * time.Hour
+const exchangeRateHourRetention = 90 * 24 * time.Hour
+
+const exchangeRateHistoryLimit = 5000
+
+// ExchangeRateInsert records a price computed by the price oracle
+func ExchangeRateInsert(symbol string, usd decimal.Decimal, sources []string, trusted bool) (*boiler.ExchangeRate, error) {
+	rate := &boiler.ExchangeRate{
+		Symbol:     strings.ToLower(symbol),
+		Usd:        usd,
+		MinUsd:     usd,
+		MaxUsd:     usd,
+		Sources:    strings.Join(sources, ","),
+		Trusted:    trusted,
+		Resolution: ExchangeRateResolutionRaw,
+		RecordedAt: time.Now(),
+	}
+	err := rate.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return rate, nil
+}
+
+// ExchangeRateHistory returns the recorded rates of the symbol in the time range, oldest first.
+// Older ranges only have downsampled rates, an empty resolution returns every resolution.
+func ExchangeRateHistory(symbol string, from time.Time, to time.Time, resolution string) (boiler.ExchangeRateSlice, error) {
+	queries := []qm.QueryMod{
+		boiler.ExchangeRateWhere.Symbol.EQ(strings.ToLower(symbol)),
+		boiler.ExchangeRateWhere.RecordedAt.GTE(from),
+		boiler.ExchangeRateWhere.RecordedAt.LTE(to),
+		qm.OrderBy(boiler.ExchangeRateColumns.RecordedAt),
+		qm.Limit(exchangeRateHistoryLimit),
+	}
+	if resolution != "" {
+		queries = append(queries, boiler.ExchangeRateWhere.Resolution.EQ(resolution))
+	}
+
+	rates, err := boiler.ExchangeRates(queries...).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// ExchangeRateTrustedAt returns the last trusted rate of the symbol recorded at most maxAge before the time
+func ExchangeRateTrustedAt(symbol string, at time.Time, maxAge time.Duration) (*boiler.ExchangeRate, error) {
+	return boiler.ExchangeRates(
+		boiler.ExchangeRateWhere.Symbol.EQ(strings.ToLower(symbol)),
+		boiler.ExchangeRateWhere.Trusted.EQ(true),
+		boiler.ExchangeRateWhere.RecordedAt.LTE(at),
+		boiler.ExchangeRateWhere.RecordedAt.GTE(at.Add(-maxAge)),
+		qm.OrderBy(boiler.ExchangeRateColumns.RecordedAt+" DESC"),
+	).One(passdb.StdConn)
+}
+
+// ExchangeRateDownsample averages old raw rates per hour and old hourly rates per day.
+// Buckets are cut in UTC, a bucket that was already downsampled is merged with the new rates weighted by sample count.
+// Raw rates used by a purchase are never removed so the purchase can still be audited.
+func ExchangeRateDownsample(now time.Time) error {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	steps := []struct {
+		from   string
+		to     string
+		trunc  string
+		cutoff time.Time
+	}{
+		{ExchangeRateResolutionRaw, ExchangeRateResolutionHour, "hour", now.Add(-exchangeRateRawRetention)},
+		{ExchangeRateResolutionHour, ExchangeRateResolutionDay, "day", now.Add(-exchangeRateHourRetention)},
+	}
+
+	for _, step := range steps {
+		// only whole buckets before the cutoff are downsampled
+		bucket := fmt.Sprintf(`(DATE_TRUNC('%s', recorded_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC')`, step.trunc)
+		cutoff := fmt.Sprintf(`(DATE_TRUNC('%s', $3::TIMESTAMPTZ AT TIME ZONE 'UTC') AT TIME ZONE 'UTC')`, step.trunc)
+
+		_, err = tx.Exec(fmt.Sprintf(`
+			INSERT INTO exchange_rates AS er (symbol, usd, min_usd, max_usd, sources, trusted, resolution, sample_count, recorded_at)
+			SELECT symbol, SUM(usd * sample_count) / SUM(sample_count), MIN(min_usd), MAX(max_usd), '', BOOL_AND(trusted), $1, SUM(sample_count), %s
+			FROM exchange_rates src
+			WHERE resolution = $2
+			  AND recorded_at < %s
+			  AND NOT EXISTS (SELECT 1 FROM purchase_exchange_rates per WHERE per.exchange_rate_id = src.id)
+			GROUP BY symbol, %s
+			ON CONFLICT (symbol, resolution, recorded_at) WHERE resolution != 'RAW' DO UPDATE SET
+				usd          = (er.usd * er.sample_count + EXCLUDED.usd * EXCLUDED.sample_count) / (er.sample_count + EXCLUDED.sample_count),
+				min_usd      = LEAST(er.min_usd, EXCLUDED.min_usd),
+				max_usd      = GREATEST(er.max_usd, EXCLUDED.max_usd),
+				trusted      = er.trusted AND EXCLUDED.trusted,
+				sample_count = er.sample_count + EXCLUDED.sample_count`, bucket, cutoff, bucket), step.to, step.from, step.cutoff)
+		if err != nil {
+			return fmt.Errorf("downsample %s rates: %w", step.from, err)
+		}
+
+		_, err = tx.Exec(fmt.Sprintf(`
+			DELETE FROM exchange_rates src
+			WHERE resolution = $1
+			  AND recorded_at < %s
+			  AND NOT EXISTS (SELECT 1 FROM purchase_exchange_rates per WHERE per.exchange_rate_id = src.id)`, strings.Replace(cutoff, "$3", "$2", 1)), step.from, step.cutoff)
+		if err != nil {
+			return fmt.Errorf("delete downsampled %s rates: %w", step.from, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// PurchaseExchangeRateInsert links a SUPS purchase transaction to the rates it was priced with
+func PurchaseExchangeRateInsert(transactionID string, txHash string, symbol string, tokenUSDRate decimal.NullDecimal, supsUSDRate decimal.Decimal, exchangeRateID null.String) error {
+	link := &boiler.PurchaseExchangeRate{
+		TransactionID:  transactionID,
+		TXHash:         txHash,
+		Symbol:         strings.ToLower(symbol),
+		TokenUsdRate:   tokenUSDRate,
+		SupsUsdRate:    supsUSDRate,
+		ExchangeRateID: exchangeRateID,
+	}
+	return link.Insert(passdb.StdConn, boil.Infer())
+}
+
+// PurchaseExchangeRatesByTxHash returns the rates used by the purchase with the on chain tx hash
+func PurchaseExchangeRatesByTxHash(txHash string) (boiler.PurchaseExchangeRateSlice, error) {
+	links, err := boiler.PurchaseExchangeRates(
+		boiler.PurchaseExchangeRateWhere.TXHash.EQ(txHash),
+		qm.Load(boiler.PurchaseExchangeRateRels.ExchangeRate),
+	).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+	return links, nil
+}
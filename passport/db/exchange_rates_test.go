@@ -0,0 +1,78 @@
+package db_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMain(m *testing.M) {
+	passdbtest.Main(m)
+}
+
+func TestExchangeRateDownsample(t *testing.T) {
+	passdbtest.Require(t)
+
+	symbol := fmt.Sprintf("test%d", rand.Int())
+	now := time.Date(2023, 1, 10, 12, 30, 0, 0, time.UTC)
+	hour := now.Add(-48 * time.Hour).Truncate(time.Hour)
+
+	insert := func(t *testing.T, usd float64, at time.Time) {
+		t.Helper()
+		rate := &boiler.ExchangeRate{
+			Symbol:      symbol,
+			Usd:         decimal.NewFromFloat(usd),
+			MinUsd:      decimal.NewFromFloat(usd),
+			MaxUsd:      decimal.NewFromFloat(usd),
+			Trusted:     true,
+			Resolution:  db.ExchangeRateResolutionRaw,
+			SampleCount: 1,
+			RecordedAt:  at,
+		}
+		err := rate.Insert(passdb.StdConn, boil.Infer())
+		if err != nil {
+			t.Fatalf("failed to insert rate: %s", err)
+		}
+	}
+	hourly := func(t *testing.T) *boiler.ExchangeRate {
+		t.Helper()
+		rates, err := db.ExchangeRateHistory(symbol, hour.Add(-time.Hour), hour.Add(time.Hour), db.ExchangeRateResolutionHour)
+		if err != nil {
+			t.Fatalf("failed to get rates: %s", err)
+		}
+		if len(rates) != 1 {
+			t.Fatalf("got %d hourly rates, want 1", len(rates))
+		}
+		return rates[0]
+	}
+
+	insert(t, 1, hour.Add(10*time.Minute))
+	insert(t, 2, hour.Add(20*time.Minute))
+	err := db.ExchangeRateDownsample(now)
+	if err != nil {
+		t.Fatalf("failed to downsample: %s", err)
+	}
+	rate := hourly(t)
+	if !rate.RecordedAt.Equal(hour) || rate.SampleCount != 2 || !rate.Usd.Equal(decimal.NewFromFloat(1.5)) {
+		t.Errorf("hourly rate = %s at %s from %d samples, want 1.5 at %s from 2", rate.Usd, rate.RecordedAt, rate.SampleCount, hour)
+	}
+
+	// a rate recorded late in an hour that was already downsampled is merged into it
+	insert(t, 4.5, hour.Add(30*time.Minute))
+	err = db.ExchangeRateDownsample(now)
+	if err != nil {
+		t.Fatalf("failed to downsample: %s", err)
+	}
+	rate = hourly(t)
+	if rate.SampleCount != 3 || !rate.Usd.Equal(decimal.NewFromFloat(2.5)) || !rate.MaxUsd.Equal(decimal.NewFromFloat(4.5)) {
+		t.Errorf("hourly rate = %s (max %s) from %d samples, want 2.5 (max 4.5) from 3", rate.Usd, rate.MaxUsd, rate.SampleCount)
+	}
+}
@@ -19,8 +19,6 @@ const KeyLatestWithdrawBlockBSC KVKey = "latest_withdraw_block"
 const KeyLatestWithdrawBlockETH KVKey = "latest_withdraw_block_eth"
 const KeyLatestDepositBlockBSC KVKey = "latest_deposit_block"
 const KeyLatestDepositBlockETH KVKey = "latest_deposit_block_eth"
-const KeyLatest1155WithdrawBlock KVKey = "latest_1155_withdraw_block"
-const KeyLatest1155DepositBlock KVKey = "latest_1155_deposit_block"
 const KeyLatestBUSDBlock KVKey = "latest_busd_block"
 const KeyLatestUSDCBlock KVKey = "latest_usdc_block"
 const KeyLatestETHBlock KVKey = "latest_eth_block"
@@ -46,6 +44,19 @@ const KeyEnablePassportExchangeRate KVKey = "enable_passport_exchange_rate"
 const KeyEnablePassportExchangeRateAfterETHBlock KVKey = "passport_exchange_rate_after_eth_block"
 const KeyEnablePassportExchangeRateAfterBSCBlock KVKey = "passport_exchange_rate_after_bsc_block"
 
+const KeyPriceOracleMaxAgeSeconds KVKey = "price_oracle_max_age_seconds"
+const KeyPriceOracleMaxDeviation KVKey = "price_oracle_max_deviation"
+const KeyPriceOracleMinSources KVKey = "price_oracle_min_sources"
+const KeyPriceOracleDeviationConfirmations KVKey = "price_oracle_deviation_confirmations"
+const KeyPriceOraclePauseUntrusted KVKey = "price_oracle_pause_untrusted"
+const KeyPriceOracleSources KVKey = "price_oracle_sources"
+
+const KeyMarketplaceFeePercentage KVKey = "marketplace_fee_percentage"
+const KeyMarketplaceMinBidIncrement KVKey = "marketplace_min_bid_increment"
+
+const KeyMetadataRefreshBatchSize KVKey = "metadata_refresh_batch_size"
+const KeyMetadataRefreshPerMinute KVKey = "metadata_refresh_per_minute"
+
 const KeySUPSPurchaseContract KVKey = "contract_purchase_address"
 
 const KeySyndicateRegisterFee KVKey = "syndicate_create_fee"
@@ -0,0 +1,149 @@
+package db
+
+import (
+	"fmt"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// OnChainStatusSource is what moved an asset from one on chain status to another
+type OnChainStatusSource string
+
+const (
+	// OnChainStatusSourceRegister is an asset getting its first status when it is registered
+	OnChainStatusSourceRegister OnChainStatusSource = "REGISTER"
+	// OnChainStatusSourceSync is the nft owner sync seeing the asset move on chain
+	OnChainStatusSourceSync OnChainStatusSource = "SYNC"
+	// OnChainStatusSourceSignature is a mint or unstake signature, which only checks the move, the sync records it once it lands on chain
+	OnChainStatusSourceSignature OnChainStatusSource = "SIGNATURE"
+	// OnChainStatusSourceAdmin is an admin correcting an asset's status
+	OnChainStatusSourceAdmin OnChainStatusSource = "ADMIN"
+)
+
+// onChainTransitions are the legal moves between statuses and what can make them.
+// MINTABLE is held on xsyn, STAKABLE is in a wallet, UNSTAKABLE is on the stake contract and UNSTAKABLE_OLD on the old one.
+// The sync only sees the latest owner so it can skip a step, like a mint and stake landing between two syncs.
+// Nothing leaves the chain on its own, so only an admin can put an asset back to MINTABLE.
+var onChainTransitions = map[OnChainStatus]map[OnChainStatus][]OnChainStatusSource{
+	MINTABLE: {
+		STAKABLE:   {OnChainStatusSourceSync, OnChainStatusSourceSignature, OnChainStatusSourceAdmin},
+		UNSTAKABLE: {OnChainStatusSourceSync, OnChainStatusSourceAdmin},
+	},
+	STAKABLE: {
+		MINTABLE:      {OnChainStatusSourceAdmin},
+		UNSTAKABLE:    {OnChainStatusSourceSync, OnChainStatusSourceAdmin},
+		UNSTAKABLEOLD: {OnChainStatusSourceSync, OnChainStatusSourceAdmin},
+	},
+	UNSTAKABLE: {
+		MINTABLE:      {OnChainStatusSourceAdmin},
+		STAKABLE:      {OnChainStatusSourceSync, OnChainStatusSourceSignature, OnChainStatusSourceAdmin},
+		UNSTAKABLEOLD: {OnChainStatusSourceSync, OnChainStatusSourceAdmin},
+	},
+	UNSTAKABLEOLD: {
+		MINTABLE:   {OnChainStatusSourceAdmin},
+		STAKABLE:   {OnChainStatusSourceSync, OnChainStatusSourceAdmin},
+		UNSTAKABLE: {OnChainStatusSourceSync, OnChainStatusSourceAdmin},
+	},
+}
+
+// ValidOnChainStatus checks the status is one of the known statuses
+func ValidOnChainStatus(status OnChainStatus) bool {
+	_, ok := onChainTransitions[status]
+	return ok
+}
+
+// ValidateOnChainTransition checks the source is allowed to move an asset between the statuses
+func ValidateOnChainTransition(from OnChainStatus, to OnChainStatus, source OnChainStatusSource) error {
+	if !ValidOnChainStatus(to) {
+		return terror.Warn(fmt.Errorf("unknown on chain status %s", to), "Unknown on chain status.")
+	}
+	for _, allowed := range onChainTransitions[from][to] {
+		if allowed == source {
+			return nil
+		}
+	}
+	return terror.Warn(
+		fmt.Errorf("illegal on chain status transition from %s to %s by %s", from, to, source),
+		fmt.Sprintf("Asset can't go from %s to %s.", from, to),
+	)
+}
+
+type OnChainStatusChange struct {
+	Source      OnChainStatusSource
+	TxHash      null.String
+	ChangedByID null.String
+	Note        null.String
+}
+
+// InsertOnChainStatus inserts a newly registered asset's status and starts its history
+func InsertOnChainStatus(exec boil.Executor, status *boiler.UserAssetOnChainStatus) error {
+	if status.OnChainStatus == "" {
+		status.OnChainStatus = string(MINTABLE)
+	}
+	if !ValidOnChainStatus(OnChainStatus(status.OnChainStatus)) {
+		return fmt.Errorf("unknown on chain status %s", status.OnChainStatus)
+	}
+
+	err := status.Insert(exec, boil.Infer())
+	if err != nil {
+		return err
+	}
+
+	history := &boiler.UserAssetOnChainStatusHistory{
+		AssetHash:    status.AssetHash,
+		CollectionID: status.CollectionID,
+		ToStatus:     status.OnChainStatus,
+		Source:       string(OnChainStatusSourceRegister),
+	}
+	return history.Insert(exec, boil.Infer())
+}
+
+// SetOnChainStatus moves an asset to a new status if the transition is legal and records it in the asset's history
+func SetOnChainStatus(exec boil.Executor, status *boiler.UserAssetOnChainStatus, to OnChainStatus, change *OnChainStatusChange) error {
+	from := OnChainStatus(status.OnChainStatus)
+	if from == to {
+		return nil
+	}
+	err := ValidateOnChainTransition(from, to, change.Source)
+	if err != nil {
+		return err
+	}
+
+	status.OnChainStatus = string(to)
+	status.UpdatedAt = time.Now()
+	_, err = status.Update(exec, boil.Whitelist(boiler.UserAssetOnChainStatusColumns.OnChainStatus, boiler.UserAssetOnChainStatusColumns.UpdatedAt))
+	if err != nil {
+		return terror.Error(err, "Failed to update on chain status.")
+	}
+
+	history := &boiler.UserAssetOnChainStatusHistory{
+		AssetHash:    status.AssetHash,
+		CollectionID: status.CollectionID,
+		FromStatus:   null.StringFrom(string(from)),
+		ToStatus:     string(to),
+		Source:       string(change.Source),
+		TXHash:       change.TxHash,
+		ChangedByID:  change.ChangedByID,
+		Note:         change.Note,
+	}
+	err = history.Insert(exec, boil.Infer())
+	if err != nil {
+		return terror.Error(err, "Failed to record on chain status change.")
+	}
+
+	return nil
+}
+
+// OnChainStatusHistory gets every status an asset has had, most recent first
+func OnChainStatusHistory(assetHash string) (boiler.UserAssetOnChainStatusHistorySlice, error) {
+	return boiler.UserAssetOnChainStatusHistories(
+		boiler.UserAssetOnChainStatusHistoryWhere.AssetHash.EQ(assetHash),
+		qm.OrderBy(fmt.Sprintf("%s DESC", boiler.UserAssetOnChainStatusHistoryColumns.CreatedAt)),
+	).All(passdb.StdConn)
+}
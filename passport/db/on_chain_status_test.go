@@ -0,0 +1,44 @@
+package db_test
+
+import (
+	"testing"
+	"xsyn-services/passport/db"
+)
+
+func TestValidateOnChainTransition(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   db.OnChainStatus
+		to     db.OnChainStatus
+		source db.OnChainStatusSource
+		ok     bool
+	}{
+		{"sync sees a mint", db.MINTABLE, db.STAKABLE, db.OnChainStatusSourceSync, true},
+		{"mint signature", db.MINTABLE, db.STAKABLE, db.OnChainStatusSourceSignature, true},
+		{"sync sees a mint and stake together", db.MINTABLE, db.UNSTAKABLE, db.OnChainStatusSourceSync, true},
+		{"signatures can't stake", db.MINTABLE, db.UNSTAKABLE, db.OnChainStatusSourceSignature, false},
+		{"nothing goes to the old stake contract from xsyn", db.MINTABLE, db.UNSTAKABLEOLD, db.OnChainStatusSourceAdmin, false},
+		{"sync sees a stake", db.STAKABLE, db.UNSTAKABLE, db.OnChainStatusSourceSync, true},
+		{"sync sees a stake on the old contract", db.STAKABLE, db.UNSTAKABLEOLD, db.OnChainStatusSourceSync, true},
+		{"unstake signature", db.UNSTAKABLE, db.STAKABLE, db.OnChainStatusSourceSignature, true},
+		{"signatures can't unstake from the old contract", db.UNSTAKABLEOLD, db.STAKABLE, db.OnChainStatusSourceSignature, false},
+		{"sync sees an unstake from the old contract", db.UNSTAKABLEOLD, db.STAKABLE, db.OnChainStatusSourceSync, true},
+		{"sync sees a migration between stake contracts", db.UNSTAKABLEOLD, db.UNSTAKABLE, db.OnChainStatusSourceSync, true},
+		{"sync can't put a wallet asset back to mintable", db.STAKABLE, db.MINTABLE, db.OnChainStatusSourceSync, false},
+		{"sync can't put a staked asset back to mintable", db.UNSTAKABLE, db.MINTABLE, db.OnChainStatusSourceSync, false},
+		{"signatures can't put an asset back to mintable", db.STAKABLE, db.MINTABLE, db.OnChainStatusSourceSignature, false},
+		{"admin puts a wallet asset back to mintable", db.STAKABLE, db.MINTABLE, db.OnChainStatusSourceAdmin, true},
+		{"admin puts an old staked asset back to mintable", db.UNSTAKABLEOLD, db.MINTABLE, db.OnChainStatusSourceAdmin, true},
+		{"register isn't a transition", db.MINTABLE, db.STAKABLE, db.OnChainStatusSourceRegister, false},
+		{"unknown target status", db.MINTABLE, db.OnChainStatus("BURNT"), db.OnChainStatusSourceAdmin, false},
+		{"unknown starting status", db.OnChainStatus("BURNT"), db.STAKABLE, db.OnChainStatusSourceAdmin, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := db.ValidateOnChainTransition(tt.from, tt.to, tt.source)
+			if (err == nil) != tt.ok {
+				t.Errorf("ValidateOnChainTransition(%s, %s, %s) = %v, want ok %t", tt.from, tt.to, tt.source, err, tt.ok)
+			}
+		})
+	}
+}
@@ -267,7 +267,7 @@ func TransactionGetByID(transactionID string) (*boiler.Transaction, error) {
 	transaction, err := boiler.Transactions(
 		boiler.TransactionWhere.ID.EQ(transactionID),
 	).One(passdb.StdConn)
-	if err != nil {
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, err
 	}
 
@@ -306,7 +306,7 @@ func TransactionGetByReference(transactionRef string) (*boiler.Transaction, erro
 	transaction, err := boiler.Transactions(
 		boiler.TransactionWhere.TransactionReference.EQ(transactionRef),
 	).One(passdb.StdConn)
-	if err != nil {
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, err
 	}
 
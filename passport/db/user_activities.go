@@ -65,7 +65,7 @@ func UserActivityCreate(
 func UserActivityGet(result *types.UserActivity, id types.UserActivityID) error {
 	q := `
 		SELECT a.id, a.user_id, a.action, a.object_type, a.object_id, a.object_slug, a.object_name, a.created_at, a.old_data, a.new_data,
-			u.id as "user.id", u.email as "user.email", u.username as "user.username", u.avatar_id as "user.avatar_id", u.role as "user.role"
+			u.id as "user.id", u.email as "user.email", u.username as "user.username", u.avatar_id as "user.avatar_id", u.avatar_user_asset_id as "user.avatar_user_asset_id", u.role as "user.role"
 		FROM user_activities a
 		JOIN users u ON u.id = a.user_id
 		WHERE a.id = $1`
@@ -84,6 +84,7 @@ func UserActivityGet(result *types.UserActivity, id types.UserActivityID) error
 		&result.User.Email,
 		&result.User.Username,
 		&result.User.AvatarID,
+		&result.User.AvatarUserAssetID,
 		&result.User.RoleID,
 	)
 	if err != nil {
@@ -148,10 +149,10 @@ func UserActivityList(result []*types.UserActivity, search string, filter *ListF
 	q = fmt.Sprintf(
 		`--sql
 		SELECT a.id, a.user_id, a.action, a.object_type, a.object_id, a.object_slug, a.object_name, a.created_at, a.old_data, a.new_data,
-			"user.id", "user.email", "user.username", "user.avatar_id"
+			"user.id", "user.email", "user.username", "user.avatar_id", "user.avatar_user_asset_id"
 		FROM user_activities a
 		JOIN (
-			SELECT users.id as "user.id", email as "user.email", username as "user.username", avatar_id as "user.avatar_id"
+			SELECT users.id as "user.id", email as "user.email", username as "user.username", avatar_id as "user.avatar_id", avatar_user_asset_id as "user.avatar_user_asset_id"
 			FROM users
 		) u ON "user.id" = a.user_id
 		%s
@@ -185,6 +186,7 @@ func UserActivityList(result []*types.UserActivity, search string, filter *ListF
 			&act.User.Email,
 			&act.User.Username,
 			&act.User.AvatarID,
+			&act.User.AvatarUserAssetID,
 		)
 		if err != nil {
 			return 0, err
@@ -26,6 +26,7 @@ const (
 	UserColumnUsername            UserColumn = "username"
 	UserColumnRoleID              UserColumn = "role_id"
 	UserColumnAvatarID            UserColumn = "avatar_id"
+	UserColumnAvatarUserAssetID   UserColumn = "avatar_user_asset_id"
 	UserColumnEmail               UserColumn = "email"
 	UserColumnMobileNumber        UserColumn = "mobile_number"
 	UserColumnFirstName           UserColumn = "first_name"
@@ -47,6 +48,7 @@ func (ic UserColumn) IsValid() error {
 		UserColumnUsername,
 		UserColumnRoleID,
 		UserColumnAvatarID,
+		UserColumnAvatarUserAssetID,
 		UserColumnEmail,
 		UserColumnFirstName,
 		UserColumnLastName,
@@ -64,7 +66,7 @@ func (ic UserColumn) IsValid() error {
 
 const UserGetQuery = `--sql
 SELECT 
-	users.id, users.role_id, users.two_factor_authentication_activated, users.two_factor_authentication_is_set, users.first_name, users.last_name, users.email, users.username, users.avatar_id, users.verified, users.old_password_required,
+	users.id, users.role_id, users.two_factor_authentication_activated, users.two_factor_authentication_is_set, users.first_name, users.last_name, users.email, users.username, users.avatar_id, users.avatar_user_asset_id, users.verified, users.old_password_required,
 	users.created_at, accounts.sups, users.updated_at, users.deleted_at, users.facebook_id, users.google_id, users.twitch_id, users.twitter_id, users.discord_id, users.public_address, users.nonce, users.faction_id, users.withdraw_lock, users.mint_lock, users.total_lock,
 	(SELECT COUNT(id) FROM user_recovery_codes urc WHERE urc.user_id = users.id) > 0 as has_recovery_code, users.mobile_number,
 	row_to_json(role) as role,
@@ -197,6 +199,7 @@ func UserList(
 			&u.Email,
 			&u.Username,
 			&u.AvatarID,
+			&u.AvatarUserAssetID,
 			&u.Verified,
 			&u.OldPasswordRequired,
 			&u.CreatedAt,
@@ -0,0 +1,239 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ninja-software/sale/dispersions"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// VestingSchedulesByAddress returns every schedule that applies to the address, both the address specific ones and the ones of its cohort
+func VestingSchedulesByAddress(addr common.Address) (boiler.VestingScheduleSlice, error) {
+	where := []qm.QueryMod{
+		boiler.VestingScheduleWhere.PublicAddress.EQ(null.StringFrom(addr.Hex())),
+	}
+
+	member, err := boiler.VestingCohortMembers(
+		boiler.VestingCohortMemberWhere.PublicAddress.EQ(addr.Hex()),
+	).One(passdb.StdConn)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if member != nil {
+		where = append(where, qm.Or2(boiler.VestingScheduleWhere.Cohort.EQ(null.StringFrom(member.Cohort))))
+	}
+
+	schedules, err := boiler.VestingSchedules(
+		qm.Expr(where...),
+		qm.Load(boiler.VestingScheduleRels.ScheduleVestingUnlockEvents),
+	).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// VestingMaxWithdrawBefore returns the total amount (in wei) the address is allowed to have withdrawn at the given time.
+// Every address needs a schedule, addresses that were never vested get an unlimited one from the dispersion import.
+// Addresses without a schedule can't withdraw until one is imported or created.
+func VestingMaxWithdrawBefore(addr common.Address, now time.Time) (decimal.Decimal, bool, error) {
+	schedules, err := VestingSchedulesByAddress(addr)
+	if err != nil {
+		return decimal.Zero, false, fmt.Errorf("get vesting schedules: %w", err)
+	}
+
+	total, unlimited := VestingLimit(schedules, now)
+	return total, unlimited, nil
+}
+
+// VestingLimit adds up what the schedules have unlocked at the given time, any unlimited schedule lifts the limit
+func VestingLimit(schedules boiler.VestingScheduleSlice, now time.Time) (decimal.Decimal, bool) {
+	total := decimal.Zero
+	for _, s := range schedules {
+		if s.Unlimited {
+			return decimal.Zero, true
+		}
+		total = total.Add(VestedAmount(s, now))
+	}
+	return total, false
+}
+
+// VestedAmount returns the amount unlocked by a single schedule at the given time.
+// Unlock events need to be loaded on the schedule to be counted.
+func VestedAmount(s *boiler.VestingSchedule, now time.Time) decimal.Decimal {
+	amount := decimal.Zero
+
+	// cliff
+	if s.CliffAt.Valid && !now.Before(s.CliffAt.Time) {
+		amount = amount.Add(s.CliffAmount)
+	}
+
+	// linear drip
+	if s.DripStartAt.Valid && s.DripEndAt.Valid && now.After(s.DripStartAt.Time) {
+		if !now.Before(s.DripEndAt.Time) {
+			amount = amount.Add(s.DripAmount)
+		} else {
+			elapsed := decimal.NewFromInt(now.Sub(s.DripStartAt.Time).Milliseconds())
+			duration := decimal.NewFromInt(s.DripEndAt.Time.Sub(s.DripStartAt.Time).Milliseconds())
+			amount = amount.Add(s.DripAmount.Mul(elapsed).Div(duration).Floor())
+		}
+	}
+
+	// one-off unlock events
+	if s.R != nil {
+		for _, ev := range s.R.ScheduleVestingUnlockEvents {
+			if !now.Before(ev.UnlockAt) {
+				amount = amount.Add(ev.Amount)
+			}
+		}
+	}
+
+	return amount
+}
+
+// VestingScheduleCreate inserts the schedule along with its unlock events
+func VestingScheduleCreate(schedule *boiler.VestingSchedule, unlocks []*boiler.VestingUnlockEvent) error {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = schedule.Insert(tx, boil.Infer())
+	if err != nil {
+		return err
+	}
+
+	for _, ev := range unlocks {
+		ev.ScheduleID = schedule.ID
+		err = ev.Insert(tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// VestingCohortMemberSet moves the address into the cohort, an address can only be in one cohort at a time
+func VestingCohortMemberSet(addr common.Address, cohort string) error {
+	member := &boiler.VestingCohortMember{
+		PublicAddress: addr.Hex(),
+		Cohort:        cohort,
+	}
+	err := member.Upsert(passdb.StdConn, true, []string{boiler.VestingCohortMemberColumns.PublicAddress}, boil.Whitelist(boiler.VestingCohortMemberColumns.Cohort), boil.Infer())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// VestingImportFromDispersions converts the hard coded dispersion tables into vesting schedules for the given addresses.
+// The dispersion curve is sampled around the old withdraw start, cliff end and drip start timestamps, addresses with an unlimited allowance get an unlimited schedule.
+// Addresses that already have a schedule are skipped, so it can be rerun to cover wallets connected since.
+// Kept around only to migrate the existing allowances, remove once every environment has been imported.
+func VestingImportFromDispersions(addresses []common.Address, withdrawStart, cliffEnd, dripStart time.Time) (int, error) {
+	// far enough in the future for every dispersion to have fully dripped
+	fullyVested := dripStart.AddDate(10, 0, 0)
+
+	imported := 0
+	for _, addr := range addresses {
+		exists, err := boiler.VestingSchedules(
+			boiler.VestingScheduleWhere.PublicAddress.EQ(null.StringFrom(addr.Hex())),
+		).Exists(passdb.StdConn)
+		if err != nil {
+			return imported, err
+		}
+		if exists {
+			continue
+		}
+
+		amountAt := func(t time.Time) (decimal.Decimal, bool, error) {
+			return dispersions.MaxWithdrawBefore(addr, t, withdrawStart, cliffEnd, dripStart)
+		}
+
+		total, infinite, err := amountAt(fullyVested)
+		if err != nil {
+			return imported, fmt.Errorf("get total dispersion for %s: %w", addr.Hex(), err)
+		}
+		if infinite {
+			err = VestingScheduleCreate(&boiler.VestingSchedule{
+				Label:         "dispersion import",
+				PublicAddress: null.StringFrom(addr.Hex()),
+				Unlimited:     true,
+			}, nil)
+			if err != nil {
+				return imported, fmt.Errorf("insert vesting schedule for %s: %w", addr.Hex(), err)
+			}
+			imported++
+			continue
+		}
+
+		atWithdrawStart, _, err := amountAt(withdrawStart)
+		if err != nil {
+			return imported, err
+		}
+		atCliffEnd, _, err := amountAt(cliffEnd)
+		if err != nil {
+			return imported, err
+		}
+		atDripStart, _, err := amountAt(dripStart)
+		if err != nil {
+			return imported, err
+		}
+
+		// find when the drip finishes, to the minute
+		low, high := dripStart, fullyVested
+		for high.Sub(low) > time.Minute {
+			mid := low.Add(high.Sub(low) / 2)
+			amt, _, err := amountAt(mid)
+			if err != nil {
+				return imported, err
+			}
+			if amt.LessThan(total) {
+				low = mid
+			} else {
+				high = mid
+			}
+		}
+
+		schedule := &boiler.VestingSchedule{
+			Label:         "dispersion import",
+			PublicAddress: null.StringFrom(addr.Hex()),
+			CliffAt:       null.TimeFrom(cliffEnd),
+			CliffAmount:   atCliffEnd.Sub(atWithdrawStart),
+			DripAmount:    total.Sub(atDripStart),
+		}
+		if schedule.DripAmount.GreaterThan(decimal.Zero) {
+			schedule.DripStartAt = null.TimeFrom(dripStart)
+			schedule.DripEndAt = null.TimeFrom(high)
+		}
+
+		// anything unlocked outside of the cliff and drip becomes a one-off unlock event
+		unlocks := []*boiler.VestingUnlockEvent{}
+		if atWithdrawStart.GreaterThan(decimal.Zero) {
+			unlocks = append(unlocks, &boiler.VestingUnlockEvent{UnlockAt: withdrawStart, Amount: atWithdrawStart})
+		}
+		if atDripStart.GreaterThan(atCliffEnd) {
+			unlocks = append(unlocks, &boiler.VestingUnlockEvent{UnlockAt: dripStart, Amount: atDripStart.Sub(atCliffEnd)})
+		}
+
+		err = VestingScheduleCreate(schedule, unlocks)
+		if err != nil {
+			return imported, fmt.Errorf("insert vesting schedule for %s: %w", addr.Hex(), err)
+		}
+		imported++
+	}
+
+	return imported, nil
+}
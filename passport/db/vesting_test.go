@@ -0,0 +1,100 @@
+package db
+
+import (
+	"testing"
+	"time"
+	"xsyn-services/boiler"
+
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestVestedAmount(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	cliff := start.AddDate(0, 3, 0)
+	dripStart := start.AddDate(0, 6, 0)
+	dripEnd := dripStart.Add(100 * time.Hour)
+
+	schedule := func() *boiler.VestingSchedule {
+		s := &boiler.VestingSchedule{
+			CliffAt:     null.TimeFrom(cliff),
+			CliffAmount: decimal.NewFromInt(1000),
+			DripStartAt: null.TimeFrom(dripStart),
+			DripEndAt:   null.TimeFrom(dripEnd),
+			DripAmount:  decimal.NewFromInt(10000),
+		}
+		s.R = s.R.NewStruct()
+		s.R.ScheduleVestingUnlockEvents = boiler.VestingUnlockEventSlice{
+			{UnlockAt: start, Amount: decimal.NewFromInt(7)},
+		}
+		return s
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want int64
+	}{
+		{"before anything unlocks", start.Add(-time.Second), 0},
+		{"unlock event", start, 7},
+		{"just before the cliff", cliff.Add(-time.Second), 7},
+		{"at the cliff", cliff, 1007},
+		{"drip start", dripStart, 1007},
+		{"a quarter of the drip", dripStart.Add(25 * time.Hour), 3507},
+		{"drip rounds down", dripStart.Add(time.Millisecond), 1007},
+		{"drip end", dripEnd, 11007},
+		{"long after", dripEnd.AddDate(5, 0, 0), 11007},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := VestedAmount(schedule(), tt.now)
+			if !got.Equal(decimal.NewFromInt(tt.want)) {
+				t.Errorf("VestedAmount() = %s, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVestedAmountWithoutUnlockEventsLoaded(t *testing.T) {
+	s := &boiler.VestingSchedule{
+		CliffAt:     null.TimeFrom(time.Unix(0, 0)),
+		CliffAmount: decimal.NewFromInt(5),
+	}
+	got := VestedAmount(s, time.Now())
+	if !got.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("VestedAmount() = %s, want 5", got)
+	}
+}
+
+func TestVestingLimit(t *testing.T) {
+	now := time.Now()
+	cliffed := func(amount int64) *boiler.VestingSchedule {
+		return &boiler.VestingSchedule{
+			CliffAt:     null.TimeFrom(now.Add(-time.Hour)),
+			CliffAmount: decimal.NewFromInt(amount),
+		}
+	}
+
+	tests := []struct {
+		name          string
+		schedules     boiler.VestingScheduleSlice
+		want          int64
+		wantUnlimited bool
+	}{
+		{"no schedule can't withdraw", boiler.VestingScheduleSlice{}, 0, false},
+		{"address and cohort schedules add up", boiler.VestingScheduleSlice{cliffed(10), cliffed(32)}, 42, false},
+		{"unlimited schedule lifts the limit", boiler.VestingScheduleSlice{cliffed(10), {Unlimited: true}}, 0, true},
+		{"nothing unlocked yet", boiler.VestingScheduleSlice{{CliffAt: null.TimeFrom(now.Add(time.Hour)), CliffAmount: decimal.NewFromInt(10)}}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, unlimited := VestingLimit(tt.schedules, now)
+			if unlimited != tt.wantUnlimited {
+				t.Errorf("VestingLimit() unlimited = %v, want %v", unlimited, tt.wantUnlimited)
+			}
+			if !got.Equal(decimal.NewFromInt(tt.want)) {
+				t.Errorf("VestingLimit() = %s, want %d", got, tt.want)
+			}
+		})
+	}
+}
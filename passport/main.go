@@ -11,9 +11,11 @@ import (
 	"time"
 	"xsyn-services/boiler"
 	"xsyn-services/passport/api"
+	"xsyn-services/passport/asset"
 	"xsyn-services/passport/comms"
 	"xsyn-services/passport/db"
 	"xsyn-services/passport/email"
+	"xsyn-services/passport/media"
 	"xsyn-services/passport/passdb"
 	"xsyn-services/passport/passlog"
 	"xsyn-services/passport/payments"
@@ -24,8 +26,6 @@ import (
 
 	"github.com/volatiletech/null/v8"
 
-	"github.com/ninja-syndicate/ws"
-
 	"github.com/jackc/pgx/v4/stdlib"
 	"github.com/shopspring/decimal"
 
@@ -179,10 +179,13 @@ func main() {
 					// private keys
 					&cli.StringFlag{Name: "signer_private_key", Value: "SAMPLE", EnvVars: []string{envPrefix + "_SIGNER_PRIVATE_KEY"}, Usage: "Private key for signing (usually operator)"},
 					&cli.StringFlag{Name: "achievement_signer_private_key", Value: "SAMPLE", EnvVars: []string{envPrefix + "_ACHIEVEMENT_SIGNER_PRIVATE_KEY"}, Usage: "Private key for signing achievement contract (usually operator)"},
+					&cli.StringFlag{Name: "collection_signer_private_keys", Value: "", EnvVars: []string{envPrefix + "_COLLECTION_SIGNER_PRIVATE_KEYS"}, Usage: "Private keys for signing 1155 withdrawals as comma separated collection_slug=key pairs"},
 
 					// chain id
 					&cli.IntFlag{Name: "bsc_chain_id", Value: 97, EnvVars: []string{envPrefix + "_BSC_CHAIN_ID"}, Usage: "BSC Chain ID"},
 					&cli.IntFlag{Name: "eth_chain_id", Value: 5, EnvVars: []string{envPrefix + "_ETH_CHAIN_ID"}, Usage: "ETH Chain ID"},
+					&cli.StringFlag{Name: "eth_node_url", Value: "", EnvVars: []string{envPrefix + "_ETH_NODE_URL"}, Usage: "ETH node for block times of holder snapshots"},
+					&cli.StringFlag{Name: "bsc_node_url", Value: "", EnvVars: []string{envPrefix + "_BSC_NODE_URL"}, Usage: "BSC node for block times of holder snapshots"},
 
 					//router address for exchange rates
 					&cli.BoolFlag{Name: "enable_purchase_subscription", Value: false, EnvVars: []string{envPrefix + "_ENABLE_PURCHASE_SUBSCRIPTION"}, Usage: "Poll payments and price"},
@@ -198,6 +201,8 @@ func main() {
 					&cli.StringFlag{Name: "bot_secret_key", Value: `HsZ8DGnNshjkvbvdmJvjLY0CEaoAyn0SnzHjLaCESL91YwsRELsaGyvJsteUf6kI`, EnvVars: []string{envPrefix + "_BOT_SECRET_KEY"}, Usage: "Key for verifying requests from our own bots"},
 					&cli.StringFlag{Name: "ignore_rate_limit_ips", Value: "127.0.0.1", EnvVars: []string{envPrefix + "_IGNORE_RATE_LIMIT_IP"}, Usage: "Ignore rate limiting on these IPs"},
 					&cli.StringFlag{Name: "email_template_path", Value: "./passport/email/templates", EnvVars: []string{envPrefix + "_EMAIL_TEMPLATE_PATH"}, Usage: "path to email templates"},
+					&cli.StringFlag{Name: "media_cache_dir", Value: "./media_cache", EnvVars: []string{envPrefix + "_MEDIA_CACHE_DIR"}, Usage: "Directory the media proxy caches images and resized variants in"},
+					&cli.StringFlag{Name: "media_allowed_hosts", Value: "afiles.ninja-cdn.com", EnvVars: []string{envPrefix + "_MEDIA_ALLOWED_HOSTS"}, Usage: "Comma separated hosts the media proxy is allowed to fetch images from"},
 				},
 
 				Usage: "run server",
@@ -289,6 +294,7 @@ func main() {
 					return nil
 				},
 			},
+			pendingCommand(),
 		},
 	}
 
@@ -338,6 +344,12 @@ func sqlConnect(
 }
 
 func SyncPayments(ucm *api.Transactor, log *zerolog.Logger, isTestnet bool, pxr *api.PassportExchangeRate, environment types.Environment) error {
+	// leave the purchases on avant until the prices can be trusted again
+	if paused, reason := payments.PurchasesPaused(); paused {
+		log.Warn().Str("reason", reason).Msg("purchases paused, prices can't be trusted")
+		pxr.Notify()
+		return nil
+	}
 
 	records1, err := payments.BNB(isTestnet)
 	if err != nil {
@@ -397,6 +409,7 @@ func SyncPayments(ucm *api.Transactor, log *zerolog.Logger, isTestnet bool, pxr
 	successful := 0
 	skipped := 0
 	failed := 0
+	held := 0
 
 	for _, r := range records {
 		ctx := context.Background()
@@ -432,6 +445,17 @@ func SyncPayments(ucm *api.Transactor, log *zerolog.Logger, isTestnet bool, pxr
 		}
 
 		err = payments.StoreRecord(ctx, types.XsynSaleUserID, types.UserIDFromString(user.ID), ucm, r, passportExchangeRatesEnabled)
+		if errors.Is(err, payments.ErrPurchaseHeld) {
+			log.Warn().Str("sym", r.Symbol).Str("txid", r.TxHash).Err(err).Msg("holding purchase for review")
+			err = payments.HoldPurchase(types.UserIDFromString(user.ID), r, err.Error())
+			if err != nil {
+				failed++
+				log.Error().Str("sym", r.Symbol).Str("txid", r.TxHash).Err(err).Msg("failed to hold purchase")
+				continue
+			}
+			held++
+			continue
+		}
 		if err != nil && strings.Contains(err.Error(), "duplicate key") {
 			skipped++
 			continue
@@ -445,14 +469,10 @@ func SyncPayments(ucm *api.Transactor, log *zerolog.Logger, isTestnet bool, pxr
 		successful++
 
 	}
-	if err != nil {
-		return fmt.Errorf("get all exchange rates: %w", err)
-	}
-
-	exchangeRates, err := payments.FetchExchangeRates(passportExchangeRatesEnabled)
-	ws.PublishMessage("/public/exchange_rates", api.HubKeySUPSExchangeRates, exchangeRates)
+	// the sale may have been toggled, subscribers only get the rates if they changed
+	pxr.Notify()
 
-	log.Info().Int("skipped", skipped).Int("successful", successful).Int("failed", failed).Msg("synced payments")
+	log.Info().Int("skipped", skipped).Int("successful", successful).Int("failed", failed).Int("held", held).Msg("synced payments")
 
 	return nil
 
@@ -470,20 +490,12 @@ func SyncDeposits(ucm *api.Transactor, purchaseAddress common.Address, isTestnet
 	return nil
 }
 
-func Sync1155Deposits(collectionSlug string, purchaseAddress common.Address, isTestnet bool, environment types.Environment) error {
-	collection, err := db.CollectionBySlug(collectionSlug)
-	if err != nil {
-		return err
-	}
-	if !collection.MintContract.Valid {
-		return fmt.Errorf("failed to get mint contract")
-	}
-
-	depositRecords, err := payments.Get1155Deposits(isTestnet, collection.MintContract.String)
+func Sync1155Deposits(collection *boiler.Collection, purchaseAddress common.Address, isTestnet bool, environment types.Environment) error {
+	depositRecords, err := payments.Get1155Deposits(isTestnet, collection)
 	if err != nil {
 		return fmt.Errorf("get deposits: %w", err)
 	}
-	_, _, err = payments.Process1155Deposits(depositRecords, collectionSlug, purchaseAddress, environment)
+	_, _, err = payments.Process1155Deposits(depositRecords, collection.Slug, purchaseAddress, environment)
 	if err != nil {
 		return fmt.Errorf("process deposits: %w", err)
 	}
@@ -531,14 +543,17 @@ func SyncNFTs(isTestnet bool, environment types.Environment) error {
 	}
 
 	for _, collection := range allCollections {
-		collectionNftOwnerStatuses, err := payments.GetNFTOwnerRecords(isTestnet, collection)
+		// a failed collection picks up from its cursor next run, it doesn't stop the others
+		indexed, err := payments.IndexNFTTransfers(collection, isTestnet)
 		if err != nil {
-			return fmt.Errorf("get nft owners: %w", err)
+			passlog.L.Warn().Err(err).Str("collection.Slug", collection.Slug).Msg("failed to index nft transfers")
+			continue
 		}
 
-		ownerUpdated, ownerSkipped, err := payments.UpdateOwners(collectionNftOwnerStatuses, collection, environment)
+		ownerUpdated, ownerSkipped, err := payments.ApplyNFTOwnershipChanges(collection, environment)
 		if err != nil {
-			return fmt.Errorf("update nft owners: %w", err)
+			passlog.L.Warn().Err(err).Str("collection.Slug", collection.Slug).Msg("failed to apply nft ownership changes")
+			continue
 		}
 
 		passlog.L.Info().
@@ -547,37 +562,28 @@ func SyncNFTs(isTestnet bool, environment types.Environment) error {
 			Str("collection.StakeContract.String", collection.StakeContract.String).
 			Str("collection.StakingContractOld.String", collection.StakingContractOld.String).
 			Bool("isTestnet", isTestnet).
-			Int("records", len(collectionNftOwnerStatuses)).
+			Int("indexed", indexed).
 			Int("updated", ownerUpdated).
 			Int("skipped", ownerSkipped).
 			Msg("synced nft ownerships")
 	}
-	db.PutBool(db.KeyEnableSyncNFTOwners, true)
 	return nil
 }
 
-func Sync1155Withdraw(collectionSlug string, isTestnet, enable1155Rollback bool) error {
-	collection, err := db.CollectionBySlug(collectionSlug)
-	if err != nil {
-		return err
-	}
-	if !collection.MintContract.Valid {
-		return fmt.Errorf("failed to get mint contract")
-	}
-
+func Sync1155Withdraw(collection *boiler.Collection, isTestnet, enable1155Rollback bool) error {
 	// Update with TX hash first
-	records, err := payments.Get1155Withdraws(isTestnet, collection.MintContract.String)
+	records, err := payments.Get1155Withdraws(isTestnet, collection)
 	if err != nil {
 		return fmt.Errorf("get 1155: %w", err)
 	}
-	success, skipped := payments.UpdateSuccessful1155WithdrawalsWithTxHash(records, collection.MintContract.String)
-	passlog.L.Info().Int("success", success).Int("skipped", skipped).Msg("add tx hashes to pending refunds")
+	success, skipped := payments.UpdateSuccessful1155WithdrawalsWithTxHash(records, collection)
+	passlog.L.Info().Str("collection_slug", collection.Slug).Int("success", success).Int("skipped", skipped).Msg("add tx hashes to pending refunds")
 
-	refundsSuccess, refundsSkipped, err := payments.ReverseFailed1155(enable1155Rollback)
+	refundsSuccess, refundsSkipped, err := payments.ReverseFailed1155(enable1155Rollback, collection)
 	if err != nil {
 		return fmt.Errorf("process withdraws: %w", err)
 	}
-	passlog.L.Info().Int("success", refundsSuccess).Int("skipped", refundsSkipped).Msg("refunds processed")
+	passlog.L.Info().Str("collection_slug", collection.Slug).Int("success", refundsSuccess).Int("skipped", refundsSkipped).Msg("refunds processed")
 
 	return nil
 }
@@ -644,27 +650,48 @@ func SyncFunc(ucm *api.Transactor, log *zerolog.Logger, isTestnet, enableWithdra
 			}
 		}
 	}(ucm, isTestnet)
-	// sync 1155 nft withdrawals
-	go func(isTestnet bool) {
-		if db.GetBoolWithDefault(db.KeyEnableSync1155, false) {
-			err := Sync1155Withdraw("supremacy-achievements", isTestnet, enableWithdrawRollback)
-			if err != nil {
-				passlog.L.Err(err).Msg("failed to sync 1155")
-			}
+	// sync 1155 nft withdrawals and deposits for every 1155 collection
+	if db.GetBoolWithDefault(db.KeyEnableSync1155, false) {
+		collections1155, err := db.Bridged1155Collections()
+		if err != nil {
+			passlog.L.Err(err).Msg("failed to get 1155 collections")
+			return nil
 		}
-	}(isTestnet)
-	// sync 1155 nft deposits
-	go func(isTestnet bool) {
-		if db.GetBoolWithDefault(db.KeyEnableSync1155, false) {
-			err := Sync1155Deposits("supremacy-achievements", config.PurchaseAddress, isTestnet, environment)
-			if err != nil {
-				passlog.L.Err(err).Msg("failed to sync 1155")
-			}
+		for _, collection := range collections1155 {
+			go func(collection *boiler.Collection, isTestnet bool) {
+				err := Sync1155Withdraw(collection, isTestnet, enableWithdrawRollback)
+				if err != nil {
+					passlog.L.Err(err).Str("collection_slug", collection.Slug).Msg("failed to sync 1155 withdrawals")
+				}
+			}(collection, isTestnet)
+			go func(collection *boiler.Collection, isTestnet bool) {
+				err := Sync1155Deposits(collection, config.PurchaseAddress, isTestnet, environment)
+				if err != nil {
+					passlog.L.Err(err).Str("collection_slug", collection.Slug).Msg("failed to sync 1155 deposits")
+				}
+			}(collection, isTestnet)
 		}
-	}(isTestnet)
+	}
 	return nil
 }
 
+// parseCollectionSignerKeys reads comma separated collection_slug=key pairs
+func parseCollectionSignerKeys(s string) (map[string]string, error) {
+	keys := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		slug, key, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(slug) == "" || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("collection signer keys must be collection_slug=key pairs")
+		}
+		keys[strings.TrimSpace(slug)] = strings.TrimSpace(key)
+	}
+	return keys, nil
+}
+
 func ServeFunc(ctxCLI *cli.Context, log *zerolog.Logger) error {
 	databaseMaxIdleConns := ctxCLI.Int("database_max_idle_conns")
 	databaseMaxOpenConns := ctxCLI.Int("database_max_open_conns")
@@ -709,6 +736,10 @@ func ServeFunc(ctxCLI *cli.Context, log *zerolog.Logger) error {
 	moralisKey := ctxCLI.String("moralis_key")
 	signerPrivateKey := ctxCLI.String("signer_private_key")
 	achievementSignerKey := ctxCLI.String("achievement_signer_private_key")
+	collectionSignerKeys, err := parseCollectionSignerKeys(ctxCLI.String("collection_signer_private_keys"))
+	if err != nil {
+		return err
+	}
 	BSCChainID := ctxCLI.Int("bsc_chain_id")
 	ETHChainID := ctxCLI.Int("eth_chain_id")
 
@@ -803,6 +834,7 @@ func ServeFunc(ctxCLI *cli.Context, log *zerolog.Logger) error {
 		Web3Params: &types.Web3Params{
 			SignerPrivateKey:      signerPrivateKey,
 			AchievementsSignerKey: achievementSignerKey,
+			CollectionSignerKeys:  collectionSignerKeys,
 			MoralisKey:            moralisKey,
 			EthChainID:            ETHChainID,
 			BscChainID:            BSCChainID,
@@ -886,7 +918,12 @@ func ServeFunc(ctxCLI *cli.Context, log *zerolog.Logger) error {
 		return terror.Error(err, "Failed to convert string to byte array")
 	}
 
-	passportExchangeRate := &api.PassportExchangeRate{}
+	passportExchangeRate := api.NewPassportExchangeRate()
+
+	mediaProxy, err := media.NewProxy(ctxCLI.String("media_cache_dir"), strings.Split(ctxCLI.String("media_allowed_hosts"), ","))
+	if err != nil {
+		return terror.Error(err, "Failed to set up media proxy")
+	}
 
 	// API Server
 	api, routes := api.NewAPI(log,
@@ -904,6 +941,7 @@ func ServeFunc(ctxCLI *cli.Context, log *zerolog.Logger) error {
 		types.Environment(environment),
 		strings.Split(ctxCLI.String("ignore_rate_limit_ips"), ","),
 		passportExchangeRate,
+		mediaProxy,
 	)
 
 	passlog.L.Info().Msg("start rpc server")
@@ -1014,6 +1052,24 @@ func ServeFunc(ctxCLI *cli.Context, log *zerolog.Logger) error {
 		}()
 	}
 
+	go asset.RunMetadataRefresh()
+	go asset.RunHolderSnapshots(asset.NodeBlockTime(map[string]string{
+		db.CollectionChainETH: ctxCLI.String("eth_node_url"),
+		db.CollectionChainBSC: ctxCLI.String("bsc_node_url"),
+	}))
+	go asset.RunReconciliations()
+	go mediaProxy.RunBlurhashes()
+
+	go func() {
+		t := time.NewTicker(time.Hour)
+		for range t.C {
+			err := db.ExchangeRateDownsample(time.Now())
+			if err != nil {
+				passlog.L.Err(err).Msg("failed to downsample exchange rates")
+			}
+		}
+	}()
+
 	if !skipUpdateUsersMixedCase {
 		go func() {
 			passlog.L.Info().Msg("updating all users to mixed case")
@@ -0,0 +1,118 @@
+package media
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+
+	"github.com/buckket/go-blurhash"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	blurhashBatchSize = 50
+	// blurhashes are made from a small variant, the detail is lost in the hash anyway
+	blurhashWidth       = 64
+	blurhashXComponents = 4
+	blurhashYComponents = 3
+	// blurhashRetryDelay is how long an image that failed to fetch for a reason that may pass is left before trying again
+	blurhashRetryDelay = 10 * time.Minute
+)
+
+// Blurhash makes a blurhash placeholder of the source
+func (p *Proxy) Blurhash(src Source) (string, error) {
+	img, err := p.Image(src)
+	if err != nil {
+		return "", err
+	}
+	return blurhash.Encode(blurhashXComponents, blurhashYComponents, resize(img, blurhashWidth))
+}
+
+// permanentBlurhashError is an image that will never make a blurhash until the asset's image changes
+func permanentBlurhashError(err error) bool {
+	return errors.Is(err, ErrHostNotAllowed) || errors.Is(err, ErrNotImage) || errors.Is(err, ErrTooLarge)
+}
+
+// UpdateBlurhashes makes blurhashes for assets with an image that doesn't have one yet, or has changed since it was made.
+// An image that can never make one is skipped until the asset's image changes, one that failed to fetch is tried again after blurhashRetryDelay,
+// so one bad url doesn't hold up the rest. It returns how many assets it went through.
+func (p *Proxy) UpdateBlurhashes() (int, error) {
+	p.blurhashRetriesLock.Lock()
+	defer p.blurhashRetriesLock.Unlock()
+
+	waiting := []string{}
+	for id, retryAt := range p.blurhashRetries {
+		if time.Now().After(retryAt) {
+			delete(p.blurhashRetries, id)
+			continue
+		}
+		waiting = append(waiting, id)
+	}
+
+	queryMods := []qm.QueryMod{
+		boiler.UserAssetWhere.ImageURL.IsNotNull(),
+		qm.Where(fmt.Sprintf("%s IS DISTINCT FROM %s", boiler.UserAssetColumns.ImageBlurhashURL, boiler.UserAssetColumns.ImageURL)),
+		qm.Select(boiler.UserAssetColumns.ID, boiler.UserAssetColumns.Hash, boiler.UserAssetColumns.ImageURL),
+		qm.Limit(blurhashBatchSize),
+	}
+	if len(waiting) > 0 {
+		queryMods = append(queryMods, boiler.UserAssetWhere.ID.NIN(waiting))
+	}
+	userAssets, err := boiler.UserAssets(queryMods...).All(passdb.StdConn)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, userAsset := range userAssets {
+		hash, err := p.Blurhash(Source{URL: userAsset.ImageURL.String})
+		if err != nil {
+			passlog.L.Warn().Err(err).Str("hash", userAsset.Hash).Str("image_url", userAsset.ImageURL.String).Msg("failed to make blurhash")
+			if !permanentBlurhashError(err) {
+				p.blurhashRetries[userAsset.ID] = time.Now().Add(blurhashRetryDelay)
+				continue
+			}
+		}
+
+		userAsset.ImageBlurhash = null.NewString(hash, err == nil)
+		userAsset.ImageBlurhashURL = userAsset.ImageURL
+		_, err = userAsset.Update(passdb.StdConn, boil.Whitelist(boiler.UserAssetColumns.ImageBlurhash, boiler.UserAssetColumns.ImageBlurhashURL))
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(userAssets), nil
+}
+
+// RunBlurhashes keeps asset blurhashes up to date and cleans the cache in the background
+func (p *Proxy) RunBlurhashes() {
+	l := passlog.L.With().Str("svc", "media").Logger()
+	ticker := time.NewTicker(time.Minute)
+	lastClean := time.Time{}
+	for range ticker.C {
+		for {
+			updated, err := p.UpdateBlurhashes()
+			if err != nil {
+				l.Error().Err(err).Msg("failed to update blurhashes")
+				break
+			}
+			if updated < blurhashBatchSize {
+				break
+			}
+		}
+
+		if time.Since(lastClean) > 24*time.Hour {
+			removed, err := p.CleanCache()
+			if err != nil {
+				l.Error().Err(err).Msg("failed to clean media cache")
+			} else if removed > 0 {
+				l.Info().Int("removed", removed).Msg("cleaned media cache")
+			}
+			lastClean = time.Now()
+		}
+	}
+}
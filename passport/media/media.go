@@ -0,0 +1,321 @@
+package media
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+
+	"github.com/chai2010/webp"
+	"golang.org/x/image/draw"
+)
+
+// Widths are the sizes variants are made at, a requested width is rounded up to the next one so the cache stays small
+var Widths = []int{64, 128, 256, 512, 1024, 2048}
+
+const (
+	maxSourceBytes  = 25 << 20
+	maxSourcePixels = 8192 * 8192
+	webpQuality     = 80
+	// CacheMaxAge is how long a cached file can go unused before the cleanup removes it
+	CacheMaxAge = 30 * 24 * time.Hour
+)
+
+var (
+	ErrHostNotAllowed = fmt.Errorf("host is not allowed")
+	ErrNotImage       = fmt.Errorf("source is not an image")
+	ErrTooLarge       = fmt.Errorf("source is too large")
+)
+
+// Proxy fetches images from allowed hosts or blobs and serves them as resized webp, keeping the originals and variants on disk
+type Proxy struct {
+	cacheDir     string
+	allowedHosts map[string]bool
+	client       *http.Client
+	// a variant is made while holding its lock, and it takes its source's lock, so they can't share locks
+	sourceLocks  [32]sync.Mutex
+	variantLocks [32]sync.Mutex
+	// blurhashRetries are the assets whose image couldn't be fetched for now, by when to try them again
+	blurhashRetries     map[string]time.Time
+	blurhashRetriesLock sync.Mutex
+}
+
+// NewProxy creates a proxy caching to the dir, images can only be fetched from the hosts given
+func NewProxy(cacheDir string, allowedHosts []string) (*Proxy, error) {
+	for _, dir := range []string{"sources", "variants"} {
+		err := os.MkdirAll(filepath.Join(cacheDir, dir), 0o755)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p := &Proxy{
+		cacheDir:        cacheDir,
+		allowedHosts:    map[string]bool{},
+		blurhashRetries: map[string]time.Time{},
+	}
+	for _, host := range allowedHosts {
+		host = strings.ToLower(strings.TrimSpace(host))
+		if host != "" {
+			p.allowedHosts[host] = true
+		}
+	}
+	p.client = &http.Client{
+		Timeout: 20 * time.Second,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return fmt.Errorf("too many redirects")
+			}
+			return p.checkURL(req.URL)
+		},
+	}
+	return p, nil
+}
+
+func (p *Proxy) checkURL(u *url.URL) error {
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return ErrHostNotAllowed
+	}
+	if !p.allowedHosts[strings.ToLower(u.Hostname())] {
+		return fmt.Errorf("%w: %s", ErrHostNotAllowed, u.Hostname())
+	}
+	return nil
+}
+
+// Source is an image the proxy can fetch, either a url on an allowed host or a blob
+type Source struct {
+	URL    string
+	BlobID string
+}
+
+func (s Source) key() string {
+	k := "url:" + s.URL
+	if s.BlobID != "" {
+		k = "blob:" + s.BlobID
+	}
+	sum := sha256.Sum256([]byte(k))
+	return hex.EncodeToString(sum[:])
+}
+
+// lock stops two requests for the same file fetching or encoding it at once
+func lock(locks *[32]sync.Mutex, path string) func() {
+	sum := sha256.Sum256([]byte(path))
+	m := &locks[int(sum[0])%len(locks)]
+	m.Lock()
+	return m.Unlock
+}
+
+// readCached reads a cached file and marks it as used for the cleanup
+func readCached(path string) ([]byte, bool) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+	return data, true
+}
+
+func writeCached(path string, data []byte) error {
+	tmp := path + ".tmp"
+	err := ioutil.WriteFile(tmp, data, 0o644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// original gets the source image, from the cache if it has been fetched before
+func (p *Proxy) original(src Source) ([]byte, error) {
+	key := src.key()
+	path := filepath.Join(p.cacheDir, "sources", key)
+	if data, ok := readCached(path); ok {
+		return data, nil
+	}
+
+	unlock := lock(&p.sourceLocks, path)
+	defer unlock()
+	if data, ok := readCached(path); ok {
+		return data, nil
+	}
+
+	var data []byte
+	var err error
+	if src.BlobID != "" {
+		data, err = p.fetchBlob(src.BlobID)
+	} else {
+		data, err = p.fetchURL(src.URL)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotImage, err.Error())
+	}
+	if cfg.Width*cfg.Height > maxSourcePixels {
+		return nil, ErrTooLarge
+	}
+
+	err = writeCached(path, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (p *Proxy) fetchURL(rawURL string) ([]byte, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	err = p.checkURL(u)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Get(u.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch %s: status %d", u.String(), resp.StatusCode)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxSourceBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxSourceBytes {
+		return nil, ErrTooLarge
+	}
+	return data, nil
+}
+
+// fetchBlob gets a public blob, private blobs are never proxied
+func (p *Proxy) fetchBlob(blobID string) ([]byte, error) {
+	blob, err := boiler.Blobs(
+		boiler.BlobWhere.ID.EQ(blobID),
+		boiler.BlobWhere.Public.EQ(true),
+	).One(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+	if len(blob.File) > maxSourceBytes {
+		return nil, ErrTooLarge
+	}
+	return blob.File, nil
+}
+
+// VariantWidth rounds a requested width up to one variants are made at, 0 is the original width
+func VariantWidth(width int) int {
+	if width <= 0 {
+		return 0
+	}
+	for _, w := range Widths {
+		if width <= w {
+			return w
+		}
+	}
+	return Widths[len(Widths)-1]
+}
+
+// Variant gets the source as webp at a width, images are never scaled up.
+// Animated gifs only keep their first frame.
+func (p *Proxy) Variant(src Source, width int) ([]byte, error) {
+	width = VariantWidth(width)
+	path := filepath.Join(p.cacheDir, "variants", fmt.Sprintf("%s_%d.webp", src.key(), width))
+	if data, ok := readCached(path); ok {
+		return data, nil
+	}
+
+	unlock := lock(&p.variantLocks, path)
+	defer unlock()
+	if data, ok := readCached(path); ok {
+		return data, nil
+	}
+
+	img, err := p.Image(src)
+	if err != nil {
+		return nil, err
+	}
+	img = resize(img, width)
+
+	data, err := webp.EncodeRGBA(img, webpQuality)
+	if err != nil {
+		return nil, err
+	}
+	err = writeCached(path, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Image gets the decoded source image
+func (p *Proxy) Image(src Source) (image.Image, error) {
+	data, err := p.original(src)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotImage, err.Error())
+	}
+	return img, nil
+}
+
+func resize(img image.Image, width int) image.Image {
+	bounds := img.Bounds()
+	if width == 0 || bounds.Dx() <= width {
+		return img
+	}
+	height := bounds.Dy() * width / bounds.Dx()
+	if height < 1 {
+		height = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+	return dst
+}
+
+// CleanCache removes cached sources and variants that haven't been used for CacheMaxAge
+func (p *Proxy) CleanCache() (int, error) {
+	removed := 0
+	cutoff := time.Now().Add(-CacheMaxAge)
+	for _, dir := range []string{"sources", "variants"} {
+		entries, err := ioutil.ReadDir(filepath.Join(p.cacheDir, dir))
+		if err != nil {
+			return removed, err
+		}
+		for _, entry := range entries {
+			if entry.ModTime().After(cutoff) {
+				continue
+			}
+			err = os.Remove(filepath.Join(p.cacheDir, dir, entry.Name()))
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return removed, err
+			}
+			removed++
+		}
+	}
+	return removed, nil
+}
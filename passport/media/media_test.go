@@ -0,0 +1,149 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/chai2010/webp"
+)
+
+func pngImage(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	img.Set(0, 0, color.White)
+	buf := &bytes.Buffer{}
+	err := png.Encode(buf, img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// hugeGIF is a 1x1 gif claiming to be 9000x9000, it is only decoded far enough to read its size
+func hugeGIF(t *testing.T) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	err := gif.Encode(buf, image.NewPaletted(image.Rect(0, 0, 1, 1), []color.Color{color.Black}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	binary.LittleEndian.PutUint16(data[6:8], 9000)
+	binary.LittleEndian.PutUint16(data[8:10], 9000)
+	return data
+}
+
+func TestProxyFetchLimits(t *testing.T) {
+	var hits int32
+	var allowed *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/image.png", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		_, _ = w.Write(pngImage(t, 600, 300))
+	})
+	mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(make([]byte, maxSourceBytes+1))
+	})
+	mux.HandleFunc("/huge.gif", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(hugeGIF(t))
+	})
+	mux.HandleFunc("/text", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("not an image"))
+	})
+	mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	mux.HandleFunc("/away", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://elsewhere.invalid/image.png", http.StatusFound)
+	})
+	mux.HandleFunc("/loop", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, allowed.URL+"/loop", http.StatusFound)
+	})
+	allowed = httptest.NewServer(mux)
+	defer allowed.Close()
+
+	u, err := url.Parse(allowed.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := NewProxy(t.TempDir(), []string{" " + u.Hostname() + " "})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("fetches and caches an allowed image", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			data, err := p.original(Source{URL: allowed.URL + "/image.png"})
+			if err != nil {
+				t.Fatalf("failed to fetch: %s", err)
+			}
+			if len(data) == 0 {
+				t.Fatalf("fetched nothing")
+			}
+		}
+		if n := atomic.LoadInt32(&hits); n != 1 {
+			t.Errorf("source fetched %d times, want once", n)
+		}
+	})
+
+	refused := []struct {
+		name string
+		url  string
+		want error
+	}{
+		{"host not allowed", "http://elsewhere.invalid/image.png", ErrHostNotAllowed},
+		{"scheme not allowed", "file:///etc/passwd", ErrHostNotAllowed},
+		{"redirect to a host not allowed", allowed.URL + "/away", ErrHostNotAllowed},
+		{"too many bytes", allowed.URL + "/large", ErrTooLarge},
+		{"too many pixels", allowed.URL + "/huge.gif", ErrTooLarge},
+		{"not an image", allowed.URL + "/text", ErrNotImage},
+		{"not found", allowed.URL + "/missing", nil},
+		{"redirect loop", allowed.URL + "/loop", nil},
+	}
+	for _, tt := range refused {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := p.original(Source{URL: tt.url})
+			if err == nil {
+				t.Fatalf("fetched %s", tt.url)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	t.Run("variants shrink but never grow", func(t *testing.T) {
+		for width, want := range map[int]int{100: 128, 0: 600, 5000: 600} {
+			data, err := p.Variant(Source{URL: allowed.URL + "/image.png"}, width)
+			if err != nil {
+				t.Fatalf("failed to make variant: %s", err)
+			}
+			cfg, err := webp.DecodeConfig(bytes.NewReader(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.Width != want {
+				t.Errorf("variant at %d is %d wide, want %d", width, cfg.Width, want)
+			}
+		}
+	})
+}
+
+func TestVariantWidth(t *testing.T) {
+	tests := map[int]int{-1: 0, 0: 0, 1: 64, 64: 64, 65: 128, 2048: 2048, 10000: 2048}
+	for width, want := range tests {
+		if got := VariantWidth(width); got != want {
+			t.Errorf("VariantWidth(%d) = %d, want %d", width, got, want)
+		}
+	}
+}
@@ -0,0 +1,433 @@
+// Package passdbtest runs tests against a throwaway postgres in docker, set up the same way as make db-reset
+package passdbtest
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+	"xsyn-services/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gofrs/uuid"
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/jackc/pgx/v4/stdlib"
+	"github.com/ninja-software/terror/v2"
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const initSQL = `
+CREATE USER passport WITH ENCRYPTED PASSWORD 'dev';
+GRANT ALL PRIVILEGES ON DATABASE passport TO passport;
+CREATE USER passport_tx WITH ENCRYPTED PASSWORD 'dev-tx';
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE EXTENSION IF NOT EXISTS pgcrypto;
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+`
+
+// migrationsBeforeSeed matches db-migrate-before-syndicate-table, the seed is written for the schema at that point
+const migrationsBeforeSeed = 55
+
+var seedFiles = []string{
+	"01_roles.sql",
+	"02_blobs.sql",
+	"03_factions.sql",
+	"04_collections.sql",
+	"05_users.sql",
+	"06_store_items.sql",
+	"07_purchased_items.sql",
+	"08_api_keys.sql",
+}
+
+// ready is set once the db is migrated and seeded
+var ready bool
+
+// Require skips the test when there is no db to run it against
+func Require(t testing.TB) {
+	t.Helper()
+	if !ready {
+		t.Skip("no docker to run postgres in")
+	}
+}
+
+// repoRoot is found from this file so tests in any package share the migrations and seed
+func repoRoot() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "..")
+}
+
+// Main starts postgres, migrates and seeds it, points passdb at it and runs the tests.
+// Use it as the package's TestMain. Without docker only the tests that don't Require the db run.
+func Main(m *testing.M) {
+	passlog.New("testing", "TraceLevel")
+	passlog.L.Info().Msg("Spinning up docker container for postgres...")
+
+	pool, err := dockertest.NewPool("")
+	if err == nil {
+		err = pool.Client.Ping()
+	}
+	if err != nil {
+		passlog.L.Warn().Err(err).Msg("docker is not available, skipping db tests")
+		os.Exit(m.Run())
+	}
+
+	user := "dev"
+	password := "dev"
+	dbName := "passport"
+
+	resource, err := pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: "postgres",
+		Tag:        "13-alpine",
+		Env: []string{
+			"POSTGRES_USER=" + user,
+			"POSTGRES_PASSWORD=" + password,
+			"POSTGRES_DB=" + dbName,
+		},
+	}, func(config *docker.HostConfig) {
+		// set AutoRemove to true so that stopped container goes away by itself
+		config.AutoRemove = true
+		config.RestartPolicy = docker.RestartPolicy{
+			Name: "no",
+		}
+	})
+	if err != nil {
+		log.Fatalf("Could not start resource: %s", err)
+	}
+
+	resource.Expire(300) // Tell docker to hard kill the container in 5 minutes
+
+	connString := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		user,
+		password,
+		"localhost",
+		resource.GetPort("5432/tcp"),
+		dbName,
+	)
+
+	// exponential backoff-retry, because the application in the container might not be ready to accept connections yet
+	if err := pool.Retry(func() error {
+		connPool, err := pgxpool.Connect(context.Background(), connString)
+		if err != nil {
+			passlog.L.Warn().Err(err).Msg("connect to db")
+			return terror.Error(err, "")
+		}
+		defer connPool.Close()
+		return connPool.Ping(context.Background())
+	}); err != nil {
+		log.Fatalf("Could not connect to docker: %s", err)
+	}
+
+	err = setup(connString)
+	if err != nil {
+		log.Fatalf("Could not set up db: %s", err)
+	}
+
+	ready = true
+	passlog.L.Info().Msg("running tests")
+	code := m.Run()
+	// You can't defer this because os.Exit doesn't care for defer
+	if err := pool.Purge(resource); err != nil {
+		log.Fatalf("Could not purge resource: %s", err)
+	}
+
+	os.Exit(code)
+}
+
+func setup(connString string) error {
+	cfg, err := pgx.ParseConfig(connString)
+	if err != nil {
+		return err
+	}
+	conn := stdlib.OpenDB(*cfg)
+	conn.SetMaxIdleConns(100)
+	conn.SetMaxOpenConns(500)
+	err = passdb.New(conn)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Exec(initSQL)
+	if err != nil {
+		return fmt.Errorf("setup roles: %w", err)
+	}
+
+	passlog.L.Info().Msg("running migrations")
+	mig, err := migrate.New("file://"+filepath.Join(repoRoot(), "migrations"), connString)
+	if err != nil {
+		return err
+	}
+	err = mig.Steps(migrationsBeforeSeed)
+	if err != nil {
+		return fmt.Errorf("migrate before seed: %w", err)
+	}
+
+	for _, file := range seedFiles {
+		fileData, err := os.ReadFile(filepath.Join(repoRoot(), "seed", file))
+		if err != nil {
+			return err
+		}
+		_, err = conn.Exec(string(fileData))
+		if err != nil {
+			return fmt.Errorf("seed %s: %w", file, err)
+		}
+	}
+
+	err = mig.Up()
+	if err != nil {
+		return fmt.Errorf("migrate: %w", err)
+	}
+
+	passlog.L.Info().Msg("db is ready")
+	return nil
+}
+
+// User inserts a user with their own account and a random public address
+func User(t testing.TB) *boiler.User {
+	t.Helper()
+	Require(t)
+
+	id := uuid.Must(uuid.NewV4()).String()
+	account := &boiler.Account{
+		ID:   id,
+		Type: boiler.AccountTypeUSER,
+	}
+	err := account.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		t.Fatalf("failed to insert account: %s", err)
+	}
+
+	user := &boiler.User{
+		ID:            id,
+		Username:      fmt.Sprintf("test-%s", id[:8]),
+		PublicAddress: null.StringFrom(common.BytesToAddress(uuid.Must(uuid.NewV4()).Bytes()).Hex()),
+		AccountID:     account.ID,
+	}
+	err = user.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		t.Fatalf("failed to insert user: %s", err)
+	}
+	return user
+}
+
+// Fund gives the user SUPS from the on chain account
+func Fund(t testing.TB, user *boiler.User, amount decimal.Decimal) {
+	t.Helper()
+
+	onChain, err := boiler.FindUser(passdb.StdConn, types.OnChainUserID.String())
+	if err != nil {
+		t.Fatalf("failed to get on chain user: %s", err)
+	}
+	tx := &boiler.Transaction{
+		ID:                   uuid.Must(uuid.NewV4()).String(),
+		Description:          "test funds",
+		TransactionReference: uuid.Must(uuid.NewV4()).String(),
+		Amount:               amount,
+		Group:                string(types.TransactionGroupTesting),
+		DebitAccountID:       onChain.AccountID,
+		CreditAccountID:      user.AccountID,
+	}
+	err = tx.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		t.Fatalf("failed to fund user: %s", err)
+	}
+}
+
+// Balance gets the user's SUPS
+func Balance(t testing.TB, user *boiler.User) decimal.Decimal {
+	t.Helper()
+
+	account, err := boiler.FindAccount(passdb.StdConn, user.AccountID)
+	if err != nil {
+		t.Fatalf("failed to get account: %s", err)
+	}
+	return account.Sups
+}
+
+// Transactor writes transactions straight to the db like the api's transactor, without the balance cache
+type Transactor struct {
+	sync.Mutex
+	// Fail makes every transaction fail, like a user without enough SUPS
+	Fail  bool
+	Calls int
+}
+
+func (tt *Transactor) Transact(nt *types.NewTransaction) (string, error) {
+	tt.Lock()
+	defer tt.Unlock()
+	tt.Calls++
+	if tt.Fail {
+		return "", fmt.Errorf("transact failed")
+	}
+	tx := &boiler.Transaction{
+		ID:                   uuid.Must(uuid.NewV4()).String(),
+		CreditAccountID:      nt.CreditAccountID,
+		DebitAccountID:       nt.DebitAccountID,
+		Amount:               nt.Amount,
+		TransactionReference: string(nt.TransactionReference),
+		Description:          nt.Description,
+		Group:                string(nt.Group),
+		SubGroup:             null.StringFrom(string(nt.SubGroup)),
+		RelatedTransactionID: nt.RelatedTransactionID,
+	}
+	err := tx.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		return "", err
+	}
+	return tx.ID, nil
+}
+
+// Collection inserts a visible collection with a random mint contract
+func Collection(t testing.TB) *boiler.Collection {
+	t.Helper()
+	Require(t)
+
+	id := uuid.Must(uuid.NewV4())
+	collection := &boiler.Collection{
+		Name:         fmt.Sprintf("test collection %s", id.String()[:8]),
+		Slug:         fmt.Sprintf("test-collection-%s", id),
+		MintContract: null.StringFrom(common.BytesToAddress(id.Bytes()).Hex()),
+		IsVisible:    null.BoolFrom(true),
+		ContractType: null.StringFrom("ERC-721"),
+	}
+	err := collection.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		t.Fatalf("failed to insert collection: %s", err)
+	}
+	return collection
+}
+
+// Asset inserts a 721 held on xsyn by the owner
+func Asset(t testing.TB, collection *boiler.Collection, owner *boiler.User) *boiler.UserAsset {
+	t.Helper()
+
+	hash := fmt.Sprintf("test-%s", uuid.Must(uuid.NewV4()).String()[:8])
+	count, err := boiler.UserAssets(boiler.UserAssetWhere.CollectionID.EQ(collection.ID)).Count(passdb.StdConn)
+	if err != nil {
+		t.Fatalf("failed to count assets: %s", err)
+	}
+	userAsset := &boiler.UserAsset{
+		CollectionID: collection.ID,
+		TokenID:      count,
+		Hash:         hash,
+		OwnerID:      owner.ID,
+		Name:         hash,
+		Data:         []byte("{}"),
+		Attributes:   []byte("[]"),
+	}
+	err = userAsset.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		t.Fatalf("failed to insert asset: %s", err)
+	}
+	err = (&boiler.UserAssetOnChainStatus{
+		AssetHash:     hash,
+		CollectionID:  collection.ID,
+		OnChainStatus: "MINTABLE",
+	}).Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		t.Fatalf("failed to insert on chain status: %s", err)
+	}
+	return userAsset
+}
+
+// Asset1155 inserts an amount of a 1155 held on xsyn by the owner
+func Asset1155(t testing.TB, collection *boiler.Collection, owner *boiler.User, tokenID int, count int) *boiler.UserAssets1155 {
+	t.Helper()
+
+	asset := &boiler.UserAssets1155{
+		OwnerID:         owner.ID,
+		CollectionID:    collection.ID,
+		ExternalTokenID: tokenID,
+		Count:           count,
+		Label:           fmt.Sprintf("test 1155 %d", tokenID),
+		Attributes:      []byte("[]"),
+	}
+	err := asset.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		t.Fatalf("failed to insert 1155 asset: %s", err)
+	}
+	return asset
+}
+
+// JoinFaction puts the user in the faction
+func JoinFaction(t testing.TB, user *boiler.User, factionID string) {
+	t.Helper()
+
+	user.FactionID = null.StringFrom(factionID)
+	_, err := user.Update(passdb.StdConn, boil.Whitelist(boiler.UserColumns.FactionID))
+	if err != nil {
+		t.Fatalf("failed to join faction: %s", err)
+	}
+}
+
+// Syndicate inserts a syndicate founded by the user, with the user it holds assets through and the founder as a member who can do everything.
+// The founder joins the first faction when they aren't in one.
+func Syndicate(t testing.TB, founder *boiler.User) *boiler.Syndicate {
+	t.Helper()
+
+	if !founder.FactionID.Valid {
+		faction, err := boiler.Factions().One(passdb.StdConn)
+		if err != nil {
+			t.Fatalf("failed to get faction: %s", err)
+		}
+		JoinFaction(t, founder, faction.ID)
+	}
+
+	id := uuid.Must(uuid.NewV4()).String()
+	account := &boiler.Account{
+		ID:   id,
+		Type: boiler.AccountTypeSYNDICATE,
+	}
+	err := account.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		t.Fatalf("failed to insert account: %s", err)
+	}
+	syndicate := &boiler.Syndicate{
+		ID:          id,
+		FactionID:   founder.FactionID.String,
+		FoundedByID: founder.ID,
+		Name:        fmt.Sprintf("test syndicate %s", id[:8]),
+		AccountID:   account.ID,
+	}
+	err = syndicate.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		t.Fatalf("failed to insert syndicate: %s", err)
+	}
+	holder := &boiler.User{
+		ID:        id,
+		Username:  fmt.Sprintf("Syndicate-%s", id),
+		RoleID:    null.StringFrom(types.UserRoleSyndicate.String()),
+		Verified:  true,
+		FactionID: founder.FactionID,
+		AccountID: account.ID,
+	}
+	err = holder.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		t.Fatalf("failed to insert syndicate holder: %s", err)
+	}
+	err = (&boiler.SyndicateMember{
+		SyndicateID:     id,
+		UserID:          founder.ID,
+		Role:            "FOUNDER",
+		CanSpendFunds:   true,
+		CanManageAssets: true,
+	}).Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		t.Fatalf("failed to insert syndicate founder: %s", err)
+	}
+	return syndicate
+}
@@ -0,0 +1,113 @@
+package payments
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/types"
+
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// HoldPurchase keeps a purchase that couldn't be priced until an admin releases it, holding it again does nothing
+func HoldPurchase(userID types.UserID, record *PurchaseRecord, reason string) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	held := &boiler.HeldPurchase{
+		TXHash: record.TxHash,
+		Symbol: record.Symbol,
+		UserID: userID.String(),
+		Record: b,
+		Reason: reason,
+	}
+	if at := record.PurchasedAt(); !at.IsZero() {
+		held.PurchasedAt = null.TimeFrom(at)
+	}
+	return held.Upsert(passdb.StdConn, false, []string{boiler.HeldPurchaseColumns.TXHash}, boil.None(), boil.Infer())
+}
+
+// HeldPurchasesList returns the held purchases, newest first
+func HeldPurchasesList(includeReleased bool) (boiler.HeldPurchaseSlice, error) {
+	queries := []qm.QueryMod{
+		qm.OrderBy(boiler.HeldPurchaseColumns.CreatedAt + " DESC"),
+	}
+	if !includeReleased {
+		queries = append(queries, boiler.HeldPurchaseWhere.ReleasedAt.IsNull())
+	}
+	return boiler.HeldPurchases(queries...).All(passdb.StdConn)
+}
+
+// ReleaseHeldPurchase credits a held purchase at the SUPS price the admin decided on.
+// The purchase's tx hash is the transaction reference, so it can't be credited twice.
+func ReleaseHeldPurchase(ucm UserCacheMap, id string, supsUSDRate decimal.Decimal, op *PendingOperator) (*boiler.HeldPurchase, error) {
+	if supsUSDRate.LessThanOrEqual(decimal.Zero) {
+		return nil, fmt.Errorf("sups usd rate must be more than 0")
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	held, err := boiler.HeldPurchases(
+		boiler.HeldPurchaseWhere.ID.EQ(id),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return nil, err
+	}
+	if held.ReleasedAt.Valid {
+		return nil, fmt.Errorf("purchase was released at %s", held.ReleasedAt.Time.Format(time.RFC3339))
+	}
+
+	record := &PurchaseRecord{}
+	err = held.Record.Unmarshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	txID, err := storeRecord(types.XsynSaleUserID, types.UserIDFromString(held.UserID), ucm, record, true, supsUSDRate, null.String{})
+	if err != nil {
+		// credited before the release was saved
+		existing, lookupErr := db.TransactionGetByReference(held.TXHash)
+		if lookupErr != nil {
+			if !errors.Is(lookupErr, sql.ErrNoRows) {
+				return nil, lookupErr
+			}
+			return nil, err
+		}
+		txID = existing.ID
+	}
+
+	held.ReleasedAt = null.TimeFrom(time.Now())
+	held.ReleasedBy = null.StringFrom(op.Name)
+	held.SupsUsdRate = decimal.NewNullDecimal(supsUSDRate)
+	held.TransactionID = null.StringFrom(txID)
+	_, err = held.Update(tx, boil.Whitelist(
+		boiler.HeldPurchaseColumns.ReleasedAt,
+		boiler.HeldPurchaseColumns.ReleasedBy,
+		boiler.HeldPurchaseColumns.SupsUsdRate,
+		boiler.HeldPurchaseColumns.TransactionID,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return held, nil
+}
@@ -24,6 +24,7 @@ const SUPSWithdrawTxsETH Path = "sups_eth_withdraw_txs"
 const SUPSDepositTxsBSC Path = "sups_deposit_txs"
 const SUPSDepositTxsETH Path = "sups_eth_deposit_txs"
 const NFTOwnerPath Path = "nft_tokens"
+const NFTTransferTxs Path = "nft_txs"
 const BNBPurchasePath Path = "bnb_txs"
 const BUSDPurchasePath Path = "busd_txs"
 const ETHPurchasePath Path = "eth_txs"
@@ -94,7 +95,6 @@ func getNFTOwnerRecords(path Path, collection *boiler.Collection, testnet bool)
 		return nil, err
 	}
 	if resp.StatusCode != http.StatusOK {
-		db.PutBool(db.KeyEnableSyncNFTOwners, false)
 		return nil, fmt.Errorf("non 200 response for %s: %d", req.URL.String(), resp.StatusCode)
 	}
 
@@ -112,33 +112,38 @@ func getNFTOwnerRecords(path Path, collection *boiler.Collection, testnet bool)
 func OwnerRecordToOwnerStatus(records []*NFTOwnerRecord, collection *boiler.Collection) map[int]*NFTOwnerStatus {
 	result := map[int]*NFTOwnerStatus{}
 	for _, record := range records {
-		// Current owner owns it; or
-		owner := common.HexToAddress(record.ToAddress)
-		if owner.Hex() == common.HexToAddress(collection.StakeContract.String).Hex() || owner.Hex() == common.HexToAddress(collection.StakingContractOld.String).Hex() {
-			// Address who sent it to the staking contract owns it
-			owner = common.HexToAddress(record.FromAddress)
-		}
+		result[record.TokenID] = ownerRecordToOwnerStatus(record, collection)
+	}
+	return result
+}
 
-		onChainStatus := db.STAKABLE
-		// Current owner IS staking contract
-		if common.HexToAddress(record.ToAddress).Hex() == common.HexToAddress(collection.StakeContract.String).Hex() {
-			onChainStatus = db.UNSTAKABLE
-		}
-		// Current owner IS staking contract
-		if common.HexToAddress(record.ToAddress).Hex() == common.HexToAddress(collection.StakingContractOld.String).Hex() {
-			onChainStatus = db.UNSTAKABLEOLD
-		}
+// ownerRecordToOwnerStatus works out who owns a token after a transfer, a token sent to a stake contract is still owned by whoever sent it
+func ownerRecordToOwnerStatus(record *NFTOwnerRecord, collection *boiler.Collection) *NFTOwnerStatus {
+	// Current owner owns it; or
+	owner := common.HexToAddress(record.ToAddress)
+	if owner.Hex() == common.HexToAddress(collection.StakeContract.String).Hex() || owner.Hex() == common.HexToAddress(collection.StakingContractOld.String).Hex() {
+		// Address who sent it to the staking contract owns it
+		owner = common.HexToAddress(record.FromAddress)
+	}
 
-		result[record.TokenID] = &NFTOwnerStatus{
-			Collection:     common.HexToAddress(collection.MintContract.String),
-			Owner:          owner,
-			OnChainStatus:  onChainStatus,
-			TxHash:         record.TxHash,
-			BlockNumber:    record.BlockNumber,
-			BlockTimestamp: time.Unix(int64(record.Time), 0),
-		}
+	onChainStatus := db.STAKABLE
+	// Current owner IS staking contract
+	if common.HexToAddress(record.ToAddress).Hex() == common.HexToAddress(collection.StakeContract.String).Hex() {
+		onChainStatus = db.UNSTAKABLE
+	}
+	// Current owner IS staking contract
+	if common.HexToAddress(record.ToAddress).Hex() == common.HexToAddress(collection.StakingContractOld.String).Hex() {
+		onChainStatus = db.UNSTAKABLEOLD
+	}
+
+	return &NFTOwnerStatus{
+		Collection:     common.HexToAddress(collection.MintContract.String),
+		Owner:          owner,
+		OnChainStatus:  onChainStatus,
+		TxHash:         record.TxHash,
+		BlockNumber:    record.BlockNumber,
+		BlockTimestamp: time.Unix(int64(record.Time), 0),
 	}
-	return result
 }
 
 func getSUPTransferRecords(path Path, latestBlock int, testnet bool) ([]*SUPTransferRecord, error) {
@@ -207,6 +212,38 @@ func getNFT1155TransferRecords(path Path, latestBlock int, testnet bool, contrac
 	return result, nil
 }
 
+// getNFTTransferRecords gets every transfer of a collection's 721s since a block, stakes and unstakes are transfers to and from a stake contract
+func getNFTTransferRecords(path Path, latestBlock int, testnet bool, collection *boiler.Collection) ([]*NFTOwnerRecord, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/%s", baseURL, path), nil)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	q.Add("since_block", strconv.Itoa(latestBlock))
+	if testnet {
+		q.Add("is_testnet", "true")
+	}
+	q.Add("contract_address", collection.MintContract.String)
+	q.Add("confirmations", "3")
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("non 200 response for %s: %d", req.URL.String(), resp.StatusCode)
+	}
+
+	result := []*NFTOwnerRecord{}
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func GetWithdraws(bscWithdrawalsEnabled, ethWithdrawalsEnabled, testnet bool) ([]*SUPTransferRecord, error) {
 	records := []*SUPTransferRecord{}
 
@@ -272,24 +309,40 @@ func GetNFTOwnerRecords(testnet bool, collection *boiler.Collection) (map[int]*N
 	return getNFTOwnerRecords(NFTOwnerPath, collection, testnet)
 }
 
-func Get1155Deposits(testnet bool, contractAddress string) ([]*NFT1155TransferRecord, error) {
-	latestDepositBlock := db.GetIntWithDefault(db.KeyLatest1155DepositBlock, 0)
-	records, err := getNFT1155TransferRecords(MultiTokenTxs, latestDepositBlock, testnet, contractAddress)
+func GetNFTTransfers(testnet bool, collection *boiler.Collection, sinceBlock int) ([]*NFTOwnerRecord, error) {
+	return getNFTTransferRecords(NFTTransferTxs, sinceBlock, testnet, collection)
+}
+
+func Get1155Deposits(testnet bool, collection *boiler.Collection) ([]*NFT1155TransferRecord, error) {
+	latestDepositBlock, err := db.CollectionSyncCursor(collection.ID, db.CollectionSyncCursor1155Deposit)
+	if err != nil {
+		return nil, fmt.Errorf("get 1155 deposit cursor: %w", err)
+	}
+	records, err := getNFT1155TransferRecords(MultiTokenTxs, latestDepositBlock, testnet, collection.MintContract.String)
 	if err != nil {
 		return nil, err
 	}
-	db.PutInt(db.KeyLatest1155DepositBlock, latestNFT1155TransferBlockFromRecords(latestDepositBlock, records))
+	err = db.PutCollectionSyncCursor(collection.ID, db.CollectionSyncCursor1155Deposit, latestNFT1155TransferBlockFromRecords(latestDepositBlock, records))
+	if err != nil {
+		return nil, fmt.Errorf("put 1155 deposit cursor: %w", err)
+	}
 	return records, nil
 }
 
-func Get1155Withdraws(testnet bool, contractAddress string) ([]*NFT1155TransferRecord, error) {
-	latest1155Block := db.GetInt(db.KeyLatest1155WithdrawBlock)
-	records, err := getNFT1155TransferRecords(MultiTokenTxs, latest1155Block, testnet, contractAddress)
+func Get1155Withdraws(testnet bool, collection *boiler.Collection) ([]*NFT1155TransferRecord, error) {
+	latest1155Block, err := db.CollectionSyncCursor(collection.ID, db.CollectionSyncCursor1155Withdraw)
+	if err != nil {
+		return nil, fmt.Errorf("get 1155 withdraw cursor: %w", err)
+	}
+	records, err := getNFT1155TransferRecords(MultiTokenTxs, latest1155Block, testnet, collection.MintContract.String)
 	if err != nil {
 		return nil, fmt.Errorf("get 1155 txes: %w", err)
 	}
 	newLatestWithdrawBlock := latestNFT1155TransferBlockFromRecords(latest1155Block, records)
-	db.PutInt(db.KeyLatest1155WithdrawBlock, newLatestWithdrawBlock)
+	err = db.PutCollectionSyncCursor(collection.ID, db.CollectionSyncCursor1155Withdraw, newLatestWithdrawBlock)
+	if err != nil {
+		return nil, fmt.Errorf("put 1155 withdraw cursor: %w", err)
+	}
 	return records, nil
 }
 
@@ -0,0 +1,209 @@
+package payments
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+	"xsyn-services/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NFTIndexerMaxLag is how long a collection's index can go without a successful run before its ownership is treated as stale
+const NFTIndexerMaxLag = 5 * time.Minute
+
+// IndexNFTTransfers reads a collection's 721 transfers since its cursor into nft_owners and records each one as an ownership change.
+// Transfers already seen are skipped, so a block read twice is harmless.
+func IndexNFTTransfers(collection *boiler.Collection, testnet bool) (int, error) {
+	cursor, err := db.CollectionSyncCursor(collection.ID, db.CollectionSyncCursor721Transfer)
+	if err != nil {
+		return 0, fmt.Errorf("get cursor: %w", err)
+	}
+
+	records, err := GetNFTTransfers(testnet, collection, cursor)
+	if err != nil {
+		return 0, fmt.Errorf("get nft transfers: %w", err)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].BlockNumber != records[j].BlockNumber {
+			return records[i].BlockNumber < records[j].BlockNumber
+		}
+		return records[i].LogIndex < records[j].LogIndex
+	})
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	indexed := 0
+	latestBlock := cursor
+	for _, record := range records {
+		if record.BlockNumber > latestBlock {
+			latestBlock = record.BlockNumber
+		}
+
+		ownerStatus := ownerRecordToOwnerStatus(record, collection)
+		change := &boiler.NFTOwnershipChange{
+			CollectionID:   collection.ID,
+			TokenID:        int64(record.TokenID),
+			FromAddress:    common.HexToAddress(record.FromAddress).Hex(),
+			ToAddress:      common.HexToAddress(record.ToAddress).Hex(),
+			OwnerAddress:   ownerStatus.Owner.Hex(),
+			OnChainStatus:  string(ownerStatus.OnChainStatus),
+			TXHash:         record.TxHash,
+			LogIndex:       record.LogIndex,
+			BlockNumber:    int64(record.BlockNumber),
+			BlockTimestamp: ownerStatus.BlockTimestamp,
+		}
+		err = change.Upsert(
+			tx,
+			false,
+			[]string{boiler.NFTOwnershipChangeColumns.CollectionID, boiler.NFTOwnershipChangeColumns.TXHash, boiler.NFTOwnershipChangeColumns.LogIndex},
+			boil.None(),
+			boil.Infer(),
+		)
+		if err != nil {
+			return 0, fmt.Errorf("insert ownership change: %w", err)
+		}
+		if change.ID == 0 {
+			// already indexed
+			continue
+		}
+
+		err = indexNFTOwner(tx, change)
+		if err != nil {
+			return 0, fmt.Errorf("index owner of token %d: %w", record.TokenID, err)
+		}
+		indexed++
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	err = db.PutCollectionSyncCursor(collection.ID, db.CollectionSyncCursor721Transfer, latestBlock)
+	if err != nil {
+		return indexed, fmt.Errorf("put cursor: %w", err)
+	}
+	return indexed, nil
+}
+
+// indexNFTOwner moves a token in nft_owners to the owner after a change, unless the index already has a later transfer of the token
+func indexNFTOwner(tx boil.Executor, change *boiler.NFTOwnershipChange) error {
+	owner, err := boiler.NFTOwners(
+		boiler.NFTOwnerWhere.CollectionID.EQ(change.CollectionID),
+		boiler.NFTOwnerWhere.TokenID.EQ(change.TokenID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	if owner == nil {
+		owner = &boiler.NFTOwner{
+			CollectionID: change.CollectionID,
+			TokenID:      change.TokenID,
+		}
+	} else if owner.BlockNumber > change.BlockNumber || (owner.BlockNumber == change.BlockNumber && owner.LogIndex > change.LogIndex) {
+		return nil
+	}
+
+	owner.OwnerAddress = change.OwnerAddress
+	owner.HolderAddress = change.ToAddress
+	owner.OnChainStatus = change.OnChainStatus
+	owner.TXHash = change.TXHash
+	owner.BlockNumber = change.BlockNumber
+	owner.LogIndex = change.LogIndex
+	owner.UpdatedAt = time.Now()
+	return owner.Upsert(
+		tx,
+		true,
+		[]string{boiler.NFTOwnerColumns.CollectionID, boiler.NFTOwnerColumns.TokenID},
+		boil.Infer(),
+		boil.Infer(),
+	)
+}
+
+// ApplyNFTOwnershipChanges moves a collection's assets to their new owners and statuses from the changes the indexer has recorded.
+// Only the latest unapplied change of a token is applied, the ones before it are marked applied with it.
+// Changes of tokens passport doesn't have an asset for yet are skipped and left unapplied, they are applied by the first run after the asset is registered.
+// A change that fails is left with its error to be retried next run, it doesn't hold back other tokens.
+func ApplyNFTOwnershipChanges(collection *boiler.Collection, environment types.Environment) (int, int, error) {
+	l := passlog.L.With().Str("svc", "nft_ownership_indexer").Str("collection_slug", collection.Slug).Logger()
+
+	changes, err := boiler.NFTOwnershipChanges(
+		boiler.NFTOwnershipChangeWhere.CollectionID.EQ(collection.ID),
+		boiler.NFTOwnershipChangeWhere.AppliedAt.IsNull(),
+		qm.OrderBy(fmt.Sprintf("%s, %s, %s",
+			boiler.NFTOwnershipChangeColumns.BlockNumber,
+			boiler.NFTOwnershipChangeColumns.LogIndex,
+			boiler.NFTOwnershipChangeColumns.ID,
+		)),
+	).All(passdb.StdConn)
+	if err != nil {
+		return 0, 0, fmt.Errorf("get ownership changes: %w", err)
+	}
+
+	latest := map[int64]*boiler.NFTOwnershipChange{}
+	tokenChangeIDs := map[int64][]int64{}
+	for _, change := range changes {
+		latest[change.TokenID] = change
+		tokenChangeIDs[change.TokenID] = append(tokenChangeIDs[change.TokenID], change.ID)
+	}
+
+	applied := 0
+	skipped := 0
+	for _, change := range changes {
+		if latest[change.TokenID] != change {
+			continue
+		}
+
+		result, err := updateOwner(int(change.TokenID), &NFTOwnerStatus{
+			Collection:     common.HexToAddress(collection.MintContract.String),
+			Owner:          common.HexToAddress(change.OwnerAddress),
+			OnChainStatus:  db.OnChainStatus(change.OnChainStatus),
+			TxHash:         change.TXHash,
+			BlockNumber:    int(change.BlockNumber),
+			BlockTimestamp: change.BlockTimestamp,
+		}, collection, environment)
+		if err != nil {
+			l.Warn().Err(err).Int64("token_id", change.TokenID).Str("tx_hash", change.TXHash).Msg("failed to apply ownership change")
+			change.ApplyError = null.StringFrom(err.Error())
+			_, err = change.Update(passdb.StdConn, boil.Whitelist(boiler.NFTOwnershipChangeColumns.ApplyError))
+			if err != nil {
+				return applied, skipped, err
+			}
+			continue
+		}
+		if result == ownerSkipped {
+			// the asset isn't in passport yet, keep the changes to apply once it is
+			skipped++
+			continue
+		}
+		applied++
+
+		_, err = boiler.NFTOwnershipChanges(
+			boiler.NFTOwnershipChangeWhere.ID.IN(tokenChangeIDs[change.TokenID]),
+		).UpdateAll(passdb.StdConn, boiler.M{
+			boiler.NFTOwnershipChangeColumns.AppliedAt:  null.TimeFrom(time.Now()),
+			boiler.NFTOwnershipChangeColumns.ApplyError: null.String{},
+		})
+		if err != nil {
+			return applied, skipped, err
+		}
+	}
+
+	return applied, skipped, nil
+}
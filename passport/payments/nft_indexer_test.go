@@ -0,0 +1,143 @@
+package payments
+
+import (
+	"fmt"
+	"testing"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+	"xsyn-services/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gofrs/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestNFTOwnershipIndex(t *testing.T) {
+	passdbtest.Require(t)
+
+	address := func() string {
+		return common.BytesToAddress(uuid.Must(uuid.NewV4()).Bytes()).Hex()
+	}
+
+	// change records a transfer of the token to the owner the way the indexer would
+	change := func(t *testing.T, collection *boiler.Collection, tokenID int64, owner string, blockNumber int64, logIndex int) *boiler.NFTOwnershipChange {
+		t.Helper()
+		c := &boiler.NFTOwnershipChange{
+			CollectionID:   collection.ID,
+			TokenID:        tokenID,
+			FromAddress:    address(),
+			ToAddress:      owner,
+			OwnerAddress:   owner,
+			OnChainStatus:  string(db.STAKABLE),
+			TXHash:         fmt.Sprintf("0x%s", uuid.Must(uuid.NewV4())),
+			LogIndex:       logIndex,
+			BlockNumber:    blockNumber,
+			BlockTimestamp: time.Now().Add(time.Duration(blockNumber) * time.Second),
+		}
+		err := c.Insert(passdb.StdConn, boil.Infer())
+		if err != nil {
+			t.Fatalf("failed to insert ownership change: %s", err)
+		}
+		return c
+	}
+
+	t.Run("an older transfer doesn't replace a later one", func(t *testing.T) {
+		collection := passdbtest.Collection(t)
+		earlier := address()
+		later := address()
+		changes := []*boiler.NFTOwnershipChange{
+			change(t, collection, 9000, later, 20, 1),
+			change(t, collection, 9000, earlier, 20, 0),
+			change(t, collection, 9000, earlier, 10, 5),
+		}
+		for _, c := range changes {
+			err := indexNFTOwner(passdb.StdConn, c)
+			if err != nil {
+				t.Fatalf("failed to index owner: %s", err)
+			}
+		}
+
+		owner, err := boiler.FindNFTOwner(passdb.StdConn, collection.ID, 9000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if owner.OwnerAddress != later || owner.TXHash != changes[0].TXHash {
+			t.Errorf("token owned by %s from %s, want %s from %s", owner.OwnerAddress, owner.TXHash, later, changes[0].TXHash)
+		}
+	})
+
+	t.Run("only the latest change of a token is applied", func(t *testing.T) {
+		collection := passdbtest.Collection(t)
+		owner := passdbtest.User(t)
+		buyer := passdbtest.User(t)
+		lastBuyer := passdbtest.User(t)
+		userAsset := passdbtest.Asset(t, collection, owner)
+		changes := []*boiler.NFTOwnershipChange{
+			change(t, collection, userAsset.TokenID, lastBuyer.PublicAddress.String, 31, 0),
+			change(t, collection, userAsset.TokenID, buyer.PublicAddress.String, 30, 0),
+			change(t, collection, 9001, address(), 30, 1),
+		}
+
+		applied, skipped, err := ApplyNFTOwnershipChanges(collection, types.Development)
+		if err != nil {
+			t.Fatalf("failed to apply changes: %s", err)
+		}
+		if applied != 1 || skipped != 1 {
+			t.Errorf("applied %d and skipped %d, want 1 and 1", applied, skipped)
+		}
+
+		err = userAsset.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if userAsset.OwnerID != lastBuyer.ID {
+			t.Errorf("asset owned by %s, want the last buyer %s", userAsset.OwnerID, lastBuyer.ID)
+		}
+		for _, c := range changes[:2] {
+			err = c.Reload(passdb.StdConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !c.AppliedAt.Valid || c.ApplyError.Valid {
+				t.Errorf("change %s of token %d not applied: %s", c.TXHash, c.TokenID, c.ApplyError.String)
+			}
+		}
+		err = changes[2].Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if changes[2].AppliedAt.Valid {
+			t.Errorf("change of a token passport doesn't have was marked applied")
+		}
+
+		// the skipped change is applied once the asset is registered
+		registered := passdbtest.Asset(t, collection, owner)
+		registered.TokenID = changes[2].TokenID
+		_, err = registered.Update(passdb.StdConn, boil.Whitelist(boiler.UserAssetColumns.TokenID))
+		if err != nil {
+			t.Fatal(err)
+		}
+		onChainOwner, err := CreateOrGetUser(common.HexToAddress(changes[2].OwnerAddress), types.Development)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		applied, skipped, err = ApplyNFTOwnershipChanges(collection, types.Development)
+		if err != nil {
+			t.Fatalf("failed to apply changes: %s", err)
+		}
+		if applied != 1 || skipped != 0 {
+			t.Errorf("applied %d and skipped %d after registering the asset, want 1 and 0", applied, skipped)
+		}
+		err = registered.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if registered.OwnerID != onChainOwner.ID {
+			t.Errorf("registered asset owned by %s, want %s", registered.OwnerID, onChainOwner.ID)
+		}
+	})
+}
@@ -40,170 +40,83 @@ func UpdateOwners(nftStatuses map[int]*NFTOwnerStatus, collection *boiler.Collec
 	l.Debug().Int("records", len(nftStatuses)).Msg("processing new owners for NFT")
 
 	for tokenID, nftStatus := range nftStatuses {
-		l.Debug().
-			Int("token_id", tokenID).
-			Str("collection", nftStatus.Collection.Hex()).
-			Str("owner", nftStatus.Owner.Hex()).
-			Str("on_chain_status", string(nftStatus.OnChainStatus)).
-			Msg("processing new owner for NFT")
-
-		// if tx exists, continue
-		txExists, err := boiler.ItemOnchainTransactions(
-			boiler.ItemOnchainTransactionWhere.TXID.EQ(nftStatus.TxHash),
-			boiler.ItemOnchainTransactionWhere.ExternalTokenID.EQ(tokenID),
-		).Exists(passdb.StdConn)
+		result, err := updateOwner(tokenID, nftStatus, collection, environment)
 		if err != nil {
-			return 0, 0, fmt.Errorf("get purchased item: %w", err)
-		}
-		if txExists {
-			continue
-		}
-
-		userAsset, err := boiler.UserAssets(
-			boiler.UserAssetWhere.CollectionID.EQ(collection.ID),
-			boiler.UserAssetWhere.TokenID.EQ(int64(tokenID)),
-		).One(passdb.StdConn)
-		if err != nil && errors.Is(err, sql.ErrNoRows) {
-			l.Debug().Err(err).Str("collection_addr", collection.MintContract.String).Int("external_token_id", tokenID).Msg("item not found")
-			skipped++
-			continue
-		} else if err != nil {
-			return 0, 0, fmt.Errorf("get purchased item: %w", err)
+			return 0, 0, err
 		}
-
-		onChainStatusObject, err := boiler.UserAssetOnChainStatuses(
-			boiler.UserAssetOnChainStatusWhere.CollectionID.EQ(userAsset.CollectionID),
-			boiler.UserAssetOnChainStatusWhere.AssetHash.EQ(userAsset.Hash),
-		).One(passdb.StdConn)
-		if err != nil {
-			l.Debug().Err(err).Interface("userAsset", userAsset).Msg("assets on chain status not found")
+		switch result {
+		case ownerUpdated:
+			updated++
+		case ownerSkipped:
 			skipped++
-			continue
 		}
+	}
 
-		// if a newer tx exists, insert the tx and continue
-		// this is for when nodes don't align and avoids assets being able to bounce back due to delayed/stale nodes
-		newerExists, err := boiler.ItemOnchainTransactions(
-			boiler.ItemOnchainTransactionWhere.CollectionID.EQ(collection.ID),
-			boiler.ItemOnchainTransactionWhere.ExternalTokenID.EQ(tokenID),
-			boiler.ItemOnchainTransactionWhere.BlockTimestamp.GT(nftStatus.BlockTimestamp), // if timestamp greater than new tx timestamp
-		).Exists(passdb.StdConn)
-		if err != nil {
-			return 0, 0, fmt.Errorf("get purchased item: %w", err)
-		}
-		if newerExists {
-			// insert older and continue
-			newItemOnChainTransaction := &boiler.ItemOnchainTransaction{
-				CollectionID:    collection.ID,
-				ExternalTokenID: tokenID,
-				TXID:            nftStatus.TxHash,
-				ContractAddr:    collection.MintContract.String,
-				FromAddr:        userAsset.OwnerID,
-				ToAddr:          nftStatus.Owner.Hex(),
-				BlockNumber:     nftStatus.BlockNumber,
-				BlockTimestamp:  nftStatus.BlockTimestamp,
-			}
-			err = newItemOnChainTransaction.Insert(passdb.StdConn, boil.Infer())
-			if err != nil {
-				passlog.L.Error().Err(err).Interface("newItemOnChainTransaction", newItemOnChainTransaction).Msg("failed to insert new on chain tx history")
-			}
+	return updated, skipped, nil
+}
 
-			continue
-		}
+type ownerUpdateResult int
 
-		// on chain user may not exist in our db
-		onChainOwner, err := CreateOrGetUser(nftStatus.Owner, environment)
-		if err != nil {
-			return 0, 0, fmt.Errorf("get or create onchain user: %w", err)
-		}
+const (
+	ownerUnchanged ownerUpdateResult = iota
+	ownerUpdated
+	ownerSkipped
+)
 
-		// of chain user has to exist, it is the current owner
-		offChainOwner, err := boiler.FindUser(passdb.StdConn, userAsset.OwnerID)
-		if err != nil {
-			return 0, 0, fmt.Errorf("get offchain user: %w", err)
-		}
+// updateOwner moves a token's asset to its on chain owner and status, it is skipped when passport doesn't know the asset
+func updateOwner(tokenID int, nftStatus *NFTOwnerStatus, collection *boiler.Collection, environment types.Environment) (ownerUpdateResult, error) {
+	l := passlog.L.With().Str("svc", "avant_nft_ownership_update").Logger()
 
-		offChainAddr := common.HexToAddress(offChainOwner.PublicAddress.String)
-		onChainAddr := common.HexToAddress(onChainOwner.PublicAddress.String)
+	l.Debug().
+		Int("token_id", tokenID).
+		Str("collection", nftStatus.Collection.Hex()).
+		Str("owner", nftStatus.Owner.Hex()).
+		Str("on_chain_status", string(nftStatus.OnChainStatus)).
+		Msg("processing new owner for NFT")
+
+	// if tx exists, continue
+	txExists, err := boiler.ItemOnchainTransactions(
+		boiler.ItemOnchainTransactionWhere.TXID.EQ(nftStatus.TxHash),
+		boiler.ItemOnchainTransactionWhere.ExternalTokenID.EQ(tokenID),
+	).Exists(passdb.StdConn)
+	if err != nil {
+		return ownerUnchanged, fmt.Errorf("get purchased item: %w", err)
+	}
+	if txExists {
+		return ownerUnchanged, nil
+	}
 
-		l.Debug().
-			Str("off_chain_user", offChainAddr.Hex()).
-			Str("on_chain_user", onChainAddr.Hex()).
-			Bool("matches", offChainAddr.Hex() == onChainAddr.Hex()).
-			Msg("check if nft owners match")
-
-		updatedBool := false
-
-		// if the owner is different, transfer asset to new owner
-		if offChainAddr.Hex() != onChainAddr.Hex() {
-			l.Debug().
-				Str("new_owner", onChainOwner.ID).
-				Str("old_owner", offChainOwner.ID).
-				Str("item_id", userAsset.ID).
-				Msg("setting new nft owner")
-
-			userAsset, _, err = asset.TransferAsset(
-				userAsset.Hash,
-				offChainOwner.ID,
-				onChainOwner.ID,
-				"",
-				true,
-				null.String{},
-				func(te *boiler.AssetTransferEvent) {
-					otherAssets, _ := supremacy_rpcclient.SupremacyAssetTransferEvent(&types.TransferEvent{
-						TransferEventID: te.ID,
-						AssetHash:       te.UserAssetHash,
-						FromUserID:      te.FromUserID,
-						ToUserID:        te.ToUserID,
-						TransferredAt:   te.TransferredAt,
-						TransferTXID:    te.TransferTXID,
-					})
-					for _, othAsstHash := range otherAssets {
-						_, _, err = asset.TransferAsset(
-							othAsstHash,
-							offChainOwner.ID,
-							onChainOwner.ID,
-							types.SupremacyGameUserID.String(),
-							false, // we don't want to change the service id
-							null.String{},
-							nil,
-						)
-						if err != nil {
-							passlog.L.Error().Err(err).
-								Str("othAsstHash", othAsstHash).
-								Str("offChainOwner.ID", offChainOwner.ID).
-								Str("onChainOwner.ID", onChainOwner.ID).Msg("failed to transfer attached assets")
-						}
-					}
-				},
-			)
-			if err != nil {
-				passlog.L.Error().Err(err).
-					Str("userAsset.Hash", userAsset.Hash).
-					Str("offChainOwner.ID", offChainOwner.ID).
-					Str("onChainOwner.ID", onChainOwner.ID).
-					Msg("failed to transfer asset - UpdateOwners")
-				return 0, 0, fmt.Errorf("set new nft owner: %w", err)
-			}
-			updatedBool = true
-		}
+	userAsset, err := boiler.UserAssets(
+		boiler.UserAssetWhere.CollectionID.EQ(collection.ID),
+		boiler.UserAssetWhere.TokenID.EQ(int64(tokenID)),
+	).One(passdb.StdConn)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		l.Debug().Err(err).Str("collection_addr", collection.MintContract.String).Int("external_token_id", tokenID).Msg("item not found")
+		return ownerSkipped, nil
+	} else if err != nil {
+		return ownerUnchanged, fmt.Errorf("get purchased item: %w", err)
+	}
 
-		if string(nftStatus.OnChainStatus) != onChainStatusObject.OnChainStatus {
-			onChainStatusObject.OnChainStatus = string(nftStatus.OnChainStatus)
-			_, err = onChainStatusObject.Update(passdb.StdConn, boil.Infer())
-			if err != nil {
-				return 0, 0, err
-			}
-			_, err = userAsset.Update(passdb.StdConn, boil.Infer())
-			if err != nil {
-				return 0, 0, err
-			}
-			updatedBool = true
-		}
-		if updatedBool {
-			updated++
-		}
+	onChainStatusObject, err := boiler.UserAssetOnChainStatuses(
+		boiler.UserAssetOnChainStatusWhere.CollectionID.EQ(userAsset.CollectionID),
+		boiler.UserAssetOnChainStatusWhere.AssetHash.EQ(userAsset.Hash),
+	).One(passdb.StdConn)
+	if err != nil {
+		l.Debug().Err(err).Interface("userAsset", userAsset).Msg("assets on chain status not found")
+		return ownerSkipped, nil
+	}
 
+	// if a newer tx exists, insert the tx and continue
+	// this is for when nodes don't align and avoids assets being able to bounce back due to delayed/stale nodes
+	newerExists, err := boiler.ItemOnchainTransactions(
+		boiler.ItemOnchainTransactionWhere.CollectionID.EQ(collection.ID),
+		boiler.ItemOnchainTransactionWhere.ExternalTokenID.EQ(tokenID),
+		boiler.ItemOnchainTransactionWhere.BlockTimestamp.GT(nftStatus.BlockTimestamp), // if timestamp greater than new tx timestamp
+	).Exists(passdb.StdConn)
+	if err != nil {
+		return ownerUnchanged, fmt.Errorf("get purchased item: %w", err)
+	}
+	if newerExists {
 		// insert older and continue
 		newItemOnChainTransaction := &boiler.ItemOnchainTransaction{
 			CollectionID:    collection.ID,
@@ -219,12 +132,145 @@ func UpdateOwners(nftStatuses map[int]*NFTOwnerStatus, collection *boiler.Collec
 		if err != nil {
 			passlog.L.Error().Err(err).Interface("newItemOnChainTransaction", newItemOnChainTransaction).Msg("failed to insert new on chain tx history")
 		}
+
+		return ownerUnchanged, nil
 	}
 
-	return updated, skipped, nil
+	// on chain user may not exist in our db
+	onChainOwner, err := CreateOrGetUser(nftStatus.Owner, environment)
+	if err != nil {
+		return ownerUnchanged, fmt.Errorf("get or create onchain user: %w", err)
+	}
+
+	// of chain user has to exist, it is the current owner
+	offChainOwner, err := boiler.FindUser(passdb.StdConn, userAsset.OwnerID)
+	if err != nil {
+		return ownerUnchanged, fmt.Errorf("get offchain user: %w", err)
+	}
+
+	offChainAddr := common.HexToAddress(offChainOwner.PublicAddress.String)
+	onChainAddr := common.HexToAddress(onChainOwner.PublicAddress.String)
+
+	l.Debug().
+		Str("off_chain_user", offChainAddr.Hex()).
+		Str("on_chain_user", onChainAddr.Hex()).
+		Bool("matches", offChainAddr.Hex() == onChainAddr.Hex()).
+		Msg("check if nft owners match")
+
+	updatedBool := false
+
+	// if the owner is different, transfer asset to new owner
+	if offChainAddr.Hex() != onChainAddr.Hex() {
+		l.Debug().
+			Str("new_owner", onChainOwner.ID).
+			Str("old_owner", offChainOwner.ID).
+			Str("item_id", userAsset.ID).
+			Msg("setting new nft owner")
+
+		userAsset, _, err = asset.TransferAsset(
+			userAsset.Hash,
+			offChainOwner.ID,
+			onChainOwner.ID,
+			"",
+			true,
+			null.String{},
+			func(te *boiler.AssetTransferEvent) {
+				otherAssets, _ := supremacy_rpcclient.SupremacyAssetTransferEvent(&types.TransferEvent{
+					TransferEventID: te.ID,
+					AssetHash:       te.UserAssetHash,
+					FromUserID:      te.FromUserID,
+					ToUserID:        te.ToUserID,
+					TransferredAt:   te.TransferredAt,
+					TransferTXID:    te.TransferTXID,
+				})
+				for _, othAsstHash := range otherAssets {
+					_, _, err = asset.TransferAsset(
+						othAsstHash,
+						offChainOwner.ID,
+						onChainOwner.ID,
+						types.SupremacyGameUserID.String(),
+						false, // we don't want to change the service id
+						null.String{},
+						nil,
+					)
+					if err != nil {
+						passlog.L.Error().Err(err).
+							Str("othAsstHash", othAsstHash).
+							Str("offChainOwner.ID", offChainOwner.ID).
+							Str("onChainOwner.ID", onChainOwner.ID).Msg("failed to transfer attached assets")
+					}
+				}
+			},
+		)
+		if err != nil {
+			passlog.L.Error().Err(err).
+				Str("userAsset.Hash", userAsset.Hash).
+				Str("offChainOwner.ID", offChainOwner.ID).
+				Str("onChainOwner.ID", onChainOwner.ID).
+				Msg("failed to transfer asset - UpdateOwners")
+			return ownerUnchanged, fmt.Errorf("set new nft owner: %w", err)
+		}
+		updatedBool = true
+	}
+
+	if string(nftStatus.OnChainStatus) != onChainStatusObject.OnChainStatus {
+		err = db.ValidateOnChainTransition(db.OnChainStatus(onChainStatusObject.OnChainStatus), nftStatus.OnChainStatus, db.OnChainStatusSourceSync)
+		if err != nil {
+			// leave the status for an admin to look at rather than stopping the sync
+			l.Warn().Err(err).Str("asset_hash", userAsset.Hash).Str("tx_hash", nftStatus.TxHash).Msg("rejected on chain status change")
+		} else {
+			err = db.SetOnChainStatus(passdb.StdConn, onChainStatusObject, nftStatus.OnChainStatus, &db.OnChainStatusChange{
+				Source: db.OnChainStatusSourceSync,
+				TxHash: null.StringFrom(nftStatus.TxHash),
+			})
+			if err != nil {
+				return ownerUnchanged, err
+			}
+			_, err = userAsset.Update(passdb.StdConn, boil.Infer())
+			if err != nil {
+				return ownerUnchanged, err
+			}
+			updatedBool = true
+		}
+	}
+
+	// insert older and continue
+	newItemOnChainTransaction := &boiler.ItemOnchainTransaction{
+		CollectionID:    collection.ID,
+		ExternalTokenID: tokenID,
+		TXID:            nftStatus.TxHash,
+		ContractAddr:    collection.MintContract.String,
+		FromAddr:        userAsset.OwnerID,
+		ToAddr:          nftStatus.Owner.Hex(),
+		BlockNumber:     nftStatus.BlockNumber,
+		BlockTimestamp:  nftStatus.BlockTimestamp,
+	}
+	err = newItemOnChainTransaction.Insert(passdb.StdConn, boil.Infer())
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("newItemOnChainTransaction", newItemOnChainTransaction).Msg("failed to insert new on chain tx history")
+	}
+
+	if updatedBool {
+		return ownerUpdated, nil
+	}
+	return ownerUnchanged, nil
 }
 
-func UpdateSuccessful1155WithdrawalsWithTxHash(records []*NFT1155TransferRecord, contract string) (int, int) {
+// pending1155RollbackInCollection limits pending 1155 rollbacks to the assets of one collection
+func pending1155RollbackInCollection(collection *boiler.Collection) qm.QueryMod {
+	return qm.Where(
+		fmt.Sprintf(
+			"%s IN (SELECT %s FROM %s WHERE %s = ?)",
+			boiler.Pending1155RollbackTableColumns.AssetID,
+			boiler.UserAssets1155Columns.ID,
+			boiler.TableNames.UserAssets1155,
+			boiler.UserAssets1155Columns.CollectionID,
+		),
+		collection.ID,
+	)
+}
+
+func UpdateSuccessful1155WithdrawalsWithTxHash(records []*NFT1155TransferRecord, collection *boiler.Collection) (int, int) {
 	l := passlog.L.With().Str("svc", "avant_pending_refund_set_tx_hash").Logger()
 
 	skipped := 0
@@ -262,6 +308,7 @@ func UpdateSuccessful1155WithdrawalsWithTxHash(records []*NFT1155TransferRecord,
 			boiler.Pending1155RollbackWhere.DeletedAt.IsNull(),
 			boiler.Pending1155RollbackWhere.TXHash.EQ(""),
 			boiler.Pending1155RollbackWhere.TXHash.NEQ(record.TxHash), // Ignore tx hash if already assigned to another pending refund
+			pending1155RollbackInCollection(collection),
 		}
 
 		count, err := boiler.Pending1155Rollbacks(filter...).Count(passdb.StdConn)
@@ -302,11 +349,12 @@ func UpdateSuccessful1155WithdrawalsWithTxHash(records []*NFT1155TransferRecord,
 	return success, skipped
 }
 
-// ReverseFailed1155 Rollback stale 1155 (dangerous if buggy, check very, very carefully)
-func ReverseFailed1155(enabled1155Rollback bool) (int, int, error) {
+// ReverseFailed1155 Rollback stale 1155 of a collection (dangerous if buggy, check very, very carefully)
+func ReverseFailed1155(enabled1155Rollback bool, collection *boiler.Collection) (int, int, error) {
 	l := passlog.L.
 		With().
 		Str("svc", "avant_rollback_1155").
+		Str("collection_slug", collection.Slug).
 		Bool("enable_1155_rollback", enabled1155Rollback).
 		Logger()
 
@@ -320,6 +368,7 @@ func ReverseFailed1155(enabled1155Rollback bool) (int, int, error) {
 		boiler.Pending1155RollbackWhere.IsRefunded.EQ(false),
 		boiler.Pending1155RollbackWhere.DeletedAt.IsNull(),
 		boiler.Pending1155RollbackWhere.TXHash.EQ(""),
+		pending1155RollbackInCollection(collection),
 		qm.Load(boiler.Pending1155RollbackRels.Asset, qm.Select(boiler.UserAssets1155Columns.ID, boiler.UserAssets1155Columns.Count)),
 	}
 
@@ -335,21 +384,24 @@ func ReverseFailed1155(enabled1155Rollback bool) (int, int, error) {
 			Int("count_to", refund.R.Asset.Count+refund.Count).
 			Logger()
 
-		refund.R.Asset.Count += refund.Count
-
-		refund.IsRefunded = true
-		refund.RefundCanceledAt = null.TimeFrom(time.Now())
-
-		_, err := refund.Update(passdb.StdConn, boil.Infer())
+		tx, err := passdb.StdConn.Begin()
 		if err != nil {
 			l.Warn().Err(err).Msg("failed to rollback 1155 asset")
 			skipped++
 			continue
 		}
-
-		_, err = refund.R.Asset.Update(passdb.StdConn, boil.Whitelist(boiler.UserAssets1155Columns.ID, boiler.UserAssets1155Columns.Count))
+		_, err = rollback1155Tx(tx, refund.ID)
+		if err != nil {
+			tx.Rollback()
+			l.Warn().Err(err).Msg("failed to rollback 1155 asset")
+			skipped++
+			continue
+		}
+		err = tx.Commit()
 		if err != nil {
 			l.Warn().Err(err).Msg("failed to rollback 1155 asset")
+			skipped++
+			continue
 		}
 
 		l.Info().Msg("successfully 1155 asset rollback")
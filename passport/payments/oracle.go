@@ -0,0 +1,437 @@
+package payments
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passlog"
+
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+)
+
+// how long an oracle price is reused before the sources are queried again
+const oracleRefreshInterval = 10 * time.Second
+
+// OracleSymbols are the symbols needed to sell SUPS
+var OracleSymbols = []string{"sups", "eth", "bnb"}
+
+// OraclePrice is the aggregated price of a symbol.
+// Untrusted prices are still usable for display, but purchases are paused while any price is untrusted.
+type OraclePrice struct {
+	Symbol   string          `json:"symbol"`
+	USD      decimal.Decimal `json:"usd"`
+	Trusted  bool            `json:"trusted"`
+	Fallback bool            `json:"fallback"`
+	Reason   string          `json:"reason,omitempty"`
+	Quotes   []*PriceQuote   `json:"quotes"`
+	// the exchange rate history record of this price
+	RecordID  null.String `json:"record_id"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// OracleSettings are how strict the oracle is with the quotes it aggregates
+type OracleSettings struct {
+	// quotes older than this are stale
+	MaxAge time.Duration
+	// largest relative move of the median accepted without confirmations
+	MaxDeviation  decimal.Decimal
+	MinSources    int
+	Confirmations int
+}
+
+func oracleSettingsFromKV() OracleSettings {
+	return OracleSettings{
+		MaxAge:        time.Duration(db.GetIntWithDefault(db.KeyPriceOracleMaxAgeSeconds, 300)) * time.Second,
+		MaxDeviation:  db.GetDecimalWithDefault(db.KeyPriceOracleMaxDeviation, decimal.NewFromFloat(0.1)),
+		MinSources:    db.GetIntWithDefault(db.KeyPriceOracleMinSources, 1),
+		Confirmations: db.GetIntWithDefault(db.KeyPriceOracleDeviationConfirmations, 3),
+	}
+}
+
+// configuredPriceSources reads the sources from the kv store, so a bad source can be dropped without a deploy
+func configuredPriceSources() []PriceSource {
+	names := db.GetStrWithDefault(db.KeyPriceOracleSources, defaultPriceSources)
+	sources, err := PriceSourcesFromNames(names)
+	if err != nil {
+		passlog.L.Error().Err(err).Str("sources", names).Msg("bad price oracle sources, using the defaults")
+		sources, _ = PriceSourcesFromNames(defaultPriceSources)
+	}
+	return sources
+}
+
+// PriceOracle combines the quotes of several sources into a single price per symbol
+type PriceOracle struct {
+	sources  func() []PriceSource
+	settings func() OracleSettings
+	// where the fallback price comes from and where prices are recorded, tests run without a db
+	floor  func(symbol string) (decimal.Decimal, error)
+	record func(price *OraclePrice, sources []string) null.String
+
+	updateMu sync.Mutex
+	mu       sync.RWMutex
+	prices   map[string]*OraclePrice
+	// last median that passed the deviation check
+	accepted map[string]decimal.Decimal
+	// a median that moved too far, it is accepted once it has been seen enough times in a row
+	candidate      map[string]decimal.Decimal
+	candidateCount map[string]int
+
+	onUpdate []func(price *OraclePrice)
+}
+
+// NewPriceOracle returns an oracle over a fixed set of sources
+func NewPriceOracle(sources ...PriceSource) *PriceOracle {
+	return newPriceOracle(func() []PriceSource { return sources })
+}
+
+func newPriceOracle(sources func() []PriceSource) *PriceOracle {
+	return &PriceOracle{
+		sources:        sources,
+		settings:       oracleSettingsFromKV,
+		floor:          floorPrice,
+		record:         recordPrice,
+		prices:         map[string]*OraclePrice{},
+		accepted:       map[string]decimal.Decimal{},
+		candidate:      map[string]decimal.Decimal{},
+		candidateCount: map[string]int{},
+	}
+}
+
+var priceOracle = newPriceOracle(configuredPriceSources)
+
+// Price returns the cached price of the symbol, refreshing it from the sources when it is out of date
+func (o *PriceOracle) Price(symbol string) *OraclePrice {
+	symbol = strings.ToLower(symbol)
+
+	o.mu.RLock()
+	price, ok := o.prices[symbol]
+	o.mu.RUnlock()
+	if ok && time.Since(price.UpdatedAt) < oracleRefreshInterval {
+		return price
+	}
+
+	return o.Update(symbol)
+}
+
+// Prices returns the last computed prices without querying the sources
+func (o *PriceOracle) Prices() []*OraclePrice {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	prices := []*OraclePrice{}
+	for _, price := range o.prices {
+		prices = append(prices, price)
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i].Symbol < prices[j].Symbol })
+	return prices
+}
+
+// Update queries every source for the symbol and aggregates the fresh quotes.
+// The median is rejected when it moves more than the max deviation from the last accepted price,
+// unless it has been confirmed by enough consecutive updates.
+// When there are not enough fresh quotes the floor price is used and the price is marked untrusted.
+func (o *PriceOracle) Update(symbol string) *OraclePrice {
+	symbol = strings.ToLower(symbol)
+
+	o.updateMu.Lock()
+	defer o.updateMu.Unlock()
+
+	// another caller may have updated while we were waiting
+	o.mu.RLock()
+	last, ok := o.prices[symbol]
+	o.mu.RUnlock()
+	if ok && time.Since(last.UpdatedAt) < time.Second {
+		return last
+	}
+
+	settings := o.settings()
+	maxAge := settings.MaxAge
+	maxDeviation := settings.MaxDeviation
+	minSources := settings.MinSources
+	confirmations := settings.Confirmations
+
+	quotes := o.quotes(symbol)
+	fresh := []decimal.Decimal{}
+	freshSources := []string{}
+	for _, q := range quotes {
+		if q.Error != "" {
+			continue
+		}
+		if time.Since(q.At) > maxAge {
+			q.Stale = true
+			continue
+		}
+		fresh = append(fresh, q.USD)
+		freshSources = append(freshSources, q.Source)
+	}
+
+	price := &OraclePrice{
+		Symbol:    symbol,
+		Quotes:    quotes,
+		UpdatedAt: time.Now(),
+	}
+
+	if len(fresh) == 0 || len(fresh) < minSources {
+		floor, err := o.floor(symbol)
+		if err != nil {
+			passlog.L.Error().Err(err).Str("symbol", symbol).Msg("no floor price to fall back to")
+		}
+		price.USD = floor
+		price.Fallback = true
+		price.Reason = fmt.Sprintf("%d of %d required sources are fresh", len(fresh), minSources)
+		price.RecordID = o.record(price, []string{"fallback"})
+		o.set(price)
+		return price
+	}
+
+	median := medianPrice(fresh)
+
+	o.mu.Lock()
+	accepted, hasAccepted := o.accepted[symbol]
+	if hasAccepted && deviation(accepted, median).GreaterThan(maxDeviation) {
+		candidate, hasCandidate := o.candidate[symbol]
+		if hasCandidate && deviation(candidate, median).LessThanOrEqual(maxDeviation) {
+			o.candidateCount[symbol]++
+		} else {
+			o.candidate[symbol] = median
+			o.candidateCount[symbol] = 1
+		}
+
+		if o.candidateCount[symbol] < confirmations {
+			price.USD = accepted
+			price.Reason = fmt.Sprintf("median %s deviates more than %s from %s (%d/%d confirmations)", median.String(), maxDeviation.String(), accepted.String(), o.candidateCount[symbol], confirmations)
+			o.mu.Unlock()
+			passlog.L.Warn().Str("symbol", symbol).Str("median", median.String()).Str("accepted", accepted.String()).Msg("price oracle rejected update")
+			price.RecordID = o.record(price, freshSources)
+			o.set(price)
+			return price
+		}
+	}
+
+	delete(o.candidate, symbol)
+	delete(o.candidateCount, symbol)
+	o.accepted[symbol] = median
+	o.mu.Unlock()
+
+	price.USD = median
+	price.Trusted = true
+	price.RecordID = o.record(price, freshSources)
+	o.set(price)
+
+	return price
+}
+
+// recordPrice stores the price in the exchange rate history, failing to record does not stop the price being used
+func recordPrice(price *OraclePrice, sources []string) null.String {
+	if price.USD.LessThanOrEqual(decimal.Zero) {
+		return null.String{}
+	}
+	rate, err := db.ExchangeRateInsert(price.Symbol, price.USD, sources, price.Trusted)
+	if err != nil {
+		passlog.L.Error().Err(err).Str("symbol", price.Symbol).Msg("failed to record exchange rate")
+		return null.String{}
+	}
+	return null.StringFrom(rate.ID)
+}
+
+func (o *PriceOracle) set(price *OraclePrice) {
+	o.mu.Lock()
+	o.prices[price.Symbol] = price
+	onUpdate := o.onUpdate
+	o.mu.Unlock()
+
+	for _, fn := range onUpdate {
+		fn(price)
+	}
+}
+
+// OnUpdate registers a callback run after every update, it must not block or query the oracle
+func (o *PriceOracle) OnUpdate(fn func(price *OraclePrice)) {
+	o.mu.Lock()
+	o.onUpdate = append(o.onUpdate, fn)
+	o.mu.Unlock()
+}
+
+func (o *PriceOracle) quotes(symbol string) []*PriceQuote {
+	wg := sync.WaitGroup{}
+	quotes := []*PriceQuote{}
+	quotesMu := sync.Mutex{}
+
+	for _, source := range o.sources() {
+		if !source.Supports(symbol) {
+			continue
+		}
+		wg.Add(1)
+		go func(source PriceSource) {
+			defer wg.Done()
+			q, err := source.Quote(symbol)
+			if err == nil && q.USD.LessThanOrEqual(decimal.Zero) {
+				err = fmt.Errorf("0 price returned")
+			}
+			if err != nil {
+				passlog.L.Warn().Err(err).Str("source", source.Name()).Str("symbol", symbol).Msg("could not fetch price")
+				q = &PriceQuote{Source: source.Name(), Symbol: symbol, Error: err.Error()}
+			}
+			quotesMu.Lock()
+			quotes = append(quotes, q)
+			quotesMu.Unlock()
+		}(source)
+	}
+	wg.Wait()
+
+	sort.Slice(quotes, func(i, j int) bool { return quotes[i].Source < quotes[j].Source })
+	return quotes
+}
+
+// floorPrice is what a symbol is priced at when none of the sources can be trusted.
+// SUPS uses the purchase floor price, the other symbols keep their last stored rate.
+func floorPrice(symbol string) (decimal.Decimal, error) {
+	var dec decimal.Decimal
+	switch symbol {
+	case "sups":
+		dec = db.GetDecimalWithDefault(db.KeyPurchaseSupsFloorPrice, decimal.NewFromFloat(0.02))
+	case "eth":
+		dec = db.GetDecimal(db.KeyEthToUSD)
+	case "bnb":
+		dec = db.GetDecimal(db.KeyBNBToUSD)
+	default:
+		return decimal.Zero, fmt.Errorf("unknown symbol %s", symbol)
+	}
+	if dec.LessThanOrEqual(decimal.Zero) {
+		return decimal.Zero, fmt.Errorf("0 price returned")
+	}
+	return dec, nil
+}
+
+func medianPrice(prices []decimal.Decimal) decimal.Decimal {
+	sorted := append([]decimal.Decimal{}, prices...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LessThan(sorted[j]) })
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return sorted[mid-1].Add(sorted[mid]).Div(decimal.NewFromInt(2))
+}
+
+// deviation returns the relative change from a to b
+func deviation(a, b decimal.Decimal) decimal.Decimal {
+	if a.IsZero() {
+		return decimal.NewFromInt(1)
+	}
+	return b.Sub(a).Div(a).Abs()
+}
+
+// OracleUpdate refreshes the price of the symbol from every source
+func OracleUpdate(symbol string) *OraclePrice {
+	return priceOracle.Update(symbol)
+}
+
+// OracleOnUpdate registers a callback run after every price update
+func OracleOnUpdate(fn func(price *OraclePrice)) {
+	priceOracle.OnUpdate(fn)
+}
+
+// OraclePrices returns the last computed price of every symbol
+func OraclePrices() []*OraclePrice {
+	return priceOracle.Prices()
+}
+
+// PurchasesPaused returns whether SUPS purchases and the store should be paused because a price can't be trusted
+func PurchasesPaused() (bool, string) {
+	if !db.GetBoolWithDefault(db.KeyPriceOraclePauseUntrusted, true) {
+		return false, ""
+	}
+	return priceOracle.Paused()
+}
+
+// Paused returns whether the price of any symbol needed to sell SUPS is untrusted, and why
+func (o *PriceOracle) Paused() (bool, string) {
+	reasons := []string{}
+	for _, symbol := range OracleSymbols {
+		price := o.Price(symbol)
+		if !price.Trusted {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", symbol, price.Reason))
+		}
+	}
+	if len(reasons) > 0 {
+		return true, strings.Join(reasons, "; ")
+	}
+	return false, ""
+}
+
+// ErrPurchaseHeld is returned for purchases that can't be priced automatically, they are held for an admin to release
+var ErrPurchaseHeld = errors.New("no trusted sups price from when the purchase was made")
+
+// supsPurchasePrice is the oracle SUPS price with the purchase multiplier and floor applied, along with the exchange rate record it is based on
+func supsPurchasePrice(passportExchangeRateEnabled bool) (decimal.Decimal, null.String, error) {
+	if !passportExchangeRateEnabled {
+		dec, err := decimal.NewFromString("0.12")
+		return dec, null.String{}, err
+	}
+
+	price := priceOracle.Price("sups")
+	dec, err := purchasePrice(price.USD, price.Fallback)
+	if err != nil {
+		return decimal.Zero, null.String{}, err
+	}
+	return dec, price.RecordID, nil
+}
+
+// supsPurchasePriceAt prices a purchase made at the given time.
+// Purchases synced late, like the ones left on avant while purchases were paused, use the last trusted rate from when they were made,
+// the current price could be far from what the buyer saw. Without one ErrPurchaseHeld is returned.
+func supsPurchasePriceAt(passportExchangeRateEnabled bool, at time.Time) (decimal.Decimal, null.String, error) {
+	if !passportExchangeRateEnabled {
+		return supsPurchasePrice(passportExchangeRateEnabled)
+	}
+	if at.IsZero() {
+		return decimal.Zero, null.String{}, fmt.Errorf("%w: purchase has no block time", ErrPurchaseHeld)
+	}
+
+	maxAge := priceOracle.settings().MaxAge
+	if time.Since(at) <= maxAge {
+		return supsPurchasePrice(passportExchangeRateEnabled)
+	}
+
+	rate, err := db.ExchangeRateTrustedAt("sups", at, maxAge)
+	if errors.Is(err, sql.ErrNoRows) {
+		return decimal.Zero, null.String{}, fmt.Errorf("%w: purchased at %s", ErrPurchaseHeld, at.Format(time.RFC3339))
+	}
+	if err != nil {
+		return decimal.Zero, null.String{}, err
+	}
+
+	dec, err := purchasePrice(rate.Usd, false)
+	if err != nil {
+		return decimal.Zero, null.String{}, err
+	}
+	return dec, null.StringFrom(rate.ID), nil
+}
+
+// purchasePrice applies the market multiplier and floor to a SUPS price, fallback prices are already the floor
+func purchasePrice(usd decimal.Decimal, fallback bool) (decimal.Decimal, error) {
+	dec := usd
+	if !fallback {
+		priceFloor := db.GetDecimalWithDefault(db.KeyPurchaseSupsFloorPrice, decimal.NewFromFloat(0.02))
+		marketPriceMultiplier := db.GetDecimalWithDefault(db.KeyPurchaseSupsMarketPriceMultiplier, decimal.NewFromFloat(1.1))
+
+		// Increase market price
+		dec = dec.Mul(marketPriceMultiplier)
+		// Check if less than floor price
+		if dec.LessThan(priceFloor) {
+			dec = priceFloor
+		}
+	}
+
+	if dec.LessThanOrEqual(decimal.Zero) {
+		return decimal.Zero, fmt.Errorf("0 price returned")
+	}
+	return dec, nil
+}
@@ -0,0 +1,197 @@
+package payments
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// PriceSource is a single upstream provider of USD prices
+type PriceSource interface {
+	Name() string
+	// Supports returns whether the source can price the (lower case) symbol
+	Supports(symbol string) bool
+	Quote(symbol string) (*PriceQuote, error)
+}
+
+// PriceQuote is a price reported by a source, At is when the source observed it
+type PriceQuote struct {
+	Source string          `json:"source"`
+	Symbol string          `json:"symbol"`
+	USD    decimal.Decimal `json:"usd"`
+	At     time.Time       `json:"at"`
+	Error  string          `json:"error,omitempty"`
+	Stale  bool            `json:"stale"`
+}
+
+var priceHTTPClient = &http.Client{Timeout: 5 * time.Second}
+
+func getPriceJSON(url string, result interface{}) error {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := priceHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("non 200 status code: %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// priceSources are the sources the oracle can be configured with, by name
+var priceSources = map[string]PriceSource{
+	AvantPriceSource{}.Name():     AvantPriceSource{},
+	CoinbasePriceSource{}.Name():  CoinbasePriceSource{},
+	CoinGeckoPriceSource{}.Name(): CoinGeckoPriceSource{},
+}
+
+const defaultPriceSources = "avant,coingecko,coinbase"
+
+// PriceSourcesFromNames returns the sources in a comma separated list of names
+func PriceSourcesFromNames(names string) ([]PriceSource, error) {
+	sources := []PriceSource{}
+	for _, name := range strings.Split(names, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		source, ok := priceSources[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown price source %s", name)
+		}
+		sources = append(sources, source)
+	}
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no price sources in %q", names)
+	}
+	return sources, nil
+}
+
+// AvantPriceSource reads the prices scraped by avant data
+type AvantPriceSource struct{}
+
+func (AvantPriceSource) Name() string { return "avant" }
+
+func (AvantPriceSource) Supports(symbol string) bool {
+	return symbol == "sups" || symbol == "eth" || symbol == "bnb"
+}
+
+func (s AvantPriceSource) Quote(symbol string) (*PriceQuote, error) {
+	result := &AvantDataResp{}
+	err := getPriceJSON(fmt.Sprintf(`%s/api/%s_price`, baseURL, symbol), result)
+	if err != nil {
+		return nil, err
+	}
+
+	dec, err := decimal.NewFromString(result.USD)
+	if err != nil {
+		return nil, err
+	}
+
+	// avant returns unix seconds, older versions returned milliseconds
+	at := time.Now()
+	if result.Time > 1e12 {
+		at = time.UnixMilli(int64(result.Time))
+	} else if result.Time > 0 {
+		at = time.Unix(int64(result.Time), 0)
+	}
+
+	return &PriceQuote{Source: s.Name(), Symbol: symbol, USD: dec, At: at}, nil
+}
+
+type CoinbaseResp struct {
+	Data struct {
+		Currency string `json:"currency"`
+		Rates    struct {
+			Usd string `json:"USD"`
+		} `json:"rates"`
+	} `json:"data"`
+}
+
+// CoinbasePriceSource reads spot exchange rates from coinbase, it does not list SUPS
+type CoinbasePriceSource struct{}
+
+func (CoinbasePriceSource) Name() string { return "coinbase" }
+
+func (CoinbasePriceSource) Supports(symbol string) bool {
+	return symbol == "eth" || symbol == "bnb"
+}
+
+func (s CoinbasePriceSource) Quote(symbol string) (*PriceQuote, error) {
+	dec, err := CoinbasePrice(symbol)
+	if err != nil {
+		return nil, err
+	}
+	// coinbase rates are live, they don't come with a timestamp
+	return &PriceQuote{Source: s.Name(), Symbol: symbol, USD: dec, At: time.Now()}, nil
+}
+
+// CoinbasePrice fetches the current USD rate of the symbol from coinbase
+func CoinbasePrice(symbol string) (decimal.Decimal, error) {
+	result := &CoinbaseResp{}
+	err := getPriceJSON(fmt.Sprintf(`https://api.coinbase.com/v2/exchange-rates?currency=%s`, strings.ToUpper(symbol)), result)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	dec, err := decimal.NewFromString(result.Data.Rates.Usd)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if dec.Equal(decimal.Zero) {
+		return decimal.Zero, fmt.Errorf("0 price returned")
+	}
+	return dec, nil
+}
+
+var coinGeckoIDs = map[string]string{
+	"sups": "supremacy",
+	"eth":  "ethereum",
+	"bnb":  "binancecoin",
+}
+
+type coinGeckoResp map[string]struct {
+	Usd           float64 `json:"usd"`
+	LastUpdatedAt int64   `json:"last_updated_at"`
+}
+
+// CoinGeckoPriceSource reads prices from the coingecko public api
+type CoinGeckoPriceSource struct{}
+
+func (CoinGeckoPriceSource) Name() string { return "coingecko" }
+
+func (CoinGeckoPriceSource) Supports(symbol string) bool {
+	_, ok := coinGeckoIDs[symbol]
+	return ok
+}
+
+func (s CoinGeckoPriceSource) Quote(symbol string) (*PriceQuote, error) {
+	id := coinGeckoIDs[symbol]
+	result := coinGeckoResp{}
+	err := getPriceJSON(fmt.Sprintf(`https://api.coingecko.com/api/v3/simple/price?ids=%s&vs_currencies=usd&include_last_updated_at=true`, id), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	price, ok := result[id]
+	if !ok {
+		return nil, fmt.Errorf("%s missing from response", id)
+	}
+
+	at := time.Now()
+	if price.LastUpdatedAt > 0 {
+		at = time.Unix(price.LastUpdatedAt, 0)
+	}
+
+	return &PriceQuote{Source: s.Name(), Symbol: symbol, USD: decimal.NewFromFloat(price.Usd), At: at}, nil
+}
@@ -0,0 +1,220 @@
+package payments
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+)
+
+// testPriceSource quotes whatever it was last set to
+type testPriceSource struct {
+	sync.Mutex
+	name string
+	usd  decimal.Decimal
+	at   time.Time
+	err  error
+}
+
+func (s *testPriceSource) Name() string                { return s.name }
+func (s *testPriceSource) Supports(symbol string) bool { return true }
+func (s *testPriceSource) Quote(symbol string) (*PriceQuote, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &PriceQuote{Source: s.name, Symbol: symbol, USD: s.usd, At: s.at}, nil
+}
+
+func (s *testPriceSource) set(usd float64, at time.Time) {
+	s.Lock()
+	s.usd = decimal.NewFromFloat(usd)
+	s.at = at
+	s.err = nil
+	s.Unlock()
+}
+
+var testOracleSettings = OracleSettings{
+	MaxAge:        time.Minute,
+	MaxDeviation:  decimal.NewFromFloat(0.1),
+	MinSources:    2,
+	Confirmations: 3,
+}
+
+func newTestPriceOracle(sources ...PriceSource) *PriceOracle {
+	o := NewPriceOracle(sources...)
+	o.settings = func() OracleSettings { return testOracleSettings }
+	o.floor = func(symbol string) (decimal.Decimal, error) { return decimal.NewFromFloat(0.02), nil }
+	o.record = func(price *OraclePrice, sources []string) null.String { return null.String{} }
+	return o
+}
+
+// update skips the cache so each call queries the sources again
+func update(o *PriceOracle, symbol string) *OraclePrice {
+	o.mu.Lock()
+	delete(o.prices, symbol)
+	o.mu.Unlock()
+	return o.Update(symbol)
+}
+
+func TestMedianPrice(t *testing.T) {
+	tests := []struct {
+		name   string
+		prices []float64
+		want   float64
+	}{
+		{"one", []float64{1.5}, 1.5},
+		{"odd", []float64{3, 1, 2}, 2},
+		{"even", []float64{4, 1, 3, 2}, 2.5},
+		{"outlier", []float64{1, 1.1, 100}, 1.1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prices := []decimal.Decimal{}
+			for _, p := range tt.prices {
+				prices = append(prices, decimal.NewFromFloat(p))
+			}
+			if got := medianPrice(prices); !got.Equal(decimal.NewFromFloat(tt.want)) {
+				t.Errorf("medianPrice() = %s, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPriceOracleUpdate(t *testing.T) {
+	a := &testPriceSource{name: "a"}
+	b := &testPriceSource{name: "b"}
+	o := newTestPriceOracle(a, b)
+
+	t.Run("fresh quotes are trusted", func(t *testing.T) {
+		a.set(1.0, time.Now())
+		b.set(1.2, time.Now())
+		price := update(o, "sups")
+		if !price.Trusted || price.Fallback || !price.USD.Equal(decimal.NewFromFloat(1.1)) {
+			t.Errorf("price = %s trusted %v fallback %v, want trusted 1.1", price.USD, price.Trusted, price.Fallback)
+		}
+	})
+
+	t.Run("stale quotes fall back to the floor", func(t *testing.T) {
+		a.set(1.0, time.Now())
+		b.set(1.0, time.Now().Add(-2*time.Minute))
+		price := update(o, "sups")
+		if price.Trusted || !price.Fallback || !price.USD.Equal(decimal.NewFromFloat(0.02)) {
+			t.Errorf("price = %s trusted %v fallback %v, want untrusted floor", price.USD, price.Trusted, price.Fallback)
+		}
+		for _, q := range price.Quotes {
+			if q.Source == "b" && !q.Stale {
+				t.Errorf("quote from b not marked stale")
+			}
+		}
+	})
+
+	t.Run("failed sources fall back to the floor", func(t *testing.T) {
+		a.set(1.0, time.Now())
+		b.Lock()
+		b.err = fmt.Errorf("down")
+		b.Unlock()
+		price := update(o, "sups")
+		if price.Trusted || !price.Fallback {
+			t.Errorf("price = %s trusted %v fallback %v, want untrusted floor", price.USD, price.Trusted, price.Fallback)
+		}
+	})
+
+	t.Run("a jump needs confirmations", func(t *testing.T) {
+		a.set(1.0, time.Now())
+		b.set(1.2, time.Now())
+		update(o, "sups")
+
+		a.set(2.0, time.Now())
+		b.set(2.0, time.Now())
+		for i := 1; i < testOracleSettings.Confirmations; i++ {
+			price := update(o, "sups")
+			if price.Trusted || !price.USD.Equal(decimal.NewFromFloat(1.1)) {
+				t.Fatalf("update %d: price = %s trusted %v, want the last accepted 1.1 untrusted", i, price.USD, price.Trusted)
+			}
+		}
+		price := update(o, "sups")
+		if !price.Trusted || !price.USD.Equal(decimal.NewFromFloat(2)) {
+			t.Errorf("price = %s trusted %v, want trusted 2", price.USD, price.Trusted)
+		}
+	})
+}
+
+func TestPriceOraclePaused(t *testing.T) {
+	a := &testPriceSource{name: "a"}
+	b := &testPriceSource{name: "b"}
+	o := newTestPriceOracle(a, b)
+
+	a.set(1.0, time.Now())
+	b.set(1.0, time.Now())
+	for _, symbol := range OracleSymbols {
+		update(o, symbol)
+	}
+	if paused, reason := o.Paused(); paused {
+		t.Errorf("paused with trusted prices: %s", reason)
+	}
+
+	b.set(1.0, time.Now().Add(-time.Hour))
+	update(o, "eth")
+	paused, reason := o.Paused()
+	if !paused {
+		t.Fatalf("not paused with a stale eth price")
+	}
+	if !strings.HasPrefix(reason, "eth:") {
+		t.Errorf("reason = %q, want the eth price", reason)
+	}
+}
+
+func TestPriceSourcesFromNames(t *testing.T) {
+	tests := []struct {
+		names   string
+		want    []string
+		wantErr bool
+	}{
+		{"avant,coingecko,coinbase", []string{"avant", "coingecko", "coinbase"}, false},
+		{" Coinbase , avant ", []string{"coinbase", "avant"}, false},
+		{"avant,binance", nil, true},
+		{"", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.names, func(t *testing.T) {
+			sources, err := PriceSourcesFromNames(tt.names)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PriceSourcesFromNames() err = %v, want err %v", err, tt.wantErr)
+			}
+			names := []string{}
+			for _, s := range sources {
+				names = append(names, s.Name())
+			}
+			if strings.Join(names, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("PriceSourcesFromNames() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestPriceOracleOnUpdate(t *testing.T) {
+	a := &testPriceSource{name: "a"}
+	b := &testPriceSource{name: "b"}
+	o := newTestPriceOracle(a, b)
+
+	updated := []*OraclePrice{}
+	o.OnUpdate(func(price *OraclePrice) {
+		updated = append(updated, price)
+	})
+
+	a.set(1.0, time.Now())
+	b.set(1.0, time.Now())
+	price := update(o, "eth")
+	// a second update inside a second is served from the cache, so nothing changed
+	o.Update("eth")
+
+	if len(updated) != 1 || updated[0] != price {
+		t.Errorf("callback ran %d times, want once with the new price", len(updated))
+	}
+}
@@ -3,10 +3,8 @@ package payments
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
 	"strings"
 	"xsyn-services/boiler"
 	"xsyn-services/passport/api/users"
@@ -18,6 +16,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
 )
 
 type AvantDataResp struct {
@@ -85,38 +84,47 @@ func ProcessValues(sups string, inputValue string, inputTokenDecimals int) (deci
 	return inputAmt, bigOutputAmt, nil
 }
 
+// StoreRecord credits the SUPS bought in the purchase, priced from when it was made.
+// ErrPurchaseHeld is returned when there is no trusted price to use.
 func StoreRecord(ctx context.Context, fromUserID types.UserID, toUserID types.UserID, ucm UserCacheMap, record *PurchaseRecord, passportExchangeRatesEnabled bool) error {
+	supPrice, exchangeRateID, err := supsPurchasePriceAt(passportExchangeRatesEnabled, record.PurchasedAt())
+	if err != nil {
+		return err
+	}
+	_, err = storeRecord(fromUserID, toUserID, ucm, record, passportExchangeRatesEnabled, supPrice, exchangeRateID)
+	return err
+}
 
+// storeRecord credits the purchase at the SUPS price and returns the transaction id
+func storeRecord(fromUserID types.UserID, toUserID types.UserID, ucm UserCacheMap, record *PurchaseRecord, passportExchangeRatesEnabled bool, supPrice decimal.Decimal, exchangeRateID null.String) (string, error) {
 	tokenValue, supsValue, err := ProcessValues(record.Sups, record.ValueInt, record.ValueDecimals)
-
 	if err != nil {
-		return err
+		return "", err
 	}
 
+	tokenUSDRate := decimal.NullDecimal{}
+
 	if passportExchangeRatesEnabled {
 		// From Record
 		usdRate, err := decimal.NewFromString(record.UsdRate)
 		if err != nil {
-			return err
+			return "", err
 		}
+		tokenUSDRate = decimal.NewNullDecimal(usdRate)
 		supsAmt, err := decimal.NewFromString(record.Sups)
 		if err != nil {
-			return err
+			return "", err
 		}
 
 		supToUsd := tokenValue.Shift(-1 * int32(record.ValueDecimals)).Mul(usdRate).Div(supsAmt)
 
-		supPrice, err := fetchPrice("sups", passportExchangeRatesEnabled)
-		if err != nil {
-			return err
-		}
 		rateDifference := (supToUsd).Div(supPrice)
 
 		record.Sups = supsAmt.Mul(rateDifference).String()
 
 		tokenValue, supsValue, err = ProcessValues(record.Sups, record.ValueInt, record.ValueDecimals)
 		if err != nil {
-			return err
+			return "", err
 		}
 
 	}
@@ -125,12 +133,12 @@ func StoreRecord(ctx context.Context, fromUserID types.UserID, toUserID types.Us
 
 	creditor, err := boiler.FindUser(passdb.StdConn, toUserID.String())
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	debitor, err := boiler.FindUser(passdb.StdConn, fromUserID.String())
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	trans := &types.NewTransaction{
@@ -142,11 +150,16 @@ func StoreRecord(ctx context.Context, fromUserID types.UserID, toUserID types.Us
 		Group:                types.TransactionGroupStore,
 	}
 
-	_, err = ucm.Transact(trans)
+	txID, err := ucm.Transact(trans)
+	if err != nil {
+		return "", fmt.Errorf("create tx entry for tx %s: %w", record.TxHash, err)
+	}
+
+	err = db.PurchaseExchangeRateInsert(txID, record.TxHash, record.Symbol, tokenUSDRate, supPrice, exchangeRateID)
 	if err != nil {
-		return fmt.Errorf("create tx entry for tx %s: %w", record.TxHash, err)
+		passlog.L.Error().Err(err).Str("txid", record.TxHash).Msg("failed to link purchase to exchange rate")
 	}
-	return nil
+	return txID, nil
 }
 
 func CheckIsCurrentBlockAfter() bool {
@@ -245,108 +258,24 @@ func BNB(isTestnet bool) ([]*PurchaseRecord, error) {
 
 	return records, nil
 }
-func fetchPrice(symbol string, passportExchangeRateEnabled bool) (decimal.Decimal, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf(`%s/api/%s_price`, baseURL, symbol), nil)
-
-	if err != nil {
-		return decimal.Zero, err
-	}
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return decimal.Zero, err
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
-		return decimal.Zero, fmt.Errorf("non 200 status code: %d", resp.StatusCode)
-	}
-	result := &AvantDataResp{}
-	err = json.NewDecoder(resp.Body).Decode(result)
-	if err != nil {
-		return decimal.Zero, err
-	}
-
-	dec, err := decimal.NewFromString(result.USD)
-	if err != nil {
-		return decimal.Zero, err
-	}
-
-	if symbol == "sups" {
-		defaultFloorPrice, err := decimal.NewFromString("0.02")
-		if err != nil {
-			return decimal.Zero, err
-		}
-		defaultMarketMultiplier, err := decimal.NewFromString("1.1")
-		if err != nil {
-			return decimal.Zero, err
-		}
-
-		priceFloor := db.GetDecimalWithDefault(db.KeyPurchaseSupsFloorPrice, defaultFloorPrice)
-		marketPriceMultiplier := db.GetDecimalWithDefault(db.KeyPurchaseSupsMarketPriceMultiplier, defaultMarketMultiplier)
-
-		// Increase market price
-		dec = dec.Mul(marketPriceMultiplier)
-		// Check if less than floor price
-		if dec.LessThan(priceFloor) {
-			dec = priceFloor
-		}
-
-		if dec.LessThanOrEqual(decimal.Zero) {
-			return decimal.Zero, fmt.Errorf("0 price returned")
-		}
-
-		if !passportExchangeRateEnabled {
-			dec, err = decimal.NewFromString("0.12")
-			if err != nil {
-				return decimal.Zero, err
-			}
-		}
-	}
-	return dec, nil
-}
-func catchPriceFetchError(symbol string, dbKey db.KVKey, passportExchangeRatesEnabled bool) (decimal.Decimal, error) {
-	passlog.L.Warn().Msg(fmt.Sprintf("could not fetch %s price", symbol))
-	dec, err := decimal.NewFromString(db.GetStr(dbKey))
-
-	if !passportExchangeRatesEnabled {
-		dec, err = decimal.NewFromString("0.12")
-		if err != nil {
-			return decimal.Zero, err
-		}
-	}
-	if err != nil {
-		return decimal.Zero, err
-	}
-	if dec.LessThanOrEqual(decimal.Zero) {
-		return decimal.Zero, fmt.Errorf("0 price returned")
-	}
-	return dec, nil
-}
-
 func FetchExchangeRates(passportExchangeRatesEnabled bool) (*PriceExchangeRates, error) {
 	enableSale := db.GetBoolWithDefault(db.KeyEnableSyncSale, true)
+	if paused, reason := PurchasesPaused(); paused {
+		passlog.L.Warn().Str("reason", reason).Msg("sale paused, prices can't be trusted")
+		enableSale = false
+	}
 
-	supsPrice, err := fetchPrice("sups", passportExchangeRatesEnabled)
+	supsPrice, _, err := supsPurchasePrice(passportExchangeRatesEnabled)
 	if err != nil {
-		supsPrice, err = catchPriceFetchError("sups", db.KeySupsToUSD, passportExchangeRatesEnabled)
-		if err != nil {
-			return nil, err
-		}
+		return nil, err
 	}
-	ethPrice, err := fetchPrice("eth", passportExchangeRatesEnabled)
-	if err != nil {
-		ethPrice, err = catchPriceFetchError("eth", db.KeyEthToUSD, passportExchangeRatesEnabled)
-		if err != nil {
-			return nil, err
-		}
+	ethPrice := priceOracle.Price("eth").USD
+	if ethPrice.LessThanOrEqual(decimal.Zero) {
+		return nil, fmt.Errorf("0 eth price returned")
 	}
-	bnbPrice, err := fetchPrice("bnb", passportExchangeRatesEnabled)
-	if err != nil {
-		bnbPrice, err = catchPriceFetchError("bnb", db.KeyBNBToUSD, passportExchangeRatesEnabled)
-		if err != nil {
-			return nil, err
-		}
+	bnbPrice := priceOracle.Price("bnb").USD
+	if bnbPrice.LessThanOrEqual(decimal.Zero) {
+		return nil, fmt.Errorf("0 bnb price returned")
 	}
 
 	priceExchangeRates := &PriceExchangeRates{SUPtoUSD: supsPrice, ETHtoUSD: ethPrice, BNBtoUSD: bnbPrice, EnableSale: enableSale}
@@ -0,0 +1,679 @@
+package payments
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/db"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passlog"
+	"xsyn-services/types"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type PendingStatus string
+
+const (
+	// PendingStatusClaimable the withdraw signature has not expired yet, the user can still claim it on chain
+	PendingStatusClaimable PendingStatus = "CLAIMABLE"
+	// PendingStatusExpired the signature has expired and no tx was found, waiting on the automatic rollback
+	PendingStatusExpired PendingStatus = "EXPIRED"
+	// PendingStatusRollingBack a rollback has been claimed but the SUPS are not recorded as returned yet, it is resumed once the claim is stale
+	PendingStatusRollingBack PendingStatus = "ROLLING_BACK"
+	// PendingStatusConfirmed a matching tx was found on chain (or an operator force confirmed it)
+	PendingStatusConfirmed PendingStatus = "CONFIRMED"
+	// PendingStatusRolledBack the withdraw has been reversed off chain
+	PendingStatusRolledBack PendingStatus = "ROLLED_BACK"
+	// PendingStatusCanceled an operator canceled the rollback without a tx hash
+	PendingStatusCanceled PendingStatus = "CANCELED"
+)
+
+type PendingAction string
+
+const (
+	PendingActionForceConfirm  PendingAction = "FORCE_CONFIRM"
+	PendingActionForceRollback PendingAction = "FORCE_ROLLBACK"
+	PendingActionCancel        PendingAction = "CANCEL"
+)
+
+// PendingOperator is who took a manual action on a pending withdraw
+type PendingOperator struct {
+	Name   string
+	UserID null.String
+}
+
+// pendingRollbackClaimTimeout is how long a claimed rollback is left alone before it can be resumed
+const pendingRollbackClaimTimeout = 5 * time.Minute
+
+func pendingStatus(isRefunded bool, txHash string, rollbackClaimedAt null.Time, refundCanceledAt null.Time, refundedAt time.Time) PendingStatus {
+	switch {
+	case isRefunded:
+		return PendingStatusRolledBack
+	case txHash != "":
+		return PendingStatusConfirmed
+	case rollbackClaimedAt.Valid:
+		return PendingStatusRollingBack
+	case refundCanceledAt.Valid:
+		return PendingStatusCanceled
+	case refundedAt.After(time.Now()):
+		return PendingStatusClaimable
+	default:
+		return PendingStatusExpired
+	}
+}
+
+func PendingRefundStatus(refund *boiler.PendingRefund) PendingStatus {
+	return pendingStatus(refund.IsRefunded, refund.TXHash, refund.RollbackClaimedAt, refund.RefundCanceledAt, refund.RefundedAt)
+}
+
+func Pending1155RollbackStatus(rollback *boiler.Pending1155Rollback) PendingStatus {
+	return pendingStatus(rollback.IsRefunded, rollback.TXHash, null.Time{}, rollback.RefundCanceledAt, rollback.RefundedAt)
+}
+
+type PendingRefundItem struct {
+	*boiler.PendingRefund
+	Status  PendingStatus                   `json:"status"`
+	Actions []*boiler.PendingWithdrawAction `json:"actions"`
+}
+
+type Pending1155RollbackItem struct {
+	*boiler.Pending1155Rollback
+	Status  PendingStatus                   `json:"status"`
+	Actions []*boiler.PendingWithdrawAction `json:"actions"`
+}
+
+// PendingRefundsList lists pending refunds, unresolved ones only unless includeResolved is set
+func PendingRefundsList(includeResolved bool) ([]*PendingRefundItem, error) {
+	queryMods := []qm.QueryMod{
+		qm.Load(boiler.PendingRefundRels.PendingWithdrawActions),
+		qm.OrderBy(boiler.PendingRefundColumns.CreatedAt + " ASC"),
+	}
+	if !includeResolved {
+		queryMods = append(queryMods,
+			boiler.PendingRefundWhere.IsRefunded.EQ(false),
+			boiler.PendingRefundWhere.RefundCanceledAt.IsNull(),
+			boiler.PendingRefundWhere.TXHash.EQ(""),
+		)
+	}
+
+	refunds, err := boiler.PendingRefunds(queryMods...).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*PendingRefundItem{}
+	for _, refund := range refunds {
+		item := &PendingRefundItem{
+			PendingRefund: refund,
+			Status:        PendingRefundStatus(refund),
+			Actions:       []*boiler.PendingWithdrawAction{},
+		}
+		if refund.R != nil && refund.R.PendingWithdrawActions != nil {
+			item.Actions = refund.R.PendingWithdrawActions
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// Pending1155RollbacksList lists pending 1155 rollbacks, unresolved ones only unless includeResolved is set
+func Pending1155RollbacksList(includeResolved bool) ([]*Pending1155RollbackItem, error) {
+	queryMods := []qm.QueryMod{
+		qm.Load(boiler.Pending1155RollbackRels.PendingWithdrawActions),
+		qm.OrderBy(boiler.Pending1155RollbackColumns.CreatedAt + " ASC"),
+	}
+	if !includeResolved {
+		queryMods = append(queryMods,
+			boiler.Pending1155RollbackWhere.IsRefunded.EQ(false),
+			boiler.Pending1155RollbackWhere.RefundCanceledAt.IsNull(),
+			boiler.Pending1155RollbackWhere.TXHash.EQ(""),
+		)
+	}
+
+	rollbacks, err := boiler.Pending1155Rollbacks(queryMods...).All(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*Pending1155RollbackItem{}
+	for _, rollback := range rollbacks {
+		item := &Pending1155RollbackItem{
+			Pending1155Rollback: rollback,
+			Status:              Pending1155RollbackStatus(rollback),
+			Actions:             []*boiler.PendingWithdrawAction{},
+		}
+		if rollback.R != nil && rollback.R.PendingWithdrawActions != nil {
+			item.Actions = rollback.R.PendingWithdrawActions
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// refundTransaction builds the transaction that reverses the withdraw of the pending refund
+func refundTransaction(refund *boiler.PendingRefund) (*types.NewTransaction, error) {
+	tx, err := db.TransactionGetByReference(refund.TransactionReference)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("no tx found")
+	}
+
+	debitor, err := boiler.FindUser(passdb.StdConn, types.OnChainUserID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get debitor account: %w", err)
+	}
+
+	return &types.NewTransaction{
+		CreditAccountID:      tx.DebitAccountID,
+		DebitAccountID:       debitor.AccountID,
+		Amount:               tx.Amount,
+		TransactionReference: types.TransactionReference(fmt.Sprintf("REFUND %s", tx.TransactionReference)),
+		Description:          fmt.Sprintf("REFUND %s", tx.Description),
+		Group:                types.TransactionGroup(tx.Group),
+	}, nil
+}
+
+// refundLedgerTransactionID gets the id of the refund transaction if the SUPS have already been returned
+func refundLedgerTransactionID(reference types.TransactionReference) (null.String, error) {
+	tx, err := db.TransactionGetByReference(string(reference))
+	if errors.Is(err, sql.ErrNoRows) {
+		return null.String{}, nil
+	}
+	if err != nil {
+		return null.String{}, err
+	}
+	return null.StringFrom(tx.ID), nil
+}
+
+func lockPendingRefund(exec boil.Executor, id string) (*boiler.PendingRefund, error) {
+	return boiler.PendingRefunds(
+		boiler.PendingRefundWhere.ID.EQ(id),
+		qm.For("UPDATE"),
+	).One(exec)
+}
+
+func lockPending1155Rollback(exec boil.Executor, id string) (*boiler.Pending1155Rollback, error) {
+	return boiler.Pending1155Rollbacks(
+		boiler.Pending1155RollbackWhere.ID.EQ(id),
+		qm.For("UPDATE"),
+	).One(exec)
+}
+
+// claimPendingRefundRollback marks the pending refund as being rolled back before any SUPS move, so only one caller can reverse it.
+// Expired refunds can be claimed, as can claims that went stale without the SUPS being recorded as returned.
+func claimPendingRefundRollback(id string) (*boiler.PendingRefund, error) {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	refund, err := lockPendingRefund(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if refund.DeletedAt.Valid {
+		return nil, fmt.Errorf("pending refund %s is deleted", refund.ID)
+	}
+	status := PendingRefundStatus(refund)
+	staleClaim := status == PendingStatusRollingBack && refund.RollbackClaimedAt.Time.Before(time.Now().Add(-pendingRollbackClaimTimeout))
+	if status != PendingStatusExpired && !staleClaim {
+		return nil, fmt.Errorf("pending refund %s can not be rolled back: %s", refund.ID, status)
+	}
+
+	refund.RollbackClaimedAt = null.TimeFrom(time.Now())
+	refund.UpdatedAt = time.Now()
+	_, err = refund.Update(tx, boil.Whitelist(
+		boiler.PendingRefundColumns.RollbackClaimedAt,
+		boiler.PendingRefundColumns.UpdatedAt,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+// releasePendingRefundClaim lets the pending refund be rolled back again after its SUPS failed to move
+func releasePendingRefundClaim(id string) error {
+	_, err := boiler.PendingRefunds(
+		boiler.PendingRefundWhere.ID.EQ(id),
+		boiler.PendingRefundWhere.IsRefunded.EQ(false),
+	).UpdateAll(passdb.StdConn, boiler.M{
+		boiler.PendingRefundColumns.RollbackClaimedAt: nil,
+		boiler.PendingRefundColumns.UpdatedAt:         time.Now(),
+	})
+	return err
+}
+
+// settlePendingRefundRollback returns the SUPS of a claimed pending refund and marks it as refunded.
+// If the refund transaction is already in the ledger from an earlier attempt it is linked instead of moving the SUPS again.
+// The action is recorded with the refund when given.
+func settlePendingRefundRollback(ucm UserCacheMap, refund *boiler.PendingRefund, newTx *types.NewTransaction, action *boiler.PendingWithdrawAction, op *PendingOperator, reason string) (*boiler.PendingRefund, error) {
+	txID, err := refundLedgerTransactionID(newTx.TransactionReference)
+	if err != nil {
+		return nil, err
+	}
+	if !txID.Valid {
+		id, transactErr := ucm.Transact(newTx)
+		if transactErr != nil {
+			// only let it be claimed again when the refund is definitely not in the ledger, otherwise it stays claimed until resumed
+			txID, err = refundLedgerTransactionID(newTx.TransactionReference)
+			if err != nil {
+				return nil, transactErr
+			}
+			if !txID.Valid {
+				err = releasePendingRefundClaim(refund.ID)
+				if err != nil {
+					passlog.L.Error().Err(err).Str("refund_id", refund.ID).Msg("failed to release pending refund rollback claim")
+				}
+				return nil, transactErr
+			}
+		} else {
+			txID = null.StringFrom(id)
+		}
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	refund, err = lockPendingRefund(tx, refund.ID)
+	if err != nil {
+		return nil, err
+	}
+	if refund.IsRefunded {
+		return nil, fmt.Errorf("pending refund %s is already rolled back", refund.ID)
+	}
+
+	refund.IsRefunded = true
+	refund.RefundCanceledAt = null.TimeFrom(time.Now())
+	refund.ReversalTransactionID = txID
+	refund.UpdatedAt = time.Now()
+	_, err = refund.Update(tx, boil.Whitelist(
+		boiler.PendingRefundColumns.IsRefunded,
+		boiler.PendingRefundColumns.RefundCanceledAt,
+		boiler.PendingRefundColumns.ReversalTransactionID,
+		boiler.PendingRefundColumns.UpdatedAt,
+	))
+	if err != nil {
+		passlog.L.Error().Err(err).Str("refund_id", refund.ID).Str("reversal_tx_id", txID.String).Msg("SUPS reversed but failed to update pending refund")
+		return nil, err
+	}
+
+	if action != nil {
+		action.PendingRefundID = null.StringFrom(refund.ID)
+		action.ReversalTransactionID = txID
+		err = insertPendingAction(tx, action, op, reason)
+		if err != nil {
+			passlog.L.Error().Err(err).Str("refund_id", refund.ID).Str("reversal_tx_id", txID.String).Msg("SUPS reversed but failed to record pending withdraw action")
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+func pendingRefundUnresolved(refund *boiler.PendingRefund) error {
+	status := PendingRefundStatus(refund)
+	if status != PendingStatusClaimable && status != PendingStatusExpired {
+		return fmt.Errorf("pending refund %s is already resolved: %s", refund.ID, status)
+	}
+	return nil
+}
+
+func pending1155RollbackUnresolved(rollback *boiler.Pending1155Rollback) error {
+	status := Pending1155RollbackStatus(rollback)
+	if status != PendingStatusClaimable && status != PendingStatusExpired {
+		return fmt.Errorf("pending 1155 rollback %s is already resolved: %s", rollback.ID, status)
+	}
+	return nil
+}
+
+func insertPendingAction(exec boil.Executor, action *boiler.PendingWithdrawAction, op *PendingOperator, reason string) error {
+	if op == nil || op.Name == "" {
+		return fmt.Errorf("operator is required")
+	}
+	if reason == "" {
+		return fmt.Errorf("reason is required")
+	}
+	action.Operator = op.Name
+	action.OperatorUserID = op.UserID
+	action.Reason = reason
+	return action.Insert(exec, boil.Infer())
+}
+
+// PendingRefundForceConfirm marks the withdraw as claimed on chain with the given tx hash, so it will never be rolled back
+func PendingRefundForceConfirm(id string, txHash string, op *PendingOperator, reason string) (*boiler.PendingRefund, error) {
+	if txHash == "" {
+		return nil, fmt.Errorf("tx hash is required")
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	refund, err := lockPendingRefund(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	err = pendingRefundUnresolved(refund)
+	if err != nil {
+		return nil, err
+	}
+
+	refund.TXHash = txHash
+	refund.RefundCanceledAt = null.TimeFrom(time.Now())
+	refund.UpdatedAt = time.Now()
+	_, err = refund.Update(tx, boil.Whitelist(
+		boiler.PendingRefundColumns.TXHash,
+		boiler.PendingRefundColumns.RefundCanceledAt,
+		boiler.PendingRefundColumns.UpdatedAt,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	err = insertPendingAction(tx, &boiler.PendingWithdrawAction{
+		PendingRefundID: null.StringFrom(refund.ID),
+		Action:          string(PendingActionForceConfirm),
+		TXHash:          null.StringFrom(txHash),
+	}, op, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+// PendingRefundForceRollback returns the withdrawn SUPS to the user, ignoring the automatic rollback kill switch.
+// The withdraw signature has to be expired so the user can not claim it on chain afterwards,
+// and avant is checked for a withdraw the scraper missed, those have to be force confirmed instead.
+func PendingRefundForceRollback(ucm UserCacheMap, lookup *WithdrawLookup, id string, op *PendingOperator, reason string) (*boiler.PendingRefund, error) {
+	// validate before moving any SUPS
+	if op == nil || op.Name == "" || reason == "" {
+		return nil, fmt.Errorf("operator and reason are required")
+	}
+
+	refund, err := boiler.FindPendingRefund(passdb.StdConn, id)
+	if err != nil {
+		return nil, err
+	}
+	status := PendingRefundStatus(refund)
+	if status != PendingStatusExpired && status != PendingStatusRollingBack {
+		return nil, fmt.Errorf("pending refund %s can not be rolled back: %s", refund.ID, status)
+	}
+
+	record, err := FindWithdrawOnChain(lookup, refund)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check the withdraw on chain: %w", err)
+	}
+	if record != nil {
+		return nil, fmt.Errorf("pending refund %s matches on chain withdraw %s, force confirm it instead", refund.ID, record.TxHash)
+	}
+
+	newTx, err := refundTransaction(refund)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err = claimPendingRefundRollback(refund.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return settlePendingRefundRollback(ucm, refund, newTx, &boiler.PendingWithdrawAction{
+		Action: string(PendingActionForceRollback),
+	}, op, reason)
+}
+
+// PendingRefundCancel stops the pending refund from ever being rolled back, without a tx hash
+func PendingRefundCancel(id string, op *PendingOperator, reason string) (*boiler.PendingRefund, error) {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	refund, err := lockPendingRefund(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	err = pendingRefundUnresolved(refund)
+	if err != nil {
+		return nil, err
+	}
+
+	refund.RefundCanceledAt = null.TimeFrom(time.Now())
+	refund.UpdatedAt = time.Now()
+	_, err = refund.Update(tx, boil.Whitelist(
+		boiler.PendingRefundColumns.RefundCanceledAt,
+		boiler.PendingRefundColumns.UpdatedAt,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	err = insertPendingAction(tx, &boiler.PendingWithdrawAction{
+		PendingRefundID: null.StringFrom(refund.ID),
+		Action:          string(PendingActionCancel),
+	}, op, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+// Pending1155RollbackForceConfirm marks the 1155 withdraw as minted on chain with the given tx hash
+func Pending1155RollbackForceConfirm(id string, txHash string, op *PendingOperator, reason string) (*boiler.Pending1155Rollback, error) {
+	if txHash == "" {
+		return nil, fmt.Errorf("tx hash is required")
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rollback, err := lockPending1155Rollback(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	err = pending1155RollbackUnresolved(rollback)
+	if err != nil {
+		return nil, err
+	}
+
+	rollback.TXHash = txHash
+	rollback.RefundCanceledAt = null.TimeFrom(time.Now())
+	_, err = rollback.Update(tx, boil.Whitelist(
+		boiler.Pending1155RollbackColumns.TXHash,
+		boiler.Pending1155RollbackColumns.RefundCanceledAt,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	err = insertPendingAction(tx, &boiler.PendingWithdrawAction{
+		Pending1155RollbackID: null.StringFrom(rollback.ID),
+		Action:                string(PendingActionForceConfirm),
+		TXHash:                null.StringFrom(txHash),
+	}, op, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return rollback, nil
+}
+
+// rollback1155Tx gives the withdrawn 1155 assets back inside the given db transaction.
+// Both rows are locked so the automatic and forced rollbacks can't both add to the count.
+func rollback1155Tx(tx boil.Executor, id string) (*boiler.Pending1155Rollback, error) {
+	rollback, err := lockPending1155Rollback(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rollback.DeletedAt.Valid {
+		return nil, fmt.Errorf("pending 1155 rollback %s is deleted", rollback.ID)
+	}
+	if Pending1155RollbackStatus(rollback) != PendingStatusExpired {
+		return nil, fmt.Errorf("pending 1155 rollback %s can not be rolled back: %s", rollback.ID, Pending1155RollbackStatus(rollback))
+	}
+
+	asset, err := boiler.UserAssets1155S(
+		boiler.UserAssets1155Where.ID.EQ(rollback.AssetID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	rollback.IsRefunded = true
+	rollback.RefundCanceledAt = null.TimeFrom(time.Now())
+	_, err = rollback.Update(tx, boil.Whitelist(
+		boiler.Pending1155RollbackColumns.IsRefunded,
+		boiler.Pending1155RollbackColumns.RefundCanceledAt,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	asset.Count += rollback.Count
+	_, err = asset.Update(tx, boil.Whitelist(boiler.UserAssets1155Columns.Count))
+	if err != nil {
+		return nil, err
+	}
+
+	return rollback, nil
+}
+
+// Pending1155RollbackForceRollback gives the withdrawn 1155 assets back to the user, ignoring the automatic rollback kill switch.
+// The withdraw signature has to be expired so the user can not mint them afterwards,
+// and avant is checked for a mint the sync missed, those have to be force confirmed instead.
+func Pending1155RollbackForceRollback(lookup *WithdrawLookup, id string, op *PendingOperator, reason string) (*boiler.Pending1155Rollback, error) {
+	// validate before giving anything back
+	if op == nil || op.Name == "" || reason == "" {
+		return nil, fmt.Errorf("operator and reason are required")
+	}
+
+	rollback, err := boiler.FindPending1155Rollback(passdb.StdConn, id)
+	if err != nil {
+		return nil, err
+	}
+	if status := Pending1155RollbackStatus(rollback); status != PendingStatusExpired {
+		return nil, fmt.Errorf("pending 1155 rollback %s can not be rolled back: %s", rollback.ID, status)
+	}
+
+	record, err := FindMint1155OnChain(lookup, rollback)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check the mint on chain: %w", err)
+	}
+	if record != nil {
+		return nil, fmt.Errorf("pending 1155 rollback %s matches on chain mint %s, force confirm it instead", rollback.ID, record.TxHash)
+	}
+
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rollback, err = rollback1155Tx(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = insertPendingAction(tx, &boiler.PendingWithdrawAction{
+		Pending1155RollbackID: null.StringFrom(rollback.ID),
+		Action:                string(PendingActionForceRollback),
+	}, op, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return rollback, nil
+}
+
+// Pending1155RollbackCancel stops the pending 1155 rollback from ever being rolled back, without a tx hash
+func Pending1155RollbackCancel(id string, op *PendingOperator, reason string) (*boiler.Pending1155Rollback, error) {
+	tx, err := passdb.StdConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rollback, err := lockPending1155Rollback(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	err = pending1155RollbackUnresolved(rollback)
+	if err != nil {
+		return nil, err
+	}
+
+	rollback.RefundCanceledAt = null.TimeFrom(time.Now())
+	_, err = rollback.Update(tx, boil.Whitelist(boiler.Pending1155RollbackColumns.RefundCanceledAt))
+	if err != nil {
+		return nil, err
+	}
+
+	err = insertPendingAction(tx, &boiler.PendingWithdrawAction{
+		Pending1155RollbackID: null.StringFrom(rollback.ID),
+		Action:                string(PendingActionCancel),
+	}, op, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return rollback, nil
+}
@@ -0,0 +1,267 @@
+package payments_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+	"xsyn-services/boiler"
+	"xsyn-services/passport/passdb"
+	"xsyn-services/passport/passdb/passdbtest"
+	"xsyn-services/passport/payments"
+	"xsyn-services/types"
+
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// expiredWithdraw withdraws the user's SUPS with a signature that has already expired
+func expiredWithdraw(t *testing.T, ucm payments.UserCacheMap, user *boiler.User, amount decimal.Decimal) *boiler.PendingRefund {
+	t.Helper()
+	id, err := payments.InsertPendingRefund(ucm, types.UserIDFromString(user.ID), amount, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("failed to insert pending refund: %s", err)
+	}
+	refund, err := boiler.FindPendingRefund(passdb.StdConn, id)
+	if err != nil {
+		t.Fatalf("failed to get pending refund: %s", err)
+	}
+	return refund
+}
+
+func TestReverseFailedWithdraws(t *testing.T) {
+	passdbtest.Require(t)
+
+	amount := decimal.New(100, 18)
+	op := &payments.PendingOperator{Name: "test"}
+
+	t.Run("refunds once however many times it runs", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		user := passdbtest.User(t)
+		passdbtest.Fund(t, user, amount)
+		refund := expiredWithdraw(t, ucm, user, amount)
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _, _ = payments.ReverseFailedWithdraws(ucm, true)
+			}()
+		}
+		wg.Wait()
+		_, _, err := payments.ReverseFailedWithdraws(ucm, true)
+		if err != nil {
+			t.Fatalf("failed to reverse withdraws: %s", err)
+		}
+
+		if got := passdbtest.Balance(t, user); !got.Equal(amount) {
+			t.Errorf("balance = %s, want %s", got, amount)
+		}
+		err = refund.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if payments.PendingRefundStatus(refund) != payments.PendingStatusRolledBack || !refund.ReversalTransactionID.Valid {
+			t.Errorf("refund status = %s, reversal tx = %v", payments.PendingRefundStatus(refund), refund.ReversalTransactionID)
+		}
+	})
+
+	t.Run("dry run moves nothing", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		user := passdbtest.User(t)
+		passdbtest.Fund(t, user, amount)
+		refund := expiredWithdraw(t, ucm, user, amount)
+
+		_, _, err := payments.ReverseFailedWithdraws(ucm, false)
+		if err != nil {
+			t.Fatalf("failed to reverse withdraws: %s", err)
+		}
+		if got := passdbtest.Balance(t, user); !got.IsZero() {
+			t.Errorf("balance = %s, want 0", got)
+		}
+		err = refund.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if payments.PendingRefundStatus(refund) != payments.PendingStatusExpired {
+			t.Errorf("refund status = %s, want %s", payments.PendingRefundStatus(refund), payments.PendingStatusExpired)
+		}
+	})
+
+	t.Run("failed transfer releases the claim", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		user := passdbtest.User(t)
+		passdbtest.Fund(t, user, amount)
+		refund := expiredWithdraw(t, ucm, user, amount)
+
+		ucm.Fail = true
+		_, _, err := payments.ReverseFailedWithdraws(ucm, true)
+		if err != nil {
+			t.Fatalf("failed to reverse withdraws: %s", err)
+		}
+		err = refund.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if payments.PendingRefundStatus(refund) != payments.PendingStatusExpired {
+			t.Errorf("refund status = %s, want %s", payments.PendingRefundStatus(refund), payments.PendingStatusExpired)
+		}
+	})
+
+	t.Run("fresh claims are left alone", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		user := passdbtest.User(t)
+		passdbtest.Fund(t, user, amount)
+		refund := expiredWithdraw(t, ucm, user, amount)
+
+		refund.RollbackClaimedAt = null.TimeFrom(time.Now())
+		_, err := refund.Update(passdb.StdConn, boil.Whitelist(boiler.PendingRefundColumns.RollbackClaimedAt))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, _, err = payments.ReverseFailedWithdraws(ucm, true)
+		if err != nil {
+			t.Fatalf("failed to reverse withdraws: %s", err)
+		}
+		if got := passdbtest.Balance(t, user); !got.IsZero() {
+			t.Errorf("balance = %s, want 0", got)
+		}
+
+		_, err = payments.PendingRefundCancel(refund.ID, op, "test")
+		if err == nil {
+			t.Errorf("canceled a refund that is being rolled back")
+		}
+	})
+
+	t.Run("stale claim links the refund already in the ledger", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		user := passdbtest.User(t)
+		passdbtest.Fund(t, user, amount)
+		refund := expiredWithdraw(t, ucm, user, amount)
+
+		// the SUPS went back but the process died before marking the refund
+		onChain, err := boiler.FindUser(passdb.StdConn, types.OnChainUserID.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		refundTxID, err := ucm.Transact(&types.NewTransaction{
+			CreditAccountID:      user.AccountID,
+			DebitAccountID:       onChain.AccountID,
+			Amount:               amount,
+			TransactionReference: types.TransactionReference(fmt.Sprintf("REFUND %s", refund.TransactionReference)),
+			Group:                types.TransactionGroupWithdrawal,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		refund.RollbackClaimedAt = null.TimeFrom(time.Now().Add(-time.Hour))
+		_, err = refund.Update(passdb.StdConn, boil.Whitelist(boiler.PendingRefundColumns.RollbackClaimedAt))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, _, err = payments.ReverseFailedWithdraws(ucm, true)
+		if err != nil {
+			t.Fatalf("failed to reverse withdraws: %s", err)
+		}
+		if got := passdbtest.Balance(t, user); !got.Equal(amount) {
+			t.Errorf("balance = %s, want %s", got, amount)
+		}
+		err = refund.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !refund.IsRefunded || refund.ReversalTransactionID.String != refundTxID {
+			t.Errorf("refund not linked to %s: refunded %v, reversal tx %v", refundTxID, refund.IsRefunded, refund.ReversalTransactionID)
+		}
+	})
+}
+
+func TestPendingRefundActions(t *testing.T) {
+	passdbtest.Require(t)
+
+	amount := decimal.New(100, 18)
+	op := &payments.PendingOperator{Name: "test"}
+
+	t.Run("force rollback refuses without an on chain check", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		user := passdbtest.User(t)
+		passdbtest.Fund(t, user, amount)
+		refund := expiredWithdraw(t, ucm, user, amount)
+
+		_, err := payments.PendingRefundForceRollback(ucm, nil, refund.ID, op, "test")
+		if err == nil {
+			t.Fatalf("rolled back without checking the chain")
+		}
+		if got := passdbtest.Balance(t, user); !got.IsZero() {
+			t.Errorf("balance = %s, want 0", got)
+		}
+	})
+
+	t.Run("confirmed refunds can't be rolled back", func(t *testing.T) {
+		ucm := &passdbtest.Transactor{}
+		user := passdbtest.User(t)
+		passdbtest.Fund(t, user, amount)
+		refund := expiredWithdraw(t, ucm, user, amount)
+
+		_, err := payments.PendingRefundForceConfirm(refund.ID, "0xabc", op, "test")
+		if err != nil {
+			t.Fatalf("failed to confirm: %s", err)
+		}
+		_, _, err = payments.ReverseFailedWithdraws(ucm, true)
+		if err != nil {
+			t.Fatalf("failed to reverse withdraws: %s", err)
+		}
+		if got := passdbtest.Balance(t, user); !got.IsZero() {
+			t.Errorf("balance = %s, want 0", got)
+		}
+		_, err = payments.PendingRefundCancel(refund.ID, op, "test")
+		if err == nil {
+			t.Errorf("canceled a confirmed refund")
+		}
+	})
+}
+
+func TestPending1155RollbackActions(t *testing.T) {
+	passdbtest.Require(t)
+
+	collection := passdbtest.Collection(t)
+	op := &payments.PendingOperator{Name: "test"}
+
+	t.Run("force rollback refuses without an on chain check", func(t *testing.T) {
+		user := passdbtest.User(t)
+		userAsset := passdbtest.Asset1155(t, collection, user, 1, 3)
+		rollback := &boiler.Pending1155Rollback{
+			UserID:     user.ID,
+			AssetID:    userAsset.ID,
+			Count:      2,
+			RefundedAt: time.Now().Add(-time.Hour),
+		}
+		err := rollback.Insert(passdb.StdConn, boil.Infer())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = payments.Pending1155RollbackForceRollback(nil, rollback.ID, op, "test")
+		if err == nil {
+			t.Fatalf("rolled back without checking the chain")
+		}
+		err = userAsset.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if userAsset.Count != 3 {
+			t.Errorf("count = %d, want 3", userAsset.Count)
+		}
+		err = rollback.Reload(passdb.StdConn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rollback.IsRefunded {
+			t.Errorf("rollback marked refunded")
+		}
+	})
+}
@@ -1,5 +1,7 @@
 package payments
 
+import "time"
+
 // PriceResp is a record for price feed
 // GET /eth_price
 // GET /bnb_price
@@ -26,6 +28,15 @@ type PurchaseRecord struct {
 	UsdRate         string `json:"usd_rate"`
 	Sups            string `json:"sups"`
 	TxHash          string `json:"tx_hash"`
+	Time            int    `json:"time"`
+}
+
+// PurchasedAt is the time of the purchase's block, zero when avant didn't send it
+func (r *PurchaseRecord) PurchasedAt() time.Time {
+	if r.Time == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(r.Time), 0)
 }
 
 // NFTOwnerRecord is a record for current owners for NFTs
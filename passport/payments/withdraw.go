@@ -2,11 +2,11 @@ package payments
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 	"xsyn-services/boiler"
 	"xsyn-services/passport/api/users"
-	"xsyn-services/passport/db"
 	"xsyn-services/passport/passdb"
 	"xsyn-services/passport/passlog"
 	"xsyn-services/types"
@@ -112,6 +112,7 @@ func UpdateSuccessfulWithdrawsWithTxHash(
 			boiler.PendingRefundWhere.DeletedAt.IsNull(),
 			boiler.PendingRefundWhere.TXHash.EQ(""),
 			boiler.PendingRefundWhere.TXHash.NEQ(record.TxHash), // Ignore tx hash if already assigned to another pending refund
+			boiler.PendingRefundWhere.RollbackClaimedAt.IsNull(),
 		}
 
 		count, err := boiler.PendingRefunds(filter...).Count(passdb.StdConn)
@@ -135,15 +136,25 @@ func UpdateSuccessfulWithdrawsWithTxHash(
 			skipped++
 			continue
 		}
-		pendingRefund.TXHash = record.TxHash
-		pendingRefund.RefundCanceledAt = null.TimeFrom(time.Now())
-
-		_, err = pendingRefund.Update(passdb.StdConn, boil.Whitelist(boiler.PendingRefundColumns.TXHash, boiler.PendingRefundColumns.RefundCanceledAt))
+		// only set the tx hash while no rollback has claimed the refund
+		updated, err := boiler.PendingRefunds(
+			boiler.PendingRefundWhere.ID.EQ(pendingRefund.ID),
+			boiler.PendingRefundWhere.IsRefunded.EQ(false),
+			boiler.PendingRefundWhere.RollbackClaimedAt.IsNull(),
+		).UpdateAll(passdb.StdConn, boiler.M{
+			boiler.PendingRefundColumns.TXHash:           record.TxHash,
+			boiler.PendingRefundColumns.RefundCanceledAt: time.Now(),
+		})
 		if err != nil {
 			l.Warn().Err(err).Msg("failed to update user pending refund with tx hash")
 			skipped++
 			continue
 		}
+		if updated == 0 {
+			l.Warn().Str("refund_id", pendingRefund.ID).Str("tx_hash", record.TxHash).Msg("pending refund matching tx is being rolled back")
+			skipped++
+			continue
+		}
 
 		//l.Info().Msg("successfully set tx hash, cancel refund")
 		success++
@@ -153,6 +164,8 @@ func UpdateSuccessfulWithdrawsWithTxHash(
 }
 
 // ReverseFailedWithdraws Rollback stale withdraws (dangerous if buggy, check very, very carefully)
+// Each refund is claimed before its SUPS move, so a force rollback running at the same time can't reverse it twice.
+// Claims left behind by a failed run are resumed once they are stale.
 func ReverseFailedWithdraws(ucm UserCacheMap, enableWithdrawRollback bool) (int, int, error) {
 	l := passlog.L.
 		With().
@@ -170,6 +183,10 @@ func ReverseFailedWithdraws(ucm UserCacheMap, enableWithdrawRollback bool) (int,
 		boiler.PendingRefundWhere.IsRefunded.EQ(false),
 		boiler.PendingRefundWhere.DeletedAt.IsNull(),
 		boiler.PendingRefundWhere.TXHash.EQ(""),
+		qm.Expr(
+			boiler.PendingRefundWhere.RollbackClaimedAt.IsNull(),
+			qm.Or2(boiler.PendingRefundWhere.RollbackClaimedAt.LT(null.TimeFrom(time.Now().Add(-pendingRollbackClaimTimeout)))),
+		),
 	}
 
 	refundsToProcess, err := boiler.PendingRefunds(filter...).All(passdb.StdConn)
@@ -178,47 +195,21 @@ func ReverseFailedWithdraws(ucm UserCacheMap, enableWithdrawRollback bool) (int,
 	}
 
 	for _, refund := range refundsToProcess {
-		tx, err := db.TransactionGetByReference(refund.TransactionReference)
+		newTx, err := refundTransaction(refund)
 		if err != nil {
 			skipped++
 			l.Warn().Err(err).Msg("failed to process refund")
 			continue
 		}
-		if tx == nil {
-			skipped++
-			l.Warn().Err(fmt.Errorf("no tx found")).Msg("failed to process refund")
-			continue
-		}
-
-		txRef := types.TransactionReference(fmt.Sprintf("REFUND %s", tx.TransactionReference))
-		debitor, err := boiler.FindUser(passdb.StdConn, types.OnChainUserID.String())
-		if err != nil {
-			skipped++
-			l.Warn().Err(err).Msg("failed to get debitor account")
-			continue
-		}
-
-		newTx := &types.NewTransaction{
-			CreditAccountID:      tx.DebitAccountID,
-			DebitAccountID:       debitor.AccountID,
-			Amount:               tx.Amount,
-			TransactionReference: txRef,
-			Description:          fmt.Sprintf("REFUND %s", tx.Description),
-			Group:                types.TransactionGroup(tx.Group),
-		}
-
-		refund.RefundCanceledAt = null.TimeFrom(time.Now())
-		refund.IsRefunded = true
 
 		l = l.With().
 			Str("refund.refund_id", refund.ID).
 			Str("refund.user_id", refund.UserID).
 			Str("refund.amount_sups", refund.AmountSups.Shift(-18).StringFixed(4)).
 			Str("refund.refunded_at", refund.RefundedAt.Format(time.RFC3339)).
-			Str("refund.refund_canceled_at", refund.RefundCanceledAt.Time.Format(time.RFC3339)).
 			Str("refund.tx_hash", refund.TXHash).
 			Str("refund.transaction_reference", refund.TransactionReference).
-			Bool("refund.is_refunded", refund.IsRefunded).
+			Bool("refund.rollback_resumed", refund.RollbackClaimedAt.Valid).
 			Str("reverse_tx.to", newTx.CreditAccountID).
 			Str("reverse_tx.from", newTx.DebitAccountID).
 			Str("reverse_tx.amount", newTx.Amount.String()).
@@ -228,17 +219,14 @@ func ReverseFailedWithdraws(ucm UserCacheMap, enableWithdrawRollback bool) (int,
 			Logger()
 
 		if enableWithdrawRollback {
-			txID, err := ucm.Transact(newTx)
+			claimed, err := claimPendingRefundRollback(refund.ID)
 			if err != nil {
 				skipped++
-				l.Warn().Err(err).Msg("failed to process refund")
+				l.Warn().Err(err).Msg("failed to claim refund")
 				continue
 			}
 
-			// Link withdrawal to transaction ID
-			refund.ReversalTransactionID = null.StringFrom(txID)
-
-			_, err = refund.Update(passdb.StdConn, boil.Infer())
+			_, err = settlePendingRefundRollback(ucm, claimed, newTx, nil, nil, "")
 			if err != nil {
 				skipped++
 				l.Warn().Err(err).Msg("failed to process refund")
@@ -254,3 +242,152 @@ func ReverseFailedWithdraws(ucm UserCacheMap, enableWithdrawRollback bool) (int,
 
 	return success, skipped, nil
 }
+
+// WithdrawLookup is what's needed to look for a SUPS withdraw on chain through avant
+type WithdrawLookup struct {
+	Testnet     bool
+	ContractBSC common.Address
+	ContractETH common.Address
+}
+
+// matchWithdrawRecords gets the withdraw contract transfers that could be the user claiming a withdraw of the amount since the given time
+func matchWithdrawRecords(records []*SUPTransferRecord, toAddress common.Address, amount decimal.Decimal, contracts []common.Address, since time.Time) []*SUPTransferRecord {
+	matches := []*SUPTransferRecord{}
+	for _, record := range records {
+		fromContract := false
+		for _, contract := range contracts {
+			if contract != (common.Address{}) && strings.EqualFold(record.FromAddress, contract.Hex()) {
+				fromContract = true
+				break
+			}
+		}
+		if !fromContract || !strings.EqualFold(record.ToAddress, toAddress.Hex()) {
+			continue
+		}
+		if int64(record.Time) < since.Unix() {
+			continue
+		}
+		val, err := decimal.NewFromString(record.ValueInt)
+		if err != nil || !val.Equal(amount) {
+			continue
+		}
+		matches = append(matches, record)
+	}
+	return matches
+}
+
+// FindWithdrawOnChain looks through every withdraw avant has seen on either chain for one that could have claimed the pending refund.
+// Transfers already matched to another pending refund are ignored. It returns nil when there is none.
+func FindWithdrawOnChain(lookup *WithdrawLookup, refund *boiler.PendingRefund) (*SUPTransferRecord, error) {
+	if lookup == nil {
+		return nil, fmt.Errorf("withdraw lookup is not configured")
+	}
+	user, err := boiler.FindUser(passdb.StdConn, refund.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.PublicAddress.Valid {
+		return nil, fmt.Errorf("user %s has no public address", user.ID)
+	}
+
+	records := []*SUPTransferRecord{}
+	for _, path := range []Path{SUPSWithdrawTxsBSC, SUPSWithdrawTxsETH} {
+		chainRecords, err := getSUPTransferRecords(path, 0, lookup.Testnet)
+		if err != nil {
+			return nil, fmt.Errorf("get withdraw txes: %w", err)
+		}
+		records = append(records, chainRecords...)
+	}
+
+	matches := matchWithdrawRecords(
+		records,
+		common.HexToAddress(user.PublicAddress.String),
+		refund.AmountSups,
+		[]common.Address{lookup.ContractBSC, lookup.ContractETH},
+		refund.CreatedAt,
+	)
+	for _, match := range matches {
+		matched, err := boiler.PendingRefunds(
+			boiler.PendingRefundWhere.TXHash.EQ(match.TxHash),
+			boiler.PendingRefundWhere.ID.NEQ(refund.ID),
+		).Exists(passdb.StdConn)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			return match, nil
+		}
+	}
+	return nil, nil
+}
+
+// matchMint1155Records gets the 1155 mints that could be the user claiming a withdraw of the token's count since the given time
+func matchMint1155Records(records []*NFT1155TransferRecord, toAddress common.Address, tokenID int, count int, since time.Time) []*NFT1155TransferRecord {
+	matches := []*NFT1155TransferRecord{}
+	for _, record := range records {
+		// null address is equal to mint
+		if !strings.EqualFold(record.FromAddress, common.Address{}.Hex()) || !strings.EqualFold(record.ToAddress, toAddress.Hex()) {
+			continue
+		}
+		if record.TokenID != tokenID || int64(record.Time) < since.Unix() {
+			continue
+		}
+		val, err := strconv.Atoi(record.ValueInt)
+		if err != nil || val != count {
+			continue
+		}
+		matches = append(matches, record)
+	}
+	return matches
+}
+
+// FindMint1155OnChain looks through every transfer avant has seen of the withdrawn token's collection for a mint that could have claimed the pending 1155 rollback.
+// Mints already matched to another pending 1155 rollback are ignored. It returns nil when there is none.
+func FindMint1155OnChain(lookup *WithdrawLookup, rollback *boiler.Pending1155Rollback) (*NFT1155TransferRecord, error) {
+	if lookup == nil {
+		return nil, fmt.Errorf("withdraw lookup is not configured")
+	}
+	user, err := boiler.FindUser(passdb.StdConn, rollback.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.PublicAddress.Valid {
+		return nil, fmt.Errorf("user %s has no public address", user.ID)
+	}
+	userAsset, err := boiler.UserAssets1155S(
+		boiler.UserAssets1155Where.ID.EQ(rollback.AssetID),
+		qm.Load(boiler.UserAssets1155Rels.Collection),
+	).One(passdb.StdConn)
+	if err != nil {
+		return nil, err
+	}
+	if !userAsset.R.Collection.MintContract.Valid {
+		return nil, fmt.Errorf("collection %s has no contract", userAsset.R.Collection.Slug)
+	}
+
+	records, err := getNFT1155TransferRecords(MultiTokenTxs, 0, lookup.Testnet, userAsset.R.Collection.MintContract.String)
+	if err != nil {
+		return nil, fmt.Errorf("get 1155 txes: %w", err)
+	}
+
+	matches := matchMint1155Records(
+		records,
+		common.HexToAddress(user.PublicAddress.String),
+		userAsset.ExternalTokenID,
+		rollback.Count,
+		rollback.CreatedAt,
+	)
+	for _, match := range matches {
+		matched, err := boiler.Pending1155Rollbacks(
+			boiler.Pending1155RollbackWhere.TXHash.EQ(match.TxHash),
+			boiler.Pending1155RollbackWhere.ID.NEQ(rollback.ID),
+		).Exists(passdb.StdConn)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			return match, nil
+		}
+	}
+	return nil, nil
+}
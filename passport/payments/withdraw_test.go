@@ -0,0 +1,119 @@
+package payments
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestMatchWithdrawRecords(t *testing.T) {
+	user := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	other := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	contractBSC := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	contractETH := common.HexToAddress("0xabcdef4444444444444444444444444444444444")
+	since := time.Unix(1000, 0)
+
+	record := func(hash string, from, to common.Address, value string, at int) *SUPTransferRecord {
+		return &SUPTransferRecord{TxHash: hash, FromAddress: from.Hex(), ToAddress: to.Hex(), ValueInt: value, Time: at}
+	}
+	records := []*SUPTransferRecord{
+		record("bsc", contractBSC, user, "500", 1500),
+		record("eth lower case", contractETH, user, "500", 2000),
+		record("wrong amount", contractBSC, user, "499", 1500),
+		record("other user", contractBSC, other, "500", 1500),
+		record("not the withdraw contract", other, user, "500", 1500),
+		record("before the withdraw", contractBSC, user, "500", 999),
+		record("bad value", contractBSC, user, "abc", 1500),
+	}
+	records[1].FromAddress = "0xabcdef4444444444444444444444444444444444"
+
+	tests := []struct {
+		name      string
+		contracts []common.Address
+		want      []string
+	}{
+		{"both chains", []common.Address{contractBSC, contractETH}, []string{"bsc", "eth lower case"}},
+		{"eth only", []common.Address{{}, contractETH}, []string{"eth lower case"}},
+		{"unset contracts match nothing", []common.Address{{}, {}}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := matchWithdrawRecords(records, user, decimal.NewFromInt(500), tt.contracts, since)
+			if len(got) != len(tt.want) {
+				t.Fatalf("matchWithdrawRecords() got %d matches, want %d", len(got), len(tt.want))
+			}
+			for i, match := range got {
+				if match.TxHash != tt.want[i] {
+					t.Errorf("matchWithdrawRecords()[%d] = %s, want %s", i, match.TxHash, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMatchMint1155Records(t *testing.T) {
+	user := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	other := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	since := time.Unix(1000, 0)
+
+	record := func(hash string, from, to common.Address, tokenID int, value string, at int) *NFT1155TransferRecord {
+		return &NFT1155TransferRecord{TxHash: hash, FromAddress: from.Hex(), ToAddress: to.Hex(), TokenID: tokenID, ValueInt: value, Time: at}
+	}
+	records := []*NFT1155TransferRecord{
+		record("mint", common.Address{}, user, 3, "2", 1500),
+		record("wrong token", common.Address{}, user, 4, "2", 1500),
+		record("wrong count", common.Address{}, user, 3, "1", 1500),
+		record("other user", common.Address{}, other, 3, "2", 1500),
+		record("transfer, not a mint", other, user, 3, "2", 1500),
+		record("before the withdraw", common.Address{}, user, 3, "2", 999),
+		record("bad value", common.Address{}, user, 3, "abc", 1500),
+	}
+	records = append(records, record("lower case", common.Address{}, user, 3, "2", 2000))
+	records[len(records)-1].ToAddress = strings.ToLower(user.Hex())
+
+	got := matchMint1155Records(records, user, 3, 2, since)
+	want := []string{"mint", "lower case"}
+	if len(got) != len(want) {
+		t.Fatalf("matchMint1155Records() got %d matches, want %d", len(got), len(want))
+	}
+	for i, match := range got {
+		if match.TxHash != want[i] {
+			t.Errorf("matchMint1155Records()[%d] = %s, want %s", i, match.TxHash, want[i])
+		}
+	}
+}
+
+func TestPendingStatus(t *testing.T) {
+	now := time.Now()
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+
+	tests := []struct {
+		name              string
+		isRefunded        bool
+		txHash            string
+		rollbackClaimedAt null.Time
+		refundCanceledAt  null.Time
+		refundedAt        time.Time
+		want              PendingStatus
+	}{
+		{"signature still valid", false, "", null.Time{}, null.Time{}, future, PendingStatusClaimable},
+		{"signature expired", false, "", null.Time{}, null.Time{}, past, PendingStatusExpired},
+		{"tx found", false, "0xabc", null.Time{}, null.TimeFrom(now), past, PendingStatusConfirmed},
+		{"canceled", false, "", null.Time{}, null.TimeFrom(now), past, PendingStatusCanceled},
+		{"rollback claimed", false, "", null.TimeFrom(now), null.Time{}, past, PendingStatusRollingBack},
+		{"rolled back", true, "", null.TimeFrom(now), null.TimeFrom(now), past, PendingStatusRolledBack},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pendingStatus(tt.isRefunded, tt.txHash, tt.rollbackClaimedAt, tt.refundCanceledAt, tt.refundedAt)
+			if got != tt.want {
+				t.Errorf("pendingStatus() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package supremacy_rpcclient
+
+import (
+	"time"
+	"xsyn-services/passport/passlog"
+
+	"github.com/ninja-software/terror/v2"
+	"github.com/volatiletech/null/v8"
+)
+
+type AssetRentalStartReq struct {
+	ApiKey            string      `json:"api_key,omitempty"`
+	RentalID          string      `json:"rental_id"`
+	Hash              string      `json:"hash"`
+	OwnerID           string      `json:"owner_id"`
+	RenterUserID      null.String `json:"renter_user_id"`
+	RenterSyndicateID null.String `json:"renter_syndicate_id"`
+	EndsAt            time.Time   `json:"ends_at"`
+}
+
+type AssetRentalStartResp struct {
+}
+
+// AssetRentalStart tells supremacy a user or syndicate can use the asset until the rental ends
+func AssetRentalStart(req *AssetRentalStartReq) error {
+	err := SupremacyClient.Call("S.AssetRentalStartHandler", req, &AssetRentalStartResp{})
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to start asset rental on supremacy")
+		return terror.Error(err, "communication to supremacy has failed")
+	}
+
+	return nil
+}
+
+type AssetRentalEndReq struct {
+	ApiKey   string `json:"api_key,omitempty"`
+	RentalID string `json:"rental_id"`
+	Hash     string `json:"hash"`
+	OwnerID  string `json:"owner_id"`
+}
+
+type AssetRentalEndResp struct {
+}
+
+// AssetRentalEnd tells supremacy the usage rights of the asset are back with the owner
+func AssetRentalEnd(rentalID, hash, ownerID string) error {
+	req := &AssetRentalEndReq{
+		RentalID: rentalID,
+		Hash:     hash,
+		OwnerID:  ownerID,
+	}
+	err := SupremacyClient.Call("S.AssetRentalEndHandler", req, &AssetRentalEndResp{})
+	if err != nil {
+		passlog.L.Error().Err(err).Interface("req", req).Msg("failed to end asset rental on supremacy")
+		return terror.Error(err, "communication to supremacy has failed")
+	}
+
+	return nil
+}
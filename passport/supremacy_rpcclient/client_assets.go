@@ -100,3 +100,60 @@ func AssetGet(assetHash string) (*XsynAsset, error) {
 
 	return resp.Asset, nil
 }
+
+type AssetsBulkReq struct {
+	AssetHashes []string `json:"asset_hashes"`
+}
+
+type AssetsBulkResp struct {
+	Assets []*XsynAsset `json:"assets"`
+	// Failed holds the reason for each hash supremacy couldn't return
+	Failed map[string]string `json:"failed"`
+}
+
+// AssetsGet fetches the metadata of many assets in one call, used by the metadata refresh worker
+func AssetsGet(assetHashes []string) (*AssetsBulkResp, error) {
+	req := &AssetsBulkReq{
+		AssetHashes: assetHashes,
+	}
+	resp := &AssetsBulkResp{}
+	err := SupremacyClient.Call("S.AssetsBulkHandler", req, resp)
+	if err != nil {
+		return nil, terror.Error(err, "communication to supremacy has failed")
+	}
+
+	return resp, nil
+}
+
+type AssetsReconcileReq struct {
+	AssetHashes []string `json:"asset_hashes"`
+}
+
+// ReconcileAsset is the gameserver's view of an asset, MetadataHash is AssetMetadataHash of the asset it would send passport
+type ReconcileAsset struct {
+	Hash         string      `json:"hash"`
+	OwnerID      string      `json:"owner_id"`
+	XsynLocked   bool        `json:"xsyn_locked"`
+	EquippedOn   null.String `json:"equipped_on"`
+	MetadataHash string      `json:"metadata_hash"`
+}
+
+type AssetsReconcileResp struct {
+	Assets []*ReconcileAsset `json:"assets"`
+	// Missing are the hashes the gameserver has no asset for
+	Missing []string `json:"missing"`
+}
+
+// AssetsReconcile gets the gameserver's owner, lock and metadata hash of many assets in one call, used by the reconciliation job
+func AssetsReconcile(assetHashes []string) (*AssetsReconcileResp, error) {
+	req := &AssetsReconcileReq{
+		AssetHashes: assetHashes,
+	}
+	resp := &AssetsReconcileResp{}
+	err := SupremacyClient.Call("S.AssetsReconcileHandler", req, resp)
+	if err != nil {
+		return nil, terror.Error(err, "communication to supremacy has failed")
+	}
+
+	return resp, nil
+}
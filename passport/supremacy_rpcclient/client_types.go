@@ -1,6 +1,9 @@
 package supremacy_rpcclient
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"github.com/volatiletech/sqlboiler/v4/types"
 	"time"
 	xsynTypes "xsyn-services/types"
@@ -415,3 +418,48 @@ type XsynAsset struct {
 	OnChainStatus    string                 `json:"on_chain_status,omitempty"`
 	Service          string                 `json:"xsyn_locked"`
 }
+
+// assetMetadata is the part of an asset both services hash to compare metadata, ownership and locks are compared on their own
+type assetMetadata struct {
+	CollectionSlug   string                 `json:"collection_slug"`
+	TokenID          int64                  `json:"token_id"`
+	Tier             string                 `json:"tier"`
+	Name             string                 `json:"name"`
+	Attributes       []*xsynTypes.Attribute `json:"attributes"`
+	AssetType        null.String            `json:"asset_type"`
+	ImageURL         null.String            `json:"image_url"`
+	ExternalURL      null.String            `json:"external_url"`
+	Description      null.String            `json:"description"`
+	BackgroundColor  null.String            `json:"background_color"`
+	AnimationURL     null.String            `json:"animation_url"`
+	YoutubeURL       null.String            `json:"youtube_url"`
+	CardAnimationURL null.String            `json:"card_animation_url"`
+	AvatarURL        null.String            `json:"avatar_url"`
+	LargeImageURL    null.String            `json:"large_image_url"`
+}
+
+// AssetMetadataHash is a sha256 of the asset's metadata as json, the gameserver computes it the same way on the asset it would send
+func AssetMetadataHash(asset *XsynAsset) (string, error) {
+	b, err := json.Marshal(&assetMetadata{
+		CollectionSlug:   asset.CollectionSlug,
+		TokenID:          asset.TokenID,
+		Tier:             asset.Tier,
+		Name:             asset.Name,
+		Attributes:       asset.Attributes,
+		AssetType:        asset.AssetType,
+		ImageURL:         asset.ImageURL,
+		ExternalURL:      asset.ExternalURL,
+		Description:      asset.Description,
+		BackgroundColor:  asset.BackgroundColor,
+		AnimationURL:     asset.AnimationURL,
+		YoutubeURL:       asset.YoutubeURL,
+		CardAnimationURL: asset.CardAnimationURL,
+		AvatarURL:        asset.AvatarURL,
+		LargeImageURL:    asset.LargeImageURL,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -37,6 +37,7 @@ type UserAsset struct {
 	UpdatedAt        time.Time    `json:"updated_at"`
 	CreatedAt        time.Time    `json:"created_at"`
 	LockedToService  null.String  `json:"locked_to_service,omitempty"`
+	ImageBlurhash    null.String  `json:"image_blurhash,omitempty"`
 }
 
 type User1155Asset struct {
@@ -133,6 +134,7 @@ func UserAssetFromBoiler(us *boiler.UserAsset) *UserAsset {
 		AvatarURL:        us.AvatarURL,
 		LargeImageURL:    us.LargeImageURL,
 		LockedToService:  us.LockedToService,
+		ImageBlurhash:    us.ImageBlurhash,
 		OnChainStatus:    "UNKNOWN",
 	}
 
@@ -25,16 +25,19 @@ type Config struct {
 }
 
 type Web3Params struct {
-	BscChainID            int
-	EthChainID            int
-	MoralisKey            string
-	SignerPrivateKey      string
+	BscChainID       int
+	EthChainID       int
+	MoralisKey       string
+	SignerPrivateKey string
+	// AchievementsSignerKey signs supremacy-achievements withdrawals when it has no collection key
 	AchievementsSignerKey string
-	SupAddrBSC            common.Address
-	SupAddrETH            common.Address
-	SupWithdrawalAddrBSC  common.Address
-	SupWithdrawalAddrETH  common.Address
-	PurchaseAddress       common.Address
+	// CollectionSignerKeys are the private keys that sign 1155 withdrawals, by collection slug
+	CollectionSignerKeys map[string]string
+	SupAddrBSC           common.Address
+	SupAddrETH           common.Address
+	SupWithdrawalAddrBSC common.Address
+	SupWithdrawalAddrETH common.Address
+	PurchaseAddress      common.Address
 }
 
 type AuthParams struct {
@@ -14,6 +14,7 @@ var (
 	UserRoleOffChain         = RoleID(uuid.Must(uuid.FromString("da2cb7b6-a795-4ad5-bcda-ce75469904e6")))
 	UserRoleXsynSaleTreasury = RoleID(uuid.Must(uuid.FromString("169cc7b9-fe5f-499b-8627-57d919bfac33")))
 	UserRoleRepairCenter     = RoleID(uuid.Must(uuid.FromString("1db10a78-8d68-4606-b13e-f46bb84a134b")))
+	UserRoleSyndicate        = RoleID(uuid.Must(uuid.FromString("4d6e2b1a-93c7-4f58-a0e2-7b1c5d9f3a68")))
 )
 
 // Role is an object representing the database table.
@@ -62,6 +63,11 @@ const (
 	PermProductArchive   Perm = "ProductArchive"
 	PermProductUnarchive Perm = "ProductUnarchive"
 
+	PermCollectionCreate    Perm = "CollectionCreate"
+	PermCollectionUpdate    Perm = "CollectionUpdate"
+	PermCollectionArchive   Perm = "CollectionArchive"
+	PermCollectionUnarchive Perm = "CollectionUnarchive"
+
 	PermAdminPortal      Perm = "AdminPortal"
 	PermImpersonateUser  Perm = "ImpersonateUser"
 	PermUserActivityList Perm = "UserActivityList"
@@ -98,6 +104,11 @@ var AllPerm = []Perm{
 	PermProductArchive,
 	PermProductUnarchive,
 
+	PermCollectionCreate,
+	PermCollectionUpdate,
+	PermCollectionArchive,
+	PermCollectionUnarchive,
+
 	PermAdminPortal,
 	PermImpersonateUser,
 	PermUserActivityList,
@@ -72,6 +72,7 @@ const (
 	TransactionGroupAssetManagement TransactionGroup = "ASSET MANAGEMENT"
 	TransactionGroupTesting         TransactionGroup = "TESTING"
 	TransactionGroupSupremacyWorld  TransactionGroup = "SUPREMACY WORLD"
+	TransactionGroupMarketplace     TransactionGroup = "MARKETPLACE"
 )
 
 type TransactionSubGroup string
@@ -81,4 +82,8 @@ const (
 	TransactionSubGroupTransfer        TransactionSubGroup = "TRANSFER"
 	TransactionSubGroupRefund          TransactionSubGroup = "REFUND"
 	TransactionSubGroupPurchase        TransactionSubGroup = "PURCHASE"
+	TransactionSubGroupFee             TransactionSubGroup = "FEE"
+	TransactionSubGroupBid             TransactionSubGroup = "BID"
+	TransactionSubGroupRental          TransactionSubGroup = "RENTAL"
+	TransactionSubGroupCrafting        TransactionSubGroup = "CRAFTING"
 )
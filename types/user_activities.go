@@ -40,6 +40,7 @@ const (
 	ObjectTypeRole         ObjectType = "Role"
 	ObjectTypeUser         ObjectType = "User"
 	ObjectTypeProduct      ObjectType = "Product"
+	ObjectTypeCollection   ObjectType = "Collection"
 )
 
 // AllObjectType contains all ObjectType enums
@@ -48,6 +49,7 @@ var AllObjectType = []ObjectType{
 	ObjectTypeOrganisation,
 	ObjectTypeRole,
 	ObjectTypeUser,
+	ObjectTypeCollection,
 }
 
 func (e ObjectType) String() string {
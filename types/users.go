@@ -23,6 +23,8 @@ const (
 	XsynSaleUsername                   string = "XsynSale"
 	RepairCenter                       string = "Repair-Center"
 	SupremacyChallengeFund             string = "Supremacy-Challenge-Fund"
+	XsynMarketplaceUsername            string = "Xsyn-Marketplace"
+	XsynTradeEscrowUsername            string = "Xsyn-Trade-Escrow"
 )
 
 var (
@@ -38,6 +40,8 @@ var (
 	RepairCentreUserID               = UserID(uuid.Must(uuid.FromString("a988b1e3-5556-4cad-83bd-d61c2b149cb7")))
 	SupremacyChallengeFundUserID     = UserID(uuid.Must(uuid.FromString("5bca9b58-a71c-4134-85d4-50106a8966dc")))
 	SupremacyWorldUserID             = UserID(uuid.Must(uuid.FromString("ba8ce250-7901-48fa-bf0c-52cd90fe139f")))
+	XsynMarketplaceUserID            = UserID(uuid.Must(uuid.FromString("7c4e5a8f-3d2b-4f6a-b1c9-8e0d2a5f6b31")))
+	XsynTradeEscrowUserID            = UserID(uuid.Must(uuid.FromString("c3a9f2d6-1e84-4b7a-9f05-6b2d8e4a1c73")))
 )
 
 func IsSystemUser(userID string) bool {
@@ -49,7 +53,9 @@ func IsSystemUser(userID string) bool {
 		SupremacySupPoolUserID.String(),
 		SupremacyZaibatsuUserID.String(),
 		SupremacyRedMountainUserID.String(),
-		SupremacyBostonCyberneticsUserID.String():
+		SupremacyBostonCyberneticsUserID.String(),
+		XsynMarketplaceUserID.String(),
+		XsynTradeEscrowUserID.String():
 		return true
 	}
 	return false
@@ -89,11 +95,12 @@ func UserFromBoil(u *boiler.User) (*User, error) {
 }
 
 type UserBrief struct {
-	ID        string          `json:"id" db:"id"`
-	Username  string          `json:"username" db:"username"`
-	AvatarID  *string         `json:"avatar_id" db:"avatar_id"`
-	FactionID *string         `json:"faction_id" db:"faction_id"`
-	Faction   *boiler.Faction `json:"faction" db:"-"`
+	ID                string          `json:"id" db:"id"`
+	Username          string          `json:"username" db:"username"`
+	AvatarID          *string         `json:"avatar_id" db:"avatar_id"`
+	AvatarUserAssetID *string         `json:"avatar_user_asset_id" db:"avatar_user_asset_id"`
+	FactionID         *string         `json:"faction_id" db:"faction_id"`
+	Faction           *boiler.Faction `json:"faction" db:"-"`
 }
 
 type UserMetadata struct {